@@ -94,6 +94,9 @@ var ErrGetTransaction = errors.New("getting transaction failed")
 // ErrGetSmartContractResults signals an error happening when trying to fetch smart contract results
 var ErrGetSmartContractResults = errors.New("getting smart contract results failed")
 
+// ErrGetTransactionTrace signals an error happening when trying to fetch the execution trace of a transaction
+var ErrGetTransactionTrace = errors.New("getting transaction trace failed")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
@@ -139,6 +142,9 @@ var ErrCannotCreateGinWebServer = errors.New("cannot create gin web server")
 // ErrNilFacadeHandler signals that a nil facade handler has been provided
 var ErrNilFacadeHandler = errors.New("nil facade handler")
 
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
 // ErrFacadeWrongTypeAssertion signals that a type conversion to a facade type failed
 var ErrFacadeWrongTypeAssertion = errors.New("facade - wrong type assertion")
 
@@ -178,6 +184,9 @@ var ErrRegisteredNFTTokenIDs = errors.New("getting registered nft token ids erro
 // ErrInvalidRole signals that an invalid role was provided
 var ErrInvalidRole = errors.New("invalid role")
 
+// ErrGetAddressTransactions signals an error in getting the transactions involving an address
+var ErrGetAddressTransactions = errors.New("getting address transactions error")
+
 // ErrIsDataTrieMigrated signals that an error occurred while trying to verify the migration status of the data trie
 var ErrIsDataTrieMigrated = errors.New("could not verify the migration status of the data trie")
 
@@ -192,3 +201,24 @@ var ErrGetWaitingEpochsLeftForPublicKey = errors.New("error getting the waiting
 
 // ErrRecursiveRelayedTxIsNotAllowed signals that recursive relayed tx is not allowed
 var ErrRecursiveRelayedTxIsNotAllowed = errors.New("recursive relayed tx is not allowed")
+
+// ErrSubscribe signals an error happening when trying to subscribe for node notifications
+var ErrSubscribe = errors.New("subscribing for notifications failed")
+
+// ErrGetNetworkConfig signals an error happening when trying to fetch the network configuration metrics
+var ErrGetNetworkConfig = errors.New("getting network config failed")
+
+// ErrGetNetworkStatus signals an error happening when trying to fetch the network status metrics
+var ErrGetNetworkStatus = errors.New("getting network status failed")
+
+// ErrInvalidNumberOfVMQueries signals that an empty list or too many vm queries have been provided
+var ErrInvalidNumberOfVMQueries = errors.New("invalid number of vm queries")
+
+// ErrGetAccountStateDiff signals an error in getting the state differences of an account
+var ErrGetAccountStateDiff = errors.New("getting account state diff error")
+
+// ErrGetStateDiff signals an error in getting the state differences between two blocks
+var ErrGetStateDiff = errors.New("getting state diff error")
+
+// ErrExportStateSnapshot signals an error in exporting a state snapshot
+var ErrExportStateSnapshot = errors.New("exporting state snapshot error")
@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/logs"
+	"github.com/multiversx/mx-chain-go/api/openapi"
 	"github.com/multiversx/mx-chain-go/config"
 	"gopkg.in/go-playground/validator.v8"
 )
@@ -38,18 +39,29 @@ func checkArgs(args ArgsNewWebServer) error {
 	if check.IfNil(args.Facade) {
 		return fmt.Errorf("%w: %s", apiErrors.ErrCannotCreateGinWebServer, apiErrors.ErrNilFacadeHandler.Error())
 	}
+	if check.IfNil(args.StatusHandler) {
+		return fmt.Errorf("%w: %s", apiErrors.ErrCannotCreateGinWebServer, apiErrors.ErrNilAppStatusHandler.Error())
+	}
 
 	return nil
 }
 
 func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
-	logConfig, ok := routesConfig.APIPackages["log"]
+	return isRootRouteEnabled(routesConfig, "log", "/log")
+}
+
+func isOpenApiRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
+	return isRootRouteEnabled(routesConfig, "openapi", openApiRoute)
+}
+
+func isRootRouteEnabled(routesConfig config.ApiRoutesConfig, packageName string, route string) bool {
+	packageConfig, ok := routesConfig.APIPackages[packageName]
 	if !ok {
 		return false
 	}
 
-	for _, cfg := range logConfig.Routes {
-		if cfg.Name == "/log" && cfg.Open {
+	for _, cfg := range packageConfig.Routes {
+		if cfg.Name == route && cfg.Open {
 			return true
 		}
 	}
@@ -99,3 +111,9 @@ func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 		ls.StartSendingBlocking()
 	})
 }
+
+func registerOpenApiRoute(ws *gin.Engine, document *openapi.Document) {
+	ws.GET(openApiRoute, func(c *gin.Context) {
+		c.JSON(http.StatusOK, document)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade/initial"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,6 +19,7 @@ func TestCommon_checkArgs(t *testing.T) {
 		Facade:          nil,
 		ApiConfig:       config.ApiRoutesConfig{},
 		AntiFloodConfig: config.WebServerAntifloodConfig{},
+		StatusHandler:   &statusHandler.AppStatusHandlerStub{},
 	}
 	err := checkArgs(args)
 	require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
@@ -31,6 +33,11 @@ func TestCommon_checkArgs(t *testing.T) {
 	require.NoError(t, err)
 	err = checkArgs(args)
 	require.NoError(t, err)
+
+	args.StatusHandler = nil
+	err = checkArgs(args)
+	require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
+	require.Contains(t, err.Error(), apiErrors.ErrNilAppStatusHandler.Error())
 }
 
 func TestCommon_isLogRouteEnabled(t *testing.T) {
@@ -53,3 +60,23 @@ func TestCommon_isLogRouteEnabled(t *testing.T) {
 	require.True(t, isLogRouteEnabled(routesConfig))
 	require.False(t, isLogRouteEnabled(config.ApiRoutesConfig{}))
 }
+
+func TestCommon_isOpenApiRouteEnabled(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, isOpenApiRouteEnabled(config.ApiRoutesConfig{}))
+
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"openapi": {
+				Routes: []config.RouteConfig{
+					{Name: "/openapi.json", Open: false},
+				},
+			},
+		},
+	}
+	require.False(t, isOpenApiRouteEnabled(routesConfig))
+
+	routesConfig.APIPackages["openapi"].Routes[0].Open = true
+	require.True(t, isOpenApiRouteEnabled(routesConfig))
+}
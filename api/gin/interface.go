@@ -7,6 +7,11 @@ type resetHandler interface {
 	IsInterfaceNil() bool
 }
 
+type reloadHandler interface {
+	ReloadIfChanged() error
+	IsInterfaceNil() bool
+}
+
 type server interface {
 	ListenAndServe() error
 	Shutdown(ctx context.Context) error
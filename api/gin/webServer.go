@@ -10,12 +10,15 @@ import (
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/openapi"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -24,13 +27,18 @@ import (
 
 var log = logger.GetOrCreate("api/gin")
 
-const prometheusMetricsRoute = "/debug/metrics/prometheus"
+const (
+	prometheusMetricsRoute = "/debug/metrics/prometheus"
+	openApiRoute           = "/openapi.json"
+	unknownAppVersion      = "unknown"
+)
 
 // ArgsNewWebServer holds the arguments needed to create a new instance of webServer
 type ArgsNewWebServer struct {
 	Facade          shared.FacadeHandler
 	ApiConfig       config.ApiRoutesConfig
 	AntiFloodConfig config.WebServerAntifloodConfig
+	StatusHandler   core.AppStatusHandler
 }
 
 type webServer struct {
@@ -38,6 +46,7 @@ type webServer struct {
 	facade          shared.FacadeHandler
 	apiConfig       config.ApiRoutesConfig
 	antiFloodConfig config.WebServerAntifloodConfig
+	statusHandler   core.AppStatusHandler
 	httpServer      shared.HttpServerCloser
 	groups          map[string]shared.GroupHandler
 	cancelFunc      func()
@@ -54,6 +63,7 @@ func NewGinWebServerHandler(args ArgsNewWebServer) (*webServer, error) {
 		facade:          args.Facade,
 		antiFloodConfig: args.AntiFloodConfig,
 		apiConfig:       args.ApiConfig,
+		statusHandler:   args.StatusHandler,
 	}, nil
 }
 
@@ -180,6 +190,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["network"] = networkGroup
 
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["jsonrpc"] = jsonRpcGroup
+
 	nodeGroup, err := groups.NewNodeGroup(ws.facade)
 	if err != nil {
 		return err
@@ -192,12 +208,24 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["proof"] = proofGroup
 
+	stateGroup, err := groups.NewStateGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["state"] = stateGroup
+
 	transactionGroup, err := groups.NewTransactionGroup(ws.facade)
 	if err != nil {
 		return err
 	}
 	groupsMap["transaction"] = transactionGroup
 
+	subscribeGroup, err := groups.NewSubscribeGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["subscribe"] = subscribeGroup
+
 	validatorGroup, err := groups.NewValidatorGroup(ws.facade)
 	if err != nil {
 		return err
@@ -227,6 +255,10 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 		registerLoggerWsRoute(ginRouter, marshalizerForLogs)
 	}
 
+	if isOpenApiRouteEnabled(ws.apiConfig) {
+		registerOpenApiRoute(ginRouter, ws.createOpenApiDocument())
+	}
+
 	if ws.facade.PprofEnabled() {
 		pprof.Register(ginRouter)
 	}
@@ -236,6 +268,38 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 	}
 }
 
+func (ws *webServer) createOpenApiDocument() *openapi.Document {
+	endpoints := make(map[string][]*shared.EndpointHandlerData, len(ws.groups))
+	for groupName, groupHandler := range ws.groups {
+		endpoints[groupName] = groupHandler.GetEndpoints()
+	}
+
+	return openapi.GenerateDocument(openapi.ArgsGenerateDocument{
+		Version:   ws.getAppVersion(),
+		Groups:    endpoints,
+		ApiConfig: ws.apiConfig,
+	})
+}
+
+func (ws *webServer) getAppVersion() string {
+	statusMetrics := ws.facade.StatusMetrics()
+	if check.IfNil(statusMetrics) {
+		return unknownAppVersion
+	}
+
+	metrics, err := statusMetrics.StatusMetricsMapWithoutP2P()
+	if err != nil {
+		return unknownAppVersion
+	}
+
+	version, ok := metrics[common.MetricAppVersion].(string)
+	if !ok || len(version) == 0 {
+		return unknownAppVersion
+	}
+
+	return version
+}
+
 func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, error) {
 	middlewares := make([]shared.MiddlewareProcessor, 0)
 
@@ -244,15 +308,38 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 		middlewares = append(middlewares, responseLoggerMiddleware)
 	}
 
+	requestDurationMiddleware, err := middleware.NewRequestDurationMiddleware(ws.statusHandler)
+	if err != nil {
+		return nil, err
+	}
+	middlewares = append(middlewares, requestDurationMiddleware)
+
+	var ctx context.Context
+	ctx, ws.cancelFunc = context.WithCancel(context.Background())
+
+	if ws.apiConfig.ApiKeys.Enabled {
+		argsApiKeysThrottler := middleware.ArgsApiKeysThrottler{
+			Config:        ws.apiConfig.ApiKeys,
+			StatusHandler: ws.statusHandler,
+		}
+		apiKeysLimiter, err := middleware.NewApiKeysThrottler(argsApiKeysThrottler)
+		if err != nil {
+			return nil, err
+		}
+
+		if ws.apiConfig.ApiKeys.ReloadIntervalInSec > 0 {
+			go ws.apiKeysReload(ctx, apiKeysLimiter)
+		}
+
+		middlewares = append(middlewares, apiKeysLimiter)
+	}
+
 	if ws.antiFloodConfig.WebServerAntifloodEnabled {
 		sourceLimiter, err := middleware.NewSourceThrottler(ws.antiFloodConfig.SameSourceRequests)
 		if err != nil {
 			return nil, err
 		}
 
-		var ctx context.Context
-		ctx, ws.cancelFunc = context.WithCancel(context.Background())
-
 		go ws.sourceLimiterReset(ctx, sourceLimiter)
 
 		middlewares = append(middlewares, sourceLimiter)
@@ -282,6 +369,22 @@ func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler)
 	}
 }
 
+func (ws *webServer) apiKeysReload(ctx context.Context, reloader reloadHandler) {
+	betweenReloadsDuration := time.Second * time.Duration(ws.apiConfig.ApiKeys.ReloadIntervalInSec)
+	for {
+		select {
+		case <-time.After(betweenReloadsDuration):
+			err := reloader.ReloadIfChanged()
+			if err != nil {
+				log.Error("cannot reload the API keys, keeping the previous ones", "error", err)
+			}
+		case <-ctx.Done():
+			log.Debug("closing webServer.apiKeysReload go routine")
+			return
+		}
+	}
+}
+
 // Close will handle the closing of inner components
 func (ws *webServer) Close() error {
 	if ws.cancelFunc != nil {
@@ -1,20 +1,28 @@
 package gin
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/openapi"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/api"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -46,6 +54,7 @@ func createMockArgsNewWebServer() ArgsNewWebServer {
 			SameSourceRequests:           1,
 			SameSourceResetIntervalInSec: 1,
 		},
+		StatusHandler: &statusHandler.AppStatusHandlerStub{},
 	}
 }
 
@@ -203,3 +212,83 @@ func TestWebServer_CloseWithDisabledServerShouldNotPanic(t *testing.T) {
 	err = ws.Close()
 	assert.Nil(t, err)
 }
+
+func TestWebServer_AllEndpointsShouldHaveSchemaMetadata(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := NewGinWebServerHandler(createMockArgsNewWebServer())
+	require.NotNil(t, ws)
+
+	err := ws.createGroups()
+	require.Nil(t, err)
+
+	for groupName, groupHandler := range ws.groups {
+		for _, endpoint := range groupHandler.GetEndpoints() {
+			endpointName := fmt.Sprintf("%s /%s%s", endpoint.Method, groupName, endpoint.Path)
+			require.NotNil(t, endpoint.Schema, "%s has no schema metadata for the OpenAPI specification", endpointName)
+			require.NotEmpty(t, endpoint.Schema.Summary, "%s has no summary for the OpenAPI specification", endpointName)
+		}
+	}
+}
+
+func TestWebServer_OpenApiDocument(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewWebServer()
+	args.Facade = &mock.FacadeStub{
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return &testscommon.StatusMetricsStub{
+				StatusMetricsMapWithoutP2PCalled: func() (map[string]interface{}, error) {
+					return map[string]interface{}{common.MetricAppVersion: "v1.2.3"}, nil
+				},
+			}
+		},
+	}
+	ws, _ := NewGinWebServerHandler(args)
+	require.NotNil(t, ws)
+
+	err := ws.createGroups()
+	require.Nil(t, err)
+
+	// all the routes are open, except the ones of the hardfork group
+	numOpenEndpoints := 0
+	ws.apiConfig.APIPackages = map[string]config.APIPackageConfig{
+		"openapi": {Routes: []config.RouteConfig{{Name: openApiRoute, Open: true}}},
+	}
+	for groupName, groupHandler := range ws.groups {
+		if groupName == "hardfork" {
+			continue
+		}
+
+		routes := make([]config.RouteConfig, 0)
+		for _, endpoint := range groupHandler.GetEndpoints() {
+			routes = append(routes, config.RouteConfig{Name: endpoint.Path, Open: true})
+			numOpenEndpoints++
+		}
+		ws.apiConfig.APIPackages[groupName] = config.APIPackageConfig{Routes: routes}
+	}
+
+	ginRouter := gin.New()
+	ws.registerRoutes(ginRouter)
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, openApiRoute, nil)
+	ginRouter.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	document := &openapi.Document{}
+	err = json.Unmarshal(resp.Body.Bytes(), document)
+	require.Nil(t, err)
+	require.Equal(t, "v1.2.3", document.Info.Version)
+
+	numOperations := 0
+	for _, pathItem := range document.Paths {
+		numOperations += len(*pathItem)
+	}
+	require.Equal(t, numOpenEndpoints, numOperations)
+
+	balanceOperation := (*document.Paths["/address/{address}/balance"])["get"]
+	require.NotNil(t, balanceOperation)
+	require.Equal(t, "getAddressAddressBalance", balanceOperation.OperationID)
+	require.Nil(t, document.Paths["/hardfork/trigger"])
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 const (
@@ -32,6 +33,8 @@ const (
 	getRegisteredNFTsPath          = "/:address/registered-nfts"
 	getESDTNFTDataPath             = "/:address/nft/:tokenIdentifier/nonce/:nonce"
 	getGuardianData                = "/:address/guardian-data"
+	getAddressTransactionsPath     = "/:address/transactions"
+	getAccountStateDiffPath        = "/:address/diff"
 	iterateKeysPath                = "/iterate-keys"
 	urlParamOnFinalBlock           = "onFinalBlock"
 	urlParamOnStartOfEpoch         = "onStartOfEpoch"
@@ -40,6 +43,12 @@ const (
 	urlParamBlockRootHash          = "blockRootHash"
 	urlParamHintEpoch              = "hintEpoch"
 	urlParamWithKeys               = "withKeys"
+	urlParamCursor                 = "cursor"
+	urlParamSize                   = "size"
+	urlParamDirection              = "direction"
+	urlParamTypes                  = "types"
+	urlParamFromNonce              = "fromNonce"
+	urlParamToNonce                = "toNonce"
 )
 
 // maxUint64 is represented on 20 characters as a string
@@ -62,6 +71,8 @@ type addressFacadeHandler interface {
 	IterateKeys(address string, numKeys uint, iteratorState [][]byte, options api.AccountQueryOptions) (map[string]string, [][]byte, api.BlockInfo, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	GetTransactionsForAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
+	GetAccountStateDiff(address string, options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -108,81 +119,192 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Path:    getAccountPath,
 			Method:  http.MethodGet,
 			Handler: ag.getAccount,
+			Schema: &shared.EndpointSchema{
+				Summary: "returns the account of the given address",
+				QueryParameters: joinQueryParameters(accountQueryOptionsParameters, []shared.QueryParameter{
+					{Name: urlParamWithKeys, Type: shared.ParameterTypeBoolean, Description: "also return the key-value pairs of the account"},
+				}),
+				Response: gin.H{"account": api.AccountResponse{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getAccountsPath,
 			Method:  http.MethodPost,
 			Handler: ag.getAccounts,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the accounts of the given addresses",
+				QueryParameters: accountQueryOptionsParameters,
+				Request:         []string{},
+				Response:        gin.H{"accounts": map[string]*api.AccountResponse{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getBalancePath,
 			Method:  http.MethodGet,
 			Handler: ag.getBalance,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the balance of the given address",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"balance": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getUsernamePath,
 			Method:  http.MethodGet,
 			Handler: ag.getUsername,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the username of the given address",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"username": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getCodeHashPath,
 			Method:  http.MethodGet,
 			Handler: ag.getCodeHash,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the code hash of the given address",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"codeHash": []byte{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getKeyPath,
 			Method:  http.MethodGet,
 			Handler: ag.getValueForKey,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the hex encoded value stored under the given hex encoded key",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"value": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getKeysPath,
 			Method:  http.MethodGet,
 			Handler: ag.getKeyValuePairs,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns all the hex encoded key-value pairs of the given address",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"pairs": map[string]string{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    iterateKeysPath,
 			Method:  http.MethodPost,
 			Handler: ag.iterateKeys,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the next batch of key-value pairs of the given address, starting from the provided iterator state",
+				QueryParameters: accountQueryOptionsParameters,
+				Request:         IterateKeysRequest{},
+				Response:        gin.H{"pairs": map[string]string{}, "newIteratorState": [][]byte{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTBalancePath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTBalance,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the balance of the given fungible token",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"tokenData": esdtTokenData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTNFTDataPath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTNFTData,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the data of the given token nonce",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"tokenData": ESDTNFTTokenData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTTokensPath,
 			Method:  http.MethodGet,
 			Handler: ag.getAllESDTData,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns all the tokens held by the given address",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"esdts": map[string]*ESDTNFTTokenData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getRegisteredNFTsPath,
 			Method:  http.MethodGet,
 			Handler: ag.getNFTTokenIDsRegisteredByAddress,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the identifiers of the tokens registered by the given address",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"tokens": []string{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTTokensWithRolePath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTTokensWithRole,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the identifiers of the tokens for which the given address has the given role",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"tokens": []string{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTsRolesPath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTsRoles,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the roles of the given address, for each token",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"roles": map[string][]string{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getGuardianData,
 			Method:  http.MethodGet,
 			Handler: ag.getGuardianData,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the guardians of the given address",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"guardianData": api.GuardianData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getDataTrieMigrationStatusPath,
 			Method:  http.MethodGet,
 			Handler: ag.isDataTrieMigrated,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns true if the data trie of the given address was migrated to the latest version",
+				QueryParameters: accountQueryOptionsParameters,
+				Response:        gin.H{"isMigrated": false},
+			},
+		},
+		{
+			Path:    getAddressTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getTransactions,
+			Schema: &shared.EndpointSchema{
+				Summary: "returns a page of the transactions involving the given address",
+				QueryParameters: []shared.QueryParameter{
+					{Name: urlParamCursor, Type: shared.ParameterTypeInteger, Description: "the cursor returned by the previous page"},
+					{Name: urlParamSize, Type: shared.ParameterTypeInteger, Description: "the maximum number of transactions in the page"},
+					{Name: urlParamDirection, Type: shared.ParameterTypeString, Description: "desc (default) or asc"},
+					{Name: urlParamTypes, Type: shared.ParameterTypeString, Description: "comma separated transaction types: normal, unsigned, reward, invalid"},
+					{Name: urlParamFromNonce, Type: shared.ParameterTypeInteger, Description: "the nonce of the first block to be considered"},
+					{Name: urlParamToNonce, Type: shared.ParameterTypeInteger, Description: "the nonce of the last block to be considered"},
+				},
+				Response: gin.H{"transactions": []*common.AddressTransactionApiResponse{}, "nextCursor": ""},
+			},
+		},
+		{
+			Path:    getAccountStateDiffPath,
+			Method:  http.MethodGet,
+			Handler: ag.getAccountStateDiff,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the differences of the given address between two blocks",
+				QueryParameters: stateDiffParameters,
+				Response:        gin.H{"diff": common.StateDiffApiResponse{}},
+			},
 		},
 	}
 	ag.endpoints = endpoints
@@ -534,6 +656,52 @@ func (ag *addressGroup) isDataTrieMigrated(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"isMigrated": isMigrated})
 }
 
+// getTransactions returns a page of the transactions, smart contract results and rewards involving the given address
+func (ag *addressGroup) getTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAddressTransactions, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := extractAddressTransactionsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAddressTransactions, err)
+		return
+	}
+
+	transactions, err := ag.getFacade().GetTransactionsForAddress(addr, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAddressTransactions, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"transactions": transactions.Transactions, "nextCursor": transactions.NextCursor})
+}
+
+// getAccountStateDiff returns the differences of the account state between two blocks
+func (ag *addressGroup) getAccountStateDiff(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountStateDiff, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := extractStateDiffQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountStateDiff, err)
+		return
+	}
+
+	stateDiff, err := ag.getFacade().GetAccountStateDiff(addr, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccountStateDiff, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"diff": stateDiff})
+}
+
 func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalToken) *ESDTNFTTokenData {
 	tokenData := &ESDTNFTTokenData{
 		TokenIdentifier: tokenIdentifier,
@@ -6,7 +6,15 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	customErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
+)
+
+const (
+	directionAscending  = "asc"
+	directionDescending = "desc"
 )
 
 func extractAccountQueryOptions(c *gin.Context) (api.AccountQueryOptions, error) {
@@ -93,3 +101,101 @@ func checkAccountQueryOptions(options api.AccountQueryOptions) error {
 
 	return nil
 }
+
+func extractAddressTransactionsQueryOptions(c *gin.Context) (common.AddressTransactionsQueryOptions, error) {
+	options, err := parseAddressTransactionsQueryOptions(c)
+	if err != nil {
+		return common.AddressTransactionsQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	return options, nil
+}
+
+func parseAddressTransactionsQueryOptions(c *gin.Context) (common.AddressTransactionsQueryOptions, error) {
+	cursor, err := parseUint64UrlParam(c, urlParamCursor)
+	if err != nil {
+		return common.AddressTransactionsQueryOptions{}, err
+	}
+
+	size, err := parseUint32UrlParam(c, urlParamSize)
+	if err != nil {
+		return common.AddressTransactionsQueryOptions{}, err
+	}
+
+	fromNonce, err := parseUint64UrlParam(c, urlParamFromNonce)
+	if err != nil {
+		return common.AddressTransactionsQueryOptions{}, err
+	}
+
+	toNonce, err := parseUint64UrlParam(c, urlParamToNonce)
+	if err != nil {
+		return common.AddressTransactionsQueryOptions{}, err
+	}
+	if fromNonce.HasValue && toNonce.HasValue && fromNonce.Value > toNonce.Value {
+		return common.AddressTransactionsQueryOptions{}, errors.New("fromNonce should not be greater than toNonce")
+	}
+
+	descending := true
+	direction := c.Request.URL.Query().Get(urlParamDirection)
+	switch direction {
+	case "", directionDescending:
+	case directionAscending:
+		descending = false
+	default:
+		return common.AddressTransactionsQueryOptions{}, fmt.Errorf("invalid direction %s, should be %s or %s", direction, directionAscending, directionDescending)
+	}
+
+	types := make([]transaction.TxType, 0)
+	for _, value := range parseListUrlParam(c, urlParamTypes) {
+		txType := transaction.TxType(value)
+		err = addressTransactions.CheckTransactionType(txType)
+		if err != nil {
+			return common.AddressTransactionsQueryOptions{}, err
+		}
+
+		types = append(types, txType)
+	}
+
+	options := common.AddressTransactionsQueryOptions{
+		Cursor:     cursor,
+		Size:       int(size.Value),
+		Descending: descending,
+		Types:      types,
+		FromNonce:  fromNonce,
+		ToNonce:    toNonce,
+	}
+	return options, nil
+}
+
+func extractStateDiffQueryOptions(c *gin.Context) (common.StateDiffQueryOptions, error) {
+	options, err := parseStateDiffQueryOptions(c)
+	if err != nil {
+		return common.StateDiffQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	return options, nil
+}
+
+func parseStateDiffQueryOptions(c *gin.Context) (common.StateDiffQueryOptions, error) {
+	fromNonce, err := parseUint64UrlParam(c, urlParamFromNonce)
+	if err != nil {
+		return common.StateDiffQueryOptions{}, err
+	}
+
+	toNonce, err := parseUint64UrlParam(c, urlParamToNonce)
+	if err != nil {
+		return common.StateDiffQueryOptions{}, err
+	}
+	if !fromNonce.HasValue || !toNonce.HasValue {
+		return common.StateDiffQueryOptions{}, errors.New("both fromNonce and toNonce should be provided")
+	}
+	if fromNonce.Value > toNonce.Value {
+		return common.StateDiffQueryOptions{}, errors.New("fromNonce should not be greater than toNonce")
+	}
+
+	options := common.StateDiffQueryOptions{
+		FromNonce: fromNonce.Value,
+		ToNonce:   toNonce.Value,
+	}
+	return options, nil
+}
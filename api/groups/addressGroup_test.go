@@ -13,12 +13,15 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1265,6 +1268,8 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
 					{Name: "/:address/is-data-trie-migrated", Open: true},
+					{Name: "/:address/transactions", Open: true},
+					{Name: "/:address/diff", Open: true},
 				},
 			},
 		},
@@ -1354,3 +1359,181 @@ func TestIsDataTrieMigrated(t *testing.T) {
 		assert.False(t, respData["isMigrated"].(bool))
 	})
 }
+
+type addressTransactionsResponseData struct {
+	Transactions []*common.AddressTransactionApiResponse `json:"transactions"`
+	NextCursor   string                                  `json:"nextCursor"`
+}
+
+type addressTransactionsResponse struct {
+	Data  addressTransactionsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+func TestAddressGroup_getTransactions(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedErr := errors.New("expected error")
+
+	t.Run("invalid url params should error", testErrorScenario(fmt.Sprintf("/address/%s/transactions?cursor=not-a-number", testAddress), "GET", nil,
+		formatExpectedErr(apiErrors.ErrGetAddressTransactions, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid direction should error", testErrorScenario(fmt.Sprintf("/address/%s/transactions?direction=sideways", testAddress), "GET", nil,
+		formatExpectedErr(apiErrors.ErrGetAddressTransactions, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid type should error", testErrorScenario(fmt.Sprintf("/address/%s/transactions?types=normal,unknown", testAddress), "GET", nil,
+		formatExpectedErr(apiErrors.ErrGetAddressTransactions, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid nonce range should error", testErrorScenario(fmt.Sprintf("/address/%s/transactions?fromNonce=10&toNonce=5", testAddress), "GET", nil,
+		formatExpectedErr(apiErrors.ErrGetAddressTransactions, apiErrors.ErrBadUrlParams)))
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsForAddressCalled: func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testAddressGroup(
+			t,
+			facade,
+			fmt.Sprintf("/address/%s/transactions", testAddress),
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrGetAddressTransactions, expectedErr),
+		)
+	})
+	t.Run("should work with default options", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsForAddressCalled: func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+				require.Equal(t, testAddress, address)
+				require.Equal(t, common.AddressTransactionsQueryOptions{Descending: true, Types: []transaction.TxType{}}, options)
+
+				return &common.AddressTransactionsApiResponse{
+					Transactions: []*common.AddressTransactionApiResponse{{Hash: "aa", Type: "normal"}},
+					NextCursor:   "7",
+				}, nil
+			},
+		}
+		response := &addressTransactionsResponse{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			fmt.Sprintf("/address/%s/transactions", testAddress),
+			"GET",
+			nil,
+			response,
+		)
+		require.Len(t, response.Data.Transactions, 1)
+		require.Equal(t, "aa", response.Data.Transactions[0].Hash)
+		require.Equal(t, "7", response.Data.NextCursor)
+	})
+	t.Run("should pass all the options", func(t *testing.T) {
+		t.Parallel()
+
+		expectedOptions := common.AddressTransactionsQueryOptions{
+			Cursor:     core.OptionalUint64{Value: 15, HasValue: true},
+			Size:       20,
+			Descending: false,
+			Types:      []transaction.TxType{transaction.TxTypeNormal, transaction.TxTypeReward},
+			FromNonce:  core.OptionalUint64{Value: 3, HasValue: true},
+			ToNonce:    core.OptionalUint64{Value: 9, HasValue: true},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsForAddressCalled: func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+				require.Equal(t, expectedOptions, options)
+
+				return &common.AddressTransactionsApiResponse{}, nil
+			},
+		}
+		response := &addressTransactionsResponse{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			fmt.Sprintf("/address/%s/transactions?cursor=15&size=20&direction=asc&types=normal,reward&fromNonce=3&toNonce=9", testAddress),
+			"GET",
+			nil,
+			response,
+		)
+		require.Empty(t, response.Error)
+	})
+}
+
+type stateDiffResponseData struct {
+	Diff *common.StateDiffApiResponse `json:"diff"`
+}
+
+type stateDiffResponse struct {
+	Data  stateDiffResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+func TestAddressGroup_getAccountStateDiff(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedErr := errors.New("expected error")
+
+	t.Run("missing nonces should error", testErrorScenario(fmt.Sprintf("/address/%s/diff?fromNonce=1", testAddress), "GET", nil,
+		formatExpectedErr(apiErrors.ErrGetAccountStateDiff, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid nonce should error", testErrorScenario(fmt.Sprintf("/address/%s/diff?fromNonce=a&toNonce=2", testAddress), "GET", nil,
+		formatExpectedErr(apiErrors.ErrGetAccountStateDiff, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid nonce range should error", testErrorScenario(fmt.Sprintf("/address/%s/diff?fromNonce=10&toNonce=5", testAddress), "GET", nil,
+		formatExpectedErr(apiErrors.ErrGetAccountStateDiff, apiErrors.ErrBadUrlParams)))
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountStateDiffCalled: func(address string, options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testAddressGroup(
+			t,
+			facade,
+			fmt.Sprintf("/address/%s/diff?fromNonce=1&toNonce=2", testAddress),
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrGetAccountStateDiff, expectedErr),
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountStateDiffCalled: func(address string, options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error) {
+				require.Equal(t, testAddress, address)
+				require.Equal(t, common.StateDiffQueryOptions{FromNonce: 3, ToNonce: 9}, options)
+
+				return &common.StateDiffApiResponse{
+					Accounts: []*common.AccountStateDiffApiResponse{
+						{
+							Address: testAddress,
+							Status:  common.AccountStateChanged,
+							Fields: map[string]*common.ValueDiffApiResponse{
+								"balance": {From: "10", To: "20"},
+							},
+						},
+					},
+				}, nil
+			},
+		}
+		response := &stateDiffResponse{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			fmt.Sprintf("/address/%s/diff?fromNonce=3&toNonce=9", testAddress),
+			"GET",
+			nil,
+			response,
+		)
+		require.Empty(t, response.Error)
+		require.Len(t, response.Data.Diff.Accounts, 1)
+		require.Equal(t, common.AccountStateChanged, response.Data.Diff.Accounts[0].Status)
+		require.Equal(t, "20", response.Data.Diff.Accounts[0].Fields["balance"].To)
+	})
+}
@@ -29,6 +29,16 @@ const (
 	urlParamForHyperblock     = "forHyperblock"
 )
 
+var blockQueryOptionsParameters = []shared.QueryParameter{
+	{Name: urlParamWithTxs, Type: shared.ParameterTypeBoolean, Description: "include the transactions of the block"},
+	{Name: urlParamWithLogs, Type: shared.ParameterTypeBoolean, Description: "include the logs of the transactions"},
+	{Name: urlParamForHyperblock, Type: shared.ParameterTypeBoolean, Description: "return the block in the hyperblock format"},
+}
+
+var alteredAccountsParameters = []shared.QueryParameter{
+	{Name: urlParamTokensFilter, Type: shared.ParameterTypeString, Description: "comma separated token identifiers, for returning only their altered balances"},
+}
+
 // blockFacadeHandler defines the methods to be implemented by a facade for handling block requests
 type blockFacadeHandler interface {
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
@@ -60,26 +70,51 @@ func NewBlockGroup(facade blockFacadeHandler) (*blockGroup, error) {
 			Path:    getBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByNonce,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the block with the given nonce",
+				QueryParameters: blockQueryOptionsParameters,
+				Response:        gin.H{"block": api.Block{}},
+			},
 		},
 		{
 			Path:    getBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByHash,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the block with the given hash",
+				QueryParameters: blockQueryOptionsParameters,
+				Response:        gin.H{"block": api.Block{}},
+			},
 		},
 		{
 			Path:    getBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByRound,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the block proposed in the given round",
+				QueryParameters: blockQueryOptionsParameters,
+				Response:        gin.H{"block": api.Block{}},
+			},
 		},
 		{
 			Path:    getAlteredAccountsByNonce,
 			Method:  http.MethodGet,
 			Handler: bg.getAlteredAccountsByNonce,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the accounts altered by the block with the given nonce",
+				QueryParameters: alteredAccountsParameters,
+				Response:        gin.H{"accounts": []*alteredAccount.AlteredAccount{}},
+			},
 		},
 		{
 			Path:    getAlteredAccountsByHash,
 			Method:  http.MethodGet,
 			Handler: bg.getAlteredAccountsByHash,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the accounts altered by the block with the given hash",
+				QueryParameters: alteredAccountsParameters,
+				Response:        gin.H{"accounts": []*alteredAccount.AlteredAccount{}},
+			},
 		},
 	}
 	bg.endpoints = endpoints
@@ -0,0 +1,34 @@
+package groups
+
+import "github.com/multiversx/mx-chain-go/api/shared"
+
+// the URL parameters shared by multiple endpoints, used for describing them in the OpenAPI specification
+
+var blockCoordinatesParameters = []shared.QueryParameter{
+	{Name: urlParamOnFinalBlock, Type: shared.ParameterTypeBoolean, Description: "query the state of the final block"},
+	{Name: urlParamBlockNonce, Type: shared.ParameterTypeInteger, Description: "query the state at the block with the provided nonce"},
+	{Name: urlParamBlockHash, Type: shared.ParameterTypeString, Description: "query the state at the block with the provided hex encoded hash"},
+	{Name: urlParamBlockRootHash, Type: shared.ParameterTypeString, Description: "query the state at the provided hex encoded root hash"},
+	{Name: urlParamHintEpoch, Type: shared.ParameterTypeInteger, Description: "the epoch of the provided root hash, speeds up the state lookup"},
+}
+
+var accountQueryOptionsParameters = joinQueryParameters(
+	blockCoordinatesParameters,
+	[]shared.QueryParameter{
+		{Name: urlParamOnStartOfEpoch, Type: shared.ParameterTypeInteger, Description: "query the state at the start of the provided epoch"},
+	},
+)
+
+var stateDiffParameters = []shared.QueryParameter{
+	{Name: urlParamFromNonce, Type: shared.ParameterTypeInteger, Description: "the nonce of the first block", Required: true},
+	{Name: urlParamToNonce, Type: shared.ParameterTypeInteger, Description: "the nonce of the second block", Required: true},
+}
+
+func joinQueryParameters(parameters ...[]shared.QueryParameter) []shared.QueryParameter {
+	joinedParameters := make([]shared.QueryParameter, 0)
+	for _, parametersSet := range parameters {
+		joinedParameters = append(joinedParameters, parametersSet...)
+	}
+
+	return joinedParameters
+}
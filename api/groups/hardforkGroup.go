@@ -46,6 +46,11 @@ func NewHardforkGroup(facade hardforkFacadeHandler) (*hardforkGroup, error) {
 			Path:    triggerPath,
 			Method:  http.MethodPost,
 			Handler: hg.triggerHandler,
+			Schema: &shared.EndpointSchema{
+				Summary:  "triggers the hardfork process",
+				Request:  HardforkRequest{},
+				Response: gin.H{"status": ""},
+			},
 		},
 	}
 	hg.endpoints = endpoints
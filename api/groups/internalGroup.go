@@ -72,86 +72,154 @@ func NewInternalBlockGroup(facade internalBlockFacadeHandler) (*internalBlockGro
 			Path:    getRawMetaBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMetaBlockByNonce,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the marshalled metablock with the given nonce",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawMetaBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMetaBlockByHash,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the marshalled metablock with the given hash",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawMetaBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMetaBlockByRound,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the marshalled metablock proposed in the given round",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawStartOfEpochMetaBlockPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawStartOfEpochMetaBlock,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the marshalled start of epoch metablock of the given epoch",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawShardBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawShardBlockByNonce,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the marshalled shard block with the given nonce",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawShardBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawShardBlockByHash,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the marshalled shard block with the given hash",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawShardBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawShardBlockByRound,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the marshalled shard block proposed in the given round",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getJSONMetaBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMetaBlockByNonce,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the JSON metablock with the given nonce",
+				Response: gin.H{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONMetaBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMetaBlockByHash,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the JSON metablock with the given hash",
+				Response: gin.H{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONMetaBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMetaBlockByRound,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the JSON metablock proposed in the given round",
+				Response: gin.H{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONStartOfEpochMetaBlockPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONStartOfEpochMetaBlock,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the JSON start of epoch metablock of the given epoch",
+				Response: gin.H{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONShardBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONShardBlockByNonce,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the JSON shard block with the given nonce",
+				Response: gin.H{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONShardBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONShardBlockByHash,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the JSON shard block with the given hash",
+				Response: gin.H{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONShardBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONShardBlockByRound,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the JSON shard block proposed in the given round",
+				Response: gin.H{"block": nil},
+			},
 		},
 		{
 			Path:    getRawMiniBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMiniBlockByHash,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the marshalled miniblock with the given hash from the given epoch",
+				Response: gin.H{"miniblock": []byte{}},
+			},
 		},
 		{
 			Path:    getJSONMiniBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMiniBlockByHash,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the JSON miniblock with the given hash from the given epoch",
+				Response: gin.H{"miniblock": nil},
+			},
 		},
 		{
 			Path:    getJSONStartOfEpochValidatorsInfoPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONStartOfEpochValidatorsInfo,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the validators info computed at the start of the given epoch",
+				Response: gin.H{"validators": nil},
+			},
 		},
 	}
 	ib.endpoints = endpoints
@@ -0,0 +1,352 @@
+package groups
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/api/shared/logging"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+)
+
+const (
+	jsonRpcPath    = "/"
+	jsonRpcVersion = "2.0"
+
+	// maxJsonRpcBatchSize limits the number of calls accepted in a single batch request
+	maxJsonRpcBatchSize = 100
+)
+
+// JSON-RPC 2.0 error codes. The reserved -32000 to -32099 range is used for the server defined errors
+const (
+	jsonRpcParseErrorCode     = -32700
+	jsonRpcInvalidRequestCode = -32600
+	jsonRpcMethodNotFoundCode = -32601
+	jsonRpcInvalidParamsCode  = -32602
+	jsonRpcInternalErrorCode  = -32603
+	jsonRpcSystemBusyCode     = -32005
+)
+
+// jsonRpcFacadeHandler defines the methods to be implemented by a facade for handling JSON-RPC requests
+type jsonRpcFacadeHandler interface {
+	GetBalance(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
+	GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
+	GetCodeHash(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
+	GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
+	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
+	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
+	ValidateTransaction(tx *transaction.Transaction) error
+	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
+	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	StatusMetrics() external.StatusMetricsHandler
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+type jsonRpcRequest struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+type jsonRpcResponse struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *jsonRpcError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type jsonRpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRpcGroup struct {
+	*baseGroup
+	facade       jsonRpcFacadeHandler
+	mutFacade    sync.RWMutex
+	methods      map[string]*jsonRpcMethod
+	mutApiConfig sync.RWMutex
+	apiConfig    config.ApiRoutesConfig
+}
+
+// NewJsonRpcGroup returns a new instance of jsonRpcGroup
+func NewJsonRpcGroup(facade jsonRpcFacadeHandler) (*jsonRpcGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for json-rpc group", errors.ErrNilFacadeHandler)
+	}
+
+	jg := &jsonRpcGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+		methods:   createJsonRpcMethods(),
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    jsonRpcPath,
+			Method:  http.MethodPost,
+			Handler: jg.handleRequest,
+			Schema: &shared.EndpointSchema{
+				Summary:     "JSON-RPC 2.0 endpoint accepting a single call or a batch of calls, for the methods whose corresponding REST routes are open",
+				Request:     jsonRpcRequest{},
+				Response:    jsonRpcResponse{},
+				ContentType: "application/json",
+			},
+		},
+	}
+	jg.endpoints = endpoints
+
+	return jg, nil
+}
+
+// RegisterRoutes will register the JSON-RPC endpoint. The provided configuration is kept in order to expose only the
+// methods whose corresponding REST routes are open
+func (jg *jsonRpcGroup) RegisterRoutes(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+	jg.mutApiConfig.Lock()
+	jg.apiConfig = apiConfig
+	jg.mutApiConfig.Unlock()
+
+	properties := getEndpointProperties(ws, jsonRpcPath, apiConfig)
+	if !properties.isOpen {
+		log.Debug("endpoint is closed", "path", jsonRpcPath)
+		return
+	}
+
+	// registered on the group path itself so the clients can post directly on /jsonrpc
+	ws.Handle(http.MethodPost, "", jg.handleRequest)
+}
+
+// handleRequest will process a single JSON-RPC call or a batch of calls
+func (jg *jsonRpcGroup) handleRequest(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, jsonRpcParseErrorCode, err.Error()))
+		return
+	}
+
+	body = bytes.TrimSpace(body)
+	isBatch := len(body) > 0 && body[0] == '['
+	if !isBatch {
+		response := jg.processRawCall(body)
+		if response == nil {
+			c.Status(http.StatusNoContent)
+			return
+		}
+
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	var rawCalls []json.RawMessage
+	err = json.Unmarshal(body, &rawCalls)
+	if err != nil {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, jsonRpcParseErrorCode, err.Error()))
+		return
+	}
+	if len(rawCalls) == 0 {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, jsonRpcInvalidRequestCode, "empty batch"))
+		return
+	}
+	if len(rawCalls) > maxJsonRpcBatchSize {
+		message := fmt.Sprintf("too many calls in batch, maximum allowed is %d", maxJsonRpcBatchSize)
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, jsonRpcInvalidRequestCode, message))
+		return
+	}
+
+	// the throttlers already counted the batch request as one call, so only the remaining calls are counted here
+	if !shared.CountBatchCalls(c, uint32(len(rawCalls)-1)) {
+		c.JSON(http.StatusTooManyRequests, newJsonRpcErrorResponse(nil, jsonRpcSystemBusyCode, errors.ErrTooManyRequests.Error()))
+		return
+	}
+
+	responses := make([]*jsonRpcResponse, 0, len(rawCalls))
+	for _, rawCall := range rawCalls {
+		response := jg.processRawCall(rawCall)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+
+	if len(responses) == 0 {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// processRawCall returns nil if the call is a notification, as no response should be sent for it
+func (jg *jsonRpcGroup) processRawCall(rawCall []byte) *jsonRpcResponse {
+	request := &jsonRpcRequest{}
+	err := json.Unmarshal(rawCall, request)
+	if err != nil {
+		_, isSyntaxError := err.(*json.SyntaxError)
+		if isSyntaxError {
+			return newJsonRpcErrorResponse(nil, jsonRpcParseErrorCode, err.Error())
+		}
+
+		return newJsonRpcErrorResponse(nil, jsonRpcInvalidRequestCode, err.Error())
+	}
+
+	if request.JsonRpc != jsonRpcVersion || len(request.Method) == 0 {
+		return newJsonRpcErrorResponse(request.ID, jsonRpcInvalidRequestCode, "invalid request")
+	}
+
+	result, rpcErr := jg.processCall(request)
+	isNotification := len(request.ID) == 0
+	if isNotification {
+		return nil
+	}
+	if rpcErr != nil {
+		return newJsonRpcErrorResponse(request.ID, rpcErr.Code, rpcErr.Message)
+	}
+
+	return &jsonRpcResponse{
+		JsonRpc: jsonRpcVersion,
+		Result:  result,
+		ID:      request.ID,
+	}
+}
+
+func (jg *jsonRpcGroup) processCall(request *jsonRpcRequest) (interface{}, *jsonRpcError) {
+	method, ok := jg.methods[request.Method]
+	if !ok || !jg.isMethodEnabled(method) {
+		return nil, &jsonRpcError{
+			Code:    jsonRpcMethodNotFoundCode,
+			Message: fmt.Sprintf("method not found: %s", request.Method),
+		}
+	}
+
+	params, err := newJsonRpcParams(request.Params, method.params)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	facade := jg.getFacade()
+	if len(method.throttler) > 0 {
+		endpointThrottler, found := facade.GetThrottlerForEndpoint(method.throttler)
+		if found {
+			if !endpointThrottler.CanProcess() {
+				return nil, &jsonRpcError{
+					Code:    jsonRpcSystemBusyCode,
+					Message: fmt.Sprintf("%s for endpoint %s", errors.ErrTooManyRequests.Error(), method.throttler),
+				}
+			}
+
+			endpointThrottler.StartProcessing()
+			defer endpointThrottler.EndProcessing()
+		}
+	}
+
+	start := time.Now()
+	result, rpcErr := method.handler(facade, params)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: JSON-RPC "+request.Method)
+
+	return result, rpcErr
+}
+
+func (jg *jsonRpcGroup) isMethodEnabled(method *jsonRpcMethod) bool {
+	jg.mutApiConfig.RLock()
+	defer jg.mutApiConfig.RUnlock()
+
+	group, ok := jg.apiConfig.APIPackages[method.apiPackage]
+	if !ok {
+		return false
+	}
+
+	for _, route := range group.Routes {
+		if route.Name == method.route {
+			return route.Open
+		}
+	}
+
+	return false
+}
+
+func newJsonRpcErrorResponse(id json.RawMessage, code int, message string) *jsonRpcResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+
+	return &jsonRpcResponse{
+		JsonRpc: jsonRpcVersion,
+		Error: &jsonRpcError{
+			Code:    code,
+			Message: message,
+		},
+		ID: id,
+	}
+}
+
+func newJsonRpcInvalidParamsError(err error) *jsonRpcError {
+	return &jsonRpcError{
+		Code:    jsonRpcInvalidParamsCode,
+		Message: fmt.Sprintf("invalid params: %s", err.Error()),
+	}
+}
+
+func newJsonRpcInternalError(scope error, err error) *jsonRpcError {
+	return &jsonRpcError{
+		Code:    jsonRpcInternalErrorCode,
+		Message: fmt.Sprintf("%s: %s", scope.Error(), err.Error()),
+	}
+}
+
+func (jg *jsonRpcGroup) getFacade() jsonRpcFacadeHandler {
+	jg.mutFacade.RLock()
+	defer jg.mutFacade.RUnlock()
+
+	return jg.facade
+}
+
+// UpdateFacade will update the facade
+func (jg *jsonRpcGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(jsonRpcFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	jg.mutFacade.Lock()
+	jg.facade = castFacade
+	jg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jg *jsonRpcGroup) IsInterfaceNil() bool {
+	return jg == nil
+}
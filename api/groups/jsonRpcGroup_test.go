@@ -0,0 +1,500 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonRpcErrorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRpcTestResponse struct {
+	JsonRpc string                 `json:"jsonrpc"`
+	Result  map[string]interface{} `json:"result"`
+	Error   *jsonRpcErrorResponse  `json:"error"`
+	ID      interface{}            `json:"id"`
+}
+
+func TestNewJsonRpcGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		jg, err := groups.NewJsonRpcGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, jg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		jg, err := groups.NewJsonRpcGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, jg)
+	})
+}
+
+func TestJsonRpcGroup_SingleCall(t *testing.T) {
+	t.Parallel()
+
+	t.Run("by-position params should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				require.Equal(t, testscommon.TestAddressAlice, address)
+				require.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, options.BlockNonce)
+				return big.NewInt(100), api.BlockInfo{Nonce: 37}, nil
+			},
+		}
+
+		body := `{"jsonrpc":"2.0","id":1,"method":"account_getBalance","params":["` + testscommon.TestAddressAlice + `",{"blockNonce":37}]}`
+		response := &jsonRpcTestResponse{}
+		code := doJsonRpcRequest(t, facade, getJsonRpcRoutesConfig(), body, response)
+
+		require.Equal(t, http.StatusOK, code)
+		require.Nil(t, response.Error)
+		require.Equal(t, "2.0", response.JsonRpc)
+		require.Equal(t, float64(1), response.ID)
+		require.Equal(t, "100", response.Result["balance"])
+	})
+	t.Run("by-name params should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				require.True(t, options.WithTransactions)
+				return &api.Block{Nonce: nonce}, nil
+			},
+		}
+
+		body := `{"jsonrpc":"2.0","id":"abc","method":"block_getByNonce","params":{"nonce":5,"options":{"withTxs":true}}}`
+		response := &jsonRpcTestResponse{}
+		code := doJsonRpcRequest(t, facade, getJsonRpcRoutesConfig(), body, response)
+
+		require.Equal(t, http.StatusOK, code)
+		require.Nil(t, response.Error)
+		require.Equal(t, "abc", response.ID)
+		block := response.Result["block"].(map[string]interface{})
+		require.Equal(t, float64(5), block["nonce"])
+	})
+	t.Run("send transaction should work", func(t *testing.T) {
+		t.Parallel()
+
+		sentTxs := 0
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+				require.Equal(t, uint64(7), txArgs.Nonce)
+				return &transaction.Transaction{Nonce: txArgs.Nonce}, []byte("hash"), nil
+			},
+			SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+				sentTxs += len(txs)
+				return uint64(len(txs)), nil
+			},
+		}
+
+		body := `{"jsonrpc":"2.0","id":2,"method":"tx_send","params":[{"nonce":7,"value":"1"}]}`
+		response := &jsonRpcTestResponse{}
+		code := doJsonRpcRequest(t, facade, getJsonRpcRoutesConfig(), body, response)
+
+		require.Equal(t, http.StatusOK, code)
+		require.Nil(t, response.Error)
+		require.Equal(t, "68617368", response.Result["txHash"])
+		require.Equal(t, 1, sentTxs)
+	})
+	t.Run("vm query should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			DecodeAddressPubkeyCalled: func(pk string) ([]byte, error) {
+				return []byte(pk), nil
+			},
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+				require.Equal(t, "getSum", query.FuncName)
+				require.Equal(t, [][]byte{{0x01}}, query.Arguments)
+				require.Equal(t, core.OptionalUint64{Value: 10, HasValue: true}, query.BlockNonce)
+				return &vm.VMOutputApi{ReturnData: [][]byte{{0x02}}}, api.BlockInfo{Nonce: 10}, nil
+			},
+		}
+
+		body := `{"jsonrpc":"2.0","id":3,"method":"vm_query","params":[{"scAddress":"sc","funcName":"getSum","args":["01"]},{"blockNonce":10}]}`
+		response := &jsonRpcTestResponse{}
+		code := doJsonRpcRequest(t, facade, getJsonRpcRoutesConfig(), body, response)
+
+		require.Equal(t, http.StatusOK, code)
+		require.Nil(t, response.Error)
+		require.NotNil(t, response.Result["data"])
+	})
+	t.Run("network status should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StatusMetricsHandler: func() external.StatusMetricsHandler {
+				return &testscommon.StatusMetricsStub{
+					NetworkMetricsCalled: func() (map[string]interface{}, error) {
+						return map[string]interface{}{"erd_nonce": 8}, nil
+					},
+				}
+			},
+		}
+
+		body := `{"jsonrpc":"2.0","id":4,"method":"network_getStatus"}`
+		response := &jsonRpcTestResponse{}
+		code := doJsonRpcRequest(t, facade, getJsonRpcRoutesConfig(), body, response)
+
+		require.Equal(t, http.StatusOK, code)
+		require.Nil(t, response.Error)
+		status := response.Result["status"].(map[string]interface{})
+		require.Equal(t, float64(8), status["erd_nonce"])
+	})
+	t.Run("notification should not return a response", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				wasCalled = true
+				return big.NewInt(0), api.BlockInfo{}, nil
+			},
+		}
+
+		body := `{"jsonrpc":"2.0","method":"account_getBalance","params":["` + testscommon.TestAddressAlice + `"]}`
+		code := doJsonRpcRequest(t, facade, getJsonRpcRoutesConfig(), body, nil)
+
+		require.Equal(t, http.StatusNoContent, code)
+		require.True(t, wasCalled)
+	})
+}
+
+func TestJsonRpcGroup_Errors(t *testing.T) {
+	t.Parallel()
+
+	testErrorCode := func(t *testing.T, facade *mock.FacadeStub, routesConfig config.ApiRoutesConfig, body string, expectedCode int) *jsonRpcTestResponse {
+		response := &jsonRpcTestResponse{}
+		code := doJsonRpcRequest(t, facade, routesConfig, body, response)
+
+		require.Equal(t, http.StatusOK, code)
+		require.NotNil(t, response.Error)
+		require.Equal(t, expectedCode, response.Error.Code)
+
+		return response
+	}
+
+	t.Run("parse error", func(t *testing.T) {
+		t.Parallel()
+
+		response := testErrorCode(t, &mock.FacadeStub{}, getJsonRpcRoutesConfig(), `{"jsonrpc":"2.0",`, -32700)
+		require.Nil(t, response.ID)
+	})
+	t.Run("invalid version", func(t *testing.T) {
+		t.Parallel()
+
+		testErrorCode(t, &mock.FacadeStub{}, getJsonRpcRoutesConfig(), `{"jsonrpc":"1.0","id":1,"method":"network_getStatus"}`, -32600)
+	})
+	t.Run("unknown method", func(t *testing.T) {
+		t.Parallel()
+
+		testErrorCode(t, &mock.FacadeStub{}, getJsonRpcRoutesConfig(), `{"jsonrpc":"2.0","id":1,"method":"unknown"}`, -32601)
+	})
+	t.Run("method with closed REST route", func(t *testing.T) {
+		t.Parallel()
+
+		routesConfig := getJsonRpcRoutesConfig()
+		routesConfig.APIPackages["address"] = config.APIPackageConfig{
+			Routes: []config.RouteConfig{
+				{Name: "/:address/balance", Open: false},
+			},
+		}
+		body := `{"jsonrpc":"2.0","id":1,"method":"account_getBalance","params":["` + testscommon.TestAddressAlice + `"]}`
+		testErrorCode(t, &mock.FacadeStub{}, routesConfig, body, -32601)
+	})
+	t.Run("too many positional params", func(t *testing.T) {
+		t.Parallel()
+
+		body := `{"jsonrpc":"2.0","id":1,"method":"block_getByRound","params":[1,{},"extra"]}`
+		testErrorCode(t, &mock.FacadeStub{}, getJsonRpcRoutesConfig(), body, -32602)
+	})
+	t.Run("unknown named param", func(t *testing.T) {
+		t.Parallel()
+
+		body := `{"jsonrpc":"2.0","id":1,"method":"block_getByRound","params":{"round":1,"other":2}}`
+		testErrorCode(t, &mock.FacadeStub{}, getJsonRpcRoutesConfig(), body, -32602)
+	})
+	t.Run("missing required param", func(t *testing.T) {
+		t.Parallel()
+
+		body := `{"jsonrpc":"2.0","id":1,"method":"account_getBalance","params":[]}`
+		testErrorCode(t, &mock.FacadeStub{}, getJsonRpcRoutesConfig(), body, -32602)
+	})
+	t.Run("incompatible account options", func(t *testing.T) {
+		t.Parallel()
+
+		body := `{"jsonrpc":"2.0","id":1,"method":"account_getBalance","params":["` + testscommon.TestAddressAlice + `",{"onFinalBlock":true,"blockNonce":2}]}`
+		testErrorCode(t, &mock.FacadeStub{}, getJsonRpcRoutesConfig(), body, -32602)
+	})
+	t.Run("facade error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				return nil, expectedErr
+			},
+		}
+
+		body := `{"jsonrpc":"2.0","id":1,"method":"block_getByNonce","params":[1]}`
+		response := testErrorCode(t, facade, getJsonRpcRoutesConfig(), body, -32603)
+		require.True(t, strings.Contains(response.Error.Message, apiErrors.ErrGetBlock.Error()))
+		require.True(t, strings.Contains(response.Error.Message, expectedErr.Error()))
+	})
+	t.Run("throttler busy", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				require.Equal(t, "/transaction/send", endpoint)
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool {
+						return false
+					},
+				}, true
+			},
+			SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+				require.Fail(t, "should have not been called")
+				return 0, nil
+			},
+		}
+
+		body := `{"jsonrpc":"2.0","id":1,"method":"tx_send","params":[{"nonce":1}]}`
+		response := testErrorCode(t, facade, getJsonRpcRoutesConfig(), body, -32005)
+		require.True(t, strings.Contains(response.Error.Message, apiErrors.ErrTooManyRequests.Error()))
+	})
+}
+
+func TestJsonRpcGroup_Batch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return responses for calls only", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				return big.NewInt(1), api.BlockInfo{}, nil
+			},
+			GetUsernameCalled: func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
+				return "alice", api.BlockInfo{}, nil
+			},
+		}
+
+		body := `[
+			{"jsonrpc":"2.0","id":1,"method":"account_getBalance","params":["` + testscommon.TestAddressAlice + `"]},
+			{"jsonrpc":"2.0","method":"account_getBalance","params":["` + testscommon.TestAddressAlice + `"]},
+			{"jsonrpc":"2.0","id":2,"method":"account_getUsername","params":{"address":"` + testscommon.TestAddressAlice + `"}},
+			{"jsonrpc":"2.0","id":3,"method":"unknown"},
+			5
+		]`
+		var responses []*jsonRpcTestResponse
+		code := doJsonRpcRequest(t, facade, getJsonRpcRoutesConfig(), body, &responses)
+
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, 4, len(responses))
+		require.Equal(t, "1", responses[0].Result["balance"])
+		require.Equal(t, "alice", responses[1].Result["username"])
+		require.Equal(t, -32601, responses[2].Error.Code)
+		require.Equal(t, float64(3), responses[2].ID)
+		require.Equal(t, -32600, responses[3].Error.Code)
+	})
+	t.Run("empty batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &jsonRpcTestResponse{}
+		code := doJsonRpcRequest(t, &mock.FacadeStub{}, getJsonRpcRoutesConfig(), `[]`, response)
+
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, -32600, response.Error.Code)
+	})
+	t.Run("each call should be counted against the throttlers", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				require.Fail(t, "should not have been called")
+				return nil, api.BlockInfo{}, nil
+			},
+		}
+		jg, _ := groups.NewJsonRpcGroup(facade)
+
+		countedCalls := uint32(0)
+		ws := gin.New()
+		ws.Use(func(c *gin.Context) {
+			shared.AddBatchCallsCounter(c, func(numCalls uint32) bool {
+				countedCalls += numCalls
+				return false
+			})
+		})
+		jg.RegisterRoutes(ws.Group("jsonrpc"), getJsonRpcRoutesConfig())
+
+		call := `{"jsonrpc":"2.0","id":1,"method":"account_getBalance","params":["` + testscommon.TestAddressAlice + `"]}`
+		body := "[" + strings.Repeat(call+",", 2) + call + "]"
+		req, _ := http.NewRequest("POST", "/jsonrpc", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusTooManyRequests, resp.Code)
+		require.Equal(t, uint32(2), countedCalls)
+		response := &jsonRpcTestResponse{}
+		err := json.Unmarshal(resp.Body.Bytes(), response)
+		require.Nil(t, err)
+		require.Equal(t, -32005, response.Error.Code)
+	})
+	t.Run("only notifications should return no content", func(t *testing.T) {
+		t.Parallel()
+
+		body := `[{"jsonrpc":"2.0","method":"unknown"}]`
+		code := doJsonRpcRequest(t, &mock.FacadeStub{}, getJsonRpcRoutesConfig(), body, nil)
+
+		require.Equal(t, http.StatusNoContent, code)
+	})
+}
+
+func TestJsonRpcGroup_ClosedEndpoint(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := getJsonRpcRoutesConfig()
+	routesConfig.APIPackages["jsonrpc"] = config.APIPackageConfig{
+		Routes: []config.RouteConfig{
+			{Name: "/", Open: false},
+		},
+	}
+
+	code := doJsonRpcRequest(t, &mock.FacadeStub{}, routesConfig, `{"jsonrpc":"2.0","id":1,"method":"network_getStatus"}`, nil)
+	require.Equal(t, http.StatusNotFound, code)
+}
+
+func TestJsonRpcGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		jg, _ := groups.NewJsonRpcGroup(&mock.FacadeStub{})
+		err := jg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		jg, _ := groups.NewJsonRpcGroup(&mock.FacadeStub{})
+		err := jg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		newFacade := &mock.FacadeStub{
+			GetUsernameCalled: func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
+				return "new", api.BlockInfo{}, nil
+			},
+		}
+
+		jg, _ := groups.NewJsonRpcGroup(&mock.FacadeStub{})
+		err := jg.UpdateFacade(newFacade)
+		require.Nil(t, err)
+
+		ws := startWebServer(jg, "jsonrpc", getJsonRpcRoutesConfig())
+		body := `{"jsonrpc":"2.0","id":1,"method":"account_getUsername","params":["` + testscommon.TestAddressAlice + `"]}`
+		req, _ := http.NewRequest("POST", "/jsonrpc", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &jsonRpcTestResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, "new", response.Result["username"])
+	})
+}
+
+func TestJsonRpcGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	jg, _ := groups.NewJsonRpcGroup(nil)
+	require.True(t, jg.IsInterfaceNil())
+
+	jg, _ = groups.NewJsonRpcGroup(&mock.FacadeStub{})
+	require.False(t, jg.IsInterfaceNil())
+}
+
+func doJsonRpcRequest(t *testing.T, facade *mock.FacadeStub, routesConfig config.ApiRoutesConfig, body string, destination interface{}) int {
+	jg, err := groups.NewJsonRpcGroup(facade)
+	require.Nil(t, err)
+
+	ws := startWebServer(jg, "jsonrpc", routesConfig)
+	req, _ := http.NewRequest("POST", "/jsonrpc", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	if destination != nil && resp.Code == http.StatusOK {
+		err = json.Unmarshal(resp.Body.Bytes(), destination)
+		require.Nil(t, err)
+	}
+
+	return resp.Code
+}
+
+func getJsonRpcRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"jsonrpc": {
+				Routes: []config.RouteConfig{
+					{Name: "/", Open: true},
+				},
+			},
+			"address": {
+				Routes: []config.RouteConfig{
+					{Name: "/:address/balance", Open: true},
+					{Name: "/:address/username", Open: true},
+				},
+			},
+			"block": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true},
+					{Name: "/by-round/:round", Open: true},
+				},
+			},
+			"transaction": {
+				Routes: []config.RouteConfig{
+					{Name: "/send", Open: true},
+				},
+			},
+			"vm-values": {
+				Routes: []config.RouteConfig{
+					{Name: "/query", Open: true},
+				},
+			},
+			"network": {
+				Routes: []config.RouteConfig{
+					{Name: "/status", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,656 @@
+package groups
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const (
+	paramAddress        = "address"
+	paramKey            = "key"
+	paramOptions        = "options"
+	paramTransaction    = "transaction"
+	paramCheckSignature = "checkSignature"
+	paramHash           = "hash"
+	paramWithResults    = "withResults"
+	paramNonce          = "nonce"
+	paramRound          = "round"
+	paramQuery          = "query"
+)
+
+type jsonRpcMethodHandler func(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError)
+
+// jsonRpcMethod maps a JSON-RPC method on the REST route it mirrors. The method is exposed only if the REST route is
+// open and the calls share the throttler of the REST endpoint, if any
+type jsonRpcMethod struct {
+	apiPackage string
+	route      string
+	throttler  string
+	params     []string
+	handler    jsonRpcMethodHandler
+}
+
+// jsonRpcAccountOptions holds the optional account query coordinates, as defined by the address URL parameters
+type jsonRpcAccountOptions struct {
+	OnFinalBlock   bool    `json:"onFinalBlock"`
+	OnStartOfEpoch *uint32 `json:"onStartOfEpoch"`
+	BlockNonce     *uint64 `json:"blockNonce"`
+	BlockHash      string  `json:"blockHash"`
+	BlockRootHash  string  `json:"blockRootHash"`
+	HintEpoch      *uint32 `json:"hintEpoch"`
+	WithKeys       bool    `json:"withKeys"`
+}
+
+// jsonRpcBlockOptions holds the optional block query flags, as defined by the block URL parameters
+type jsonRpcBlockOptions struct {
+	WithTxs       bool `json:"withTxs"`
+	WithLogs      bool `json:"withLogs"`
+	ForHyperblock bool `json:"forHyperblock"`
+}
+
+// jsonRpcQueryOptions holds the optional block coordinates of a vm query
+type jsonRpcQueryOptions struct {
+	BlockNonce *uint64 `json:"blockNonce"`
+	BlockHash  string  `json:"blockHash"`
+}
+
+func createJsonRpcMethods() map[string]*jsonRpcMethod {
+	return map[string]*jsonRpcMethod{
+		"account_getAccount": {
+			apiPackage: "address",
+			route:      getAccountPath,
+			params:     []string{paramAddress, paramOptions},
+			handler:    jsonRpcGetAccount,
+		},
+		"account_getBalance": {
+			apiPackage: "address",
+			route:      getBalancePath,
+			params:     []string{paramAddress, paramOptions},
+			handler:    jsonRpcGetBalance,
+		},
+		"account_getUsername": {
+			apiPackage: "address",
+			route:      getUsernamePath,
+			params:     []string{paramAddress, paramOptions},
+			handler:    jsonRpcGetUsername,
+		},
+		"account_getCodeHash": {
+			apiPackage: "address",
+			route:      getCodeHashPath,
+			params:     []string{paramAddress, paramOptions},
+			handler:    jsonRpcGetCodeHash,
+		},
+		"account_getKeys": {
+			apiPackage: "address",
+			route:      getKeysPath,
+			params:     []string{paramAddress, paramOptions},
+			handler:    jsonRpcGetKeyValuePairs,
+		},
+		"account_getValueForKey": {
+			apiPackage: "address",
+			route:      getKeyPath,
+			params:     []string{paramAddress, paramKey, paramOptions},
+			handler:    jsonRpcGetValueForKey,
+		},
+		"account_getESDTTokens": {
+			apiPackage: "address",
+			route:      getESDTTokensPath,
+			params:     []string{paramAddress, paramOptions},
+			handler:    jsonRpcGetAllESDTTokens,
+		},
+		"account_getGuardianData": {
+			apiPackage: "address",
+			route:      getGuardianData,
+			params:     []string{paramAddress, paramOptions},
+			handler:    jsonRpcGetGuardianData,
+		},
+		"tx_send": {
+			apiPackage: "transaction",
+			route:      sendTransactionPath,
+			throttler:  sendTransactionEndpoint,
+			params:     []string{paramTransaction},
+			handler:    jsonRpcSendTransaction,
+		},
+		"tx_simulate": {
+			apiPackage: "transaction",
+			route:      simulateTransactionPath,
+			throttler:  simulateTransactionEndpoint,
+			params:     []string{paramTransaction, paramCheckSignature},
+			handler:    jsonRpcSimulateTransaction,
+		},
+		"tx_cost": {
+			apiPackage: "transaction",
+			route:      costPath,
+			params:     []string{paramTransaction},
+			handler:    jsonRpcComputeTransactionGasLimit,
+		},
+		"tx_get": {
+			apiPackage: "transaction",
+			route:      getTransactionPath,
+			throttler:  getTransactionEndpoint,
+			params:     []string{paramHash, paramWithResults},
+			handler:    jsonRpcGetTransaction,
+		},
+		"block_getByNonce": {
+			apiPackage: "block",
+			route:      getBlockByNoncePath,
+			params:     []string{paramNonce, paramOptions},
+			handler:    jsonRpcGetBlockByNonce,
+		},
+		"block_getByHash": {
+			apiPackage: "block",
+			route:      getBlockByHashPath,
+			params:     []string{paramHash, paramOptions},
+			handler:    jsonRpcGetBlockByHash,
+		},
+		"block_getByRound": {
+			apiPackage: "block",
+			route:      getBlockByRoundPath,
+			params:     []string{paramRound, paramOptions},
+			handler:    jsonRpcGetBlockByRound,
+		},
+		"vm_query": {
+			apiPackage: "vm-values",
+			route:      queryPath,
+			params:     []string{paramQuery, paramOptions},
+			handler:    jsonRpcExecuteQuery,
+		},
+		"network_getConfig": {
+			apiPackage: "network",
+			route:      getConfigPath,
+			handler:    jsonRpcGetNetworkConfig,
+		},
+		"network_getStatus": {
+			apiPackage: "network",
+			route:      getStatusPath,
+			handler:    jsonRpcGetNetworkStatus,
+		},
+	}
+}
+
+// jsonRpcParams holds the raw params of a call, indexed by their names
+type jsonRpcParams map[string]json.RawMessage
+
+// newJsonRpcParams accepts both by-position (array) and by-name (object) params
+func newJsonRpcParams(rawParams json.RawMessage, names []string) (jsonRpcParams, error) {
+	params := make(jsonRpcParams)
+	if len(rawParams) == 0 || string(rawParams) == "null" {
+		return params, nil
+	}
+
+	var positional []json.RawMessage
+	err := json.Unmarshal(rawParams, &positional)
+	if err == nil {
+		if len(positional) > len(names) {
+			return nil, fmt.Errorf("too many params, expected at most %d", len(names))
+		}
+		for i, value := range positional {
+			params[names[i]] = value
+		}
+
+		return params, nil
+	}
+
+	err = json.Unmarshal(rawParams, &params)
+	if err != nil {
+		return nil, fmt.Errorf("params should be either an array or an object")
+	}
+
+	for name := range params {
+		if !isKnownParam(name, names) {
+			return nil, fmt.Errorf("unknown param %s", name)
+		}
+	}
+
+	return params, nil
+}
+
+func isKnownParam(name string, names []string) bool {
+	for _, knownName := range names {
+		if knownName == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// decode unmarshalls the named param in the provided value. Missing optional params leave the value untouched
+func (params jsonRpcParams) decode(name string, value interface{}, required bool) error {
+	rawValue, ok := params[name]
+	if !ok || string(rawValue) == "null" {
+		if required {
+			return fmt.Errorf("missing param %s", name)
+		}
+
+		return nil
+	}
+
+	err := json.Unmarshal(rawValue, value)
+	if err != nil {
+		return fmt.Errorf("invalid param %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (params jsonRpcParams) decodeNonEmptyString(name string) (string, error) {
+	value := ""
+	err := params.decode(name, &value, true)
+	if err != nil {
+		return "", err
+	}
+	if len(value) == 0 {
+		return "", fmt.Errorf("empty param %s", name)
+	}
+
+	return value, nil
+}
+
+func (params jsonRpcParams) decodeAccountQueryOptions() (api.AccountQueryOptions, error) {
+	rpcOptions := jsonRpcAccountOptions{}
+	err := params.decode(paramOptions, &rpcOptions, false)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	options := api.AccountQueryOptions{
+		OnFinalBlock: rpcOptions.OnFinalBlock,
+		WithKeys:     rpcOptions.WithKeys,
+	}
+	if rpcOptions.OnStartOfEpoch != nil {
+		options.OnStartOfEpoch = core.OptionalUint32{Value: *rpcOptions.OnStartOfEpoch, HasValue: true}
+	}
+	if rpcOptions.BlockNonce != nil {
+		options.BlockNonce = core.OptionalUint64{Value: *rpcOptions.BlockNonce, HasValue: true}
+	}
+	if rpcOptions.HintEpoch != nil {
+		options.HintEpoch = core.OptionalUint32{Value: *rpcOptions.HintEpoch, HasValue: true}
+	}
+
+	options.BlockHash, err = hex.DecodeString(rpcOptions.BlockHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, fmt.Errorf("%w for block hash", err)
+	}
+
+	options.BlockRootHash, err = hex.DecodeString(rpcOptions.BlockRootHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, fmt.Errorf("%w for block root hash", err)
+	}
+
+	err = checkAccountQueryOptions(options)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	return options, nil
+}
+
+func (params jsonRpcParams) decodeAccountParams() (string, api.AccountQueryOptions, error) {
+	address, err := params.decodeNonEmptyString(paramAddress)
+	if err != nil {
+		return "", api.AccountQueryOptions{}, err
+	}
+
+	options, err := params.decodeAccountQueryOptions()
+	if err != nil {
+		return "", api.AccountQueryOptions{}, err
+	}
+
+	return address, options, nil
+}
+
+func (params jsonRpcParams) decodeBlockQueryOptions() (api.BlockQueryOptions, error) {
+	rpcOptions := jsonRpcBlockOptions{}
+	err := params.decode(paramOptions, &rpcOptions, false)
+	if err != nil {
+		return api.BlockQueryOptions{}, err
+	}
+
+	return api.BlockQueryOptions{
+		WithTransactions: rpcOptions.WithTxs,
+		WithLogs:         rpcOptions.WithLogs,
+		ForHyperblock:    rpcOptions.ForHyperblock,
+	}, nil
+}
+
+func jsonRpcGetAccount(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	address, options, err := params.decodeAccountParams()
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	accountResponse, blockInfo, err := facade.GetAccount(address, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrCouldNotGetAccount, err)
+	}
+
+	accountResponse.Address = address
+	return gin.H{"account": accountResponse, "blockInfo": blockInfo}, nil
+}
+
+func jsonRpcGetBalance(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	address, options, err := params.decodeAccountParams()
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	balance, blockInfo, err := facade.GetBalance(address, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetBalance, err)
+	}
+
+	return gin.H{"balance": balance.String(), "blockInfo": blockInfo}, nil
+}
+
+func jsonRpcGetUsername(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	address, options, err := params.decodeAccountParams()
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	username, blockInfo, err := facade.GetUsername(address, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetUsername, err)
+	}
+
+	return gin.H{"username": username, "blockInfo": blockInfo}, nil
+}
+
+func jsonRpcGetCodeHash(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	address, options, err := params.decodeAccountParams()
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	codeHash, blockInfo, err := facade.GetCodeHash(address, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetCodeHash, err)
+	}
+
+	return gin.H{"codeHash": codeHash, "blockInfo": blockInfo}, nil
+}
+
+func jsonRpcGetKeyValuePairs(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	address, options, err := params.decodeAccountParams()
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	pairs, blockInfo, err := facade.GetKeyValuePairs(address, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetKeyValuePairs, err)
+	}
+
+	return gin.H{"pairs": pairs, "blockInfo": blockInfo}, nil
+}
+
+func jsonRpcGetValueForKey(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	address, options, err := params.decodeAccountParams()
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	key, err := params.decodeNonEmptyString(paramKey)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	value, blockInfo, err := facade.GetValueForKey(address, key, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetValueForKey, err)
+	}
+
+	return gin.H{"value": value, "blockInfo": blockInfo}, nil
+}
+
+func jsonRpcGetAllESDTTokens(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	address, options, err := params.decodeAccountParams()
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	tokens, blockInfo, err := facade.GetAllESDTTokens(address, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetESDTNFTData, err)
+	}
+
+	formattedTokens := make(map[string]*ESDTNFTTokenData)
+	for tokenID, esdtData := range tokens {
+		formattedTokens[tokenID] = buildTokenDataApiResponse(tokenID, esdtData)
+	}
+
+	return gin.H{"esdts": formattedTokens, "blockInfo": blockInfo}, nil
+}
+
+func jsonRpcGetGuardianData(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	address, options, err := params.decodeAccountParams()
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	guardianData, blockInfo, err := facade.GetGuardianData(address, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetGuardianData, err)
+	}
+
+	return gin.H{"guardianData": guardianData, "blockInfo": blockInfo}, nil
+}
+
+func jsonRpcCreateTransaction(facade jsonRpcFacadeHandler, params jsonRpcParams) (*transaction.Transaction, []byte, *jsonRpcError) {
+	ftx := transaction.FrontendTransaction{}
+	err := params.decode(paramTransaction, &ftx, true)
+	if err != nil {
+		return nil, nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	tx, txHash, err := facade.CreateTransaction(newArgsCreateTransaction(&ftx))
+	if err != nil {
+		return nil, nil, newJsonRpcInvalidParamsError(fmt.Errorf("%s: %w", errors.ErrTxGenerationFailed.Error(), err))
+	}
+
+	return tx, txHash, nil
+}
+
+func jsonRpcSendTransaction(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	tx, txHash, rpcErr := jsonRpcCreateTransaction(facade, params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	err := facade.ValidateTransaction(tx)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(fmt.Errorf("%s: %w", errors.ErrTxGenerationFailed.Error(), err))
+	}
+
+	_, err = facade.SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrTxGenerationFailed, err)
+	}
+
+	return gin.H{"txHash": hex.EncodeToString(txHash)}, nil
+}
+
+func jsonRpcSimulateTransaction(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	checkSignature := true
+	err := params.decode(paramCheckSignature, &checkSignature, false)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	tx, txHash, rpcErr := jsonRpcCreateTransaction(facade, params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	err = facade.ValidateTransactionForSimulation(tx, checkSignature)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(fmt.Errorf("%s: %w", errors.ErrTxGenerationFailed.Error(), err))
+	}
+
+	executionResults, err := facade.SimulateTransactionExecution(tx)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrTxGenerationFailed, err)
+	}
+
+	executionResults.Hash = hex.EncodeToString(txHash)
+	return gin.H{"result": executionResults}, nil
+}
+
+func jsonRpcComputeTransactionGasLimit(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	tx, _, rpcErr := jsonRpcCreateTransaction(facade, params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	cost, err := facade.ComputeTransactionGasLimit(tx)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrTxGenerationFailed, err)
+	}
+
+	return cost, nil
+}
+
+func jsonRpcGetTransaction(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	hash, err := params.decodeNonEmptyString(paramHash)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	withResults := false
+	err = params.decode(paramWithResults, &withResults, false)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	tx, err := facade.GetTransaction(hash, withResults)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetTransaction, err)
+	}
+
+	return gin.H{"transaction": tx}, nil
+}
+
+func jsonRpcGetBlockByNonce(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	nonce := uint64(0)
+	err := params.decode(paramNonce, &nonce, true)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	options, err := params.decodeBlockQueryOptions()
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	block, err := facade.GetBlockByNonce(nonce, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func jsonRpcGetBlockByHash(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	hash, err := params.decodeNonEmptyString(paramHash)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	options, err := params.decodeBlockQueryOptions()
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	block, err := facade.GetBlockByHash(hash, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func jsonRpcGetBlockByRound(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	round := uint64(0)
+	err := params.decode(paramRound, &round, true)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	options, err := params.decodeBlockQueryOptions()
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	block, err := facade.GetBlockByRound(round, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func jsonRpcExecuteQuery(facade jsonRpcFacadeHandler, params jsonRpcParams) (interface{}, *jsonRpcError) {
+	request := VMValueRequest{}
+	err := params.decode(paramQuery, &request, true)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	rpcOptions := jsonRpcQueryOptions{}
+	err = params.decode(paramOptions, &rpcOptions, false)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	command, err := createSCQuery(facade, &request)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err)
+	}
+
+	if rpcOptions.BlockNonce != nil {
+		command.BlockNonce = core.OptionalUint64{Value: *rpcOptions.BlockNonce, HasValue: true}
+	}
+	command.BlockHash, err = hex.DecodeString(rpcOptions.BlockHash)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(fmt.Errorf("%w for block hash", err))
+	}
+
+	vmOutputApi, blockInfo, err := facade.ExecuteSCQuery(command)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrQueryError, err)
+	}
+
+	vmExecErrMsg := ""
+	if len(vmOutputApi.ReturnCode) > 0 && vmOutputApi.ReturnCode != vmcommon.Ok.String() {
+		vmExecErrMsg = vmOutputApi.ReturnCode + ":" + vmOutputApi.ReturnMessage
+	}
+
+	return gin.H{"data": vmOutputApi, "blockInfo": blockInfo, "error": vmExecErrMsg}, nil
+}
+
+func jsonRpcGetNetworkConfig(facade jsonRpcFacadeHandler, _ jsonRpcParams) (interface{}, *jsonRpcError) {
+	configMetrics, err := facade.StatusMetrics().ConfigMetrics()
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetNetworkConfig, err)
+	}
+
+	return gin.H{"config": configMetrics}, nil
+}
+
+func jsonRpcGetNetworkStatus(facade jsonRpcFacadeHandler, _ jsonRpcParams) (interface{}, *jsonRpcError) {
+	networkMetrics, err := facade.StatusMetrics().NetworkMetrics()
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetNetworkStatus, err)
+	}
+
+	return gin.H{"status": networkMetrics}, nil
+}
@@ -83,76 +83,136 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 			Path:    getConfigPath,
 			Method:  http.MethodGet,
 			Handler: ng.getNetworkConfig,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the configuration metrics of the network",
+				Response: gin.H{"config": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    getStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.getNetworkStatus,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the status metrics of the network",
+				Response: gin.H{"status": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    economicsPath,
 			Method:  http.MethodGet,
 			Handler: ng.economicsMetrics,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the economics metrics of the network",
+				Response: gin.H{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    enableEpochsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getEnableEpochs,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the activation epochs of the protocol features",
+				Response: gin.H{"enableEpochs": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    getESDTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(""),
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the identifiers of all the issued tokens",
+				Response: gin.H{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    getFFTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(core.FungibleESDT),
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the identifiers of the issued fungible tokens",
+				Response: gin.H{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    getSFTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(core.SemiFungibleESDT),
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the identifiers of the issued semi-fungible tokens",
+				Response: gin.H{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    getNFTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(core.NonFungibleESDTv2),
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the identifiers of the issued non-fungible tokens",
+				Response: gin.H{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    directStakedInfoPath,
 			Method:  http.MethodGet,
 			Handler: ng.directStakedInfo,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the list of the direct stakers",
+				Response: gin.H{"list": []*api.DirectStakedValue{}},
+			},
 		},
 		{
 			Path:    delegatedInfoPath,
 			Method:  http.MethodGet,
 			Handler: ng.delegatedInfo,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the list of the delegators",
+				Response: gin.H{"list": []*api.Delegator{}},
+			},
 		},
 		{
 			Path:    getESDTSupplyPath,
 			Method:  http.MethodGet,
 			Handler: ng.getESDTTokenSupply,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the supply of the given token",
+				Response: api.ESDTSupply{},
+			},
 		},
 		{
 			Path:    ratingsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getRatingsConfig,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the ratings configuration",
+				Response: gin.H{"config": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    genesisNodesConfigPath,
 			Method:  http.MethodGet,
 			Handler: ng.getGenesisNodesConfig,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the public keys of the genesis nodes",
+				Response: gin.H{"nodes": GenesisNodesConfig{}},
+			},
 		},
 		{
 			Path:    genesisBalances,
 			Method:  http.MethodGet,
 			Handler: ng.getGenesisBalances,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the balances minted at genesis",
+				Response: gin.H{"balances": []*common.InitialAccountAPI{}},
+			},
 		},
 		{
 			Path:    gasConfigPath,
 			Method:  http.MethodGet,
 			Handler: ng.getGasConfig,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the currently scheduled gas configs",
+				Response: gin.H{"gasConfigs": GasConfig{}},
+			},
 		},
 	}
 	ng.endpoints = endpoints
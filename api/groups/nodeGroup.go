@@ -80,76 +80,141 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Path:    heartbeatStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.heartbeatStatus,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the heartbeat status of the known nodes",
+				Response: gin.H{"heartbeats": []data.PubKeyHeartbeat{}},
+			},
 		},
 		{
 			Path:    statusPath,
 			Method:  http.MethodGet,
 			Handler: ng.statusMetrics,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the status metrics of the node",
+				Response: gin.H{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    p2pStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.p2pStatusMetrics,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the p2p status metrics of the node",
+				Response: gin.H{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    metricsPath,
 			Method:  http.MethodGet,
 			Handler: ng.prometheusMetrics,
+			Schema: &shared.EndpointSchema{
+				Summary:     "returns the status metrics of the node in the prometheus format",
+				Response:    "",
+				ContentType: "text/plain",
+			},
 		},
 		{
 			Path:    debugPath,
 			Method:  http.MethodPost,
 			Handler: ng.queryDebug,
+			Schema: &shared.EndpointSchema{
+				Summary:  "queries the debug handler with the given name",
+				Request:  QueryDebugRequest{},
+				Response: gin.H{"result": []string{}},
+			},
 		},
 		{
 			Path:    peerInfoPath,
 			Method:  http.MethodGet,
 			Handler: ng.peerInfo,
+			Schema: &shared.EndpointSchema{
+				Summary: "returns the information about the given p2p peer",
+				QueryParameters: []shared.QueryParameter{
+					{Name: pidQueryParam, Type: shared.ParameterTypeString, Description: "the peer ID", Required: true},
+				},
+				Response: gin.H{"info": []core.QueryP2PPeerInfo{}},
+			},
 		},
 		{
 			Path:    epochStartDataForEpoch,
 			Method:  http.MethodGet,
 			Handler: ng.epochStartDataForEpoch,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the start of epoch data of the given epoch",
+				Response: gin.H{"epochStart": common.EpochStartDataAPI{}},
+			},
 		},
 		{
 			Path:    bootstrapStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.bootstrapMetrics,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the bootstrap metrics of the node",
+				Response: gin.H{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    connectedPeersRatingsPath,
 			Method:  http.MethodGet,
 			Handler: ng.connectedPeersRatings,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the ratings of the connected peers, as JSON string",
+				Response: gin.H{"ratings": ""},
+			},
 		},
 		{
 			Path:    managedKeysCount,
 			Method:  http.MethodGet,
 			Handler: ng.managedKeysCount,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the number of keys managed by the node",
+				Response: gin.H{"count": 0},
+			},
 		},
 		{
 			Path:    managedKeys,
 			Method:  http.MethodGet,
 			Handler: ng.managedKeys,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the keys managed by the node",
+				Response: gin.H{"managedKeys": []string{}},
+			},
 		},
 		{
 			Path:    loadedKeys,
 			Method:  http.MethodGet,
 			Handler: ng.loadedKeys,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the keys loaded by the node",
+				Response: gin.H{"loadedKeys": []string{}},
+			},
 		},
 		{
 			Path:    eligibleManagedKeys,
 			Method:  http.MethodGet,
 			Handler: ng.managedKeysEligible,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the managed keys that are eligible in the current epoch",
+				Response: gin.H{"eligibleKeys": []string{}},
+			},
 		},
 		{
 			Path:    waitingManagedKeys,
 			Method:  http.MethodGet,
 			Handler: ng.managedKeysWaiting,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the managed keys that are waiting in the current epoch",
+				Response: gin.H{"waitingKeys": []string{}},
+			},
 		},
 		{
 			Path:    epochsLeftInWaiting,
 			Method:  http.MethodGet,
 			Handler: ng.waitingEpochsLeft,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the number of epochs the given key will remain in the waiting list",
+				Response: gin.H{"epochsLeft": uint32(0)},
+			},
 		},
 	}
 	ng.endpoints = endpoints
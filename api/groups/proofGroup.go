@@ -58,6 +58,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    getProofPath,
 			Method:  http.MethodGet,
 			Handler: pg.getProof,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the merkle proof of the given address in the trie with the given root hash",
+				Response: gin.H{"proof": []string{}, "value": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofEndpoint, facade),
@@ -69,6 +73,14 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    getProofDataTriePath,
 			Method:  http.MethodGet,
 			Handler: pg.getProofDataTrie,
+			Schema: &shared.EndpointSchema{
+				Summary: "returns the merkle proofs of the given key from the data trie of the given address",
+				Response: gin.H{
+					"proofs":           gin.H{"mainProof": []string{}, "dataTrieProof": []string{}},
+					"value":            "",
+					"dataTrieRootHash": "",
+				},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofDataTrieEndpoint, facade),
@@ -80,6 +92,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    getProofCurrentRootHashPath,
 			Method:  http.MethodGet,
 			Handler: pg.getProofCurrentRootHash,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the merkle proof of the given address in the current state",
+				Response: gin.H{"proof": []string{}, "value": "", "rootHash": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofCurrentRootHashEndpoint, facade),
@@ -91,6 +107,11 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    verifyProofPath,
 			Method:  http.MethodPost,
 			Handler: pg.verifyProof,
+			Schema: &shared.EndpointSchema{
+				Summary:  "verifies the given merkle proof",
+				Request:  VerifyProofRequest{},
+				Response: gin.H{"ok": false},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyProofEndpoint, facade),
@@ -0,0 +1,131 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	getStateDiffPath        = "/diff"
+	exportStateSnapshotPath = "/snapshot/:epoch"
+)
+
+// stateFacadeHandler defines the methods to be implemented by a facade for state requests
+type stateFacadeHandler interface {
+	GetStateDiff(options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error)
+	ExportStateSnapshot(epoch uint32) (string, error)
+	IsInterfaceNil() bool
+}
+
+type stateGroup struct {
+	*baseGroup
+	facade    stateFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewStateGroup returns a new instance of stateGroup
+func NewStateGroup(facade stateFacadeHandler) (*stateGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for state group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &stateGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    getStateDiffPath,
+			Method:  http.MethodGet,
+			Handler: sg.getStateDiff,
+			Schema: &shared.EndpointSchema{
+				Summary:         "returns the accounts added, changed or removed between two blocks, together with their differences",
+				QueryParameters: stateDiffParameters,
+				Response:        gin.H{"diff": common.StateDiffApiResponse{}},
+			},
+		},
+		{
+			Path:    exportStateSnapshotPath,
+			Method:  http.MethodPost,
+			Handler: sg.exportStateSnapshot,
+			Schema: &shared.EndpointSchema{
+				Summary:  "starts exporting the state tries of the given epoch start block in a state snapshot file",
+				Response: gin.H{"file": ""},
+			},
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// getStateDiff returns the accounts altered between two blocks, together with their differences
+func (sg *stateGroup) getStateDiff(c *gin.Context) {
+	options, err := extractStateDiffQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetStateDiff, err)
+		return
+	}
+
+	stateDiff, err := sg.getFacade().GetStateDiff(options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetStateDiff, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"diff": stateDiff})
+}
+
+// exportStateSnapshot starts exporting the state snapshot of an epoch and returns the path of the file
+func (sg *stateGroup) exportStateSnapshot(c *gin.Context) {
+	epoch, err := getQueryParamEpoch(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrExportStateSnapshot, errors.ErrInvalidEpoch)
+		return
+	}
+
+	file, err := sg.getFacade().ExportStateSnapshot(epoch)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrExportStateSnapshot, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"file": file})
+}
+
+func (sg *stateGroup) getFacade() stateFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *stateGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(stateFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = castFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *stateGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
@@ -0,0 +1,207 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStateGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewStateGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewStateGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+	})
+}
+
+func TestStateGroup_getStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid url params should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, code := requestStateDiff(t, &mock.FacadeStub{}, "/state/diff?fromNonce=5")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStateDiff.Error()))
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetStateDiffCalled: func(options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response, code := requestStateDiff(t, facade, "/state/diff?fromNonce=1&toNonce=2")
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStateDiff.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetStateDiffCalled: func(options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error) {
+				require.Equal(t, common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 2}, options)
+
+				return &common.StateDiffApiResponse{
+					Accounts: []*common.AccountStateDiffApiResponse{
+						{Address: "erd1a", Status: common.AccountStateAdded},
+						{Address: "erd1b", Status: common.AccountStateRemoved},
+					},
+					Truncated: true,
+				}, nil
+			},
+		}
+
+		response, code := requestStateDiff(t, facade, "/state/diff?fromNonce=1&toNonce=2")
+		assert.Equal(t, http.StatusOK, code)
+		require.Empty(t, response.Error)
+		require.Len(t, response.Data.Diff.Accounts, 2)
+		assert.Equal(t, common.AccountStateAdded, response.Data.Diff.Accounts[0].Status)
+		assert.Equal(t, common.AccountStateRemoved, response.Data.Diff.Accounts[1].Status)
+		assert.True(t, response.Data.Diff.Truncated)
+	})
+}
+
+type exportStateSnapshotResponse struct {
+	Data struct {
+		File string `json:"file"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestStateGroup_exportStateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, code := requestExportStateSnapshot(t, &mock.FacadeStub{}, "/state/snapshot/abc")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrExportStateSnapshot.Error()))
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidEpoch.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			ExportStateSnapshotCalled: func(epoch uint32) (string, error) {
+				return "", expectedErr
+			},
+		}
+
+		response, code := requestExportStateSnapshot(t, facade, "/state/snapshot/3")
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrExportStateSnapshot.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ExportStateSnapshotCalled: func(epoch uint32) (string, error) {
+				require.Equal(t, uint32(3), epoch)
+				return "Shard_0_Epoch_3.snapshot", nil
+			},
+		}
+
+		response, code := requestExportStateSnapshot(t, facade, "/state/snapshot/3")
+		assert.Equal(t, http.StatusOK, code)
+		require.Empty(t, response.Error)
+		assert.Equal(t, "Shard_0_Epoch_3.snapshot", response.Data.File)
+	})
+}
+
+func TestStateGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	sg, _ := groups.NewStateGroup(&mock.FacadeStub{})
+
+	err := sg.UpdateFacade(nil)
+	assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+
+	err = sg.UpdateFacade("not a facade")
+	assert.Equal(t, apiErrors.ErrFacadeWrongTypeAssertion, err)
+
+	err = sg.UpdateFacade(&mock.FacadeStub{})
+	assert.NoError(t, err)
+}
+
+func TestStateGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	sg, _ := groups.NewStateGroup(nil)
+	assert.True(t, sg.IsInterfaceNil())
+
+	sg, _ = groups.NewStateGroup(&mock.FacadeStub{})
+	assert.False(t, sg.IsInterfaceNil())
+}
+
+func requestStateDiff(t *testing.T, facade *mock.FacadeStub, url string) (*stateDiffResponse, int) {
+	sg, err := groups.NewStateGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(sg, "state", getStateRoutesConfig())
+
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &stateDiffResponse{}
+	loadResponse(resp.Body, response)
+
+	return response, resp.Code
+}
+
+func requestExportStateSnapshot(t *testing.T, facade *mock.FacadeStub, url string) (*exportStateSnapshotResponse, int) {
+	sg, err := groups.NewStateGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(sg, "state", getStateRoutesConfig())
+
+	req, _ := http.NewRequest("POST", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &exportStateSnapshotResponse{}
+	loadResponse(resp.Body, response)
+
+	return response, resp.Code
+}
+
+func getStateRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"state": {
+				Routes: []config.RouteConfig{
+					{Name: "/diff", Open: true},
+					{Name: "/snapshot/:epoch", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,278 @@
+package groups
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
+)
+
+const (
+	subscribeWebSocketPath = "/ws"
+	subscribeEventsPath    = "/events"
+
+	urlParamSubscriptionTypes       = "types"
+	urlParamSubscriptionAddresses   = "addresses"
+	urlParamSubscriptionIdentifiers = "identifiers"
+	urlParamSubscriptionTopics      = "topics"
+
+	subscriptionKeepAliveInterval = 30 * time.Second
+	webSocketWriteTimeout         = 10 * time.Second
+)
+
+// subscribeFacadeHandler defines the methods to be implemented by a facade for handling subscriptions requests
+type subscribeFacadeHandler interface {
+	Subscribe(filter subscriptions.Filter) (*subscriptions.Subscription, error)
+	IsInterfaceNil() bool
+}
+
+var subscriptionFilterParameters = []shared.QueryParameter{
+	{Name: urlParamSubscriptionTypes, Type: shared.ParameterTypeString, Description: "comma separated notification types: block, transaction, event"},
+	{Name: urlParamSubscriptionAddresses, Type: shared.ParameterTypeString, Description: "comma separated bech32 addresses"},
+	{Name: urlParamSubscriptionIdentifiers, Type: shared.ParameterTypeString, Description: "comma separated event identifiers"},
+	{Name: urlParamSubscriptionTopics, Type: shared.ParameterTypeString, Description: "comma separated hex encoded event topics"},
+}
+
+type subscribeGroup struct {
+	*baseGroup
+	facade    subscribeFacadeHandler
+	mutFacade sync.RWMutex
+	upgrader  websocket.Upgrader
+}
+
+// NewSubscribeGroup returns a new instance of subscribeGroup
+func NewSubscribeGroup(facade subscribeFacadeHandler) (*subscribeGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for subscribe group", apiErrors.ErrNilFacadeHandler)
+	}
+
+	sg := &subscribeGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+		// the default origin check of the upgrader only accepts same origin requests
+		upgrader: websocket.Upgrader{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    subscribeWebSocketPath,
+			Method:  http.MethodGet,
+			Handler: sg.subscribeWebSocket,
+			Schema: &shared.EndpointSchema{
+				Summary:         "opens a websocket connection on which the notifications matching the filter are pushed as JSON messages",
+				QueryParameters: subscriptionFilterParameters,
+				Response:        subscriptions.Notification{},
+				ContentType:     "application/json",
+			},
+		},
+		{
+			Path:    subscribeEventsPath,
+			Method:  http.MethodGet,
+			Handler: sg.subscribeServerSentEvents,
+			Schema: &shared.EndpointSchema{
+				Summary:         "streams the notifications matching the filter as server-sent events",
+				QueryParameters: subscriptionFilterParameters,
+				Response:        subscriptions.Notification{},
+				ContentType:     "text/event-stream",
+			},
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// subscribeWebSocket upgrades the connection to a websocket and pushes the notifications as JSON messages
+func (sg *subscribeGroup) subscribeWebSocket(c *gin.Context) {
+	subscription, ok := sg.subscribe(c)
+	if !ok {
+		return
+	}
+	defer subscription.Close()
+
+	conn, err := sg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("subscribeWebSocket: cannot upgrade connection", "error", err)
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	chClientGone := make(chan struct{})
+	go func() {
+		// the client is not expected to send anything, the read loop is only used to detect a closed connection
+		defer close(chClientGone)
+		for {
+			_, _, errRead := conn.ReadMessage()
+			if errRead != nil {
+				return
+			}
+		}
+	}()
+
+	keepAlive := time.NewTicker(subscriptionKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case notification := <-subscription.Notifications():
+			_ = conn.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout))
+			err = conn.WriteJSON(notification)
+			if err != nil {
+				log.Debug("subscribeWebSocket: cannot write notification", "error", err)
+				return
+			}
+		case <-keepAlive.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(webSocketWriteTimeout))
+			if err != nil {
+				return
+			}
+		case <-subscription.Done():
+			closeMessage := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "subscription ended")
+			_ = conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(webSocketWriteTimeout))
+			return
+		case <-chClientGone:
+			return
+		}
+	}
+}
+
+// subscribeServerSentEvents streams the notifications using the server-sent events protocol
+func (sg *subscribeGroup) subscribeServerSentEvents(c *gin.Context) {
+	subscription, ok := sg.subscribe(c)
+	if !ok {
+		return
+	}
+	defer subscription.Close()
+
+	keepAlive := time.NewTicker(subscriptionKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case notification := <-subscription.Notifications():
+			c.SSEvent(string(notification.Type), notification)
+			return true
+		case <-keepAlive.C:
+			_, err := w.Write([]byte(":keep-alive\n\n"))
+			return err == nil
+		case <-subscription.Done():
+			return false
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+func (sg *subscribeGroup) subscribe(c *gin.Context) (*subscriptions.Subscription, bool) {
+	filter, err := parseSubscriptionFilter(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrValidation, err)
+		return nil, false
+	}
+
+	subscription, err := sg.getFacade().Subscribe(filter)
+	if errors.Is(err, subscriptions.ErrTooManySubscribers) {
+		shared.RespondWith(
+			c,
+			http.StatusTooManyRequests,
+			nil,
+			fmt.Sprintf("%s: %s", apiErrors.ErrSubscribe.Error(), err.Error()),
+			shared.ReturnCodeSystemBusy,
+		)
+		return nil, false
+	}
+	if err != nil {
+		shared.RespondWithInternalError(c, apiErrors.ErrSubscribe, err)
+		return nil, false
+	}
+
+	return subscription, true
+}
+
+func parseSubscriptionFilter(c *gin.Context) (subscriptions.Filter, error) {
+	filter := subscriptions.Filter{
+		Addresses:   parseListUrlParam(c, urlParamSubscriptionAddresses),
+		Identifiers: parseListUrlParam(c, urlParamSubscriptionIdentifiers),
+	}
+
+	for _, value := range parseListUrlParam(c, urlParamSubscriptionTypes) {
+		notificationType, err := subscriptions.ParseNotificationType(value)
+		if err != nil {
+			return subscriptions.Filter{}, err
+		}
+
+		filter.Types = append(filter.Types, notificationType)
+	}
+
+	for _, value := range parseListUrlParam(c, urlParamSubscriptionTopics) {
+		topic, err := hex.DecodeString(value)
+		if err != nil {
+			return subscriptions.Filter{}, fmt.Errorf("%w for topic %s", err, value)
+		}
+
+		filter.Topics = append(filter.Topics, topic)
+	}
+
+	return filter, nil
+}
+
+func parseListUrlParam(c *gin.Context, name string) []string {
+	param := c.Request.URL.Query().Get(name)
+	if param == "" {
+		return nil
+	}
+
+	values := make([]string, 0)
+	for _, value := range strings.Split(param, ",") {
+		value = strings.TrimSpace(value)
+		if len(value) > 0 {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+func (sg *subscribeGroup) getFacade() subscribeFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *subscribeGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return apiErrors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(subscribeFacadeHandler)
+	if !ok {
+		return apiErrors.ErrFacadeWrongTypeAssertion
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = castFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *subscribeGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
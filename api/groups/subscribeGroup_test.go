@@ -0,0 +1,292 @@
+package groups_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+var finalizedHeaderHash = []byte("finalized header hash")
+
+func pushFinalizedBlockWhenSubscribed(t *testing.T, hub *subscriptionsHubHolder) {
+	for i := 0; i < 100; i++ {
+		if hub.numSubscribers() > 0 {
+			err := hub.FinalizedBlock(&outport.FinalizedBlock{ShardID: 1, HeaderHash: finalizedHeaderHash})
+			require.Nil(t, err)
+			return
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	require.Fail(t, "no subscriber registered")
+}
+
+type subscriptionsHubHolder struct {
+	outportFinalizer
+	numSubscribers func() int
+}
+
+type outportFinalizer interface {
+	FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error
+}
+
+func createSubscriptionsHubHolder(t *testing.T) (*subscriptionsHubHolder, *mock.FacadeStub) {
+	hub, err := subscriptions.NewSubscriptionsHub(subscriptions.ArgsSubscriptionsHub{
+		Marshaller:              &marshallerMock.MarshalizerMock{},
+		AddressConverter:        testscommon.RealWorldBech32PubkeyConverter,
+		MaxSubscribers:          1,
+		NotificationsBufferSize: 10,
+	})
+	require.Nil(t, err)
+
+	holder := &subscriptionsHubHolder{
+		outportFinalizer: hub,
+		numSubscribers:   hub.NumSubscribers,
+	}
+	facade := &mock.FacadeStub{
+		SubscribeCalled: hub.Subscribe,
+	}
+
+	return holder, facade
+}
+
+func TestNewSubscribeGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewSubscribeGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewSubscribeGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+	})
+}
+
+func TestSubscribeGroup_SubscribeErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid type should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewSubscribeGroup(&mock.FacadeStub{})
+		ws := startWebServer(sg, "subscribe", getSubscribeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/subscribe/events?types=block,invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.True(t, strings.Contains(response.Error, subscriptions.ErrUnknownNotificationType.Error()))
+	})
+	t.Run("invalid topic should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewSubscribeGroup(&mock.FacadeStub{})
+		ws := startWebServer(sg, "subscribe", getSubscribeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/subscribe/ws?topics=not-hex", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Equal(t, shared.ReturnCodeRequestError, response.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			SubscribeCalled: func(filter subscriptions.Filter) (*subscriptions.Subscription, error) {
+				return nil, expectedErr
+			},
+		}
+		sg, _ := groups.NewSubscribeGroup(facade)
+		ws := startWebServer(sg, "subscribe", getSubscribeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/subscribe/events", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.True(t, strings.Contains(response.Error, apiErrors.ErrSubscribe.Error()))
+		require.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("too many subscribers should return system busy", func(t *testing.T) {
+		t.Parallel()
+
+		_, facade := createSubscriptionsHubHolder(t)
+		_, _ = facade.Subscribe(subscriptions.Filter{})
+
+		sg, _ := groups.NewSubscribeGroup(facade)
+		ws := startWebServer(sg, "subscribe", getSubscribeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/subscribe/events", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusTooManyRequests, resp.Code)
+		require.Equal(t, shared.ReturnCodeSystemBusy, response.Code)
+	})
+}
+
+func TestSubscribeGroup_ServerSentEvents(t *testing.T) {
+	t.Parallel()
+
+	hub, facade := createSubscriptionsHubHolder(t)
+	sg, _ := groups.NewSubscribeGroup(facade)
+	server := httptest.NewServer(startWebServer(sg, "subscribe", getSubscribeRoutesConfig()))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+"/subscribe/events?types=finalizedBlock", nil)
+	go pushFinalizedBlockWhenSubscribed(t, hub)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	reader := bufio.NewReader(resp.Body)
+	eventLine, err := reader.ReadString('\n')
+	require.Nil(t, err)
+	require.Equal(t, fmt.Sprintf("event:%s\n", subscriptions.FinalizedBlockNotificationType), eventLine)
+
+	dataLine, err := reader.ReadString('\n')
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(dataLine, "data:"))
+
+	notification := &subscriptions.Notification{}
+	err = json.Unmarshal([]byte(strings.TrimPrefix(dataLine, "data:")), notification)
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString(finalizedHeaderHash), notification.Block.Hash)
+	require.Equal(t, uint32(1), notification.Block.ShardID)
+}
+
+func TestSubscribeGroup_WebSocket(t *testing.T) {
+	t.Parallel()
+
+	hub, facade := createSubscriptionsHubHolder(t)
+	sg, _ := groups.NewSubscribeGroup(facade)
+	server := httptest.NewServer(startWebServer(sg, "subscribe", getSubscribeRoutesConfig()))
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscribe/ws?types=finalizedBlock"
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	require.Nil(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	go pushFinalizedBlockWhenSubscribed(t, hub)
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	notification := &subscriptions.Notification{}
+	err = conn.ReadJSON(notification)
+	require.Nil(t, err)
+	require.Equal(t, subscriptions.FinalizedBlockNotificationType, notification.Type)
+	require.Equal(t, hex.EncodeToString(finalizedHeaderHash), notification.Block.Hash)
+}
+
+func TestSubscribeGroup_WebSocketCrossOriginShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	_, facade := createSubscriptionsHubHolder(t)
+	sg, _ := groups.NewSubscribeGroup(facade)
+	server := httptest.NewServer(startWebServer(sg, "subscribe", getSubscribeRoutesConfig()))
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscribe/ws?types=finalizedBlock"
+	header := http.Header{}
+	header.Set("Origin", "http://other-origin.com")
+	conn, resp, err := websocket.DefaultDialer.Dial(wsURL, header)
+	require.Equal(t, websocket.ErrBadHandshake, err)
+	require.Nil(t, conn)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	_ = resp.Body.Close()
+}
+
+func TestSubscribeGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewSubscribeGroup(&mock.FacadeStub{})
+		err := sg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewSubscribeGroup(&mock.FacadeStub{})
+		err := sg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewSubscribeGroup(&mock.FacadeStub{})
+		err := sg.UpdateFacade(&mock.FacadeStub{})
+		require.Nil(t, err)
+	})
+}
+
+func TestSubscribeGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	sg, _ := groups.NewSubscribeGroup(nil)
+	require.True(t, sg.IsInterfaceNil())
+
+	sg, _ = groups.NewSubscribeGroup(&mock.FacadeStub{})
+	require.False(t, sg.IsInterfaceNil())
+}
+
+func getSubscribeRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"subscribe": {
+				Routes: []config.RouteConfig{
+					{Name: "/ws", Open: true},
+					{Name: "/events", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -2,6 +2,7 @@ package groups
 
 import (
 	"encoding/hex"
+	errorsGo "errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -19,6 +20,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/shared/logging"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 )
 
@@ -29,6 +31,7 @@ const (
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
 	getScrsByTxHashEndpoint          = "/transaction/scrs-by-tx-hash/:txhash"
+	getTransactionTraceEndpoint      = "/transaction/:hash/trace"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
 	simulateSCRCostPath              = "/cost-scr"
@@ -36,6 +39,7 @@ const (
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
 	getScrsByTxHashPath              = "/scrs-by-tx-hash/:txhash"
+	getTransactionTracePath          = "/:txhash/trace"
 	getTransactionsPool              = "/pool"
 
 	queryParamWithResults    = "withResults"
@@ -57,6 +61,7 @@ type transactionFacadeHandler interface {
 	SimulateSCRExecutionCost(scr *smartContractResult.SmartContractResult) (*transaction.CostResponse, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetTransactionTrace(hash string) (*tracing.TransactionTrace, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
@@ -89,6 +94,11 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    sendTransactionPath,
 			Method:  http.MethodPost,
 			Handler: tg.sendTransaction,
+			Schema: &shared.EndpointSchema{
+				Summary:  "send a signed transaction",
+				Request:  transaction.FrontendTransaction{},
+				Response: gin.H{"txHash": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(sendTransactionEndpoint, facade),
@@ -100,6 +110,14 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    simulateTransactionPath,
 			Method:  http.MethodPost,
 			Handler: tg.simulateTransaction,
+			Schema: &shared.EndpointSchema{
+				Summary: "simulate the execution of a transaction without broadcasting it",
+				QueryParameters: []shared.QueryParameter{
+					{Name: queryParamCheckSignature, Type: shared.ParameterTypeBoolean, Description: "verify the signature of the transaction, defaults to true"},
+				},
+				Request:  transaction.FrontendTransaction{},
+				Response: gin.H{"result": txSimData.SimulationResultsWithVMOutput{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateTransactionEndpoint, facade),
@@ -111,6 +129,11 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    simulateSCRCostPath,
 			Method:  http.MethodPost,
 			Handler: tg.simulateSCR,
+			Schema: &shared.EndpointSchema{
+				Summary:  "estimate the cost of executing a smart contract result",
+				Request:  smartContractResult.SmartContractResult{},
+				Response: transaction.CostResponse{},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateSCRCostEndpoint, facade),
@@ -122,11 +145,30 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    costPath,
 			Method:  http.MethodPost,
 			Handler: tg.computeTransactionGasLimit,
+			Schema: &shared.EndpointSchema{
+				Summary:  "estimate the gas limit of a transaction",
+				Request:  transaction.FrontendTransaction{},
+				Response: transaction.CostResponse{},
+			},
 		},
 		{
 			Path:    getTransactionsPool,
 			Method:  http.MethodGet,
 			Handler: tg.getTransactionsPool,
+			Schema: &shared.EndpointSchema{
+				Summary: "get the transactions from pool, either all of them or only the ones of a sender. Only one of the txPool, nonce and nonceGaps fields is returned, depending on the provided parameters",
+				QueryParameters: []shared.QueryParameter{
+					{Name: queryParamSender, Type: shared.ParameterTypeString, Description: "the bech32 address of the sender"},
+					{Name: queryParamFields, Type: shared.ParameterTypeString, Description: "comma separated fields to be returned for each transaction, * for all of them"},
+					{Name: queryParamLastNonce, Type: shared.ParameterTypeBoolean, Description: "return the last nonce of the sender from pool"},
+					{Name: queryParamNonceGaps, Type: shared.ParameterTypeBoolean, Description: "return the nonce gaps of the sender from pool"},
+				},
+				Response: gin.H{
+					"txPool":    common.TransactionsPoolAPIResponse{},
+					"nonce":     uint64(0),
+					"nonceGaps": common.TransactionsPoolNonceGapsForSenderApiResponse{},
+				},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionPath, facade),
@@ -138,6 +180,11 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    sendMultiplePath,
 			Method:  http.MethodPost,
 			Handler: tg.sendMultipleTransactions,
+			Schema: &shared.EndpointSchema{
+				Summary:  "send multiple signed transactions",
+				Request:  []transaction.FrontendTransaction{},
+				Response: gin.H{"txsSent": 0, "txsHashes": map[int]string{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(sendMultipleTransactionsEndpoint, facade),
@@ -149,6 +196,13 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    getTransactionPath,
 			Method:  http.MethodGet,
 			Handler: tg.getTransaction,
+			Schema: &shared.EndpointSchema{
+				Summary: "get a transaction by its hash",
+				QueryParameters: []shared.QueryParameter{
+					{Name: queryParamWithResults, Type: shared.ParameterTypeBoolean, Description: "include the smart contract results and logs of the transaction"},
+				},
+				Response: gin.H{"transaction": transaction.ApiTransactionResult{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionEndpoint, facade),
@@ -160,6 +214,13 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    getScrsByTxHashPath,
 			Method:  http.MethodGet,
 			Handler: tg.getScrsByTxHash,
+			Schema: &shared.EndpointSchema{
+				Summary: "get the smart contract results generated by a transaction",
+				QueryParameters: []shared.QueryParameter{
+					{Name: queryParameterScrHash, Type: shared.ParameterTypeString, Description: "the hex encoded hash of one of the smart contract results, used for locating them", Required: true},
+				},
+				Response: gin.H{"scrs": []*transaction.ApiSmartContractResult{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getScrsByTxHashEndpoint, facade),
@@ -167,6 +228,21 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    getTransactionTracePath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionTrace,
+			Schema: &shared.EndpointSchema{
+				Summary:  "get the call frames of the smart contract executions triggered by a transaction, in all the shards. Requires the execution tracer to be enabled",
+				Response: gin.H{"trace": tracing.TransactionTrace{}},
+			},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionTraceEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	tg.endpoints = endpoints
 
@@ -443,6 +519,51 @@ func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 	)
 }
 
+// getTransactionTrace returns the execution trace of the transaction identified by the given hash
+func (tg *transactionGroup) getTransactionTrace(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	trace, err := tg.getFacade().GetTransactionTrace(txhash)
+	if err != nil {
+		statusCode, returnCode := http.StatusInternalServerError, shared.ReturnCodeInternalError
+		if errorsGo.Is(err, tracing.ErrTraceNotFound) {
+			statusCode, returnCode = http.StatusNotFound, shared.ReturnCodeRequestError
+		}
+
+		c.JSON(
+			statusCode,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionTrace.Error(), err.Error()),
+				Code:  returnCode,
+			},
+		)
+		return
+	}
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionTrace")
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"trace": trace},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func (tg *transactionGroup) getScrsByTxHash(c *gin.Context) {
 	txhash := c.Param("txhash")
 	if txhash == "" {
@@ -774,7 +895,16 @@ func (tg *transactionGroup) getTransactionsPoolNonceGapsForSender(sender string,
 }
 
 func (tg *transactionGroup) createTransaction(receivedTx *transaction.FrontendTransaction) (*transaction.Transaction, []byte, error) {
-	txArgs := &external.ArgsCreateTransaction{
+	txArgs := newArgsCreateTransaction(receivedTx)
+	start := time.Now()
+	tx, txHash, err := tg.getFacade().CreateTransaction(txArgs)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+
+	return tx, txHash, err
+}
+
+func newArgsCreateTransaction(receivedTx *transaction.FrontendTransaction) *external.ArgsCreateTransaction {
+	return &external.ArgsCreateTransaction{
 		Nonce:               receivedTx.Nonce,
 		Value:               receivedTx.Value,
 		Receiver:            receivedTx.Receiver,
@@ -793,11 +923,6 @@ func (tg *transactionGroup) createTransaction(receivedTx *transaction.FrontendTr
 		Relayer:             receivedTx.RelayerAddr,
 		RelayerSignatureHex: receivedTx.RelayerSignature,
 	}
-	start := time.Now()
-	tx, txHash, err := tg.getFacade().CreateTransaction(txArgs)
-	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
-
-	return tx, txHash, err
 }
 
 func validateQuery(sender, fields string, lastNonce, nonceGaps bool) error {
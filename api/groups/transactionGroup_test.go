@@ -21,6 +21,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -412,6 +413,98 @@ func TestTransactionsGroup_getSCRsByTxHash(t *testing.T) {
 	})
 }
 
+func TestTransactionsGroup_getTransactionTrace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		localErr := fmt.Errorf("error")
+		facade := &mock.FacadeStub{
+			GetTransactionTraceCalled: func(hash string) (*tracing.TransactionTrace, error) {
+				return nil, localErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest(http.MethodGet, "/transaction/aabb/trace", bytes.NewBuffer([]byte{}))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(txResp.Error, apiErrors.ErrGetTransactionTrace.Error()))
+		assert.True(t, strings.Contains(txResp.Error, localErr.Error()))
+		assert.Empty(t, txResp.Data)
+	})
+	t.Run("trace not found should return not found", func(t *testing.T) {
+		facade := &mock.FacadeStub{
+			GetTransactionTraceCalled: func(hash string) (*tracing.TransactionTrace, error) {
+				return nil, tracing.ErrTraceNotFound
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest(http.MethodGet, "/transaction/aabb/trace", bytes.NewBuffer([]byte{}))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.True(t, strings.Contains(txResp.Error, tracing.ErrTraceNotFound.Error()))
+		assert.Equal(t, shared.ReturnCodeRequestError, txResp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		expectedTrace := &tracing.TransactionTrace{
+			TxHash: "aabb",
+			Frames: []*tracing.CallFrame{
+				{
+					Type:     tracing.SmartContractCallFrame,
+					Callee:   "callee",
+					Function: "function",
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionTraceCalled: func(hash string) (*tracing.TransactionTrace, error) {
+				require.Equal(t, "aabb", hash)
+				return expectedTrace, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest(http.MethodGet, "/transaction/aabb/trace", bytes.NewBuffer([]byte{}))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		traceResp := struct {
+			Data struct {
+				Trace *tracing.TransactionTrace `json:"trace"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}{}
+		loadResponse(resp.Body, &traceResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, traceResp.Error)
+		assert.Equal(t, expectedTrace, traceResp.Data.Trace)
+	})
+}
+
 func TestTransactionGroup_sendMultipleTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -1193,6 +1286,7 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
 					{Name: "/scrs-by-tx-hash/:txhash", Open: true},
+					{Name: "/:txhash/trace", Open: true},
 				},
 			},
 		},
@@ -47,11 +47,19 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 			Path:    statisticsPath,
 			Method:  http.MethodGet,
 			Handler: ng.statistics,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the statistics of all the validators",
+				Response: gin.H{"statistics": map[string]*validator.ValidatorStatistics{}},
+			},
 		},
 		{
 			Path:    auctionPath,
 			Method:  http.MethodGet,
 			Handler: ng.auction,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the auction list",
+				Response: gin.H{"auctionList": []*common.AuctionListValidatorAPIResponse{}},
+			},
 		},
 	}
 	ng.endpoints = endpoints
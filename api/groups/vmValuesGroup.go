@@ -8,30 +8,39 @@ import (
 	"sync"
 
 	"github.com/gin-gonic/gin"
-	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	apiData "github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
 const (
-	hexPath    = "/hex"
-	stringPath = "/string"
-	intPath    = "/int"
-	queryPath  = "/query"
+	hexPath           = "/hex"
+	stringPath        = "/string"
+	intPath           = "/int"
+	queryPath         = "/query"
+	queryMultiplePath = "/query-multiple"
+
+	// maxNumQueriesPerRequest limits the number of queries accepted by a single query-multiple request
+	maxNumQueriesPerRequest = 100
 )
 
 // vmValuesFacadeHandler defines the methods to be implemented by a facade for vm-values requests
 type vmValuesFacadeHandler interface {
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error)
+	ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultApi, apiData.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
 
+type addressPubkeyDecoder interface {
+	DecodeAddressPubkey(pk string) ([]byte, error)
+}
+
 type vmValuesGroup struct {
 	*baseGroup
 	facade    vmValuesFacadeHandler
@@ -54,21 +63,56 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 			Path:    hexPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getHex,
+			Schema: &shared.EndpointSchema{
+				Summary:         "execute a smart contract view function and get the first returned value as hex",
+				QueryParameters: blockCoordinatesParameters,
+				Request:         VMValueRequest{},
+				Response:        gin.H{"data": "", "blockInfo": apiData.BlockInfo{}},
+			},
 		},
 		{
 			Path:    stringPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getString,
+			Schema: &shared.EndpointSchema{
+				Summary:         "execute a smart contract view function and get the first returned value as string",
+				QueryParameters: blockCoordinatesParameters,
+				Request:         VMValueRequest{},
+				Response:        gin.H{"data": "", "blockInfo": apiData.BlockInfo{}},
+			},
 		},
 		{
 			Path:    intPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getInt,
+			Schema: &shared.EndpointSchema{
+				Summary:         "execute a smart contract view function and get the first returned value as a base 10 integer string",
+				QueryParameters: blockCoordinatesParameters,
+				Request:         VMValueRequest{},
+				Response:        gin.H{"data": "", "blockInfo": apiData.BlockInfo{}},
+			},
 		},
 		{
 			Path:    queryPath,
 			Method:  http.MethodPost,
 			Handler: vvg.executeQuery,
+			Schema: &shared.EndpointSchema{
+				Summary:         "execute a smart contract view function and get its whole output",
+				QueryParameters: blockCoordinatesParameters,
+				Request:         VMValueRequest{},
+				Response:        gin.H{"data": vm.VMOutputApi{}, "blockInfo": apiData.BlockInfo{}},
+			},
+		},
+		{
+			Path:    queryMultiplePath,
+			Method:  http.MethodPost,
+			Handler: vvg.executeMultipleQueries,
+			Schema: &shared.EndpointSchema{
+				Summary:         "execute multiple smart contract view functions against the same block",
+				QueryParameters: blockCoordinatesParameters,
+				Request:         VMValuesMultipleRequest{},
+				Response:        gin.H{"results": []*common.SCQueryResultApi{}, "blockInfo": apiData.BlockInfo{}},
+			},
 		},
 	}
 	vvg.endpoints = endpoints
@@ -87,6 +131,11 @@ type VMValueRequest struct {
 	ShouldBeSynced bool     `json:"shouldBeSynced"`
 }
 
+// VMValuesMultipleRequest represents the structure of a request holding several queries to be executed against the same block
+type VMValuesMultipleRequest struct {
+	Queries []VMValueRequest `json:"queries"`
+}
+
 // getHex returns the data as bytes, hex-encoded
 func (vvg *vmValuesGroup) getHex(context *gin.Context) {
 	vvg.doGetVMValue(context, vm.AsHex)
@@ -129,6 +178,56 @@ func (vvg *vmValuesGroup) executeQuery(context *gin.Context) {
 	vvg.returnOkResponse(context, vmOutput, execErrMsg, blockInfo)
 }
 
+// executeMultipleQueries executes all the provided queries against the same block and returns the result of each query
+func (vvg *vmValuesGroup) executeMultipleQueries(context *gin.Context) {
+	request := VMValuesMultipleRequest{}
+	err := context.ShouldBindJSON(&request)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeMultipleQueries", errors.ErrInvalidJSONRequest)
+		return
+	}
+
+	numQueries := len(request.Queries)
+	if numQueries == 0 || numQueries > maxNumQueriesPerRequest {
+		vvg.returnBadRequest(context, "executeMultipleQueries", fmt.Errorf("%w, provided %d, maximum allowed %d",
+			errors.ErrInvalidNumberOfVMQueries, numQueries, maxNumQueriesPerRequest))
+		return
+	}
+
+	options, err := extractVMQueriesBlockCoordinates(context)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeMultipleQueries", err)
+		return
+	}
+
+	commands := make([]*process.SCQuery, 0, numQueries)
+	for i := range request.Queries {
+		command, errCreate := createSCQuery(vvg.getFacade(), &request.Queries[i])
+		if errCreate != nil {
+			vvg.returnBadRequest(context, "executeMultipleQueries", fmt.Errorf("query %d: %w", i, errCreate))
+			return
+		}
+
+		applyVMQueriesBlockCoordinates(command, options)
+		commands = append(commands, command)
+	}
+
+	results, blockInfo, err := vvg.getFacade().ExecuteSCQueries(commands)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeMultipleQueries", err)
+		return
+	}
+
+	context.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"results": results, "blockInfo": blockInfo},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, string, apiData.BlockInfo, error) {
 	request := VMValueRequest{}
 	err := context.ShouldBindJSON(&request)
@@ -136,16 +235,18 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 		return nil, "", apiData.BlockInfo{}, errors.ErrInvalidJSONRequest
 	}
 
-	command, err := vvg.createSCQuery(&request)
+	command, err := createSCQuery(vvg.getFacade(), &request)
 	if err != nil {
 		return nil, "", apiData.BlockInfo{}, err
 	}
 
-	command.BlockNonce, command.BlockHash, err = extractBlockCoordinates(context)
+	options, err := extractVMQueriesBlockCoordinates(context)
 	if err != nil {
 		return nil, "", apiData.BlockInfo{}, err
 	}
 
+	applyVMQueriesBlockCoordinates(command, options)
+
 	vmOutputApi, blockInfo, err := vvg.getFacade().ExecuteSCQuery(command)
 	if err != nil {
 		return nil, "", apiData.BlockInfo{}, err
@@ -159,22 +260,29 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 	return vmOutputApi, vmExecErrMsg, blockInfo, nil
 }
 
-func extractBlockCoordinates(context *gin.Context) (core.OptionalUint64, []byte, error) {
-	blockNonce, err := parseUint64UrlParam(context, urlParamBlockNonce)
+func extractVMQueriesBlockCoordinates(context *gin.Context) (apiData.AccountQueryOptions, error) {
+	options, err := extractAccountQueryOptions(context)
 	if err != nil {
-		return core.OptionalUint64{}, nil, fmt.Errorf("%w for block nonce", err)
+		return apiData.AccountQueryOptions{}, err
 	}
 
-	blockHash, err := parseHexBytesUrlParam(context, urlParamBlockHash)
-	if err != nil {
-		return core.OptionalUint64{}, nil, fmt.Errorf("%w for block hash", err)
+	if options.OnStartOfEpoch.HasValue {
+		return apiData.AccountQueryOptions{}, fmt.Errorf("%w: onStartOfEpoch is not supported for vm queries", errors.ErrBadUrlParams)
 	}
 
-	return blockNonce, blockHash, nil
+	return options, nil
+}
+
+func applyVMQueriesBlockCoordinates(command *process.SCQuery, options apiData.AccountQueryOptions) {
+	command.OnFinalBlock = options.OnFinalBlock
+	command.BlockNonce = options.BlockNonce
+	command.BlockHash = options.BlockHash
+	command.BlockRootHash = options.BlockRootHash
+	command.HintEpoch = options.HintEpoch
 }
 
-func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
-	decodedAddress, err := vvg.getFacade().DecodeAddressPubkey(request.ScAddress)
+func createSCQuery(decoder addressPubkeyDecoder, request *VMValueRequest) (*process.SCQuery, error) {
+	decodedAddress, err := decoder.DecodeAddressPubkey(request.ScAddress)
 	if err != nil {
 		return nil, fmt.Errorf("'%s' is not a valid address: %s", request.ScAddress, err.Error())
 	}
@@ -199,7 +307,7 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 	}
 
 	if len(request.CallerAddr) > 0 {
-		callerAddress, errDecodeCaller := vvg.getFacade().DecodeAddressPubkey(request.CallerAddr)
+		callerAddress, errDecodeCaller := decoder.DecodeAddressPubkey(request.CallerAddr)
 		if errDecodeCaller != nil {
 			return nil, errDecodeCaller
 		}
@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -146,6 +147,8 @@ func TestQuery(t *testing.T) {
 
 	t.Run("invalid block nonce should error", testQueryShouldError("/vm-values/query?blockNonce=invalid_nonce"))
 	t.Run("invalid block hash should error", testQueryShouldError("/vm-values/query?blockHash=invalid_nonce"))
+	t.Run("on start of epoch should error", testQueryShouldError("/vm-values/query?onStartOfEpoch=1"))
+	t.Run("more block coordinates should error", testQueryShouldError("/vm-values/query?onFinalBlock=true&blockNonce=1"))
 	t.Run("should work - block nonce", func(t *testing.T) {
 		t.Parallel()
 
@@ -179,6 +182,35 @@ func TestQuery(t *testing.T) {
 		url := fmt.Sprintf("/vm-values/query?blockHash=%s", hex.EncodeToString(providedBlockHash))
 		testQueryShouldWork(t, url, &facade)
 	})
+	t.Run("should work - block root hash", func(t *testing.T) {
+		t.Parallel()
+
+		providedRootHash := []byte("provided root hash")
+		facade := mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+				require.Equal(t, providedRootHash, query.BlockRootHash)
+				require.Equal(t, core.OptionalUint32{Value: 3, HasValue: true}, query.HintEpoch)
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{big.NewInt(42).Bytes()},
+				}, api.BlockInfo{}, nil
+			},
+		}
+		url := fmt.Sprintf("/vm-values/query?blockRootHash=%s&hintEpoch=3", hex.EncodeToString(providedRootHash))
+		testQueryShouldWork(t, url, &facade)
+	})
+	t.Run("should work - on final block", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+				require.True(t, query.OnFinalBlock)
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{big.NewInt(42).Bytes()},
+				}, api.BlockInfo{}, nil
+			},
+		}
+		testQueryShouldWork(t, "/vm-values/query?onFinalBlock=true", &facade)
+	})
 	t.Run("should work - no block coordinates", func(t *testing.T) {
 		t.Parallel()
 
@@ -211,6 +243,140 @@ func TestQuery(t *testing.T) {
 	})
 }
 
+type queryMultipleResponse struct {
+	Results   []*common.SCQueryResultApi `json:"results"`
+	BlockInfo api.BlockInfo              `json:"blockInfo"`
+	Error     string                     `json:"error"`
+}
+
+func createQueryMultipleRequest(numQueries int) groups.VMValuesMultipleRequest {
+	request := groups.VMValuesMultipleRequest{
+		Queries: make([]groups.VMValueRequest, 0, numQueries),
+	}
+	for i := 0; i < numQueries; i++ {
+		request.Queries = append(request.Queries, groups.VMValueRequest{
+			ScAddress: dummyScAddress,
+			FuncName:  fmt.Sprintf("function%d", i),
+			Args:      []string{hex.EncodeToString([]byte{byte(i)})},
+		})
+	}
+
+	return request
+}
+
+func TestQueryMultiple(t *testing.T) {
+	t.Parallel()
+
+	facadeShouldNotBeCalled := func(t *testing.T) *mock.FacadeStub {
+		return &mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error) {
+				require.Fail(t, "should have not been called")
+				return nil, api.BlockInfo{}, nil
+			},
+		}
+	}
+	testQueryMultipleShouldError := func(url string, request interface{}, expectedErrMessage string) func(t *testing.T) {
+		return func(t *testing.T) {
+			t.Parallel()
+
+			response := simpleResponse{}
+			statusCode := doPost(t, facadeShouldNotBeCalled(t), url, request, &response)
+			require.Equal(t, http.StatusBadRequest, statusCode)
+			require.Contains(t, response.Error, expectedErrMessage)
+		}
+	}
+
+	t.Run("invalid json should error", testQueryMultipleShouldError("/vm-values/query-multiple",
+		[]byte("dummy"), apiErrors.ErrInvalidJSONRequest.Error()))
+	t.Run("no queries should error", testQueryMultipleShouldError("/vm-values/query-multiple",
+		createQueryMultipleRequest(0), apiErrors.ErrInvalidNumberOfVMQueries.Error()))
+	t.Run("too many queries should error", testQueryMultipleShouldError("/vm-values/query-multiple",
+		createQueryMultipleRequest(101), apiErrors.ErrInvalidNumberOfVMQueries.Error()))
+	t.Run("invalid block nonce should error", testQueryMultipleShouldError("/vm-values/query-multiple?blockNonce=invalid_nonce",
+		createQueryMultipleRequest(2), apiErrors.ErrBadUrlParams.Error()))
+	t.Run("more block coordinates should error", testQueryMultipleShouldError("/vm-values/query-multiple?blockNonce=1&blockHash=aa",
+		createQueryMultipleRequest(2), "only one block coordinate"))
+	t.Run("hint epoch without block root hash should error", testQueryMultipleShouldError("/vm-values/query-multiple?blockNonce=1&hintEpoch=2",
+		createQueryMultipleRequest(2), "hintEpoch is optional, but only compatible with blockRootHash"))
+	t.Run("on start of epoch should error", testQueryMultipleShouldError("/vm-values/query-multiple?onStartOfEpoch=1",
+		createQueryMultipleRequest(2), "onStartOfEpoch is not supported"))
+	invalidQueryRequest := createQueryMultipleRequest(3)
+	invalidQueryRequest.Queries[2].CallValue = "not an int"
+	t.Run("invalid query should error", testQueryMultipleShouldError("/vm-values/query-multiple",
+		invalidQueryRequest, "query 2: non numeric call value"))
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error) {
+				return nil, api.BlockInfo{}, expectedErr
+			},
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, facade, "/vm-values/query-multiple", createQueryMultipleRequest(2), &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work - block nonce", func(t *testing.T) {
+		t.Parallel()
+
+		providedBlockNonce := core.OptionalUint64{Value: 123, HasValue: true}
+		facade := &mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error) {
+				require.Len(t, queries, 2)
+				for _, query := range queries {
+					require.Equal(t, providedBlockNonce, query.BlockNonce)
+					require.Empty(t, query.BlockHash)
+					require.Empty(t, query.BlockRootHash)
+				}
+
+				return []*common.SCQueryResultApi{
+					{Data: &vm.VMOutputApi{ReturnData: [][]byte{big.NewInt(42).Bytes()}}},
+					{Error: expectedErr.Error()},
+				}, api.BlockInfo{Nonce: 123}, nil
+			},
+		}
+
+		response := queryMultipleResponse{}
+		url := fmt.Sprintf("/vm-values/query-multiple?blockNonce=%d", providedBlockNonce.Value)
+		statusCode := doPost(t, facade, url, createQueryMultipleRequest(2), &response)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Len(t, response.Results, 2)
+		require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Results[0].Data.ReturnData[0]).Int64())
+		require.Empty(t, response.Results[0].Error)
+		require.Nil(t, response.Results[1].Data)
+		require.Equal(t, expectedErr.Error(), response.Results[1].Error)
+		require.Equal(t, uint64(123), response.BlockInfo.Nonce)
+	})
+	t.Run("should work - block root hash", func(t *testing.T) {
+		t.Parallel()
+
+		providedRootHash := []byte("root hash")
+		facade := &mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error) {
+				require.Len(t, queries, 3)
+				for i, query := range queries {
+					require.Equal(t, fmt.Sprintf("function%d", i), query.FuncName)
+					require.Equal(t, [][]byte{{byte(i)}}, query.Arguments)
+					require.Equal(t, providedRootHash, query.BlockRootHash)
+					require.Equal(t, core.OptionalUint32{Value: 7, HasValue: true}, query.HintEpoch)
+					require.False(t, query.BlockNonce.HasValue)
+				}
+
+				return make([]*common.SCQueryResultApi, len(queries)), api.BlockInfo{RootHash: hex.EncodeToString(providedRootHash)}, nil
+			},
+		}
+
+		response := queryMultipleResponse{}
+		url := fmt.Sprintf("/vm-values/query-multiple?blockRootHash=%s&hintEpoch=7", hex.EncodeToString(providedRootHash))
+		statusCode := doPost(t, facade, url, createQueryMultipleRequest(3), &response)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Len(t, response.Results, 3)
+		require.Equal(t, hex.EncodeToString(providedRootHash), response.BlockInfo.RootHash)
+	})
+}
+
 func testQueryShouldWork(t *testing.T, url string, facade shared.FacadeHandler) {
 	request := groups.VMValueRequest{
 		ScAddress: dummyScAddress,
@@ -581,6 +747,7 @@ func getVmValuesRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/string", Open: true},
 					{Name: "/int", Open: true},
 					{Name: "/query", Open: true},
+					{Name: "/query-multiple", Open: true},
 				},
 			},
 		},
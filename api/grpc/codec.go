@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"fmt"
+)
+
+const codecName = "proto"
+
+// gogoCodec marshals the messages using the methods generated by gogo protobuf, avoiding the reflection
+// based marshalling of the default gRPC codec
+type gogoCodec struct {
+}
+
+// Marshal returns the wire format of the provided message
+func (codec *gogoCodec) Marshal(v interface{}) ([]byte, error) {
+	message, ok := v.(gogoMessage)
+	if !ok {
+		return nil, fmt.Errorf("%w, type %T", errNotGogoMessage, v)
+	}
+
+	return message.Marshal()
+}
+
+// Unmarshal parses the wire format into the provided message
+func (codec *gogoCodec) Unmarshal(data []byte, v interface{}) error {
+	message, ok := v.(gogoMessage)
+	if !ok {
+		return fmt.Errorf("%w, type %T", errNotGogoMessage, v)
+	}
+
+	return message.Unmarshal(data)
+}
+
+// Name returns the name of the codec, used as the content-subtype of the requests
+func (codec *gogoCodec) Name() string {
+	return codecName
+}
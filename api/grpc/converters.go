@@ -0,0 +1,319 @@
+package grpc
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+func convertAccountQueryOptions(options *AccountQueryOptions) api.AccountQueryOptions {
+	if options == nil {
+		return api.AccountQueryOptions{}
+	}
+
+	return api.AccountQueryOptions{
+		OnFinalBlock:   options.OnFinalBlock,
+		OnStartOfEpoch: core.OptionalUint32{Value: options.OnStartOfEpoch, HasValue: options.HasOnStartOfEpoch},
+		BlockNonce:     core.OptionalUint64{Value: options.BlockNonce, HasValue: options.HasBlockNonce},
+		BlockHash:      options.BlockHash,
+		BlockRootHash:  options.BlockRootHash,
+		HintEpoch:      core.OptionalUint32{Value: options.HintEpoch, HasValue: options.HasHintEpoch},
+		WithKeys:       options.WithKeys,
+	}
+}
+
+func convertBlockQueryOptions(options *BlockQueryOptions) api.BlockQueryOptions {
+	if options == nil {
+		return api.BlockQueryOptions{}
+	}
+
+	return api.BlockQueryOptions{
+		WithTransactions: options.WithTransactions,
+		WithLogs:         options.WithLogs,
+	}
+}
+
+func convertBlockInfo(blockInfo api.BlockInfo) *BlockInfo {
+	return &BlockInfo{
+		Nonce:    blockInfo.Nonce,
+		Hash:     blockInfo.Hash,
+		RootHash: blockInfo.RootHash,
+	}
+}
+
+func convertAccount(account api.AccountResponse) *Account {
+	return &Account{
+		Address:         account.Address,
+		Nonce:           account.Nonce,
+		Balance:         account.Balance,
+		Username:        account.Username,
+		Code:            account.Code,
+		CodeHash:        account.CodeHash,
+		RootHash:        account.RootHash,
+		CodeMetadata:    account.CodeMetadata,
+		DeveloperReward: account.DeveloperReward,
+		OwnerAddress:    account.OwnerAddress,
+	}
+}
+
+func convertESDTToken(tokenIdentifier string, esdtData *esdt.ESDigitalToken) *ESDTToken {
+	token := &ESDTToken{
+		TokenIdentifier: tokenIdentifier,
+		Type:            esdtData.Type,
+		Balance:         bigIntToString(esdtData.Value),
+		Properties:      esdtData.Properties,
+	}
+
+	if esdtData.TokenMetaData != nil {
+		token.Nonce = esdtData.TokenMetaData.Nonce
+		token.Name = esdtData.TokenMetaData.Name
+		token.Creator = string(esdtData.TokenMetaData.Creator)
+		token.Royalties = esdtData.TokenMetaData.Royalties
+		token.Hash = esdtData.TokenMetaData.Hash
+		token.URIs = esdtData.TokenMetaData.URIs
+		token.Attributes = esdtData.TokenMetaData.Attributes
+	}
+
+	return token
+}
+
+func convertESDTTokens(tokens map[string]*esdt.ESDigitalToken) []*ESDTToken {
+	tokenIdentifiers := make([]string, 0, len(tokens))
+	for tokenIdentifier := range tokens {
+		tokenIdentifiers = append(tokenIdentifiers, tokenIdentifier)
+	}
+	sort.Strings(tokenIdentifiers)
+
+	result := make([]*ESDTToken, 0, len(tokens))
+	for _, tokenIdentifier := range tokenIdentifiers {
+		result = append(result, convertESDTToken(tokenIdentifier, tokens[tokenIdentifier]))
+	}
+
+	return result
+}
+
+func convertKeyValuePairs(pairs map[string]string) []*KeyValuePair {
+	keys := make([]string, 0, len(pairs))
+	for key := range pairs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make([]*KeyValuePair, 0, len(pairs))
+	for _, key := range keys {
+		result = append(result, &KeyValuePair{
+			Key:   key,
+			Value: pairs[key],
+		})
+	}
+
+	return result
+}
+
+func convertBlock(block *api.Block) *Block {
+	result := &Block{
+		Nonce:           block.Nonce,
+		Round:           block.Round,
+		Epoch:           block.Epoch,
+		Shard:           block.Shard,
+		NumTxs:          block.NumTxs,
+		Hash:            block.Hash,
+		PrevBlockHash:   block.PrevBlockHash,
+		StateRootHash:   block.StateRootHash,
+		AccumulatedFees: block.AccumulatedFees,
+		DeveloperFees:   block.DeveloperFees,
+		Status:          block.Status,
+		Timestamp:       block.Timestamp,
+		TimestampMs:     block.TimestampMs,
+		MiniBlocks:      make([]*MiniBlock, 0, len(block.MiniBlocks)),
+	}
+
+	for _, miniBlock := range block.MiniBlocks {
+		result.MiniBlocks = append(result.MiniBlocks, convertMiniBlock(miniBlock))
+	}
+
+	return result
+}
+
+func convertMiniBlock(miniBlock *api.MiniBlock) *MiniBlock {
+	result := &MiniBlock{
+		Hash:              miniBlock.Hash,
+		Type:              miniBlock.Type,
+		ProcessingType:    miniBlock.ProcessingType,
+		ConstructionState: miniBlock.ConstructionState,
+		SourceShard:       miniBlock.SourceShard,
+		DestinationShard:  miniBlock.DestinationShard,
+		Transactions:      make([]*TransactionResult, 0, len(miniBlock.Transactions)),
+	}
+
+	for _, tx := range miniBlock.Transactions {
+		result.Transactions = append(result.Transactions, &TransactionResult{
+			Hash:      tx.Hash,
+			Type:      tx.Type,
+			Nonce:     tx.Nonce,
+			Value:     tx.Value,
+			Receiver:  tx.Receiver,
+			Sender:    tx.Sender,
+			GasPrice:  tx.GasPrice,
+			GasLimit:  tx.GasLimit,
+			Data:      tx.Data,
+			Signature: tx.Signature,
+			Status:    string(tx.Status),
+		})
+	}
+
+	return result
+}
+
+func newArgsCreateTransaction(tx *Transaction) *external.ArgsCreateTransaction {
+	return &external.ArgsCreateTransaction{
+		Nonce:               tx.Nonce,
+		Value:               tx.Value,
+		Receiver:            tx.Receiver,
+		ReceiverUsername:    tx.ReceiverUsername,
+		Sender:              tx.Sender,
+		SenderUsername:      tx.SenderUsername,
+		GasPrice:            tx.GasPrice,
+		GasLimit:            tx.GasLimit,
+		DataField:           tx.Data,
+		SignatureHex:        tx.Signature,
+		ChainID:             tx.ChainID,
+		Version:             tx.Version,
+		Options:             tx.Options,
+		Guardian:            tx.GuardianAddr,
+		GuardianSigHex:      tx.GuardianSignature,
+		Relayer:             tx.RelayerAddr,
+		RelayerSignatureHex: tx.RelayerSignature,
+	}
+}
+
+func convertSmartContractResults(results map[string]*transaction.ApiSmartContractResult) []*SmartContractResult {
+	hashes := make([]string, 0, len(results))
+	for hash := range results {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	converted := make([]*SmartContractResult, 0, len(results))
+	for _, hash := range hashes {
+		scr := results[hash]
+		converted = append(converted, &SmartContractResult{
+			Hash:          hash,
+			Nonce:         scr.Nonce,
+			Value:         bigIntToString(scr.Value),
+			Receiver:      scr.RcvAddr,
+			Sender:        scr.SndAddr,
+			Data:          []byte(scr.Data),
+			GasLimit:      scr.GasLimit,
+			GasPrice:      scr.GasPrice,
+			ReturnMessage: scr.ReturnMessage,
+		})
+	}
+
+	return converted
+}
+
+func convertLogs(logs *transaction.ApiLogs) *Logs {
+	if logs == nil {
+		return nil
+	}
+
+	result := &Logs{
+		Address: logs.Address,
+		Events:  make([]*Event, 0, len(logs.Events)),
+	}
+	for _, event := range logs.Events {
+		result.Events = append(result.Events, &Event{
+			Address:    event.Address,
+			Identifier: event.Identifier,
+			Topics:     event.Topics,
+			Data:       event.Data,
+		})
+	}
+
+	return result
+}
+
+func createSCQuery(decoder addressDecoder, request *VMQueryRequest) (*process.SCQuery, error) {
+	scAddress, err := decoder.DecodeAddressPubkey(request.ScAddress)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid address: %w", request.ScAddress, err)
+	}
+
+	query := &process.SCQuery{
+		ScAddress:      scAddress,
+		FuncName:       request.FuncName,
+		Arguments:      request.Args,
+		SameScState:    request.SameScState,
+		ShouldBeSynced: request.ShouldBeSynced,
+		BlockNonce:     core.OptionalUint64{Value: request.BlockNonce, HasValue: request.HasBlockNonce},
+		BlockHash:      request.BlockHash,
+	}
+
+	if len(request.CallerAddr) > 0 {
+		query.CallerAddr, err = decoder.DecodeAddressPubkey(request.CallerAddr)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid address: %w", request.CallerAddr, err)
+		}
+	}
+
+	if len(request.CallValue) > 0 {
+		callValue, ok := big.NewInt(0).SetString(request.CallValue, 10)
+		if !ok {
+			return nil, fmt.Errorf("non numeric call value provided: %s", request.CallValue)
+		}
+		query.CallValue = callValue
+	}
+
+	return query, nil
+}
+
+func convertVMOutput(vmOutput *vm.VMOutputApi, blockInfo api.BlockInfo) *VMQueryResponse {
+	return &VMQueryResponse{
+		ReturnData:    vmOutput.ReturnData,
+		ReturnCode:    vmOutput.ReturnCode,
+		ReturnMessage: vmOutput.ReturnMessage,
+		GasRemaining:  vmOutput.GasRemaining,
+		GasRefund:     bigIntToString(vmOutput.GasRefund),
+		BlockInfo:     convertBlockInfo(blockInfo),
+	}
+}
+
+func convertProof(proof *common.GetProofResponse) *ProofResponse {
+	if proof == nil {
+		return nil
+	}
+
+	return &ProofResponse{
+		Proof:    proof.Proof,
+		Value:    proof.Value,
+		RootHash: proof.RootHash,
+	}
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+func hashesToHex(hashes [][]byte) []string {
+	result := make([]string, 0, len(hashes))
+	for _, hash := range hashes {
+		result = append(result, hex.EncodeToString(hash))
+	}
+
+	return result
+}
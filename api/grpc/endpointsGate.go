@@ -0,0 +1,164 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const nodeAPIMethodPrefix = "/nodeapi.NodeAPI/"
+
+// restEndpoint holds the REST route whose api.toml enablement and endpoint throttler also apply to a gRPC method
+type restEndpoint struct {
+	apiPackage string
+	route      string
+	throttler  string
+}
+
+var (
+	getAccountEndpoint          = restEndpoint{apiPackage: "address", route: "/:address"}
+	getESDTBalanceEndpoint      = restEndpoint{apiPackage: "address", route: "/:address/esdt/:tokenIdentifier"}
+	getESDTNFTDataEndpoint      = restEndpoint{apiPackage: "address", route: "/:address/nft/:tokenIdentifier/nonce/:nonce"}
+	getAllESDTTokensEndpoint    = restEndpoint{apiPackage: "address", route: "/:address/esdt"}
+	getKeyValuePairsEndpoint    = restEndpoint{apiPackage: "address", route: "/:address/keys"}
+	iterateKeysEndpoint         = restEndpoint{apiPackage: "address", route: "/iterate-keys"}
+	getBlockByHashEndpoint      = restEndpoint{apiPackage: "block", route: "/by-hash/:hash"}
+	getBlockByNonceEndpoint     = restEndpoint{apiPackage: "block", route: "/by-nonce/:nonce"}
+	getBlockByRoundEndpoint     = restEndpoint{apiPackage: "block", route: "/by-round/:round"}
+	sendTransactionsEndpoint    = restEndpoint{apiPackage: "transaction", route: "/send-multiple", throttler: "/transaction/send-multiple"}
+	simulateTransactionEndpoint = restEndpoint{apiPackage: "transaction", route: "/simulate", throttler: "/transaction/simulate"}
+	computeTxCostEndpoint       = restEndpoint{apiPackage: "transaction", route: "/cost"}
+	executeVMQueryEndpoint      = restEndpoint{apiPackage: "vm-values", route: "/query"}
+	getProofEndpoint            = restEndpoint{apiPackage: "proof", route: "/root-hash/:roothash/address/:address", throttler: "/proof/root-hash/:roothash/address/:address"}
+	getProofCurrentRootEndpoint = restEndpoint{apiPackage: "proof", route: "/address/:address", throttler: "/proof/address/:address"}
+	getProofDataTrieEndpoint    = restEndpoint{apiPackage: "proof", route: "/root-hash/:roothash/address/:address/key/:key", throttler: "/proof/root-hash/:roothash/address/:address/key/:key"}
+	verifyProofEndpoint         = restEndpoint{apiPackage: "proof", route: "/verify", throttler: "/proof/verify"}
+)
+
+// methodsEndpoints maps each gRPC method to its REST endpoint. The methods serving several REST endpoints are
+// resolved on the received request by resolveEndpoint
+var methodsEndpoints = map[string]restEndpoint{
+	"GetAccount":             getAccountEndpoint,
+	"GetESDTData":            getESDTBalanceEndpoint,
+	"GetAllESDTTokens":       getAllESDTTokensEndpoint,
+	"GetKeyValuePairs":       getKeyValuePairsEndpoint,
+	"IterateKeys":            iterateKeysEndpoint,
+	"GetBlockByHash":         getBlockByHashEndpoint,
+	"GetBlockByNonce":        getBlockByNonceEndpoint,
+	"GetBlockByRound":        getBlockByRoundEndpoint,
+	"StreamBlocks":           getBlockByNonceEndpoint,
+	"SendTransactions":       sendTransactionsEndpoint,
+	"SimulateTransaction":    simulateTransactionEndpoint,
+	"ComputeTransactionCost": computeTxCostEndpoint,
+	"ExecuteVMQuery":         executeVMQueryEndpoint,
+	"GetProof":               getProofEndpoint,
+	"GetProofDataTrie":       getProofDataTrieEndpoint,
+	"VerifyProof":            verifyProofEndpoint,
+}
+
+// endpointsGate applies the REST routes enablement from api.toml and the REST endpoint throttlers to the gRPC methods
+type endpointsGate struct {
+	apiPackages map[string]config.APIPackageConfig
+	getFacade   func() FacadeHandler
+}
+
+func newEndpointsGate(apiPackages map[string]config.APIPackageConfig, getFacade func() FacadeHandler) *endpointsGate {
+	return &endpointsGate{
+		apiPackages: apiPackages,
+		getFacade:   getFacade,
+	}
+}
+
+func (gate *endpointsGate) unaryInterceptor(
+	ctx context.Context,
+	request interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	endProcessing, err := gate.startProcessing(info.FullMethod, request)
+	if err != nil {
+		return nil, err
+	}
+	defer endProcessing()
+
+	return handler(ctx, request)
+}
+
+func (gate *endpointsGate) streamInterceptor(
+	server interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	endProcessing, err := gate.startProcessing(info.FullMethod, nil)
+	if err != nil {
+		return err
+	}
+	defer endProcessing()
+
+	return handler(server, stream)
+}
+
+// startProcessing checks that the REST route of the method is open and that its endpoint throttler allows one more
+// request, returning the function to be called once the request is processed
+func (gate *endpointsGate) startProcessing(fullMethod string, request interface{}) (func(), error) {
+	endpoint, ok := resolveEndpoint(fullMethod, request)
+	if !ok || !gate.isRouteOpen(endpoint) {
+		return nil, status.Error(codes.Unimplemented, fmt.Sprintf("%s: %s", errEndpointClosed.Error(), fullMethod))
+	}
+	if len(endpoint.throttler) == 0 {
+		return func() {}, nil
+	}
+
+	endpointThrottler, found := gate.getFacade().GetThrottlerForEndpoint(endpoint.throttler)
+	if !found {
+		return func() {}, nil
+	}
+	if !endpointThrottler.CanProcess() {
+		return nil, status.Error(codes.ResourceExhausted, fmt.Sprintf("%s for endpoint %s", errTooManyRequests.Error(), endpoint.throttler))
+	}
+
+	endpointThrottler.StartProcessing()
+
+	return endpointThrottler.EndProcessing, nil
+}
+
+func (gate *endpointsGate) isRouteOpen(endpoint restEndpoint) bool {
+	group, ok := gate.apiPackages[endpoint.apiPackage]
+	if !ok {
+		return false
+	}
+
+	for _, route := range group.Routes {
+		if route.Name == endpoint.route {
+			return route.Open
+		}
+	}
+
+	return false
+}
+
+func resolveEndpoint(fullMethod string, request interface{}) (restEndpoint, bool) {
+	if len(fullMethod) <= len(nodeAPIMethodPrefix) || fullMethod[:len(nodeAPIMethodPrefix)] != nodeAPIMethodPrefix {
+		return restEndpoint{}, false
+	}
+
+	switch typedRequest := request.(type) {
+	case *ESDTDataRequest:
+		if typedRequest.Nonce > 0 {
+			return getESDTNFTDataEndpoint, true
+		}
+	case *ProofRequest:
+		if len(typedRequest.RootHash) == 0 {
+			return getProofCurrentRootEndpoint, true
+		}
+	}
+
+	endpoint, ok := methodsEndpoints[fullMethod[len(nodeAPIMethodPrefix):]]
+
+	return endpoint, ok
+}
@@ -0,0 +1,145 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func createTestAPIPackages() map[string]config.APIPackageConfig {
+	return map[string]config.APIPackageConfig{
+		"address": {Routes: []config.RouteConfig{
+			{Name: "/:address", Open: true},
+			{Name: "/:address/esdt/:tokenIdentifier", Open: true},
+			{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: false},
+			{Name: "/:address/keys", Open: false},
+		}},
+		"transaction": {Routes: []config.RouteConfig{
+			{Name: "/send-multiple", Open: true},
+		}},
+		"proof": {Routes: []config.RouteConfig{
+			{Name: "/root-hash/:roothash/address/:address", Open: true},
+			{Name: "/address/:address", Open: true},
+		}},
+	}
+}
+
+func createGateWithThrottler(throttlerEndpoint *string, throttler core.Throttler) *endpointsGate {
+	facade := &mock.FacadeStub{
+		GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+			*throttlerEndpoint = endpoint
+			return throttler, true
+		},
+	}
+
+	return newEndpointsGate(createTestAPIPackages(), func() FacadeHandler {
+		return facade
+	})
+}
+
+func TestEndpointsGate_UnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	handlerCalled := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerCalled = true
+		return "response", nil
+	}
+
+	t.Run("closed route should not call the handler", func(t *testing.T) {
+		handlerCalled = false
+		gate := newEndpointsGate(createTestAPIPackages(), nil)
+
+		response, err := gate.unaryInterceptor(context.Background(), &ESDTDataRequest{Nonce: 1}, &grpc.UnaryServerInfo{FullMethod: nodeAPIMethodPrefix + "GetESDTData"}, handler)
+		require.Nil(t, response)
+		requireStatusCode(t, err, codes.Unimplemented)
+		require.False(t, handlerCalled)
+	})
+	t.Run("route missing from the config should not call the handler", func(t *testing.T) {
+		handlerCalled = false
+		gate := newEndpointsGate(createTestAPIPackages(), nil)
+
+		response, err := gate.unaryInterceptor(context.Background(), &VMQueryRequest{}, &grpc.UnaryServerInfo{FullMethod: nodeAPIMethodPrefix + "ExecuteVMQuery"}, handler)
+		require.Nil(t, response)
+		requireStatusCode(t, err, codes.Unimplemented)
+		require.False(t, handlerCalled)
+	})
+	t.Run("unknown method should not call the handler", func(t *testing.T) {
+		handlerCalled = false
+		gate := newEndpointsGate(createTestAPIPackages(), nil)
+
+		response, err := gate.unaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/other.Service/GetAccount"}, handler)
+		require.Nil(t, response)
+		requireStatusCode(t, err, codes.Unimplemented)
+		require.False(t, handlerCalled)
+	})
+	t.Run("open route without throttler should call the handler", func(t *testing.T) {
+		handlerCalled = false
+		gate := newEndpointsGate(createTestAPIPackages(), nil)
+
+		response, err := gate.unaryInterceptor(context.Background(), &ESDTDataRequest{}, &grpc.UnaryServerInfo{FullMethod: nodeAPIMethodPrefix + "GetESDTData"}, handler)
+		require.Nil(t, err)
+		require.Equal(t, "response", response)
+		require.True(t, handlerCalled)
+	})
+	t.Run("busy throttler should not call the handler", func(t *testing.T) {
+		handlerCalled = false
+		throttlerEndpoint := ""
+		throttler := &mock.ThrottlerStub{
+			CanProcessCalled: func() bool {
+				return false
+			},
+		}
+		gate := createGateWithThrottler(&throttlerEndpoint, throttler)
+
+		response, err := gate.unaryInterceptor(context.Background(), &SendTransactionsRequest{}, &grpc.UnaryServerInfo{FullMethod: nodeAPIMethodPrefix + "SendTransactions"}, handler)
+		require.Nil(t, response)
+		requireStatusCode(t, err, codes.ResourceExhausted)
+		require.Equal(t, "/transaction/send-multiple", throttlerEndpoint)
+		require.False(t, handlerCalled)
+		require.False(t, throttler.StartWasCalled)
+	})
+	t.Run("throttler should wrap the handler", func(t *testing.T) {
+		handlerCalled = false
+		throttlerEndpoint := ""
+		throttler := &mock.ThrottlerStub{}
+		gate := createGateWithThrottler(&throttlerEndpoint, throttler)
+
+		response, err := gate.unaryInterceptor(context.Background(), &ProofRequest{}, &grpc.UnaryServerInfo{FullMethod: nodeAPIMethodPrefix + "GetProof"}, handler)
+		require.Nil(t, err)
+		require.Equal(t, "response", response)
+		require.Equal(t, "/proof/address/:address", throttlerEndpoint)
+		require.True(t, handlerCalled)
+		require.True(t, throttler.StartWasCalled)
+		require.True(t, throttler.EndWasCalled)
+
+		_, err = gate.unaryInterceptor(context.Background(), &ProofRequest{RootHash: "root"}, &grpc.UnaryServerInfo{FullMethod: nodeAPIMethodPrefix + "GetProof"}, handler)
+		require.Nil(t, err)
+		require.Equal(t, "/proof/root-hash/:roothash/address/:address", throttlerEndpoint)
+	})
+}
+
+func TestEndpointsGate_StreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	handlerCalled := false
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		handlerCalled = true
+		return nil
+	}
+	gate := newEndpointsGate(createTestAPIPackages(), nil)
+
+	err := gate.streamInterceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: nodeAPIMethodPrefix + "GetKeyValuePairs"}, handler)
+	requireStatusCode(t, err, codes.Unimplemented)
+	require.False(t, handlerCalled)
+
+	err = gate.streamInterceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: nodeAPIMethodPrefix + "StreamBlocks"}, handler)
+	requireStatusCode(t, err, codes.Unimplemented)
+	require.False(t, handlerCalled)
+}
@@ -0,0 +1,28 @@
+package grpc
+
+import "errors"
+
+// ErrNilFacadeHandler signals that a nil facade handler has been provided
+var ErrNilFacadeHandler = errors.New("nil facade handler")
+
+// ErrInvalidInterface signals that an empty interface has been provided for an enabled gRPC server
+var ErrInvalidInterface = errors.New("invalid gRPC server interface")
+
+// ErrInvalidKeyValuePairsBatchSize signals that an invalid key-value pairs batch size has been provided
+var ErrInvalidKeyValuePairsBatchSize = errors.New("invalid key-value pairs batch size")
+
+// ErrInvalidMaxBlocksPerStream signals that an invalid maximum number of blocks per stream has been provided
+var ErrInvalidMaxBlocksPerStream = errors.New("invalid maximum number of blocks per stream")
+
+// ErrServerAlreadyStarted signals that the gRPC server was already started
+var ErrServerAlreadyStarted = errors.New("gRPC server already started")
+
+var errNotGogoMessage = errors.New("message does not implement the gogo protobuf marshalling methods")
+var errEmptyAddress = errors.New("empty address")
+var errEmptyHash = errors.New("empty hash")
+var errNilTransaction = errors.New("nil transaction")
+var errNoTransactions = errors.New("no transactions provided")
+var errInvalidBlockRange = errors.New("invalid block range")
+var errTooManyTransactions = errors.New("too many transactions provided")
+var errEndpointClosed = errors.New("endpoint is not enabled")
+var errTooManyRequests = errors.New("too many requests")
@@ -0,0 +1,178 @@
+package grpc
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"google.golang.org/grpc"
+)
+
+var log = logger.GetOrCreate("api/grpc")
+
+const gracefulStopTimeout = time.Second
+
+// ArgsNewGRPCServer holds the arguments needed to create a new instance of grpcServer
+type ArgsNewGRPCServer struct {
+	Facade      FacadeHandler
+	Config      config.GRPCServerConfig
+	APIPackages map[string]config.APIPackageConfig
+}
+
+type grpcServer struct {
+	mut      sync.Mutex
+	config   config.GRPCServerConfig
+	service  *nodeAPIService
+	gate     *endpointsGate
+	server   *grpc.Server
+	listener net.Listener
+}
+
+// NewGRPCServer returns a new instance of grpcServer
+func NewGRPCServer(args ArgsNewGRPCServer) (*grpcServer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	service := newNodeAPIService(args.Facade, args.Config.KeyValuePairsBatchSize, args.Config.MaxBlocksPerStream)
+
+	return &grpcServer{
+		config:  args.Config,
+		service: service,
+		gate:    newEndpointsGate(args.APIPackages, service.getFacade),
+	}, nil
+}
+
+func checkArgs(args ArgsNewGRPCServer) error {
+	if check.IfNil(args.Facade) {
+		return ErrNilFacadeHandler
+	}
+	if !args.Config.Enabled {
+		return nil
+	}
+	if len(args.Config.Interface) == 0 {
+		return ErrInvalidInterface
+	}
+	if args.Config.KeyValuePairsBatchSize == 0 {
+		return ErrInvalidKeyValuePairsBatchSize
+	}
+	if args.Config.MaxBlocksPerStream == 0 {
+		return ErrInvalidMaxBlocksPerStream
+	}
+
+	return nil
+}
+
+// Start will start listening on the configured interface and serve the gRPC requests on a separate go routine
+func (gs *grpcServer) Start() error {
+	gs.mut.Lock()
+	defer gs.mut.Unlock()
+
+	if !gs.config.Enabled {
+		log.Debug("gRPC server is turned off")
+		return nil
+	}
+	if gs.server != nil {
+		return ErrServerAlreadyStarted
+	}
+
+	listener, err := net.Listen("tcp", gs.config.Interface)
+	if err != nil {
+		return err
+	}
+
+	gs.listener = listener
+	gs.server = grpc.NewServer(gs.createServerOptions()...)
+	RegisterNodeAPIServer(gs.server, gs.service)
+
+	log.Debug("starting gRPC server", "interface", listener.Addr().String())
+	go gs.serve(gs.server, listener)
+
+	return nil
+}
+
+func (gs *grpcServer) createServerOptions() []grpc.ServerOption {
+	options := []grpc.ServerOption{
+		grpc.ForceServerCodec(&gogoCodec{}),
+		grpc.UnaryInterceptor(gs.gate.unaryInterceptor),
+		grpc.StreamInterceptor(gs.gate.streamInterceptor),
+	}
+	if gs.config.MaxConcurrentStreams > 0 {
+		options = append(options, grpc.MaxConcurrentStreams(gs.config.MaxConcurrentStreams))
+	}
+	if gs.config.MaxRecvMsgSizeInBytes > 0 {
+		options = append(options, grpc.MaxRecvMsgSize(gs.config.MaxRecvMsgSizeInBytes))
+	}
+	if gs.config.MaxSendMsgSizeInBytes > 0 {
+		options = append(options, grpc.MaxSendMsgSize(gs.config.MaxSendMsgSizeInBytes))
+	}
+
+	return options
+}
+
+func (gs *grpcServer) serve(server *grpc.Server, listener net.Listener) {
+	err := server.Serve(listener)
+	if err != nil && err != grpc.ErrServerStopped {
+		log.Error("could not start gRPC server", "error", err.Error())
+	}
+}
+
+// Address returns the address the gRPC server listens on, or an empty string if the server is not started
+func (gs *grpcServer) Address() string {
+	gs.mut.Lock()
+	defer gs.mut.Unlock()
+
+	if gs.listener == nil {
+		return ""
+	}
+
+	return gs.listener.Addr().String()
+}
+
+// UpdateFacade updates the facade used to serve the requests
+func (gs *grpcServer) UpdateFacade(facade FacadeHandler) error {
+	if check.IfNil(facade) {
+		return ErrNilFacadeHandler
+	}
+
+	gs.service.setFacade(facade)
+
+	return nil
+}
+
+// Close gracefully stops the gRPC server, forcing the stop if the active requests do not finish in time
+func (gs *grpcServer) Close() error {
+	gs.mut.Lock()
+	defer gs.mut.Unlock()
+
+	if gs.server == nil {
+		return nil
+	}
+
+	chStopped := make(chan struct{})
+	go func(server *grpc.Server) {
+		server.GracefulStop()
+		close(chStopped)
+	}(gs.server)
+
+	select {
+	case <-chStopped:
+	case <-time.After(gracefulStopTimeout):
+		log.Debug("gRPC server did not stop gracefully in time, forcing the stop")
+		gs.server.Stop()
+	}
+
+	gs.server = nil
+	gs.listener = nil
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gs *grpcServer) IsInterfaceNil() bool {
+	return gs == nil
+}
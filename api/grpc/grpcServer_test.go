@@ -0,0 +1,180 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func createMockArgsNewGRPCServer() ArgsNewGRPCServer {
+	return ArgsNewGRPCServer{
+		Facade: &mock.FacadeStub{},
+		Config: config.GRPCServerConfig{
+			Enabled:                true,
+			Interface:              "localhost:0",
+			MaxConcurrentStreams:   10,
+			MaxRecvMsgSizeInBytes:  1024 * 1024,
+			MaxSendMsgSizeInBytes:  1024 * 1024,
+			KeyValuePairsBatchSize: 10,
+			MaxBlocksPerStream:     10,
+		},
+		APIPackages: map[string]config.APIPackageConfig{
+			"address": {Routes: []config.RouteConfig{{Name: "/:address", Open: true}}},
+		},
+	}
+}
+
+func createFacadeReturningNonce(nonce uint64) *mock.FacadeStub {
+	return &mock.FacadeStub{
+		GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+			return api.AccountResponse{Address: address, Nonce: nonce}, api.BlockInfo{}, nil
+		},
+	}
+}
+
+func TestNewGRPCServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewGRPCServer()
+		args.Facade = nil
+		server, err := NewGRPCServer(args)
+		require.Nil(t, server)
+		require.Equal(t, ErrNilFacadeHandler, err)
+	})
+	t.Run("invalid interface should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewGRPCServer()
+		args.Config.Interface = ""
+		server, err := NewGRPCServer(args)
+		require.Nil(t, server)
+		require.Equal(t, ErrInvalidInterface, err)
+	})
+	t.Run("invalid key-value pairs batch size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewGRPCServer()
+		args.Config.KeyValuePairsBatchSize = 0
+		server, err := NewGRPCServer(args)
+		require.Nil(t, server)
+		require.Equal(t, ErrInvalidKeyValuePairsBatchSize, err)
+	})
+	t.Run("invalid max blocks per stream should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewGRPCServer()
+		args.Config.MaxBlocksPerStream = 0
+		server, err := NewGRPCServer(args)
+		require.Nil(t, server)
+		require.Equal(t, ErrInvalidMaxBlocksPerStream, err)
+	})
+	t.Run("disabled server should not check the config", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := NewGRPCServer(ArgsNewGRPCServer{Facade: &mock.FacadeStub{}})
+		require.Nil(t, err)
+		require.False(t, server.IsInterfaceNil())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := NewGRPCServer(createMockArgsNewGRPCServer())
+		require.Nil(t, err)
+		require.False(t, server.IsInterfaceNil())
+	})
+}
+
+func TestGrpcServer_StartDisabledShouldNotListen(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewGRPCServer()
+	args.Config.Enabled = false
+	server, _ := NewGRPCServer(args)
+
+	err := server.Start()
+	require.Nil(t, err)
+	require.Empty(t, server.Address())
+	require.Nil(t, server.Close())
+}
+
+func TestGrpcServer_StartUpdateFacadeAndClose(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewGRPCServer()
+	args.Facade = createFacadeReturningNonce(1)
+	server, _ := NewGRPCServer(args)
+
+	err := server.Start()
+	require.Nil(t, err)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	err = server.Start()
+	require.Equal(t, ErrServerAlreadyStarted, err)
+
+	address := server.Address()
+	require.NotEmpty(t, address)
+
+	conn, err := grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(&gogoCodec{})),
+	)
+	require.Nil(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+	client := NewNodeAPIClient(conn)
+
+	response, err := client.GetAccount(context.Background(), &AccountRequest{Address: "alice"})
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), response.Account.Nonce)
+
+	err = server.UpdateFacade(nil)
+	require.Equal(t, ErrNilFacadeHandler, err)
+
+	err = server.UpdateFacade(createFacadeReturningNonce(2))
+	require.Nil(t, err)
+
+	response, err = client.GetAccount(context.Background(), &AccountRequest{Address: "alice"})
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), response.Account.Nonce)
+
+	err = server.Close()
+	require.Nil(t, err)
+	require.Empty(t, server.Address())
+
+	_, err = client.GetAccount(context.Background(), &AccountRequest{Address: "alice"})
+	require.NotNil(t, err)
+}
+
+func TestGogoCodec(t *testing.T) {
+	t.Parallel()
+
+	codec := &gogoCodec{}
+	require.Equal(t, "proto", codec.Name())
+
+	_, err := codec.Marshal("not a gogo message")
+	require.ErrorIs(t, err, errNotGogoMessage)
+
+	err = codec.Unmarshal([]byte{}, struct{}{})
+	require.ErrorIs(t, err, errNotGogoMessage)
+
+	buff, err := codec.Marshal(&BlockInfo{Nonce: 7, Hash: "hash"})
+	require.Nil(t, err)
+
+	recovered := &BlockInfo{}
+	err = codec.Unmarshal(buff, recovered)
+	require.Nil(t, err)
+	require.Equal(t, &BlockInfo{Nonce: 7, Hash: "hash"}, recovered)
+}
@@ -0,0 +1,16397 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nodeApi.proto
+
+package grpc
+
+import (
+	bytes "bytes"
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountQueryOptions holds the options used when querying the state of an account.
+// The Has* flags mark the optional numeric fields as set
+type AccountQueryOptions struct {
+	OnFinalBlock      bool   `protobuf:"varint,1,opt,name=OnFinalBlock,proto3" json:"OnFinalBlock,omitempty"`
+	HasOnStartOfEpoch bool   `protobuf:"varint,2,opt,name=HasOnStartOfEpoch,proto3" json:"HasOnStartOfEpoch,omitempty"`
+	OnStartOfEpoch    uint32 `protobuf:"varint,3,opt,name=OnStartOfEpoch,proto3" json:"OnStartOfEpoch,omitempty"`
+	HasBlockNonce     bool   `protobuf:"varint,4,opt,name=HasBlockNonce,proto3" json:"HasBlockNonce,omitempty"`
+	BlockNonce        uint64 `protobuf:"varint,5,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	BlockHash         []byte `protobuf:"bytes,6,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	BlockRootHash     []byte `protobuf:"bytes,7,opt,name=BlockRootHash,proto3" json:"BlockRootHash,omitempty"`
+	HasHintEpoch      bool   `protobuf:"varint,8,opt,name=HasHintEpoch,proto3" json:"HasHintEpoch,omitempty"`
+	HintEpoch         uint32 `protobuf:"varint,9,opt,name=HintEpoch,proto3" json:"HintEpoch,omitempty"`
+	WithKeys          bool   `protobuf:"varint,10,opt,name=WithKeys,proto3" json:"WithKeys,omitempty"`
+}
+
+func (m *AccountQueryOptions) Reset()      { *m = AccountQueryOptions{} }
+func (*AccountQueryOptions) ProtoMessage() {}
+func (*AccountQueryOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{0}
+}
+func (m *AccountQueryOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountQueryOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountQueryOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountQueryOptions.Merge(m, src)
+}
+func (m *AccountQueryOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountQueryOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountQueryOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountQueryOptions proto.InternalMessageInfo
+
+func (m *AccountQueryOptions) GetOnFinalBlock() bool {
+	if m != nil {
+		return m.OnFinalBlock
+	}
+	return false
+}
+
+func (m *AccountQueryOptions) GetHasOnStartOfEpoch() bool {
+	if m != nil {
+		return m.HasOnStartOfEpoch
+	}
+	return false
+}
+
+func (m *AccountQueryOptions) GetOnStartOfEpoch() uint32 {
+	if m != nil {
+		return m.OnStartOfEpoch
+	}
+	return 0
+}
+
+func (m *AccountQueryOptions) GetHasBlockNonce() bool {
+	if m != nil {
+		return m.HasBlockNonce
+	}
+	return false
+}
+
+func (m *AccountQueryOptions) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *AccountQueryOptions) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *AccountQueryOptions) GetBlockRootHash() []byte {
+	if m != nil {
+		return m.BlockRootHash
+	}
+	return nil
+}
+
+func (m *AccountQueryOptions) GetHasHintEpoch() bool {
+	if m != nil {
+		return m.HasHintEpoch
+	}
+	return false
+}
+
+func (m *AccountQueryOptions) GetHintEpoch() uint32 {
+	if m != nil {
+		return m.HintEpoch
+	}
+	return 0
+}
+
+func (m *AccountQueryOptions) GetWithKeys() bool {
+	if m != nil {
+		return m.WithKeys
+	}
+	return false
+}
+
+// BlockInfo holds the coordinates of the block used to resolve a state query
+type BlockInfo struct {
+	Nonce    uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Hash     string `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	RootHash string `protobuf:"bytes,3,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+}
+
+func (m *BlockInfo) Reset()      { *m = BlockInfo{} }
+func (*BlockInfo) ProtoMessage() {}
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{1}
+}
+func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInfo.Merge(m, src)
+}
+func (m *BlockInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockInfo proto.InternalMessageInfo
+
+func (m *BlockInfo) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockInfo) GetRootHash() string {
+	if m != nil {
+		return m.RootHash
+	}
+	return ""
+}
+
+// AccountRequest is used to request data about an account
+type AccountRequest struct {
+	Address string               `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Options *AccountQueryOptions `protobuf:"bytes,2,opt,name=Options,proto3" json:"Options,omitempty"`
+}
+
+func (m *AccountRequest) Reset()      { *m = AccountRequest{} }
+func (*AccountRequest) ProtoMessage() {}
+func (*AccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{2}
+}
+func (m *AccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountRequest.Merge(m, src)
+}
+func (m *AccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountRequest proto.InternalMessageInfo
+
+func (m *AccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountRequest) GetOptions() *AccountQueryOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// Account holds the data of an account
+type Account struct {
+	Address         string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Nonce           uint64 `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Balance         string `protobuf:"bytes,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	Username        string `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"`
+	Code            string `protobuf:"bytes,5,opt,name=Code,proto3" json:"Code,omitempty"`
+	CodeHash        []byte `protobuf:"bytes,6,opt,name=CodeHash,proto3" json:"CodeHash,omitempty"`
+	RootHash        []byte `protobuf:"bytes,7,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	CodeMetadata    []byte `protobuf:"bytes,8,opt,name=CodeMetadata,proto3" json:"CodeMetadata,omitempty"`
+	DeveloperReward string `protobuf:"bytes,9,opt,name=DeveloperReward,proto3" json:"DeveloperReward,omitempty"`
+	OwnerAddress    string `protobuf:"bytes,10,opt,name=OwnerAddress,proto3" json:"OwnerAddress,omitempty"`
+}
+
+func (m *Account) Reset()      { *m = Account{} }
+func (*Account) ProtoMessage() {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{3}
+}
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return m.Size()
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Account) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Account) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *Account) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *Account) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Account) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *Account) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *Account) GetCodeMetadata() []byte {
+	if m != nil {
+		return m.CodeMetadata
+	}
+	return nil
+}
+
+func (m *Account) GetDeveloperReward() string {
+	if m != nil {
+		return m.DeveloperReward
+	}
+	return ""
+}
+
+func (m *Account) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+// AccountResponse is the response of an account request
+type AccountResponse struct {
+	Account   *Account   `protobuf:"bytes,1,opt,name=Account,proto3" json:"Account,omitempty"`
+	BlockInfo *BlockInfo `protobuf:"bytes,2,opt,name=BlockInfo,proto3" json:"BlockInfo,omitempty"`
+}
+
+func (m *AccountResponse) Reset()      { *m = AccountResponse{} }
+func (*AccountResponse) ProtoMessage() {}
+func (*AccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{4}
+}
+func (m *AccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountResponse.Merge(m, src)
+}
+func (m *AccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountResponse proto.InternalMessageInfo
+
+func (m *AccountResponse) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *AccountResponse) GetBlockInfo() *BlockInfo {
+	if m != nil {
+		return m.BlockInfo
+	}
+	return nil
+}
+
+// ESDTDataRequest is used to request the data of an ESDT token held by an account
+type ESDTDataRequest struct {
+	Address         string               `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	TokenIdentifier string               `protobuf:"bytes,2,opt,name=TokenIdentifier,proto3" json:"TokenIdentifier,omitempty"`
+	Nonce           uint64               `protobuf:"varint,3,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Options         *AccountQueryOptions `protobuf:"bytes,4,opt,name=Options,proto3" json:"Options,omitempty"`
+}
+
+func (m *ESDTDataRequest) Reset()      { *m = ESDTDataRequest{} }
+func (*ESDTDataRequest) ProtoMessage() {}
+func (*ESDTDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{5}
+}
+func (m *ESDTDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTDataRequest.Merge(m, src)
+}
+func (m *ESDTDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTDataRequest proto.InternalMessageInfo
+
+func (m *ESDTDataRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ESDTDataRequest) GetTokenIdentifier() string {
+	if m != nil {
+		return m.TokenIdentifier
+	}
+	return ""
+}
+
+func (m *ESDTDataRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ESDTDataRequest) GetOptions() *AccountQueryOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// ESDTToken holds the data of an ESDT token held by an account
+type ESDTToken struct {
+	TokenIdentifier string   `protobuf:"bytes,1,opt,name=TokenIdentifier,proto3" json:"TokenIdentifier,omitempty"`
+	Type            uint32   `protobuf:"varint,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Balance         string   `protobuf:"bytes,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	Properties      []byte   `protobuf:"bytes,4,opt,name=Properties,proto3" json:"Properties,omitempty"`
+	Nonce           uint64   `protobuf:"varint,5,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Name            []byte   `protobuf:"bytes,6,opt,name=Name,proto3" json:"Name,omitempty"`
+	Creator         string   `protobuf:"bytes,7,opt,name=Creator,proto3" json:"Creator,omitempty"`
+	Royalties       uint32   `protobuf:"varint,8,opt,name=Royalties,proto3" json:"Royalties,omitempty"`
+	Hash            []byte   `protobuf:"bytes,9,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	URIs            [][]byte `protobuf:"bytes,10,rep,name=URIs,proto3" json:"URIs,omitempty"`
+	Attributes      []byte   `protobuf:"bytes,11,opt,name=Attributes,proto3" json:"Attributes,omitempty"`
+}
+
+func (m *ESDTToken) Reset()      { *m = ESDTToken{} }
+func (*ESDTToken) ProtoMessage() {}
+func (*ESDTToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{6}
+}
+func (m *ESDTToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTToken.Merge(m, src)
+}
+func (m *ESDTToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTToken proto.InternalMessageInfo
+
+func (m *ESDTToken) GetTokenIdentifier() string {
+	if m != nil {
+		return m.TokenIdentifier
+	}
+	return ""
+}
+
+func (m *ESDTToken) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *ESDTToken) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *ESDTToken) GetProperties() []byte {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *ESDTToken) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ESDTToken) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *ESDTToken) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ESDTToken) GetRoyalties() uint32 {
+	if m != nil {
+		return m.Royalties
+	}
+	return 0
+}
+
+func (m *ESDTToken) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *ESDTToken) GetURIs() [][]byte {
+	if m != nil {
+		return m.URIs
+	}
+	return nil
+}
+
+func (m *ESDTToken) GetAttributes() []byte {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// ESDTDataResponse is the response of an ESDT data request
+type ESDTDataResponse struct {
+	Token     *ESDTToken `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+	BlockInfo *BlockInfo `protobuf:"bytes,2,opt,name=BlockInfo,proto3" json:"BlockInfo,omitempty"`
+}
+
+func (m *ESDTDataResponse) Reset()      { *m = ESDTDataResponse{} }
+func (*ESDTDataResponse) ProtoMessage() {}
+func (*ESDTDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{7}
+}
+func (m *ESDTDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTDataResponse.Merge(m, src)
+}
+func (m *ESDTDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTDataResponse proto.InternalMessageInfo
+
+func (m *ESDTDataResponse) GetToken() *ESDTToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *ESDTDataResponse) GetBlockInfo() *BlockInfo {
+	if m != nil {
+		return m.BlockInfo
+	}
+	return nil
+}
+
+// ESDTTokensResponse holds all the ESDT tokens of an account
+type ESDTTokensResponse struct {
+	Tokens    []*ESDTToken `protobuf:"bytes,1,rep,name=Tokens,proto3" json:"Tokens,omitempty"`
+	BlockInfo *BlockInfo   `protobuf:"bytes,2,opt,name=BlockInfo,proto3" json:"BlockInfo,omitempty"`
+}
+
+func (m *ESDTTokensResponse) Reset()      { *m = ESDTTokensResponse{} }
+func (*ESDTTokensResponse) ProtoMessage() {}
+func (*ESDTTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{8}
+}
+func (m *ESDTTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTTokensResponse.Merge(m, src)
+}
+func (m *ESDTTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTTokensResponse proto.InternalMessageInfo
+
+func (m *ESDTTokensResponse) GetTokens() []*ESDTToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *ESDTTokensResponse) GetBlockInfo() *BlockInfo {
+	if m != nil {
+		return m.BlockInfo
+	}
+	return nil
+}
+
+// KeyValuePair holds a hex encoded key and its hex encoded value from the data trie of an account
+type KeyValuePair struct {
+	Key   string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (m *KeyValuePair) Reset()      { *m = KeyValuePair{} }
+func (*KeyValuePair) ProtoMessage() {}
+func (*KeyValuePair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{9}
+}
+func (m *KeyValuePair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyValuePair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KeyValuePair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyValuePair.Merge(m, src)
+}
+func (m *KeyValuePair) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyValuePair) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyValuePair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyValuePair proto.InternalMessageInfo
+
+func (m *KeyValuePair) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *KeyValuePair) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// KeyValuePairsBatch is a batch of key-value pairs streamed from the data trie of an account
+type KeyValuePairsBatch struct {
+	Pairs         []*KeyValuePair `protobuf:"bytes,1,rep,name=Pairs,proto3" json:"Pairs,omitempty"`
+	IteratorState [][]byte        `protobuf:"bytes,2,rep,name=IteratorState,proto3" json:"IteratorState,omitempty"`
+	BlockInfo     *BlockInfo      `protobuf:"bytes,3,opt,name=BlockInfo,proto3" json:"BlockInfo,omitempty"`
+}
+
+func (m *KeyValuePairsBatch) Reset()      { *m = KeyValuePairsBatch{} }
+func (*KeyValuePairsBatch) ProtoMessage() {}
+func (*KeyValuePairsBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{10}
+}
+func (m *KeyValuePairsBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyValuePairsBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KeyValuePairsBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyValuePairsBatch.Merge(m, src)
+}
+func (m *KeyValuePairsBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyValuePairsBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyValuePairsBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyValuePairsBatch proto.InternalMessageInfo
+
+func (m *KeyValuePairsBatch) GetPairs() []*KeyValuePair {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+func (m *KeyValuePairsBatch) GetIteratorState() [][]byte {
+	if m != nil {
+		return m.IteratorState
+	}
+	return nil
+}
+
+func (m *KeyValuePairsBatch) GetBlockInfo() *BlockInfo {
+	if m != nil {
+		return m.BlockInfo
+	}
+	return nil
+}
+
+// IterateKeysRequest is used to iterate the data trie of an account in batches.
+// An empty IteratorState starts the iteration from the root of the data trie
+type IterateKeysRequest struct {
+	Address         string               `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	NumKeysPerBatch uint32               `protobuf:"varint,2,opt,name=NumKeysPerBatch,proto3" json:"NumKeysPerBatch,omitempty"`
+	MaxKeys         uint64               `protobuf:"varint,3,opt,name=MaxKeys,proto3" json:"MaxKeys,omitempty"`
+	IteratorState   [][]byte             `protobuf:"bytes,4,rep,name=IteratorState,proto3" json:"IteratorState,omitempty"`
+	Options         *AccountQueryOptions `protobuf:"bytes,5,opt,name=Options,proto3" json:"Options,omitempty"`
+}
+
+func (m *IterateKeysRequest) Reset()      { *m = IterateKeysRequest{} }
+func (*IterateKeysRequest) ProtoMessage() {}
+func (*IterateKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{11}
+}
+func (m *IterateKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IterateKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *IterateKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IterateKeysRequest.Merge(m, src)
+}
+func (m *IterateKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IterateKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IterateKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IterateKeysRequest proto.InternalMessageInfo
+
+func (m *IterateKeysRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *IterateKeysRequest) GetNumKeysPerBatch() uint32 {
+	if m != nil {
+		return m.NumKeysPerBatch
+	}
+	return 0
+}
+
+func (m *IterateKeysRequest) GetMaxKeys() uint64 {
+	if m != nil {
+		return m.MaxKeys
+	}
+	return 0
+}
+
+func (m *IterateKeysRequest) GetIteratorState() [][]byte {
+	if m != nil {
+		return m.IteratorState
+	}
+	return nil
+}
+
+func (m *IterateKeysRequest) GetOptions() *AccountQueryOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// BlockQueryOptions holds the options used when fetching blocks
+type BlockQueryOptions struct {
+	WithTransactions bool `protobuf:"varint,1,opt,name=WithTransactions,proto3" json:"WithTransactions,omitempty"`
+	WithLogs         bool `protobuf:"varint,2,opt,name=WithLogs,proto3" json:"WithLogs,omitempty"`
+}
+
+func (m *BlockQueryOptions) Reset()      { *m = BlockQueryOptions{} }
+func (*BlockQueryOptions) ProtoMessage() {}
+func (*BlockQueryOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{12}
+}
+func (m *BlockQueryOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockQueryOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockQueryOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockQueryOptions.Merge(m, src)
+}
+func (m *BlockQueryOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockQueryOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockQueryOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockQueryOptions proto.InternalMessageInfo
+
+func (m *BlockQueryOptions) GetWithTransactions() bool {
+	if m != nil {
+		return m.WithTransactions
+	}
+	return false
+}
+
+func (m *BlockQueryOptions) GetWithLogs() bool {
+	if m != nil {
+		return m.WithLogs
+	}
+	return false
+}
+
+// BlockByHashRequest is used to request a block by its hex encoded hash
+type BlockByHashRequest struct {
+	Hash    string             `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Options *BlockQueryOptions `protobuf:"bytes,2,opt,name=Options,proto3" json:"Options,omitempty"`
+}
+
+func (m *BlockByHashRequest) Reset()      { *m = BlockByHashRequest{} }
+func (*BlockByHashRequest) ProtoMessage() {}
+func (*BlockByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{13}
+}
+func (m *BlockByHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockByHashRequest.Merge(m, src)
+}
+func (m *BlockByHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockByHashRequest proto.InternalMessageInfo
+
+func (m *BlockByHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockByHashRequest) GetOptions() *BlockQueryOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// BlockByNonceRequest is used to request a block by its nonce
+type BlockByNonceRequest struct {
+	Nonce   uint64             `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Options *BlockQueryOptions `protobuf:"bytes,2,opt,name=Options,proto3" json:"Options,omitempty"`
+}
+
+func (m *BlockByNonceRequest) Reset()      { *m = BlockByNonceRequest{} }
+func (*BlockByNonceRequest) ProtoMessage() {}
+func (*BlockByNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{14}
+}
+func (m *BlockByNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockByNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockByNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockByNonceRequest.Merge(m, src)
+}
+func (m *BlockByNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockByNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockByNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockByNonceRequest proto.InternalMessageInfo
+
+func (m *BlockByNonceRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockByNonceRequest) GetOptions() *BlockQueryOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// BlockByRoundRequest is used to request a block by its round
+type BlockByRoundRequest struct {
+	Round   uint64             `protobuf:"varint,1,opt,name=Round,proto3" json:"Round,omitempty"`
+	Options *BlockQueryOptions `protobuf:"bytes,2,opt,name=Options,proto3" json:"Options,omitempty"`
+}
+
+func (m *BlockByRoundRequest) Reset()      { *m = BlockByRoundRequest{} }
+func (*BlockByRoundRequest) ProtoMessage() {}
+func (*BlockByRoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{15}
+}
+func (m *BlockByRoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockByRoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockByRoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockByRoundRequest.Merge(m, src)
+}
+func (m *BlockByRoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockByRoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockByRoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockByRoundRequest proto.InternalMessageInfo
+
+func (m *BlockByRoundRequest) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *BlockByRoundRequest) GetOptions() *BlockQueryOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// BlockRangeRequest is used to stream all the blocks between two nonces, both ends included
+type BlockRangeRequest struct {
+	FromNonce uint64             `protobuf:"varint,1,opt,name=FromNonce,proto3" json:"FromNonce,omitempty"`
+	ToNonce   uint64             `protobuf:"varint,2,opt,name=ToNonce,proto3" json:"ToNonce,omitempty"`
+	Options   *BlockQueryOptions `protobuf:"bytes,3,opt,name=Options,proto3" json:"Options,omitempty"`
+}
+
+func (m *BlockRangeRequest) Reset()      { *m = BlockRangeRequest{} }
+func (*BlockRangeRequest) ProtoMessage() {}
+func (*BlockRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{16}
+}
+func (m *BlockRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRangeRequest.Merge(m, src)
+}
+func (m *BlockRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRangeRequest proto.InternalMessageInfo
+
+func (m *BlockRangeRequest) GetFromNonce() uint64 {
+	if m != nil {
+		return m.FromNonce
+	}
+	return 0
+}
+
+func (m *BlockRangeRequest) GetToNonce() uint64 {
+	if m != nil {
+		return m.ToNonce
+	}
+	return 0
+}
+
+func (m *BlockRangeRequest) GetOptions() *BlockQueryOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// TransactionResult holds the data of a transaction included in a block
+type TransactionResult struct {
+	Hash      string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Nonce     uint64 `protobuf:"varint,3,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Value     string `protobuf:"bytes,4,opt,name=Value,proto3" json:"Value,omitempty"`
+	Receiver  string `protobuf:"bytes,5,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Sender    string `protobuf:"bytes,6,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	GasPrice  uint64 `protobuf:"varint,7,opt,name=GasPrice,proto3" json:"GasPrice,omitempty"`
+	GasLimit  uint64 `protobuf:"varint,8,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	Data      []byte `protobuf:"bytes,9,opt,name=Data,proto3" json:"Data,omitempty"`
+	Signature string `protobuf:"bytes,10,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	Status    string `protobuf:"bytes,11,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (m *TransactionResult) Reset()      { *m = TransactionResult{} }
+func (*TransactionResult) ProtoMessage() {}
+func (*TransactionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{17}
+}
+func (m *TransactionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionResult.Merge(m, src)
+}
+func (m *TransactionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionResult proto.InternalMessageInfo
+
+func (m *TransactionResult) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TransactionResult) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TransactionResult) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TransactionResult) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TransactionResult) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *TransactionResult) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *TransactionResult) GetGasPrice() uint64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *TransactionResult) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *TransactionResult) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TransactionResult) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *TransactionResult) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// MiniBlock holds the data of a miniblock included in a block
+type MiniBlock struct {
+	Hash              string               `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Type              string               `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	ProcessingType    string               `protobuf:"bytes,3,opt,name=ProcessingType,proto3" json:"ProcessingType,omitempty"`
+	ConstructionState string               `protobuf:"bytes,4,opt,name=ConstructionState,proto3" json:"ConstructionState,omitempty"`
+	SourceShard       uint32               `protobuf:"varint,5,opt,name=SourceShard,proto3" json:"SourceShard,omitempty"`
+	DestinationShard  uint32               `protobuf:"varint,6,opt,name=DestinationShard,proto3" json:"DestinationShard,omitempty"`
+	Transactions      []*TransactionResult `protobuf:"bytes,7,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *MiniBlock) Reset()      { *m = MiniBlock{} }
+func (*MiniBlock) ProtoMessage() {}
+func (*MiniBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{18}
+}
+func (m *MiniBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MiniBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MiniBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MiniBlock.Merge(m, src)
+}
+func (m *MiniBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MiniBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MiniBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MiniBlock proto.InternalMessageInfo
+
+func (m *MiniBlock) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *MiniBlock) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MiniBlock) GetProcessingType() string {
+	if m != nil {
+		return m.ProcessingType
+	}
+	return ""
+}
+
+func (m *MiniBlock) GetConstructionState() string {
+	if m != nil {
+		return m.ConstructionState
+	}
+	return ""
+}
+
+func (m *MiniBlock) GetSourceShard() uint32 {
+	if m != nil {
+		return m.SourceShard
+	}
+	return 0
+}
+
+func (m *MiniBlock) GetDestinationShard() uint32 {
+	if m != nil {
+		return m.DestinationShard
+	}
+	return 0
+}
+
+func (m *MiniBlock) GetTransactions() []*TransactionResult {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+// Block holds the data of a block
+type Block struct {
+	Nonce           uint64       `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Round           uint64       `protobuf:"varint,2,opt,name=Round,proto3" json:"Round,omitempty"`
+	Epoch           uint32       `protobuf:"varint,3,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Shard           uint32       `protobuf:"varint,4,opt,name=Shard,proto3" json:"Shard,omitempty"`
+	NumTxs          uint32       `protobuf:"varint,5,opt,name=NumTxs,proto3" json:"NumTxs,omitempty"`
+	Hash            string       `protobuf:"bytes,6,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	PrevBlockHash   string       `protobuf:"bytes,7,opt,name=PrevBlockHash,proto3" json:"PrevBlockHash,omitempty"`
+	StateRootHash   string       `protobuf:"bytes,8,opt,name=StateRootHash,proto3" json:"StateRootHash,omitempty"`
+	AccumulatedFees string       `protobuf:"bytes,9,opt,name=AccumulatedFees,proto3" json:"AccumulatedFees,omitempty"`
+	DeveloperFees   string       `protobuf:"bytes,10,opt,name=DeveloperFees,proto3" json:"DeveloperFees,omitempty"`
+	Status          string       `protobuf:"bytes,11,opt,name=Status,proto3" json:"Status,omitempty"`
+	Timestamp       int64        `protobuf:"varint,12,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	TimestampMs     int64        `protobuf:"varint,13,opt,name=TimestampMs,proto3" json:"TimestampMs,omitempty"`
+	MiniBlocks      []*MiniBlock `protobuf:"bytes,14,rep,name=MiniBlocks,proto3" json:"MiniBlocks,omitempty"`
+}
+
+func (m *Block) Reset()      { *m = Block{} }
+func (*Block) ProtoMessage() {}
+func (*Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{19}
+}
+func (m *Block) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block.Merge(m, src)
+}
+func (m *Block) XXX_Size() int {
+	return m.Size()
+}
+func (m *Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Block proto.InternalMessageInfo
+
+func (m *Block) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Block) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Block) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Block) GetShard() uint32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *Block) GetNumTxs() uint32 {
+	if m != nil {
+		return m.NumTxs
+	}
+	return 0
+}
+
+func (m *Block) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Block) GetPrevBlockHash() string {
+	if m != nil {
+		return m.PrevBlockHash
+	}
+	return ""
+}
+
+func (m *Block) GetStateRootHash() string {
+	if m != nil {
+		return m.StateRootHash
+	}
+	return ""
+}
+
+func (m *Block) GetAccumulatedFees() string {
+	if m != nil {
+		return m.AccumulatedFees
+	}
+	return ""
+}
+
+func (m *Block) GetDeveloperFees() string {
+	if m != nil {
+		return m.DeveloperFees
+	}
+	return ""
+}
+
+func (m *Block) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Block) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Block) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+func (m *Block) GetMiniBlocks() []*MiniBlock {
+	if m != nil {
+		return m.MiniBlocks
+	}
+	return nil
+}
+
+// Transaction holds the data of a transaction to be sent, simulated or estimated
+type Transaction struct {
+	Nonce             uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Value             string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	Receiver          string `protobuf:"bytes,3,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Sender            string `protobuf:"bytes,4,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	ReceiverUsername  []byte `protobuf:"bytes,5,opt,name=ReceiverUsername,proto3" json:"ReceiverUsername,omitempty"`
+	SenderUsername    []byte `protobuf:"bytes,6,opt,name=SenderUsername,proto3" json:"SenderUsername,omitempty"`
+	GasPrice          uint64 `protobuf:"varint,7,opt,name=GasPrice,proto3" json:"GasPrice,omitempty"`
+	GasLimit          uint64 `protobuf:"varint,8,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	Data              []byte `protobuf:"bytes,9,opt,name=Data,proto3" json:"Data,omitempty"`
+	Signature         string `protobuf:"bytes,10,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	ChainID           string `protobuf:"bytes,11,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	Version           uint32 `protobuf:"varint,12,opt,name=Version,proto3" json:"Version,omitempty"`
+	Options           uint32 `protobuf:"varint,13,opt,name=Options,proto3" json:"Options,omitempty"`
+	GuardianAddr      string `protobuf:"bytes,14,opt,name=GuardianAddr,proto3" json:"GuardianAddr,omitempty"`
+	GuardianSignature string `protobuf:"bytes,15,opt,name=GuardianSignature,proto3" json:"GuardianSignature,omitempty"`
+	RelayerAddr       string `protobuf:"bytes,16,opt,name=RelayerAddr,proto3" json:"RelayerAddr,omitempty"`
+	RelayerSignature  string `protobuf:"bytes,17,opt,name=RelayerSignature,proto3" json:"RelayerSignature,omitempty"`
+}
+
+func (m *Transaction) Reset()      { *m = Transaction{} }
+func (*Transaction) ProtoMessage() {}
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{20}
+}
+func (m *Transaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Transaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Transaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transaction.Merge(m, src)
+}
+func (m *Transaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Transaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transaction proto.InternalMessageInfo
+
+func (m *Transaction) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Transaction) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Transaction) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *Transaction) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *Transaction) GetReceiverUsername() []byte {
+	if m != nil {
+		return m.ReceiverUsername
+	}
+	return nil
+}
+
+func (m *Transaction) GetSenderUsername() []byte {
+	if m != nil {
+		return m.SenderUsername
+	}
+	return nil
+}
+
+func (m *Transaction) GetGasPrice() uint64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *Transaction) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *Transaction) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Transaction) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *Transaction) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *Transaction) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Transaction) GetOptions() uint32 {
+	if m != nil {
+		return m.Options
+	}
+	return 0
+}
+
+func (m *Transaction) GetGuardianAddr() string {
+	if m != nil {
+		return m.GuardianAddr
+	}
+	return ""
+}
+
+func (m *Transaction) GetGuardianSignature() string {
+	if m != nil {
+		return m.GuardianSignature
+	}
+	return ""
+}
+
+func (m *Transaction) GetRelayerAddr() string {
+	if m != nil {
+		return m.RelayerAddr
+	}
+	return ""
+}
+
+func (m *Transaction) GetRelayerSignature() string {
+	if m != nil {
+		return m.RelayerSignature
+	}
+	return ""
+}
+
+// SendTransactionsRequest is used to send a batch of transactions
+type SendTransactionsRequest struct {
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *SendTransactionsRequest) Reset()      { *m = SendTransactionsRequest{} }
+func (*SendTransactionsRequest) ProtoMessage() {}
+func (*SendTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{21}
+}
+func (m *SendTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SendTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendTransactionsRequest.Merge(m, src)
+}
+func (m *SendTransactionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendTransactionsRequest proto.InternalMessageInfo
+
+func (m *SendTransactionsRequest) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+// SendTransactionsResponse holds the hex encoded hashes of the sent transactions, in the request order
+type SendTransactionsResponse struct {
+	NumOfSentTxs uint64   `protobuf:"varint,1,opt,name=NumOfSentTxs,proto3" json:"NumOfSentTxs,omitempty"`
+	TxsHashes    []string `protobuf:"bytes,2,rep,name=TxsHashes,proto3" json:"TxsHashes,omitempty"`
+}
+
+func (m *SendTransactionsResponse) Reset()      { *m = SendTransactionsResponse{} }
+func (*SendTransactionsResponse) ProtoMessage() {}
+func (*SendTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{22}
+}
+func (m *SendTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SendTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendTransactionsResponse.Merge(m, src)
+}
+func (m *SendTransactionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendTransactionsResponse proto.InternalMessageInfo
+
+func (m *SendTransactionsResponse) GetNumOfSentTxs() uint64 {
+	if m != nil {
+		return m.NumOfSentTxs
+	}
+	return 0
+}
+
+func (m *SendTransactionsResponse) GetTxsHashes() []string {
+	if m != nil {
+		return m.TxsHashes
+	}
+	return nil
+}
+
+// SimulateTransactionRequest is used to simulate the execution of a transaction
+type SimulateTransactionRequest struct {
+	Transaction        *Transaction `protobuf:"bytes,1,opt,name=Transaction,proto3" json:"Transaction,omitempty"`
+	SkipSignatureCheck bool         `protobuf:"varint,2,opt,name=SkipSignatureCheck,proto3" json:"SkipSignatureCheck,omitempty"`
+}
+
+func (m *SimulateTransactionRequest) Reset()      { *m = SimulateTransactionRequest{} }
+func (*SimulateTransactionRequest) ProtoMessage() {}
+func (*SimulateTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{23}
+}
+func (m *SimulateTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SimulateTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateTransactionRequest.Merge(m, src)
+}
+func (m *SimulateTransactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateTransactionRequest proto.InternalMessageInfo
+
+func (m *SimulateTransactionRequest) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *SimulateTransactionRequest) GetSkipSignatureCheck() bool {
+	if m != nil {
+		return m.SkipSignatureCheck
+	}
+	return false
+}
+
+// SmartContractResult holds the data of a smart contract result generated by a transaction
+type SmartContractResult struct {
+	Hash          string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Nonce         uint64 `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Value         string `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	Receiver      string `protobuf:"bytes,4,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Sender        string `protobuf:"bytes,5,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	Data          []byte `protobuf:"bytes,6,opt,name=Data,proto3" json:"Data,omitempty"`
+	GasLimit      uint64 `protobuf:"varint,7,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	GasPrice      uint64 `protobuf:"varint,8,opt,name=GasPrice,proto3" json:"GasPrice,omitempty"`
+	ReturnMessage string `protobuf:"bytes,9,opt,name=ReturnMessage,proto3" json:"ReturnMessage,omitempty"`
+}
+
+func (m *SmartContractResult) Reset()      { *m = SmartContractResult{} }
+func (*SmartContractResult) ProtoMessage() {}
+func (*SmartContractResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{24}
+}
+func (m *SmartContractResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SmartContractResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SmartContractResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SmartContractResult.Merge(m, src)
+}
+func (m *SmartContractResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SmartContractResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SmartContractResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SmartContractResult proto.InternalMessageInfo
+
+func (m *SmartContractResult) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *SmartContractResult) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SmartContractResult) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *SmartContractResult) GetGasPrice() uint64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *SmartContractResult) GetReturnMessage() string {
+	if m != nil {
+		return m.ReturnMessage
+	}
+	return ""
+}
+
+// Event holds the data of a log event
+type Event struct {
+	Address    string   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Identifier string   `protobuf:"bytes,2,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Topics     [][]byte `protobuf:"bytes,3,rep,name=Topics,proto3" json:"Topics,omitempty"`
+	Data       []byte   `protobuf:"bytes,4,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (m *Event) Reset()      { *m = Event{} }
+func (*Event) ProtoMessage() {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{25}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Event) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *Event) GetTopics() [][]byte {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *Event) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// Logs holds the log events generated by a transaction
+type Logs struct {
+	Address string   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Events  []*Event `protobuf:"bytes,2,rep,name=Events,proto3" json:"Events,omitempty"`
+}
+
+func (m *Logs) Reset()      { *m = Logs{} }
+func (*Logs) ProtoMessage() {}
+func (*Logs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{26}
+}
+func (m *Logs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Logs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Logs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Logs.Merge(m, src)
+}
+func (m *Logs) XXX_Size() int {
+	return m.Size()
+}
+func (m *Logs) XXX_DiscardUnknown() {
+	xxx_messageInfo_Logs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Logs proto.InternalMessageInfo
+
+func (m *Logs) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Logs) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// SimulationResponse holds the results of a transaction simulation
+type SimulationResponse struct {
+	Hash       string                 `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Status     string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	FailReason string                 `protobuf:"bytes,3,opt,name=FailReason,proto3" json:"FailReason,omitempty"`
+	ScResults  []*SmartContractResult `protobuf:"bytes,4,rep,name=ScResults,proto3" json:"ScResults,omitempty"`
+	Logs       *Logs                  `protobuf:"bytes,5,opt,name=Logs,proto3" json:"Logs,omitempty"`
+}
+
+func (m *SimulationResponse) Reset()      { *m = SimulationResponse{} }
+func (*SimulationResponse) ProtoMessage() {}
+func (*SimulationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{27}
+}
+func (m *SimulationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SimulationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulationResponse.Merge(m, src)
+}
+func (m *SimulationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulationResponse proto.InternalMessageInfo
+
+func (m *SimulationResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SimulationResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *SimulationResponse) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
+func (m *SimulationResponse) GetScResults() []*SmartContractResult {
+	if m != nil {
+		return m.ScResults
+	}
+	return nil
+}
+
+func (m *SimulationResponse) GetLogs() *Logs {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+// TransactionCostResponse holds the estimated cost of a transaction
+type TransactionCostResponse struct {
+	GasUnits      uint64                 `protobuf:"varint,1,opt,name=GasUnits,proto3" json:"GasUnits,omitempty"`
+	ReturnMessage string                 `protobuf:"bytes,2,opt,name=ReturnMessage,proto3" json:"ReturnMessage,omitempty"`
+	ScResults     []*SmartContractResult `protobuf:"bytes,3,rep,name=ScResults,proto3" json:"ScResults,omitempty"`
+	Logs          *Logs                  `protobuf:"bytes,4,opt,name=Logs,proto3" json:"Logs,omitempty"`
+}
+
+func (m *TransactionCostResponse) Reset()      { *m = TransactionCostResponse{} }
+func (*TransactionCostResponse) ProtoMessage() {}
+func (*TransactionCostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{28}
+}
+func (m *TransactionCostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionCostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionCostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionCostResponse.Merge(m, src)
+}
+func (m *TransactionCostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionCostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionCostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionCostResponse proto.InternalMessageInfo
+
+func (m *TransactionCostResponse) GetGasUnits() uint64 {
+	if m != nil {
+		return m.GasUnits
+	}
+	return 0
+}
+
+func (m *TransactionCostResponse) GetReturnMessage() string {
+	if m != nil {
+		return m.ReturnMessage
+	}
+	return ""
+}
+
+func (m *TransactionCostResponse) GetScResults() []*SmartContractResult {
+	if m != nil {
+		return m.ScResults
+	}
+	return nil
+}
+
+func (m *TransactionCostResponse) GetLogs() *Logs {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+// VMQueryRequest is used to execute a read-only smart contract call
+type VMQueryRequest struct {
+	ScAddress      string   `protobuf:"bytes,1,opt,name=ScAddress,proto3" json:"ScAddress,omitempty"`
+	FuncName       string   `protobuf:"bytes,2,opt,name=FuncName,proto3" json:"FuncName,omitempty"`
+	CallerAddr     string   `protobuf:"bytes,3,opt,name=CallerAddr,proto3" json:"CallerAddr,omitempty"`
+	CallValue      string   `protobuf:"bytes,4,opt,name=CallValue,proto3" json:"CallValue,omitempty"`
+	Args           [][]byte `protobuf:"bytes,5,rep,name=Args,proto3" json:"Args,omitempty"`
+	SameScState    bool     `protobuf:"varint,6,opt,name=SameScState,proto3" json:"SameScState,omitempty"`
+	ShouldBeSynced bool     `protobuf:"varint,7,opt,name=ShouldBeSynced,proto3" json:"ShouldBeSynced,omitempty"`
+	HasBlockNonce  bool     `protobuf:"varint,8,opt,name=HasBlockNonce,proto3" json:"HasBlockNonce,omitempty"`
+	BlockNonce     uint64   `protobuf:"varint,9,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	BlockHash      []byte   `protobuf:"bytes,10,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+}
+
+func (m *VMQueryRequest) Reset()      { *m = VMQueryRequest{} }
+func (*VMQueryRequest) ProtoMessage() {}
+func (*VMQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{29}
+}
+func (m *VMQueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VMQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VMQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VMQueryRequest.Merge(m, src)
+}
+func (m *VMQueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VMQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VMQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VMQueryRequest proto.InternalMessageInfo
+
+func (m *VMQueryRequest) GetScAddress() string {
+	if m != nil {
+		return m.ScAddress
+	}
+	return ""
+}
+
+func (m *VMQueryRequest) GetFuncName() string {
+	if m != nil {
+		return m.FuncName
+	}
+	return ""
+}
+
+func (m *VMQueryRequest) GetCallerAddr() string {
+	if m != nil {
+		return m.CallerAddr
+	}
+	return ""
+}
+
+func (m *VMQueryRequest) GetCallValue() string {
+	if m != nil {
+		return m.CallValue
+	}
+	return ""
+}
+
+func (m *VMQueryRequest) GetArgs() [][]byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *VMQueryRequest) GetSameScState() bool {
+	if m != nil {
+		return m.SameScState
+	}
+	return false
+}
+
+func (m *VMQueryRequest) GetShouldBeSynced() bool {
+	if m != nil {
+		return m.ShouldBeSynced
+	}
+	return false
+}
+
+func (m *VMQueryRequest) GetHasBlockNonce() bool {
+	if m != nil {
+		return m.HasBlockNonce
+	}
+	return false
+}
+
+func (m *VMQueryRequest) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *VMQueryRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+// VMQueryResponse holds the output of a read-only smart contract call
+type VMQueryResponse struct {
+	ReturnData    [][]byte   `protobuf:"bytes,1,rep,name=ReturnData,proto3" json:"ReturnData,omitempty"`
+	ReturnCode    string     `protobuf:"bytes,2,opt,name=ReturnCode,proto3" json:"ReturnCode,omitempty"`
+	ReturnMessage string     `protobuf:"bytes,3,opt,name=ReturnMessage,proto3" json:"ReturnMessage,omitempty"`
+	GasRemaining  uint64     `protobuf:"varint,4,opt,name=GasRemaining,proto3" json:"GasRemaining,omitempty"`
+	GasRefund     string     `protobuf:"bytes,5,opt,name=GasRefund,proto3" json:"GasRefund,omitempty"`
+	BlockInfo     *BlockInfo `protobuf:"bytes,6,opt,name=BlockInfo,proto3" json:"BlockInfo,omitempty"`
+}
+
+func (m *VMQueryResponse) Reset()      { *m = VMQueryResponse{} }
+func (*VMQueryResponse) ProtoMessage() {}
+func (*VMQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{30}
+}
+func (m *VMQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VMQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VMQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VMQueryResponse.Merge(m, src)
+}
+func (m *VMQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VMQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VMQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VMQueryResponse proto.InternalMessageInfo
+
+func (m *VMQueryResponse) GetReturnData() [][]byte {
+	if m != nil {
+		return m.ReturnData
+	}
+	return nil
+}
+
+func (m *VMQueryResponse) GetReturnCode() string {
+	if m != nil {
+		return m.ReturnCode
+	}
+	return ""
+}
+
+func (m *VMQueryResponse) GetReturnMessage() string {
+	if m != nil {
+		return m.ReturnMessage
+	}
+	return ""
+}
+
+func (m *VMQueryResponse) GetGasRemaining() uint64 {
+	if m != nil {
+		return m.GasRemaining
+	}
+	return 0
+}
+
+func (m *VMQueryResponse) GetGasRefund() string {
+	if m != nil {
+		return m.GasRefund
+	}
+	return ""
+}
+
+func (m *VMQueryResponse) GetBlockInfo() *BlockInfo {
+	if m != nil {
+		return m.BlockInfo
+	}
+	return nil
+}
+
+// ProofRequest is used to request the Merkle proof of an account. An empty RootHash means the current root hash
+type ProofRequest struct {
+	RootHash string `protobuf:"bytes,1,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+}
+
+func (m *ProofRequest) Reset()      { *m = ProofRequest{} }
+func (*ProofRequest) ProtoMessage() {}
+func (*ProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{31}
+}
+func (m *ProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofRequest.Merge(m, src)
+}
+func (m *ProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofRequest proto.InternalMessageInfo
+
+func (m *ProofRequest) GetRootHash() string {
+	if m != nil {
+		return m.RootHash
+	}
+	return ""
+}
+
+func (m *ProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ProofResponse holds a Merkle proof
+type ProofResponse struct {
+	Proof    [][]byte `protobuf:"bytes,1,rep,name=Proof,proto3" json:"Proof,omitempty"`
+	Value    []byte   `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	RootHash string   `protobuf:"bytes,3,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+}
+
+func (m *ProofResponse) Reset()      { *m = ProofResponse{} }
+func (*ProofResponse) ProtoMessage() {}
+func (*ProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{32}
+}
+func (m *ProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofResponse.Merge(m, src)
+}
+func (m *ProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofResponse proto.InternalMessageInfo
+
+func (m *ProofResponse) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *ProofResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ProofResponse) GetRootHash() string {
+	if m != nil {
+		return m.RootHash
+	}
+	return ""
+}
+
+// ProofDataTrieRequest is used to request the Merkle proofs of an account and of a key from its data trie
+type ProofDataTrieRequest struct {
+	RootHash string `protobuf:"bytes,1,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	Key      string `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
+}
+
+func (m *ProofDataTrieRequest) Reset()      { *m = ProofDataTrieRequest{} }
+func (*ProofDataTrieRequest) ProtoMessage() {}
+func (*ProofDataTrieRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{33}
+}
+func (m *ProofDataTrieRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofDataTrieRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProofDataTrieRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofDataTrieRequest.Merge(m, src)
+}
+func (m *ProofDataTrieRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofDataTrieRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofDataTrieRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofDataTrieRequest proto.InternalMessageInfo
+
+func (m *ProofDataTrieRequest) GetRootHash() string {
+	if m != nil {
+		return m.RootHash
+	}
+	return ""
+}
+
+func (m *ProofDataTrieRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ProofDataTrieRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+// ProofDataTrieResponse holds the Merkle proofs of an account and of a key from its data trie
+type ProofDataTrieResponse struct {
+	MainProof     *ProofResponse `protobuf:"bytes,1,opt,name=MainProof,proto3" json:"MainProof,omitempty"`
+	DataTrieProof *ProofResponse `protobuf:"bytes,2,opt,name=DataTrieProof,proto3" json:"DataTrieProof,omitempty"`
+}
+
+func (m *ProofDataTrieResponse) Reset()      { *m = ProofDataTrieResponse{} }
+func (*ProofDataTrieResponse) ProtoMessage() {}
+func (*ProofDataTrieResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{34}
+}
+func (m *ProofDataTrieResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofDataTrieResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProofDataTrieResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofDataTrieResponse.Merge(m, src)
+}
+func (m *ProofDataTrieResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofDataTrieResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofDataTrieResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofDataTrieResponse proto.InternalMessageInfo
+
+func (m *ProofDataTrieResponse) GetMainProof() *ProofResponse {
+	if m != nil {
+		return m.MainProof
+	}
+	return nil
+}
+
+func (m *ProofDataTrieResponse) GetDataTrieProof() *ProofResponse {
+	if m != nil {
+		return m.DataTrieProof
+	}
+	return nil
+}
+
+// VerifyProofRequest is used to verify a Merkle proof
+type VerifyProofRequest struct {
+	RootHash string   `protobuf:"bytes,1,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	Address  string   `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	Proof    [][]byte `protobuf:"bytes,3,rep,name=Proof,proto3" json:"Proof,omitempty"`
+}
+
+func (m *VerifyProofRequest) Reset()      { *m = VerifyProofRequest{} }
+func (*VerifyProofRequest) ProtoMessage() {}
+func (*VerifyProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{35}
+}
+func (m *VerifyProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VerifyProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyProofRequest.Merge(m, src)
+}
+func (m *VerifyProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyProofRequest proto.InternalMessageInfo
+
+func (m *VerifyProofRequest) GetRootHash() string {
+	if m != nil {
+		return m.RootHash
+	}
+	return ""
+}
+
+func (m *VerifyProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VerifyProofRequest) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// VerifyProofResponse holds the result of a Merkle proof verification
+type VerifyProofResponse struct {
+	Ok bool `protobuf:"varint,1,opt,name=Ok,proto3" json:"Ok,omitempty"`
+}
+
+func (m *VerifyProofResponse) Reset()      { *m = VerifyProofResponse{} }
+func (*VerifyProofResponse) ProtoMessage() {}
+func (*VerifyProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{36}
+}
+func (m *VerifyProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VerifyProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyProofResponse.Merge(m, src)
+}
+func (m *VerifyProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyProofResponse proto.InternalMessageInfo
+
+func (m *VerifyProofResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*AccountQueryOptions)(nil), "nodeapi.AccountQueryOptions")
+	proto.RegisterType((*BlockInfo)(nil), "nodeapi.BlockInfo")
+	proto.RegisterType((*AccountRequest)(nil), "nodeapi.AccountRequest")
+	proto.RegisterType((*Account)(nil), "nodeapi.Account")
+	proto.RegisterType((*AccountResponse)(nil), "nodeapi.AccountResponse")
+	proto.RegisterType((*ESDTDataRequest)(nil), "nodeapi.ESDTDataRequest")
+	proto.RegisterType((*ESDTToken)(nil), "nodeapi.ESDTToken")
+	proto.RegisterType((*ESDTDataResponse)(nil), "nodeapi.ESDTDataResponse")
+	proto.RegisterType((*ESDTTokensResponse)(nil), "nodeapi.ESDTTokensResponse")
+	proto.RegisterType((*KeyValuePair)(nil), "nodeapi.KeyValuePair")
+	proto.RegisterType((*KeyValuePairsBatch)(nil), "nodeapi.KeyValuePairsBatch")
+	proto.RegisterType((*IterateKeysRequest)(nil), "nodeapi.IterateKeysRequest")
+	proto.RegisterType((*BlockQueryOptions)(nil), "nodeapi.BlockQueryOptions")
+	proto.RegisterType((*BlockByHashRequest)(nil), "nodeapi.BlockByHashRequest")
+	proto.RegisterType((*BlockByNonceRequest)(nil), "nodeapi.BlockByNonceRequest")
+	proto.RegisterType((*BlockByRoundRequest)(nil), "nodeapi.BlockByRoundRequest")
+	proto.RegisterType((*BlockRangeRequest)(nil), "nodeapi.BlockRangeRequest")
+	proto.RegisterType((*TransactionResult)(nil), "nodeapi.TransactionResult")
+	proto.RegisterType((*MiniBlock)(nil), "nodeapi.MiniBlock")
+	proto.RegisterType((*Block)(nil), "nodeapi.Block")
+	proto.RegisterType((*Transaction)(nil), "nodeapi.Transaction")
+	proto.RegisterType((*SendTransactionsRequest)(nil), "nodeapi.SendTransactionsRequest")
+	proto.RegisterType((*SendTransactionsResponse)(nil), "nodeapi.SendTransactionsResponse")
+	proto.RegisterType((*SimulateTransactionRequest)(nil), "nodeapi.SimulateTransactionRequest")
+	proto.RegisterType((*SmartContractResult)(nil), "nodeapi.SmartContractResult")
+	proto.RegisterType((*Event)(nil), "nodeapi.Event")
+	proto.RegisterType((*Logs)(nil), "nodeapi.Logs")
+	proto.RegisterType((*SimulationResponse)(nil), "nodeapi.SimulationResponse")
+	proto.RegisterType((*TransactionCostResponse)(nil), "nodeapi.TransactionCostResponse")
+	proto.RegisterType((*VMQueryRequest)(nil), "nodeapi.VMQueryRequest")
+	proto.RegisterType((*VMQueryResponse)(nil), "nodeapi.VMQueryResponse")
+	proto.RegisterType((*ProofRequest)(nil), "nodeapi.ProofRequest")
+	proto.RegisterType((*ProofResponse)(nil), "nodeapi.ProofResponse")
+	proto.RegisterType((*ProofDataTrieRequest)(nil), "nodeapi.ProofDataTrieRequest")
+	proto.RegisterType((*ProofDataTrieResponse)(nil), "nodeapi.ProofDataTrieResponse")
+	proto.RegisterType((*VerifyProofRequest)(nil), "nodeapi.VerifyProofRequest")
+	proto.RegisterType((*VerifyProofResponse)(nil), "nodeapi.VerifyProofResponse")
+}
+
+func init() { proto.RegisterFile("nodeApi.proto", fileDescriptor_6aeeda6fa2765447) }
+
+var fileDescriptor_6aeeda6fa2765447 = []byte{
+	// 2402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6c, 0x1c, 0x49,
+	0xf5, 0x77, 0xcf, 0x97, 0x67, 0x9e, 0x67, 0xc6, 0x4e, 0x25, 0x9b, 0xcc, 0x7f, 0x36, 0xff, 0x91,
+	0xb7, 0x09, 0x91, 0x15, 0x20, 0x1b, 0x85, 0x55, 0x04, 0x68, 0xd9, 0x95, 0x3f, 0x12, 0xdb, 0x64,
+	0xfd, 0x91, 0x1a, 0x27, 0x91, 0x00, 0xad, 0xa8, 0xf4, 0x94, 0xed, 0x56, 0x66, 0xba, 0x87, 0xee,
+	0xea, 0x6c, 0x7c, 0x03, 0x89, 0x1b, 0x07, 0xb8, 0xa1, 0x3d, 0x70, 0xe2, 0xc2, 0x85, 0x13, 0x37,
+	0x2e, 0x5c, 0x11, 0xa7, 0x1c, 0x73, 0x24, 0xce, 0x05, 0x89, 0xcb, 0xde, 0x38, 0x70, 0x41, 0xf5,
+	0xd1, 0xd5, 0x55, 0xdd, 0x3d, 0x8e, 0x37, 0x91, 0x38, 0x79, 0xde, 0xaf, 0x5e, 0xbd, 0x7a, 0xf5,
+	0xbe, 0xea, 0xf5, 0x33, 0x74, 0x82, 0x70, 0x44, 0x57, 0xa7, 0xfe, 0xcd, 0x69, 0x14, 0xb2, 0x10,
+	0xcd, 0x73, 0x92, 0x4c, 0xfd, 0xfe, 0x77, 0x8e, 0x7c, 0x76, 0x9c, 0x3c, 0xb9, 0xe9, 0x85, 0x93,
+	0x0f, 0x8f, 0xc2, 0xa3, 0xf0, 0x43, 0xb1, 0xfe, 0x24, 0x39, 0x14, 0x94, 0x20, 0xc4, 0x2f, 0xb9,
+	0xcf, 0xfd, 0x77, 0x05, 0x2e, 0xae, 0x7a, 0x5e, 0x98, 0x04, 0xec, 0x41, 0x42, 0xa3, 0x93, 0xbd,
+	0x29, 0xf3, 0xc3, 0x20, 0x46, 0x2e, 0xb4, 0xf7, 0x82, 0x7b, 0x7e, 0x40, 0xc6, 0x6b, 0xe3, 0xd0,
+	0x7b, 0xda, 0x73, 0x96, 0x9d, 0x95, 0x26, 0xb6, 0x30, 0xf4, 0x6d, 0xb8, 0xb0, 0x45, 0xe2, 0xbd,
+	0x60, 0xc8, 0x48, 0xc4, 0xf6, 0x0e, 0xef, 0x4e, 0x43, 0xef, 0xb8, 0x57, 0x11, 0x8c, 0xc5, 0x05,
+	0x74, 0x1d, 0xba, 0x39, 0xd6, 0xea, 0xb2, 0xb3, 0xd2, 0xc1, 0x39, 0x14, 0x5d, 0x83, 0xce, 0x16,
+	0x89, 0xc5, 0x09, 0xbb, 0x61, 0xe0, 0xd1, 0x5e, 0x4d, 0x48, 0xb4, 0x41, 0x34, 0x00, 0x30, 0x58,
+	0xea, 0xcb, 0xce, 0x4a, 0x0d, 0x1b, 0x08, 0xba, 0x0a, 0x2d, 0x41, 0x6d, 0x91, 0xf8, 0xb8, 0xd7,
+	0x58, 0x76, 0x56, 0xda, 0x38, 0x03, 0xf8, 0x19, 0x82, 0xc0, 0x61, 0xc8, 0x04, 0xc7, 0xbc, 0xe0,
+	0xb0, 0x41, 0x6e, 0x83, 0x2d, 0x12, 0x6f, 0xf9, 0x01, 0x93, 0xfa, 0x36, 0xa5, 0x0d, 0x4c, 0x8c,
+	0x9f, 0x93, 0x31, 0xb4, 0xc4, 0x85, 0x32, 0x00, 0xf5, 0xa1, 0xf9, 0xd8, 0x67, 0xc7, 0xf7, 0xe9,
+	0x49, 0xdc, 0x03, 0xb1, 0x5b, 0xd3, 0xee, 0x03, 0xa5, 0xe1, 0x76, 0x70, 0x18, 0xa2, 0x4b, 0x50,
+	0x97, 0x37, 0x71, 0xc4, 0x4d, 0x24, 0x81, 0x10, 0xd4, 0x84, 0x76, 0xdc, 0xa6, 0x2d, 0x2c, 0x7e,
+	0x73, 0x91, 0x5a, 0xeb, 0xaa, 0xc0, 0x35, 0xed, 0x3e, 0x81, 0xae, 0xf2, 0x25, 0xa6, 0x3f, 0x4f,
+	0x68, 0xcc, 0x50, 0x0f, 0xe6, 0x57, 0x47, 0xa3, 0x88, 0xc6, 0xb1, 0x90, 0xdc, 0xc2, 0x29, 0x89,
+	0xee, 0xc0, 0xbc, 0xf2, 0xb5, 0x10, 0xbf, 0x70, 0xfb, 0xea, 0x4d, 0x15, 0x42, 0x37, 0x4b, 0xe2,
+	0x01, 0xa7, 0xcc, 0xee, 0x9f, 0x2a, 0x30, 0xaf, 0x18, 0xce, 0x90, 0xae, 0xef, 0x53, 0x31, 0xef,
+	0xd3, 0x83, 0xf9, 0x35, 0x32, 0x26, 0x1c, 0x97, 0xaa, 0xa7, 0x24, 0xbf, 0xd5, 0xc3, 0x98, 0x46,
+	0x01, 0x99, 0x48, 0x7f, 0xb7, 0xb0, 0xa6, 0xb9, 0x15, 0xd6, 0xc3, 0x91, 0x74, 0x72, 0x0b, 0x8b,
+	0xdf, 0x9c, 0x9f, 0xff, 0x35, 0xbc, 0xab, 0x69, 0xcb, 0x42, 0xd2, 0xaf, 0x4d, 0xd3, 0xa5, 0x9c,
+	0x6f, 0x87, 0x32, 0x32, 0x22, 0x8c, 0x08, 0x97, 0xb6, 0xb1, 0x85, 0xa1, 0x15, 0x58, 0xdc, 0xa0,
+	0xcf, 0xe8, 0x38, 0x9c, 0xd2, 0x08, 0xd3, 0x2f, 0x48, 0x34, 0x12, 0x8e, 0x6d, 0xe1, 0x3c, 0x2c,
+	0x92, 0xe4, 0x8b, 0x80, 0x46, 0xa9, 0x11, 0x40, 0xb0, 0x59, 0x98, 0x1b, 0xc2, 0xa2, 0xf6, 0x49,
+	0x3c, 0x0d, 0x83, 0x98, 0xa2, 0x1b, 0xda, 0x82, 0xc2, 0x6c, 0x0b, 0xb7, 0x97, 0xf2, 0xa6, 0xc7,
+	0xda, 0xc4, 0xb7, 0x8c, 0x28, 0x51, 0x8e, 0x42, 0x9a, 0x5b, 0xaf, 0xe0, 0x8c, 0xc9, 0xfd, 0x83,
+	0x03, 0x8b, 0x77, 0x87, 0x1b, 0x07, 0x1b, 0x84, 0x91, 0x37, 0x87, 0xc1, 0x0a, 0x2c, 0x1e, 0x84,
+	0x4f, 0x69, 0xb0, 0x3d, 0xa2, 0x01, 0xf3, 0x0f, 0x7d, 0x1a, 0xa9, 0x68, 0xcb, 0xc3, 0x99, 0x4b,
+	0xab, 0xa6, 0x4b, 0x8d, 0x30, 0xaa, 0x7d, 0xcd, 0x30, 0x6a, 0x71, 0x2d, 0xc5, 0x29, 0x65, 0x5a,
+	0x38, 0xe5, 0x5a, 0x20, 0xa8, 0x1d, 0x9c, 0x4c, 0x65, 0x5c, 0x75, 0xb0, 0xf8, 0x7d, 0x46, 0x58,
+	0x0d, 0x00, 0xf6, 0x23, 0xee, 0x30, 0xe6, 0x53, 0xa9, 0x60, 0x1b, 0x1b, 0x48, 0x76, 0xa7, 0x7a,
+	0x2e, 0xed, 0x76, 0x79, 0x20, 0xca, 0xc0, 0x12, 0xbf, 0xf9, 0x19, 0xeb, 0x11, 0x25, 0x2c, 0x8c,
+	0x44, 0x4c, 0xb5, 0x70, 0x4a, 0xf2, 0x0a, 0x80, 0xc3, 0x13, 0x32, 0x16, 0x47, 0x34, 0x65, 0x05,
+	0xd0, 0x80, 0x4e, 0xe1, 0x96, 0x94, 0xc5, 0x7f, 0x73, 0xec, 0x21, 0xde, 0xe6, 0xe1, 0x52, 0xe5,
+	0x18, 0xff, 0xcd, 0x35, 0x5d, 0x65, 0x2c, 0xf2, 0x9f, 0x24, 0x8c, 0xc6, 0xbd, 0x05, 0xa9, 0x69,
+	0x86, 0xb8, 0x01, 0x2c, 0x65, 0x4e, 0x55, 0x71, 0xb4, 0x02, 0x75, 0x61, 0x9e, 0x9e, 0x93, 0x8b,
+	0x0b, 0x6d, 0x58, 0x2c, 0x19, 0xde, 0x22, 0x8a, 0x22, 0x40, 0x5a, 0x4a, 0x6c, 0x44, 0x6e, 0x43,
+	0x22, 0x3d, 0x67, 0xb9, 0x3a, 0xe3, 0x48, 0xc5, 0xf1, 0x16, 0x67, 0xde, 0x81, 0xf6, 0x7d, 0x7a,
+	0xf2, 0x88, 0x8c, 0x13, 0xba, 0x4f, 0xfc, 0x08, 0x2d, 0x41, 0xf5, 0x3e, 0x3d, 0x51, 0x91, 0xc0,
+	0x7f, 0x72, 0x7f, 0x89, 0x65, 0x15, 0xa3, 0x92, 0x70, 0xbf, 0x74, 0x00, 0x99, 0x1b, 0xe3, 0x35,
+	0xc2, 0xbc, 0x63, 0xf4, 0x2d, 0xa8, 0x0b, 0x4a, 0xe9, 0xfa, 0x9e, 0x3e, 0xdc, 0xe4, 0xc5, 0x92,
+	0x87, 0xbf, 0x08, 0xdb, 0x8c, 0x46, 0xdc, 0xa3, 0x43, 0x46, 0x18, 0x3f, 0x81, 0x3b, 0xc7, 0x06,
+	0xed, 0x3b, 0x55, 0xcf, 0x73, 0xa7, 0x17, 0x0e, 0x20, 0x29, 0x83, 0xf2, 0xaa, 0x7f, 0xae, 0x84,
+	0xdc, 0x4d, 0x26, 0x9c, 0x77, 0x9f, 0x46, 0xe2, 0x22, 0x2a, 0xd6, 0xf3, 0x30, 0x97, 0xb1, 0x43,
+	0x9e, 0x73, 0x48, 0xa5, 0x64, 0x4a, 0x16, 0x2f, 0x53, 0x2b, 0xbb, 0x8c, 0x91, 0xba, 0xf5, 0xaf,
+	0x93, 0xba, 0x3f, 0x81, 0x0b, 0xe2, 0x7e, 0xe6, 0x2a, 0xba, 0x01, 0x4b, 0xfc, 0x65, 0x3b, 0x88,
+	0x48, 0x10, 0x13, 0x4f, 0x4a, 0x95, 0x3d, 0x43, 0x01, 0x4f, 0x5f, 0xc5, 0xcf, 0xc2, 0xa3, 0x58,
+	0xb5, 0x0b, 0x9a, 0x76, 0x3f, 0x07, 0x24, 0x84, 0xaf, 0x9d, 0xf0, 0x54, 0x49, 0xcd, 0x95, 0x66,
+	0x91, 0x63, 0x3c, 0x84, 0x1f, 0xe5, 0x1f, 0xb0, 0xbe, 0xed, 0x89, 0x72, 0xe5, 0x09, 0x5c, 0x54,
+	0xf2, 0x45, 0xae, 0xa7, 0x07, 0x94, 0xbf, 0xbf, 0xef, 0x7a, 0x04, 0x0e, 0x93, 0x60, 0x64, 0x1c,
+	0x21, 0xe8, 0xf4, 0x08, 0x41, 0xbc, 0xe5, 0x11, 0xbf, 0x74, 0x94, 0x0f, 0x30, 0x09, 0x8e, 0xf4,
+	0x25, 0xae, 0x42, 0xeb, 0x5e, 0x14, 0x4e, 0xcc, 0x8b, 0x64, 0x00, 0x0f, 0x97, 0x83, 0xd0, 0x7c,
+	0x94, 0x53, 0xd2, 0xd4, 0xa1, 0x7a, 0x7e, 0x1d, 0x7e, 0x5f, 0x81, 0x0b, 0x86, 0x5b, 0x31, 0x8d,
+	0x93, 0x71, 0xb9, 0xa7, 0xcc, 0x9a, 0xdd, 0x52, 0x35, 0xbb, 0xfc, 0x35, 0xd1, 0xf9, 0x5d, 0x33,
+	0xf2, 0x5b, 0x3c, 0xe8, 0xd4, 0xa3, 0xfe, 0x33, 0x1a, 0xa9, 0x26, 0x40, 0xd3, 0xe8, 0x32, 0x34,
+	0x86, 0x34, 0x18, 0xd1, 0x48, 0x54, 0xeb, 0x16, 0x56, 0x14, 0xdf, 0xb3, 0x49, 0xe2, 0xfd, 0xc8,
+	0xf7, 0xa8, 0x28, 0xd8, 0x35, 0xac, 0x69, 0xb5, 0xf6, 0x99, 0x3f, 0xf1, 0x59, 0xaf, 0xa9, 0xd7,
+	0x04, 0xcd, 0x75, 0xe5, 0x35, 0x36, 0xad, 0xd7, 0xfc, 0x37, 0xb7, 0xeb, 0xd0, 0x3f, 0x0a, 0x08,
+	0x4b, 0x22, 0xaa, 0xde, 0xf8, 0x0c, 0x10, 0x1a, 0x30, 0xc2, 0x12, 0x59, 0xb5, 0x5b, 0x58, 0x51,
+	0xee, 0xef, 0x2a, 0xd0, 0xda, 0xf1, 0x03, 0x5f, 0xf6, 0xca, 0xe7, 0xb5, 0xcb, 0x75, 0xe8, 0xee,
+	0x47, 0xa1, 0x47, 0xe3, 0xd8, 0x0f, 0x8e, 0xc4, 0xaa, 0x7c, 0xd2, 0x72, 0x28, 0xef, 0xbd, 0xd7,
+	0xc3, 0x20, 0x66, 0x51, 0x22, 0xac, 0x9f, 0xa6, 0x39, 0x67, 0x2d, 0x2e, 0xa0, 0x65, 0x58, 0x18,
+	0x86, 0x49, 0xe4, 0xd1, 0xe1, 0x31, 0x6f, 0x67, 0xea, 0xa2, 0xa0, 0x98, 0x10, 0xcf, 0xdf, 0x0d,
+	0x1a, 0x33, 0x3f, 0x20, 0x62, 0x97, 0x60, 0x6b, 0x08, 0xb6, 0x02, 0x8e, 0x3e, 0x81, 0xb6, 0x95,
+	0xe7, 0xf3, 0xcb, 0x55, 0x2b, 0x68, 0x0a, 0x51, 0x81, 0x2d, 0x7e, 0xf7, 0xcf, 0x55, 0xa8, 0x4b,
+	0xab, 0x94, 0xa7, 0x9d, 0xce, 0x94, 0x8a, 0x99, 0x29, 0x97, 0xa0, 0x6e, 0x7e, 0x36, 0x48, 0x82,
+	0xa3, 0x52, 0xd9, 0x9a, 0x44, 0xa5, 0x86, 0x97, 0xa1, 0xb1, 0x9b, 0x4c, 0x0e, 0x9e, 0xc7, 0xea,
+	0xaa, 0x8a, 0xd2, 0x5e, 0x68, 0x18, 0x5e, 0xb8, 0x06, 0x9d, 0xfd, 0x88, 0x3e, 0xcb, 0xbe, 0x16,
+	0xe4, 0xfb, 0x6e, 0x83, 0x9c, 0x4b, 0x98, 0x52, 0x77, 0x96, 0x4d, 0xc9, 0x65, 0x81, 0xbc, 0x78,
+	0xaf, 0x7a, 0x5e, 0x32, 0x49, 0xc6, 0x84, 0xd1, 0xd1, 0x3d, 0x4a, 0xe3, 0xb4, 0x75, 0xcc, 0xc1,
+	0x5c, 0x9e, 0xee, 0x26, 0x05, 0x9f, 0x8c, 0x2b, 0x1b, 0x9c, 0x15, 0x5b, 0x3c, 0x22, 0x0f, 0xfc,
+	0x09, 0x8d, 0x19, 0x99, 0x4c, 0x7b, 0xed, 0x65, 0x67, 0xa5, 0x8a, 0x33, 0x80, 0x7b, 0x5b, 0x13,
+	0x3b, 0x71, 0xaf, 0x23, 0xd6, 0x4d, 0x08, 0xdd, 0x06, 0xd0, 0xa1, 0x19, 0xf7, 0xba, 0xb9, 0xb7,
+	0x5c, 0x2f, 0x61, 0x83, 0xcb, 0xfd, 0xb2, 0x06, 0x0b, 0x86, 0x1b, 0x67, 0xfb, 0xae, 0xf8, 0x42,
+	0x5b, 0x19, 0x5c, 0x9d, 0x99, 0xc1, 0x35, 0x2b, 0x83, 0x6f, 0xc0, 0x52, 0xca, 0xa3, 0x3f, 0x0d,
+	0xea, 0x22, 0x2b, 0x0b, 0x38, 0xcf, 0x1a, 0xb9, 0x4b, 0x73, 0xca, 0xde, 0x2d, 0x87, 0xfe, 0x0f,
+	0xab, 0x02, 0xef, 0x17, 0x8f, 0x89, 0x1f, 0x6c, 0x6f, 0x28, 0xd7, 0xa5, 0x24, 0x5f, 0x79, 0x44,
+	0xa3, 0xd8, 0x0f, 0x03, 0xe1, 0xb9, 0x0e, 0x4e, 0x49, 0xbe, 0x92, 0xd6, 0xe1, 0x8e, 0x5c, 0x31,
+	0xbe, 0xc6, 0x37, 0x13, 0x12, 0x8d, 0x7c, 0x12, 0xf0, 0x3e, 0xa1, 0xd7, 0x95, 0x1f, 0x1a, 0x26,
+	0xc6, 0x2b, 0x42, 0x4a, 0x67, 0x7a, 0x2d, 0xca, 0x8a, 0x50, 0x58, 0xe0, 0x31, 0x82, 0xe9, 0x98,
+	0x9c, 0xc8, 0x0f, 0x95, 0xde, 0x92, 0xe0, 0x33, 0x21, 0x69, 0x7f, 0x41, 0x66, 0xe2, 0x2e, 0x08,
+	0xb6, 0x02, 0xee, 0x0e, 0xe1, 0x0a, 0xb7, 0xb4, 0x99, 0xe5, 0xe9, 0xa3, 0xf4, 0xbd, 0x5c, 0xb1,
+	0x90, 0xcd, 0xd8, 0xa5, 0xd2, 0x62, 0x61, 0x97, 0x89, 0x9f, 0x42, 0xaf, 0x28, 0x54, 0x35, 0xa2,
+	0x2e, 0xb4, 0x77, 0x93, 0xc9, 0xde, 0xe1, 0x90, 0x06, 0x8c, 0xa7, 0xb9, 0x8c, 0x41, 0x0b, 0x13,
+	0x49, 0xf2, 0x3c, 0xe6, 0x79, 0x49, 0x63, 0xd1, 0xce, 0xb5, 0x70, 0x06, 0xb8, 0xbf, 0x72, 0xa0,
+	0x3f, 0xf4, 0x65, 0x4a, 0x5a, 0x05, 0x4b, 0xaa, 0x7d, 0xc7, 0x0a, 0x76, 0xd5, 0x61, 0x97, 0x6b,
+	0x6d, 0x65, 0xc5, 0x4d, 0x40, 0xc3, 0xa7, 0xfe, 0x54, 0x9b, 0x66, 0xfd, 0x98, 0x7a, 0x4f, 0x55,
+	0x97, 0x53, 0xb2, 0xe2, 0xfe, 0xc7, 0x81, 0x8b, 0xc3, 0x09, 0x89, 0xd8, 0x7a, 0x18, 0xb0, 0x88,
+	0x78, 0xec, 0x8c, 0x77, 0xb4, 0xfc, 0xa3, 0x5a, 0x67, 0x5c, 0x75, 0x56, 0xc6, 0xd5, 0x66, 0x66,
+	0x5c, 0xdd, 0xca, 0xb8, 0x34, 0xca, 0x1b, 0x46, 0x94, 0x9b, 0x59, 0x31, 0x9f, 0xcb, 0x0a, 0x33,
+	0x9b, 0x9a, 0xb9, 0x6c, 0xba, 0x06, 0x1d, 0x4c, 0x59, 0x12, 0x05, 0x3b, 0x34, 0x8e, 0xc9, 0x11,
+	0x55, 0x75, 0xd0, 0x06, 0xdd, 0x09, 0xd4, 0xef, 0x3e, 0xa3, 0x67, 0x4e, 0x12, 0x06, 0x00, 0x85,
+	0x6f, 0x53, 0x03, 0xe1, 0x97, 0x39, 0x08, 0xa7, 0xbe, 0xc7, 0x7b, 0x17, 0xde, 0xe4, 0x2a, 0x4a,
+	0x5f, 0xa6, 0x96, 0x5d, 0xc6, 0xdd, 0x82, 0x1a, 0x6f, 0x32, 0xcf, 0x38, 0xed, 0x3a, 0x34, 0x84,
+	0x42, 0x32, 0x60, 0x16, 0x6e, 0x77, 0xb3, 0x0f, 0x1c, 0x0e, 0x63, 0xb5, 0xea, 0xfe, 0xd5, 0x01,
+	0xa4, 0xa2, 0x47, 0xbe, 0x72, 0x32, 0x2c, 0xcb, 0xbc, 0x96, 0xd5, 0xf0, 0x8a, 0x55, 0xc3, 0x07,
+	0x00, 0xf7, 0x88, 0x3f, 0xc6, 0x94, 0xc4, 0x61, 0xa0, 0x9c, 0x67, 0x20, 0xe8, 0x07, 0xd0, 0x1a,
+	0x7a, 0x32, 0x1a, 0x62, 0xd1, 0xc0, 0x9b, 0x0d, 0x7a, 0x49, 0xc8, 0xe0, 0x8c, 0x1d, 0x7d, 0x20,
+	0x2f, 0xaa, 0xfa, 0xfa, 0x8e, 0xde, 0xc6, 0x41, 0x2c, 0x96, 0xdc, 0xbf, 0x38, 0x70, 0xc5, 0x08,
+	0xdc, 0xf5, 0x30, 0xce, 0x06, 0x14, 0xd2, 0xb1, 0x0f, 0x03, 0x9f, 0xa5, 0x99, 0xa5, 0xe9, 0xa2,
+	0x63, 0x2b, 0x25, 0x8e, 0xb5, 0x95, 0xaf, 0xbe, 0x9d, 0xf2, 0xb5, 0xd9, 0xca, 0xff, 0xbd, 0x02,
+	0xdd, 0x47, 0x3b, 0xa2, 0x2f, 0x35, 0x9a, 0xdf, 0xa1, 0x67, 0x7b, 0x35, 0x03, 0xf8, 0x8d, 0xee,
+	0x25, 0x81, 0x27, 0x3e, 0xeb, 0xa5, 0xc2, 0x9a, 0xe6, 0x8e, 0x58, 0x27, 0xe3, 0xb1, 0xaa, 0x84,
+	0xca, 0x11, 0x19, 0xc2, 0x25, 0x73, 0xca, 0x6c, 0x4c, 0x33, 0x80, 0xbb, 0x7c, 0x35, 0x12, 0xa6,
+	0x16, 0x1f, 0xf3, 0xfc, 0xb7, 0x68, 0xb7, 0xc8, 0x84, 0x0e, 0x3d, 0xd9, 0x96, 0x35, 0x44, 0xf6,
+	0x9b, 0x90, 0x78, 0xb0, 0x8e, 0xc3, 0x64, 0x3c, 0x5a, 0xa3, 0xc3, 0x93, 0xc0, 0xa3, 0x23, 0x91,
+	0x5c, 0x4d, 0x9c, 0x43, 0x8b, 0xc3, 0xd0, 0xe6, 0x9b, 0x87, 0xa1, 0xad, 0xb3, 0x87, 0xa1, 0x90,
+	0x1b, 0x86, 0xba, 0xff, 0x72, 0x60, 0x51, 0x1b, 0x53, 0x45, 0xc0, 0x00, 0x40, 0x3a, 0x54, 0xe4,
+	0x90, 0x23, 0xee, 0x66, 0x20, 0xd9, 0xba, 0x98, 0xcc, 0xa9, 0xac, 0xcc, 0x90, 0x62, 0x94, 0x54,
+	0xcb, 0xa2, 0x84, 0x3f, 0x6b, 0x24, 0xc6, 0x74, 0x42, 0xfc, 0xc0, 0x0f, 0x8e, 0x84, 0x71, 0x6b,
+	0xd8, 0xc2, 0xb8, 0xee, 0x82, 0x3e, 0xe4, 0x0d, 0xa1, 0xac, 0x57, 0x19, 0x60, 0x7f, 0x90, 0x37,
+	0xce, 0xf3, 0x41, 0xbe, 0x01, 0xed, 0xfd, 0x28, 0x0c, 0x0f, 0xd3, 0xb8, 0x31, 0xa7, 0x85, 0x8e,
+	0x3d, 0x4f, 0x35, 0xeb, 0x44, 0xc5, 0xaa, 0x13, 0xee, 0x63, 0xe8, 0x28, 0x29, 0xca, 0x60, 0x97,
+	0xa0, 0x2e, 0x00, 0x65, 0x2b, 0x49, 0xd8, 0xdd, 0x50, 0xdb, 0xac, 0xcd, 0xb3, 0x46, 0xb8, 0x9f,
+	0xc3, 0x25, 0xb1, 0x95, 0x5b, 0xf9, 0x20, 0xf2, 0xe9, 0x3b, 0xa9, 0x99, 0x4e, 0x50, 0xaa, 0x7a,
+	0x82, 0xe2, 0xfe, 0xda, 0x81, 0xf7, 0x72, 0x07, 0xa8, 0x1b, 0x7c, 0x04, 0xad, 0x1d, 0xe2, 0x07,
+	0xe9, 0x2d, 0xb8, 0x29, 0x2f, 0x6b, 0x53, 0x5a, 0x97, 0xc5, 0x19, 0x23, 0xfa, 0x18, 0x3a, 0xa9,
+	0x24, 0xb9, 0xb3, 0x72, 0xe6, 0x4e, 0x9b, 0xd9, 0xfd, 0x19, 0xa0, 0x47, 0x34, 0xf2, 0x0f, 0x4f,
+	0xde, 0xdd, 0x25, 0x99, 0x07, 0xaa, 0x86, 0x07, 0xdc, 0x6f, 0xc2, 0x45, 0xeb, 0x04, 0x75, 0xd9,
+	0x2e, 0x54, 0xf6, 0xd2, 0x7f, 0x6a, 0x54, 0xf6, 0x9e, 0xde, 0xfe, 0x4d, 0x0b, 0xe6, 0x77, 0xf9,
+	0x3f, 0x54, 0xf6, 0xb7, 0xd1, 0xa7, 0x00, 0x9b, 0x94, 0xa5, 0x03, 0xd8, 0x2b, 0x85, 0xd9, 0xac,
+	0xd4, 0xb2, 0xdf, 0x2b, 0x2e, 0x28, 0xe1, 0x6b, 0xb0, 0xb0, 0x49, 0x59, 0x3a, 0xae, 0x43, 0x3d,
+	0x6b, 0x48, 0x66, 0x8c, 0x65, 0xfb, 0xff, 0x57, 0xb2, 0xa2, 0x64, 0x6c, 0xc1, 0x12, 0x57, 0x62,
+	0x3c, 0xce, 0xa6, 0x70, 0xb3, 0x55, 0x79, 0xbf, 0x38, 0x86, 0xcb, 0x5a, 0xa5, 0x1f, 0x09, 0x49,
+	0xd6, 0x7c, 0xec, 0x3c, 0x92, 0x8a, 0x03, 0xb5, 0x5b, 0x0e, 0xda, 0x86, 0x05, 0x63, 0x98, 0x85,
+	0x32, 0xee, 0xe2, 0x88, 0xeb, 0x4d, 0xa2, 0x7e, 0x08, 0xdd, 0x4d, 0xca, 0x8c, 0x59, 0x8f, 0x21,
+	0xad, 0x38, 0x01, 0xea, 0x77, 0xed, 0x45, 0xf4, 0x29, 0x2c, 0x66, 0xdb, 0x55, 0x95, 0xcb, 0xef,
+	0x37, 0x27, 0x3c, 0x67, 0x0b, 0x90, 0x5f, 0x98, 0x05, 0x01, 0xe6, 0xfc, 0xa6, 0x20, 0xe0, 0x63,
+	0x68, 0x0f, 0x59, 0x44, 0xc9, 0x44, 0x90, 0x31, 0xca, 0x0d, 0x4d, 0xcc, 0xc9, 0x4c, 0x7e, 0xef,
+	0x2d, 0x07, 0x3d, 0x86, 0xa5, 0x7c, 0x73, 0x8b, 0x96, 0xb3, 0x17, 0xb2, 0xbc, 0x99, 0xee, 0x7f,
+	0x70, 0x06, 0x87, 0x72, 0xf7, 0x63, 0xb8, 0x58, 0xd2, 0xd6, 0xa2, 0x6f, 0x64, 0x3b, 0x67, 0x36,
+	0xbd, 0xfd, 0xf7, 0xf3, 0x4c, 0x66, 0x6f, 0xb3, 0x0f, 0x97, 0xd7, 0xc3, 0xc9, 0x34, 0x61, 0x34,
+	0xd7, 0x36, 0xa0, 0xd2, 0xb6, 0xb8, 0xbf, 0x5c, 0x86, 0x5a, 0x6d, 0xc6, 0x3a, 0x74, 0xef, 0x3e,
+	0xa7, 0x5e, 0xc2, 0xa8, 0x7a, 0x7e, 0x8c, 0xb8, 0xb4, 0x5f, 0xf7, 0x7e, 0xaf, 0xb8, 0xa0, 0x84,
+	0x7c, 0x1f, 0x9a, 0x9b, 0x94, 0xc9, 0x62, 0xf4, 0x5e, 0xbe, 0xea, 0xc8, 0xcd, 0x33, 0x8a, 0x11,
+	0x7a, 0x20, 0x32, 0xc3, 0xaa, 0x86, 0xe8, 0xff, 0x6d, 0xde, 0x5c, 0x19, 0xee, 0x0f, 0x66, 0x2d,
+	0xeb, 0xb4, 0x5d, 0x30, 0xca, 0x8d, 0x11, 0xd2, 0xc5, 0x32, 0xd7, 0xbf, 0x5a, 0xbe, 0x28, 0x25,
+	0xad, 0x7d, 0xf2, 0xe2, 0xd5, 0x60, 0xee, 0xe5, 0xab, 0xc1, 0xdc, 0x57, 0xaf, 0x06, 0xce, 0x2f,
+	0x4e, 0x07, 0xce, 0x1f, 0x4f, 0x07, 0xce, 0xdf, 0x4e, 0x07, 0xce, 0x8b, 0xd3, 0x81, 0xf3, 0xf2,
+	0x74, 0xe0, 0xfc, 0xe3, 0x74, 0xe0, 0xfc, 0xf3, 0x74, 0x30, 0xf7, 0xd5, 0xe9, 0xc0, 0xf9, 0xed,
+	0xeb, 0xc1, 0xdc, 0x8b, 0xd7, 0x83, 0xb9, 0x97, 0xaf, 0x07, 0x73, 0x3f, 0xae, 0x1d, 0x45, 0x53,
+	0xef, 0x49, 0x43, 0xfc, 0x7f, 0xf7, 0xbb, 0xff, 0x1d, 0x00, 0x4a, 0xeb, 0xd2, 0x98, 0x28, 0x1e,
+	0x00, 0x00,
+}
+
+func (this *AccountQueryOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountQueryOptions)
+	if !ok {
+		that2, ok := that.(AccountQueryOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OnFinalBlock != that1.OnFinalBlock {
+		return false
+	}
+	if this.HasOnStartOfEpoch != that1.HasOnStartOfEpoch {
+		return false
+	}
+	if this.OnStartOfEpoch != that1.OnStartOfEpoch {
+		return false
+	}
+	if this.HasBlockNonce != that1.HasBlockNonce {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	if !bytes.Equal(this.BlockRootHash, that1.BlockRootHash) {
+		return false
+	}
+	if this.HasHintEpoch != that1.HasHintEpoch {
+		return false
+	}
+	if this.HintEpoch != that1.HintEpoch {
+		return false
+	}
+	if this.WithKeys != that1.WithKeys {
+		return false
+	}
+	return true
+}
+func (this *BlockInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockInfo)
+	if !ok {
+		that2, ok := that.(BlockInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.RootHash != that1.RootHash {
+		return false
+	}
+	return true
+}
+func (this *AccountRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountRequest)
+	if !ok {
+		that2, ok := that.(AccountRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	return true
+}
+func (this *Account) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Account)
+	if !ok {
+		that2, ok := that.(Account)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
+	}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
+		return false
+	}
+	if !bytes.Equal(this.CodeMetadata, that1.CodeMetadata) {
+		return false
+	}
+	if this.DeveloperReward != that1.DeveloperReward {
+		return false
+	}
+	if this.OwnerAddress != that1.OwnerAddress {
+		return false
+	}
+	return true
+}
+func (this *AccountResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountResponse)
+	if !ok {
+		that2, ok := that.(AccountResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Account.Equal(that1.Account) {
+		return false
+	}
+	if !this.BlockInfo.Equal(that1.BlockInfo) {
+		return false
+	}
+	return true
+}
+func (this *ESDTDataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTDataRequest)
+	if !ok {
+		that2, ok := that.(ESDTDataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.TokenIdentifier != that1.TokenIdentifier {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	return true
+}
+func (this *ESDTToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTToken)
+	if !ok {
+		that2, ok := that.(ESDTToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TokenIdentifier != that1.TokenIdentifier {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if !bytes.Equal(this.Properties, that1.Properties) {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if !bytes.Equal(this.Name, that1.Name) {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.Royalties != that1.Royalties {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if len(this.URIs) != len(that1.URIs) {
+		return false
+	}
+	for i := range this.URIs {
+		if !bytes.Equal(this.URIs[i], that1.URIs[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Attributes, that1.Attributes) {
+		return false
+	}
+	return true
+}
+func (this *ESDTDataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTDataResponse)
+	if !ok {
+		that2, ok := that.(ESDTDataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Token.Equal(that1.Token) {
+		return false
+	}
+	if !this.BlockInfo.Equal(that1.BlockInfo) {
+		return false
+	}
+	return true
+}
+func (this *ESDTTokensResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTTokensResponse)
+	if !ok {
+		that2, ok := that.(ESDTTokensResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	if !this.BlockInfo.Equal(that1.BlockInfo) {
+		return false
+	}
+	return true
+}
+func (this *KeyValuePair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KeyValuePair)
+	if !ok {
+		that2, ok := that.(KeyValuePair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
+func (this *KeyValuePairsBatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KeyValuePairsBatch)
+	if !ok {
+		that2, ok := that.(KeyValuePairsBatch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Pairs) != len(that1.Pairs) {
+		return false
+	}
+	for i := range this.Pairs {
+		if !this.Pairs[i].Equal(that1.Pairs[i]) {
+			return false
+		}
+	}
+	if len(this.IteratorState) != len(that1.IteratorState) {
+		return false
+	}
+	for i := range this.IteratorState {
+		if !bytes.Equal(this.IteratorState[i], that1.IteratorState[i]) {
+			return false
+		}
+	}
+	if !this.BlockInfo.Equal(that1.BlockInfo) {
+		return false
+	}
+	return true
+}
+func (this *IterateKeysRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IterateKeysRequest)
+	if !ok {
+		that2, ok := that.(IterateKeysRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.NumKeysPerBatch != that1.NumKeysPerBatch {
+		return false
+	}
+	if this.MaxKeys != that1.MaxKeys {
+		return false
+	}
+	if len(this.IteratorState) != len(that1.IteratorState) {
+		return false
+	}
+	for i := range this.IteratorState {
+		if !bytes.Equal(this.IteratorState[i], that1.IteratorState[i]) {
+			return false
+		}
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	return true
+}
+func (this *BlockQueryOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockQueryOptions)
+	if !ok {
+		that2, ok := that.(BlockQueryOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WithTransactions != that1.WithTransactions {
+		return false
+	}
+	if this.WithLogs != that1.WithLogs {
+		return false
+	}
+	return true
+}
+func (this *BlockByHashRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockByHashRequest)
+	if !ok {
+		that2, ok := that.(BlockByHashRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	return true
+}
+func (this *BlockByNonceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockByNonceRequest)
+	if !ok {
+		that2, ok := that.(BlockByNonceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	return true
+}
+func (this *BlockByRoundRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockByRoundRequest)
+	if !ok {
+		that2, ok := that.(BlockByRoundRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	return true
+}
+func (this *BlockRangeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockRangeRequest)
+	if !ok {
+		that2, ok := that.(BlockRangeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FromNonce != that1.FromNonce {
+		return false
+	}
+	if this.ToNonce != that1.ToNonce {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	return true
+}
+func (this *TransactionResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionResult)
+	if !ok {
+		that2, ok := that.(TransactionResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.GasPrice != that1.GasPrice {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Signature != that1.Signature {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	return true
+}
+func (this *MiniBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MiniBlock)
+	if !ok {
+		that2, ok := that.(MiniBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.ProcessingType != that1.ProcessingType {
+		return false
+	}
+	if this.ConstructionState != that1.ConstructionState {
+		return false
+	}
+	if this.SourceShard != that1.SourceShard {
+		return false
+	}
+	if this.DestinationShard != that1.DestinationShard {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Block) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Block)
+	if !ok {
+		that2, ok := that.(Block)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Shard != that1.Shard {
+		return false
+	}
+	if this.NumTxs != that1.NumTxs {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.PrevBlockHash != that1.PrevBlockHash {
+		return false
+	}
+	if this.StateRootHash != that1.StateRootHash {
+		return false
+	}
+	if this.AccumulatedFees != that1.AccumulatedFees {
+		return false
+	}
+	if this.DeveloperFees != that1.DeveloperFees {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.TimestampMs != that1.TimestampMs {
+		return false
+	}
+	if len(this.MiniBlocks) != len(that1.MiniBlocks) {
+		return false
+	}
+	for i := range this.MiniBlocks {
+		if !this.MiniBlocks[i].Equal(that1.MiniBlocks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Transaction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Transaction)
+	if !ok {
+		that2, ok := that.(Transaction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if !bytes.Equal(this.ReceiverUsername, that1.ReceiverUsername) {
+		return false
+	}
+	if !bytes.Equal(this.SenderUsername, that1.SenderUsername) {
+		return false
+	}
+	if this.GasPrice != that1.GasPrice {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Signature != that1.Signature {
+		return false
+	}
+	if this.ChainID != that1.ChainID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Options != that1.Options {
+		return false
+	}
+	if this.GuardianAddr != that1.GuardianAddr {
+		return false
+	}
+	if this.GuardianSignature != that1.GuardianSignature {
+		return false
+	}
+	if this.RelayerAddr != that1.RelayerAddr {
+		return false
+	}
+	if this.RelayerSignature != that1.RelayerSignature {
+		return false
+	}
+	return true
+}
+func (this *SendTransactionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendTransactionsRequest)
+	if !ok {
+		that2, ok := that.(SendTransactionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SendTransactionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendTransactionsResponse)
+	if !ok {
+		that2, ok := that.(SendTransactionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumOfSentTxs != that1.NumOfSentTxs {
+		return false
+	}
+	if len(this.TxsHashes) != len(that1.TxsHashes) {
+		return false
+	}
+	for i := range this.TxsHashes {
+		if this.TxsHashes[i] != that1.TxsHashes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *SimulateTransactionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SimulateTransactionRequest)
+	if !ok {
+		that2, ok := that.(SimulateTransactionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Transaction.Equal(that1.Transaction) {
+		return false
+	}
+	if this.SkipSignatureCheck != that1.SkipSignatureCheck {
+		return false
+	}
+	return true
+}
+func (this *SmartContractResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SmartContractResult)
+	if !ok {
+		that2, ok := that.(SmartContractResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.GasPrice != that1.GasPrice {
+		return false
+	}
+	if this.ReturnMessage != that1.ReturnMessage {
+		return false
+	}
+	return true
+}
+func (this *Event) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Event)
+	if !ok {
+		that2, ok := that.(Event)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Identifier != that1.Identifier {
+		return false
+	}
+	if len(this.Topics) != len(that1.Topics) {
+		return false
+	}
+	for i := range this.Topics {
+		if !bytes.Equal(this.Topics[i], that1.Topics[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *Logs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Logs)
+	if !ok {
+		that2, ok := that.(Logs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Events) != len(that1.Events) {
+		return false
+	}
+	for i := range this.Events {
+		if !this.Events[i].Equal(that1.Events[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SimulationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SimulationResponse)
+	if !ok {
+		that2, ok := that.(SimulationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.FailReason != that1.FailReason {
+		return false
+	}
+	if len(this.ScResults) != len(that1.ScResults) {
+		return false
+	}
+	for i := range this.ScResults {
+		if !this.ScResults[i].Equal(that1.ScResults[i]) {
+			return false
+		}
+	}
+	if !this.Logs.Equal(that1.Logs) {
+		return false
+	}
+	return true
+}
+func (this *TransactionCostResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionCostResponse)
+	if !ok {
+		that2, ok := that.(TransactionCostResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.GasUnits != that1.GasUnits {
+		return false
+	}
+	if this.ReturnMessage != that1.ReturnMessage {
+		return false
+	}
+	if len(this.ScResults) != len(that1.ScResults) {
+		return false
+	}
+	for i := range this.ScResults {
+		if !this.ScResults[i].Equal(that1.ScResults[i]) {
+			return false
+		}
+	}
+	if !this.Logs.Equal(that1.Logs) {
+		return false
+	}
+	return true
+}
+func (this *VMQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VMQueryRequest)
+	if !ok {
+		that2, ok := that.(VMQueryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScAddress != that1.ScAddress {
+		return false
+	}
+	if this.FuncName != that1.FuncName {
+		return false
+	}
+	if this.CallerAddr != that1.CallerAddr {
+		return false
+	}
+	if this.CallValue != that1.CallValue {
+		return false
+	}
+	if len(this.Args) != len(that1.Args) {
+		return false
+	}
+	for i := range this.Args {
+		if !bytes.Equal(this.Args[i], that1.Args[i]) {
+			return false
+		}
+	}
+	if this.SameScState != that1.SameScState {
+		return false
+	}
+	if this.ShouldBeSynced != that1.ShouldBeSynced {
+		return false
+	}
+	if this.HasBlockNonce != that1.HasBlockNonce {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	return true
+}
+func (this *VMQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VMQueryResponse)
+	if !ok {
+		that2, ok := that.(VMQueryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ReturnData) != len(that1.ReturnData) {
+		return false
+	}
+	for i := range this.ReturnData {
+		if !bytes.Equal(this.ReturnData[i], that1.ReturnData[i]) {
+			return false
+		}
+	}
+	if this.ReturnCode != that1.ReturnCode {
+		return false
+	}
+	if this.ReturnMessage != that1.ReturnMessage {
+		return false
+	}
+	if this.GasRemaining != that1.GasRemaining {
+		return false
+	}
+	if this.GasRefund != that1.GasRefund {
+		return false
+	}
+	if !this.BlockInfo.Equal(that1.BlockInfo) {
+		return false
+	}
+	return true
+}
+func (this *ProofRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProofRequest)
+	if !ok {
+		that2, ok := that.(ProofRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RootHash != that1.RootHash {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+func (this *ProofResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProofResponse)
+	if !ok {
+		that2, ok := that.(ProofResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Proof) != len(that1.Proof) {
+		return false
+	}
+	for i := range this.Proof {
+		if !bytes.Equal(this.Proof[i], that1.Proof[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
+	}
+	if this.RootHash != that1.RootHash {
+		return false
+	}
+	return true
+}
+func (this *ProofDataTrieRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProofDataTrieRequest)
+	if !ok {
+		that2, ok := that.(ProofDataTrieRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RootHash != that1.RootHash {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	return true
+}
+func (this *ProofDataTrieResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProofDataTrieResponse)
+	if !ok {
+		that2, ok := that.(ProofDataTrieResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MainProof.Equal(that1.MainProof) {
+		return false
+	}
+	if !this.DataTrieProof.Equal(that1.DataTrieProof) {
+		return false
+	}
+	return true
+}
+func (this *VerifyProofRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VerifyProofRequest)
+	if !ok {
+		that2, ok := that.(VerifyProofRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RootHash != that1.RootHash {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Proof) != len(that1.Proof) {
+		return false
+	}
+	for i := range this.Proof {
+		if !bytes.Equal(this.Proof[i], that1.Proof[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *VerifyProofResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VerifyProofResponse)
+	if !ok {
+		that2, ok := that.(VerifyProofResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Ok != that1.Ok {
+		return false
+	}
+	return true
+}
+func (this *AccountQueryOptions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&grpc.AccountQueryOptions{")
+	s = append(s, "OnFinalBlock: "+fmt.Sprintf("%#v", this.OnFinalBlock)+",\n")
+	s = append(s, "HasOnStartOfEpoch: "+fmt.Sprintf("%#v", this.HasOnStartOfEpoch)+",\n")
+	s = append(s, "OnStartOfEpoch: "+fmt.Sprintf("%#v", this.OnStartOfEpoch)+",\n")
+	s = append(s, "HasBlockNonce: "+fmt.Sprintf("%#v", this.HasBlockNonce)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "BlockRootHash: "+fmt.Sprintf("%#v", this.BlockRootHash)+",\n")
+	s = append(s, "HasHintEpoch: "+fmt.Sprintf("%#v", this.HasHintEpoch)+",\n")
+	s = append(s, "HintEpoch: "+fmt.Sprintf("%#v", this.HintEpoch)+",\n")
+	s = append(s, "WithKeys: "+fmt.Sprintf("%#v", this.WithKeys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpc.BlockInfo{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.AccountRequest{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Account) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&grpc.Account{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "Username: "+fmt.Sprintf("%#v", this.Username)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "CodeHash: "+fmt.Sprintf("%#v", this.CodeHash)+",\n")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "CodeMetadata: "+fmt.Sprintf("%#v", this.CodeMetadata)+",\n")
+	s = append(s, "DeveloperReward: "+fmt.Sprintf("%#v", this.DeveloperReward)+",\n")
+	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.AccountResponse{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	if this.BlockInfo != nil {
+		s = append(s, "BlockInfo: "+fmt.Sprintf("%#v", this.BlockInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTDataRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&grpc.ESDTDataRequest{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "TokenIdentifier: "+fmt.Sprintf("%#v", this.TokenIdentifier)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTToken) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&grpc.ESDTToken{")
+	s = append(s, "TokenIdentifier: "+fmt.Sprintf("%#v", this.TokenIdentifier)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "Properties: "+fmt.Sprintf("%#v", this.Properties)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Creator: "+fmt.Sprintf("%#v", this.Creator)+",\n")
+	s = append(s, "Royalties: "+fmt.Sprintf("%#v", this.Royalties)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "URIs: "+fmt.Sprintf("%#v", this.URIs)+",\n")
+	s = append(s, "Attributes: "+fmt.Sprintf("%#v", this.Attributes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTDataResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.ESDTDataResponse{")
+	if this.Token != nil {
+		s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	}
+	if this.BlockInfo != nil {
+		s = append(s, "BlockInfo: "+fmt.Sprintf("%#v", this.BlockInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTTokensResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.ESDTTokensResponse{")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	if this.BlockInfo != nil {
+		s = append(s, "BlockInfo: "+fmt.Sprintf("%#v", this.BlockInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KeyValuePair) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.KeyValuePair{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KeyValuePairsBatch) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpc.KeyValuePairsBatch{")
+	if this.Pairs != nil {
+		s = append(s, "Pairs: "+fmt.Sprintf("%#v", this.Pairs)+",\n")
+	}
+	s = append(s, "IteratorState: "+fmt.Sprintf("%#v", this.IteratorState)+",\n")
+	if this.BlockInfo != nil {
+		s = append(s, "BlockInfo: "+fmt.Sprintf("%#v", this.BlockInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IterateKeysRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&grpc.IterateKeysRequest{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "NumKeysPerBatch: "+fmt.Sprintf("%#v", this.NumKeysPerBatch)+",\n")
+	s = append(s, "MaxKeys: "+fmt.Sprintf("%#v", this.MaxKeys)+",\n")
+	s = append(s, "IteratorState: "+fmt.Sprintf("%#v", this.IteratorState)+",\n")
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockQueryOptions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.BlockQueryOptions{")
+	s = append(s, "WithTransactions: "+fmt.Sprintf("%#v", this.WithTransactions)+",\n")
+	s = append(s, "WithLogs: "+fmt.Sprintf("%#v", this.WithLogs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockByHashRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.BlockByHashRequest{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockByNonceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.BlockByNonceRequest{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockByRoundRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.BlockByRoundRequest{")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockRangeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpc.BlockRangeRequest{")
+	s = append(s, "FromNonce: "+fmt.Sprintf("%#v", this.FromNonce)+",\n")
+	s = append(s, "ToNonce: "+fmt.Sprintf("%#v", this.ToNonce)+",\n")
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&grpc.TransactionResult{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "GasPrice: "+fmt.Sprintf("%#v", this.GasPrice)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MiniBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&grpc.MiniBlock{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ProcessingType: "+fmt.Sprintf("%#v", this.ProcessingType)+",\n")
+	s = append(s, "ConstructionState: "+fmt.Sprintf("%#v", this.ConstructionState)+",\n")
+	s = append(s, "SourceShard: "+fmt.Sprintf("%#v", this.SourceShard)+",\n")
+	s = append(s, "DestinationShard: "+fmt.Sprintf("%#v", this.DestinationShard)+",\n")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Block) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 18)
+	s = append(s, "&grpc.Block{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Shard: "+fmt.Sprintf("%#v", this.Shard)+",\n")
+	s = append(s, "NumTxs: "+fmt.Sprintf("%#v", this.NumTxs)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "PrevBlockHash: "+fmt.Sprintf("%#v", this.PrevBlockHash)+",\n")
+	s = append(s, "StateRootHash: "+fmt.Sprintf("%#v", this.StateRootHash)+",\n")
+	s = append(s, "AccumulatedFees: "+fmt.Sprintf("%#v", this.AccumulatedFees)+",\n")
+	s = append(s, "DeveloperFees: "+fmt.Sprintf("%#v", this.DeveloperFees)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "TimestampMs: "+fmt.Sprintf("%#v", this.TimestampMs)+",\n")
+	if this.MiniBlocks != nil {
+		s = append(s, "MiniBlocks: "+fmt.Sprintf("%#v", this.MiniBlocks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Transaction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 21)
+	s = append(s, "&grpc.Transaction{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "ReceiverUsername: "+fmt.Sprintf("%#v", this.ReceiverUsername)+",\n")
+	s = append(s, "SenderUsername: "+fmt.Sprintf("%#v", this.SenderUsername)+",\n")
+	s = append(s, "GasPrice: "+fmt.Sprintf("%#v", this.GasPrice)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "ChainID: "+fmt.Sprintf("%#v", this.ChainID)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	s = append(s, "GuardianAddr: "+fmt.Sprintf("%#v", this.GuardianAddr)+",\n")
+	s = append(s, "GuardianSignature: "+fmt.Sprintf("%#v", this.GuardianSignature)+",\n")
+	s = append(s, "RelayerAddr: "+fmt.Sprintf("%#v", this.RelayerAddr)+",\n")
+	s = append(s, "RelayerSignature: "+fmt.Sprintf("%#v", this.RelayerSignature)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SendTransactionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpc.SendTransactionsRequest{")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SendTransactionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.SendTransactionsResponse{")
+	s = append(s, "NumOfSentTxs: "+fmt.Sprintf("%#v", this.NumOfSentTxs)+",\n")
+	s = append(s, "TxsHashes: "+fmt.Sprintf("%#v", this.TxsHashes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SimulateTransactionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.SimulateTransactionRequest{")
+	if this.Transaction != nil {
+		s = append(s, "Transaction: "+fmt.Sprintf("%#v", this.Transaction)+",\n")
+	}
+	s = append(s, "SkipSignatureCheck: "+fmt.Sprintf("%#v", this.SkipSignatureCheck)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SmartContractResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&grpc.SmartContractResult{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "GasPrice: "+fmt.Sprintf("%#v", this.GasPrice)+",\n")
+	s = append(s, "ReturnMessage: "+fmt.Sprintf("%#v", this.ReturnMessage)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Event) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&grpc.Event{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Identifier: "+fmt.Sprintf("%#v", this.Identifier)+",\n")
+	s = append(s, "Topics: "+fmt.Sprintf("%#v", this.Topics)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Logs) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.Logs{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	if this.Events != nil {
+		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SimulationResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&grpc.SimulationResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "FailReason: "+fmt.Sprintf("%#v", this.FailReason)+",\n")
+	if this.ScResults != nil {
+		s = append(s, "ScResults: "+fmt.Sprintf("%#v", this.ScResults)+",\n")
+	}
+	if this.Logs != nil {
+		s = append(s, "Logs: "+fmt.Sprintf("%#v", this.Logs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionCostResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&grpc.TransactionCostResponse{")
+	s = append(s, "GasUnits: "+fmt.Sprintf("%#v", this.GasUnits)+",\n")
+	s = append(s, "ReturnMessage: "+fmt.Sprintf("%#v", this.ReturnMessage)+",\n")
+	if this.ScResults != nil {
+		s = append(s, "ScResults: "+fmt.Sprintf("%#v", this.ScResults)+",\n")
+	}
+	if this.Logs != nil {
+		s = append(s, "Logs: "+fmt.Sprintf("%#v", this.Logs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VMQueryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&grpc.VMQueryRequest{")
+	s = append(s, "ScAddress: "+fmt.Sprintf("%#v", this.ScAddress)+",\n")
+	s = append(s, "FuncName: "+fmt.Sprintf("%#v", this.FuncName)+",\n")
+	s = append(s, "CallerAddr: "+fmt.Sprintf("%#v", this.CallerAddr)+",\n")
+	s = append(s, "CallValue: "+fmt.Sprintf("%#v", this.CallValue)+",\n")
+	s = append(s, "Args: "+fmt.Sprintf("%#v", this.Args)+",\n")
+	s = append(s, "SameScState: "+fmt.Sprintf("%#v", this.SameScState)+",\n")
+	s = append(s, "ShouldBeSynced: "+fmt.Sprintf("%#v", this.ShouldBeSynced)+",\n")
+	s = append(s, "HasBlockNonce: "+fmt.Sprintf("%#v", this.HasBlockNonce)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VMQueryResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&grpc.VMQueryResponse{")
+	s = append(s, "ReturnData: "+fmt.Sprintf("%#v", this.ReturnData)+",\n")
+	s = append(s, "ReturnCode: "+fmt.Sprintf("%#v", this.ReturnCode)+",\n")
+	s = append(s, "ReturnMessage: "+fmt.Sprintf("%#v", this.ReturnMessage)+",\n")
+	s = append(s, "GasRemaining: "+fmt.Sprintf("%#v", this.GasRemaining)+",\n")
+	s = append(s, "GasRefund: "+fmt.Sprintf("%#v", this.GasRefund)+",\n")
+	if this.BlockInfo != nil {
+		s = append(s, "BlockInfo: "+fmt.Sprintf("%#v", this.BlockInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ProofRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.ProofRequest{")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ProofResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpc.ProofResponse{")
+	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ProofDataTrieRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpc.ProofDataTrieRequest{")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ProofDataTrieResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpc.ProofDataTrieResponse{")
+	if this.MainProof != nil {
+		s = append(s, "MainProof: "+fmt.Sprintf("%#v", this.MainProof)+",\n")
+	}
+	if this.DataTrieProof != nil {
+		s = append(s, "DataTrieProof: "+fmt.Sprintf("%#v", this.DataTrieProof)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifyProofRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpc.VerifyProofRequest{")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifyProofResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpc.VerifyProofResponse{")
+	s = append(s, "Ok: "+fmt.Sprintf("%#v", this.Ok)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringNodeApi(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeAPIClient is the client API for NodeAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeAPIClient interface {
+	GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error)
+	GetESDTData(ctx context.Context, in *ESDTDataRequest, opts ...grpc.CallOption) (*ESDTDataResponse, error)
+	GetAllESDTTokens(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*ESDTTokensResponse, error)
+	// GetKeyValuePairs streams all the key-value pairs of an account, sorted by key
+	GetKeyValuePairs(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (NodeAPI_GetKeyValuePairsClient, error)
+	// IterateKeys streams the data trie of an account, one batch per iteration step
+	IterateKeys(ctx context.Context, in *IterateKeysRequest, opts ...grpc.CallOption) (NodeAPI_IterateKeysClient, error)
+	GetBlockByHash(ctx context.Context, in *BlockByHashRequest, opts ...grpc.CallOption) (*Block, error)
+	GetBlockByNonce(ctx context.Context, in *BlockByNonceRequest, opts ...grpc.CallOption) (*Block, error)
+	GetBlockByRound(ctx context.Context, in *BlockByRoundRequest, opts ...grpc.CallOption) (*Block, error)
+	// StreamBlocks streams the blocks in the requested nonce range, in ascending order
+	StreamBlocks(ctx context.Context, in *BlockRangeRequest, opts ...grpc.CallOption) (NodeAPI_StreamBlocksClient, error)
+	SendTransactions(ctx context.Context, in *SendTransactionsRequest, opts ...grpc.CallOption) (*SendTransactionsResponse, error)
+	SimulateTransaction(ctx context.Context, in *SimulateTransactionRequest, opts ...grpc.CallOption) (*SimulationResponse, error)
+	ComputeTransactionCost(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*TransactionCostResponse, error)
+	ExecuteVMQuery(ctx context.Context, in *VMQueryRequest, opts ...grpc.CallOption) (*VMQueryResponse, error)
+	GetProof(ctx context.Context, in *ProofRequest, opts ...grpc.CallOption) (*ProofResponse, error)
+	GetProofDataTrie(ctx context.Context, in *ProofDataTrieRequest, opts ...grpc.CallOption) (*ProofDataTrieResponse, error)
+	VerifyProof(ctx context.Context, in *VerifyProofRequest, opts ...grpc.CallOption) (*VerifyProofResponse, error)
+}
+
+type nodeAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeAPIClient(cc *grpc.ClientConn) NodeAPIClient {
+	return &nodeAPIClient{cc}
+}
+
+func (c *nodeAPIClient) GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) GetESDTData(ctx context.Context, in *ESDTDataRequest, opts ...grpc.CallOption) (*ESDTDataResponse, error) {
+	out := new(ESDTDataResponse)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/GetESDTData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) GetAllESDTTokens(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*ESDTTokensResponse, error) {
+	out := new(ESDTTokensResponse)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/GetAllESDTTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) GetKeyValuePairs(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (NodeAPI_GetKeyValuePairsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NodeAPI_serviceDesc.Streams[0], "/nodeapi.NodeAPI/GetKeyValuePairs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeAPIGetKeyValuePairsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NodeAPI_GetKeyValuePairsClient interface {
+	Recv() (*KeyValuePairsBatch, error)
+	grpc.ClientStream
+}
+
+type nodeAPIGetKeyValuePairsClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeAPIGetKeyValuePairsClient) Recv() (*KeyValuePairsBatch, error) {
+	m := new(KeyValuePairsBatch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeAPIClient) IterateKeys(ctx context.Context, in *IterateKeysRequest, opts ...grpc.CallOption) (NodeAPI_IterateKeysClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NodeAPI_serviceDesc.Streams[1], "/nodeapi.NodeAPI/IterateKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeAPIIterateKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NodeAPI_IterateKeysClient interface {
+	Recv() (*KeyValuePairsBatch, error)
+	grpc.ClientStream
+}
+
+type nodeAPIIterateKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeAPIIterateKeysClient) Recv() (*KeyValuePairsBatch, error) {
+	m := new(KeyValuePairsBatch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeAPIClient) GetBlockByHash(ctx context.Context, in *BlockByHashRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/GetBlockByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) GetBlockByNonce(ctx context.Context, in *BlockByNonceRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/GetBlockByNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) GetBlockByRound(ctx context.Context, in *BlockByRoundRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/GetBlockByRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) StreamBlocks(ctx context.Context, in *BlockRangeRequest, opts ...grpc.CallOption) (NodeAPI_StreamBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NodeAPI_serviceDesc.Streams[2], "/nodeapi.NodeAPI/StreamBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeAPIStreamBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NodeAPI_StreamBlocksClient interface {
+	Recv() (*Block, error)
+	grpc.ClientStream
+}
+
+type nodeAPIStreamBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeAPIStreamBlocksClient) Recv() (*Block, error) {
+	m := new(Block)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeAPIClient) SendTransactions(ctx context.Context, in *SendTransactionsRequest, opts ...grpc.CallOption) (*SendTransactionsResponse, error) {
+	out := new(SendTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/SendTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) SimulateTransaction(ctx context.Context, in *SimulateTransactionRequest, opts ...grpc.CallOption) (*SimulationResponse, error) {
+	out := new(SimulationResponse)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/SimulateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) ComputeTransactionCost(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*TransactionCostResponse, error) {
+	out := new(TransactionCostResponse)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/ComputeTransactionCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) ExecuteVMQuery(ctx context.Context, in *VMQueryRequest, opts ...grpc.CallOption) (*VMQueryResponse, error) {
+	out := new(VMQueryResponse)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/ExecuteVMQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) GetProof(ctx context.Context, in *ProofRequest, opts ...grpc.CallOption) (*ProofResponse, error) {
+	out := new(ProofResponse)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/GetProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) GetProofDataTrie(ctx context.Context, in *ProofDataTrieRequest, opts ...grpc.CallOption) (*ProofDataTrieResponse, error) {
+	out := new(ProofDataTrieResponse)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/GetProofDataTrie", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeAPIClient) VerifyProof(ctx context.Context, in *VerifyProofRequest, opts ...grpc.CallOption) (*VerifyProofResponse, error) {
+	out := new(VerifyProofResponse)
+	err := c.cc.Invoke(ctx, "/nodeapi.NodeAPI/VerifyProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeAPIServer is the server API for NodeAPI service.
+type NodeAPIServer interface {
+	GetAccount(context.Context, *AccountRequest) (*AccountResponse, error)
+	GetESDTData(context.Context, *ESDTDataRequest) (*ESDTDataResponse, error)
+	GetAllESDTTokens(context.Context, *AccountRequest) (*ESDTTokensResponse, error)
+	// GetKeyValuePairs streams all the key-value pairs of an account, sorted by key
+	GetKeyValuePairs(*AccountRequest, NodeAPI_GetKeyValuePairsServer) error
+	// IterateKeys streams the data trie of an account, one batch per iteration step
+	IterateKeys(*IterateKeysRequest, NodeAPI_IterateKeysServer) error
+	GetBlockByHash(context.Context, *BlockByHashRequest) (*Block, error)
+	GetBlockByNonce(context.Context, *BlockByNonceRequest) (*Block, error)
+	GetBlockByRound(context.Context, *BlockByRoundRequest) (*Block, error)
+	// StreamBlocks streams the blocks in the requested nonce range, in ascending order
+	StreamBlocks(*BlockRangeRequest, NodeAPI_StreamBlocksServer) error
+	SendTransactions(context.Context, *SendTransactionsRequest) (*SendTransactionsResponse, error)
+	SimulateTransaction(context.Context, *SimulateTransactionRequest) (*SimulationResponse, error)
+	ComputeTransactionCost(context.Context, *Transaction) (*TransactionCostResponse, error)
+	ExecuteVMQuery(context.Context, *VMQueryRequest) (*VMQueryResponse, error)
+	GetProof(context.Context, *ProofRequest) (*ProofResponse, error)
+	GetProofDataTrie(context.Context, *ProofDataTrieRequest) (*ProofDataTrieResponse, error)
+	VerifyProof(context.Context, *VerifyProofRequest) (*VerifyProofResponse, error)
+}
+
+// UnimplementedNodeAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedNodeAPIServer struct {
+}
+
+func (*UnimplementedNodeAPIServer) GetAccount(ctx context.Context, req *AccountRequest) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (*UnimplementedNodeAPIServer) GetESDTData(ctx context.Context, req *ESDTDataRequest) (*ESDTDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetESDTData not implemented")
+}
+func (*UnimplementedNodeAPIServer) GetAllESDTTokens(ctx context.Context, req *AccountRequest) (*ESDTTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllESDTTokens not implemented")
+}
+func (*UnimplementedNodeAPIServer) GetKeyValuePairs(req *AccountRequest, srv NodeAPI_GetKeyValuePairsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetKeyValuePairs not implemented")
+}
+func (*UnimplementedNodeAPIServer) IterateKeys(req *IterateKeysRequest, srv NodeAPI_IterateKeysServer) error {
+	return status.Errorf(codes.Unimplemented, "method IterateKeys not implemented")
+}
+func (*UnimplementedNodeAPIServer) GetBlockByHash(ctx context.Context, req *BlockByHashRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHash not implemented")
+}
+func (*UnimplementedNodeAPIServer) GetBlockByNonce(ctx context.Context, req *BlockByNonceRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByNonce not implemented")
+}
+func (*UnimplementedNodeAPIServer) GetBlockByRound(ctx context.Context, req *BlockByRoundRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByRound not implemented")
+}
+func (*UnimplementedNodeAPIServer) StreamBlocks(req *BlockRangeRequest, srv NodeAPI_StreamBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlocks not implemented")
+}
+func (*UnimplementedNodeAPIServer) SendTransactions(ctx context.Context, req *SendTransactionsRequest) (*SendTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTransactions not implemented")
+}
+func (*UnimplementedNodeAPIServer) SimulateTransaction(ctx context.Context, req *SimulateTransactionRequest) (*SimulationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTransaction not implemented")
+}
+func (*UnimplementedNodeAPIServer) ComputeTransactionCost(ctx context.Context, req *Transaction) (*TransactionCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeTransactionCost not implemented")
+}
+func (*UnimplementedNodeAPIServer) ExecuteVMQuery(ctx context.Context, req *VMQueryRequest) (*VMQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteVMQuery not implemented")
+}
+func (*UnimplementedNodeAPIServer) GetProof(ctx context.Context, req *ProofRequest) (*ProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProof not implemented")
+}
+func (*UnimplementedNodeAPIServer) GetProofDataTrie(ctx context.Context, req *ProofDataTrieRequest) (*ProofDataTrieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProofDataTrie not implemented")
+}
+func (*UnimplementedNodeAPIServer) VerifyProof(ctx context.Context, req *VerifyProofRequest) (*VerifyProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyProof not implemented")
+}
+
+func RegisterNodeAPIServer(s *grpc.Server, srv NodeAPIServer) {
+	s.RegisterService(&_NodeAPI_serviceDesc, srv)
+}
+
+func _NodeAPI_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_GetESDTData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ESDTDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetESDTData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/GetESDTData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetESDTData(ctx, req.(*ESDTDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_GetAllESDTTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetAllESDTTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/GetAllESDTTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetAllESDTTokens(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_GetKeyValuePairs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AccountRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeAPIServer).GetKeyValuePairs(m, &nodeAPIGetKeyValuePairsServer{stream})
+}
+
+type NodeAPI_GetKeyValuePairsServer interface {
+	Send(*KeyValuePairsBatch) error
+	grpc.ServerStream
+}
+
+type nodeAPIGetKeyValuePairsServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeAPIGetKeyValuePairsServer) Send(m *KeyValuePairsBatch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NodeAPI_IterateKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IterateKeysRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeAPIServer).IterateKeys(m, &nodeAPIIterateKeysServer{stream})
+}
+
+type NodeAPI_IterateKeysServer interface {
+	Send(*KeyValuePairsBatch) error
+	grpc.ServerStream
+}
+
+type nodeAPIIterateKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeAPIIterateKeysServer) Send(m *KeyValuePairsBatch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NodeAPI_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/GetBlockByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetBlockByHash(ctx, req.(*BlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_GetBlockByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetBlockByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/GetBlockByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetBlockByNonce(ctx, req.(*BlockByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_GetBlockByRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockByRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetBlockByRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/GetBlockByRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetBlockByRound(ctx, req.(*BlockByRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeAPIServer).StreamBlocks(m, &nodeAPIStreamBlocksServer{stream})
+}
+
+type NodeAPI_StreamBlocksServer interface {
+	Send(*Block) error
+	grpc.ServerStream
+}
+
+type nodeAPIStreamBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeAPIStreamBlocksServer) Send(m *Block) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NodeAPI_SendTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).SendTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/SendTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).SendTransactions(ctx, req.(*SendTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_SimulateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).SimulateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/SimulateTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).SimulateTransaction(ctx, req.(*SimulateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_ComputeTransactionCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Transaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).ComputeTransactionCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/ComputeTransactionCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).ComputeTransactionCost(ctx, req.(*Transaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_ExecuteVMQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).ExecuteVMQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/ExecuteVMQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).ExecuteVMQuery(ctx, req.(*VMQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_GetProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/GetProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetProof(ctx, req.(*ProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_GetProofDataTrie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProofDataTrieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).GetProofDataTrie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/GetProofDataTrie",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).GetProofDataTrie(ctx, req.(*ProofDataTrieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeAPI_VerifyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeAPIServer).VerifyProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodeapi.NodeAPI/VerifyProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeAPIServer).VerifyProof(ctx, req.(*VerifyProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NodeAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nodeapi.NodeAPI",
+	HandlerType: (*NodeAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccount",
+			Handler:    _NodeAPI_GetAccount_Handler,
+		},
+		{
+			MethodName: "GetESDTData",
+			Handler:    _NodeAPI_GetESDTData_Handler,
+		},
+		{
+			MethodName: "GetAllESDTTokens",
+			Handler:    _NodeAPI_GetAllESDTTokens_Handler,
+		},
+		{
+			MethodName: "GetBlockByHash",
+			Handler:    _NodeAPI_GetBlockByHash_Handler,
+		},
+		{
+			MethodName: "GetBlockByNonce",
+			Handler:    _NodeAPI_GetBlockByNonce_Handler,
+		},
+		{
+			MethodName: "GetBlockByRound",
+			Handler:    _NodeAPI_GetBlockByRound_Handler,
+		},
+		{
+			MethodName: "SendTransactions",
+			Handler:    _NodeAPI_SendTransactions_Handler,
+		},
+		{
+			MethodName: "SimulateTransaction",
+			Handler:    _NodeAPI_SimulateTransaction_Handler,
+		},
+		{
+			MethodName: "ComputeTransactionCost",
+			Handler:    _NodeAPI_ComputeTransactionCost_Handler,
+		},
+		{
+			MethodName: "ExecuteVMQuery",
+			Handler:    _NodeAPI_ExecuteVMQuery_Handler,
+		},
+		{
+			MethodName: "GetProof",
+			Handler:    _NodeAPI_GetProof_Handler,
+		},
+		{
+			MethodName: "GetProofDataTrie",
+			Handler:    _NodeAPI_GetProofDataTrie_Handler,
+		},
+		{
+			MethodName: "VerifyProof",
+			Handler:    _NodeAPI_VerifyProof_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetKeyValuePairs",
+			Handler:       _NodeAPI_GetKeyValuePairs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "IterateKeys",
+			Handler:       _NodeAPI_IterateKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBlocks",
+			Handler:       _NodeAPI_StreamBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "nodeApi.proto",
+}
+
+func (m *AccountQueryOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountQueryOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountQueryOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithKeys {
+		i--
+		if m.WithKeys {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HintEpoch != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.HintEpoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HasHintEpoch {
+		i--
+		if m.HasHintEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BlockRootHash) > 0 {
+		i -= len(m.BlockRootHash)
+		copy(dAtA[i:], m.BlockRootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.BlockRootHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HasBlockNonce {
+		i--
+		if m.HasBlockNonce {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OnStartOfEpoch != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.OnStartOfEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HasOnStartOfEpoch {
+		i--
+		if m.HasOnStartOfEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OnFinalBlock {
+		i--
+		if m.OnFinalBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Account) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Account) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.DeveloperReward) > 0 {
+		i -= len(m.DeveloperReward)
+		copy(dAtA[i:], m.DeveloperReward)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.DeveloperReward)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CodeMetadata) > 0 {
+		i -= len(m.CodeMetadata)
+		copy(dAtA[i:], m.CodeMetadata)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.CodeMetadata)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInfo != nil {
+		{
+			size, err := m.BlockInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenIdentifier) > 0 {
+		i -= len(m.TokenIdentifier)
+		copy(dAtA[i:], m.TokenIdentifier)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.TokenIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		i -= len(m.Attributes)
+		copy(dAtA[i:], m.Attributes)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Attributes)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.URIs) > 0 {
+		for iNdEx := len(m.URIs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.URIs[iNdEx])
+			copy(dAtA[i:], m.URIs[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.URIs[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Royalties != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Royalties))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Properties) > 0 {
+		i -= len(m.Properties)
+		copy(dAtA[i:], m.Properties)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Properties)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenIdentifier) > 0 {
+		i -= len(m.TokenIdentifier)
+		copy(dAtA[i:], m.TokenIdentifier)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.TokenIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInfo != nil {
+		{
+			size, err := m.BlockInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInfo != nil {
+		{
+			size, err := m.BlockInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyValuePair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyValuePair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyValuePair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyValuePairsBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyValuePairsBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyValuePairsBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInfo != nil {
+		{
+			size, err := m.BlockInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IteratorState) > 0 {
+		for iNdEx := len(m.IteratorState) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IteratorState[iNdEx])
+			copy(dAtA[i:], m.IteratorState[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.IteratorState[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IterateKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IterateKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IterateKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IteratorState) > 0 {
+		for iNdEx := len(m.IteratorState) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IteratorState[iNdEx])
+			copy(dAtA[i:], m.IteratorState[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.IteratorState[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxKeys != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.MaxKeys))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumKeysPerBatch != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.NumKeysPerBatch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockQueryOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockQueryOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockQueryOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithLogs {
+		i--
+		if m.WithLogs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WithTransactions {
+		i--
+		if m.WithTransactions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockByRoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockByRoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockByRoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Round != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToNonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.ToNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromNonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.FromNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasPrice != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasPrice))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MiniBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MiniBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MiniBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DestinationShard != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.DestinationShard))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SourceShard != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.SourceShard))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ConstructionState) > 0 {
+		i -= len(m.ConstructionState)
+		copy(dAtA[i:], m.ConstructionState)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ConstructionState)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProcessingType) > 0 {
+		i -= len(m.ProcessingType)
+		copy(dAtA[i:], m.ProcessingType)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ProcessingType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Block) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Block) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MiniBlocks) > 0 {
+		for iNdEx := len(m.MiniBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MiniBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.TimestampMs != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.DeveloperFees) > 0 {
+		i -= len(m.DeveloperFees)
+		copy(dAtA[i:], m.DeveloperFees)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.DeveloperFees)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.AccumulatedFees) > 0 {
+		i -= len(m.AccumulatedFees)
+		copy(dAtA[i:], m.AccumulatedFees)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.AccumulatedFees)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.StateRootHash) > 0 {
+		i -= len(m.StateRootHash)
+		copy(dAtA[i:], m.StateRootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.StateRootHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PrevBlockHash) > 0 {
+		i -= len(m.PrevBlockHash)
+		copy(dAtA[i:], m.PrevBlockHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.PrevBlockHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NumTxs != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.NumTxs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Shard != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Round != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Transaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RelayerSignature) > 0 {
+		i -= len(m.RelayerSignature)
+		copy(dAtA[i:], m.RelayerSignature)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RelayerSignature)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.RelayerAddr) > 0 {
+		i -= len(m.RelayerAddr)
+		copy(dAtA[i:], m.RelayerAddr)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RelayerAddr)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.GuardianSignature) > 0 {
+		i -= len(m.GuardianSignature)
+		copy(dAtA[i:], m.GuardianSignature)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.GuardianSignature)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.GuardianAddr) > 0 {
+		i -= len(m.GuardianAddr)
+		copy(dAtA[i:], m.GuardianAddr)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.GuardianAddr)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Options != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Options))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Version != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasPrice != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasPrice))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SenderUsername) > 0 {
+		i -= len(m.SenderUsername)
+		copy(dAtA[i:], m.SenderUsername)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.SenderUsername)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ReceiverUsername) > 0 {
+		i -= len(m.ReceiverUsername)
+		copy(dAtA[i:], m.ReceiverUsername)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ReceiverUsername)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendTransactionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendTransactionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendTransactionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendTransactionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxsHashes) > 0 {
+		for iNdEx := len(m.TxsHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxsHashes[iNdEx])
+			copy(dAtA[i:], m.TxsHashes[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.TxsHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.NumOfSentTxs != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.NumOfSentTxs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SkipSignatureCheck {
+		i--
+		if m.SkipSignatureCheck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Transaction != nil {
+		{
+			size, err := m.Transaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SmartContractResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SmartContractResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SmartContractResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReturnMessage) > 0 {
+		i -= len(m.ReturnMessage)
+		copy(dAtA[i:], m.ReturnMessage)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ReturnMessage)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GasPrice != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasPrice))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Logs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Logs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Logs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Logs != nil {
+		{
+			size, err := m.Logs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ScResults) > 0 {
+		for iNdEx := len(m.ScResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FailReason) > 0 {
+		i -= len(m.FailReason)
+		copy(dAtA[i:], m.FailReason)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.FailReason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionCostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionCostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Logs != nil {
+		{
+			size, err := m.Logs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ScResults) > 0 {
+		for iNdEx := len(m.ScResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ReturnMessage) > 0 {
+		i -= len(m.ReturnMessage)
+		copy(dAtA[i:], m.ReturnMessage)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ReturnMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasUnits != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasUnits))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VMQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VMQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VMQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HasBlockNonce {
+		i--
+		if m.HasBlockNonce {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ShouldBeSynced {
+		i--
+		if m.ShouldBeSynced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SameScState {
+		i--
+		if m.SameScState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CallValue) > 0 {
+		i -= len(m.CallValue)
+		copy(dAtA[i:], m.CallValue)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.CallValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CallerAddr) > 0 {
+		i -= len(m.CallerAddr)
+		copy(dAtA[i:], m.CallerAddr)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.CallerAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FuncName) > 0 {
+		i -= len(m.FuncName)
+		copy(dAtA[i:], m.FuncName)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.FuncName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScAddress) > 0 {
+		i -= len(m.ScAddress)
+		copy(dAtA[i:], m.ScAddress)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ScAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VMQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VMQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VMQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInfo != nil {
+		{
+			size, err := m.BlockInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.GasRefund) > 0 {
+		i -= len(m.GasRefund)
+		copy(dAtA[i:], m.GasRefund)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.GasRefund)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasRemaining != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasRemaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReturnMessage) > 0 {
+		i -= len(m.ReturnMessage)
+		copy(dAtA[i:], m.ReturnMessage)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ReturnMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReturnCode) > 0 {
+		i -= len(m.ReturnCode)
+		copy(dAtA[i:], m.ReturnCode)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ReturnCode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReturnData) > 0 {
+		for iNdEx := len(m.ReturnData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReturnData[iNdEx])
+			copy(dAtA[i:], m.ReturnData[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ReturnData[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofDataTrieRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofDataTrieRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofDataTrieRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofDataTrieResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofDataTrieResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofDataTrieResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataTrieProof != nil {
+		{
+			size, err := m.DataTrieProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MainProof != nil {
+		{
+			size, err := m.MainProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ok {
+		i--
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNodeApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNodeApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountQueryOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OnFinalBlock {
+		n += 2
+	}
+	if m.HasOnStartOfEpoch {
+		n += 2
+	}
+	if m.OnStartOfEpoch != 0 {
+		n += 1 + sovNodeApi(uint64(m.OnStartOfEpoch))
+	}
+	if m.HasBlockNonce {
+		n += 2
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.BlockNonce))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.BlockRootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.HasHintEpoch {
+		n += 2
+	}
+	if m.HintEpoch != 0 {
+		n += 1 + sovNodeApi(uint64(m.HintEpoch))
+	}
+	if m.WithKeys {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlockInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.CodeMetadata)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.DeveloperReward)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.BlockInfo != nil {
+		l = m.BlockInfo.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ESDTDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.TokenIdentifier)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ESDTToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIdentifier)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovNodeApi(uint64(m.Type))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Properties)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Royalties != 0 {
+		n += 1 + sovNodeApi(uint64(m.Royalties))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if len(m.URIs) > 0 {
+		for _, b := range m.URIs {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	l = len(m.Attributes)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ESDTDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.BlockInfo != nil {
+		l = m.BlockInfo.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ESDTTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	if m.BlockInfo != nil {
+		l = m.BlockInfo.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyValuePair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyValuePairsBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	if len(m.IteratorState) > 0 {
+		for _, b := range m.IteratorState {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	if m.BlockInfo != nil {
+		l = m.BlockInfo.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *IterateKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.NumKeysPerBatch != 0 {
+		n += 1 + sovNodeApi(uint64(m.NumKeysPerBatch))
+	}
+	if m.MaxKeys != 0 {
+		n += 1 + sovNodeApi(uint64(m.MaxKeys))
+	}
+	if len(m.IteratorState) > 0 {
+		for _, b := range m.IteratorState {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockQueryOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WithTransactions {
+		n += 2
+	}
+	if m.WithLogs {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlockByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockByRoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovNodeApi(uint64(m.Round))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromNonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.FromNonce))
+	}
+	if m.ToNonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.ToNonce))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.GasPrice != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasPrice))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasLimit))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *MiniBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.ProcessingType)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.ConstructionState)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.SourceShard != 0 {
+		n += 1 + sovNodeApi(uint64(m.SourceShard))
+	}
+	if m.DestinationShard != 0 {
+		n += 1 + sovNodeApi(uint64(m.DestinationShard))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Block) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovNodeApi(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovNodeApi(uint64(m.Epoch))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovNodeApi(uint64(m.Shard))
+	}
+	if m.NumTxs != 0 {
+		n += 1 + sovNodeApi(uint64(m.NumTxs))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.PrevBlockHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.StateRootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.AccumulatedFees)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.DeveloperFees)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovNodeApi(uint64(m.Timestamp))
+	}
+	if m.TimestampMs != 0 {
+		n += 1 + sovNodeApi(uint64(m.TimestampMs))
+	}
+	if len(m.MiniBlocks) > 0 {
+		for _, e := range m.MiniBlocks {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Transaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.ReceiverUsername)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.SenderUsername)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.GasPrice != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasPrice))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasLimit))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovNodeApi(uint64(m.Version))
+	}
+	if m.Options != 0 {
+		n += 1 + sovNodeApi(uint64(m.Options))
+	}
+	l = len(m.GuardianAddr)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.GuardianSignature)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.RelayerAddr)
+	if l > 0 {
+		n += 2 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.RelayerSignature)
+	if l > 0 {
+		n += 2 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *SendTransactionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SendTransactionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumOfSentTxs != 0 {
+		n += 1 + sovNodeApi(uint64(m.NumOfSentTxs))
+	}
+	if len(m.TxsHashes) > 0 {
+		for _, s := range m.TxsHashes {
+			l = len(s)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulateTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.SkipSignatureCheck {
+		n += 2
+	}
+	return n
+}
+
+func (m *SmartContractResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasLimit))
+	}
+	if m.GasPrice != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasPrice))
+	}
+	l = len(m.ReturnMessage)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, b := range m.Topics {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *Logs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.FailReason)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if len(m.ScResults) > 0 {
+		for _, e := range m.ScResults {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	if m.Logs != nil {
+		l = m.Logs.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUnits != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasUnits))
+	}
+	l = len(m.ReturnMessage)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if len(m.ScResults) > 0 {
+		for _, e := range m.ScResults {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	if m.Logs != nil {
+		l = m.Logs.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *VMQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScAddress)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.FuncName)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.CallerAddr)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.CallValue)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, b := range m.Args {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	if m.SameScState {
+		n += 2
+	}
+	if m.ShouldBeSynced {
+		n += 2
+	}
+	if m.HasBlockNonce {
+		n += 2
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.BlockNonce))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *VMQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReturnData) > 0 {
+		for _, b := range m.ReturnData {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	l = len(m.ReturnCode)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.ReturnMessage)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.GasRemaining != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasRemaining))
+	}
+	l = len(m.GasRefund)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.BlockInfo != nil {
+		l = m.BlockInfo.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofDataTrieRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofDataTrieResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MainProof != nil {
+		l = m.MainProof.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.DataTrieProof != nil {
+		l = m.DataTrieProof.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifyProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VerifyProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ok {
+		n += 2
+	}
+	return n
+}
+
+func sovNodeApi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNodeApi(x uint64) (n int) {
+	return sovNodeApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AccountQueryOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountQueryOptions{`,
+		`OnFinalBlock:` + fmt.Sprintf("%v", this.OnFinalBlock) + `,`,
+		`HasOnStartOfEpoch:` + fmt.Sprintf("%v", this.HasOnStartOfEpoch) + `,`,
+		`OnStartOfEpoch:` + fmt.Sprintf("%v", this.OnStartOfEpoch) + `,`,
+		`HasBlockNonce:` + fmt.Sprintf("%v", this.HasBlockNonce) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`BlockRootHash:` + fmt.Sprintf("%v", this.BlockRootHash) + `,`,
+		`HasHintEpoch:` + fmt.Sprintf("%v", this.HasHintEpoch) + `,`,
+		`HintEpoch:` + fmt.Sprintf("%v", this.HintEpoch) + `,`,
+		`WithKeys:` + fmt.Sprintf("%v", this.WithKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockInfo{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountRequest{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Options:` + strings.Replace(this.Options.String(), "AccountQueryOptions", "AccountQueryOptions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Account) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Account{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`CodeHash:` + fmt.Sprintf("%v", this.CodeHash) + `,`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`CodeMetadata:` + fmt.Sprintf("%v", this.CodeMetadata) + `,`,
+		`DeveloperReward:` + fmt.Sprintf("%v", this.DeveloperReward) + `,`,
+		`OwnerAddress:` + fmt.Sprintf("%v", this.OwnerAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountResponse{`,
+		`Account:` + strings.Replace(this.Account.String(), "Account", "Account", 1) + `,`,
+		`BlockInfo:` + strings.Replace(this.BlockInfo.String(), "BlockInfo", "BlockInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTDataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTDataRequest{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`TokenIdentifier:` + fmt.Sprintf("%v", this.TokenIdentifier) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Options:` + strings.Replace(this.Options.String(), "AccountQueryOptions", "AccountQueryOptions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTToken{`,
+		`TokenIdentifier:` + fmt.Sprintf("%v", this.TokenIdentifier) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`Properties:` + fmt.Sprintf("%v", this.Properties) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Creator:` + fmt.Sprintf("%v", this.Creator) + `,`,
+		`Royalties:` + fmt.Sprintf("%v", this.Royalties) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`URIs:` + fmt.Sprintf("%v", this.URIs) + `,`,
+		`Attributes:` + fmt.Sprintf("%v", this.Attributes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTDataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTDataResponse{`,
+		`Token:` + strings.Replace(this.Token.String(), "ESDTToken", "ESDTToken", 1) + `,`,
+		`BlockInfo:` + strings.Replace(this.BlockInfo.String(), "BlockInfo", "BlockInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTTokensResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTokens := "[]*ESDTToken{"
+	for _, f := range this.Tokens {
+		repeatedStringForTokens += strings.Replace(f.String(), "ESDTToken", "ESDTToken", 1) + ","
+	}
+	repeatedStringForTokens += "}"
+	s := strings.Join([]string{`&ESDTTokensResponse{`,
+		`Tokens:` + repeatedStringForTokens + `,`,
+		`BlockInfo:` + strings.Replace(this.BlockInfo.String(), "BlockInfo", "BlockInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyValuePair) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyValuePair{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyValuePairsBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPairs := "[]*KeyValuePair{"
+	for _, f := range this.Pairs {
+		repeatedStringForPairs += strings.Replace(f.String(), "KeyValuePair", "KeyValuePair", 1) + ","
+	}
+	repeatedStringForPairs += "}"
+	s := strings.Join([]string{`&KeyValuePairsBatch{`,
+		`Pairs:` + repeatedStringForPairs + `,`,
+		`IteratorState:` + fmt.Sprintf("%v", this.IteratorState) + `,`,
+		`BlockInfo:` + strings.Replace(this.BlockInfo.String(), "BlockInfo", "BlockInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IterateKeysRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IterateKeysRequest{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`NumKeysPerBatch:` + fmt.Sprintf("%v", this.NumKeysPerBatch) + `,`,
+		`MaxKeys:` + fmt.Sprintf("%v", this.MaxKeys) + `,`,
+		`IteratorState:` + fmt.Sprintf("%v", this.IteratorState) + `,`,
+		`Options:` + strings.Replace(this.Options.String(), "AccountQueryOptions", "AccountQueryOptions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockQueryOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockQueryOptions{`,
+		`WithTransactions:` + fmt.Sprintf("%v", this.WithTransactions) + `,`,
+		`WithLogs:` + fmt.Sprintf("%v", this.WithLogs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockByHashRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockByHashRequest{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Options:` + strings.Replace(this.Options.String(), "BlockQueryOptions", "BlockQueryOptions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockByNonceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockByNonceRequest{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Options:` + strings.Replace(this.Options.String(), "BlockQueryOptions", "BlockQueryOptions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockByRoundRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockByRoundRequest{`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Options:` + strings.Replace(this.Options.String(), "BlockQueryOptions", "BlockQueryOptions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockRangeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockRangeRequest{`,
+		`FromNonce:` + fmt.Sprintf("%v", this.FromNonce) + `,`,
+		`ToNonce:` + fmt.Sprintf("%v", this.ToNonce) + `,`,
+		`Options:` + strings.Replace(this.Options.String(), "BlockQueryOptions", "BlockQueryOptions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionResult{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Receiver:` + fmt.Sprintf("%v", this.Receiver) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`GasPrice:` + fmt.Sprintf("%v", this.GasPrice) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MiniBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*TransactionResult{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "TransactionResult", "TransactionResult", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&MiniBlock{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ProcessingType:` + fmt.Sprintf("%v", this.ProcessingType) + `,`,
+		`ConstructionState:` + fmt.Sprintf("%v", this.ConstructionState) + `,`,
+		`SourceShard:` + fmt.Sprintf("%v", this.SourceShard) + `,`,
+		`DestinationShard:` + fmt.Sprintf("%v", this.DestinationShard) + `,`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Block) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMiniBlocks := "[]*MiniBlock{"
+	for _, f := range this.MiniBlocks {
+		repeatedStringForMiniBlocks += strings.Replace(f.String(), "MiniBlock", "MiniBlock", 1) + ","
+	}
+	repeatedStringForMiniBlocks += "}"
+	s := strings.Join([]string{`&Block{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`NumTxs:` + fmt.Sprintf("%v", this.NumTxs) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`PrevBlockHash:` + fmt.Sprintf("%v", this.PrevBlockHash) + `,`,
+		`StateRootHash:` + fmt.Sprintf("%v", this.StateRootHash) + `,`,
+		`AccumulatedFees:` + fmt.Sprintf("%v", this.AccumulatedFees) + `,`,
+		`DeveloperFees:` + fmt.Sprintf("%v", this.DeveloperFees) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`TimestampMs:` + fmt.Sprintf("%v", this.TimestampMs) + `,`,
+		`MiniBlocks:` + repeatedStringForMiniBlocks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Transaction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Transaction{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Receiver:` + fmt.Sprintf("%v", this.Receiver) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`ReceiverUsername:` + fmt.Sprintf("%v", this.ReceiverUsername) + `,`,
+		`SenderUsername:` + fmt.Sprintf("%v", this.SenderUsername) + `,`,
+		`GasPrice:` + fmt.Sprintf("%v", this.GasPrice) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`ChainID:` + fmt.Sprintf("%v", this.ChainID) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
+		`GuardianAddr:` + fmt.Sprintf("%v", this.GuardianAddr) + `,`,
+		`GuardianSignature:` + fmt.Sprintf("%v", this.GuardianSignature) + `,`,
+		`RelayerAddr:` + fmt.Sprintf("%v", this.RelayerAddr) + `,`,
+		`RelayerSignature:` + fmt.Sprintf("%v", this.RelayerSignature) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SendTransactionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*Transaction{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "Transaction", "Transaction", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&SendTransactionsRequest{`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SendTransactionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SendTransactionsResponse{`,
+		`NumOfSentTxs:` + fmt.Sprintf("%v", this.NumOfSentTxs) + `,`,
+		`TxsHashes:` + fmt.Sprintf("%v", this.TxsHashes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SimulateTransactionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SimulateTransactionRequest{`,
+		`Transaction:` + strings.Replace(this.Transaction.String(), "Transaction", "Transaction", 1) + `,`,
+		`SkipSignatureCheck:` + fmt.Sprintf("%v", this.SkipSignatureCheck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SmartContractResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SmartContractResult{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Receiver:` + fmt.Sprintf("%v", this.Receiver) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`GasPrice:` + fmt.Sprintf("%v", this.GasPrice) + `,`,
+		`ReturnMessage:` + fmt.Sprintf("%v", this.ReturnMessage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Event) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Event{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Identifier:` + fmt.Sprintf("%v", this.Identifier) + `,`,
+		`Topics:` + fmt.Sprintf("%v", this.Topics) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Logs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEvents := "[]*Event{"
+	for _, f := range this.Events {
+		repeatedStringForEvents += strings.Replace(f.String(), "Event", "Event", 1) + ","
+	}
+	repeatedStringForEvents += "}"
+	s := strings.Join([]string{`&Logs{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Events:` + repeatedStringForEvents + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SimulationResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForScResults := "[]*SmartContractResult{"
+	for _, f := range this.ScResults {
+		repeatedStringForScResults += strings.Replace(f.String(), "SmartContractResult", "SmartContractResult", 1) + ","
+	}
+	repeatedStringForScResults += "}"
+	s := strings.Join([]string{`&SimulationResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`FailReason:` + fmt.Sprintf("%v", this.FailReason) + `,`,
+		`ScResults:` + repeatedStringForScResults + `,`,
+		`Logs:` + strings.Replace(this.Logs.String(), "Logs", "Logs", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionCostResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForScResults := "[]*SmartContractResult{"
+	for _, f := range this.ScResults {
+		repeatedStringForScResults += strings.Replace(f.String(), "SmartContractResult", "SmartContractResult", 1) + ","
+	}
+	repeatedStringForScResults += "}"
+	s := strings.Join([]string{`&TransactionCostResponse{`,
+		`GasUnits:` + fmt.Sprintf("%v", this.GasUnits) + `,`,
+		`ReturnMessage:` + fmt.Sprintf("%v", this.ReturnMessage) + `,`,
+		`ScResults:` + repeatedStringForScResults + `,`,
+		`Logs:` + strings.Replace(this.Logs.String(), "Logs", "Logs", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VMQueryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VMQueryRequest{`,
+		`ScAddress:` + fmt.Sprintf("%v", this.ScAddress) + `,`,
+		`FuncName:` + fmt.Sprintf("%v", this.FuncName) + `,`,
+		`CallerAddr:` + fmt.Sprintf("%v", this.CallerAddr) + `,`,
+		`CallValue:` + fmt.Sprintf("%v", this.CallValue) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`SameScState:` + fmt.Sprintf("%v", this.SameScState) + `,`,
+		`ShouldBeSynced:` + fmt.Sprintf("%v", this.ShouldBeSynced) + `,`,
+		`HasBlockNonce:` + fmt.Sprintf("%v", this.HasBlockNonce) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VMQueryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VMQueryResponse{`,
+		`ReturnData:` + fmt.Sprintf("%v", this.ReturnData) + `,`,
+		`ReturnCode:` + fmt.Sprintf("%v", this.ReturnCode) + `,`,
+		`ReturnMessage:` + fmt.Sprintf("%v", this.ReturnMessage) + `,`,
+		`GasRemaining:` + fmt.Sprintf("%v", this.GasRemaining) + `,`,
+		`GasRefund:` + fmt.Sprintf("%v", this.GasRefund) + `,`,
+		`BlockInfo:` + strings.Replace(this.BlockInfo.String(), "BlockInfo", "BlockInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProofRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProofRequest{`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProofResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProofResponse{`,
+		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProofDataTrieRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProofDataTrieRequest{`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProofDataTrieResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProofDataTrieResponse{`,
+		`MainProof:` + strings.Replace(this.MainProof.String(), "ProofResponse", "ProofResponse", 1) + `,`,
+		`DataTrieProof:` + strings.Replace(this.DataTrieProof.String(), "ProofResponse", "ProofResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifyProofRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifyProofRequest{`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifyProofResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifyProofResponse{`,
+		`Ok:` + fmt.Sprintf("%v", this.Ok) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringNodeApi(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AccountQueryOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountQueryOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountQueryOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnFinalBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnFinalBlock = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasOnStartOfEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasOnStartOfEpoch = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnStartOfEpoch", wireType)
+			}
+			m.OnStartOfEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnStartOfEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBlockNonce", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasBlockNonce = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRootHash = append(m.BlockRootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRootHash == nil {
+				m.BlockRootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasHintEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasHintEpoch = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HintEpoch", wireType)
+			}
+			m.HintEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HintEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithKeys", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithKeys = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &AccountQueryOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Account) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeMetadata = append(m.CodeMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeMetadata == nil {
+				m.CodeMetadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperReward = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockInfo == nil {
+				m.BlockInfo = &BlockInfo{}
+			}
+			if err := m.BlockInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &AccountQueryOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = append(m.Properties[:0], dAtA[iNdEx:postIndex]...)
+			if m.Properties == nil {
+				m.Properties = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
+			if m.Name == nil {
+				m.Name = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			m.Royalties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Royalties |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIs = append(m.URIs, make([]byte, postIndex-iNdEx))
+			copy(m.URIs[len(m.URIs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attributes == nil {
+				m.Attributes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &ESDTToken{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockInfo == nil {
+				m.BlockInfo = &BlockInfo{}
+			}
+			if err := m.BlockInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &ESDTToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockInfo == nil {
+				m.BlockInfo = &BlockInfo{}
+			}
+			if err := m.BlockInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyValuePair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyValuePair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyValuePair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyValuePairsBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyValuePairsBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyValuePairsBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, &KeyValuePair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IteratorState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IteratorState = append(m.IteratorState, make([]byte, postIndex-iNdEx))
+			copy(m.IteratorState[len(m.IteratorState)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockInfo == nil {
+				m.BlockInfo = &BlockInfo{}
+			}
+			if err := m.BlockInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IterateKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IterateKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IterateKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumKeysPerBatch", wireType)
+			}
+			m.NumKeysPerBatch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumKeysPerBatch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeys", wireType)
+			}
+			m.MaxKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxKeys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IteratorState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IteratorState = append(m.IteratorState, make([]byte, postIndex-iNdEx))
+			copy(m.IteratorState[len(m.IteratorState)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &AccountQueryOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockQueryOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockQueryOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockQueryOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithTransactions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithTransactions = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithLogs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &BlockQueryOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockByNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockByNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &BlockQueryOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockByRoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockByRoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockByRoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &BlockQueryOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromNonce", wireType)
+			}
+			m.FromNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToNonce", wireType)
+			}
+			m.ToNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &BlockQueryOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			m.GasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MiniBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MiniBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MiniBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstructionState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstructionState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceShard", wireType)
+			}
+			m.SourceShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationShard", wireType)
+			}
+			m.DestinationShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &TransactionResult{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Block) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			}
+			m.NumTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTxs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRootHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatedFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniBlocks = append(m.MiniBlocks, &MiniBlock{})
+			if err := m.MiniBlocks[len(m.MiniBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverUsername", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverUsername = append(m.ReceiverUsername[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReceiverUsername == nil {
+				m.ReceiverUsername = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderUsername", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderUsername = append(m.SenderUsername[:0], dAtA[iNdEx:postIndex]...)
+			if m.SenderUsername == nil {
+				m.SenderUsername = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			m.GasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			m.Options = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Options |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendTransactionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendTransactionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &Transaction{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendTransactionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendTransactionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfSentTxs", wireType)
+			}
+			m.NumOfSentTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfSentTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxsHashes = append(m.TxsHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
+			}
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipSignatureCheck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipSignatureCheck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SmartContractResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SmartContractResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SmartContractResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			m.GasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, make([]byte, postIndex-iNdEx))
+			copy(m.Topics[len(m.Topics)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Logs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Logs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Logs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScResults = append(m.ScResults, &SmartContractResult{})
+			if err := m.ScResults[len(m.ScResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Logs == nil {
+				m.Logs = &Logs{}
+			}
+			if err := m.Logs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionCostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionCostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUnits", wireType)
+			}
+			m.GasUnits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUnits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScResults = append(m.ScResults, &SmartContractResult{})
+			if err := m.ScResults[len(m.ScResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Logs == nil {
+				m.Logs = &Logs{}
+			}
+			if err := m.Logs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VMQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VMQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VMQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuncName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuncName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
+			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SameScState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SameScState = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShouldBeSynced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ShouldBeSynced = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBlockNonce", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasBlockNonce = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VMQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VMQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VMQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData, make([]byte, postIndex-iNdEx))
+			copy(m.ReturnData[len(m.ReturnData)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRemaining", wireType)
+			}
+			m.GasRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasRefund = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockInfo == nil {
+				m.BlockInfo = &BlockInfo{}
+			}
+			if err := m.BlockInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofDataTrieRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofDataTrieRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofDataTrieRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofDataTrieResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofDataTrieResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofDataTrieResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MainProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MainProof == nil {
+				m.MainProof = &ProofResponse{}
+			}
+			if err := m.MainProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTrieProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataTrieProof == nil {
+				m.DataTrieProof = &ProofResponse{}
+			}
+			if err := m.DataTrieProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNodeApi(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNodeApi
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNodeApi
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNodeApi
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNodeApi        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNodeApi          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNodeApi = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,430 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=plugins=grpc:. nodeApi.proto
+
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxTransactionsPerRequest is the maximum number of transactions accepted by a SendTransactions call
+const maxTransactionsPerRequest = 100
+
+type nodeAPIService struct {
+	mutFacade              sync.RWMutex
+	facade                 FacadeHandler
+	keyValuePairsBatchSize uint32
+	maxBlocksPerStream     uint64
+}
+
+func newNodeAPIService(facade FacadeHandler, keyValuePairsBatchSize uint32, maxBlocksPerStream uint64) *nodeAPIService {
+	return &nodeAPIService{
+		facade:                 facade,
+		keyValuePairsBatchSize: keyValuePairsBatchSize,
+		maxBlocksPerStream:     maxBlocksPerStream,
+	}
+}
+
+// GetAccount returns the data of the requested account
+func (service *nodeAPIService) GetAccount(_ context.Context, request *AccountRequest) (*AccountResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(errEmptyAddress)
+	}
+
+	account, blockInfo, err := service.getFacade().GetAccount(request.Address, convertAccountQueryOptions(request.Options))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &AccountResponse{
+		Account:   convertAccount(account),
+		BlockInfo: convertBlockInfo(blockInfo),
+	}, nil
+}
+
+// GetESDTData returns the data of an ESDT token held by the requested account
+func (service *nodeAPIService) GetESDTData(_ context.Context, request *ESDTDataRequest) (*ESDTDataResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(errEmptyAddress)
+	}
+
+	esdtData, blockInfo, err := service.getFacade().GetESDTData(
+		request.Address,
+		request.TokenIdentifier,
+		request.Nonce,
+		convertAccountQueryOptions(request.Options),
+	)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &ESDTDataResponse{
+		Token:     convertESDTToken(request.TokenIdentifier, esdtData),
+		BlockInfo: convertBlockInfo(blockInfo),
+	}, nil
+}
+
+// GetAllESDTTokens returns all the ESDT tokens held by the requested account, sorted by token identifier
+func (service *nodeAPIService) GetAllESDTTokens(_ context.Context, request *AccountRequest) (*ESDTTokensResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(errEmptyAddress)
+	}
+
+	tokens, blockInfo, err := service.getFacade().GetAllESDTTokens(request.Address, convertAccountQueryOptions(request.Options))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &ESDTTokensResponse{
+		Tokens:    convertESDTTokens(tokens),
+		BlockInfo: convertBlockInfo(blockInfo),
+	}, nil
+}
+
+// GetKeyValuePairs streams all the key-value pairs of the requested account, in batches sorted by key
+func (service *nodeAPIService) GetKeyValuePairs(request *AccountRequest, stream NodeAPI_GetKeyValuePairsServer) error {
+	if len(request.Address) == 0 {
+		return invalidArgumentError(errEmptyAddress)
+	}
+
+	pairs, blockInfo, err := service.getFacade().GetKeyValuePairs(request.Address, convertAccountQueryOptions(request.Options))
+	if err != nil {
+		return internalError(err)
+	}
+
+	convertedPairs := convertKeyValuePairs(pairs)
+	convertedBlockInfo := convertBlockInfo(blockInfo)
+	batchSize := int(service.keyValuePairsBatchSize)
+	for start := 0; start == 0 || start < len(convertedPairs); start += batchSize {
+		end := start + batchSize
+		if end > len(convertedPairs) {
+			end = len(convertedPairs)
+		}
+
+		err = sendOnStream(stream.Context(), func() error {
+			return stream.Send(&KeyValuePairsBatch{
+				Pairs:     convertedPairs[start:end],
+				BlockInfo: convertedBlockInfo,
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IterateKeys streams the data trie of the requested account, one batch for each iteration step. The iteration stops
+// when the whole trie was traversed or when the requested maximum number of keys was reached
+func (service *nodeAPIService) IterateKeys(request *IterateKeysRequest, stream NodeAPI_IterateKeysServer) error {
+	if len(request.Address) == 0 {
+		return invalidArgumentError(errEmptyAddress)
+	}
+
+	batchSize := uint64(request.NumKeysPerBatch)
+	if batchSize == 0 {
+		batchSize = uint64(service.keyValuePairsBatchSize)
+	}
+
+	facade := service.getFacade()
+	options := convertAccountQueryOptions(request.Options)
+	iteratorState := request.IteratorState
+	numSentKeys := uint64(0)
+	for {
+		numKeys := batchSize
+		if request.MaxKeys > 0 && request.MaxKeys-numSentKeys < numKeys {
+			numKeys = request.MaxKeys - numSentKeys
+		}
+
+		pairs, newIteratorState, blockInfo, err := facade.IterateKeys(request.Address, uint(numKeys), iteratorState, options)
+		if err != nil {
+			return internalError(err)
+		}
+
+		err = sendOnStream(stream.Context(), func() error {
+			return stream.Send(&KeyValuePairsBatch{
+				Pairs:         convertKeyValuePairs(pairs),
+				IteratorState: newIteratorState,
+				BlockInfo:     convertBlockInfo(blockInfo),
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		numSentKeys += uint64(len(pairs))
+		isTrieTraversed := len(newIteratorState) == 0
+		isMaxKeysReached := request.MaxKeys > 0 && numSentKeys >= request.MaxKeys
+		if isTrieTraversed || isMaxKeysReached {
+			return nil
+		}
+
+		iteratorState = newIteratorState
+	}
+}
+
+// GetBlockByHash returns the block with the requested hash
+func (service *nodeAPIService) GetBlockByHash(_ context.Context, request *BlockByHashRequest) (*Block, error) {
+	if len(request.Hash) == 0 {
+		return nil, invalidArgumentError(errEmptyHash)
+	}
+
+	block, err := service.getFacade().GetBlockByHash(request.Hash, convertBlockQueryOptions(request.Options))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return convertBlock(block), nil
+}
+
+// GetBlockByNonce returns the block with the requested nonce
+func (service *nodeAPIService) GetBlockByNonce(_ context.Context, request *BlockByNonceRequest) (*Block, error) {
+	block, err := service.getFacade().GetBlockByNonce(request.Nonce, convertBlockQueryOptions(request.Options))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return convertBlock(block), nil
+}
+
+// GetBlockByRound returns the block with the requested round
+func (service *nodeAPIService) GetBlockByRound(_ context.Context, request *BlockByRoundRequest) (*Block, error) {
+	block, err := service.getFacade().GetBlockByRound(request.Round, convertBlockQueryOptions(request.Options))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return convertBlock(block), nil
+}
+
+// StreamBlocks streams the blocks in the requested nonce range, in ascending order
+func (service *nodeAPIService) StreamBlocks(request *BlockRangeRequest, stream NodeAPI_StreamBlocksServer) error {
+	if request.FromNonce > request.ToNonce {
+		return invalidArgumentError(fmt.Errorf("%w, from nonce %d is greater than to nonce %d", errInvalidBlockRange, request.FromNonce, request.ToNonce))
+	}
+	numBlocks := request.ToNonce - request.FromNonce + 1
+	if numBlocks == 0 || numBlocks > service.maxBlocksPerStream {
+		return invalidArgumentError(fmt.Errorf("%w, at most %d blocks can be streamed at once", errInvalidBlockRange, service.maxBlocksPerStream))
+	}
+
+	facade := service.getFacade()
+	options := convertBlockQueryOptions(request.Options)
+	for nonce := request.FromNonce; nonce <= request.ToNonce; nonce++ {
+		block, err := facade.GetBlockByNonce(nonce, options)
+		if err != nil {
+			return internalError(fmt.Errorf("%w for nonce %d", err, nonce))
+		}
+
+		err = sendOnStream(stream.Context(), func() error {
+			return stream.Send(convertBlock(block))
+		})
+		if err != nil {
+			return err
+		}
+
+		if nonce == request.ToNonce {
+			break
+		}
+	}
+
+	return nil
+}
+
+// SendTransactions validates and sends the provided transactions. If any of the transactions is invalid, none of them is sent
+func (service *nodeAPIService) SendTransactions(_ context.Context, request *SendTransactionsRequest) (*SendTransactionsResponse, error) {
+	if len(request.Transactions) == 0 {
+		return nil, invalidArgumentError(errNoTransactions)
+	}
+	if len(request.Transactions) > maxTransactionsPerRequest {
+		return nil, invalidArgumentError(fmt.Errorf("%w: %d provided, maximum %d", errTooManyTransactions, len(request.Transactions), maxTransactionsPerRequest))
+	}
+
+	facade := service.getFacade()
+	txs := make([]*transaction.Transaction, 0, len(request.Transactions))
+	txsHashes := make([][]byte, 0, len(request.Transactions))
+	for idx, receivedTx := range request.Transactions {
+		tx, txHash, err := service.createTransaction(receivedTx)
+		if err == nil {
+			err = facade.ValidateTransaction(tx)
+		}
+		if err != nil {
+			return nil, invalidArgumentError(fmt.Errorf("transaction %d: %w", idx, err))
+		}
+
+		txs = append(txs, tx)
+		txsHashes = append(txsHashes, txHash)
+	}
+
+	numOfSentTxs, err := facade.SendBulkTransactions(txs)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &SendTransactionsResponse{
+		NumOfSentTxs: numOfSentTxs,
+		TxsHashes:    hashesToHex(txsHashes),
+	}, nil
+}
+
+// SimulateTransaction simulates the execution of the provided transaction
+func (service *nodeAPIService) SimulateTransaction(_ context.Context, request *SimulateTransactionRequest) (*SimulationResponse, error) {
+	tx, txHash, err := service.createTransaction(request.Transaction)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	facade := service.getFacade()
+	err = facade.ValidateTransactionForSimulation(tx, !request.SkipSignatureCheck)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	results, err := facade.SimulateTransactionExecution(tx)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &SimulationResponse{
+		Hash:       hex.EncodeToString(txHash),
+		Status:     string(results.Status),
+		FailReason: results.FailReason,
+		ScResults:  convertSmartContractResults(results.ScResults),
+		Logs:       convertLogs(results.Logs),
+	}, nil
+}
+
+// ComputeTransactionCost estimates the gas units needed by the provided transaction
+func (service *nodeAPIService) ComputeTransactionCost(_ context.Context, request *Transaction) (*TransactionCostResponse, error) {
+	tx, _, err := service.createTransaction(request)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	cost, err := service.getFacade().ComputeTransactionGasLimit(tx)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &TransactionCostResponse{
+		GasUnits:      cost.GasUnits,
+		ReturnMessage: cost.ReturnMessage,
+		ScResults:     convertSmartContractResults(cost.SmartContractResults),
+		Logs:          convertLogs(cost.Logs),
+	}, nil
+}
+
+// ExecuteVMQuery executes a read-only smart contract call
+func (service *nodeAPIService) ExecuteVMQuery(_ context.Context, request *VMQueryRequest) (*VMQueryResponse, error) {
+	facade := service.getFacade()
+	query, err := createSCQuery(facade, request)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	vmOutput, blockInfo, err := facade.ExecuteSCQuery(query)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return convertVMOutput(vmOutput, blockInfo), nil
+}
+
+// GetProof returns the Merkle proof of the requested account. The current root hash is used if none is provided
+func (service *nodeAPIService) GetProof(_ context.Context, request *ProofRequest) (*ProofResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(errEmptyAddress)
+	}
+
+	facade := service.getFacade()
+	if len(request.RootHash) == 0 {
+		proof, err := facade.GetProofCurrentRootHash(request.Address)
+		if err != nil {
+			return nil, internalError(err)
+		}
+
+		return convertProof(proof), nil
+	}
+
+	proof, err := facade.GetProof(request.RootHash, request.Address)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return convertProof(proof), nil
+}
+
+// GetProofDataTrie returns the Merkle proofs of the requested account and of the requested key from its data trie
+func (service *nodeAPIService) GetProofDataTrie(_ context.Context, request *ProofDataTrieRequest) (*ProofDataTrieResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(errEmptyAddress)
+	}
+
+	mainProof, dataTrieProof, err := service.getFacade().GetProofDataTrie(request.RootHash, request.Address, request.Key)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &ProofDataTrieResponse{
+		MainProof:     convertProof(mainProof),
+		DataTrieProof: convertProof(dataTrieProof),
+	}, nil
+}
+
+// VerifyProof verifies the provided Merkle proof
+func (service *nodeAPIService) VerifyProof(_ context.Context, request *VerifyProofRequest) (*VerifyProofResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(errEmptyAddress)
+	}
+
+	ok, err := service.getFacade().VerifyProof(request.RootHash, request.Address, request.Proof)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &VerifyProofResponse{Ok: ok}, nil
+}
+
+func (service *nodeAPIService) createTransaction(receivedTx *Transaction) (*transaction.Transaction, []byte, error) {
+	if receivedTx == nil {
+		return nil, nil, errNilTransaction
+	}
+
+	return service.getFacade().CreateTransaction(newArgsCreateTransaction(receivedTx))
+}
+
+func (service *nodeAPIService) getFacade() FacadeHandler {
+	service.mutFacade.RLock()
+	defer service.mutFacade.RUnlock()
+
+	return service.facade
+}
+
+func (service *nodeAPIService) setFacade(facade FacadeHandler) {
+	service.mutFacade.Lock()
+	service.facade = facade
+	service.mutFacade.Unlock()
+}
+
+func sendOnStream(ctx context.Context, send func() error) error {
+	err := ctx.Err()
+	if err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	return send()
+}
+
+func invalidArgumentError(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+func internalError(err error) error {
+	return status.Error(codes.Internal, err.Error())
+}
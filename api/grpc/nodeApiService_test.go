@@ -0,0 +1,821 @@
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	testKeyValuePairsBatchSize = 2
+	testMaxBlocksPerStream     = 5
+)
+
+var expectedErr = errors.New("expected error")
+
+func startTestService(t *testing.T, facade FacadeHandler) NodeAPIClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.ForceServerCodec(&gogoCodec{}))
+	RegisterNodeAPIServer(server, newNodeAPIService(facade, testKeyValuePairsBatchSize, testMaxBlocksPerStream))
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(&gogoCodec{})),
+	)
+	require.Nil(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+	})
+
+	return NewNodeAPIClient(conn)
+}
+
+func requireStatusCode(t *testing.T, err error, expectedCode codes.Code) {
+	require.NotNil(t, err)
+	require.Equal(t, expectedCode, status.Code(err), err.Error())
+}
+
+func receiveAllKeyValuePairsBatches(t *testing.T, stream interface {
+	Recv() (*KeyValuePairsBatch, error)
+}) ([]*KeyValuePairsBatch, error) {
+	batches := make([]*KeyValuePairsBatch, 0)
+	for {
+		batch, err := stream.Recv()
+		if err == io.EOF {
+			return batches, nil
+		}
+		if err != nil {
+			return batches, err
+		}
+
+		batches = append(batches, batch)
+	}
+}
+
+func TestNodeAPIService_GetAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestService(t, &mock.FacadeStub{})
+		response, err := client.GetAccount(context.Background(), &AccountRequest{})
+		require.Nil(t, response)
+		requireStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				return api.AccountResponse{}, api.BlockInfo{}, expectedErr
+			},
+		}
+		client := startTestService(t, facade)
+		response, err := client.GetAccount(context.Background(), &AccountRequest{Address: "alice"})
+		require.Nil(t, response)
+		requireStatusCode(t, err, codes.Internal)
+		require.Contains(t, err.Error(), expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedOptions := api.AccountQueryOptions{
+			OnFinalBlock:   true,
+			OnStartOfEpoch: core.OptionalUint32{Value: 3, HasValue: true},
+			BlockNonce:     core.OptionalUint64{Value: 37, HasValue: true},
+			BlockHash:      []byte("block hash"),
+			BlockRootHash:  []byte("root hash"),
+			HintEpoch:      core.OptionalUint32{Value: 2, HasValue: true},
+			WithKeys:       true,
+		}
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				require.Equal(t, "alice", address)
+				require.Equal(t, expectedOptions, options)
+
+				return api.AccountResponse{
+					Address:  address,
+					Nonce:    7,
+					Balance:  "1000",
+					CodeHash: []byte("code hash"),
+				}, api.BlockInfo{Nonce: 37, Hash: "hash", RootHash: "root"}, nil
+			},
+		}
+		client := startTestService(t, facade)
+		response, err := client.GetAccount(context.Background(), &AccountRequest{
+			Address: "alice",
+			Options: &AccountQueryOptions{
+				OnFinalBlock:      true,
+				HasOnStartOfEpoch: true,
+				OnStartOfEpoch:    3,
+				HasBlockNonce:     true,
+				BlockNonce:        37,
+				BlockHash:         []byte("block hash"),
+				BlockRootHash:     []byte("root hash"),
+				HasHintEpoch:      true,
+				HintEpoch:         2,
+				WithKeys:          true,
+			},
+		})
+		require.Nil(t, err)
+		require.Equal(t, &Account{Address: "alice", Nonce: 7, Balance: "1000", CodeHash: []byte("code hash")}, response.Account)
+		require.Equal(t, &BlockInfo{Nonce: 37, Hash: "hash", RootHash: "root"}, response.BlockInfo)
+	})
+}
+
+func TestNodeAPIService_ESDTTokens(t *testing.T) {
+	t.Parallel()
+
+	nft := &esdt.ESDigitalToken{
+		Type:       uint32(core.NonFungibleV2),
+		Value:      big.NewInt(1),
+		Properties: []byte("properties"),
+		TokenMetaData: &esdt.MetaData{
+			Nonce:      5,
+			Name:       []byte("name"),
+			Creator:    []byte("creator"),
+			Royalties:  100,
+			Hash:       []byte("hash"),
+			URIs:       [][]byte{[]byte("uri")},
+			Attributes: []byte("attributes"),
+		},
+	}
+
+	t.Run("GetESDTData should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetESDTDataCalled: func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error) {
+				require.Equal(t, "alice", address)
+				require.Equal(t, "NFT-abcdef", key)
+				require.Equal(t, uint64(5), nonce)
+
+				return nft, api.BlockInfo{Nonce: 1}, nil
+			},
+		}
+		client := startTestService(t, facade)
+		response, err := client.GetESDTData(context.Background(), &ESDTDataRequest{
+			Address:         "alice",
+			TokenIdentifier: "NFT-abcdef",
+			Nonce:           5,
+		})
+		require.Nil(t, err)
+		require.Equal(t, &ESDTToken{
+			TokenIdentifier: "NFT-abcdef",
+			Type:            uint32(core.NonFungibleV2),
+			Balance:         "1",
+			Properties:      []byte("properties"),
+			Nonce:           5,
+			Name:            []byte("name"),
+			Creator:         "creator",
+			Royalties:       100,
+			Hash:            []byte("hash"),
+			URIs:            [][]byte{[]byte("uri")},
+			Attributes:      []byte("attributes"),
+		}, response.Token)
+		require.Equal(t, uint64(1), response.BlockInfo.Nonce)
+	})
+	t.Run("GetAllESDTTokens should return the tokens sorted by identifier", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAllESDTTokensCalled: func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error) {
+				return map[string]*esdt.ESDigitalToken{
+					"TKN-abcdef":    {Value: big.NewInt(10)},
+					"NFT-abcdef-05": nft,
+					"ABC-abcdef":    {Value: nil},
+				}, api.BlockInfo{}, nil
+			},
+		}
+		client := startTestService(t, facade)
+		response, err := client.GetAllESDTTokens(context.Background(), &AccountRequest{Address: "alice"})
+		require.Nil(t, err)
+		require.Len(t, response.Tokens, 3)
+		require.Equal(t, "ABC-abcdef", response.Tokens[0].TokenIdentifier)
+		require.Equal(t, "0", response.Tokens[0].Balance)
+		require.Equal(t, "NFT-abcdef-05", response.Tokens[1].TokenIdentifier)
+		require.Equal(t, uint64(5), response.Tokens[1].Nonce)
+		require.Equal(t, "TKN-abcdef", response.Tokens[2].TokenIdentifier)
+		require.Equal(t, "10", response.Tokens[2].Balance)
+	})
+}
+
+func TestNodeAPIService_GetKeyValuePairs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetKeyValuePairsCalled: func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error) {
+				return nil, api.BlockInfo{}, expectedErr
+			},
+		}
+		client := startTestService(t, facade)
+		stream, err := client.GetKeyValuePairs(context.Background(), &AccountRequest{Address: "alice"})
+		require.Nil(t, err)
+
+		_, err = receiveAllKeyValuePairsBatches(t, stream)
+		requireStatusCode(t, err, codes.Internal)
+	})
+	t.Run("should stream the pairs in sorted batches", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetKeyValuePairsCalled: func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error) {
+				return map[string]string{
+					"05": "e",
+					"01": "a",
+					"03": "c",
+					"02": "b",
+					"04": "d",
+				}, api.BlockInfo{Nonce: 4}, nil
+			},
+		}
+		client := startTestService(t, facade)
+		stream, err := client.GetKeyValuePairs(context.Background(), &AccountRequest{Address: "alice"})
+		require.Nil(t, err)
+
+		batches, err := receiveAllKeyValuePairsBatches(t, stream)
+		require.Nil(t, err)
+		require.Len(t, batches, 3)
+		require.Equal(t, []*KeyValuePair{{Key: "01", Value: "a"}, {Key: "02", Value: "b"}}, batches[0].Pairs)
+		require.Equal(t, []*KeyValuePair{{Key: "03", Value: "c"}, {Key: "04", Value: "d"}}, batches[1].Pairs)
+		require.Equal(t, []*KeyValuePair{{Key: "05", Value: "e"}}, batches[2].Pairs)
+		for _, batch := range batches {
+			require.Equal(t, uint64(4), batch.BlockInfo.Nonce)
+		}
+	})
+	t.Run("no pairs should send one empty batch", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetKeyValuePairsCalled: func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error) {
+				return map[string]string{}, api.BlockInfo{Nonce: 4}, nil
+			},
+		}
+		client := startTestService(t, facade)
+		stream, err := client.GetKeyValuePairs(context.Background(), &AccountRequest{Address: "alice"})
+		require.Nil(t, err)
+
+		batches, err := receiveAllKeyValuePairsBatches(t, stream)
+		require.Nil(t, err)
+		require.Len(t, batches, 1)
+		require.Empty(t, batches[0].Pairs)
+		require.Equal(t, uint64(4), batches[0].BlockInfo.Nonce)
+	})
+}
+
+// createIteratingFacade returns a facade iterating over numKeys keys, using the index of the next key as iterator state
+func createIteratingFacade(t *testing.T, numKeys int, requestedNumKeys *[]uint) *mock.FacadeStub {
+	return &mock.FacadeStub{
+		IterateKeysCalled: func(address string, numKeysToFetch uint, iteratorState [][]byte, options api.AccountQueryOptions) (map[string]string, [][]byte, api.BlockInfo, error) {
+			*requestedNumKeys = append(*requestedNumKeys, numKeysToFetch)
+
+			start := 0
+			if len(iteratorState) > 0 {
+				_, err := fmt.Sscanf(string(iteratorState[0]), "%d", &start)
+				require.Nil(t, err)
+			}
+
+			pairs := make(map[string]string)
+			end := start
+			for ; end < numKeys && end < start+int(numKeysToFetch); end++ {
+				pairs[fmt.Sprintf("%02d", end)] = "value"
+			}
+
+			var newIteratorState [][]byte
+			if end < numKeys {
+				newIteratorState = [][]byte{[]byte(fmt.Sprintf("%d", end))}
+			}
+
+			return pairs, newIteratorState, api.BlockInfo{}, nil
+		},
+	}
+}
+
+func TestNodeAPIService_IterateKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestService(t, &mock.FacadeStub{})
+		stream, err := client.IterateKeys(context.Background(), &IterateKeysRequest{})
+		require.Nil(t, err)
+
+		_, err = receiveAllKeyValuePairsBatches(t, stream)
+		requireStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("should iterate the whole trie with the default batch size", func(t *testing.T) {
+		t.Parallel()
+
+		requestedNumKeys := make([]uint, 0)
+		client := startTestService(t, createIteratingFacade(t, 5, &requestedNumKeys))
+		stream, err := client.IterateKeys(context.Background(), &IterateKeysRequest{Address: "alice"})
+		require.Nil(t, err)
+
+		batches, err := receiveAllKeyValuePairsBatches(t, stream)
+		require.Nil(t, err)
+		require.Len(t, batches, 3)
+		require.Equal(t, []uint{2, 2, 2}, requestedNumKeys)
+		require.Equal(t, [][]byte{[]byte("2")}, batches[0].IteratorState)
+		require.Equal(t, [][]byte{[]byte("4")}, batches[1].IteratorState)
+		require.Empty(t, batches[2].IteratorState)
+		require.Equal(t, []*KeyValuePair{{Key: "04", Value: "value"}}, batches[2].Pairs)
+	})
+	t.Run("should stop when the maximum number of keys is reached", func(t *testing.T) {
+		t.Parallel()
+
+		requestedNumKeys := make([]uint, 0)
+		client := startTestService(t, createIteratingFacade(t, 100, &requestedNumKeys))
+		stream, err := client.IterateKeys(context.Background(), &IterateKeysRequest{
+			Address:         "alice",
+			NumKeysPerBatch: 3,
+			MaxKeys:         7,
+			IteratorState:   [][]byte{[]byte("10")},
+		})
+		require.Nil(t, err)
+
+		batches, err := receiveAllKeyValuePairsBatches(t, stream)
+		require.Nil(t, err)
+		require.Len(t, batches, 3)
+		require.Equal(t, []uint{3, 3, 1}, requestedNumKeys)
+		require.Equal(t, "10", batches[0].Pairs[0].Key)
+		require.Equal(t, [][]byte{[]byte("17")}, batches[2].IteratorState)
+	})
+}
+
+func TestNodeAPIService_GetBlock(t *testing.T) {
+	t.Parallel()
+
+	providedBlock := &api.Block{
+		Nonce: 10,
+		Round: 11,
+		Hash:  "hash",
+		MiniBlocks: []*api.MiniBlock{
+			{
+				Hash: "miniblock hash",
+				Type: "TxBlock",
+				Transactions: []*transaction.ApiTransactionResult{
+					{
+						Hash:   "tx hash",
+						Nonce:  1,
+						Value:  "100",
+						Data:   []byte("data"),
+						Status: transaction.TxStatusSuccess,
+					},
+				},
+			},
+		},
+	}
+	expectedBlock := &Block{
+		Nonce: 10,
+		Round: 11,
+		Hash:  "hash",
+		MiniBlocks: []*MiniBlock{
+			{
+				Hash: "miniblock hash",
+				Type: "TxBlock",
+				Transactions: []*TransactionResult{
+					{
+						Hash:   "tx hash",
+						Nonce:  1,
+						Value:  "100",
+						Data:   []byte("data"),
+						Status: string(transaction.TxStatusSuccess),
+					},
+				},
+			},
+		},
+	}
+	expectedOptions := api.BlockQueryOptions{WithTransactions: true, WithLogs: true}
+	facade := &mock.FacadeStub{
+		GetBlockByHashCalled: func(hash string, options api.BlockQueryOptions) (*api.Block, error) {
+			require.Equal(t, "hash", hash)
+			require.Equal(t, expectedOptions, options)
+			return providedBlock, nil
+		},
+		GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			require.Equal(t, uint64(10), nonce)
+			require.Equal(t, expectedOptions, options)
+			return providedBlock, nil
+		},
+		GetBlockByRoundCalled: func(round uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			if round == 0 {
+				return nil, expectedErr
+			}
+
+			require.Equal(t, uint64(11), round)
+			require.Equal(t, expectedOptions, options)
+			return providedBlock, nil
+		},
+	}
+	client := startTestService(t, facade)
+	options := &BlockQueryOptions{WithTransactions: true, WithLogs: true}
+
+	block, err := client.GetBlockByHash(context.Background(), &BlockByHashRequest{Options: options})
+	require.Nil(t, block)
+	requireStatusCode(t, err, codes.InvalidArgument)
+
+	block, err = client.GetBlockByHash(context.Background(), &BlockByHashRequest{Hash: "hash", Options: options})
+	require.Nil(t, err)
+	require.Equal(t, expectedBlock, block)
+
+	block, err = client.GetBlockByNonce(context.Background(), &BlockByNonceRequest{Nonce: 10, Options: options})
+	require.Nil(t, err)
+	require.Equal(t, expectedBlock, block)
+
+	block, err = client.GetBlockByRound(context.Background(), &BlockByRoundRequest{Round: 11, Options: options})
+	require.Nil(t, err)
+	require.Equal(t, expectedBlock, block)
+
+	block, err = client.GetBlockByRound(context.Background(), &BlockByRoundRequest{Round: 0, Options: options})
+	require.Nil(t, block)
+	requireStatusCode(t, err, codes.Internal)
+}
+
+func receiveAllBlocks(stream NodeAPI_StreamBlocksClient) ([]*Block, error) {
+	blocks := make([]*Block, 0)
+	for {
+		block, err := stream.Recv()
+		if err == io.EOF {
+			return blocks, nil
+		}
+		if err != nil {
+			return blocks, err
+		}
+
+		blocks = append(blocks, block)
+	}
+}
+
+func TestNodeAPIService_StreamBlocks(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			if nonce == 100 {
+				return nil, expectedErr
+			}
+
+			return &api.Block{Nonce: nonce}, nil
+		},
+	}
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestService(t, facade)
+		stream, err := client.StreamBlocks(context.Background(), &BlockRangeRequest{FromNonce: 5, ToNonce: 4})
+		require.Nil(t, err)
+		_, err = receiveAllBlocks(stream)
+		requireStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("too many blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestService(t, facade)
+		stream, err := client.StreamBlocks(context.Background(), &BlockRangeRequest{FromNonce: 1, ToNonce: 1 + testMaxBlocksPerStream})
+		require.Nil(t, err)
+		_, err = receiveAllBlocks(stream)
+		requireStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("facade error should stop the stream", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestService(t, facade)
+		stream, err := client.StreamBlocks(context.Background(), &BlockRangeRequest{FromNonce: 98, ToNonce: 101})
+		require.Nil(t, err)
+		blocks, err := receiveAllBlocks(stream)
+		requireStatusCode(t, err, codes.Internal)
+		require.Len(t, blocks, 2)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestService(t, facade)
+		stream, err := client.StreamBlocks(context.Background(), &BlockRangeRequest{FromNonce: 3, ToNonce: 7})
+		require.Nil(t, err)
+		blocks, err := receiveAllBlocks(stream)
+		require.Nil(t, err)
+		require.Len(t, blocks, 5)
+		for idx, block := range blocks {
+			require.Equal(t, uint64(3+idx), block.Nonce)
+		}
+	})
+}
+
+func createTransactionsFacade(sentTxs *[]*transaction.Transaction) *mock.FacadeStub {
+	return &mock.FacadeStub{
+		CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+			if txArgs.Nonce == 0 {
+				return nil, nil, expectedErr
+			}
+
+			return &transaction.Transaction{Nonce: txArgs.Nonce, Data: txArgs.DataField}, []byte(fmt.Sprintf("hash%d", txArgs.Nonce)), nil
+		},
+		ValidateTransactionHandler: func(tx *transaction.Transaction) error {
+			if tx.Nonce == 13 {
+				return expectedErr
+			}
+
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+			*sentTxs = append(*sentTxs, txs...)
+			return uint64(len(txs)), nil
+		},
+	}
+}
+
+func TestNodeAPIService_SendTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestService(t, &mock.FacadeStub{})
+		response, err := client.SendTransactions(context.Background(), &SendTransactionsRequest{})
+		require.Nil(t, response)
+		requireStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("too many transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		sentTxs := make([]*transaction.Transaction, 0)
+		client := startTestService(t, createTransactionsFacade(&sentTxs))
+
+		txs := make([]*Transaction, maxTransactionsPerRequest+1)
+		for i := range txs {
+			txs[i] = &Transaction{Nonce: 1}
+		}
+		response, err := client.SendTransactions(context.Background(), &SendTransactionsRequest{Transactions: txs})
+		require.Nil(t, response)
+		requireStatusCode(t, err, codes.InvalidArgument)
+		require.Contains(t, err.Error(), errTooManyTransactions.Error())
+		require.Empty(t, sentTxs)
+	})
+	t.Run("invalid transaction should not send any transaction", func(t *testing.T) {
+		t.Parallel()
+
+		sentTxs := make([]*transaction.Transaction, 0)
+		client := startTestService(t, createTransactionsFacade(&sentTxs))
+
+		response, err := client.SendTransactions(context.Background(), &SendTransactionsRequest{
+			Transactions: []*Transaction{{Nonce: 1}, {Nonce: 13}},
+		})
+		require.Nil(t, response)
+		requireStatusCode(t, err, codes.InvalidArgument)
+		require.Contains(t, err.Error(), "transaction 1")
+
+		response, err = client.SendTransactions(context.Background(), &SendTransactionsRequest{
+			Transactions: []*Transaction{{Nonce: 0}},
+		})
+		require.Nil(t, response)
+		requireStatusCode(t, err, codes.InvalidArgument)
+		require.Contains(t, err.Error(), "transaction 0")
+		require.Empty(t, sentTxs)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sentTxs := make([]*transaction.Transaction, 0)
+		client := startTestService(t, createTransactionsFacade(&sentTxs))
+
+		response, err := client.SendTransactions(context.Background(), &SendTransactionsRequest{
+			Transactions: []*Transaction{{Nonce: 1, Data: []byte("data")}, {Nonce: 2}},
+		})
+		require.Nil(t, err)
+		require.Equal(t, uint64(2), response.NumOfSentTxs)
+		require.Equal(t, []string{hex.EncodeToString([]byte("hash1")), hex.EncodeToString([]byte("hash2"))}, response.TxsHashes)
+		require.Len(t, sentTxs, 2)
+		require.Equal(t, []byte("data"), sentTxs[0].Data)
+	})
+}
+
+func TestNodeAPIService_SimulateTransaction(t *testing.T) {
+	t.Parallel()
+
+	sentTxs := make([]*transaction.Transaction, 0)
+	facade := createTransactionsFacade(&sentTxs)
+	facade.ValidateTransactionForSimulationHandler = func(tx *transaction.Transaction, checkSignature bool) error {
+		if checkSignature {
+			return expectedErr
+		}
+
+		return nil
+	}
+	facade.SimulateTransactionExecutionHandler = func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+		return &txSimData.SimulationResultsWithVMOutput{
+			SimulationResults: transaction.SimulationResults{
+				Status: transaction.TxStatusSuccess,
+				ScResults: map[string]*transaction.ApiSmartContractResult{
+					"scr2": {Nonce: 2, Value: big.NewInt(5), Data: "@6f6b"},
+					"scr1": {Nonce: 1},
+				},
+				Logs: &transaction.ApiLogs{
+					Address: "erd1",
+					Events:  []*transaction.Events{{Identifier: "transfer", Topics: [][]byte{[]byte("topic")}}},
+				},
+			},
+		}, nil
+	}
+	client := startTestService(t, facade)
+
+	response, err := client.SimulateTransaction(context.Background(), &SimulateTransactionRequest{})
+	require.Nil(t, response)
+	requireStatusCode(t, err, codes.InvalidArgument)
+
+	response, err = client.SimulateTransaction(context.Background(), &SimulateTransactionRequest{Transaction: &Transaction{Nonce: 1}})
+	require.Nil(t, response)
+	requireStatusCode(t, err, codes.InvalidArgument)
+
+	response, err = client.SimulateTransaction(context.Background(), &SimulateTransactionRequest{
+		Transaction:        &Transaction{Nonce: 1},
+		SkipSignatureCheck: true,
+	})
+	require.Nil(t, err)
+	require.Equal(t, &SimulationResponse{
+		Hash:   hex.EncodeToString([]byte("hash1")),
+		Status: string(transaction.TxStatusSuccess),
+		ScResults: []*SmartContractResult{
+			{Hash: "scr1", Nonce: 1, Value: "0"},
+			{Hash: "scr2", Nonce: 2, Value: "5", Data: []byte("@6f6b")},
+		},
+		Logs: &Logs{
+			Address: "erd1",
+			Events:  []*Event{{Identifier: "transfer", Topics: [][]byte{[]byte("topic")}}},
+		},
+	}, response)
+	require.Empty(t, sentTxs)
+}
+
+func TestNodeAPIService_ComputeTransactionCost(t *testing.T) {
+	t.Parallel()
+
+	sentTxs := make([]*transaction.Transaction, 0)
+	facade := createTransactionsFacade(&sentTxs)
+	facade.ComputeTransactionGasLimitHandler = func(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+		if tx.Nonce == 2 {
+			return nil, expectedErr
+		}
+
+		return &transaction.CostResponse{GasUnits: 50000, ReturnMessage: "ok"}, nil
+	}
+	client := startTestService(t, facade)
+
+	response, err := client.ComputeTransactionCost(context.Background(), &Transaction{Nonce: 0})
+	require.Nil(t, response)
+	requireStatusCode(t, err, codes.InvalidArgument)
+
+	response, err = client.ComputeTransactionCost(context.Background(), &Transaction{Nonce: 2})
+	require.Nil(t, response)
+	requireStatusCode(t, err, codes.Internal)
+
+	response, err = client.ComputeTransactionCost(context.Background(), &Transaction{Nonce: 1})
+	require.Nil(t, err)
+	require.Equal(t, uint64(50000), response.GasUnits)
+	require.Equal(t, "ok", response.ReturnMessage)
+	require.Empty(t, response.ScResults)
+	require.Nil(t, response.Logs)
+}
+
+func TestNodeAPIService_ExecuteVMQuery(t *testing.T) {
+	t.Parallel()
+
+	scAddress := hex.EncodeToString([]byte("sc address"))
+	callerAddress := hex.EncodeToString([]byte("caller"))
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+			require.Equal(t, &process.SCQuery{
+				ScAddress:      []byte("sc address"),
+				FuncName:       "getSum",
+				CallerAddr:     []byte("caller"),
+				CallValue:      big.NewInt(10),
+				Arguments:      [][]byte{[]byte("arg")},
+				SameScState:    true,
+				ShouldBeSynced: true,
+				BlockNonce:     core.OptionalUint64{Value: 8, HasValue: true},
+			}, query)
+
+			return &vm.VMOutputApi{
+				ReturnData: [][]byte{{42}},
+				ReturnCode: "ok",
+			}, api.BlockInfo{Nonce: 8}, nil
+		},
+	}
+	client := startTestService(t, facade)
+
+	response, err := client.ExecuteVMQuery(context.Background(), &VMQueryRequest{ScAddress: "not hex"})
+	require.Nil(t, response)
+	requireStatusCode(t, err, codes.InvalidArgument)
+
+	response, err = client.ExecuteVMQuery(context.Background(), &VMQueryRequest{ScAddress: scAddress, CallValue: "ten"})
+	require.Nil(t, response)
+	requireStatusCode(t, err, codes.InvalidArgument)
+
+	response, err = client.ExecuteVMQuery(context.Background(), &VMQueryRequest{
+		ScAddress:      scAddress,
+		FuncName:       "getSum",
+		CallerAddr:     callerAddress,
+		CallValue:      "10",
+		Args:           [][]byte{[]byte("arg")},
+		SameScState:    true,
+		ShouldBeSynced: true,
+		HasBlockNonce:  true,
+		BlockNonce:     8,
+	})
+	require.Nil(t, err)
+	require.Equal(t, &VMQueryResponse{
+		ReturnData: [][]byte{{42}},
+		ReturnCode: "ok",
+		GasRefund:  "0",
+		BlockInfo:  &BlockInfo{Nonce: 8},
+	}, response)
+}
+
+func TestNodeAPIService_Proofs(t *testing.T) {
+	t.Parallel()
+
+	providedProof := &common.GetProofResponse{
+		Proof:    [][]byte{[]byte("node1"), []byte("node2")},
+		Value:    []byte("value"),
+		RootHash: "root hash",
+	}
+	expectedProof := &ProofResponse{
+		Proof:    [][]byte{[]byte("node1"), []byte("node2")},
+		Value:    []byte("value"),
+		RootHash: "root hash",
+	}
+	facade := &mock.FacadeStub{
+		GetProofCalled: func(rootHash string, address string) (*common.GetProofResponse, error) {
+			require.Equal(t, "root hash", rootHash)
+			return providedProof, nil
+		},
+		GetProofCurrentRootHashCalled: func(address string) (*common.GetProofResponse, error) {
+			return nil, expectedErr
+		},
+		GetProofDataTrieCalled: func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+			require.Equal(t, "key", key)
+			return providedProof, providedProof, nil
+		},
+		VerifyProofCalled: func(rootHash string, address string, proof [][]byte) (bool, error) {
+			require.Equal(t, providedProof.Proof, proof)
+			return true, nil
+		},
+	}
+	client := startTestService(t, facade)
+
+	proof, err := client.GetProof(context.Background(), &ProofRequest{RootHash: "root hash"})
+	require.Nil(t, proof)
+	requireStatusCode(t, err, codes.InvalidArgument)
+
+	proof, err = client.GetProof(context.Background(), &ProofRequest{RootHash: "root hash", Address: "alice"})
+	require.Nil(t, err)
+	require.Equal(t, expectedProof, proof)
+
+	proof, err = client.GetProof(context.Background(), &ProofRequest{Address: "alice"})
+	require.Nil(t, proof)
+	requireStatusCode(t, err, codes.Internal)
+
+	dataTrieProof, err := client.GetProofDataTrie(context.Background(), &ProofDataTrieRequest{RootHash: "root hash", Address: "alice", Key: "key"})
+	require.Nil(t, err)
+	require.Equal(t, &ProofDataTrieResponse{MainProof: expectedProof, DataTrieProof: expectedProof}, dataTrieProof)
+
+	verifyResponse, err := client.VerifyProof(context.Background(), &VerifyProofRequest{RootHash: "root hash", Address: "alice", Proof: providedProof.Proof})
+	require.Nil(t, err)
+	require.True(t, verifyResponse.Ok)
+}
@@ -0,0 +1,382 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+	headerRetryAfter         = "Retry-After"
+	retryAfterInSeconds      = "1"
+	anonymousApiKeyName      = "anonymous"
+	unknownApiKeyName        = "unknown"
+)
+
+// ArgsApiKeysThrottler holds the arguments needed to create a new instance of apiKeysThrottler
+type ArgsApiKeysThrottler struct {
+	Config        config.ApiKeysConfig
+	StatusHandler core.AppStatusHandler
+}
+
+type apiKeyTier struct {
+	requestsPerSecond          uint32
+	maxConcurrentHeavyRequests uint32
+	heavyEndpoints             map[string]struct{}
+	allowedGroups              map[string]struct{}
+	endpointsLimits            map[string]uint32
+}
+
+type apiKeyData struct {
+	name string
+	tier *apiKeyTier
+}
+
+type apiKeyUsage struct {
+	windowStart         int64
+	numRequests         uint32
+	numEndpointRequests map[string]uint32
+	numHeavyRequests    uint32
+}
+
+// apiKeysThrottler is a middleware limiter which authenticates the requests by their API key and applies the quotas
+// of the tier the key belongs to. The quotas are counted on windows of one second
+type apiKeysThrottler struct {
+	keysFile                string
+	headerName              string
+	allowRequestsWithoutKey bool
+	statusHandler           core.AppStatusHandler
+	getTimeHandler          func() time.Time
+
+	mutKeys         sync.RWMutex
+	keys            map[string]*apiKeyData
+	keysFileModTime time.Time
+	mutUsage        sync.Mutex
+	usageByKeyName  map[string]*apiKeyUsage
+}
+
+// NewApiKeysThrottler creates a new instance of an apiKeysThrottler, loading the API keys file
+func NewApiKeysThrottler(args ArgsApiKeysThrottler) (*apiKeysThrottler, error) {
+	if check.IfNil(args.StatusHandler) {
+		return nil, ErrNilAppStatusHandler
+	}
+	if len(args.Config.HeaderName) == 0 {
+		return nil, ErrEmptyApiKeyHeaderName
+	}
+
+	akt := &apiKeysThrottler{
+		keysFile:                args.Config.KeysFile,
+		headerName:              args.Config.HeaderName,
+		allowRequestsWithoutKey: args.Config.AllowRequestsWithoutKey,
+		statusHandler:           args.StatusHandler,
+		getTimeHandler:          time.Now,
+		usageByKeyName:          make(map[string]*apiKeyUsage),
+	}
+
+	err := akt.ReloadIfChanged()
+	if err != nil {
+		return nil, err
+	}
+
+	return akt, nil
+}
+
+// ReloadIfChanged loads the API keys file again if it was modified since the last load. On error, the previously
+// loaded API keys are kept
+func (akt *apiKeysThrottler) ReloadIfChanged() error {
+	fileInfo, err := os.Stat(akt.keysFile)
+	if err != nil {
+		return err
+	}
+
+	akt.mutKeys.RLock()
+	isChanged := !fileInfo.ModTime().Equal(akt.keysFileModTime)
+	akt.mutKeys.RUnlock()
+	if !isChanged {
+		return nil
+	}
+
+	keysFileConfig := config.ApiKeysFileConfig{}
+	err = core.LoadTomlFile(&keysFileConfig, akt.keysFile)
+	if err != nil {
+		return err
+	}
+
+	keys, err := createApiKeys(keysFileConfig)
+	if err != nil {
+		return err
+	}
+
+	akt.mutKeys.Lock()
+	akt.keys = keys
+	akt.keysFileModTime = fileInfo.ModTime()
+	akt.mutKeys.Unlock()
+
+	log.Debug("loaded API keys", "file", akt.keysFile, "num tiers", len(keysFileConfig.Tiers), "num keys", len(keys))
+
+	return nil
+}
+
+func createApiKeys(keysFileConfig config.ApiKeysFileConfig) (map[string]*apiKeyData, error) {
+	tiers := make(map[string]*apiKeyTier, len(keysFileConfig.Tiers))
+	for _, tierConfig := range keysFileConfig.Tiers {
+		_, exists := tiers[tierConfig.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: duplicated tier %s", ErrInvalidApiKeysConfig, tierConfig.Name)
+		}
+
+		tier, err := createApiKeyTier(tierConfig)
+		if err != nil {
+			return nil, err
+		}
+		tiers[tierConfig.Name] = tier
+	}
+
+	keys := make(map[string]*apiKeyData, len(keysFileConfig.Keys))
+	keyNames := make(map[string]struct{}, len(keysFileConfig.Keys))
+	for _, keyConfig := range keysFileConfig.Keys {
+		if len(keyConfig.Name) == 0 || len(keyConfig.Key) == 0 {
+			return nil, fmt.Errorf("%w: empty API key name or value", ErrInvalidApiKeysConfig)
+		}
+
+		_, nameExists := keyNames[keyConfig.Name]
+		_, keyExists := keys[keyConfig.Key]
+		if nameExists || keyExists {
+			return nil, fmt.Errorf("%w: duplicated API key %s", ErrInvalidApiKeysConfig, keyConfig.Name)
+		}
+
+		tier, ok := tiers[keyConfig.Tier]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown tier %s for API key %s", ErrInvalidApiKeysConfig, keyConfig.Tier, keyConfig.Name)
+		}
+
+		keyNames[keyConfig.Name] = struct{}{}
+		keys[keyConfig.Key] = &apiKeyData{
+			name: keyConfig.Name,
+			tier: tier,
+		}
+	}
+
+	return keys, nil
+}
+
+func createApiKeyTier(tierConfig config.ApiKeyTierConfig) (*apiKeyTier, error) {
+	if len(tierConfig.Name) == 0 {
+		return nil, fmt.Errorf("%w: empty tier name", ErrInvalidApiKeysConfig)
+	}
+	if tierConfig.RequestsPerSecond == 0 {
+		return nil, fmt.Errorf("%w: zero requests per second for tier %s", ErrInvalidApiKeysConfig, tierConfig.Name)
+	}
+	if len(tierConfig.HeavyEndpoints) > 0 && tierConfig.MaxConcurrentHeavyRequests == 0 {
+		return nil, fmt.Errorf("%w: zero concurrent heavy requests for tier %s", ErrInvalidApiKeysConfig, tierConfig.Name)
+	}
+
+	tier := &apiKeyTier{
+		requestsPerSecond:          tierConfig.RequestsPerSecond,
+		maxConcurrentHeavyRequests: tierConfig.MaxConcurrentHeavyRequests,
+		heavyEndpoints:             make(map[string]struct{}, len(tierConfig.HeavyEndpoints)),
+		allowedGroups:              make(map[string]struct{}, len(tierConfig.AllowedGroups)),
+		endpointsLimits:            make(map[string]uint32, len(tierConfig.EndpointsLimits)),
+	}
+	for _, endpoint := range tierConfig.HeavyEndpoints {
+		tier.heavyEndpoints[endpoint] = struct{}{}
+	}
+	for _, group := range tierConfig.AllowedGroups {
+		tier.allowedGroups[group] = struct{}{}
+	}
+	for _, endpointLimit := range tierConfig.EndpointsLimits {
+		if endpointLimit.RequestsPerSecond == 0 {
+			return nil, fmt.Errorf("%w: zero requests per second for endpoint %s of tier %s",
+				ErrInvalidApiKeysConfig, endpointLimit.Endpoint, tierConfig.Name)
+		}
+		tier.endpointsLimits[endpointLimit.Endpoint] = endpointLimit.RequestsPerSecond
+	}
+
+	return tier, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (akt *apiKeysThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiKey := c.GetHeader(akt.headerName)
+		if len(apiKey) == 0 {
+			if akt.allowRequestsWithoutKey {
+				c.Next()
+				return
+			}
+
+			akt.reject(c, http.StatusUnauthorized, anonymousApiKeyName, ErrMissingApiKey)
+			return
+		}
+
+		akt.mutKeys.RLock()
+		key, ok := akt.keys[apiKey]
+		akt.mutKeys.RUnlock()
+		if !ok {
+			akt.reject(c, http.StatusUnauthorized, unknownApiKeyName, ErrInvalidApiKey)
+			return
+		}
+
+		// the full path is the route pattern, e.g. /address/:address/balance, being empty for unknown routes
+		endpoint := c.FullPath()
+		if !key.tier.isGroupAllowed(endpoint) {
+			akt.reject(c, http.StatusForbidden, key.name, fmt.Errorf("%w: %s", ErrRouteGroupNotAllowed, endpoint))
+			return
+		}
+
+		isHeavy, err := akt.startRequest(c, key, endpoint)
+		if err != nil {
+			c.Header(headerRetryAfter, retryAfterInSeconds)
+			akt.reject(c, http.StatusTooManyRequests, key.name, err)
+			return
+		}
+		if isHeavy {
+			defer akt.finishHeavyRequest(key.name)
+		}
+
+		shared.AddBatchCallsCounter(c, func(numCalls uint32) bool {
+			return akt.countBatchCalls(key, numCalls)
+		})
+
+		c.Next()
+	}
+}
+
+// startRequest counts the request against the quotas of the API key, returning true if the request is a heavy one
+func (akt *apiKeysThrottler) startRequest(c *gin.Context, key *apiKeyData, endpoint string) (bool, error) {
+	now := akt.getTimeHandler().Unix()
+	tier := key.tier
+
+	akt.mutUsage.Lock()
+	defer akt.mutUsage.Unlock()
+
+	usage := akt.getUsage(key.name, now)
+	defer setRateLimitHeaders(c, tier.requestsPerSecond, usage, now)
+
+	if usage.numRequests >= tier.requestsPerSecond {
+		return false, fmt.Errorf("%w for API key %s", ErrTooManyRequests, key.name)
+	}
+
+	endpointLimit, hasEndpointLimit := tier.endpointsLimits[endpoint]
+	if hasEndpointLimit && usage.numEndpointRequests[endpoint] >= endpointLimit {
+		return false, fmt.Errorf("%w for API key %s on endpoint %s", ErrTooManyRequests, key.name, endpoint)
+	}
+
+	_, isHeavy := tier.heavyEndpoints[endpoint]
+	if isHeavy && usage.numHeavyRequests >= tier.maxConcurrentHeavyRequests {
+		return false, fmt.Errorf("%w for API key %s", ErrTooManyHeavyRequests, key.name)
+	}
+
+	usage.numRequests++
+	if hasEndpointLimit {
+		usage.numEndpointRequests[endpoint]++
+	}
+	if isHeavy {
+		usage.numHeavyRequests++
+	}
+
+	return isHeavy, nil
+}
+
+// countBatchCalls counts the additional calls of a batch request against the requests quota of the API key
+func (akt *apiKeysThrottler) countBatchCalls(key *apiKeyData, numCalls uint32) bool {
+	now := akt.getTimeHandler().Unix()
+
+	akt.mutUsage.Lock()
+	defer akt.mutUsage.Unlock()
+
+	usage := akt.getUsage(key.name, now)
+	usage.numRequests += numCalls
+
+	return usage.numRequests <= key.tier.requestsPerSecond
+}
+
+// getUsage returns the usage of the API key, resetting the requests counters when a new window starts. The heavy
+// requests counter is not reset, as it holds the requests still in progress
+func (akt *apiKeysThrottler) getUsage(keyName string, now int64) *apiKeyUsage {
+	usage, ok := akt.usageByKeyName[keyName]
+	if !ok {
+		usage = &apiKeyUsage{}
+		akt.usageByKeyName[keyName] = usage
+	}
+
+	if usage.windowStart != now || usage.numEndpointRequests == nil {
+		usage.windowStart = now
+		usage.numRequests = 0
+		usage.numEndpointRequests = make(map[string]uint32)
+	}
+
+	return usage
+}
+
+func (akt *apiKeysThrottler) finishHeavyRequest(keyName string) {
+	akt.mutUsage.Lock()
+	defer akt.mutUsage.Unlock()
+
+	usage, ok := akt.usageByKeyName[keyName]
+	if ok && usage.numHeavyRequests > 0 {
+		usage.numHeavyRequests--
+	}
+}
+
+func setRateLimitHeaders(c *gin.Context, limit uint32, usage *apiKeyUsage, now int64) {
+	remaining := uint32(0)
+	if usage.numRequests < limit {
+		remaining = limit - usage.numRequests
+	}
+
+	c.Header(headerRateLimitLimit, strconv.FormatUint(uint64(limit), 10))
+	c.Header(headerRateLimitRemaining, strconv.FormatUint(uint64(remaining), 10))
+	c.Header(headerRateLimitReset, strconv.FormatInt(now+1, 10))
+}
+
+func (akt *apiKeysThrottler) reject(c *gin.Context, status int, keyName string, err error) {
+	log.Debug("API request rejected", "API key", keyName, "path", c.Request.URL.Path, "error", err)
+
+	akt.statusHandler.Increment(common.MetricApiKeysRejectedRequests)
+	akt.statusHandler.SetStringValue(common.MetricApiKeysLastRejectedKey, keyName)
+
+	returnCode := shared.ReturnCodeRequestError
+	if status == http.StatusTooManyRequests {
+		returnCode = shared.ReturnCodeSystemBusy
+	}
+
+	c.AbortWithStatusJSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: err.Error(),
+			Code:  returnCode,
+		},
+	)
+}
+
+func (tier *apiKeyTier) isGroupAllowed(endpoint string) bool {
+	if len(tier.allowedGroups) == 0 || len(endpoint) == 0 {
+		return true
+	}
+
+	group, _, _ := strings.Cut(strings.TrimPrefix(endpoint, "/"), "/")
+	_, ok := tier.allowedGroups[group]
+
+	return ok
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (akt *apiKeysThrottler) IsInterfaceNil() bool {
+	return akt == nil
+}
@@ -0,0 +1,441 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testApiKeyHeader = "X-Api-Key"
+	basicApiKey      = "basic-secret"
+	premiumApiKey    = "premium-secret"
+)
+
+const testApiKeysFile = `
+[[Tiers]]
+    Name = "basic"
+    RequestsPerSecond = 3
+    MaxConcurrentHeavyRequests = 1
+    HeavyEndpoints = ["/address/:address/keys"]
+    AllowedGroups = ["address", "transaction"]
+    EndpointsLimits = [{ Endpoint = "/transaction/send", RequestsPerSecond = 1 }]
+
+[[Tiers]]
+    Name = "premium"
+    RequestsPerSecond = 100
+
+[[Keys]]
+    Name = "basic-user"
+    Key = "basic-secret"
+    Tier = "basic"
+
+[[Keys]]
+    Name = "premium-user"
+    Key = "premium-secret"
+    Tier = "premium"
+`
+
+func writeApiKeysFile(tb testing.TB, dir string, content string) string {
+	filePath := filepath.Join(dir, "apiKeys.toml")
+	err := os.WriteFile(filePath, []byte(content), 0644)
+	require.Nil(tb, err)
+
+	return filePath
+}
+
+func createMockArgsApiKeysThrottler(keysFile string) middleware.ArgsApiKeysThrottler {
+	return middleware.ArgsApiKeysThrottler{
+		Config: config.ApiKeysConfig{
+			Enabled:                 true,
+			KeysFile:                keysFile,
+			HeaderName:              testApiKeyHeader,
+			AllowRequestsWithoutKey: true,
+		},
+		StatusHandler: &statusHandler.AppStatusHandlerStub{},
+	}
+}
+
+func startNodeServerApiKeysThrottler(mw shared.MiddlewareProcessor, handler gin.HandlerFunc) *gin.Engine {
+	ws := gin.New()
+	ws.Use(mw.MiddlewareHandlerFunc())
+	ws.GET("/address/:address/balance", handler)
+	ws.GET("/address/:address/keys", handler)
+	ws.POST("/transaction/send", handler)
+	ws.GET("/network/config", handler)
+
+	return ws
+}
+
+func doApiKeyRequest(ws *gin.Engine, method string, path string, apiKey string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, nil)
+	if len(apiKey) > 0 {
+		req.Header.Set(testApiKeyHeader, apiKey)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewApiKeysThrottler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsApiKeysThrottler(writeApiKeysFile(t, t.TempDir(), testApiKeysFile))
+		args.StatusHandler = nil
+
+		akt, err := middleware.NewApiKeysThrottler(args)
+		assert.True(t, check.IfNil(akt))
+		assert.Equal(t, middleware.ErrNilAppStatusHandler, err)
+	})
+	t.Run("empty header name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsApiKeysThrottler(writeApiKeysFile(t, t.TempDir(), testApiKeysFile))
+		args.Config.HeaderName = ""
+
+		akt, err := middleware.NewApiKeysThrottler(args)
+		assert.True(t, check.IfNil(akt))
+		assert.Equal(t, middleware.ErrEmptyApiKeyHeaderName, err)
+	})
+	t.Run("missing keys file should error", func(t *testing.T) {
+		t.Parallel()
+
+		akt, err := middleware.NewApiKeysThrottler(createMockArgsApiKeysThrottler(filepath.Join(t.TempDir(), "missing.toml")))
+		assert.True(t, check.IfNil(akt))
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid keys files should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidFiles := map[string]string{
+			"duplicated tier": `
+[[Tiers]]
+    Name = "basic"
+    RequestsPerSecond = 1
+[[Tiers]]
+    Name = "basic"
+    RequestsPerSecond = 2`,
+			"zero requests per second": `
+[[Tiers]]
+    Name = "basic"
+    RequestsPerSecond = 0`,
+			"heavy endpoints without concurrency limit": `
+[[Tiers]]
+    Name = "basic"
+    RequestsPerSecond = 1
+    HeavyEndpoints = ["/address/:address/keys"]`,
+			"zero endpoint limit": `
+[[Tiers]]
+    Name = "basic"
+    RequestsPerSecond = 1
+    EndpointsLimits = [{ Endpoint = "/transaction/send", RequestsPerSecond = 0 }]`,
+			"unknown tier": `
+[[Tiers]]
+    Name = "basic"
+    RequestsPerSecond = 1
+[[Keys]]
+    Name = "user"
+    Key = "secret"
+    Tier = "premium"`,
+			"duplicated key": `
+[[Tiers]]
+    Name = "basic"
+    RequestsPerSecond = 1
+[[Keys]]
+    Name = "user1"
+    Key = "secret"
+    Tier = "basic"
+[[Keys]]
+    Name = "user2"
+    Key = "secret"
+    Tier = "basic"`,
+			"empty key": `
+[[Tiers]]
+    Name = "basic"
+    RequestsPerSecond = 1
+[[Keys]]
+    Name = "user"
+    Tier = "basic"`,
+		}
+
+		for name, content := range invalidFiles {
+			akt, err := middleware.NewApiKeysThrottler(createMockArgsApiKeysThrottler(writeApiKeysFile(t, t.TempDir(), content)))
+			assert.True(t, check.IfNil(akt), name)
+			assert.True(t, errors.Is(err, middleware.ErrInvalidApiKeysConfig), name)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		akt, err := middleware.NewApiKeysThrottler(createMockArgsApiKeysThrottler(writeApiKeysFile(t, t.TempDir(), testApiKeysFile)))
+		assert.False(t, check.IfNil(akt))
+		assert.Nil(t, err)
+	})
+}
+
+func TestApiKeysThrottler_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	okHandler := func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	}
+
+	t.Run("requests without key", func(t *testing.T) {
+		t.Parallel()
+
+		keysFile := writeApiKeysFile(t, t.TempDir(), testApiKeysFile)
+		akt, _ := middleware.NewApiKeysThrottler(createMockArgsApiKeysThrottler(keysFile))
+		ws := startNodeServerApiKeysThrottler(akt, okHandler)
+
+		resp := doApiKeyRequest(ws, http.MethodGet, "/network/config", "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, resp.Header().Get("X-RateLimit-Limit"))
+
+		rejectedKeys := make([]string, 0)
+		args := createMockArgsApiKeysThrottler(keysFile)
+		args.Config.AllowRequestsWithoutKey = false
+		args.StatusHandler = &statusHandler.AppStatusHandlerStub{
+			SetStringValueHandler: func(key string, value string) {
+				if key == common.MetricApiKeysLastRejectedKey {
+					rejectedKeys = append(rejectedKeys, value)
+				}
+			},
+		}
+		akt, _ = middleware.NewApiKeysThrottler(args)
+		ws = startNodeServerApiKeysThrottler(akt, okHandler)
+
+		resp = doApiKeyRequest(ws, http.MethodGet, "/network/config", "")
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Contains(t, resp.Body.String(), middleware.ErrMissingApiKey.Error())
+		assert.Equal(t, []string{"anonymous"}, rejectedKeys)
+	})
+	t.Run("unknown key should be rejected and reported", func(t *testing.T) {
+		t.Parallel()
+
+		numRejected := 0
+		rejectedKeys := make([]string, 0)
+		args := createMockArgsApiKeysThrottler(writeApiKeysFile(t, t.TempDir(), testApiKeysFile))
+		args.StatusHandler = &statusHandler.AppStatusHandlerStub{
+			IncrementHandler: func(key string) {
+				if key == common.MetricApiKeysRejectedRequests {
+					numRejected++
+				}
+			},
+			SetStringValueHandler: func(key string, value string) {
+				if key == common.MetricApiKeysLastRejectedKey {
+					rejectedKeys = append(rejectedKeys, value)
+				}
+			},
+		}
+		akt, _ := middleware.NewApiKeysThrottler(args)
+		ws := startNodeServerApiKeysThrottler(akt, okHandler)
+
+		resp := doApiKeyRequest(ws, http.MethodGet, "/network/config", "unknown-secret")
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Contains(t, resp.Body.String(), middleware.ErrInvalidApiKey.Error())
+		assert.Equal(t, 1, numRejected)
+		assert.Equal(t, []string{"unknown"}, rejectedKeys)
+	})
+	t.Run("route group not allowed should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		akt, _ := middleware.NewApiKeysThrottler(createMockArgsApiKeysThrottler(writeApiKeysFile(t, t.TempDir(), testApiKeysFile)))
+		ws := startNodeServerApiKeysThrottler(akt, okHandler)
+
+		resp := doApiKeyRequest(ws, http.MethodGet, "/network/config", basicApiKey)
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+		assert.Contains(t, resp.Body.String(), middleware.ErrRouteGroupNotAllowed.Error())
+
+		resp = doApiKeyRequest(ws, http.MethodGet, "/network/config", premiumApiKey)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("requests per second quota", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		akt, _ := middleware.NewApiKeysThrottler(createMockArgsApiKeysThrottler(writeApiKeysFile(t, t.TempDir(), testApiKeysFile)))
+		akt.SetGetTimeHandler(func() time.Time {
+			return currentTime
+		})
+		ws := startNodeServerApiKeysThrottler(akt, okHandler)
+
+		for i := 0; i < 3; i++ {
+			resp := doApiKeyRequest(ws, http.MethodGet, "/address/erd1/balance", basicApiKey)
+			require.Equal(t, http.StatusOK, resp.Code)
+			assert.Equal(t, "3", resp.Header().Get("X-RateLimit-Limit"))
+			assert.Equal(t, []string{"2", "1", "0"}[i], resp.Header().Get("X-RateLimit-Remaining"))
+			assert.Equal(t, "1001", resp.Header().Get("X-RateLimit-Reset"))
+		}
+
+		resp := doApiKeyRequest(ws, http.MethodGet, "/address/erd1/balance", basicApiKey)
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.Equal(t, "0", resp.Header().Get("X-RateLimit-Remaining"))
+		assert.Equal(t, "1", resp.Header().Get("Retry-After"))
+		assert.Contains(t, resp.Body.String(), middleware.ErrTooManyRequests.Error())
+
+		// the quotas of the other keys are not affected
+		resp = doApiKeyRequest(ws, http.MethodGet, "/address/erd1/balance", premiumApiKey)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "99", resp.Header().Get("X-RateLimit-Remaining"))
+
+		currentTime = time.Unix(1001, 0)
+		resp = doApiKeyRequest(ws, http.MethodGet, "/address/erd1/balance", basicApiKey)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "2", resp.Header().Get("X-RateLimit-Remaining"))
+		assert.Equal(t, "1002", resp.Header().Get("X-RateLimit-Reset"))
+	})
+	t.Run("endpoint quota", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		akt, _ := middleware.NewApiKeysThrottler(createMockArgsApiKeysThrottler(writeApiKeysFile(t, t.TempDir(), testApiKeysFile)))
+		akt.SetGetTimeHandler(func() time.Time {
+			return currentTime
+		})
+		ws := startNodeServerApiKeysThrottler(akt, okHandler)
+
+		resp := doApiKeyRequest(ws, http.MethodPost, "/transaction/send", basicApiKey)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		resp = doApiKeyRequest(ws, http.MethodPost, "/transaction/send", basicApiKey)
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.Contains(t, resp.Body.String(), "/transaction/send")
+
+		resp = doApiKeyRequest(ws, http.MethodGet, "/address/erd1/balance", basicApiKey)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		currentTime = time.Unix(1001, 0)
+		resp = doApiKeyRequest(ws, http.MethodPost, "/transaction/send", basicApiKey)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("concurrent heavy requests quota", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		startedHeavyRequest := make(chan struct{})
+		finishHeavyRequest := make(chan struct{})
+		heavyHandler := func(c *gin.Context) {
+			if c.Query("block") == "true" {
+				close(startedHeavyRequest)
+				<-finishHeavyRequest
+			}
+			c.Status(http.StatusOK)
+		}
+
+		akt, _ := middleware.NewApiKeysThrottler(createMockArgsApiKeysThrottler(writeApiKeysFile(t, t.TempDir(), testApiKeysFile)))
+		akt.SetGetTimeHandler(func() time.Time {
+			return currentTime
+		})
+		ws := startNodeServerApiKeysThrottler(akt, heavyHandler)
+
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp := doApiKeyRequest(ws, http.MethodGet, "/address/erd1/keys?block=true", basicApiKey)
+			assert.Equal(t, http.StatusOK, resp.Code)
+		}()
+		<-startedHeavyRequest
+
+		resp := doApiKeyRequest(ws, http.MethodGet, "/address/erd1/keys", basicApiKey)
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.Contains(t, resp.Body.String(), middleware.ErrTooManyHeavyRequests.Error())
+
+		close(finishHeavyRequest)
+		wg.Wait()
+
+		currentTime = time.Unix(1001, 0)
+		resp = doApiKeyRequest(ws, http.MethodGet, "/address/erd1/keys", basicApiKey)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("batch calls should be counted against the requests quota", func(t *testing.T) {
+		t.Parallel()
+
+		akt, _ := middleware.NewApiKeysThrottler(createMockArgsApiKeysThrottler(writeApiKeysFile(t, t.TempDir(), testApiKeysFile)))
+		akt.SetGetTimeHandler(func() time.Time {
+			return time.Unix(1000, 0)
+		})
+		batchResults := make([]bool, 0)
+		ws := startNodeServerApiKeysThrottler(akt, func(c *gin.Context) {
+			batchResults = append(batchResults, shared.CountBatchCalls(c, 2))
+			c.Status(http.StatusOK)
+		})
+
+		resp := doApiKeyRequest(ws, http.MethodGet, "/address/erd1/balance", basicApiKey)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, []bool{true}, batchResults)
+
+		resp = doApiKeyRequest(ws, http.MethodGet, "/address/erd1/balance", basicApiKey)
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	})
+}
+
+func TestApiKeysThrottler_ReloadIfChanged(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	keysFile := writeApiKeysFile(t, dir, testApiKeysFile)
+	akt, _ := middleware.NewApiKeysThrottler(createMockArgsApiKeysThrottler(keysFile))
+	ws := startNodeServerApiKeysThrottler(akt, func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	err := akt.ReloadIfChanged()
+	assert.Nil(t, err)
+
+	newContent := `
+[[Tiers]]
+    Name = "basic"
+    RequestsPerSecond = 5
+
+[[Keys]]
+    Name = "new-user"
+    Key = "new-secret"
+    Tier = "basic"
+`
+	writeApiKeysFile(t, dir, newContent)
+	modTime := time.Now().Add(time.Minute)
+	err = os.Chtimes(keysFile, modTime, modTime)
+	require.Nil(t, err)
+
+	err = akt.ReloadIfChanged()
+	assert.Nil(t, err)
+
+	resp := doApiKeyRequest(ws, http.MethodGet, "/network/config", "new-secret")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "5", resp.Header().Get("X-RateLimit-Limit"))
+	resp = doApiKeyRequest(ws, http.MethodGet, "/network/config", premiumApiKey)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	// an invalid file should keep the previously loaded keys
+	writeApiKeysFile(t, dir, "[[Tiers]]\n    Name = \"basic\"\n")
+	modTime = modTime.Add(time.Minute)
+	err = os.Chtimes(keysFile, modTime, modTime)
+	require.Nil(t, err)
+
+	err = akt.ReloadIfChanged()
+	assert.True(t, errors.Is(err, middleware.ErrInvalidApiKeysConfig))
+
+	resp = doApiKeyRequest(ws, http.MethodGet, "/network/config", "new-secret")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
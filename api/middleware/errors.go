@@ -7,3 +7,24 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrEmptyApiKeyHeaderName signals that an empty API key header name has been provided
+var ErrEmptyApiKeyHeaderName = errors.New("empty API key header name")
+
+// ErrInvalidApiKeysConfig signals that the API keys file holds an invalid configuration
+var ErrInvalidApiKeysConfig = errors.New("invalid API keys configuration")
+
+// ErrMissingApiKey signals that the request does not hold an API key
+var ErrMissingApiKey = errors.New("missing API key")
+
+// ErrInvalidApiKey signals that the request holds an unknown API key
+var ErrInvalidApiKey = errors.New("invalid API key")
+
+// ErrRouteGroupNotAllowed signals that the route group is not accessible with the provided API key
+var ErrRouteGroupNotAllowed = errors.New("route group not allowed for the API key")
+
+// ErrTooManyHeavyRequests signals that too many heavy requests are processed at the same time for the same API key
+var ErrTooManyHeavyRequests = errors.New("too many concurrent heavy requests")
@@ -0,0 +1,8 @@
+package middleware
+
+import "time"
+
+// SetGetTimeHandler -
+func (akt *apiKeysThrottler) SetGetTimeHandler(handler func() time.Time) {
+	akt.getTimeHandler = handler
+}
@@ -57,13 +57,30 @@ func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 			return
 		}
 
-		defer gt.finish(path)
+		// the additional calls of a batch request occupy their own slots until the request is finished
+		numBatchCallsSlots := 0
+		shared.AddBatchCallsCounter(c, func(numCalls uint32) bool {
+			for i := uint32(0); i < numCalls; i++ {
+				select {
+				case gt.queue <- struct{}{}:
+					numBatchCallsSlots++
+				default:
+					return false
+				}
+			}
+
+			return true
+		})
+
+		defer func() {
+			gt.finish(path, numBatchCallsSlots)
+		}()
 
 		c.Next()
 	}
 }
 
-func (gt *globalThrottler) finish(path string) {
+func (gt *globalThrottler) finish(path string, numBatchCallsSlots int) {
 	gt.mutDebugRequests.Lock()
 	gt.debugRequests[path]--
 	if gt.debugRequests[path] < 1 {
@@ -71,7 +88,9 @@ func (gt *globalThrottler) finish(path string) {
 	}
 	gt.mutDebugRequests.Unlock()
 
-	<-gt.queue
+	for i := 0; i < numBatchCallsSlots+1; i++ {
+		<-gt.queue
+	}
 }
 
 func (gt *globalThrottler) printDebugInfo() {
@@ -13,6 +13,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -112,3 +113,24 @@ func makeRequestGlobalThrottler(ws *gin.Engine, mutResponses *sync.Mutex, respon
 	responses[resp.Code]++
 	mutResponses.Unlock()
 }
+
+func TestGlobalThrottler_BatchCallsShouldOccupySlots(t *testing.T) {
+	t.Parallel()
+
+	maxConnections := uint32(5)
+	batchResults := make([]bool, 0)
+	ws := startNodeServerGlobalThrottler(func(c *gin.Context) {
+		batchResults = append(batchResults, shared.CountBatchCalls(c, 4))
+		batchResults = append(batchResults, shared.CountBatchCalls(c, 1))
+	}, maxConnections)
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest("GET", "/address/testAddress/balance", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	}
+
+	// all the slots are released when the requests finish, so the second request has the same outcome
+	assert.Equal(t, []bool{true, false, true, false}, batchResults)
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const unknownRouteGroup = "unknown"
+
+type requestDurationMiddleware struct {
+	statusHandler core.AppStatusHandler
+}
+
+// NewRequestDurationMiddleware returns a new instance of requestDurationMiddleware
+func NewRequestDurationMiddleware(statusHandler core.AppStatusHandler) (*requestDurationMiddleware, error) {
+	if check.IfNil(statusHandler) {
+		return nil, ErrNilAppStatusHandler
+	}
+
+	return &requestDurationMiddleware{
+		statusHandler: statusHandler,
+	}, nil
+}
+
+// MiddlewareHandlerFunc reports the duration of each request, labeled by the API group of the matched route
+func (rdm *requestDurationMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		startTime := time.Now()
+
+		c.Next()
+
+		metricKey := common.ComputeLabeledMetricKey(common.MetricApiRequestDurationUs, getRouteGroup(c.FullPath()))
+		rdm.statusHandler.SetUInt64Value(metricKey, uint64(time.Since(startTime).Microseconds()))
+	}
+}
+
+// getRouteGroup returns the first segment of the route pattern, e.g. address for /address/:address/balance.
+// The requests on unknown routes are grouped together, so the reported labels can not be flooded
+func getRouteGroup(fullPath string) string {
+	group, _, _ := strings.Cut(strings.TrimPrefix(fullPath, "/"), "/")
+	if len(group) == 0 {
+		return unknownRouteGroup
+	}
+
+	return group
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rdm *requestDurationMiddleware) IsInterfaceNil() bool {
+	return rdm == nil
+}
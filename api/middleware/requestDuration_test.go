@@ -0,0 +1,74 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRequestDurationMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		rdm, err := middleware.NewRequestDurationMiddleware(nil)
+		assert.True(t, check.IfNil(rdm))
+		assert.Equal(t, middleware.ErrNilAppStatusHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rdm, err := middleware.NewRequestDurationMiddleware(&statusHandler.AppStatusHandlerStub{})
+		assert.False(t, check.IfNil(rdm))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRequestDurationMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	reportedKeys := make([]string, 0)
+	rdm, _ := middleware.NewRequestDurationMiddleware(&statusHandler.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			mut.Lock()
+			reportedKeys = append(reportedKeys, key)
+			mut.Unlock()
+		},
+	})
+
+	ws := gin.New()
+	ws.Use(rdm.MiddlewareHandlerFunc())
+	ws.GET("/address/:address/balance", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	ws.GET("/node/status", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	paths := []string{"/address/erd1/balance", "/node/status", "/missing/route"}
+	for _, path := range paths {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+	}
+
+	mut.Lock()
+	defer mut.Unlock()
+
+	expectedKeys := []string{
+		"erd_api_request_duration_us_address",
+		"erd_api_request_duration_us_node",
+		"erd_api_request_duration_us_unknown",
+	}
+	require.Equal(t, expectedKeys, reportedKeys)
+}
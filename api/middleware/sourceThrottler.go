@@ -64,10 +64,23 @@ func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 			return
 		}
 
+		shared.AddBatchCallsCounter(c, func(numCalls uint32) bool {
+			return st.countBatchCalls(remoteAddr, numCalls)
+		})
+
 		c.Next()
 	}
 }
 
+func (st *sourceThrottler) countBatchCalls(remoteAddr string, numCalls uint32) bool {
+	st.mutRequests.Lock()
+	defer st.mutRequests.Unlock()
+
+	st.sourceRequests[remoteAddr] += numCalls
+
+	return st.sourceRequests[remoteAddr] <= st.maxNumRequests
+}
+
 // Reset resets all accumulated counters
 func (st *sourceThrottler) Reset() {
 	st.mutRequests.Lock()
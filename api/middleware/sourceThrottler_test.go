@@ -11,6 +11,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -132,3 +133,26 @@ func makeRequestSourceThrottler(ws *gin.Engine, mutResponses *sync.Mutex, respon
 	responses[resp.Code]++
 	mutResponses.Unlock()
 }
+
+func TestSourceThrottler_BatchCallsShouldBeCounted(t *testing.T) {
+	t.Parallel()
+
+	maxConnections := uint32(10)
+	batchResults := make([]bool, 0)
+	ws, _ := startNodeServerSourceThrottler(func(c *gin.Context) {
+		batchResults = append(batchResults, shared.CountBatchCalls(c, 4))
+	}, maxConnections)
+
+	mutResponses := sync.Mutex{}
+	responses := make(map[int]int)
+	for i := 0; i < 3; i++ {
+		makeRequestSourceThrottler(ws, &mutResponses, responses)
+	}
+
+	// the first two requests count 5 calls each, the third one being rejected by the middleware
+	assert.Equal(t, []bool{true, true}, batchResults)
+	mutResponses.Lock()
+	assert.Equal(t, 2, responses[http.StatusOK])
+	assert.Equal(t, 1, responses[http.StatusTooManyRequests])
+	mutResponses.Unlock()
+}
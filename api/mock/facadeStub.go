@@ -16,7 +16,9 @@ import (
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 )
@@ -36,6 +38,7 @@ type FacadeStub struct {
 	ValidateTransactionForSimulationHandler     func(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactionsHandler                 func(txs []*transaction.Transaction) (uint64, error)
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	ExecuteSCQueriesHandler                     func(queries []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler                  func() (map[string]*validator.ValidatorStatistics, error)
 	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
@@ -51,6 +54,9 @@ type FacadeStub struct {
 	GetCodeHashCalled                           func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
 	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
 	IterateKeysCalled                           func(address string, numKeys uint, iteratorState [][]byte, options api.AccountQueryOptions) (map[string]string, [][]byte, api.BlockInfo, error)
+	GetAccountStateDiffCalled                   func(address string, options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error)
+	GetStateDiffCalled                          func(options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error)
+	ExportStateSnapshotCalled                   func(epoch uint32) (string, error)
 	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
 	GetESDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetAllESDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
@@ -83,6 +89,7 @@ type FacadeStub struct {
 	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
 	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
+	GetTransactionsForAddressCalled             func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
 	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
@@ -100,7 +107,9 @@ type FacadeStub struct {
 	P2PPrometheusMetricsEnabledCalled           func() bool
 	AuctionListHandler                          func() ([]*common.AuctionListValidatorAPIResponse, error)
 	GetSCRsByTxHashCalled                       func(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetTransactionTraceCalled                   func(hash string) (*tracing.TransactionTrace, error)
 	SimulateSCRExecutionCostCalled              func(scr *smartContractResult.SmartContractResult) (*transaction.CostResponse, error)
+	SubscribeCalled                             func(filter subscriptions.Filter) (*subscriptions.Subscription, error)
 }
 
 // SimulateSCRExecutionCost -
@@ -112,6 +121,24 @@ func (f *FacadeStub) SimulateSCRExecutionCost(scr *smartContractResult.SmartCont
 	return nil, nil
 }
 
+// Subscribe -
+func (f *FacadeStub) Subscribe(filter subscriptions.Filter) (*subscriptions.Subscription, error) {
+	if f.SubscribeCalled != nil {
+		return f.SubscribeCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionTrace -
+func (f *FacadeStub) GetTransactionTrace(hash string) (*tracing.TransactionTrace, error) {
+	if f.GetTransactionTraceCalled != nil {
+		return f.GetTransactionTraceCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // GetSCRsByTxHash -
 func (f *FacadeStub) GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error) {
 	if f.GetSCRsByTxHashCalled != nil {
@@ -262,6 +289,33 @@ func (f *FacadeStub) IterateKeys(address string, numKeys uint, iteratorState [][
 	return nil, nil, api.BlockInfo{}, nil
 }
 
+// GetAccountStateDiff -
+func (f *FacadeStub) GetAccountStateDiff(address string, options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error) {
+	if f.GetAccountStateDiffCalled != nil {
+		return f.GetAccountStateDiffCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetStateDiff -
+func (f *FacadeStub) GetStateDiff(options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error) {
+	if f.GetStateDiffCalled != nil {
+		return f.GetStateDiffCalled(options)
+	}
+
+	return nil, nil
+}
+
+// ExportStateSnapshot -
+func (f *FacadeStub) ExportStateSnapshot(epoch uint32) (string, error) {
+	if f.ExportStateSnapshotCalled != nil {
+		return f.ExportStateSnapshotCalled(epoch)
+	}
+
+	return "", nil
+}
+
 // GetGuardianData -
 func (f *FacadeStub) GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error) {
 	if f.GetGuardianDataCalled != nil {
@@ -423,6 +477,15 @@ func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, ap
 	return nil, api.BlockInfo{}, nil
 }
 
+// ExecuteSCQueries is a mock implementation.
+func (f *FacadeStub) ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error) {
+	if f.ExecuteSCQueriesHandler != nil {
+		return f.ExecuteSCQueriesHandler(queries)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
 // StatusMetrics is the mock implementation for the StatusMetrics
 func (f *FacadeStub) StatusMetrics() external.StatusMetricsHandler {
 	if f.StatusMetricsHandler != nil {
@@ -771,6 +834,15 @@ func (f *FacadeStub) Close() error {
 	return nil
 }
 
+// GetTransactionsForAddress -
+func (f *FacadeStub) GetTransactionsForAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	if f.GetTransactionsForAddressCalled != nil {
+		return f.GetTransactionsForAddressCalled(address, options)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *FacadeStub) IsInterfaceNil() bool {
 	return f == nil
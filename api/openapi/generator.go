@@ -0,0 +1,225 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+const (
+	openAPIVersion             = "3.0.3"
+	documentTitle              = "MultiversX node REST API"
+	documentDescription        = "Generated from the endpoints of the API groups that are open in api.toml"
+	jsonContentType            = "application/json"
+	parameterInPath            = "path"
+	parameterInQuery           = "query"
+	successResponseCode        = "200"
+	defaultResponseCode        = "default"
+	successResponseDescription = "successful operation"
+	errorResponseDescription   = "bad request, internal error or too many requests"
+)
+
+// ArgsGenerateDocument holds the arguments needed to generate the OpenAPI document
+type ArgsGenerateDocument struct {
+	Version   string
+	Groups    map[string][]*shared.EndpointHandlerData
+	ApiConfig config.ApiRoutesConfig
+}
+
+// GenerateDocument creates the OpenAPI 3 document describing the endpoints that are open in the provided API config.
+// The endpoints without schema metadata are described only by their path parameters and a generic response
+func GenerateDocument(args ArgsGenerateDocument) *Document {
+	registry := newSchemasRegistry()
+	genericResponseSchema := registry.schemaForValue(shared.GenericAPIResponse{})
+
+	document := &Document{
+		OpenAPI: openAPIVersion,
+		Info: Info{
+			Title:       documentTitle,
+			Description: documentDescription,
+			Version:     args.Version,
+		},
+		Paths: make(map[string]*PathItem),
+	}
+
+	groupNames := make([]string, 0, len(args.Groups))
+	for groupName := range args.Groups {
+		groupNames = append(groupNames, groupName)
+	}
+	sort.Strings(groupNames)
+
+	for _, groupName := range groupNames {
+		for _, endpoint := range args.Groups[groupName] {
+			if !isEndpointOpen(args.ApiConfig, groupName, endpoint.Path) {
+				continue
+			}
+
+			fullPath := convertPath(fmt.Sprintf("/%s%s", groupName, endpoint.Path))
+			pathItem, exists := document.Paths[fullPath]
+			if !exists {
+				pathItem = &PathItem{}
+				document.Paths[fullPath] = pathItem
+			}
+
+			(*pathItem)[strings.ToLower(endpoint.Method)] = createOperation(registry, groupName, fullPath, endpoint, genericResponseSchema)
+		}
+	}
+
+	document.Components = Components{
+		Schemas: registry.schemas,
+	}
+
+	return document
+}
+
+func isEndpointOpen(apiConfig config.ApiRoutesConfig, groupName string, path string) bool {
+	group, ok := apiConfig.APIPackages[groupName]
+	if !ok {
+		return false
+	}
+
+	for _, route := range group.Routes {
+		if route.Name == path {
+			return route.Open
+		}
+	}
+
+	return false
+}
+
+func createOperation(
+	registry *schemasRegistry,
+	groupName string,
+	fullPath string,
+	endpoint *shared.EndpointHandlerData,
+	genericResponseSchema *Schema,
+) *Operation {
+	schema := endpoint.Schema
+	if schema == nil {
+		schema = &shared.EndpointSchema{}
+	}
+
+	operation := &Operation{
+		OperationID: createOperationID(endpoint.Method, fullPath),
+		Summary:     schema.Summary,
+		Tags:        []string{groupName},
+		Parameters:  createParameters(fullPath, schema.QueryParameters),
+		Responses: map[string]*Response{
+			successResponseCode: createSuccessResponse(registry, schema),
+			defaultResponseCode: {
+				Description: errorResponseDescription,
+				Content:     createJSONContent(genericResponseSchema),
+			},
+		},
+	}
+	if schema.Request != nil && endpoint.Method != http.MethodGet {
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content:  createJSONContent(registry.schemaForValue(schema.Request)),
+		}
+	}
+
+	return operation
+}
+
+func createSuccessResponse(registry *schemasRegistry, schema *shared.EndpointSchema) *Response {
+	responseSchema := registry.schemaForValue(schema.Response)
+	if len(schema.ContentType) > 0 {
+		return &Response{
+			Description: successResponseDescription,
+			Content:     createContent(schema.ContentType, responseSchema),
+		}
+	}
+
+	return &Response{
+		Description: successResponseDescription,
+		Content: createJSONContent(&Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"data":  responseSchema,
+				"error": {Type: "string"},
+				"code":  {Type: "string"},
+			},
+		}),
+	}
+}
+
+func createJSONContent(schema *Schema) map[string]*MediaType {
+	return createContent(jsonContentType, schema)
+}
+
+func createContent(contentType string, schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{
+		contentType: {
+			Schema: schema,
+		},
+	}
+}
+
+func createParameters(fullPath string, queryParameters []shared.QueryParameter) []*Parameter {
+	parameters := make([]*Parameter, 0)
+	for _, segment := range strings.Split(fullPath, "/") {
+		if !strings.HasPrefix(segment, "{") {
+			continue
+		}
+
+		parameters = append(parameters, &Parameter{
+			Name:     strings.Trim(segment, "{}"),
+			In:       parameterInPath,
+			Required: true,
+			Schema:   &Schema{Type: shared.ParameterTypeString},
+		})
+	}
+
+	for _, queryParameter := range queryParameters {
+		parameters = append(parameters, &Parameter{
+			Name:        queryParameter.Name,
+			In:          parameterInQuery,
+			Description: queryParameter.Description,
+			Required:    queryParameter.Required,
+			Schema:      &Schema{Type: queryParameter.Type},
+		})
+	}
+
+	return parameters
+}
+
+// convertPath converts the gin path parameters (:name and *name) into the OpenAPI format ({name})
+func convertPath(ginPath string) string {
+	segments := strings.Split(ginPath, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// createOperationID builds an unique camel case identifier out of the method and the path,
+// e.g. GET /address/{address}/balance becomes getAddressAddressBalance
+func createOperationID(method string, fullPath string) string {
+	builder := strings.Builder{}
+	builder.WriteString(strings.ToLower(method))
+
+	capitalizeNext := true
+	for _, r := range fullPath {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			capitalizeNext = true
+			continue
+		}
+
+		if capitalizeNext {
+			r = unicode.ToUpper(r)
+			capitalizeNext = false
+		}
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
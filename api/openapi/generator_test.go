@@ -0,0 +1,198 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRequest struct {
+	Value string `json:"value"`
+}
+
+type testResponse struct {
+	Nonce uint64 `json:"nonce"`
+}
+
+func createTestGroups() map[string][]*shared.EndpointHandlerData {
+	return map[string][]*shared.EndpointHandlerData{
+		"address": {
+			{
+				Path:   "/:address/balance",
+				Method: http.MethodGet,
+				Schema: &shared.EndpointSchema{
+					Summary: "get the balance",
+					QueryParameters: []shared.QueryParameter{
+						{Name: "onFinalBlock", Type: shared.ParameterTypeBoolean, Description: "final block"},
+					},
+					Request:  testRequest{},
+					Response: gin.H{"balance": ""},
+				},
+			},
+			{
+				Path:   "/bulk",
+				Method: http.MethodPost,
+				Schema: &shared.EndpointSchema{
+					Summary:  "bulk request",
+					Request:  []testRequest{},
+					Response: testResponse{},
+				},
+			},
+			{
+				Path:   "/closed",
+				Method: http.MethodGet,
+			},
+		},
+		"node": {
+			{
+				Path:   "/metrics",
+				Method: http.MethodGet,
+				Schema: &shared.EndpointSchema{
+					Summary:     "metrics",
+					Response:    "",
+					ContentType: "text/plain",
+				},
+			},
+			{
+				Path:   "/files/*path",
+				Method: http.MethodGet,
+			},
+		},
+		"missing": {
+			{
+				Path:   "/missing",
+				Method: http.MethodGet,
+			},
+		},
+	}
+}
+
+func createTestApiConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"address": {Routes: []config.RouteConfig{
+				{Name: "/:address/balance", Open: true},
+				{Name: "/bulk", Open: true},
+				{Name: "/closed", Open: false},
+			}},
+			"node": {Routes: []config.RouteConfig{
+				{Name: "/metrics", Open: true},
+				{Name: "/files/*path", Open: true},
+			}},
+		},
+	}
+}
+
+func TestGenerateDocument(t *testing.T) {
+	t.Parallel()
+
+	document := GenerateDocument(ArgsGenerateDocument{
+		Version:   "v1.0.0",
+		Groups:    createTestGroups(),
+		ApiConfig: createTestApiConfig(),
+	})
+
+	t.Run("header", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, openAPIVersion, document.OpenAPI)
+		assert.Equal(t, "v1.0.0", document.Info.Version)
+		assert.Equal(t, documentTitle, document.Info.Title)
+	})
+	t.Run("only the open endpoints are described", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 4, len(document.Paths))
+		assert.Nil(t, document.Paths["/address/closed"])
+		assert.Nil(t, document.Paths["/missing/missing"])
+	})
+	t.Run("get endpoint with path and query parameters", func(t *testing.T) {
+		t.Parallel()
+
+		pathItem := document.Paths["/address/{address}/balance"]
+		require.NotNil(t, pathItem)
+		operation := (*pathItem)["get"]
+		require.NotNil(t, operation)
+
+		assert.Equal(t, "getAddressAddressBalance", operation.OperationID)
+		assert.Equal(t, "get the balance", operation.Summary)
+		assert.Equal(t, []string{"address"}, operation.Tags)
+		assert.Nil(t, operation.RequestBody)
+
+		require.Equal(t, 2, len(operation.Parameters))
+		assert.Equal(t, &Parameter{Name: "address", In: parameterInPath, Required: true, Schema: &Schema{Type: "string"}}, operation.Parameters[0])
+		assert.Equal(t, &Parameter{Name: "onFinalBlock", In: parameterInQuery, Description: "final block", Schema: &Schema{Type: "boolean"}}, operation.Parameters[1])
+
+		dataSchema := operation.Responses[successResponseCode].Content[jsonContentType].Schema.Properties["data"]
+		assert.Equal(t, &Schema{Type: "object", Properties: map[string]*Schema{"balance": {Type: "string"}}}, dataSchema)
+
+		errorSchema := operation.Responses[defaultResponseCode].Content[jsonContentType].Schema
+		assert.Equal(t, componentsSchemasPrefix+"shared.GenericAPIResponse", errorSchema.Ref)
+	})
+	t.Run("post endpoint with request body", func(t *testing.T) {
+		t.Parallel()
+
+		operation := (*document.Paths["/address/bulk"])["post"]
+		require.NotNil(t, operation)
+		require.NotNil(t, operation.RequestBody)
+
+		requestSchema := operation.RequestBody.Content[jsonContentType].Schema
+		assert.Equal(t, "array", requestSchema.Type)
+		assert.Equal(t, componentsSchemasPrefix+"openapi.testRequest", requestSchema.Items.Ref)
+
+		dataSchema := operation.Responses[successResponseCode].Content[jsonContentType].Schema.Properties["data"]
+		assert.Equal(t, componentsSchemasPrefix+"openapi.testResponse", dataSchema.Ref)
+		assert.Equal(t, &Schema{Type: "integer", Format: "int64"}, document.Components.Schemas["openapi.testResponse"].Properties["nonce"])
+	})
+	t.Run("custom content type", func(t *testing.T) {
+		t.Parallel()
+
+		operation := (*document.Paths["/node/metrics"])["get"]
+		require.NotNil(t, operation)
+
+		content := operation.Responses[successResponseCode].Content
+		require.Equal(t, 1, len(content))
+		assert.Equal(t, &Schema{Type: "string"}, content["text/plain"].Schema)
+	})
+	t.Run("endpoint without schema", func(t *testing.T) {
+		t.Parallel()
+
+		operation := (*document.Paths["/node/files/{path}"])["get"]
+		require.NotNil(t, operation)
+
+		assert.Empty(t, operation.Summary)
+		require.Equal(t, 1, len(operation.Parameters))
+		assert.Equal(t, "path", operation.Parameters[0].Name)
+		assert.Equal(t, &Schema{}, operation.Responses[successResponseCode].Content[jsonContentType].Schema.Properties["data"])
+	})
+	t.Run("document should be serializable", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := json.Marshal(document)
+		require.Nil(t, err)
+		assert.Contains(t, string(buff), `"$ref":"#/components/schemas/openapi.testResponse"`)
+	})
+}
+
+func TestConvertPath(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "/address/{address}/key/{key}", convertPath("/address/:address/key/:key"))
+	assert.Equal(t, "/node/files/{path}", convertPath("/node/files/*path"))
+	assert.Equal(t, "/network/config", convertPath("/network/config"))
+	assert.Equal(t, "/json-rpc/", convertPath("/json-rpc/"))
+}
+
+func TestCreateOperationID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "getAddressAddressBalance", createOperationID(http.MethodGet, "/address/{address}/balance"))
+	assert.Equal(t, "postVmValuesQueryMultiple", createOperationID(http.MethodPost, "/vm-values/query-multiple"))
+	assert.Equal(t, "postJsonRpc", createOperationID(http.MethodPost, "/json-rpc/"))
+}
@@ -0,0 +1,181 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const componentsSchemasPrefix = "#/components/schemas/"
+
+var (
+	jsonMarshalerType      = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	bigIntType             = reflect.TypeOf(big.Int{})
+	timeType               = reflect.TypeOf(time.Time{})
+	invalidSchemaNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+)
+
+// schemasRegistry converts go types into schemas. Named structs are registered once as components and referenced
+// afterward, which also breaks the recursion on self referencing types
+type schemasRegistry struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+func newSchemasRegistry() *schemasRegistry {
+	return &schemasRegistry{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+// schemaForValue returns the schema of the provided instance. Maps with string keys and interface values (such as
+// gin.H) are described by the dynamic types of their values
+func (registry *schemasRegistry) schemaForValue(value interface{}) *Schema {
+	if value == nil {
+		return &Schema{}
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	t := reflectedValue.Type()
+	isDynamicObject := t.Kind() == reflect.Map && t.Key().Kind() == reflect.String && t.Elem().Kind() == reflect.Interface
+	if !isDynamicObject {
+		return registry.schemaForType(t)
+	}
+
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema, reflectedValue.Len()),
+	}
+	iterator := reflectedValue.MapRange()
+	for iterator.Next() {
+		schema.Properties[iterator.Key().String()] = registry.schemaForValue(iterator.Value().Interface())
+	}
+
+	return schema
+}
+
+func (registry *schemasRegistry) schemaForType(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t {
+	case bigIntType:
+		return &Schema{Type: "integer"}
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		// custom marshalled types can not be described by reflection
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: registry.schemaForType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: registry.schemaForType(t.Elem())}
+	case reflect.Struct:
+		return registry.schemaForStruct(t)
+	default:
+		return &Schema{}
+	}
+}
+
+func (registry *schemasRegistry) schemaForStruct(t reflect.Type) *Schema {
+	if len(t.Name()) == 0 {
+		return registry.createStructSchema(t)
+	}
+
+	name, isRegistered := registry.names[t]
+	if !isRegistered {
+		name = registry.newSchemaName(t)
+		registry.names[t] = name
+		// the name is reserved before describing the fields, as they might reference the struct itself
+		registry.schemas[name] = &Schema{}
+		registry.schemas[name] = registry.createStructSchema(t)
+	}
+
+	return &Schema{Ref: componentsSchemasPrefix + name}
+}
+
+func (registry *schemasRegistry) createStructSchema(t reflect.Type) *Schema {
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+	registry.addStructFields(schema, t)
+
+	return schema
+}
+
+func (registry *schemasRegistry) addStructFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		tagName, tagOptions, _ := strings.Cut(tag, ",")
+		if field.Anonymous && len(tagName) == 0 {
+			embeddedType := field.Type
+			for embeddedType.Kind() == reflect.Ptr {
+				embeddedType = embeddedType.Elem()
+			}
+			if embeddedType.Kind() == reflect.Struct {
+				registry.addStructFields(schema, embeddedType)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if len(tagName) > 0 {
+			name = tagName
+		}
+
+		if strings.Contains(tagOptions, "string") {
+			schema.Properties[name] = &Schema{Type: "string"}
+			continue
+		}
+		schema.Properties[name] = registry.schemaForType(field.Type)
+	}
+}
+
+func (registry *schemasRegistry) newSchemaName(t reflect.Type) string {
+	baseName := invalidSchemaNameChars.ReplaceAllString(path.Base(t.PkgPath())+"."+t.Name(), "_")
+
+	name := baseName
+	for i := 1; ; i++ {
+		_, exists := registry.schemas[name]
+		if !exists {
+			return name
+		}
+
+		name = fmt.Sprintf("%s_%d", baseName, i)
+	}
+}
@@ -0,0 +1,125 @@
+package openapi
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEmbedded struct {
+	Embedded string `json:"embedded"`
+}
+
+type testNode struct {
+	testEmbedded
+	Name       string           `json:"name"`
+	Value      *big.Int         `json:"value"`
+	Amount     uint64           `json:"amount,string"`
+	Children   []*testNode      `json:"children,omitempty"`
+	Data       []byte           `json:"data"`
+	Labels     map[string]int32 `json:"labels"`
+	Created    time.Time        `json:"created"`
+	Ignored    string           `json:"-"`
+	NoTag      bool
+	unexported int
+}
+
+type testMarshaller struct {
+	Field string
+}
+
+// MarshalJSON -
+func (tm testMarshaller) MarshalJSON() ([]byte, error) {
+	return []byte(`"marshalled"`), nil
+}
+
+func TestSchemasRegistry_SchemaForValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil value should return an empty schema", func(t *testing.T) {
+		t.Parallel()
+
+		registry := newSchemasRegistry()
+		assert.Equal(t, &Schema{}, registry.schemaForValue(nil))
+	})
+	t.Run("primitives", func(t *testing.T) {
+		t.Parallel()
+
+		registry := newSchemasRegistry()
+		assert.Equal(t, &Schema{Type: "string"}, registry.schemaForValue(""))
+		assert.Equal(t, &Schema{Type: "boolean"}, registry.schemaForValue(true))
+		assert.Equal(t, &Schema{Type: "integer", Format: "int32"}, registry.schemaForValue(uint32(0)))
+		assert.Equal(t, &Schema{Type: "integer", Format: "int64"}, registry.schemaForValue(0))
+		assert.Equal(t, &Schema{Type: "number", Format: "double"}, registry.schemaForValue(0.1))
+		assert.Equal(t, &Schema{Type: "string", Format: "byte"}, registry.schemaForValue([]byte{}))
+		assert.Equal(t, &Schema{Type: "array", Items: &Schema{Type: "string"}}, registry.schemaForValue([]string{}))
+		assert.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{Type: "string"}}, registry.schemaForValue(map[int]string{}))
+		assert.Equal(t, &Schema{}, registry.schemaForValue(testMarshaller{}))
+		assert.Empty(t, registry.schemas)
+	})
+	t.Run("dynamic objects are described by their values", func(t *testing.T) {
+		t.Parallel()
+
+		registry := newSchemasRegistry()
+		schema := registry.schemaForValue(gin.H{"nonce": uint64(0), "any": nil, "node": &testNode{}})
+
+		assert.Equal(t, "object", schema.Type)
+		assert.Equal(t, &Schema{Type: "integer", Format: "int64"}, schema.Properties["nonce"])
+		assert.Equal(t, &Schema{}, schema.Properties["any"])
+		assert.Equal(t, &Schema{Ref: componentsSchemasPrefix + "openapi.testNode"}, schema.Properties["node"])
+	})
+	t.Run("recursive struct", func(t *testing.T) {
+		t.Parallel()
+
+		registry := newSchemasRegistry()
+		schema := registry.schemaForValue(testNode{})
+		require.Equal(t, &Schema{Ref: componentsSchemasPrefix + "openapi.testNode"}, schema)
+
+		expectedSchema := &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"embedded": {Type: "string"},
+				"name":     {Type: "string"},
+				"value":    {Type: "integer"},
+				"amount":   {Type: "string"},
+				"children": {Type: "array", Items: &Schema{Ref: componentsSchemasPrefix + "openapi.testNode"}},
+				"data":     {Type: "string", Format: "byte"},
+				"labels":   {Type: "object", AdditionalProperties: &Schema{Type: "integer", Format: "int32"}},
+				"created":  {Type: "string", Format: "date-time"},
+				"NoTag":    {Type: "boolean"},
+			},
+		}
+		assert.Equal(t, expectedSchema, registry.schemas["openapi.testNode"])
+		assert.Equal(t, 1, len(registry.schemas))
+	})
+	t.Run("anonymous struct should be inlined", func(t *testing.T) {
+		t.Parallel()
+
+		registry := newSchemasRegistry()
+		schema := registry.schemaForValue(struct {
+			Field string `json:"field"`
+		}{})
+
+		assert.Equal(t, &Schema{Type: "object", Properties: map[string]*Schema{"field": {Type: "string"}}}, schema)
+		assert.Empty(t, registry.schemas)
+	})
+	t.Run("name collisions should be resolved", func(t *testing.T) {
+		t.Parallel()
+
+		registry := newSchemasRegistry()
+		registry.schemas["openapi.testEmbedded"] = &Schema{}
+
+		schema := registry.schemaForType(reflect.TypeOf(testEmbedded{}))
+		assert.Equal(t, componentsSchemasPrefix+"openapi.testEmbedded_1", schema.Ref)
+
+		// the same type should reuse the registered name
+		schema = registry.schemaForType(reflect.TypeOf(&testEmbedded{}))
+		assert.Equal(t, componentsSchemasPrefix+"openapi.testEmbedded_1", schema.Ref)
+		assert.Equal(t, 2, len(registry.schemas))
+	})
+}
@@ -17,7 +17,9 @@ import (
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 )
@@ -58,6 +60,7 @@ type GroupHandler interface {
 		ws *gin.RouterGroup,
 		apiConfig config.ApiRoutesConfig,
 	)
+	GetEndpoints() []*EndpointHandlerData
 	IsInterfaceNil() bool
 }
 
@@ -76,6 +79,9 @@ type FacadeHandler interface {
 	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
 	IterateKeys(address string, numKeys uint, iteratorState [][]byte, options api.AccountQueryOptions) (map[string]string, [][]byte, api.BlockInfo, error)
+	GetAccountStateDiff(address string, options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error)
+	GetStateDiff(options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error)
+	ExportStateSnapshot(epoch uint32) (string, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
@@ -120,6 +126,7 @@ type FacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	RestApiInterface() string
 	RestAPIServerDebugMode() bool
@@ -129,6 +136,7 @@ type FacadeHandler interface {
 	GetGasConfigs() (map[string]map[string]uint64, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
+	GetTransactionsForAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
@@ -139,6 +147,8 @@ type FacadeHandler interface {
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
 	GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetTransactionTrace(hash string) (*tracing.TransactionTrace, error)
 	P2PPrometheusMetricsEnabled() bool
+	Subscribe(filter subscriptions.Filter) (*subscriptions.Subscription, error)
 	IsInterfaceNil() bool
 }
@@ -30,6 +30,39 @@ type EndpointHandlerData struct {
 	Method                string
 	Handler               gin.HandlerFunc
 	AdditionalMiddlewares []AdditionalMiddleware
+	Schema                *EndpointSchema
+}
+
+const (
+	// ParameterTypeString marks a string URL parameter
+	ParameterTypeString = "string"
+
+	// ParameterTypeInteger marks an integer URL parameter
+	ParameterTypeInteger = "integer"
+
+	// ParameterTypeBoolean marks a boolean URL parameter
+	ParameterTypeBoolean = "boolean"
+)
+
+// QueryParameter describes an URL query parameter accepted by an API endpoint
+type QueryParameter struct {
+	Name        string
+	Type        string
+	Description string
+	Required    bool
+}
+
+// EndpointSchema holds the metadata used for describing an API endpoint in the OpenAPI specification. The path
+// parameters are extracted from the endpoint path. Request holds an instance of the expected request body, if any,
+// while Response holds an instance of the data field of a successful response. A gin.H response is described by
+// the types of its values. When ContentType is set, Response describes the whole body of the successful response
+// instead of the data field of the GenericAPIResponse
+type EndpointSchema struct {
+	Summary         string
+	QueryParameters []QueryParameter
+	Request         interface{}
+	Response        interface{}
+	ContentType     string
 }
 
 // GenericAPIResponse defines the structure of all responses on API endpoints
@@ -102,3 +135,32 @@ func RespondWithSuccess(c *gin.Context, data interface{}) {
 		ReturnCodeSuccess,
 	)
 }
+
+// batchCallsCountersKey is the key of the request context under which the throttling middlewares register their
+// batch calls counters
+const batchCallsCountersKey = "batchCallsCounters"
+
+// BatchCallsCounter counts the additional calls bundled in a batch request against the quota of a throttler,
+// returning false if the quota does not allow them
+type BatchCallsCounter func(numCalls uint32) bool
+
+// AddBatchCallsCounter registers the batch calls counter of a throttling middleware on the request context
+func AddBatchCallsCounter(c *gin.Context, counter BatchCallsCounter) {
+	counters, _ := c.Get(batchCallsCountersKey)
+	countersSlice, _ := counters.([]BatchCallsCounter)
+	c.Set(batchCallsCountersKey, append(countersSlice, counter))
+}
+
+// CountBatchCalls counts the additional calls bundled in a batch request against all the throttling middlewares the
+// request passed through, returning false if any of them does not allow the calls
+func CountBatchCalls(c *gin.Context, numCalls uint32) bool {
+	counters, _ := c.Get(batchCallsCountersKey)
+	countersSlice, _ := counters.([]BatchCallsCounter)
+
+	isAllowed := true
+	for _, counter := range countersSlice {
+		isAllowed = counter(numCalls) && isAllowed
+	}
+
+	return isAllowed
+}
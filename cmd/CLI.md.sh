@@ -2,11 +2,13 @@
 
 generate() {
     generateForAssessmentTool
+    generateForDBMigrator
     generateForKeyGenerator
     generateForLogViewer
     generateForNode
     generateForSeedNode
     generateForTermUi
+    generateForTrieTool
 }
 
 generateForAssessmentTool() {
@@ -23,6 +25,20 @@ $(code)
     echo "$HELP" > ./assessment/CLI.md
 }
 
+generateForDBMigrator() {
+    HELP="
+# Database Migrator CLI
+
+The **Database Migrator Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbmigrator --help
+
+$(./dbmigrator/dbmigrator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbmigrator/CLI.md
+}
+
 generateForKeyGenerator() {
     HELP="
 # Keygenerator CLI
@@ -93,6 +109,20 @@ $(code)
     echo "$HELP" > ./termui/CLI.md
 }
 
+generateForTrieTool() {
+    HELP="
+# Trie Tool CLI
+
+The **Trie Tool** exposes the following Command Line Interface:
+$(code)
+\$ trietool --help
+
+$(./trietool/trietool --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./trietool/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-go/cmd/dbmigrator/migration"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	inPlaceMigrationSuffix = "_migration"
+	inPlaceBackupSuffix    = "_backup"
+	defaultBatchDelay      = 2
+	defaultMaxBatchSize    = 45000
+	defaultMaxOpenFiles    = 10
+)
+
+var (
+	errInvalidDestination = errors.New("exactly one of the destination and in-place flags should be provided")
+	errMissingSource      = errors.New("missing source flag")
+)
+
+var (
+	dbMigratorHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+	// source defines a flag for the database directory to be migrated
+	source = cli.StringFlag{
+		Name: "source",
+		Usage: "The `[path]` of the database directory to be migrated, for example " +
+			"db/<chain ID>/Epoch_<epoch>/Shard_<shard>/AccountsTrie. The node should be stopped while the tool is running",
+	}
+	// destination defines a flag for the directory of the migrated database
+	destination = cli.StringFlag{
+		Name:  "destination",
+		Usage: "The `[path]` of the directory where the migrated database will be created. It should not exist or be empty",
+	}
+	// inPlace defines a flag for replacing the source directory with the migrated database
+	inPlace = cli.BoolFlag{
+		Name: "in-place",
+		Usage: "Boolean option for replacing the source directory with the migrated database. The original database " +
+			"is kept in the <source>" + inPlaceBackupSuffix + " directory",
+	}
+	// dbType defines a flag for the type of the migrated database
+	dbType = cli.StringFlag{
+		Name:  "type",
+		Usage: "The `[type]` of the migrated database: PebbleDB, LvlDBSerial or LvlDB",
+		Value: string(storageunit.PebbleDB),
+	}
+	// skipVerification defines a flag for skipping the comparison of the migrated database against the source
+	skipVerification = cli.BoolFlag{
+		Name:  "skip-verification",
+		Usage: "Boolean option for skipping the comparison of the migrated database against the source database",
+	}
+	// maxBatchSize defines a flag for the write batch size
+	maxBatchSize = cli.IntFlag{
+		Name: "max-batch-size",
+		Usage: "The `[size]` of the write batch, used when the source directory does not contain the persister " +
+			"configuration file",
+		Value: defaultMaxBatchSize,
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbMigratorHelpTemplate
+	app.Name = "Database Migrator CLI App"
+	app.Usage = "This tool migrates offline a node's database directory to another database type, e.g. from LevelDB to Pebble"
+	app.Flags = []cli.Flag{
+		logLevel,
+		source,
+		destination,
+		inPlace,
+		dbType,
+		skipVerification,
+		maxBatchSize,
+	}
+	app.Version = "v1.0.0"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Action = migrate
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func migrate(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	if len(ctx.GlobalString(source.Name)) == 0 {
+		return errMissingSource
+	}
+	sourcePath := filepath.Clean(ctx.GlobalString(source.Name))
+
+	isInPlace := ctx.GlobalBool(inPlace.Name)
+	destinationPath := ctx.GlobalString(destination.Name)
+	if isInPlace == (len(destinationPath) > 0) {
+		return errInvalidDestination
+	}
+	if isInPlace {
+		destinationPath = sourcePath + inPlaceMigrationSuffix
+	}
+
+	args := migration.ArgsDBMigrator{
+		SourcePath:      sourcePath,
+		DestinationPath: destinationPath,
+		DestinationType: storageunit.DBType(ctx.GlobalString(dbType.Name)),
+		DefaultDBConfig: config.DBConfig{
+			BatchDelaySeconds: defaultBatchDelay,
+			MaxBatchSize:      ctx.GlobalInt(maxBatchSize.Name),
+			MaxOpenFiles:      defaultMaxOpenFiles,
+		},
+		VerifyMigration: !ctx.GlobalBool(skipVerification.Name),
+	}
+	migrator, err := migration.NewDBMigrator(args)
+	if err != nil {
+		return err
+	}
+
+	result, err := migrator.Migrate()
+	if err != nil {
+		return err
+	}
+
+	log.Info("migration finished",
+		"source type", result.SourceType,
+		"destination type", result.DestinationType,
+		"num keys", result.NumKeys,
+		"num bytes", result.NumBytes,
+	)
+
+	if !isInPlace {
+		return nil
+	}
+
+	return replaceSource(sourcePath, destinationPath)
+}
+
+func replaceSource(sourcePath string, migratedPath string) error {
+	backupPath := sourcePath + inPlaceBackupSuffix
+	_, err := os.Stat(backupPath)
+	if err == nil {
+		return fmt.Errorf("the backup directory %s already exists, the migrated database was left in %s", backupPath, migratedPath)
+	}
+
+	err = os.Rename(sourcePath, backupPath)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(migratedPath, sourcePath)
+	if err != nil {
+		return err
+	}
+
+	log.Info("replaced the source directory with the migrated database", "path", sourcePath, "backup", backupPath)
+
+	return nil
+}
@@ -0,0 +1,243 @@
+package migration
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const logProgressInterval = 100000
+
+var log = logger.GetOrCreate("dbmigrator/migration")
+
+var supportedDestinationTypes = map[storageunit.DBType]struct{}{
+	storageunit.LvlDB:       {},
+	storageunit.LvlDBSerial: {},
+	storageunit.PebbleDB:    {},
+}
+
+// ArgsDBMigrator holds the arguments needed to create a database migrator
+type ArgsDBMigrator struct {
+	SourcePath      string
+	DestinationPath string
+	DestinationType storageunit.DBType
+	// DefaultDBConfig is used for the source directories which do not contain the persister configuration file
+	DefaultDBConfig config.DBConfig
+	VerifyMigration bool
+}
+
+// MigrationResult holds the outcome of a finished migration
+type MigrationResult struct {
+	SourceType      storageunit.DBType
+	DestinationType storageunit.DBType
+	NumKeys         uint64
+	NumBytes        uint64
+}
+
+type dbMigrator struct {
+	sourcePath      string
+	destinationPath string
+	destinationType storageunit.DBType
+	defaultDBConfig config.DBConfig
+	verifyMigration bool
+}
+
+// NewDBMigrator creates a migrator which copies all the (key, value) pairs of a persister into a new persister
+// of the destination type. Both the base and the sharded persisters are supported, as the layout of the source
+// is read from its persister configuration file
+func NewDBMigrator(args ArgsDBMigrator) (*dbMigrator, error) {
+	if len(args.SourcePath) == 0 || len(args.DestinationPath) == 0 {
+		return nil, ErrEmptyPath
+	}
+	if filepath.Clean(args.SourcePath) == filepath.Clean(args.DestinationPath) {
+		return nil, ErrSamePath
+	}
+	_, isSupported := supportedDestinationTypes[args.DestinationType]
+	if !isSupported {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedDBType, args.DestinationType)
+	}
+
+	return &dbMigrator{
+		sourcePath:      args.SourcePath,
+		destinationPath: args.DestinationPath,
+		destinationType: args.DestinationType,
+		defaultDBConfig: args.DefaultDBConfig,
+		verifyMigration: args.VerifyMigration,
+	}, nil
+}
+
+// Migrate copies the source database into the destination database. The source database is not altered
+func (dm *dbMigrator) Migrate() (*MigrationResult, error) {
+	if isDirEmpty(dm.sourcePath) {
+		return nil, fmt.Errorf("%w: %s", ErrSourceNotFound, dm.sourcePath)
+	}
+	if !isDirEmpty(dm.destinationPath) {
+		return nil, fmt.Errorf("%w: %s", ErrDestinationNotEmpty, dm.destinationPath)
+	}
+
+	sourceConfig, err := storageFactory.NewDBConfigHandler(dm.defaultDBConfig).GetDBConfig(dm.sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	sourceConfig.UseTmpAsFilePath = false
+	if storageunit.DBType(sourceConfig.Type) == dm.destinationType {
+		return nil, fmt.Errorf("%w: %s", ErrSameDBType, sourceConfig.Type)
+	}
+
+	destinationConfig := *sourceConfig
+	destinationConfig.Type = string(dm.destinationType)
+
+	source, err := openReadOnlyPersister(*sourceConfig, dm.sourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("%w while opening the source database", err)
+	}
+	defer closeAndLog(source, dm.sourcePath)
+
+	log.Info("migrating database",
+		"source", dm.sourcePath,
+		"source type", sourceConfig.Type,
+		"destination", dm.destinationPath,
+		"destination type", destinationConfig.Type,
+		"num shards", sourceConfig.NumShards,
+	)
+
+	result, err := dm.copyData(source, destinationConfig)
+	if err != nil {
+		return nil, err
+	}
+	result.SourceType = storageunit.DBType(sourceConfig.Type)
+	result.DestinationType = dm.destinationType
+
+	if !dm.verifyMigration {
+		return result, nil
+	}
+
+	err = dm.verify(source, destinationConfig, result.NumKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (dm *dbMigrator) copyData(source storage.Persister, destinationConfig config.DBConfig) (*MigrationResult, error) {
+	destination, err := createPersister(destinationConfig, dm.destinationPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the destination database", err)
+	}
+
+	result := &MigrationResult{}
+	var errPut error
+	source.RangeKeys(func(key []byte, val []byte) bool {
+		errPut = destination.Put(key, val)
+		if errPut != nil {
+			return false
+		}
+
+		result.NumKeys++
+		result.NumBytes += uint64(len(key) + len(val))
+		if result.NumKeys%logProgressInterval == 0 {
+			log.Info("migration in progress", "num keys", result.NumKeys, "num bytes", result.NumBytes)
+		}
+
+		return true
+	})
+
+	// closing the destination writes the pending batch
+	errClose := destination.Close()
+	if errPut != nil {
+		return nil, fmt.Errorf("%w while writing the destination database", errPut)
+	}
+	if errClose != nil {
+		return nil, fmt.Errorf("%w while closing the destination database", errClose)
+	}
+
+	return result, nil
+}
+
+func (dm *dbMigrator) verify(source storage.Persister, destinationConfig config.DBConfig, numKeys uint64) error {
+	destination, err := createPersister(destinationConfig, dm.destinationPath)
+	if err != nil {
+		return fmt.Errorf("%w while reopening the destination database", err)
+	}
+	defer closeAndLog(destination, dm.destinationPath)
+
+	numDestinationKeys := uint64(0)
+	destination.RangeKeys(func(_ []byte, _ []byte) bool {
+		numDestinationKeys++
+		return true
+	})
+	if numDestinationKeys != numKeys {
+		return fmt.Errorf("%w: the source has %d keys while the destination has %d keys",
+			ErrVerificationFailed, numKeys, numDestinationKeys)
+	}
+
+	var errVerify error
+	source.RangeKeys(func(key []byte, val []byte) bool {
+		migratedVal, errGet := destination.Get(key)
+		if errGet != nil {
+			errVerify = fmt.Errorf("%w: %s for key %x", ErrVerificationFailed, errGet.Error(), key)
+			return false
+		}
+		if !bytes.Equal(val, migratedVal) {
+			errVerify = fmt.Errorf("%w: different value for key %x", ErrVerificationFailed, key)
+			return false
+		}
+
+		return true
+	})
+	if errVerify != nil {
+		return errVerify
+	}
+
+	log.Info("verified the migrated database", "num keys", numKeys)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dm *dbMigrator) IsInterfaceNil() bool {
+	return dm == nil
+}
+
+func createPersister(dbConfig config.DBConfig, path string) (storage.Persister, error) {
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return persisterFactory.Create(path)
+}
+
+// openReadOnlyPersister opens the existing database without altering it, not even its persister configuration file
+func openReadOnlyPersister(dbConfig config.DBConfig, path string) (storage.Persister, error) {
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return persisterFactory.CreateReadOnly(path)
+}
+
+func closeAndLog(persister storage.Persister, path string) {
+	err := persister.Close()
+	if err != nil {
+		log.Warn("cannot close database", "path", path, "error", err.Error())
+	}
+}
+
+func isDirEmpty(path string) bool {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return true
+	}
+
+	return len(entries) == 0
+}
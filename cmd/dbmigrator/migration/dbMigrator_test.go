@@ -0,0 +1,257 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const numTestKeys = 250
+
+func createTestDBConfig(dbType storageunit.DBType, numShards int32) config.DBConfig {
+	return config.DBConfig{
+		Type:                string(dbType),
+		BatchDelaySeconds:   2,
+		MaxBatchSize:        100,
+		MaxOpenFiles:        10,
+		ShardIDProviderType: string(storageunit.BinarySplit),
+		NumShards:           numShards,
+	}
+}
+
+func createSourceDB(t *testing.T, dbConfig config.DBConfig) string {
+	path := filepath.Join(t.TempDir(), "AccountsTrie")
+	persister, err := createPersister(dbConfig, path)
+	require.Nil(t, err)
+	for i := 0; i < numTestKeys; i++ {
+		require.Nil(t, persister.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.Nil(t, persister.Close())
+
+	return path
+}
+
+func createMockArgsDBMigrator(sourcePath string) ArgsDBMigrator {
+	return ArgsDBMigrator{
+		SourcePath:      sourcePath,
+		DestinationPath: sourcePath + "_migrated",
+		DestinationType: storageunit.PebbleDB,
+		DefaultDBConfig: createTestDBConfig(storageunit.LvlDBSerial, 0),
+		VerifyMigration: true,
+	}
+}
+
+func requireMigratedData(t *testing.T, path string, expectedType storageunit.DBType) {
+	// the persister configuration file saved along with the database selects its type
+	dbConfig := createTestDBConfig(storageunit.LvlDBSerial, 0)
+	savedConfig, err := storageFactory.NewDBConfigHandler(dbConfig).GetDBConfig(path)
+	require.Nil(t, err)
+	require.Equal(t, string(expectedType), savedConfig.Type)
+
+	persister, err := createPersister(dbConfig, path)
+	require.Nil(t, err)
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	for i := 0; i < numTestKeys; i++ {
+		value, errGet := persister.Get([]byte(fmt.Sprintf("key%d", i)))
+		require.Nil(t, errGet)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), value)
+	}
+}
+
+// readDirFiles returns the contents of all the files found in the provided directory, indexed by their relative path
+func readDirFiles(t *testing.T, path string) map[string][]byte {
+	files := make(map[string][]byte)
+	err := filepath.WalkDir(path, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(path, filePath)
+		if err != nil {
+			return err
+		}
+		files[relativePath], err = os.ReadFile(filePath)
+
+		return err
+	})
+	require.Nil(t, err)
+
+	return files
+}
+
+func TestNewDBMigrator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty source path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBMigrator("path")
+		args.SourcePath = ""
+		migrator, err := NewDBMigrator(args)
+		assert.Equal(t, ErrEmptyPath, err)
+		assert.Nil(t, migrator)
+	})
+	t.Run("empty destination path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBMigrator("path")
+		args.DestinationPath = ""
+		migrator, err := NewDBMigrator(args)
+		assert.Equal(t, ErrEmptyPath, err)
+		assert.Nil(t, migrator)
+	})
+	t.Run("same paths should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBMigrator("path")
+		args.DestinationPath = "./path/"
+		migrator, err := NewDBMigrator(args)
+		assert.Equal(t, ErrSamePath, err)
+		assert.Nil(t, migrator)
+	})
+	t.Run("unsupported destination type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBMigrator("path")
+		args.DestinationType = storageunit.MemoryDB
+		migrator, err := NewDBMigrator(args)
+		assert.True(t, errors.Is(err, ErrUnsupportedDBType))
+		assert.Nil(t, migrator)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		migrator, err := NewDBMigrator(createMockArgsDBMigrator("path"))
+		assert.Nil(t, err)
+		assert.False(t, migrator.IsInterfaceNil())
+	})
+}
+
+func TestDBMigrator_Migrate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing source should error", func(t *testing.T) {
+		t.Parallel()
+
+		migrator, _ := NewDBMigrator(createMockArgsDBMigrator(filepath.Join(t.TempDir(), "missing")))
+		result, err := migrator.Migrate()
+		assert.True(t, errors.Is(err, ErrSourceNotFound))
+		assert.Nil(t, result)
+	})
+	t.Run("not empty destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBMigrator(createSourceDB(t, createTestDBConfig(storageunit.LvlDBSerial, 0)))
+		require.Nil(t, os.MkdirAll(filepath.Join(args.DestinationPath, "file"), os.ModePerm))
+		migrator, _ := NewDBMigrator(args)
+		result, err := migrator.Migrate()
+		assert.True(t, errors.Is(err, ErrDestinationNotEmpty))
+		assert.Nil(t, result)
+	})
+	t.Run("source with the destination type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBMigrator(createSourceDB(t, createTestDBConfig(storageunit.PebbleDB, 0)))
+		migrator, _ := NewDBMigrator(args)
+		result, err := migrator.Migrate()
+		assert.True(t, errors.Is(err, ErrSameDBType))
+		assert.Nil(t, result)
+	})
+	t.Run("leveldb to pebble should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBMigrator(createSourceDB(t, createTestDBConfig(storageunit.LvlDBSerial, 0)))
+		sourceFiles := readDirFiles(t, args.SourcePath)
+		migrator, _ := NewDBMigrator(args)
+		result, err := migrator.Migrate()
+		require.Nil(t, err)
+		assert.Equal(t, sourceFiles, readDirFiles(t, args.SourcePath))
+		assert.Equal(t, storageunit.LvlDBSerial, result.SourceType)
+		assert.Equal(t, storageunit.PebbleDB, result.DestinationType)
+		assert.Equal(t, uint64(numTestKeys), result.NumKeys)
+		assert.NotZero(t, result.NumBytes)
+
+		requireMigratedData(t, args.DestinationPath, storageunit.PebbleDB)
+		// the source is not altered
+		requireMigratedData(t, args.SourcePath, storageunit.LvlDBSerial)
+	})
+	t.Run("sharded leveldb to sharded pebble should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBMigrator(createSourceDB(t, createTestDBConfig(storageunit.LvlDBSerial, 4)))
+		args.VerifyMigration = false
+		migrator, _ := NewDBMigrator(args)
+		result, err := migrator.Migrate()
+		require.Nil(t, err)
+		assert.Equal(t, uint64(numTestKeys), result.NumKeys)
+
+		requireMigratedData(t, args.DestinationPath, storageunit.PebbleDB)
+		for i := 0; i < 4; i++ {
+			assert.DirExists(t, filepath.Join(args.DestinationPath, fmt.Sprintf("%d", i)))
+		}
+	})
+	t.Run("pebble to leveldb should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBMigrator(createSourceDB(t, createTestDBConfig(storageunit.PebbleDB, 0)))
+		args.DestinationType = storageunit.LvlDBSerial
+		migrator, _ := NewDBMigrator(args)
+		result, err := migrator.Migrate()
+		require.Nil(t, err)
+		assert.Equal(t, uint64(numTestKeys), result.NumKeys)
+
+		requireMigratedData(t, args.DestinationPath, storageunit.LvlDBSerial)
+	})
+}
+
+func TestDBMigrator_Verify(t *testing.T) {
+	t.Parallel()
+
+	sourceConfig := createTestDBConfig(storageunit.LvlDBSerial, 0)
+	args := createMockArgsDBMigrator(createSourceDB(t, sourceConfig))
+	args.VerifyMigration = false
+	migrator, _ := NewDBMigrator(args)
+	_, err := migrator.Migrate()
+	require.Nil(t, err)
+
+	source, err := createPersister(sourceConfig, args.SourcePath)
+	require.Nil(t, err)
+	defer func() {
+		_ = source.Close()
+	}()
+	destinationConfig := createTestDBConfig(storageunit.PebbleDB, 0)
+
+	t.Run("different number of keys should error", func(t *testing.T) {
+		err = migrator.verify(source, destinationConfig, numTestKeys+1)
+		assert.True(t, errors.Is(err, ErrVerificationFailed))
+	})
+	t.Run("different value should error", func(t *testing.T) {
+		updateDestination(t, args.DestinationPath, destinationConfig, []byte("value0"))
+		err = migrator.verify(source, destinationConfig, numTestKeys)
+		assert.True(t, errors.Is(err, ErrVerificationFailed))
+	})
+	t.Run("same data should work", func(t *testing.T) {
+		updateDestination(t, args.DestinationPath, destinationConfig, []byte("value1"))
+		err = migrator.verify(source, destinationConfig, numTestKeys)
+		assert.Nil(t, err)
+	})
+}
+
+func updateDestination(t *testing.T, path string, dbConfig config.DBConfig, value []byte) {
+	persister, err := createPersister(dbConfig, path)
+	require.Nil(t, err)
+	require.Nil(t, persister.Put([]byte("key1"), value))
+	require.Nil(t, persister.Close())
+}
@@ -0,0 +1,24 @@
+package migration
+
+import "errors"
+
+// ErrEmptyPath signals that an empty path has been provided
+var ErrEmptyPath = errors.New("empty path")
+
+// ErrSamePath signals that the source and the destination paths are the same
+var ErrSamePath = errors.New("the source and the destination paths should differ")
+
+// ErrSourceNotFound signals that the source directory does not exist or is empty
+var ErrSourceNotFound = errors.New("the source directory does not exist or is empty")
+
+// ErrDestinationNotEmpty signals that the destination directory already contains files
+var ErrDestinationNotEmpty = errors.New("the destination directory is not empty")
+
+// ErrUnsupportedDBType signals that the database type can not be used as migration destination
+var ErrUnsupportedDBType = errors.New("unsupported destination database type")
+
+// ErrSameDBType signals that the source database already has the destination type
+var ErrSameDBType = errors.New("the source database already has the destination type")
+
+// ErrVerificationFailed signals that the migrated database differs from the source database
+var ErrVerificationFailed = errors.New("migration verification failed")
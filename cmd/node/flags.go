@@ -357,6 +357,15 @@ var (
 		Name:  "force-start-from-network",
 		Usage: "Flag that will force the start from network bootstrap process",
 	}
+	// stateSnapshotFile defines a flag for the state snapshot file to be imported when starting from the network
+	stateSnapshotFile = cli.StringFlag{
+		Name: "state-snapshot-file",
+		Usage: "This flag specifies the `file` holding a state snapshot exported by another node. When the node " +
+			"bootstraps from the network, the state tries are loaded from this file instead of being synced from the peers. " +
+			"The snapshot is verified against the root hashes of the epoch start block and the node falls back to the " +
+			"network sync if the verification fails",
+		Value: "",
+	}
 	// disableConsensusWatchdog defines a flag that will disable the consensus watchdog
 	disableConsensusWatchdog = cli.BoolFlag{
 		Name:  "disable-consensus-watchdog",
@@ -460,6 +469,7 @@ func getFlags() []cli.Flag {
 		memBallast,
 		memoryUsageToCreateProfiles,
 		forceStartFromNetwork,
+		stateSnapshotFile,
 		disableConsensusWatchdog,
 		serializeSnapshots,
 		noKey,
@@ -494,6 +504,7 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.UseLogView = ctx.GlobalBool(useLogView.Name)
 	flagsConfig.ValidatorKeyIndex = ctx.GlobalInt(validatorKeyIndex.Name)
 	flagsConfig.ForceStartFromNetwork = ctx.GlobalBool(forceStartFromNetwork.Name)
+	flagsConfig.StateSnapshotFile = ctx.GlobalString(stateSnapshotFile.Name)
 	flagsConfig.DisableConsensusWatchdog = ctx.GlobalBool(disableConsensusWatchdog.Name)
 	flagsConfig.SerializeSnapshots = ctx.GlobalBool(serializeSnapshots.Name)
 	flagsConfig.OperationMode = ctx.GlobalString(operationMode.Name)
@@ -0,0 +1,24 @@
+package inspector
+
+type disabledWalkHandler struct {
+}
+
+// ProcessNode returns nil
+func (handler *disabledWalkHandler) ProcessNode(_ []byte, _ []byte) error {
+	return nil
+}
+
+// ProcessMainTrieLeaf returns nil
+func (handler *disabledWalkHandler) ProcessMainTrieLeaf(_ []byte, _ []byte) error {
+	return nil
+}
+
+// ProcessDataTrieLeaf returns nil
+func (handler *disabledWalkHandler) ProcessDataTrieLeaf(_ []byte, _ []byte, _ []byte) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *disabledWalkHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,64 @@
+package inspector
+
+// NodeIssue holds the details of a missing or corrupt trie node
+type NodeIssue struct {
+	Hash  string `json:"hash"`
+	Trie  string `json:"trie"`
+	Error string `json:"error"`
+}
+
+// WalkReport holds the result of walking the tries
+type WalkReport struct {
+	NumTries     uint64       `json:"numTries"`
+	NumNodes     uint64       `json:"numNodes"`
+	NumLeaves    uint64       `json:"numLeaves"`
+	NumRepaired  uint64       `json:"numRepaired"`
+	MissingNodes []*NodeIssue `json:"missingNodes"`
+	CorruptNodes []*NodeIssue `json:"corruptNodes"`
+}
+
+// HasIssues returns true if missing or corrupt nodes were found
+func (report *WalkReport) HasIssues() bool {
+	return len(report.MissingNodes) > 0 || len(report.CorruptNodes) > 0
+}
+
+// ExportedNode is the JSONL entry used to export a serialized trie node
+type ExportedNode struct {
+	Hash string `json:"hash"`
+	Node string `json:"node"`
+}
+
+// AccountEntry is the JSONL entry used to dump a user account
+type AccountEntry struct {
+	Type            string `json:"type"`
+	Address         string `json:"address"`
+	Nonce           uint64 `json:"nonce"`
+	Balance         string `json:"balance"`
+	CodeHash        string `json:"codeHash,omitempty"`
+	RootHash        string `json:"rootHash,omitempty"`
+	CodeMetadata    string `json:"codeMetadata,omitempty"`
+	OwnerAddress    string `json:"ownerAddress,omitempty"`
+	UserName        string `json:"userName,omitempty"`
+	DeveloperReward string `json:"developerReward,omitempty"`
+}
+
+// KeyEntry is the JSONL entry used to dump a key from the data trie of an account
+type KeyEntry struct {
+	Type    string `json:"type"`
+	Address string `json:"address"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+// ValidatorEntry is the JSONL entry used to dump a peer account
+type ValidatorEntry struct {
+	Type          string `json:"type"`
+	BLSPublicKey  string `json:"blsPublicKey"`
+	RewardAddress string `json:"rewardAddress"`
+	ShardID       uint32 `json:"shardId"`
+	List          string `json:"list"`
+	IndexInList   uint32 `json:"indexInList"`
+	Rating        uint32 `json:"rating"`
+	TempRating    uint32 `json:"tempRating"`
+	Nonce         uint64 `json:"nonce"`
+}
@@ -0,0 +1,30 @@
+package inspector
+
+import "errors"
+
+// ErrReadOnlyStorage signals that a write operation was attempted on a storage opened as read-only
+var ErrReadOnlyStorage = errors.New("the storage is opened as read-only")
+
+// ErrNoPersisterFound signals that no persister was found for the provided storage directory
+var ErrNoPersisterFound = errors.New("no persister found")
+
+// ErrNilNodesSource signals that a nil nodes source was provided
+var ErrNilNodesSource = errors.New("nil nodes source")
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilWriter signals that a nil writer was provided
+var ErrNilWriter = errors.New("nil writer")
+
+// ErrEmptyRootHash signals that an empty root hash was provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrHashMismatch signals that the hash of a trie node does not match the key under which it is stored
+var ErrHashMismatch = errors.New("trie node hash mismatch")
+
+// ErrNodeNotFound signals that a trie node was not found
+var ErrNodeNotFound = errors.New("trie node not found")
+
+// ErrNilStatisticsCollector signals that a nil statistics collector was provided
+var ErrNilStatisticsCollector = errors.New("nil statistics collector")
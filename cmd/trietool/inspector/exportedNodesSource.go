@@ -0,0 +1,71 @@
+package inspector
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// exportedNodesSource holds the trie nodes loaded from a JSONL export created by the dump command
+type exportedNodesSource struct {
+	nodes map[string][]byte
+}
+
+// NewExportedNodesSource loads all the trie nodes from the provided JSONL export
+func NewExportedNodesSource(reader io.Reader) (*exportedNodesSource, error) {
+	if reader == nil {
+		return nil, ErrNilNodesSource
+	}
+
+	source := &exportedNodesSource{
+		nodes: make(map[string][]byte),
+	}
+
+	decoder := json.NewDecoder(reader)
+	for lineIndex := 1; ; lineIndex++ {
+		exportedNode := &ExportedNode{}
+		err := decoder.Decode(exportedNode)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w for exported node %d", err, lineIndex)
+		}
+
+		hash, err := hex.DecodeString(exportedNode.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the hash of the exported node %d", err, lineIndex)
+		}
+		encodedNode, err := hex.DecodeString(exportedNode.Node)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the exported node %d", err, lineIndex)
+		}
+
+		source.nodes[string(hash)] = encodedNode
+	}
+
+	log.Debug("loaded exported trie nodes", "num nodes", len(source.nodes))
+
+	return source, nil
+}
+
+// Get returns the exported node with the provided hash
+func (source *exportedNodesSource) Get(key []byte) ([]byte, error) {
+	encodedNode, found := source.nodes[string(key)]
+	if !found {
+		return nil, ErrNodeNotFound
+	}
+
+	return encodedNode, nil
+}
+
+// Close returns nil
+func (source *exportedNodesSource) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (source *exportedNodesSource) IsInterfaceNil() bool {
+	return source == nil
+}
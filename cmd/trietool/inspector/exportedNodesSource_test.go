@@ -0,0 +1,57 @@
+package inspector
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExportedNodesSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil reader should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := NewExportedNodesSource(nil)
+		require.Equal(t, ErrNilNodesSource, err)
+		require.Nil(t, source)
+	})
+	t.Run("invalid json should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := NewExportedNodesSource(strings.NewReader(`{"hash":"aa","node":"bb"}` + "\n" + `{"hash"`))
+		require.ErrorContains(t, err, "exported node 2")
+		require.Nil(t, source)
+	})
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := NewExportedNodesSource(strings.NewReader(`{"hash":"not hex","node":"bb"}`))
+		require.ErrorContains(t, err, "hash of the exported node 1")
+		require.Nil(t, source)
+	})
+	t.Run("invalid node should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := NewExportedNodesSource(strings.NewReader(`{"hash":"aa","node":"not hex"}`))
+		require.ErrorContains(t, err, "exported node 1")
+		require.Nil(t, source)
+	})
+	t.Run("should load all the nodes", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := NewExportedNodesSource(strings.NewReader(`{"hash":"aa","node":"bb"}` + "\n" + `{"hash":"cc","node":"dd"}` + "\n"))
+		require.Nil(t, err)
+		require.False(t, source.IsInterfaceNil())
+
+		node, err := source.Get([]byte{0xcc})
+		require.Nil(t, err)
+		require.Equal(t, []byte{0xdd}, node)
+
+		node, err = source.Get([]byte{0xbb})
+		require.Equal(t, ErrNodeNotFound, err)
+		require.Nil(t, node)
+		require.Nil(t, source.Close())
+	})
+}
@@ -0,0 +1,29 @@
+package inspector
+
+import "github.com/multiversx/mx-chain-go/storage"
+
+// NodesSource defines a source of serialized trie nodes, indexed by their hash
+type NodesSource interface {
+	Get(key []byte) ([]byte, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// WalkHandler receives the trie nodes and the leaves found while walking the tries
+type WalkHandler interface {
+	ProcessNode(hash []byte, encodedNode []byte) error
+	ProcessMainTrieLeaf(key []byte, value []byte) error
+	ProcessDataTrieLeaf(address []byte, key []byte, value []byte) error
+	IsInterfaceNil() bool
+}
+
+// TrieWalker defines a component able to walk all the nodes of a trie
+type TrieWalker interface {
+	Walk(rootHash []byte, handler WalkHandler) (*WalkReport, error)
+	IsInterfaceNil() bool
+}
+
+type persisterCreator interface {
+	Create(path string) (storage.Persister, error)
+	CreateReadOnly(path string) (storage.Persister, error)
+}
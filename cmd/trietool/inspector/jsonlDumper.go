@@ -0,0 +1,162 @@
+package inspector
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+)
+
+const (
+	accountEntryType   = "account"
+	keyEntryType       = "key"
+	validatorEntryType = "validator"
+)
+
+// ArgsJSONLDumper holds the arguments needed to create a JSONL dumper
+type ArgsJSONLDumper struct {
+	Writer           io.Writer
+	Marshaller       marshal.Marshalizer
+	AddressConverter core.PubkeyConverter
+	IsPeerTrie       bool
+	ExportNodes      bool
+}
+
+// jsonlDumper writes one JSON entry per line for each account and data trie key found while walking the tries.
+// When exporting the nodes, it writes the serialized trie nodes instead, which can be used to repair another storage
+type jsonlDumper struct {
+	encoder          *json.Encoder
+	marshaller       marshal.Marshalizer
+	addressConverter core.PubkeyConverter
+	isPeerTrie       bool
+	exportNodes      bool
+}
+
+// NewJSONLDumper creates a new JSONL dumper
+func NewJSONLDumper(args ArgsJSONLDumper) (*jsonlDumper, error) {
+	if args.Writer == nil {
+		return nil, ErrNilWriter
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, errors.ErrNilMarshalizer
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, errors.ErrNilPubKeyConverter
+	}
+
+	return &jsonlDumper{
+		encoder:          json.NewEncoder(args.Writer),
+		marshaller:       args.Marshaller,
+		addressConverter: args.AddressConverter,
+		isPeerTrie:       args.IsPeerTrie,
+		exportNodes:      args.ExportNodes,
+	}, nil
+}
+
+// ProcessNode writes the serialized node, if the nodes are exported
+func (dumper *jsonlDumper) ProcessNode(hash []byte, encodedNode []byte) error {
+	if !dumper.exportNodes {
+		return nil
+	}
+
+	return dumper.encoder.Encode(&ExportedNode{
+		Hash: hex.EncodeToString(hash),
+		Node: hex.EncodeToString(encodedNode),
+	})
+}
+
+// ProcessMainTrieLeaf writes the user or peer account held by the leaf. The code leaves are skipped
+func (dumper *jsonlDumper) ProcessMainTrieLeaf(key []byte, value []byte) error {
+	if dumper.exportNodes {
+		return nil
+	}
+	if dumper.isPeerTrie {
+		return dumper.writePeerAccount(key, value)
+	}
+
+	account := &accounts.UserAccountData{}
+	err := dumper.marshaller.Unmarshal(account, value)
+	if err != nil || !bytes.Equal(account.Address, key) {
+		return nil
+	}
+
+	entry := &AccountEntry{
+		Type:         accountEntryType,
+		Address:      dumper.encodeAddress(account.Address),
+		Nonce:        account.Nonce,
+		Balance:      bigIntToString(account.Balance),
+		CodeHash:     hex.EncodeToString(account.CodeHash),
+		RootHash:     hex.EncodeToString(account.RootHash),
+		CodeMetadata: hex.EncodeToString(account.CodeMetadata),
+		OwnerAddress: dumper.encodeAddress(account.OwnerAddress),
+		UserName:     string(account.UserName),
+	}
+	if account.DeveloperReward != nil && account.DeveloperReward.Sign() != 0 {
+		entry.DeveloperReward = account.DeveloperReward.String()
+	}
+
+	return dumper.encoder.Encode(entry)
+}
+
+func (dumper *jsonlDumper) writePeerAccount(key []byte, value []byte) error {
+	account := &accounts.PeerAccountData{}
+	err := dumper.marshaller.Unmarshal(account, value)
+	if err != nil {
+		log.Debug("skipped peer trie leaf", "key", key, "error", err)
+		return nil
+	}
+
+	return dumper.encoder.Encode(&ValidatorEntry{
+		Type:          validatorEntryType,
+		BLSPublicKey:  hex.EncodeToString(account.BLSPublicKey),
+		RewardAddress: dumper.encodeAddress(account.RewardAddress),
+		ShardID:       account.ShardId,
+		List:          account.List,
+		IndexInList:   account.IndexInList,
+		Rating:        account.Rating,
+		TempRating:    account.TempRating,
+		Nonce:         account.Nonce,
+	})
+}
+
+// ProcessDataTrieLeaf writes the key and the value held by the data trie leaf
+func (dumper *jsonlDumper) ProcessDataTrieLeaf(address []byte, key []byte, value []byte) error {
+	if dumper.exportNodes {
+		return nil
+	}
+
+	return dumper.encoder.Encode(&KeyEntry{
+		Type:    keyEntryType,
+		Address: dumper.encodeAddress(address),
+		Key:     hex.EncodeToString(key),
+		Value:   hex.EncodeToString(value),
+	})
+}
+
+func (dumper *jsonlDumper) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return dumper.addressConverter.SilentEncode(address, log)
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dumper *jsonlDumper) IsInterfaceNil() bool {
+	return dumper == nil
+}
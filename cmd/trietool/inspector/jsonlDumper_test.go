@@ -0,0 +1,179 @@
+package inspector
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	chainErrors "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsJSONLDumper(writer *bytes.Buffer) ArgsJSONLDumper {
+	return ArgsJSONLDumper{
+		Writer:           writer,
+		Marshaller:       testMarshaller,
+		AddressConverter: testscommon.RealWorldBech32PubkeyConverter,
+	}
+}
+
+func readLines(t *testing.T, buffer *bytes.Buffer) []map[string]interface{} {
+	lines := make([]map[string]interface{}, 0)
+	for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+		entry := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal([]byte(line), &entry))
+		lines = append(lines, entry)
+	}
+
+	return lines
+}
+
+func TestNewJSONLDumper(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil writer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJSONLDumper(nil)
+		args.Writer = nil
+		dumper, err := NewJSONLDumper(args)
+		require.Equal(t, ErrNilWriter, err)
+		require.Nil(t, dumper)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJSONLDumper(&bytes.Buffer{})
+		args.Marshaller = nil
+		dumper, err := NewJSONLDumper(args)
+		require.Equal(t, chainErrors.ErrNilMarshalizer, err)
+		require.Nil(t, dumper)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJSONLDumper(&bytes.Buffer{})
+		args.AddressConverter = nil
+		dumper, err := NewJSONLDumper(args)
+		require.Equal(t, chainErrors.ErrNilPubKeyConverter, err)
+		require.Nil(t, dumper)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dumper, err := NewJSONLDumper(createMockArgsJSONLDumper(&bytes.Buffer{}))
+		require.Nil(t, err)
+		require.False(t, dumper.IsInterfaceNil())
+	})
+}
+
+func TestJsonlDumper_DumpAccountsAndKeys(t *testing.T) {
+	t.Parallel()
+
+	tries := createTestTries(t)
+	buffer := &bytes.Buffer{}
+	dumper, _ := NewJSONLDumper(createMockArgsJSONLDumper(buffer))
+	walker, _ := NewTrieWalker(createMockArgsTrieWalker(tries.storer))
+	report, err := walker.Walk(tries.rootHash, dumper)
+	require.Nil(t, err)
+	require.False(t, report.HasIssues())
+
+	lines := readLines(t, buffer)
+	// the code entry is not dumped
+	require.Len(t, lines, numAccounts+numDataTrieKeys)
+
+	contractAddress := testscommon.RealWorldBech32PubkeyConverter.SilentEncode(tries.contractAddress, log)
+	numAccountEntries := 0
+	numKeyEntries := 0
+	for _, line := range lines {
+		switch line["type"] {
+		case accountEntryType:
+			numAccountEntries++
+			if line["address"] != contractAddress {
+				require.NotContains(t, line, "rootHash")
+				continue
+			}
+
+			require.Equal(t, hex.EncodeToString(tries.dataTrieRootHash), line["rootHash"])
+			require.Equal(t, hex.EncodeToString(testCodeHash), line["codeHash"])
+			require.Equal(t, testscommon.RealWorldBech32PubkeyConverter.SilentEncode(createTestAddress(1), log), line["ownerAddress"])
+			require.Equal(t, "0", line["balance"])
+		case keyEntryType:
+			numKeyEntries++
+			require.Equal(t, contractAddress, line["address"])
+			key, _ := hex.DecodeString(line["key"].(string))
+			value, _ := hex.DecodeString(line["value"].(string))
+			require.Equal(t, "value"+strings.TrimPrefix(string(key), "key"), string(value))
+		default:
+			require.Fail(t, "unexpected entry type")
+		}
+	}
+	require.Equal(t, numAccounts, numAccountEntries)
+	require.Equal(t, numDataTrieKeys, numKeyEntries)
+}
+
+func TestJsonlDumper_DumpPeerAccounts(t *testing.T) {
+	t.Parallel()
+
+	buffer := &bytes.Buffer{}
+	args := createMockArgsJSONLDumper(buffer)
+	args.IsPeerTrie = true
+	dumper, _ := NewJSONLDumper(args)
+
+	blsKey := []byte("bls key")
+	peerAccount := &accounts.PeerAccountData{
+		BLSPublicKey:  blsKey,
+		RewardAddress: createTestAddress(2),
+		ShardId:       1,
+		List:          "eligible",
+		IndexInList:   3,
+		Rating:        50,
+		TempRating:    51,
+		Nonce:         4,
+	}
+	peerAccountBytes, err := testMarshaller.Marshal(peerAccount)
+	require.Nil(t, err)
+	require.Nil(t, dumper.ProcessMainTrieLeaf(blsKey, peerAccountBytes))
+	require.Nil(t, dumper.ProcessMainTrieLeaf([]byte("invalid"), []byte("invalid peer account")))
+
+	entry := &ValidatorEntry{}
+	require.Nil(t, json.Unmarshal(buffer.Bytes(), entry))
+	expectedEntry := &ValidatorEntry{
+		Type:          validatorEntryType,
+		BLSPublicKey:  hex.EncodeToString(blsKey),
+		RewardAddress: testscommon.RealWorldBech32PubkeyConverter.SilentEncode(createTestAddress(2), log),
+		ShardID:       1,
+		List:          "eligible",
+		IndexInList:   3,
+		Rating:        50,
+		TempRating:    51,
+		Nonce:         4,
+	}
+	require.Equal(t, expectedEntry, entry)
+}
+
+func TestJsonlDumper_ExportNodes(t *testing.T) {
+	t.Parallel()
+
+	tries := createTestTries(t)
+	buffer := &bytes.Buffer{}
+	args := createMockArgsJSONLDumper(buffer)
+	args.ExportNodes = true
+	dumper, _ := NewJSONLDumper(args)
+	walker, _ := NewTrieWalker(createMockArgsTrieWalker(tries.storer))
+	report, err := walker.Walk(tries.rootHash, dumper)
+	require.Nil(t, err)
+
+	lines := readLines(t, buffer)
+	require.Len(t, lines, int(report.NumNodes))
+	for _, line := range lines {
+		require.Len(t, line, 2)
+		hash, _ := hex.DecodeString(line["hash"].(string))
+		node, _ := hex.DecodeString(line["node"].(string))
+		require.Equal(t, hash, testHasher.Compute(string(node)))
+	}
+}
@@ -0,0 +1,80 @@
+package inspector
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/errors"
+)
+
+// repairingNodesSource reads the trie nodes from the storage that is repaired. Only the nodes missing from it are
+// requested from the backup source and, if their hash checks out, they are saved in the repaired storage
+type repairingNodesSource struct {
+	target      common.BaseStorer
+	backup      NodesSource
+	hasher      hashing.Hasher
+	numRepaired uint64
+}
+
+// NewRepairingNodesSource creates a new nodes source which repairs the target storage with nodes from the backup source
+func NewRepairingNodesSource(target common.BaseStorer, backup NodesSource, hasher hashing.Hasher) (*repairingNodesSource, error) {
+	if check.IfNil(target) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(backup) {
+		return nil, ErrNilNodesSource
+	}
+	if check.IfNil(hasher) {
+		return nil, errors.ErrNilHasher
+	}
+
+	return &repairingNodesSource{
+		target: target,
+		backup: backup,
+		hasher: hasher,
+	}, nil
+}
+
+// Get returns the node from the target storage, restoring it from the backup source if it is missing
+func (source *repairingNodesSource) Get(key []byte) ([]byte, error) {
+	encodedNode, err := source.target.Get(key)
+	if err == nil {
+		return encodedNode, nil
+	}
+
+	encodedNode, errBackup := source.backup.Get(key)
+	if errBackup != nil {
+		return nil, err
+	}
+	if !bytes.Equal(source.hasher.Compute(string(encodedNode)), key) {
+		log.Warn("ignored backup trie node with mismatching hash", "hash", key)
+		return nil, err
+	}
+
+	err = source.target.Put(key, encodedNode)
+	if err != nil {
+		return nil, err
+	}
+
+	source.numRepaired++
+	log.Trace("restored trie node", "hash", key)
+
+	return encodedNode, nil
+}
+
+// NumRepaired returns the number of nodes restored in the target storage
+func (source *repairingNodesSource) NumRepaired() uint64 {
+	return source.numRepaired
+}
+
+// Close returns nil, as the target storage and the backup source are closed by their owner
+func (source *repairingNodesSource) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (source *repairingNodesSource) IsInterfaceNil() bool {
+	return source == nil
+}
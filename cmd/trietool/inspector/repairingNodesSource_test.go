@@ -0,0 +1,177 @@
+package inspector
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	chainErrors "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRepairingNodesSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil target should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := NewRepairingNodesSource(nil, testscommon.NewMemDbMock(), testHasher)
+		require.Equal(t, ErrNilStorer, err)
+		require.Nil(t, source)
+	})
+	t.Run("nil backup should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := NewRepairingNodesSource(testscommon.NewMemDbMock(), nil, testHasher)
+		require.Equal(t, ErrNilNodesSource, err)
+		require.Nil(t, source)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := NewRepairingNodesSource(testscommon.NewMemDbMock(), testscommon.NewMemDbMock(), nil)
+		require.Equal(t, chainErrors.ErrNilHasher, err)
+		require.Nil(t, source)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := NewRepairingNodesSource(testscommon.NewMemDbMock(), testscommon.NewMemDbMock(), testHasher)
+		require.Nil(t, err)
+		require.False(t, source.IsInterfaceNil())
+		require.Nil(t, source.Close())
+	})
+}
+
+func TestRepairingNodesSource_Get(t *testing.T) {
+	t.Parallel()
+
+	node := []byte("node")
+	nodeHash := testHasher.Compute(string(node))
+
+	t.Run("existing node should not be requested from the backup", func(t *testing.T) {
+		t.Parallel()
+
+		target := testscommon.NewMemDbMock()
+		require.Nil(t, target.Put(nodeHash, node))
+		backup := testscommon.NewMemDbMock()
+		backup.GetCalled = func(key []byte) ([]byte, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		}
+
+		source, _ := NewRepairingNodesSource(target, backup, testHasher)
+		value, err := source.Get(nodeHash)
+		require.Nil(t, err)
+		require.Equal(t, node, value)
+		require.Zero(t, source.NumRepaired())
+	})
+	t.Run("node missing from the backup should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, _ := NewRepairingNodesSource(testscommon.NewMemDbMock(), testscommon.NewMemDbMock(), testHasher)
+		value, err := source.Get(nodeHash)
+		require.NotNil(t, err)
+		require.Nil(t, value)
+		require.Zero(t, source.NumRepaired())
+	})
+	t.Run("backup node with mismatching hash should not be restored", func(t *testing.T) {
+		t.Parallel()
+
+		target := testscommon.NewMemDbMock()
+		backup := testscommon.NewMemDbMock()
+		require.Nil(t, backup.Put(nodeHash, []byte("other node")))
+
+		source, _ := NewRepairingNodesSource(target, backup, testHasher)
+		value, err := source.Get(nodeHash)
+		require.NotNil(t, err)
+		require.Nil(t, value)
+		require.Zero(t, source.NumRepaired())
+		_, err = target.Get(nodeHash)
+		require.NotNil(t, err)
+	})
+	t.Run("target put error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		target := testscommon.NewMemDbMock()
+		target.PutCalled = func(key, val []byte) error {
+			return expectedErr
+		}
+		backup := testscommon.NewMemDbMock()
+		require.Nil(t, backup.Put(nodeHash, node))
+
+		source, _ := NewRepairingNodesSource(target, backup, testHasher)
+		value, err := source.Get(nodeHash)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, value)
+		require.Zero(t, source.NumRepaired())
+	})
+	t.Run("missing node should be restored from the backup", func(t *testing.T) {
+		t.Parallel()
+
+		target := testscommon.NewMemDbMock()
+		backup := testscommon.NewMemDbMock()
+		require.Nil(t, backup.Put(nodeHash, node))
+
+		source, _ := NewRepairingNodesSource(target, backup, testHasher)
+		value, err := source.Get(nodeHash)
+		require.Nil(t, err)
+		require.Equal(t, node, value)
+		require.Equal(t, uint64(1), source.NumRepaired())
+		value, err = target.Get(nodeHash)
+		require.Nil(t, err)
+		require.Equal(t, node, value)
+	})
+}
+
+func TestRepairingNodesSource_RepairTries(t *testing.T) {
+	t.Parallel()
+
+	tries := createTestTries(t)
+	backup := testscommon.NewMemDbMock()
+	for _, hash := range [][]byte{tries.rootHash, tries.dataTrieRootHash} {
+		node, err := tries.storer.Get(hash)
+		require.Nil(t, err)
+		require.Nil(t, backup.Put(hash, node))
+		require.Nil(t, tries.storer.Remove(hash))
+	}
+
+	walker, _ := NewTrieWalker(createMockArgsTrieWalker(tries.storer))
+	report, err := walker.Walk(tries.rootHash, nil)
+	require.Nil(t, err)
+	require.Len(t, report.MissingNodes, 1)
+
+	// the export of the backup is used as repair source
+	exportBuffer := &bytes.Buffer{}
+	dumper, _ := NewJSONLDumper(ArgsJSONLDumper{
+		Writer:           exportBuffer,
+		Marshaller:       testMarshaller,
+		AddressConverter: testscommon.RealWorldBech32PubkeyConverter,
+		ExportNodes:      true,
+	})
+	for _, hash := range [][]byte{tries.rootHash, tries.dataTrieRootHash} {
+		node, _ := backup.Get(hash)
+		require.Nil(t, dumper.ProcessNode(hash, node))
+	}
+	require.Equal(t, 2, strings.Count(exportBuffer.String(), "\n"))
+	require.Contains(t, exportBuffer.String(), hex.EncodeToString(tries.dataTrieRootHash))
+
+	exportedNodes, err := NewExportedNodesSource(exportBuffer)
+	require.Nil(t, err)
+	repairingSource, _ := NewRepairingNodesSource(tries.storer, exportedNodes, testHasher)
+	walker, _ = NewTrieWalker(createMockArgsTrieWalker(repairingSource))
+	report, err = walker.Walk(tries.rootHash, nil)
+	require.Nil(t, err)
+	require.False(t, report.HasIssues())
+	require.Equal(t, uint64(2), repairingSource.NumRepaired())
+
+	walker, _ = NewTrieWalker(createMockArgsTrieWalker(tries.storer))
+	report, err = walker.Walk(tries.rootHash, nil)
+	require.Nil(t, err)
+	require.False(t, report.HasIssues())
+	require.Equal(t, uint64(2), report.NumTries)
+}
@@ -0,0 +1,62 @@
+package inspector
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/statistics/disabled"
+)
+
+const singleNodeStorerIdentifier = "trietool"
+
+// singleNodeStorer holds an already fetched and verified trie node, so it can be decoded without reading it again
+type singleNodeStorer struct {
+	hash        []byte
+	encodedNode []byte
+}
+
+func newSingleNodeStorer(hash []byte, encodedNode []byte) *singleNodeStorer {
+	return &singleNodeStorer{
+		hash:        hash,
+		encodedNode: encodedNode,
+	}
+}
+
+// Get returns the held node if the key is its hash
+func (sns *singleNodeStorer) Get(key []byte) ([]byte, error) {
+	if !bytes.Equal(key, sns.hash) {
+		return nil, ErrNodeNotFound
+	}
+
+	return sns.encodedNode, nil
+}
+
+// Put returns ErrReadOnlyStorage
+func (sns *singleNodeStorer) Put(_ []byte, _ []byte) error {
+	return ErrReadOnlyStorage
+}
+
+// Remove returns ErrReadOnlyStorage
+func (sns *singleNodeStorer) Remove(_ []byte) error {
+	return ErrReadOnlyStorage
+}
+
+// Close returns nil
+func (sns *singleNodeStorer) Close() error {
+	return nil
+}
+
+// GetIdentifier returns the identifier of the storer
+func (sns *singleNodeStorer) GetIdentifier() string {
+	return singleNodeStorerIdentifier
+}
+
+// GetStateStatsHandler returns a disabled state statistics handler
+func (sns *singleNodeStorer) GetStateStatsHandler() common.StateStatisticsHandler {
+	return disabled.NewStateStatistics()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sns *singleNodeStorer) IsInterfaceNil() bool {
+	return sns == nil
+}
@@ -0,0 +1,99 @@
+package inspector
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+)
+
+// ArgsStatisticsHandler holds the arguments needed to create a statistics handler
+type ArgsStatisticsHandler struct {
+	Trie             common.TrieStats
+	Marshaller       marshal.Marshalizer
+	AddressConverter core.PubkeyConverter
+	Collector        common.TriesStatisticsCollector
+	CollectDataTries bool
+}
+
+// statisticsHandler collects the statistics of the main trie and of the data tries of the accounts found while
+// walking the main trie
+type statisticsHandler struct {
+	disabledWalkHandler
+	trie             common.TrieStats
+	marshaller       marshal.Marshalizer
+	addressConverter core.PubkeyConverter
+	collector        common.TriesStatisticsCollector
+	collectDataTries bool
+}
+
+// NewStatisticsHandler creates a new statistics handler
+func NewStatisticsHandler(args ArgsStatisticsHandler) (*statisticsHandler, error) {
+	if args.Trie == nil {
+		return nil, errors.ErrNilTrie
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, errors.ErrNilMarshalizer
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, errors.ErrNilPubKeyConverter
+	}
+	if args.Collector == nil {
+		return nil, ErrNilStatisticsCollector
+	}
+
+	return &statisticsHandler{
+		trie:             args.Trie,
+		marshaller:       args.Marshaller,
+		addressConverter: args.AddressConverter,
+		collector:        args.Collector,
+		collectDataTries: args.CollectDataTries,
+	}, nil
+}
+
+// AddMainTrieStatistics collects the statistics of the main trie with the provided root hash
+func (handler *statisticsHandler) AddMainTrieStatistics(rootHash []byte) error {
+	trieStats, err := handler.trie.GetTrieStats("", rootHash)
+	if err != nil {
+		return err
+	}
+
+	handler.collector.Add(trieStats, common.MainTrie)
+
+	return nil
+}
+
+// ProcessMainTrieLeaf collects the statistics of the data trie of the account held by the leaf
+func (handler *statisticsHandler) ProcessMainTrieLeaf(key []byte, value []byte) error {
+	if !handler.collectDataTries {
+		return nil
+	}
+
+	account := &accounts.UserAccountData{}
+	err := handler.marshaller.Unmarshal(account, value)
+	if err != nil || !bytes.Equal(account.Address, key) {
+		return nil
+	}
+	if common.IsEmptyTrie(account.RootHash) {
+		return nil
+	}
+
+	address := handler.addressConverter.SilentEncode(account.Address, log)
+	trieStats, err := handler.trie.GetTrieStats(address, account.RootHash)
+	if err != nil {
+		return err
+	}
+
+	handler.collector.Add(trieStats, common.DataTrie)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *statisticsHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
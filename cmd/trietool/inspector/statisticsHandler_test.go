@@ -0,0 +1,132 @@
+package inspector
+
+import (
+	"testing"
+
+	chainErrors "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/trie/statistics"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsStatisticsHandler(t *testing.T, tries *testTries) ArgsStatisticsHandler {
+	return ArgsStatisticsHandler{
+		Trie:             createTestTrie(t, tries.storer),
+		Marshaller:       testMarshaller,
+		AddressConverter: testscommon.RealWorldBech32PubkeyConverter,
+		Collector:        statistics.NewTrieStatisticsCollector(),
+		CollectDataTries: true,
+	}
+}
+
+func TestNewStatisticsHandler(t *testing.T) {
+	t.Parallel()
+
+	tries := &testTries{storer: testscommon.NewMemDbMock()}
+
+	t.Run("nil trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStatisticsHandler(t, tries)
+		args.Trie = nil
+		handler, err := NewStatisticsHandler(args)
+		require.Equal(t, chainErrors.ErrNilTrie, err)
+		require.Nil(t, handler)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStatisticsHandler(t, tries)
+		args.Marshaller = nil
+		handler, err := NewStatisticsHandler(args)
+		require.Equal(t, chainErrors.ErrNilMarshalizer, err)
+		require.Nil(t, handler)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStatisticsHandler(t, tries)
+		args.AddressConverter = nil
+		handler, err := NewStatisticsHandler(args)
+		require.Equal(t, chainErrors.ErrNilPubKeyConverter, err)
+		require.Nil(t, handler)
+	})
+	t.Run("nil collector should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStatisticsHandler(t, tries)
+		args.Collector = nil
+		handler, err := NewStatisticsHandler(args)
+		require.Equal(t, ErrNilStatisticsCollector, err)
+		require.Nil(t, handler)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewStatisticsHandler(createMockArgsStatisticsHandler(t, tries))
+		require.Nil(t, err)
+		require.False(t, handler.IsInterfaceNil())
+	})
+}
+
+func TestStatisticsHandler_CollectStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should collect the statistics of all the tries", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		walker, _ := NewTrieWalker(createMockArgsTrieWalker(tries.storer))
+		fullReport, err := walker.Walk(tries.rootHash, nil)
+		require.Nil(t, err)
+
+		args := createMockArgsStatisticsHandler(t, tries)
+		handler, _ := NewStatisticsHandler(args)
+		require.Nil(t, handler.AddMainTrieStatistics(tries.rootHash))
+
+		argsWalker := createMockArgsTrieWalker(tries.storer)
+		argsWalker.WalkDataTries = false
+		walker, _ = NewTrieWalker(argsWalker)
+		_, err = walker.Walk(tries.rootHash, handler)
+		require.Nil(t, err)
+		require.Equal(t, fullReport.NumNodes, args.Collector.GetNumNodes())
+	})
+	t.Run("without data tries should collect only the main trie statistics", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		args := createMockArgsStatisticsHandler(t, tries)
+		args.CollectDataTries = false
+		handler, _ := NewStatisticsHandler(args)
+		require.Nil(t, handler.AddMainTrieStatistics(tries.rootHash))
+
+		argsWalker := createMockArgsTrieWalker(tries.storer)
+		argsWalker.WalkDataTries = false
+		walker, _ := NewTrieWalker(argsWalker)
+		report, err := walker.Walk(tries.rootHash, handler)
+		require.Nil(t, err)
+		require.Equal(t, report.NumNodes, args.Collector.GetNumNodes())
+	})
+	t.Run("missing data trie node should error", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		require.Nil(t, tries.storer.Remove(tries.dataTrieRootHash))
+		handler, _ := NewStatisticsHandler(createMockArgsStatisticsHandler(t, tries))
+
+		argsWalker := createMockArgsTrieWalker(tries.storer)
+		argsWalker.WalkDataTries = false
+		walker, _ := NewTrieWalker(argsWalker)
+		report, err := walker.Walk(tries.rootHash, handler)
+		require.NotNil(t, err)
+		require.Nil(t, report)
+	})
+	t.Run("missing main trie node should error", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		require.Nil(t, tries.storer.Remove(tries.rootHash))
+		handler, _ := NewStatisticsHandler(createMockArgsStatisticsHandler(t, tries))
+		require.NotNil(t, handler.AddMainTrieStatistics(tries.rootHash))
+	})
+}
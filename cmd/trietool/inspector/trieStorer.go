@@ -0,0 +1,193 @@
+package inspector
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("trietool/inspector")
+
+// ArgsTrieStorer holds the arguments needed to open the storage of a trie from a node's storage directory
+type ArgsTrieStorer struct {
+	DBPath   string
+	ShardID  string
+	DBConfig config.DBConfig
+	Writable bool
+}
+
+// trieStorer gives access to the trie nodes stored in all the epoch and static persisters of a trie storage
+type trieStorer struct {
+	persisters []storage.Persister
+	writable   bool
+}
+
+// NewTrieStorer opens all the persisters of a trie storage found in the provided directory. The DBPath is the
+// directory which contains the Epoch_* and Static directories, i.e. <working dir>/db/<chain ID>. The persisters
+// are queried from the most recent epoch to the oldest, the static one being the last. Unless the storer is
+// writable, the persisters are opened read-only and all the write operations will error
+func NewTrieStorer(args ArgsTrieStorer) (*trieStorer, error) {
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(args.DBPath)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs, err := getStoredEpochs(args.DBPath)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(epochs)+1)
+	for _, epoch := range epochs {
+		paths = append(paths, pathManager.PathForEpoch(args.ShardID, epoch, args.DBConfig.FilePath))
+	}
+	paths = append(paths, pathManager.PathForStatic(args.ShardID, args.DBConfig.FilePath))
+
+	persisterFactory, err := storageFactory.NewPersisterFactory(args.DBConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := &trieStorer{
+		persisters: make([]storage.Persister, 0, len(paths)),
+		writable:   args.Writable,
+	}
+	for _, path := range paths {
+		if !dirExists(path) {
+			continue
+		}
+
+		persister, errCreate := createPersister(persisterFactory, path, args.Writable)
+		if errCreate != nil {
+			_ = ts.Close()
+			return nil, fmt.Errorf("%w while opening %s", errCreate, path)
+		}
+
+		log.Debug("opened trie persister", "path", path)
+		ts.persisters = append(ts.persisters, persister)
+	}
+
+	if len(ts.persisters) == 0 {
+		return nil, fmt.Errorf("%w for %s in %s, shard %s", ErrNoPersisterFound, args.DBConfig.FilePath, args.DBPath, args.ShardID)
+	}
+
+	return ts, nil
+}
+
+func createPersister(persisterFactory persisterCreator, path string, writable bool) (storage.Persister, error) {
+	if writable {
+		return persisterFactory.Create(path)
+	}
+
+	return persisterFactory.CreateReadOnly(path)
+}
+
+func getStoredEpochs(dbPath string) ([]uint32, error) {
+	entries, err := os.ReadDir(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	epochPrefix := storage.DefaultEpochString + "_"
+	epochs := make([]uint32, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), epochPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(entry.Name(), epochPrefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] > epochs[j]
+	})
+
+	return epochs, nil
+}
+
+func dirExists(path string) bool {
+	info, err := os.Stat(filepath.Clean(path))
+	if err != nil {
+		return false
+	}
+
+	return info.IsDir()
+}
+
+// Get returns the value stored under the provided key in the first persister that holds it. A read error, other than
+// the missing key, is returned as is
+func (ts *trieStorer) Get(key []byte) ([]byte, error) {
+	for _, persister := range ts.persisters {
+		value, err := persister.Get(key)
+		if err == nil {
+			return value, nil
+		}
+		if !errors.Is(err, storage.ErrKeyNotFound) {
+			return nil, err
+		}
+	}
+
+	return nil, ErrNodeNotFound
+}
+
+// Put saves the key-value pair in the most recent persister
+func (ts *trieStorer) Put(key []byte, value []byte) error {
+	if !ts.writable {
+		return ErrReadOnlyStorage
+	}
+	if len(ts.persisters) == 0 {
+		return ErrNoPersisterFound
+	}
+
+	return ts.persisters[0].Put(key, value)
+}
+
+// Remove removes the key from all the persisters
+func (ts *trieStorer) Remove(key []byte) error {
+	if !ts.writable {
+		return ErrReadOnlyStorage
+	}
+
+	for _, persister := range ts.persisters {
+		err := persister.Remove(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close closes all the persisters. Calling it multiple times is safe
+func (ts *trieStorer) Close() error {
+	var lastErr error
+	for _, persister := range ts.persisters {
+		err := persister.Close()
+		if err != nil {
+			log.Warn("cannot close trie persister", "error", err)
+			lastErr = err
+		}
+	}
+	ts.persisters = nil
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *trieStorer) IsInterfaceNil() bool {
+	return ts == nil
+}
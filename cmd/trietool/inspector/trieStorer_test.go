@@ -0,0 +1,229 @@
+package inspector
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/mock"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/require"
+)
+
+const testShardID = "0"
+
+func createTestDBConfig() config.DBConfig {
+	return config.DBConfig{
+		FilePath:          "AccountsTrie",
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+}
+
+// createTestStorage creates the AccountsTrie persisters of the epochs 0 and 2 and the static one. The key "common"
+// is saved in all of them, with the directory name as value
+func createTestStorage(t *testing.T) string {
+	dbPath := t.TempDir()
+	dbConfig := createTestDBConfig()
+	directories := map[string]string{
+		"Epoch_0": filepath.Join(dbPath, "Epoch_0", "Shard_"+testShardID, dbConfig.FilePath),
+		"Epoch_2": filepath.Join(dbPath, "Epoch_2", "Shard_"+testShardID, dbConfig.FilePath),
+		"Static":  filepath.Join(dbPath, "Static", "Shard_"+testShardID, dbConfig.FilePath),
+	}
+
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfig)
+	require.Nil(t, err)
+	for name, path := range directories {
+		persister, errCreate := persisterFactory.Create(path)
+		require.Nil(t, errCreate)
+		require.Nil(t, persister.Put([]byte(name), []byte(name)))
+		require.Nil(t, persister.Put([]byte("common"), []byte(name)))
+		require.Nil(t, persister.Close())
+	}
+
+	// directories which are not epoch directories and epochs without the trie storage should be ignored
+	require.Nil(t, os.MkdirAll(filepath.Join(dbPath, "Epoch_3", "Shard_1", dbConfig.FilePath), os.ModePerm))
+	require.Nil(t, os.MkdirAll(filepath.Join(dbPath, "Epoch_x"), os.ModePerm))
+
+	return dbPath
+}
+
+func TestNewTrieStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewTrieStorer(ArgsTrieStorer{
+			DBPath:   filepath.Join(t.TempDir(), "missing"),
+			ShardID:  testShardID,
+			DBConfig: createTestDBConfig(),
+		})
+		require.NotNil(t, err)
+		require.Nil(t, storer)
+	})
+	t.Run("no persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewTrieStorer(ArgsTrieStorer{
+			DBPath:   createTestStorage(t),
+			ShardID:  "2",
+			DBConfig: createTestDBConfig(),
+		})
+		require.True(t, errors.Is(err, ErrNoPersisterFound))
+		require.Nil(t, storer)
+	})
+	t.Run("should open all the persisters", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewTrieStorer(ArgsTrieStorer{
+			DBPath:   createTestStorage(t),
+			ShardID:  testShardID,
+			DBConfig: createTestDBConfig(),
+		})
+		require.Nil(t, err)
+		require.False(t, storer.IsInterfaceNil())
+		require.Len(t, storer.persisters, 3)
+		require.Nil(t, storer.Close())
+	})
+}
+
+func TestTrieStorer_ReadOnly(t *testing.T) {
+	t.Parallel()
+
+	storer, err := NewTrieStorer(ArgsTrieStorer{
+		DBPath:   createTestStorage(t),
+		ShardID:  testShardID,
+		DBConfig: createTestDBConfig(),
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	for _, name := range []string{"Epoch_0", "Epoch_2", "Static"} {
+		value, errGet := storer.Get([]byte(name))
+		require.Nil(t, errGet)
+		require.Equal(t, []byte(name), value)
+	}
+
+	value, err := storer.Get([]byte("common"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("Epoch_2"), value)
+
+	value, err = storer.Get([]byte("missing"))
+	require.Equal(t, ErrNodeNotFound, err)
+	require.Nil(t, value)
+
+	require.Equal(t, ErrReadOnlyStorage, storer.Put([]byte("key"), []byte("value")))
+	require.Equal(t, ErrReadOnlyStorage, storer.Remove([]byte("common")))
+}
+
+func TestTrieStorer_ReadOnlyPersisters(t *testing.T) {
+	t.Parallel()
+
+	storer, err := NewTrieStorer(ArgsTrieStorer{
+		DBPath:   createTestStorage(t),
+		ShardID:  testShardID,
+		DBConfig: createTestDBConfig(),
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	for _, persister := range storer.persisters {
+		require.Equal(t, storage.ErrReadOnlyPersister, persister.Put([]byte("key"), []byte("value")))
+	}
+}
+
+func TestTrieStorer_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing key in a persister should continue with the next one", func(t *testing.T) {
+		t.Parallel()
+
+		storer := &trieStorer{
+			persisters: []storage.Persister{
+				&mock.PersisterStub{
+					GetCalled: func(key []byte) ([]byte, error) {
+						return nil, storage.ErrKeyNotFound
+					},
+				},
+				&mock.PersisterStub{
+					GetCalled: func(key []byte) ([]byte, error) {
+						return []byte("value"), nil
+					},
+				},
+			},
+		}
+		value, err := storer.Get([]byte("key"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("value"), value)
+	})
+	t.Run("persister error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		storer := &trieStorer{
+			persisters: []storage.Persister{
+				&mock.PersisterStub{
+					GetCalled: func(key []byte) ([]byte, error) {
+						return nil, expectedErr
+					},
+				},
+				&mock.PersisterStub{
+					GetCalled: func(key []byte) ([]byte, error) {
+						require.Fail(t, "should have not been called")
+						return nil, nil
+					},
+				},
+			},
+		}
+		value, err := storer.Get([]byte("key"))
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, value)
+	})
+}
+
+func TestTrieStorer_Writable(t *testing.T) {
+	t.Parallel()
+
+	dbPath := createTestStorage(t)
+	args := ArgsTrieStorer{
+		DBPath:   dbPath,
+		ShardID:  testShardID,
+		DBConfig: createTestDBConfig(),
+		Writable: true,
+	}
+	storer, err := NewTrieStorer(args)
+	require.Nil(t, err)
+	require.Nil(t, storer.Put([]byte("key"), []byte("value")))
+	require.Nil(t, storer.Remove([]byte("common")))
+	require.Nil(t, storer.Close())
+	require.Nil(t, storer.Close())
+	require.Equal(t, ErrNoPersisterFound, storer.Put([]byte("key"), []byte("value")))
+
+	// the value should have been saved in the most recent epoch
+	persisterFactory, _ := storageFactory.NewPersisterFactory(args.DBConfig)
+	persister, err := persisterFactory.Create(filepath.Join(dbPath, "Epoch_2", "Shard_"+testShardID, args.DBConfig.FilePath))
+	require.Nil(t, err)
+	value, err := persister.Get([]byte("key"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.Nil(t, persister.Close())
+
+	args.Writable = false
+	storer, err = NewTrieStorer(args)
+	require.Nil(t, err)
+	value, err = storer.Get([]byte("common"))
+	require.Equal(t, ErrNodeNotFound, err)
+	require.Nil(t, value)
+	require.Nil(t, storer.Close())
+}
@@ -0,0 +1,213 @@
+package inspector
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+)
+
+// MainTrieName is the name used in reports for the issues found in the main trie
+const MainTrieName = "main"
+
+// ArgsTrieWalker holds the arguments needed to create a trie walker
+type ArgsTrieWalker struct {
+	NodesSource         NodesSource
+	Marshaller          marshal.Marshalizer
+	Hasher              hashing.Hasher
+	EnableEpochsHandler common.EnableEpochsHandler
+	WalkDataTries       bool
+}
+
+type nodeToWalk struct {
+	hash       []byte
+	keyBuilder common.KeyBuilder
+}
+
+// trieWalker walks every node of a main trie and, optionally, of the data tries of its accounts. Unlike the trie
+// iterators, it does not stop at the first missing or corrupt node: it records the issue and continues with the
+// rest of the tries
+type trieWalker struct {
+	nodesSource         NodesSource
+	marshaller          marshal.Marshalizer
+	hasher              hashing.Hasher
+	enableEpochsHandler common.EnableEpochsHandler
+	walkDataTries       bool
+}
+
+// NewTrieWalker creates a new trie walker
+func NewTrieWalker(args ArgsTrieWalker) (*trieWalker, error) {
+	if check.IfNil(args.NodesSource) {
+		return nil, ErrNilNodesSource
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, errors.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, errors.ErrNilHasher
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, errors.ErrNilEnableEpochsHandler
+	}
+
+	return &trieWalker{
+		nodesSource:         args.NodesSource,
+		marshaller:          args.Marshaller,
+		hasher:              args.Hasher,
+		enableEpochsHandler: args.EnableEpochsHandler,
+		walkDataTries:       args.WalkDataTries,
+	}, nil
+}
+
+// Walk walks the trie with the provided root hash and reports the missing and corrupt nodes. The nodes and the
+// leaves are passed to the provided handler, which can be nil. The walk is stopped only if the handler errors
+func (tw *trieWalker) Walk(rootHash []byte, handler WalkHandler) (*WalkReport, error) {
+	if common.IsEmptyTrie(rootHash) {
+		return nil, ErrEmptyRootHash
+	}
+	if check.IfNil(handler) {
+		handler = &disabledWalkHandler{}
+	}
+
+	report := &WalkReport{
+		MissingNodes: make([]*NodeIssue, 0),
+		CorruptNodes: make([]*NodeIssue, 0),
+	}
+	err := tw.walkTrie(rootHash, nil, handler, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func (tw *trieWalker) walkTrie(rootHash []byte, address []byte, handler WalkHandler, report *WalkReport) error {
+	report.NumTries++
+	trieName := MainTrieName
+	var leafParser common.TrieLeafParser
+	if len(address) > 0 {
+		trieName = hex.EncodeToString(address)
+
+		var err error
+		leafParser, err = parsers.NewDataTrieLeafParser(address, tw.marshaller, tw.enableEpochsHandler)
+		if err != nil {
+			return err
+		}
+	}
+
+	stack := []*nodeToWalk{{hash: rootHash, keyBuilder: keyBuilder.NewKeyBuilder()}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		encodedNode, err := tw.nodesSource.Get(current.hash)
+		if err != nil {
+			report.MissingNodes = append(report.MissingNodes, newNodeIssue(current.hash, trieName, err))
+			continue
+		}
+		report.NumNodes++
+
+		if !bytes.Equal(tw.hasher.Compute(string(encodedNode)), current.hash) {
+			report.CorruptNodes = append(report.CorruptNodes, newNodeIssue(current.hash, trieName, ErrHashMismatch))
+			continue
+		}
+
+		nodeStorer := newSingleNodeStorer(current.hash, encodedNode)
+		nodeData, err := trie.GetNodeDataFromHash(current.hash, current.keyBuilder, nodeStorer, tw.marshaller, tw.hasher)
+		if err != nil {
+			report.CorruptNodes = append(report.CorruptNodes, newNodeIssue(current.hash, trieName, err))
+			continue
+		}
+
+		err = handler.ProcessNode(current.hash, encodedNode)
+		if err != nil {
+			return err
+		}
+
+		// the children are pushed in reverse order, so the leaves are walked in the order of their keys
+		for i := len(nodeData) - 1; i >= 0; i-- {
+			if !nodeData[i].IsLeaf() {
+				stack = append(stack, &nodeToWalk{hash: nodeData[i].GetData(), keyBuilder: nodeData[i].GetKeyBuilder()})
+				continue
+			}
+
+			report.NumLeaves++
+			err = tw.processLeaf(current.hash, nodeData[i], address, leafParser, handler, report)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (tw *trieWalker) processLeaf(
+	nodeHash []byte,
+	data common.TrieNodeData,
+	address []byte,
+	leafParser common.TrieLeafParser,
+	handler WalkHandler,
+	report *WalkReport,
+) error {
+	key, err := data.GetKeyBuilder().GetKey()
+	if err != nil {
+		return fmt.Errorf("%w while building the key of a leaf", err)
+	}
+
+	if check.IfNil(leafParser) {
+		return tw.processMainTrieLeaf(key, data.GetData(), handler, report)
+	}
+
+	keyValue, err := leafParser.ParseLeaf(key, data.GetData(), data.GetVersion())
+	if err != nil {
+		report.CorruptNodes = append(report.CorruptNodes, newNodeIssue(nodeHash, hex.EncodeToString(address), err))
+		return nil
+	}
+
+	return handler.ProcessDataTrieLeaf(address, keyValue.Key(), keyValue.Value())
+}
+
+func (tw *trieWalker) processMainTrieLeaf(key []byte, value []byte, handler WalkHandler, report *WalkReport) error {
+	err := handler.ProcessMainTrieLeaf(key, value)
+	if err != nil {
+		return err
+	}
+	if !tw.walkDataTries {
+		return nil
+	}
+
+	account := &accounts.UserAccountData{}
+	err = tw.marshaller.Unmarshal(account, value)
+	if err != nil || !bytes.Equal(account.Address, key) {
+		// the main trie also holds the contracts code, under the code hash
+		return nil
+	}
+	if common.IsEmptyTrie(account.RootHash) {
+		return nil
+	}
+
+	return tw.walkTrie(account.RootHash, key, handler, report)
+}
+
+func newNodeIssue(hash []byte, trieName string, err error) *NodeIssue {
+	return &NodeIssue{
+		Hash:  hex.EncodeToString(hash),
+		Trie:  trieName,
+		Error: err.Error(),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tw *trieWalker) IsInterfaceNil() bool {
+	return tw == nil
+}
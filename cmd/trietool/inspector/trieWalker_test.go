@@ -0,0 +1,331 @@
+package inspector
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	chainErrors "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	testStorage "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	numAccounts      = 5
+	numDataTrieKeys  = 10
+	maxLevelInMemory = 5
+)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	testHasher     = &hashingMocks.HasherMock{}
+	testCodeHash   = testHasher.Compute("code")
+)
+
+type testTries struct {
+	storer           *testscommon.MemDbMock
+	rootHash         []byte
+	contractAddress  []byte
+	dataTrieRootHash []byte
+}
+
+func createTestAddress(index int) []byte {
+	return bytes.Repeat([]byte{byte(index + 1)}, 32)
+}
+
+func createTestDataTrieKey(index int) []byte {
+	return []byte(fmt.Sprintf("key%d", index))
+}
+
+func createTestDataTrieValue(index int) []byte {
+	return []byte(fmt.Sprintf("value%d", index))
+}
+
+type testTrie interface {
+	common.Trie
+	common.TrieStats
+}
+
+func createTestTrie(t *testing.T, storer *testscommon.MemDbMock) testTrie {
+	args := testStorage.GetStorageManagerArgs()
+	args.MainStorer = storer
+	args.Marshalizer = testMarshaller
+	args.Hasher = testHasher
+	trieStorageManager, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(trieStorageManager, testMarshaller, testHasher, enableEpochsHandlerMock.NewEnableEpochsHandlerStub(), maxLevelInMemory)
+	require.Nil(t, err)
+
+	return tr
+}
+
+// createTestTries creates a main trie holding numAccounts accounts and a code entry. The first account is a
+// contract with a data trie of numDataTrieKeys keys
+func createTestTries(t *testing.T) *testTries {
+	storer := testscommon.NewMemDbMock()
+	contractAddress := createTestAddress(0)
+
+	dataTrie := createTestTrie(t, storer)
+	for i := 0; i < numDataTrieKeys; i++ {
+		key := createTestDataTrieKey(i)
+		value := append(append(createTestDataTrieValue(i), key...), contractAddress...)
+		require.Nil(t, dataTrie.Update(key, value))
+	}
+	require.Nil(t, dataTrie.Commit())
+	dataTrieRootHash, err := dataTrie.RootHash()
+	require.Nil(t, err)
+
+	mainTrie := createTestTrie(t, storer)
+	for i := 0; i < numAccounts; i++ {
+		account := &accounts.UserAccountData{
+			Address: createTestAddress(i),
+			Nonce:   uint64(i),
+			Balance: big.NewInt(int64(i * 10)),
+		}
+		if i == 0 {
+			account.RootHash = dataTrieRootHash
+			account.CodeHash = testCodeHash
+			account.OwnerAddress = createTestAddress(1)
+		}
+
+		accountBytes, errMarshal := testMarshaller.Marshal(account)
+		require.Nil(t, errMarshal)
+		require.Nil(t, mainTrie.Update(account.Address, accountBytes))
+	}
+
+	codeEntryBytes, err := testMarshaller.Marshal(&state.CodeEntry{Code: []byte("code"), NumReferences: 1})
+	require.Nil(t, err)
+	require.Nil(t, mainTrie.Update(testCodeHash, codeEntryBytes))
+	require.Nil(t, mainTrie.Commit())
+	rootHash, err := mainTrie.RootHash()
+	require.Nil(t, err)
+
+	return &testTries{
+		storer:           storer,
+		rootHash:         rootHash,
+		contractAddress:  contractAddress,
+		dataTrieRootHash: dataTrieRootHash,
+	}
+}
+
+func createMockArgsTrieWalker(source NodesSource) ArgsTrieWalker {
+	return ArgsTrieWalker{
+		NodesSource:         source,
+		Marshaller:          testMarshaller,
+		Hasher:              testHasher,
+		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+		WalkDataTries:       true,
+	}
+}
+
+type recordingWalkHandler struct {
+	numNodes       int
+	mainTrieLeaves map[string][]byte
+	dataTrieLeaves map[string][]byte
+	err            error
+}
+
+func newRecordingWalkHandler() *recordingWalkHandler {
+	return &recordingWalkHandler{
+		mainTrieLeaves: make(map[string][]byte),
+		dataTrieLeaves: make(map[string][]byte),
+	}
+}
+
+func (handler *recordingWalkHandler) ProcessNode(_ []byte, _ []byte) error {
+	handler.numNodes++
+	return handler.err
+}
+
+func (handler *recordingWalkHandler) ProcessMainTrieLeaf(key []byte, value []byte) error {
+	handler.mainTrieLeaves[string(key)] = value
+	return handler.err
+}
+
+func (handler *recordingWalkHandler) ProcessDataTrieLeaf(address []byte, key []byte, value []byte) error {
+	handler.dataTrieLeaves[string(address)+string(key)] = value
+	return handler.err
+}
+
+func (handler *recordingWalkHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
+
+func TestNewTrieWalker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil nodes source should error", func(t *testing.T) {
+		t.Parallel()
+
+		walker, err := NewTrieWalker(createMockArgsTrieWalker(nil))
+		require.Equal(t, ErrNilNodesSource, err)
+		require.Nil(t, walker)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieWalker(testscommon.NewMemDbMock())
+		args.Marshaller = nil
+		walker, err := NewTrieWalker(args)
+		require.Equal(t, chainErrors.ErrNilMarshalizer, err)
+		require.Nil(t, walker)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieWalker(testscommon.NewMemDbMock())
+		args.Hasher = nil
+		walker, err := NewTrieWalker(args)
+		require.Equal(t, chainErrors.ErrNilHasher, err)
+		require.Nil(t, walker)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieWalker(testscommon.NewMemDbMock())
+		args.EnableEpochsHandler = nil
+		walker, err := NewTrieWalker(args)
+		require.Equal(t, chainErrors.ErrNilEnableEpochsHandler, err)
+		require.Nil(t, walker)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		walker, err := NewTrieWalker(createMockArgsTrieWalker(testscommon.NewMemDbMock()))
+		require.Nil(t, err)
+		require.False(t, walker.IsInterfaceNil())
+	})
+}
+
+func TestTrieWalker_Walk(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		walker, _ := NewTrieWalker(createMockArgsTrieWalker(testscommon.NewMemDbMock()))
+		report, err := walker.Walk(nil, nil)
+		require.Equal(t, ErrEmptyRootHash, err)
+		require.Nil(t, report)
+	})
+	t.Run("healthy tries should pass the leaves to the handler", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		walker, _ := NewTrieWalker(createMockArgsTrieWalker(tries.storer))
+		handler := newRecordingWalkHandler()
+		report, err := walker.Walk(tries.rootHash, handler)
+		require.Nil(t, err)
+		require.False(t, report.HasIssues())
+		require.Equal(t, uint64(2), report.NumTries)
+		require.Equal(t, uint64(numAccounts+1+numDataTrieKeys), report.NumLeaves)
+		require.Equal(t, uint64(handler.numNodes), report.NumNodes)
+		require.Equal(t, numAccounts+1, len(handler.mainTrieLeaves))
+		require.Equal(t, numDataTrieKeys, len(handler.dataTrieLeaves))
+		for i := 0; i < numDataTrieKeys; i++ {
+			value := handler.dataTrieLeaves[string(tries.contractAddress)+string(createTestDataTrieKey(i))]
+			require.Equal(t, createTestDataTrieValue(i), value)
+		}
+	})
+	t.Run("without data tries should walk only the main trie", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		args := createMockArgsTrieWalker(tries.storer)
+		args.WalkDataTries = false
+		walker, _ := NewTrieWalker(args)
+		handler := newRecordingWalkHandler()
+		report, err := walker.Walk(tries.rootHash, handler)
+		require.Nil(t, err)
+		require.Equal(t, uint64(1), report.NumTries)
+		require.Equal(t, uint64(numAccounts+1), report.NumLeaves)
+		require.Empty(t, handler.dataTrieLeaves)
+	})
+	t.Run("missing nodes should be reported and the walk should continue", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		require.Nil(t, tries.storer.Remove(tries.dataTrieRootHash))
+		_, expectedErr := tries.storer.Get(tries.dataTrieRootHash)
+		walker, _ := NewTrieWalker(createMockArgsTrieWalker(tries.storer))
+		handler := newRecordingWalkHandler()
+		report, err := walker.Walk(tries.rootHash, handler)
+		require.Nil(t, err)
+		require.True(t, report.HasIssues())
+		require.Equal(t, []*NodeIssue{
+			{
+				Hash:  hex.EncodeToString(tries.dataTrieRootHash),
+				Trie:  hex.EncodeToString(tries.contractAddress),
+				Error: expectedErr.Error(),
+			},
+		}, report.MissingNodes)
+		require.Empty(t, report.CorruptNodes)
+		require.Equal(t, numAccounts+1, len(handler.mainTrieLeaves))
+		require.Empty(t, handler.dataTrieLeaves)
+	})
+	t.Run("missing root should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		walker, _ := NewTrieWalker(createMockArgsTrieWalker(testscommon.NewMemDbMock()))
+		report, err := walker.Walk([]byte("missing root hash"), nil)
+		require.Nil(t, err)
+		require.Len(t, report.MissingNodes, 1)
+		require.Equal(t, MainTrieName, report.MissingNodes[0].Trie)
+		require.Zero(t, report.NumNodes)
+	})
+	t.Run("nodes with mismatching hash should be reported as corrupt", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		require.Nil(t, tries.storer.Put(tries.dataTrieRootHash, []byte("corrupt node")))
+		walker, _ := NewTrieWalker(createMockArgsTrieWalker(tries.storer))
+		report, err := walker.Walk(tries.rootHash, nil)
+		require.Nil(t, err)
+		require.Empty(t, report.MissingNodes)
+		require.Equal(t, []*NodeIssue{
+			{
+				Hash:  hex.EncodeToString(tries.dataTrieRootHash),
+				Trie:  hex.EncodeToString(tries.contractAddress),
+				Error: ErrHashMismatch.Error(),
+			},
+		}, report.CorruptNodes)
+	})
+	t.Run("nodes which cannot be decoded should be reported as corrupt", func(t *testing.T) {
+		t.Parallel()
+
+		undecodableNode := []byte("undecodable node")
+		undecodableNodeHash := testHasher.Compute(string(undecodableNode))
+		storer := testscommon.NewMemDbMock()
+		require.Nil(t, storer.Put(undecodableNodeHash, undecodableNode))
+		walker, _ := NewTrieWalker(createMockArgsTrieWalker(storer))
+		report, err := walker.Walk(undecodableNodeHash, nil)
+		require.Nil(t, err)
+		require.Len(t, report.CorruptNodes, 1)
+		require.Equal(t, hex.EncodeToString(undecodableNodeHash), report.CorruptNodes[0].Hash)
+		require.Equal(t, uint64(1), report.NumNodes)
+	})
+	t.Run("handler error should stop the walk", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		walker, _ := NewTrieWalker(createMockArgsTrieWalker(tries.storer))
+		handler := newRecordingWalkHandler()
+		handler.err = errors.New("expected error")
+		report, err := walker.Walk(tries.rootHash, handler)
+		require.Equal(t, handler.err, err)
+		require.Nil(t, report)
+		require.Equal(t, 1, handler.numNodes)
+	})
+}
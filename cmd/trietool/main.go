@@ -0,0 +1,554 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	marshallerFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-go/cmd/trietool/inspector"
+	"github.com/multiversx/mx-chain-go/common"
+	commonDisabled "github.com/multiversx/mx-chain-go/common/disabled"
+	"github.com/multiversx/mx-chain-go/common/enablers"
+	commonFactory "github.com/multiversx/mx-chain-go/common/factory"
+	"github.com/multiversx/mx-chain-go/common/forking"
+	"github.com/multiversx/mx-chain-go/common/statistics/disabled"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/trie"
+	trieStatistics "github.com/multiversx/mx-chain-go/trie/statistics"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	accountsTrie           = "accounts"
+	peerTrie               = "peer"
+	trieStatisticsLogger   = "trieStatistics"
+	maxIssuesToLog         = 100
+	outputFilePermissions  = 0644
+	defaultConfigFilePath  = "./config/config.toml"
+	defaultShardIdentifier = "0"
+)
+
+var (
+	errTrieHasIssues        = errors.New("the trie has missing or corrupt nodes")
+	errInvalidTrie          = errors.New("invalid trie, expected accounts or peer")
+	errMissingFlag          = errors.New("missing flag")
+	errInvalidRepairSources = errors.New("exactly one of the source-db-path and source-export flags should be provided")
+)
+
+var (
+	trieToolHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+	// configurationFile defines a flag for the path to the node's main toml configuration file
+	configurationFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The `[path]` for the node's main configuration file. This TOML file contains the storage, " +
+			"marshaller and hasher configurations",
+		Value: defaultConfigFilePath,
+	}
+	// dbPath defines a flag for the node's database directory
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The `[path]` to the node's database directory, which contains the Epoch_* and Static directories. " +
+			"It is usually <working dir>/db/<chain ID>. The node should be stopped while the tool is running",
+	}
+	// shard defines a flag for the shard of the node
+	shard = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The `[shard]` of the node, as used in the storage directory names: 0, 1, 2 or metachain",
+		Value: defaultShardIdentifier,
+	}
+	// trieType defines a flag for the inspected trie
+	trieType = cli.StringFlag{
+		Name:  "trie",
+		Usage: "The `[trie]` to be inspected: accounts or peer. The data tries are inspected along with the accounts trie",
+		Value: accountsTrie,
+	}
+	// rootHash defines a flag for the root hash of the inspected trie
+	rootHash = cli.StringFlag{
+		Name:  "root-hash",
+		Usage: "The hex encoded `[root hash]` of the trie, as found in the block headers",
+	}
+	// output defines a flag for the output file
+	output = cli.StringFlag{
+		Name:  "output",
+		Usage: "The `[path]` of the output file",
+	}
+	// exportNodes defines a flag for exporting the trie nodes instead of the accounts and the keys
+	exportNodes = cli.BoolFlag{
+		Name: "export-nodes",
+		Usage: "Boolean option for exporting the serialized trie nodes instead of the accounts and the keys. The " +
+			"export can be used as a source by the repair command",
+	}
+	// sourceDBPath defines a flag for the database directory used as repair source
+	sourceDBPath = cli.StringFlag{
+		Name:  "source-db-path",
+		Usage: "The `[path]` to the database directory of another node from the same shard, used as repair source",
+	}
+	// sourceExport defines a flag for the nodes export used as repair source
+	sourceExport = cli.StringFlag{
+		Name:  "source-export",
+		Usage: "The `[path]` to a trie nodes export created with dump --export-nodes, used as repair source",
+	}
+
+	trieFlags = []cli.Flag{
+		configurationFile,
+		dbPath,
+		shard,
+		trieType,
+		rootHash,
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+type trieWithStats interface {
+	common.Trie
+	common.TrieStats
+}
+
+type toolComponents struct {
+	generalConfig       *config.Config
+	dbConfig            config.DBConfig
+	isPeerTrie          bool
+	rootHash            []byte
+	marshaller          marshal.Marshalizer
+	hasher              hashing.Hasher
+	enableEpochsHandler common.EnableEpochsHandler
+	addressConverter    core.PubkeyConverter
+}
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = trieToolHelpTemplate
+	app.Name = "Trie Tool CLI App"
+	app.Usage = "This tool inspects and repairs offline the accounts and peer tries of a node's storage"
+	app.Flags = []cli.Flag{
+		logLevel,
+	}
+	app.Version = "v1.0.0"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Before = func(c *cli.Context) error {
+		return logger.SetLogLevel(c.GlobalString(logLevel.Name))
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "verify",
+			Usage:  "walks every node of the trie and of the data tries, reporting the missing and corrupt nodes",
+			Flags:  append(trieFlags, output),
+			Action: verify,
+		},
+		{
+			Name:   "stats",
+			Usage:  "collects and prints the statistics of the trie and of the data tries",
+			Flags:  trieFlags,
+			Action: stats,
+		},
+		{
+			Name:   "dump",
+			Usage:  "dumps the accounts and the data tries keys, or the serialized trie nodes, to a JSONL file",
+			Flags:  append(trieFlags, output, exportNodes),
+			Action: dump,
+		},
+		{
+			Name:   "repair",
+			Usage:  "restores only the missing trie nodes from another node's storage or from a trie nodes export",
+			Flags:  append(trieFlags, sourceDBPath, sourceExport, output),
+			Action: repair,
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func verify(ctx *cli.Context) error {
+	components, err := createToolComponents(ctx)
+	if err != nil {
+		return err
+	}
+
+	storer, err := openTrieStorer(ctx.String(dbPath.Name), ctx.String(shard.Name), components, false)
+	if err != nil {
+		return err
+	}
+	defer closeAndLog(storer)
+
+	walker, err := createTrieWalker(storer, components, !components.isPeerTrie)
+	if err != nil {
+		return err
+	}
+
+	log.Info("verifying trie", "root hash", components.rootHash)
+	report, err := walker.Walk(components.rootHash, nil)
+	if err != nil {
+		return err
+	}
+
+	return handleReport(report, ctx.String(output.Name))
+}
+
+func stats(ctx *cli.Context) error {
+	components, err := createToolComponents(ctx)
+	if err != nil {
+		return err
+	}
+
+	storer, err := openTrieStorer(ctx.String(dbPath.Name), ctx.String(shard.Name), components, false)
+	if err != nil {
+		return err
+	}
+
+	// the trie storage manager closes the storer
+	tr, err := createTrie(storer, components)
+	if err != nil {
+		_ = storer.Close()
+		return err
+	}
+	defer closeAndLog(tr.GetStorageManager())
+
+	collector := trieStatistics.NewTrieStatisticsCollector()
+	argsStatisticsHandler := inspector.ArgsStatisticsHandler{
+		Trie:             tr,
+		Marshaller:       components.marshaller,
+		AddressConverter: components.addressConverter,
+		Collector:        collector,
+		CollectDataTries: !components.isPeerTrie,
+	}
+	statisticsHandler, err := inspector.NewStatisticsHandler(argsStatisticsHandler)
+	if err != nil {
+		return err
+	}
+
+	err = statisticsHandler.AddMainTrieStatistics(components.rootHash)
+	if err != nil {
+		return fmt.Errorf("%w, the verify command reports all the missing and corrupt nodes", err)
+	}
+
+	// the data tries are walked by the statistics handler
+	walker, err := createTrieWalker(storer, components, false)
+	if err != nil {
+		return err
+	}
+	report, err := walker.Walk(components.rootHash, statisticsHandler)
+	if err != nil {
+		return fmt.Errorf("%w, the verify command reports all the missing and corrupt nodes", err)
+	}
+
+	statisticsLogger := logger.GetOrCreate(trieStatisticsLogger)
+	if statisticsLogger.GetLevel() > logger.LogDebug {
+		statisticsLogger.SetLevel(logger.LogDebug)
+	}
+	collector.Print()
+	log.Info("collected trie statistics", "num nodes", collector.GetNumNodes(), "num main trie leaves", report.NumLeaves)
+
+	return nil
+}
+
+func dump(ctx *cli.Context) error {
+	outputPath := ctx.String(output.Name)
+	if len(outputPath) == 0 {
+		return fmt.Errorf("%w: %s", errMissingFlag, output.Name)
+	}
+
+	components, err := createToolComponents(ctx)
+	if err != nil {
+		return err
+	}
+
+	storer, err := openTrieStorer(ctx.String(dbPath.Name), ctx.String(shard.Name), components, false)
+	if err != nil {
+		return err
+	}
+	defer closeAndLog(storer)
+
+	outputFile, err := os.OpenFile(outputPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, outputFilePermissions)
+	if err != nil {
+		return err
+	}
+	defer closeAndLog(outputFile)
+
+	argsDumper := inspector.ArgsJSONLDumper{
+		Writer:           outputFile,
+		Marshaller:       components.marshaller,
+		AddressConverter: components.addressConverter,
+		IsPeerTrie:       components.isPeerTrie,
+		ExportNodes:      ctx.Bool(exportNodes.Name),
+	}
+	dumper, err := inspector.NewJSONLDumper(argsDumper)
+	if err != nil {
+		return err
+	}
+
+	walker, err := createTrieWalker(storer, components, !components.isPeerTrie)
+	if err != nil {
+		return err
+	}
+
+	log.Info("dumping trie", "root hash", components.rootHash, "output", outputPath)
+	report, err := walker.Walk(components.rootHash, dumper)
+	if err != nil {
+		return err
+	}
+
+	return handleReport(report, "")
+}
+
+func repair(ctx *cli.Context) error {
+	components, err := createToolComponents(ctx)
+	if err != nil {
+		return err
+	}
+
+	backup, err := openRepairSource(ctx, components)
+	if err != nil {
+		return err
+	}
+	defer closeAndLog(backup)
+
+	storer, err := openTrieStorer(ctx.String(dbPath.Name), ctx.String(shard.Name), components, true)
+	if err != nil {
+		return err
+	}
+	defer closeAndLog(storer)
+
+	repairingSource, err := inspector.NewRepairingNodesSource(storer, backup, components.hasher)
+	if err != nil {
+		return err
+	}
+
+	walker, err := createTrieWalker(repairingSource, components, !components.isPeerTrie)
+	if err != nil {
+		return err
+	}
+
+	log.Info("repairing trie", "root hash", components.rootHash)
+	report, err := walker.Walk(components.rootHash, nil)
+	if err != nil {
+		return err
+	}
+	report.NumRepaired = repairingSource.NumRepaired()
+
+	return handleReport(report, ctx.String(output.Name))
+}
+
+func openRepairSource(ctx *cli.Context, components *toolComponents) (inspector.NodesSource, error) {
+	sourceDBPathValue := ctx.String(sourceDBPath.Name)
+	sourceExportValue := ctx.String(sourceExport.Name)
+	if len(sourceDBPathValue) > 0 == (len(sourceExportValue) > 0) {
+		return nil, errInvalidRepairSources
+	}
+
+	if len(sourceDBPathValue) > 0 {
+		return openTrieStorer(sourceDBPathValue, ctx.String(shard.Name), components, false)
+	}
+
+	exportFile, err := os.Open(sourceExportValue)
+	if err != nil {
+		return nil, err
+	}
+	defer closeAndLog(exportFile)
+
+	return inspector.NewExportedNodesSource(exportFile)
+}
+
+func createToolComponents(ctx *cli.Context) (*toolComponents, error) {
+	if len(ctx.String(dbPath.Name)) == 0 {
+		return nil, fmt.Errorf("%w: %s", errMissingFlag, dbPath.Name)
+	}
+
+	rootHashBytes, err := hex.DecodeString(ctx.String(rootHash.Name))
+	if err != nil {
+		return nil, fmt.Errorf("%w for the %s flag", err, rootHash.Name)
+	}
+	if len(rootHashBytes) == 0 {
+		return nil, fmt.Errorf("%w: %s", errMissingFlag, rootHash.Name)
+	}
+
+	generalConfig, err := common.LoadMainConfig(ctx.String(configurationFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	components := &toolComponents{
+		generalConfig: generalConfig,
+		rootHash:      rootHashBytes,
+	}
+	switch ctx.String(trieType.Name) {
+	case accountsTrie:
+		components.dbConfig = generalConfig.AccountsTrieStorage.DB
+	case peerTrie:
+		components.dbConfig = generalConfig.PeerAccountsTrieStorage.DB
+		components.isPeerTrie = true
+	default:
+		return nil, fmt.Errorf("%w: %s", errInvalidTrie, ctx.String(trieType.Name))
+	}
+
+	components.marshaller, err = marshallerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+	components.hasher, err = hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return nil, err
+	}
+	components.addressConverter, err = commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	// all the flags are enabled, so both the legacy and the auto balanced data trie leaves can be parsed
+	components.enableEpochsHandler, err = enablers.NewEnableEpochsHandler(config.EnableEpochs{}, forking.NewGenericEpochNotifier())
+	if err != nil {
+		return nil, err
+	}
+
+	return components, nil
+}
+
+func openTrieStorer(path string, shardID string, components *toolComponents, writable bool) (common.BaseStorer, error) {
+	argsTrieStorer := inspector.ArgsTrieStorer{
+		DBPath:   path,
+		ShardID:  shardID,
+		DBConfig: components.dbConfig,
+		Writable: writable,
+	}
+
+	return inspector.NewTrieStorer(argsTrieStorer)
+}
+
+func createTrieWalker(source inspector.NodesSource, components *toolComponents, walkDataTries bool) (inspector.TrieWalker, error) {
+	argsTrieWalker := inspector.ArgsTrieWalker{
+		NodesSource:         source,
+		Marshaller:          components.marshaller,
+		Hasher:              components.hasher,
+		EnableEpochsHandler: components.enableEpochsHandler,
+		WalkDataTries:       walkDataTries,
+	}
+
+	return inspector.NewTrieWalker(argsTrieWalker)
+}
+
+func createTrie(storer common.BaseStorer, components *toolComponents) (trieWithStats, error) {
+	identifier := dataRetriever.UserAccountsUnit.String()
+	maxTrieLevelInMemory := components.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory
+	if components.isPeerTrie {
+		identifier = dataRetriever.PeerAccountsUnit.String()
+		maxTrieLevelInMemory = components.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory
+	}
+
+	argsTrieStorageManager := trie.NewTrieStorageManagerArgs{
+		MainStorer:     storer,
+		Marshalizer:    components.marshaller,
+		Hasher:         components.hasher,
+		GeneralConfig:  components.generalConfig.TrieStorageManagerConfig,
+		IdleProvider:   commonDisabled.NewProcessStatusHandler(),
+		Identifier:     identifier,
+		StatsCollector: disabled.NewStateStatistics(),
+	}
+	options := trie.StorageManagerOptions{
+		PruningEnabled:   false,
+		SnapshotsEnabled: false,
+	}
+	trieStorageManager, err := trie.CreateTrieStorageManager(argsTrieStorageManager, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return trie.NewTrie(trieStorageManager, components.marshaller, components.hasher, components.enableEpochsHandler, maxTrieLevelInMemory)
+}
+
+func handleReport(report *inspector.WalkReport, outputPath string) error {
+	for i, issue := range report.MissingNodes {
+		if i == maxIssuesToLog {
+			break
+		}
+		log.Warn("missing trie node", "hash", issue.Hash, "trie", issue.Trie, "error", issue.Error)
+	}
+	for i, issue := range report.CorruptNodes {
+		if i == maxIssuesToLog {
+			break
+		}
+		log.Warn("corrupt trie node", "hash", issue.Hash, "trie", issue.Trie, "error", issue.Error)
+	}
+
+	log.Info("trie walk finished",
+		"num tries", report.NumTries,
+		"num nodes", report.NumNodes,
+		"num leaves", report.NumLeaves,
+		"num repaired", report.NumRepaired,
+		"num missing", len(report.MissingNodes),
+		"num corrupt", len(report.CorruptNodes),
+	)
+
+	if len(outputPath) > 0 {
+		reportBytes, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		err = os.WriteFile(outputPath, reportBytes, outputFilePermissions)
+		if err != nil {
+			return err
+		}
+		log.Info("saved the report", "path", outputPath)
+	}
+
+	if report.HasIssues() {
+		return errTrieHasIssues
+	}
+
+	return nil
+}
+
+type closer interface {
+	Close() error
+}
+
+func closeAndLog(c closer) {
+	err := c.Close()
+	if err != nil {
+		log.Warn("close error", "error", err)
+	}
+}
@@ -14,11 +14,12 @@ import (
 )
 
 const (
-	keySeparator   = "-"
-	expectedKeyLen = 2
-	hashIndex      = 0
-	shardIndex     = 1
-	nonceIndex     = 0
+	keySeparator           = "-"
+	expectedKeyLen         = 2
+	hashIndex              = 0
+	shardIndex             = 1
+	nonceIndex             = 0
+	labeledMetricSeparator = "_"
 )
 
 type chainParametersHandler interface {
@@ -208,3 +209,9 @@ func GetNonceAndShardFromKey(nonceShardKey []byte) (uint64, uint32, error) {
 func ConvertTimeStampSecToMs(timeStamp uint64) uint64 {
 	return timeStamp * 1000
 }
+
+// ComputeLabeledMetricKey returns the key of a metric that carries a label, e.g. the consensus subround duration
+// metric for a certain subround. The label is appended to the metric prefix, in lower case
+func ComputeLabeledMetricKey(metricPrefix string, label string) string {
+	return metricPrefix + labeledMetricSeparator + strings.ToLower(label)
+}
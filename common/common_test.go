@@ -259,3 +259,10 @@ func TestConsesusGroupSizeForShardAndEpoch(t *testing.T) {
 		require.Equal(t, int(groupSize), size)
 	})
 }
+
+func TestComputeLabeledMetricKey(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "erd_consensus_subround_duration_us_block", common.ComputeLabeledMetricKey(common.MetricConsensusSubroundDurationUs, "BLOCK"))
+	require.Equal(t, "erd_api_request_duration_us_address", common.ComputeLabeledMetricKey(common.MetricApiRequestDurationUs, "address"))
+}
@@ -400,6 +400,12 @@ const MetricTotalFees = "erd_total_fees"
 // MetricEpochForEconomicsData holds the epoch for which economics data are computed
 const MetricEpochForEconomicsData = "erd_epoch_for_economics_data"
 
+// MetricApiKeysRejectedRequests is the metric that counts the API requests rejected by the API keys authentication
+const MetricApiKeysRejectedRequests = "erd_api_keys_rejected_requests"
+
+// MetricApiKeysLastRejectedKey holds the name of the API key of the last rejected API request
+const MetricApiKeysLastRejectedKey = "erd_api_keys_last_rejected_key"
+
 // MetachainShardId will be used to identify a shard ID as metachain
 const MetachainShardId = uint32(0xFFFFFFFF)
 
@@ -936,6 +942,32 @@ const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_cl
 // to process VM queries
 const MetricAreVMQueriesReady = "erd_are_vm_queries_ready"
 
+// MetricVMQueriesStateViewHits is the metric that counts the VM queries executed on an already pinned state view
+const MetricVMQueriesStateViewHits = "erd_vm_queries_state_view_hits"
+
+// MetricVMQueriesStateViewMisses is the metric that counts the VM queries that required a state view to be re-pinned
+const MetricVMQueriesStateViewMisses = "erd_vm_queries_state_view_misses"
+
+// MetricVMQueriesLastStateViewRecreationDurationMs is the metric that holds the duration, in milliseconds, of the last
+// state view re-pinning
+const MetricVMQueriesLastStateViewRecreationDurationMs = "erd_vm_queries_last_state_view_recreation_duration_ms"
+
+// MetricBlockProcessingDurationUs is the metric used for reporting, in microseconds, the duration of a successful
+// block processing. The reported values are observed in a histogram
+const MetricBlockProcessingDurationUs = "erd_block_processing_duration_us"
+
+// MetricConsensusSubroundDurationUs is the metric prefix used for reporting, in microseconds, the duration of the
+// consensus subrounds jobs. The subround name is appended as label. The reported values are observed in a histogram
+const MetricConsensusSubroundDurationUs = "erd_consensus_subround_duration_us"
+
+// MetricApiRequestDurationUs is the metric prefix used for reporting, in microseconds, the duration of the API
+// requests. The API group name is appended as label. The reported values are observed in a histogram
+const MetricApiRequestDurationUs = "erd_api_request_duration_us"
+
+// MetricTrieCommitDurationUs is the metric used for reporting, in microseconds, the duration of the accounts trie
+// commit. The reported values are observed in a histogram
+const MetricTrieCommitDurationUs = "erd_trie_commit_duration_us"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -1288,3 +1320,12 @@ const (
 	FixGetBalanceFlag                                   core.EnableEpochFlag = "FixGetBalanceFlag"
 	// all new flags must be added to createAllFlagsMap method, as part of enableEpochsHandler allFlagsDefined
 )
+
+// AccountStateAdded is the status of an account that exists only at the end of a state diff
+const AccountStateAdded = "added"
+
+// AccountStateChanged is the status of an account that was modified between the ends of a state diff
+const AccountStateChanged = "changed"
+
+// AccountStateRemoved is the status of an account that exists only at the start of a state diff
+const AccountStateRemoved = "removed"
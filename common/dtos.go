@@ -1,7 +1,11 @@
 package common
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
 )
 
 // GetProofResponse is a struct that stores the response of a GetProof API request
@@ -11,6 +15,12 @@ type GetProofResponse struct {
 	RootHash string
 }
 
+// SCQueryResultApi is a struct that holds the outcome of a single smart contract query from a batch
+type SCQueryResultApi struct {
+	Data  *vm.VMOutputApi `json:"data"`
+	Error string          `json:"error,omitempty"`
+}
+
 // TransactionsPoolAPIResponse is a struct that holds the data to be returned when getting the transaction pool from an API call
 type TransactionsPoolAPIResponse struct {
 	RegularTransactions  []Transaction `json:"regularTransactions"`
@@ -28,6 +38,69 @@ type TransactionsPoolForSenderApiResponse struct {
 	Transactions []Transaction `json:"transactions"`
 }
 
+// AddressTransactionsQueryOptions holds the options of a paginated address transactions request
+type AddressTransactionsQueryOptions struct {
+	Cursor     core.OptionalUint64
+	Size       int
+	Descending bool
+	Types      []transaction.TxType
+	FromNonce  core.OptionalUint64
+	ToNonce    core.OptionalUint64
+}
+
+// AddressTransactionApiResponse is a struct that holds an entry of the transactions by address index
+type AddressTransactionApiResponse struct {
+	Hash             string `json:"hash"`
+	Type             string `json:"type"`
+	Sender           string `json:"sender"`
+	Receiver         string `json:"receiver"`
+	BlockNonce       uint64 `json:"blockNonce"`
+	BlockHash        string `json:"blockHash"`
+	Round            uint64 `json:"round"`
+	Epoch            uint32 `json:"epoch"`
+	MiniblockHash    string `json:"miniblockHash"`
+	SourceShard      uint32 `json:"sourceShard"`
+	DestinationShard uint32 `json:"destinationShard"`
+	Timestamp        uint64 `json:"timestamp"`
+}
+
+// AddressTransactionsApiResponse is a struct that holds a page of transactions involving an address
+type AddressTransactionsApiResponse struct {
+	Transactions []*AddressTransactionApiResponse `json:"transactions"`
+	NextCursor   string                           `json:"nextCursor,omitempty"`
+}
+
+// StateDiffQueryOptions holds the blocks between which a state diff is computed
+type StateDiffQueryOptions struct {
+	FromNonce uint64
+	ToNonce   uint64
+}
+
+// ValueDiffApiResponse is a struct that holds the values of an entry at both ends of a state diff
+type ValueDiffApiResponse struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// AccountStateDiffApiResponse is a struct that holds the differences of an account between two blocks
+type AccountStateDiffApiResponse struct {
+	Address      string                           `json:"address"`
+	Status       string                           `json:"status"`
+	Fields       map[string]*ValueDiffApiResponse `json:"fields,omitempty"`
+	AddedKeys    map[string]string                `json:"addedKeys,omitempty"`
+	ChangedKeys  map[string]*ValueDiffApiResponse `json:"changedKeys,omitempty"`
+	RemovedKeys  map[string]string                `json:"removedKeys,omitempty"`
+	ESDTBalances map[string]*ValueDiffApiResponse `json:"esdtBalances,omitempty"`
+}
+
+// StateDiffApiResponse is a struct that holds the accounts that differ between two blocks
+type StateDiffApiResponse struct {
+	FromBlockInfo api.BlockInfo                  `json:"fromBlockInfo"`
+	ToBlockInfo   api.BlockInfo                  `json:"toBlockInfo"`
+	Accounts      []*AccountStateDiffApiResponse `json:"accounts"`
+	Truncated     bool                           `json:"truncated"`
+}
+
 // NonceGapApiResponse is a struct that holds a nonce gap from transactions pool
 // From - first unknown nonce
 // To   - last unknown nonce
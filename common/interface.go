@@ -222,6 +222,12 @@ type TriesStatisticsCollector interface {
 	GetNumNodes() uint64
 }
 
+// CacheRatesHandler defines the counters of the cache hits and misses of a storer
+type CacheRatesHandler interface {
+	IncrCacheHit()
+	IncrCacheMiss()
+}
+
 // StateStatisticsHandler defines the behaviour of a storage statistics handler
 type StateStatisticsHandler interface {
 	Reset()
@@ -242,6 +248,10 @@ type StateStatisticsHandler interface {
 	IncrTrie()
 	Trie() uint64
 
+	CacheRates(storer string) CacheRatesHandler
+	CacheHits(storer string) uint64
+	CacheMisses(storer string) uint64
+
 	ProcessingStats() []string
 	SnapshotStats() []string
 
@@ -1,7 +1,19 @@
 package disabled
 
+import "github.com/multiversx/mx-chain-go/common"
+
 type stateStatistics struct{}
 
+type cacheRates struct{}
+
+// IncrCacheHit does nothing
+func (rates *cacheRates) IncrCacheHit() {
+}
+
+// IncrCacheMiss does nothing
+func (rates *cacheRates) IncrCacheMiss() {
+}
+
 // NewStateStatistics will create a new disabled statistics component
 func NewStateStatistics() *stateStatistics {
 	return &stateStatistics{}
@@ -73,6 +85,21 @@ func (s *stateStatistics) Trie() uint64 {
 	return 0
 }
 
+// CacheRates returns a disabled cache rates component
+func (s *stateStatistics) CacheRates(_ string) common.CacheRatesHandler {
+	return &cacheRates{}
+}
+
+// CacheHits returns zero
+func (s *stateStatistics) CacheHits(_ string) uint64 {
+	return 0
+}
+
+// CacheMisses returns zero
+func (s *stateStatistics) CacheMisses(_ string) uint64 {
+	return 0
+}
+
 // ProcessingStats returns nil
 func (s *stateStatistics) ProcessingStats() []string {
 	return nil
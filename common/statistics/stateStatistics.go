@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 type stateStatistics struct {
@@ -16,6 +18,24 @@ type stateStatistics struct {
 	mutPersisters        sync.RWMutex
 
 	numTrie uint64
+
+	cacheRates    map[string]*cacheRates
+	mutCacheRates sync.RWMutex
+}
+
+type cacheRates struct {
+	numHits   uint64
+	numMisses uint64
+}
+
+// IncrCacheHit will increment the cache hits counter
+func (rates *cacheRates) IncrCacheHit() {
+	atomic.AddUint64(&rates.numHits, 1)
+}
+
+// IncrCacheMiss will increment the cache misses counter
+func (rates *cacheRates) IncrCacheMiss() {
+	atomic.AddUint64(&rates.numMisses, 1)
 }
 
 // NewStateStatistics returns a structure able to collect statistics for state
@@ -24,6 +44,7 @@ func NewStateStatistics() *stateStatistics {
 		numPersister:         make(map[uint32]uint64),
 		numWritePersister:    make(map[uint32]uint64),
 		numSnapshotPersister: make(map[uint32]uint64),
+		cacheRates:           make(map[string]*cacheRates),
 	}
 }
 
@@ -37,6 +58,14 @@ func (ss *stateStatistics) Reset() {
 	ss.mutPersisters.Unlock()
 
 	atomic.StoreUint64(&ss.numTrie, 0)
+
+	// the counters are held by the storers, so they are reset in place
+	ss.mutCacheRates.RLock()
+	for _, rates := range ss.cacheRates {
+		atomic.StoreUint64(&rates.numHits, 0)
+		atomic.StoreUint64(&rates.numMisses, 0)
+	}
+	ss.mutCacheRates.RUnlock()
 }
 
 // ResetSnapshot will reset snapshot statistics
@@ -126,6 +155,47 @@ func (ss *stateStatistics) Trie() uint64 {
 	return atomic.LoadUint64(&ss.numTrie)
 }
 
+// CacheRates returns the cache hits and misses counters of the given storer. The counters are created on the first
+// call and are meant to be kept by the storer, so counting does not need any lock
+func (ss *stateStatistics) CacheRates(storer string) common.CacheRatesHandler {
+	ss.mutCacheRates.Lock()
+	defer ss.mutCacheRates.Unlock()
+
+	rates, found := ss.cacheRates[storer]
+	if !found {
+		rates = &cacheRates{}
+		ss.cacheRates[storer] = rates
+	}
+
+	return rates
+}
+
+// CacheHits returns the number of cache hits of the given storer
+func (ss *stateStatistics) CacheHits(storer string) uint64 {
+	ss.mutCacheRates.RLock()
+	defer ss.mutCacheRates.RUnlock()
+
+	rates, found := ss.cacheRates[storer]
+	if !found {
+		return 0
+	}
+
+	return atomic.LoadUint64(&rates.numHits)
+}
+
+// CacheMisses returns the number of cache misses of the given storer
+func (ss *stateStatistics) CacheMisses(storer string) uint64 {
+	ss.mutCacheRates.RLock()
+	defer ss.mutCacheRates.RUnlock()
+
+	rates, found := ss.cacheRates[storer]
+	if !found {
+		return 0
+	}
+
+	return atomic.LoadUint64(&rates.numMisses)
+}
+
 // SnapshotStats returns collected snapshot statistics as string
 func (ss *stateStatistics) SnapshotStats() []string {
 	stats := make([]string, 0)
@@ -161,6 +231,25 @@ func (ss *stateStatistics) ProcessingStats() []string {
 
 	stats = append(stats, fmt.Sprintf("trie op = %v", atomic.LoadUint64(&ss.numTrie)))
 
+	return append(stats, ss.cacheRatesStats()...)
+}
+
+func (ss *stateStatistics) cacheRatesStats() []string {
+	ss.mutCacheRates.RLock()
+	defer ss.mutCacheRates.RUnlock()
+
+	stats := make([]string, 0, len(ss.cacheRates))
+	for storer, rates := range ss.cacheRates {
+		hits := atomic.LoadUint64(&rates.numHits)
+		misses := atomic.LoadUint64(&rates.numMisses)
+		if hits+misses == 0 {
+			continue
+		}
+
+		hitRate := float64(hits) * 100 / float64(hits+misses)
+		stats = append(stats, fmt.Sprintf("cache storer = %s hits = %v misses = %v hit rate = %.2f%%", storer, hits, misses, hitRate))
+	}
+
 	return stats
 }
 
@@ -81,6 +81,42 @@ func TestStateStatistics_Processing(t *testing.T) {
 		ss.Reset()
 		assert.Equal(t, uint64(0), ss.Cache())
 	})
+
+	t.Run("cache hit rates", func(t *testing.T) {
+		t.Parallel()
+
+		ss := NewStateStatistics()
+
+		assert.Equal(t, uint64(0), ss.CacheHits("storer"))
+		assert.Equal(t, uint64(0), ss.CacheMisses("storer"))
+
+		storerRates := ss.CacheRates("storer")
+		storerRates.IncrCacheHit()
+		storerRates.IncrCacheHit()
+		ss.CacheRates("storer").IncrCacheHit()
+		storerRates.IncrCacheMiss()
+		ss.CacheRates("other storer").IncrCacheMiss()
+		ss.CacheRates("unused storer")
+		assert.Equal(t, uint64(3), ss.CacheHits("storer"))
+		assert.Equal(t, uint64(1), ss.CacheMisses("storer"))
+		assert.Equal(t, uint64(0), ss.CacheHits("other storer"))
+		assert.Equal(t, uint64(1), ss.CacheMisses("other storer"))
+
+		stats := ss.ProcessingStats()
+		assert.Contains(t, stats, "cache storer = storer hits = 3 misses = 1 hit rate = 75.00%")
+		assert.Contains(t, stats, "cache storer = other storer hits = 0 misses = 1 hit rate = 0.00%")
+
+		// cache op, trie op and the two storers with reads
+		assert.Len(t, stats, 4)
+
+		ss.Reset()
+		assert.Equal(t, uint64(0), ss.CacheHits("storer"))
+		assert.Equal(t, uint64(0), ss.CacheMisses("other storer"))
+
+		// the counters kept by the storers are still in use after reset
+		storerRates.IncrCacheHit()
+		assert.Equal(t, uint64(1), ss.CacheHits("storer"))
+	})
 }
 
 func TestStateStatistics_Snapshot(t *testing.T) {
@@ -155,6 +191,12 @@ func TestStateStatistics_ConcurrenyOperations(t *testing.T) {
 				ss.IncrPersister(epoch)
 			case 3:
 				ss.IncrTrie()
+			case 4:
+				ss.CacheRates("storer").IncrCacheHit()
+			case 5:
+				ss.CacheRates("storer").IncrCacheMiss()
+			case 6:
+				_ = ss.CacheHits("storer") + ss.CacheMisses("storer")
 			case 7:
 				_ = ss.Cache()
 			case 8:
@@ -331,6 +331,7 @@ type StateTriesConfig struct {
 	MaxStateTrieLevelInMemory   uint
 	MaxPeerTrieLevelInMemory    uint
 	StateStatisticsEnabled      bool
+	DataTriesCommitWorkers      uint32
 }
 
 // TrieStorageManagerConfig will hold config information about trie storage manager
@@ -338,6 +339,15 @@ type TrieStorageManagerConfig struct {
 	PruningBufferLen      uint32
 	SnapshotsBufferLen    uint32
 	SnapshotsGoroutineNum uint32
+	Warmup                TrieNodesWarmupConfig
+}
+
+// TrieNodesWarmupConfig will hold the configuration for persisting the most accessed trie nodes on close and for
+// prefetching them in the trie storers caches on startup
+type TrieNodesWarmupConfig struct {
+	Enabled         bool
+	MaxNodes        uint32
+	MaxTrackedNodes uint32
 }
 
 // EndpointsThrottlersConfig holds a pair of an endpoint and its maximum number of simultaneous go routines
@@ -444,7 +454,8 @@ type WasmVMVersionByEpoch struct {
 // QueryVirtualMachineConfig holds the configuration for the virtual machine(s) used in query process
 type QueryVirtualMachineConfig struct {
 	VirtualMachineConfig
-	NumConcurrentVMs int
+	NumConcurrentVMs    int
+	NumPinnedStateViews int
 }
 
 // VirtualMachineGasConfig holds the configuration for the virtual machine(s) gas operations
@@ -497,6 +508,8 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	AddressTransactionsIndexEnabled    bool
+	AddressTransactionsStorageConfig   StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -506,6 +519,9 @@ type DebugConfig struct {
 	ShuffleOut          ShuffleOutDebugConfig
 	EpochStart          EpochStartDebugConfig
 	Process             ProcessDebugConfig
+	ExecutionTracer     ExecutionTracerDebugConfig
+	GasProfiler         GasProfilerDebugConfig
+	WasmCoverage        WasmCoverageDebugConfig
 }
 
 // HealthServiceConfig will hold health service (monitoring) configuration
@@ -549,6 +565,23 @@ type EpochStartDebugConfig struct {
 	ProcessDataTrieOnCommitEpoch bool
 }
 
+// ExecutionTracerDebugConfig will hold the smart contract execution tracer debug configuration
+type ExecutionTracerDebugConfig struct {
+	Enabled               bool
+	MaxTracedTransactions int
+}
+
+// GasProfilerDebugConfig will hold the gas profiler debug configuration
+type GasProfilerDebugConfig struct {
+	Enabled                 bool
+	MaxProfiledTransactions int
+}
+
+// WasmCoverageDebugConfig will hold the Wasm contracts coverage debug configuration
+type WasmCoverageDebugConfig struct {
+	Enabled bool
+}
+
 // ProcessDebugConfig will hold the process debug configuration
 type ProcessDebugConfig struct {
 	Enabled                     bool
@@ -561,9 +594,60 @@ type ProcessDebugConfig struct {
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	Logging     ApiLoggingConfig
+	GRPC        GRPCServerConfig
+	ApiKeys     ApiKeysConfig
 	APIPackages map[string]APIPackageConfig
 }
 
+// ApiKeysConfig holds the configuration of the API keys authentication
+type ApiKeysConfig struct {
+	Enabled                 bool
+	KeysFile                string
+	HeaderName              string
+	AllowRequestsWithoutKey bool
+	ReloadIntervalInSec     uint32
+}
+
+// ApiKeysFileConfig holds the quota tiers and the API keys defined in the API keys file
+type ApiKeysFileConfig struct {
+	Tiers []ApiKeyTierConfig
+	Keys  []ApiKeyConfig
+}
+
+// ApiKeyTierConfig holds the quotas applied to all the API keys of a tier
+type ApiKeyTierConfig struct {
+	Name                       string
+	RequestsPerSecond          uint32
+	MaxConcurrentHeavyRequests uint32
+	HeavyEndpoints             []string
+	AllowedGroups              []string
+	EndpointsLimits            []ApiKeyEndpointLimitConfig
+}
+
+// ApiKeyEndpointLimitConfig holds the maximum number of requests per second accepted on an endpoint
+type ApiKeyEndpointLimitConfig struct {
+	Endpoint          string
+	RequestsPerSecond uint32
+}
+
+// ApiKeyConfig holds the definition of a single API key
+type ApiKeyConfig struct {
+	Name string
+	Key  string
+	Tier string
+}
+
+// GRPCServerConfig holds the configuration for the gRPC API server
+type GRPCServerConfig struct {
+	Enabled                bool
+	Interface              string
+	MaxConcurrentStreams   uint32
+	MaxRecvMsgSizeInBytes  int
+	MaxSendMsgSizeInBytes  int
+	KeyValuePairsBatchSize uint32
+	MaxBlocksPerStream     uint64
+}
+
 // ApiLoggingConfig holds the configuration related to API requests logging
 type ApiLoggingConfig struct {
 	LoggingEnabled          bool
@@ -5,6 +5,7 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	HostDriversConfig      []HostDriversConfig
+	SubscriptionsHub       SubscriptionsHubConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -51,3 +52,10 @@ type HostDriversConfig struct {
 	AcknowledgeTimeoutInSec    int
 	Version                    uint32
 }
+
+// SubscriptionsHubConfig will hold the configuration for the API subscriptions hub driver
+type SubscriptionsHubConfig struct {
+	Enabled                 bool
+	MaxSubscribers          uint32
+	NotificationsBufferSize uint32
+}
@@ -454,6 +454,13 @@ func TestAPIRoutesToml(t *testing.T) {
 			LoggingEnabled:          true,
 			ThresholdInMicroSeconds: loggingThreshold,
 		},
+		ApiKeys: ApiKeysConfig{
+			Enabled:                 true,
+			KeysFile:                "./config/apiKeys.toml",
+			HeaderName:              "X-Api-Key",
+			AllowRequestsWithoutKey: true,
+			ReloadIntervalInSec:     10,
+		},
 		APIPackages: map[string]APIPackageConfig{
 			package0: {
 				Routes: []RouteConfig{
@@ -474,6 +481,13 @@ func TestAPIRoutesToml(t *testing.T) {
     LoggingEnabled = true
     ThresholdInMicroSeconds = 10
 
+[ApiKeys]
+    Enabled = true
+    KeysFile = "./config/apiKeys.toml"
+    HeaderName = "X-Api-Key"
+    AllowRequestsWithoutKey = true
+    ReloadIntervalInSec = 10
+
      # API routes configuration
 [APIPackages]
 
@@ -501,6 +515,50 @@ func TestAPIRoutesToml(t *testing.T) {
 	assert.Equal(t, expectedCfg, cfg)
 }
 
+func TestApiKeysFileToml(t *testing.T) {
+	expectedCfg := ApiKeysFileConfig{
+		Tiers: []ApiKeyTierConfig{
+			{
+				Name:                       "basic",
+				RequestsPerSecond:          10,
+				MaxConcurrentHeavyRequests: 1,
+				HeavyEndpoints:             []string{"/address/:address/keys"},
+				AllowedGroups:              []string{"address", "transaction"},
+				EndpointsLimits: []ApiKeyEndpointLimitConfig{
+					{Endpoint: "/transaction/send", RequestsPerSecond: 2},
+				},
+			},
+		},
+		Keys: []ApiKeyConfig{
+			{Name: "explorer", Key: "secret", Tier: "basic"},
+		},
+	}
+
+	testString := `
+[[Tiers]]
+    Name = "basic"
+    RequestsPerSecond = 10
+    MaxConcurrentHeavyRequests = 1
+    HeavyEndpoints = ["/address/:address/keys"]
+    AllowedGroups = ["address", "transaction"]
+    EndpointsLimits = [
+        { Endpoint = "/transaction/send", RequestsPerSecond = 2 },
+    ]
+
+[[Keys]]
+    Name = "explorer"
+    Key = "secret"
+    Tier = "basic"
+`
+
+	cfg := ApiKeysFileConfig{}
+
+	err := toml.Unmarshal([]byte(testString), &cfg)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedCfg, cfg)
+}
+
 func TestP2pConfig(t *testing.T) {
 	initialPeersList := "/ip4/127.0.0.1/tcp/9999/p2p/16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"
 	protocolID1 := "test protocol id 1"
@@ -2,11 +2,13 @@ package spos
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/consensus"
 )
 
@@ -37,6 +39,7 @@ type Subround struct {
 	consensusStateChangedChannel chan bool
 	executeStoredMessages        func()
 	appStatusHandler             core.AppStatusHandler
+	durationMetricKey            string
 
 	Job    func(ctx context.Context) bool // method does the Subround Job and send the result to the peers
 	Check  func() bool                    // method checks if the consensus of the Subround is done
@@ -87,6 +90,7 @@ func NewSubround(
 		Check:                        nil,
 		Extend:                       nil,
 		appStatusHandler:             appStatusHandler,
+		durationMetricKey:            common.ComputeLabeledMetricKey(common.MetricConsensusSubroundDurationUs, strings.Trim(name, "()")),
 		currentPid:                   currentPid,
 	}
 
@@ -132,6 +136,11 @@ func (sr *Subround) DoWork(ctx context.Context, roundHandler consensus.RoundHand
 		return false
 	}
 
+	jobStartTime := time.Now()
+	defer func() {
+		sr.appStatusHandler.SetUInt64Value(sr.durationMetricKey, uint64(time.Since(jobStartTime).Microseconds()))
+	}()
+
 	// execute stored messages which were received in this new round but before this initialisation
 	go sr.executeStoredMessages()
 
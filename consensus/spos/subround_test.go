@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/consensus/mock"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/consensus/spos/bls"
@@ -691,6 +692,43 @@ func testDoWork(t *testing.T, checkDone bool, shouldWork bool) {
 	assert.Equal(t, shouldWork, r)
 }
 
+func TestSubround_DoWorkShouldReportTheSubroundDuration(t *testing.T) {
+	t.Parallel()
+
+	reportedKey := ""
+	appStatusHandler := &statusHandler.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			reportedKey = key
+		},
+	}
+	sr, _ := spos.NewSubround(
+		-1,
+		bls.SrStartRound,
+		bls.SrBlock,
+		int64(0*roundTimeDuration/100),
+		int64(5*roundTimeDuration/100),
+		"(START_ROUND)",
+		initConsensusState(),
+		make(chan bool, 1),
+		executeStoredMessages,
+		consensus.InitConsensusCore(),
+		chainID,
+		currentPid,
+		appStatusHandler,
+	)
+	sr.Job = func(_ context.Context) bool {
+		return true
+	}
+	sr.Check = func() bool {
+		return true
+	}
+
+	r := sr.DoWork(context.Background(), &consensus.RoundHandlerMock{})
+	assert.True(t, r)
+	assert.Equal(t, "erd_consensus_subround_duration_us_start_round", reportedKey)
+	assert.Equal(t, common.ComputeLabeledMetricKey(common.MetricConsensusSubroundDurationUs, "START_ROUND"), reportedKey)
+}
+
 func TestSubround_DoWorkShouldReturnTrueWhenJobIsDoneAndConsensusIsDoneAfterAWhile(t *testing.T) {
 	t.Parallel()
 
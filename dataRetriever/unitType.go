@@ -51,6 +51,8 @@ const (
 	ScheduledSCRsUnit UnitType = 22
 	// ProofsUnit is the header proofs unit identifier
 	ProofsUnit UnitType = 23
+	// AddressTransactionsUnit is the transactions by address storage unit identifier
+	AddressTransactionsUnit UnitType = 24
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -114,6 +116,8 @@ func (ut UnitType) String() string {
 		return "ScheduledSCRsUnit"
 	case ProofsUnit:
 		return "ProofsUnit"
+	case AddressTransactionsUnit:
+		return "AddressTransactionsUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
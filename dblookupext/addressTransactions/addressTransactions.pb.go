@@ -0,0 +1,1143 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: addressTransactions.proto
+
+package addressTransactions
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddressTransaction is used to store an entry of the transactions by address index
+type AddressTransaction struct {
+	TxHash           []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	Type             string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Sender           []byte `protobuf:"bytes,3,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	Receiver         []byte `protobuf:"bytes,4,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	BlockNonce       uint64 `protobuf:"varint,5,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	BlockHash        []byte `protobuf:"bytes,6,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	Round            uint64 `protobuf:"varint,7,opt,name=Round,proto3" json:"Round,omitempty"`
+	Epoch            uint32 `protobuf:"varint,8,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	MiniblockHash    []byte `protobuf:"bytes,9,opt,name=MiniblockHash,proto3" json:"MiniblockHash,omitempty"`
+	SourceShard      uint32 `protobuf:"varint,10,opt,name=SourceShard,proto3" json:"SourceShard,omitempty"`
+	DestinationShard uint32 `protobuf:"varint,11,opt,name=DestinationShard,proto3" json:"DestinationShard,omitempty"`
+	Timestamp        uint64 `protobuf:"varint,12,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+}
+
+func (m *AddressTransaction) Reset()      { *m = AddressTransaction{} }
+func (*AddressTransaction) ProtoMessage() {}
+func (*AddressTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4213e982049533d, []int{0}
+}
+func (m *AddressTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTransaction.Merge(m, src)
+}
+func (m *AddressTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTransaction proto.InternalMessageInfo
+
+func (m *AddressTransaction) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *AddressTransaction) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AddressTransaction) GetSender() []byte {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+func (m *AddressTransaction) GetReceiver() []byte {
+	if m != nil {
+		return m.Receiver
+	}
+	return nil
+}
+
+func (m *AddressTransaction) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *AddressTransaction) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetMiniblockHash() []byte {
+	if m != nil {
+		return m.MiniblockHash
+	}
+	return nil
+}
+
+func (m *AddressTransaction) GetSourceShard() uint32 {
+	if m != nil {
+		return m.SourceShard
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetDestinationShard() uint32 {
+	if m != nil {
+		return m.DestinationShard
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// BlockAddressTransactions is used to store all the index keys written when recording a block, so they can be reverted
+type BlockAddressTransactions struct {
+	Keys [][]byte `protobuf:"bytes,1,rep,name=Keys,proto3" json:"Keys,omitempty"`
+}
+
+func (m *BlockAddressTransactions) Reset()      { *m = BlockAddressTransactions{} }
+func (*BlockAddressTransactions) ProtoMessage() {}
+func (*BlockAddressTransactions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4213e982049533d, []int{1}
+}
+func (m *BlockAddressTransactions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockAddressTransactions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockAddressTransactions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockAddressTransactions.Merge(m, src)
+}
+func (m *BlockAddressTransactions) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockAddressTransactions) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockAddressTransactions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockAddressTransactions proto.InternalMessageInfo
+
+func (m *BlockAddressTransactions) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AddressTransaction)(nil), "proto.AddressTransaction")
+	proto.RegisterType((*BlockAddressTransactions)(nil), "proto.BlockAddressTransactions")
+}
+
+func init() { proto.RegisterFile("addressTransactions.proto", fileDescriptor_f4213e982049533d) }
+
+var fileDescriptor_f4213e982049533d = []byte{
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4a, 0xeb, 0x40,
+	0x14, 0x80, 0x33, 0xfd, 0xbb, 0xed, 0xb4, 0x85, 0xcb, 0xdc, 0xcb, 0x65, 0x6e, 0x91, 0x43, 0x28,
+	0x2e, 0x82, 0x60, 0xbb, 0xf0, 0x09, 0x2c, 0x0a, 0x82, 0xd4, 0x45, 0x9a, 0x95, 0xbb, 0xfc, 0x8c,
+	0x4d, 0xd0, 0x66, 0x42, 0x26, 0x11, 0xbb, 0xf3, 0x11, 0x7c, 0x0c, 0x1f, 0xc5, 0x65, 0x97, 0x5d,
+	0xda, 0xe9, 0xc6, 0x65, 0x1f, 0x41, 0x72, 0x22, 0x6d, 0xa5, 0xae, 0x72, 0xbe, 0x2f, 0xe7, 0x1c,
+	0xce, 0x9c, 0x19, 0xfa, 0xdf, 0x0d, 0x82, 0x54, 0x28, 0xe5, 0xa4, 0x6e, 0xac, 0x5c, 0x3f, 0x8b,
+	0x64, 0xac, 0x06, 0x49, 0x2a, 0x33, 0xc9, 0xea, 0xf8, 0xe9, 0x9d, 0x4e, 0xa3, 0x2c, 0xcc, 0xbd,
+	0x81, 0x2f, 0x67, 0xc3, 0xa9, 0x9c, 0xca, 0x21, 0x6a, 0x2f, 0xbf, 0x43, 0x42, 0xc0, 0xa8, 0xac,
+	0xea, 0x6f, 0x2a, 0x94, 0x9d, 0x1f, 0xf4, 0x64, 0xff, 0x68, 0xc3, 0x79, 0xba, 0x72, 0x55, 0xc8,
+	0x89, 0x49, 0xac, 0x8e, 0xfd, 0x45, 0x8c, 0xd1, 0x9a, 0x33, 0x4f, 0x04, 0xaf, 0x98, 0xc4, 0x6a,
+	0xd9, 0x18, 0x17, 0xb9, 0x13, 0x11, 0x07, 0x22, 0xe5, 0xd5, 0x32, 0xb7, 0x24, 0xd6, 0xa3, 0x4d,
+	0x5b, 0xf8, 0x22, 0x7a, 0x14, 0x29, 0xaf, 0xe1, 0x9f, 0x2d, 0x33, 0xa0, 0x74, 0xf4, 0x20, 0xfd,
+	0xfb, 0x1b, 0x19, 0xfb, 0x82, 0xd7, 0x4d, 0x62, 0xd5, 0xec, 0x3d, 0xc3, 0x8e, 0x68, 0x0b, 0x09,
+	0x47, 0x68, 0x60, 0xf1, 0x4e, 0xb0, 0xbf, 0xb4, 0x6e, 0xcb, 0x3c, 0x0e, 0xf8, 0x2f, 0x2c, 0x2c,
+	0xa1, 0xb0, 0x97, 0x89, 0xf4, 0x43, 0xde, 0x34, 0x89, 0xd5, 0xb5, 0x4b, 0x60, 0xc7, 0xb4, 0x3b,
+	0x8e, 0xe2, 0xc8, 0xdb, 0x76, 0x6b, 0x61, 0xb7, 0xef, 0x92, 0x99, 0xb4, 0x3d, 0x91, 0x79, 0xea,
+	0x8b, 0x49, 0xe8, 0xa6, 0x01, 0xa7, 0xd8, 0x61, 0x5f, 0xb1, 0x13, 0xfa, 0xfb, 0x42, 0xa8, 0x2c,
+	0x8a, 0xdd, 0x62, 0x41, 0x65, 0x5a, 0x1b, 0xd3, 0x0e, 0x7c, 0x31, 0xbd, 0x13, 0xcd, 0x84, 0xca,
+	0xdc, 0x59, 0xc2, 0x3b, 0x38, 0xe3, 0x4e, 0xf4, 0x07, 0x94, 0xe3, 0x51, 0x0e, 0xd7, 0xae, 0x8a,
+	0xfd, 0x5e, 0x8b, 0xb9, 0xe2, 0xc4, 0xac, 0x5a, 0x1d, 0x1b, 0xe3, 0xd1, 0x78, 0xb1, 0x02, 0x63,
+	0xb9, 0x02, 0x63, 0xb3, 0x02, 0xf2, 0xac, 0x81, 0xbc, 0x6a, 0x20, 0x6f, 0x1a, 0xc8, 0x42, 0x03,
+	0x59, 0x6a, 0x20, 0xef, 0x1a, 0xc8, 0x87, 0x06, 0x63, 0xa3, 0x81, 0xbc, 0xac, 0xc1, 0x58, 0xac,
+	0xc1, 0x58, 0xae, 0xc1, 0xb8, 0xfd, 0xf3, 0xc3, 0x6b, 0xf1, 0x1a, 0x78, 0xf1, 0x67, 0x9f, 0x03,
+	0x00, 0xd3, 0x4f, 0x16, 0xea, 0x4b, 0x02, 0x00, 0x00,
+}
+
+func (this *AddressTransaction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressTransaction)
+	if !ok {
+		that2, ok := that.(AddressTransaction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Sender, that1.Sender) {
+		return false
+	}
+	if !bytes.Equal(this.Receiver, that1.Receiver) {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if !bytes.Equal(this.MiniblockHash, that1.MiniblockHash) {
+		return false
+	}
+	if this.SourceShard != that1.SourceShard {
+		return false
+	}
+	if this.DestinationShard != that1.DestinationShard {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (this *BlockAddressTransactions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockAddressTransactions)
+	if !ok {
+		that2, ok := that.(BlockAddressTransactions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Keys) != len(that1.Keys) {
+		return false
+	}
+	for i := range this.Keys {
+		if !bytes.Equal(this.Keys[i], that1.Keys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AddressTransaction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&addressTransactions.AddressTransaction{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "MiniblockHash: "+fmt.Sprintf("%#v", this.MiniblockHash)+",\n")
+	s = append(s, "SourceShard: "+fmt.Sprintf("%#v", this.SourceShard)+",\n")
+	s = append(s, "DestinationShard: "+fmt.Sprintf("%#v", this.DestinationShard)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockAddressTransactions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&addressTransactions.BlockAddressTransactions{")
+	s = append(s, "Keys: "+fmt.Sprintf("%#v", this.Keys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAddressTransactions(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AddressTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.DestinationShard != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.DestinationShard))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SourceShard != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.SourceShard))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.MiniblockHash) > 0 {
+		i -= len(m.MiniblockHash)
+		copy(dAtA[i:], m.MiniblockHash)
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(len(m.MiniblockHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Round != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockAddressTransactions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockAddressTransactions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockAddressTransactions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintAddressTransactions(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAddressTransactions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAddressTransactions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddressTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovAddressTransactions(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAddressTransactions(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovAddressTransactions(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovAddressTransactions(uint64(l))
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.BlockNonce))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovAddressTransactions(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.Epoch))
+	}
+	l = len(m.MiniblockHash)
+	if l > 0 {
+		n += 1 + l + sovAddressTransactions(uint64(l))
+	}
+	if m.SourceShard != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.SourceShard))
+	}
+	if m.DestinationShard != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.DestinationShard))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *BlockAddressTransactions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovAddressTransactions(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAddressTransactions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAddressTransactions(x uint64) (n int) {
+	return sovAddressTransactions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AddressTransaction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressTransaction{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`Receiver:` + fmt.Sprintf("%v", this.Receiver) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`MiniblockHash:` + fmt.Sprintf("%v", this.MiniblockHash) + `,`,
+		`SourceShard:` + fmt.Sprintf("%v", this.SourceShard) + `,`,
+		`DestinationShard:` + fmt.Sprintf("%v", this.DestinationShard) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockAddressTransactions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockAddressTransactions{`,
+		`Keys:` + fmt.Sprintf("%v", this.Keys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAddressTransactions(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AddressTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = append(m.Receiver[:0], dAtA[iNdEx:postIndex]...)
+			if m.Receiver == nil {
+				m.Receiver = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniblockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniblockHash = append(m.MiniblockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MiniblockHash == nil {
+				m.MiniblockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceShard", wireType)
+			}
+			m.SourceShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationShard", wireType)
+			}
+			m.DestinationShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockAddressTransactions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockAddressTransactions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockAddressTransactions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAddressTransactions(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAddressTransactions
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAddressTransactions
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAddressTransactions
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAddressTransactions
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAddressTransactions        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAddressTransactions          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAddressTransactions = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,541 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. addressTransactions.proto
+
+package addressTransactions
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common/logging"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dblookupext/addressTransactions")
+
+const (
+	counterKeyPrefix = byte('c')
+	entryKeyPrefix   = byte('e')
+	dedupKeyPrefix   = byte('d')
+	blockKeyPrefix   = byte('b')
+
+	// DefaultPageSize is the number of entries returned when the query does not specify a size
+	DefaultPageSize = 50
+	// MaxPageSize is the maximum number of entries returned by a single query
+	MaxPageSize = 500
+	// maxScannedEntriesFactor bounds the number of entries read from the storage for a single query, relative to the
+	// page size, so that very selective filters (or many reverted entries) do not turn into a full scan
+	maxScannedEntriesFactor = 20
+)
+
+// ArgsAddressTransactionsIndex holds the arguments needed to create a new addressTransactionsIndex
+type ArgsAddressTransactionsIndex struct {
+	Storer                   storage.Storer
+	TransactionsStorer       storage.Storer
+	UnsignedTxsStorer        storage.Storer
+	RewardTxsStorer          storage.Storer
+	Marshaller               marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+}
+
+// Query holds the parameters of a paginated address transactions query
+type Query struct {
+	Address    []byte
+	Cursor     core.OptionalUint64
+	Size       int
+	Descending bool
+	Types      []transaction.TxType
+	FromNonce  core.OptionalUint64
+	ToNonce    core.OptionalUint64
+}
+
+// Page holds a page of indexed transactions and the cursor of the next page, if any
+type Page struct {
+	Transactions []*AddressTransaction
+	NextCursor   core.OptionalUint64
+}
+
+type addressTransactionsIndex struct {
+	storer                   storage.Storer
+	transactionsStorer       storage.Storer
+	unsignedTxsStorer        storage.Storer
+	rewardTxsStorer          storage.Storer
+	marshaller               marshal.Marshalizer
+	hasher                   hashing.Hasher
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	mutex                    sync.RWMutex
+}
+
+// NewAddressTransactionsIndex will create a new instance of the transactions by address index
+func NewAddressTransactionsIndex(args ArgsAddressTransactionsIndex) (*addressTransactionsIndex, error) {
+	if check.IfNil(args.Storer) {
+		return nil, fmt.Errorf("%w for the address transactions storer", core.ErrNilStore)
+	}
+	if check.IfNil(args.TransactionsStorer) {
+		return nil, fmt.Errorf("%w for the transactions storer", core.ErrNilStore)
+	}
+	if check.IfNil(args.UnsignedTxsStorer) {
+		return nil, fmt.Errorf("%w for the unsigned transactions storer", core.ErrNilStore)
+	}
+	if check.IfNil(args.RewardTxsStorer) {
+		return nil, fmt.Errorf("%w for the reward transactions storer", core.ErrNilStore)
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, core.ErrNilHasher
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return nil, process.ErrNilUint64Converter
+	}
+
+	return &addressTransactionsIndex{
+		storer:                   args.Storer,
+		transactionsStorer:       args.TransactionsStorer,
+		unsignedTxsStorer:        args.UnsignedTxsStorer,
+		rewardTxsStorer:          args.RewardTxsStorer,
+		marshaller:               args.Marshaller,
+		hasher:                   args.Hasher,
+		uint64ByteSliceConverter: args.Uint64ByteSliceConverter,
+	}, nil
+}
+
+// RecordBlock will index all the transactions, smart contract results and rewards of the provided block by the
+// addresses involved. Recording the same block twice is a no-op.
+func (ati *addressTransactionsIndex) RecordBlock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	blockBody data.BodyHandler,
+	scrResultsFromPool map[string]data.TransactionHandler,
+	createdIntraShardMiniBlocks []*block.MiniBlock,
+) error {
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	body, ok := blockBody.(*block.Body)
+	if !ok {
+		return errCannotCastToBlockBody
+	}
+
+	ati.mutex.Lock()
+	defer ati.mutex.Unlock()
+
+	blockKey := buildKey(blockKeyPrefix, blockHeaderHash)
+	if ati.storer.Has(blockKey) == nil {
+		return nil
+	}
+
+	recorder := &blockRecorder{
+		index:              ati,
+		header:             blockHeader,
+		headerHash:         blockHeaderHash,
+		scrResultsFromPool: scrResultsFromPool,
+		counters:           make(map[string]uint64),
+		seenPairs:          make(map[string]struct{}),
+		record:             &BlockAddressTransactions{},
+	}
+
+	miniBlocks := make([]*block.MiniBlock, 0, len(body.MiniBlocks)+len(createdIntraShardMiniBlocks))
+	miniBlocks = append(miniBlocks, body.MiniBlocks...)
+	miniBlocks = append(miniBlocks, createdIntraShardMiniBlocks...)
+	for _, miniBlock := range miniBlocks {
+		err := recorder.recordMiniBlock(miniBlock)
+		if err != nil {
+			return err
+		}
+	}
+
+	for address, counter := range recorder.counters {
+		err := ati.storer.Put(buildKey(counterKeyPrefix, []byte(address)), ati.uint64ByteSliceConverter.ToByteSlice(counter))
+		if err != nil {
+			return err
+		}
+	}
+
+	return ati.putMarshalled(blockKey, recorder.record)
+}
+
+// RevertBlock will remove all the index entries written when the provided block was recorded
+func (ati *addressTransactionsIndex) RevertBlock(blockHeaderHash []byte) error {
+	ati.mutex.Lock()
+	defer ati.mutex.Unlock()
+
+	blockKey := buildKey(blockKeyPrefix, blockHeaderHash)
+	buff, err := ati.storer.Get(blockKey)
+	if err != nil {
+		// block was not recorded, nothing to revert
+		return nil
+	}
+
+	record := &BlockAddressTransactions{}
+	err = ati.marshaller.Unmarshal(record, buff)
+	if err != nil {
+		return err
+	}
+
+	// the counters are not decremented on purpose: the sequence numbers are never reused, so the cursors that were
+	// already handed out remain valid, the reverted entries are simply skipped when scanning
+	for _, key := range record.Keys {
+		err = ati.storer.Remove(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ati.storer.Remove(blockKey)
+}
+
+// GetTransactions returns a page of the transactions involving the address from the query
+func (ati *addressTransactionsIndex) GetTransactions(query Query) (*Page, error) {
+	if len(query.Address) == 0 {
+		return nil, ErrNilAddress
+	}
+
+	typesFilter, err := createTypesFilter(query.Types)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := query.Size
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	ati.mutex.RLock()
+	defer ati.mutex.RUnlock()
+
+	counter := ati.getCounter(query.Address)
+	page := &Page{
+		Transactions: make([]*AddressTransaction, 0, pageSize),
+	}
+	if counter == 0 {
+		return page, nil
+	}
+
+	seq, ok := computeStartingSequence(query, counter)
+	maxScannedEntries := pageSize * maxScannedEntriesFactor
+	for scanned := 0; ok && scanned < maxScannedEntries; scanned++ {
+		if len(page.Transactions) == pageSize {
+			break
+		}
+
+		entry, errGet := ati.getEntry(query.Address, seq)
+		if errGet == nil && isMatchingEntry(entry, query, typesFilter) {
+			page.Transactions = append(page.Transactions, entry)
+		}
+
+		seq, ok = nextSequence(seq, counter, query.Descending)
+	}
+
+	if ok {
+		page.NextCursor = core.OptionalUint64{Value: seq, HasValue: true}
+	}
+
+	return page, nil
+}
+
+func computeStartingSequence(query Query, counter uint64) (uint64, bool) {
+	if !query.Cursor.HasValue {
+		if query.Descending {
+			return counter - 1, true
+		}
+		return 0, true
+	}
+
+	if query.Cursor.Value >= counter {
+		if query.Descending {
+			return counter - 1, true
+		}
+		return 0, false
+	}
+
+	return query.Cursor.Value, true
+}
+
+func nextSequence(seq uint64, counter uint64, descending bool) (uint64, bool) {
+	if descending {
+		if seq == 0 {
+			return 0, false
+		}
+		return seq - 1, true
+	}
+
+	if seq+1 >= counter {
+		return 0, false
+	}
+	return seq + 1, true
+}
+
+func createTypesFilter(types []transaction.TxType) (map[string]struct{}, error) {
+	if len(types) == 0 {
+		return nil, nil
+	}
+
+	filter := make(map[string]struct{}, len(types))
+	for _, txType := range types {
+		err := CheckTransactionType(txType)
+		if err != nil {
+			return nil, err
+		}
+
+		filter[string(txType)] = struct{}{}
+	}
+
+	return filter, nil
+}
+
+// CheckTransactionType returns an error if the provided type can not be used to filter the address transactions
+func CheckTransactionType(txType transaction.TxType) error {
+	switch txType {
+	case transaction.TxTypeNormal, transaction.TxTypeUnsigned, transaction.TxTypeReward, transaction.TxTypeInvalid:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidTransactionType, txType)
+	}
+}
+
+func isMatchingEntry(entry *AddressTransaction, query Query, typesFilter map[string]struct{}) bool {
+	if query.FromNonce.HasValue && entry.BlockNonce < query.FromNonce.Value {
+		return false
+	}
+	if query.ToNonce.HasValue && entry.BlockNonce > query.ToNonce.Value {
+		return false
+	}
+	if len(typesFilter) == 0 {
+		return true
+	}
+
+	_, found := typesFilter[entry.Type]
+	return found
+}
+
+func (ati *addressTransactionsIndex) getCounter(address []byte) uint64 {
+	buff, err := ati.storer.Get(buildKey(counterKeyPrefix, address))
+	if err != nil {
+		return 0
+	}
+
+	counter, err := ati.uint64ByteSliceConverter.ToUint64(buff)
+	if err != nil {
+		log.Warn("addressTransactionsIndex: cannot decode counter", "address", address, "error", err)
+		return 0
+	}
+
+	return counter
+}
+
+func (ati *addressTransactionsIndex) getEntry(address []byte, seq uint64) (*AddressTransaction, error) {
+	buff, err := ati.storer.Get(ati.buildEntryKey(address, seq))
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &AddressTransaction{}
+	err = ati.marshaller.Unmarshal(entry, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (ati *addressTransactionsIndex) putMarshalled(key []byte, obj interface{}) error {
+	buff, err := ati.marshaller.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	return ati.storer.Put(key, buff)
+}
+
+func (ati *addressTransactionsIndex) buildEntryKey(address []byte, seq uint64) []byte {
+	return buildKey(entryKeyPrefix, address, ati.uint64ByteSliceConverter.ToByteSlice(seq))
+}
+
+func buildKey(prefix byte, parts ...[]byte) []byte {
+	return append([]byte{prefix}, bytes.Join(parts, nil)...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ati *addressTransactionsIndex) IsInterfaceNil() bool {
+	return ati == nil
+}
+
+type blockRecorder struct {
+	index              *addressTransactionsIndex
+	header             data.HeaderHandler
+	headerHash         []byte
+	scrResultsFromPool map[string]data.TransactionHandler
+	counters           map[string]uint64
+	seenPairs          map[string]struct{}
+	record             *BlockAddressTransactions
+}
+
+func (br *blockRecorder) recordMiniBlock(miniBlock *block.MiniBlock) error {
+	if miniBlock == nil {
+		return nil
+	}
+
+	txType, ok := getTxTypeForMiniBlock(miniBlock.Type)
+	if !ok {
+		return nil
+	}
+
+	miniBlockHash, err := core.CalculateHash(br.index.marshaller, br.index.hasher, miniBlock)
+	if err != nil {
+		return err
+	}
+
+	for _, txHash := range miniBlock.TxHashes {
+		tx, errGet := br.getTransaction(txHash, miniBlock.Type)
+		if errGet != nil {
+			logging.LogErrAsWarnExceptAsDebugIfClosingError(log, errGet, "addressTransactionsIndex: cannot get transaction",
+				"txHash", txHash, "miniblock type", miniBlock.Type, "error", errGet)
+			continue
+		}
+
+		entry := &AddressTransaction{
+			TxHash:           txHash,
+			Type:             string(txType),
+			Sender:           tx.GetSndAddr(),
+			Receiver:         tx.GetRcvAddr(),
+			BlockNonce:       br.header.GetNonce(),
+			BlockHash:        br.headerHash,
+			Round:            br.header.GetRound(),
+			Epoch:            br.header.GetEpoch(),
+			MiniblockHash:    miniBlockHash,
+			SourceShard:      miniBlock.SenderShardID,
+			DestinationShard: miniBlock.ReceiverShardID,
+			Timestamp:        br.header.GetTimeStamp(),
+		}
+
+		for _, address := range getInvolvedAddresses(tx) {
+			err = br.recordEntry(address, entry)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (br *blockRecorder) recordEntry(address []byte, entry *AddressTransaction) error {
+	dedupKey := buildKey(dedupKeyPrefix, address, entry.TxHash)
+	_, seenInBlock := br.seenPairs[string(dedupKey)]
+	if seenInBlock || br.index.storer.Has(dedupKey) == nil {
+		return nil
+	}
+
+	counter, found := br.counters[string(address)]
+	if !found {
+		counter = br.index.getCounter(address)
+	}
+
+	entryKey := br.index.buildEntryKey(address, counter)
+	err := br.index.putMarshalled(entryKey, entry)
+	if err != nil {
+		return err
+	}
+
+	err = br.index.storer.Put(dedupKey, br.index.uint64ByteSliceConverter.ToByteSlice(counter))
+	if err != nil {
+		return err
+	}
+
+	br.counters[string(address)] = counter + 1
+	br.seenPairs[string(dedupKey)] = struct{}{}
+	br.record.Keys = append(br.record.Keys, entryKey, dedupKey)
+
+	return nil
+}
+
+func (br *blockRecorder) getTransaction(txHash []byte, miniBlockType block.Type) (data.TransactionHandler, error) {
+	switch miniBlockType {
+	case block.SmartContractResultBlock:
+		scr, found := br.scrResultsFromPool[string(txHash)]
+		if found && !check.IfNil(scr) {
+			return scr, nil
+		}
+		return br.getTransactionFromStorer(br.index.unsignedTxsStorer, txHash, &smartContractResult.SmartContractResult{})
+	case block.RewardsBlock:
+		return br.getTransactionFromStorer(br.index.rewardTxsStorer, txHash, &rewardTx.RewardTx{})
+	default:
+		return br.getTransactionFromStorer(br.index.transactionsStorer, txHash, &transaction.Transaction{})
+	}
+}
+
+func (br *blockRecorder) getTransactionFromStorer(storer storage.Storer, txHash []byte, tx data.TransactionHandler) (data.TransactionHandler, error) {
+	buff, err := storer.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = br.index.marshaller.Unmarshal(tx, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func getTxTypeForMiniBlock(miniBlockType block.Type) (transaction.TxType, bool) {
+	switch miniBlockType {
+	case block.TxBlock:
+		return transaction.TxTypeNormal, true
+	case block.InvalidBlock:
+		return transaction.TxTypeInvalid, true
+	case block.SmartContractResultBlock:
+		return transaction.TxTypeUnsigned, true
+	case block.RewardsBlock:
+		return transaction.TxTypeReward, true
+	default:
+		return "", false
+	}
+}
+
+func getInvolvedAddresses(tx data.TransactionHandler) [][]byte {
+	candidates := [][]byte{tx.GetSndAddr(), tx.GetRcvAddr()}
+	relayedTx, ok := tx.(*transaction.Transaction)
+	if ok {
+		candidates = append(candidates, relayedTx.RelayerAddr)
+	}
+
+	addresses := make([][]byte, 0, len(candidates))
+	for _, candidate := range candidates {
+		if len(candidate) == 0 || containsAddress(addresses, candidate) {
+			continue
+		}
+		addresses = append(addresses, candidate)
+	}
+
+	return addresses
+}
+
+func containsAddress(addresses [][]byte, address []byte) bool {
+	for _, existing := range addresses {
+		if bytes.Equal(existing, address) {
+			return true
+		}
+	}
+
+	return false
+}
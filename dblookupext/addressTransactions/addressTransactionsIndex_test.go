@@ -0,0 +1,407 @@
+package addressTransactions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	alice          = []byte("alice")
+	bob            = []byte("bob")
+	carol          = []byte("carol")
+)
+
+func createMockArgs() ArgsAddressTransactionsIndex {
+	return ArgsAddressTransactionsIndex{
+		Storer:                   testscommon.CreateMemUnit(),
+		TransactionsStorer:       testscommon.CreateMemUnit(),
+		UnsignedTxsStorer:        testscommon.CreateMemUnit(),
+		RewardTxsStorer:          testscommon.CreateMemUnit(),
+		Marshaller:               testMarshaller,
+		Hasher:                   &hashingMocks.HasherMock{},
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+	}
+}
+
+func putTx(t *testing.T, args ArgsAddressTransactionsIndex, txHash string, tx data.TransactionHandler) {
+	buff, err := testMarshaller.Marshal(tx)
+	require.Nil(t, err)
+
+	switch tx.(type) {
+	case *smartContractResult.SmartContractResult:
+		err = args.UnsignedTxsStorer.Put([]byte(txHash), buff)
+	case *rewardTx.RewardTx:
+		err = args.RewardTxsStorer.Put([]byte(txHash), buff)
+	default:
+		err = args.TransactionsStorer.Put([]byte(txHash), buff)
+	}
+	require.Nil(t, err)
+}
+
+func createBlockWithTxs(t *testing.T, args ArgsAddressTransactionsIndex, nonce uint64, senders [][]byte, receivers [][]byte) (*block.Header, *block.Body) {
+	txHashes := make([][]byte, 0, len(senders))
+	for i := range senders {
+		txHash := fmt.Sprintf("tx-%d-%d", nonce, i)
+		putTx(t, args, txHash, &transaction.Transaction{Nonce: uint64(i), SndAddr: senders[i], RcvAddr: receivers[i]})
+		txHashes = append(txHashes, []byte(txHash))
+	}
+
+	header := &block.Header{Nonce: nonce, Round: nonce + 10, Epoch: 1, TimeStamp: 1000 + nonce}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: txHashes},
+		},
+	}
+
+	return header, body
+}
+
+func getTxHashes(page *Page) []string {
+	hashes := make([]string, 0, len(page.Transactions))
+	for _, entry := range page.Transactions {
+		hashes = append(hashes, string(entry.TxHash))
+	}
+
+	return hashes
+}
+
+func TestNewAddressTransactionsIndex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Storer = nil
+		index, err := NewAddressTransactionsIndex(args)
+		require.True(t, errors.Is(err, core.ErrNilStore))
+		require.Nil(t, index)
+	})
+	t.Run("nil transactions storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.TransactionsStorer = nil
+		index, err := NewAddressTransactionsIndex(args)
+		require.True(t, errors.Is(err, core.ErrNilStore))
+		require.Nil(t, index)
+	})
+	t.Run("nil unsigned transactions storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.UnsignedTxsStorer = nil
+		index, err := NewAddressTransactionsIndex(args)
+		require.True(t, errors.Is(err, core.ErrNilStore))
+		require.Nil(t, index)
+	})
+	t.Run("nil reward transactions storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.RewardTxsStorer = nil
+		index, err := NewAddressTransactionsIndex(args)
+		require.True(t, errors.Is(err, core.ErrNilStore))
+		require.Nil(t, index)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Marshaller = nil
+		index, err := NewAddressTransactionsIndex(args)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+		require.Nil(t, index)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Hasher = nil
+		index, err := NewAddressTransactionsIndex(args)
+		require.Equal(t, core.ErrNilHasher, err)
+		require.Nil(t, index)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Uint64ByteSliceConverter = nil
+		index, err := NewAddressTransactionsIndex(args)
+		require.Equal(t, process.ErrNilUint64Converter, err)
+		require.Nil(t, index)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		index, err := NewAddressTransactionsIndex(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, index.IsInterfaceNil())
+	})
+}
+
+func TestAddressTransactionsIndex_RecordBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		index, _ := NewAddressTransactionsIndex(createMockArgs())
+		err := index.RecordBlock([]byte("hash"), &block.Header{}, nil, nil, nil)
+		require.Equal(t, errCannotCastToBlockBody, err)
+	})
+	t.Run("should index all miniblock types", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		index, _ := NewAddressTransactionsIndex(args)
+
+		putTx(t, args, "tx", &transaction.Transaction{SndAddr: alice, RcvAddr: bob, RelayerAddr: carol})
+		putTx(t, args, "invalid", &transaction.Transaction{SndAddr: alice, RcvAddr: alice})
+		putTx(t, args, "reward", &rewardTx.RewardTx{RcvAddr: alice})
+		scrsFromPool := map[string]data.TransactionHandler{
+			"scr": &smartContractResult.SmartContractResult{SndAddr: bob, RcvAddr: alice},
+		}
+		header := &block.Header{Nonce: 7, Round: 8, Epoch: 2, TimeStamp: 1234}
+		body := &block.Body{
+			MiniBlocks: []*block.MiniBlock{
+				{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx")}, SenderShardID: 0, ReceiverShardID: 1},
+				{Type: block.InvalidBlock, TxHashes: [][]byte{[]byte("invalid")}},
+				{Type: block.RewardsBlock, TxHashes: [][]byte{[]byte("reward")}},
+				{Type: block.PeerBlock, TxHashes: [][]byte{[]byte("peer")}},
+			},
+		}
+		intraShardMiniBlocks := []*block.MiniBlock{
+			{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr")}},
+		}
+
+		err := index.RecordBlock([]byte("hash"), header, body, scrsFromPool, intraShardMiniBlocks)
+		require.Nil(t, err)
+
+		page, err := index.GetTransactions(Query{Address: alice, Descending: true})
+		require.Nil(t, err)
+		require.Equal(t, []string{"scr", "reward", "invalid", "tx"}, getTxHashes(page))
+		require.False(t, page.NextCursor.HasValue)
+
+		txEntry := page.Transactions[3]
+		require.Equal(t, string(transaction.TxTypeNormal), txEntry.Type)
+		require.Equal(t, alice, txEntry.Sender)
+		require.Equal(t, bob, txEntry.Receiver)
+		require.Equal(t, uint64(7), txEntry.BlockNonce)
+		require.Equal(t, []byte("hash"), txEntry.BlockHash)
+		require.Equal(t, uint64(8), txEntry.Round)
+		require.Equal(t, uint32(2), txEntry.Epoch)
+		require.Equal(t, uint32(1), txEntry.DestinationShard)
+		require.Equal(t, uint64(1234), txEntry.Timestamp)
+		require.NotEmpty(t, txEntry.MiniblockHash)
+
+		page, _ = index.GetTransactions(Query{Address: bob, Descending: true})
+		require.Equal(t, []string{"scr", "tx"}, getTxHashes(page))
+
+		page, _ = index.GetTransactions(Query{Address: carol})
+		require.Equal(t, []string{"tx"}, getTxHashes(page))
+	})
+	t.Run("missing transaction should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		index, _ := NewAddressTransactionsIndex(args)
+
+		header, body := createBlockWithTxs(t, args, 1, [][]byte{alice}, [][]byte{bob})
+		body.MiniBlocks[0].TxHashes = append(body.MiniBlocks[0].TxHashes, []byte("missing"))
+
+		err := index.RecordBlock([]byte("hash"), header, body, nil, nil)
+		require.Nil(t, err)
+
+		page, _ := index.GetTransactions(Query{Address: alice})
+		require.Equal(t, []string{"tx-1-0"}, getTxHashes(page))
+	})
+	t.Run("recording the same block twice should not duplicate entries", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		index, _ := NewAddressTransactionsIndex(args)
+
+		header, body := createBlockWithTxs(t, args, 1, [][]byte{alice}, [][]byte{bob})
+		_ = index.RecordBlock([]byte("hash"), header, body, nil, nil)
+		_ = index.RecordBlock([]byte("hash"), header, body, nil, nil)
+		// same transaction in a different block (e.g. a replayed block with a different hash)
+		_ = index.RecordBlock([]byte("other hash"), header, body, nil, nil)
+
+		page, _ := index.GetTransactions(Query{Address: alice})
+		require.Equal(t, []string{"tx-1-0"}, getTxHashes(page))
+	})
+}
+
+func TestAddressTransactionsIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	index, _ := NewAddressTransactionsIndex(args)
+
+	header1, body1 := createBlockWithTxs(t, args, 1, [][]byte{alice}, [][]byte{bob})
+	header2, body2 := createBlockWithTxs(t, args, 2, [][]byte{alice, bob}, [][]byte{carol, alice})
+	_ = index.RecordBlock([]byte("hash1"), header1, body1, nil, nil)
+	_ = index.RecordBlock([]byte("hash2"), header2, body2, nil, nil)
+
+	page, _ := index.GetTransactions(Query{Address: alice, Descending: true})
+	require.Equal(t, []string{"tx-2-1", "tx-2-0", "tx-1-0"}, getTxHashes(page))
+
+	err := index.RevertBlock([]byte("hash2"))
+	require.Nil(t, err)
+
+	page, _ = index.GetTransactions(Query{Address: alice})
+	require.Equal(t, []string{"tx-1-0"}, getTxHashes(page))
+	page, _ = index.GetTransactions(Query{Address: carol})
+	require.Empty(t, page.Transactions)
+
+	// reverting an unknown block is a no-op
+	err = index.RevertBlock([]byte("unknown"))
+	require.Nil(t, err)
+
+	// the block can be recorded again after a revert
+	err = index.RecordBlock([]byte("hash2"), header2, body2, nil, nil)
+	require.Nil(t, err)
+	page, _ = index.GetTransactions(Query{Address: alice, Descending: true})
+	require.Equal(t, []string{"tx-2-1", "tx-2-0", "tx-1-0"}, getTxHashes(page))
+}
+
+func TestAddressTransactionsIndex_GetTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	index, _ := NewAddressTransactionsIndex(args)
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		header, body := createBlockWithTxs(t, args, nonce, [][]byte{alice, bob}, [][]byte{bob, alice})
+		err := index.RecordBlock([]byte(fmt.Sprintf("hash%d", nonce)), header, body, nil, nil)
+		require.Nil(t, err)
+	}
+	putTx(t, args, "reward", &rewardTx.RewardTx{RcvAddr: alice})
+	err := index.RecordBlock([]byte("hash6"), &block.Header{Nonce: 6}, &block.Body{
+		MiniBlocks: []*block.MiniBlock{{Type: block.RewardsBlock, TxHashes: [][]byte{[]byte("reward")}}},
+	}, nil, nil)
+	require.Nil(t, err)
+
+	t.Run("nil address should error", func(t *testing.T) {
+		t.Parallel()
+
+		page, errGet := index.GetTransactions(Query{})
+		require.Equal(t, ErrNilAddress, errGet)
+		require.Nil(t, page)
+	})
+	t.Run("invalid type should error", func(t *testing.T) {
+		t.Parallel()
+
+		page, errGet := index.GetTransactions(Query{Address: alice, Types: []transaction.TxType{"unknown"}})
+		require.True(t, errors.Is(errGet, ErrInvalidTransactionType))
+		require.Nil(t, page)
+	})
+	t.Run("unknown address should return an empty page", func(t *testing.T) {
+		t.Parallel()
+
+		page, errGet := index.GetTransactions(Query{Address: carol})
+		require.Nil(t, errGet)
+		require.Empty(t, page.Transactions)
+		require.False(t, page.NextCursor.HasValue)
+	})
+	t.Run("descending pagination", func(t *testing.T) {
+		t.Parallel()
+
+		query := Query{Address: alice, Size: 4, Descending: true}
+		page, errGet := index.GetTransactions(query)
+		require.Nil(t, errGet)
+		require.Equal(t, []string{"reward", "tx-5-1", "tx-5-0", "tx-4-1"}, getTxHashes(page))
+		require.Equal(t, core.OptionalUint64{Value: 6, HasValue: true}, page.NextCursor)
+
+		query.Cursor = page.NextCursor
+		page, _ = index.GetTransactions(query)
+		require.Equal(t, []string{"tx-4-0", "tx-3-1", "tx-3-0", "tx-2-1"}, getTxHashes(page))
+
+		query.Cursor = page.NextCursor
+		page, _ = index.GetTransactions(query)
+		require.Equal(t, []string{"tx-2-0", "tx-1-1", "tx-1-0"}, getTxHashes(page))
+		require.False(t, page.NextCursor.HasValue)
+	})
+	t.Run("ascending pagination", func(t *testing.T) {
+		t.Parallel()
+
+		query := Query{Address: alice, Size: 5}
+		page, _ := index.GetTransactions(query)
+		require.Equal(t, []string{"tx-1-0", "tx-1-1", "tx-2-0", "tx-2-1", "tx-3-0"}, getTxHashes(page))
+		require.Equal(t, core.OptionalUint64{Value: 5, HasValue: true}, page.NextCursor)
+
+		query.Cursor = page.NextCursor
+		page, _ = index.GetTransactions(query)
+		require.Equal(t, []string{"tx-3-1", "tx-4-0", "tx-4-1", "tx-5-0", "tx-5-1"}, getTxHashes(page))
+
+		query.Cursor = page.NextCursor
+		page, _ = index.GetTransactions(query)
+		require.Equal(t, []string{"reward"}, getTxHashes(page))
+		require.False(t, page.NextCursor.HasValue)
+
+		query.Cursor = core.OptionalUint64{Value: 100, HasValue: true}
+		page, _ = index.GetTransactions(query)
+		require.Empty(t, page.Transactions)
+	})
+	t.Run("filter by type", func(t *testing.T) {
+		t.Parallel()
+
+		page, _ := index.GetTransactions(Query{Address: alice, Descending: true, Types: []transaction.TxType{transaction.TxTypeReward}})
+		require.Equal(t, []string{"reward"}, getTxHashes(page))
+	})
+	t.Run("filter by nonce range", func(t *testing.T) {
+		t.Parallel()
+
+		page, _ := index.GetTransactions(Query{
+			Address:   alice,
+			FromNonce: core.OptionalUint64{Value: 2, HasValue: true},
+			ToNonce:   core.OptionalUint64{Value: 3, HasValue: true},
+		})
+		require.Equal(t, []string{"tx-2-0", "tx-2-1", "tx-3-0", "tx-3-1"}, getTxHashes(page))
+	})
+	t.Run("scanned entries should be bounded", func(t *testing.T) {
+		t.Parallel()
+
+		argsLocal := createMockArgs()
+		localIndex, _ := NewAddressTransactionsIndex(argsLocal)
+		for nonce := uint64(1); nonce <= 15; nonce++ {
+			header, body := createBlockWithTxs(t, argsLocal, nonce, [][]byte{alice, alice}, [][]byte{bob, bob})
+			_ = localIndex.RecordBlock([]byte(fmt.Sprintf("hash%d", nonce)), header, body, nil, nil)
+		}
+
+		page, _ := localIndex.GetTransactions(Query{
+			Address:   alice,
+			Size:      1,
+			FromNonce: core.OptionalUint64{Value: 100, HasValue: true},
+		})
+		require.Empty(t, page.Transactions)
+		require.Equal(t, core.OptionalUint64{Value: maxScannedEntriesFactor, HasValue: true}, page.NextCursor)
+	})
+}
+
+func TestCheckTransactionType(t *testing.T) {
+	t.Parallel()
+
+	for _, txType := range []transaction.TxType{transaction.TxTypeNormal, transaction.TxTypeUnsigned, transaction.TxTypeReward, transaction.TxTypeInvalid} {
+		require.Nil(t, CheckTransactionType(txType))
+	}
+
+	err := CheckTransactionType("unknown")
+	require.True(t, errors.Is(err, ErrInvalidTransactionType))
+	require.Contains(t, err.Error(), "unknown")
+}
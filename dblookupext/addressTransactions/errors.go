@@ -0,0 +1,11 @@
+package addressTransactions
+
+import "errors"
+
+var errCannotCastToBlockBody = errors.New("cannot cast to block body")
+
+// ErrNilAddress signals that a nil or empty address was provided
+var ErrNilAddress = errors.New("nil address")
+
+// ErrInvalidTransactionType signals that an unknown transaction type was provided as filter
+var ErrInvalidTransactionType = errors.New("invalid transaction type")
@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
+)
+
+type addressTransactionsIndex struct {
+}
+
+// NewAddressTransactionsIndex returns a disabled transactions by address index
+func NewAddressTransactionsIndex() *addressTransactionsIndex {
+	return &addressTransactionsIndex{}
+}
+
+// RecordBlock does nothing
+func (ati *addressTransactionsIndex) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _ map[string]data.TransactionHandler, _ []*block.MiniBlock) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (ati *addressTransactionsIndex) RevertBlock(_ []byte) error {
+	return nil
+}
+
+// GetTransactions returns the index not enabled error
+func (ati *addressTransactionsIndex) GetTransactions(_ addressTransactions.Query) (*addressTransactions.Page, error) {
+	return nil, dblookupext.ErrAddressTransactionsIndexNotEnabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ati *addressTransactionsIndex) IsInterfaceNil() bool {
+	return ati == nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 )
 
@@ -53,6 +54,11 @@ func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (*esdtSupply.SupplyESDT
 	return nil, errorDisabledHistoryRepository
 }
 
+// GetAddressTransactions returns a not implemented error
+func (nhr *nilHistoryRepository) GetAddressTransactions(_ addressTransactions.Query) (*addressTransactions.Page, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // GetResultsHashesByTxHash -
 func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
 	return nil, nil
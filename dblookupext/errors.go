@@ -13,6 +13,11 @@ var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
 var errNilESDTSuppliesHandler = errors.New("nil esdt supplies handler")
 
+var errNilAddressTransactionsHandler = errors.New("nil address transactions handler")
+
+// ErrAddressTransactionsIndexNotEnabled signals that the transactions by address index is not enabled
+var ErrAddressTransactionsIndexNotEnabled = errors.New("address transactions index is not enabled")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
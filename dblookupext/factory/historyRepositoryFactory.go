@@ -9,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/dblookupext/disabled"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	"github.com/multiversx/mx-chain-go/process"
@@ -84,6 +85,11 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return nil, err
 	}
 
+	addressTransactionsHandler, err := hpf.createAddressTransactionsHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	roundHdrHashDataStorer, err := hpf.store.GetStorer(dataRetriever.RoundHdrHashDataUnit)
 	if err != nil {
 		return nil, err
@@ -120,10 +126,47 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: miniblockHashByTxHashStorer,
 		EventsHashesByTxHashStorer:  resultsHashesByTxHashStorer,
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		AddressTransactionsHandler:  addressTransactionsHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
+func (hpf *historyRepositoryFactory) createAddressTransactionsHandler() (dblookupext.AddressTransactionsHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.AddressTransactionsIndexEnabled {
+		return disabled.NewAddressTransactionsIndex(), nil
+	}
+
+	addressTransactionsStorer, err := hpf.store.GetStorer(dataRetriever.AddressTransactionsUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionsStorer, err := hpf.store.GetStorer(dataRetriever.TransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedTxsStorer, err := hpf.store.GetStorer(dataRetriever.UnsignedTransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardTxsStorer, err := hpf.store.GetStorer(dataRetriever.RewardTransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return addressTransactions.NewAddressTransactionsIndex(addressTransactions.ArgsAddressTransactionsIndex{
+		Storer:                   addressTransactionsStorer,
+		TransactionsStorer:       transactionsStorer,
+		UnsignedTxsStorer:        unsignedTxsStorer,
+		RewardTxsStorer:          rewardTxsStorer,
+		Marshaller:               hpf.marshalizer,
+		Hasher:                   hpf.hasher,
+		Uint64ByteSliceConverter: hpf.uInt64ByteSliceConverter,
+	})
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hpf *historyRepositoryFactory) IsInterfaceNil() bool {
 	return hpf == nil
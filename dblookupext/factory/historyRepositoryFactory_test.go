@@ -89,29 +89,69 @@ func TestHistoryRepositoryFactory_CreateMissingStorersReturnsError(t *testing.T)
 	t.Run("missing ResultsHashesByTxHashUnit", testWithMissingStorer(dataRetriever.ResultsHashesByTxHashUnit))
 }
 
-func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T) {
-	return func(t *testing.T) {
+func TestHistoryRepositoryFactory_CreateWithAddressTransactionsIndex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing AddressTransactionsUnit", testWithMissingStorerAndAddressTransactionsIndex(dataRetriever.AddressTransactionsUnit))
+	t.Run("missing TransactionUnit", testWithMissingStorerAndAddressTransactionsIndex(dataRetriever.TransactionUnit))
+	t.Run("missing UnsignedTransactionUnit", testWithMissingStorerAndAddressTransactionsIndex(dataRetriever.UnsignedTransactionUnit))
+	t.Run("missing RewardTransactionUnit", testWithMissingStorerAndAddressTransactionsIndex(dataRetriever.RewardTransactionUnit))
+	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
 		args := getArgs()
 		args.Config.Enabled = true
+		args.Config.AddressTransactionsIndexEnabled = true
 		args.Store = &storageStubs.ChainStorerStub{
 			GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
-				if unitType == missingUnit {
-					return nil, fmt.Errorf("%w for %s", storage.ErrKeyNotFound, missingUnit.String())
-				}
 				return &storageStubs.StorerStub{}, nil
 			},
 		}
+
 		hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
 		repository, err := hrf.Create()
-		require.NotNil(t, err)
-		require.True(t, strings.Contains(err.Error(), storage.ErrKeyNotFound.Error()))
-		require.True(t, strings.Contains(err.Error(), missingUnit.String()))
-		require.True(t, check.IfNil(repository))
+		require.NoError(t, err)
+		require.True(t, repository.IsEnabled())
+	})
+}
+
+func testWithMissingStorerAndAddressTransactionsIndex(missingUnit dataRetriever.UnitType) func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		args := getArgs()
+		args.Config.AddressTransactionsIndexEnabled = true
+		testWithMissingStorerAndArgs(t, args, missingUnit)
 	}
 }
 
+func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		testWithMissingStorerAndArgs(t, getArgs(), missingUnit)
+	}
+}
+
+func testWithMissingStorerAndArgs(t *testing.T, args *factory.ArgsHistoryRepositoryFactory, missingUnit dataRetriever.UnitType) {
+	args.Config.Enabled = true
+	args.Store = &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			if unitType == missingUnit {
+				return nil, fmt.Errorf("%w for %s", storage.ErrKeyNotFound, missingUnit.String())
+			}
+			return &storageStubs.StorerStub{}, nil
+		},
+	}
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+	repository, err := hrf.Create()
+	require.NotNil(t, err)
+	require.True(t, strings.Contains(err.Error(), storage.ErrKeyNotFound.Error()))
+	require.True(t, strings.Contains(err.Error(), missingUnit.String()))
+	require.True(t, check.IfNil(repository))
+}
+
 func getArgs() *factory.ArgsHistoryRepositoryFactory {
 	return &factory.ArgsHistoryRepositoryFactory{
 		SelfShardID:              0,
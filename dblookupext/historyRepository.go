@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common/logging"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -38,6 +39,7 @@ type HistoryRepositoryArguments struct {
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
+	AddressTransactionsHandler  AddressTransactionsHandler
 }
 
 type historyRepository struct {
@@ -51,6 +53,7 @@ type historyRepository struct {
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
+	addressTransactionsHandler AddressTransactionsHandler
 
 	// These maps temporarily hold notifications of "notarized at source or destination", to deal with unwanted concurrency effects
 	// The unwanted concurrency effects could be accentuated by the fast db-replay-validate mechanism.
@@ -94,6 +97,9 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.ESDTSuppliesHandler) {
 		return nil, errNilESDTSuppliesHandler
 	}
+	if check.IfNil(arguments.AddressTransactionsHandler) {
+		return nil, errNilAddressTransactionsHandler
+	}
 	if check.IfNil(arguments.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
@@ -117,6 +123,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
+		addressTransactionsHandler:                   arguments.AddressTransactionsHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
 	}, nil
 }
@@ -178,6 +185,11 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	err = hr.addressTransactionsHandler.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, createdIntraShardMiniBlocks)
+	if err != nil {
+		return err
+	}
+
 	err = hr.putHashByRound(blockHeaderHash, blockHeader)
 	if err != nil {
 		return err
@@ -480,7 +492,20 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	err := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	blockHeaderHash, err := core.CalculateHash(hr.marshalizer, hr.hasher, blockHeader)
+	if err != nil {
+		return err
+	}
+
+	return hr.addressTransactionsHandler.RevertBlock(blockHeaderHash)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -488,6 +513,11 @@ func (hr *historyRepository) GetESDTSupply(token string) (*esdtSupply.SupplyESDT
 	return hr.esdtSuppliesHandler.GetESDTSupply(token)
 }
 
+// GetAddressTransactions will return a page of the transactions involving the address from the query
+func (hr *historyRepository) GetAddressTransactions(query addressTransactions.Query) (*addressTransactions.Page, error) {
+	return hr.addressTransactionsHandler.GetTransactions(query)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hr *historyRepository) IsInterfaceNil() bool {
 	return hr == nil
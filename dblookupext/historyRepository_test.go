@@ -8,11 +8,15 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
 	"github.com/multiversx/mx-chain-go/common/mock"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	epochStartMocks "github.com/multiversx/mx-chain-go/epochStart/mock"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
@@ -26,6 +30,15 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 			return nil, storage.ErrKeyNotFound
 		},
 	}, &storageStubs.StorerStub{})
+	addressTransactionsIndex, _ := addressTransactions.NewAddressTransactionsIndex(addressTransactions.ArgsAddressTransactionsIndex{
+		Storer:                   testscommon.CreateMemUnit(),
+		TransactionsStorer:       testscommon.CreateMemUnit(),
+		UnsignedTxsStorer:        testscommon.CreateMemUnit(),
+		RewardTxsStorer:          testscommon.CreateMemUnit(),
+		Marshaller:               &mock.MarshalizerMock{},
+		Hasher:                   &hashingMocks.HasherMock{},
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+	})
 
 	args := HistoryRepositoryArguments{
 		SelfShardID:                 0,
@@ -37,6 +50,7 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &hashingMocks.HasherMock{},
 		ESDTSuppliesHandler:         sp,
+		AddressTransactionsHandler:  addressTransactionsIndex,
 		Uint64ByteSliceConverter:    &epochStartMocks.Uint64ByteSliceConverterMock{},
 	}
 
@@ -88,6 +102,12 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, process.ErrNilUint64Converter, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.AddressTransactionsHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilAddressTransactionsHandler, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
@@ -152,6 +172,58 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 	require.Equal(t, 1, repo.blockHashByRound.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
 }
 
+func TestHistoryRepository_RecordAndRevertBlockWithAddressTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	sender := []byte("sender")
+	txsStorer := testscommon.CreateMemUnit()
+	addressTransactionsIndex, _ := addressTransactions.NewAddressTransactionsIndex(addressTransactions.ArgsAddressTransactionsIndex{
+		Storer:                   testscommon.CreateMemUnit(),
+		TransactionsStorer:       txsStorer,
+		UnsignedTxsStorer:        testscommon.CreateMemUnit(),
+		RewardTxsStorer:          testscommon.CreateMemUnit(),
+		Marshaller:               args.Marshalizer,
+		Hasher:                   args.Hasher,
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+	})
+	repo.addressTransactionsHandler = addressTransactionsIndex
+	repo.esdtSuppliesHandler, _ = esdtSupply.NewSuppliesProcessor(args.Marshalizer, testscommon.CreateMemUnit(), testscommon.CreateMemUnit())
+
+	txBuff, _ := args.Marshalizer.Marshal(&transaction.Transaction{SndAddr: sender, RcvAddr: []byte("receiver")})
+	_ = txsStorer.Put([]byte("txA"), txBuff)
+
+	blockHeader := &block.Header{Nonce: 4, Round: 5}
+	headerHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, blockHeader)
+	blockBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				Type:     block.TxBlock,
+				TxHashes: [][]byte{[]byte("txA")},
+			},
+		},
+	}
+
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	page, err := repo.GetAddressTransactions(addressTransactions.Query{Address: sender})
+	require.Nil(t, err)
+	require.Len(t, page.Transactions, 1)
+	require.Equal(t, []byte("txA"), page.Transactions[0].TxHash)
+	require.Equal(t, headerHash, page.Transactions[0].BlockHash)
+
+	err = repo.RevertBlock(blockHeader, blockBody)
+	require.Nil(t, err)
+
+	page, err = repo.GetAddressTransactions(addressTransactions.Query{Address: sender})
+	require.Nil(t, err)
+	require.Empty(t, page.Transactions)
+}
+
 func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package dblookupext
 import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 )
 
@@ -27,6 +28,7 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetAddressTransactions(query addressTransactions.Query) (*addressTransactions.Page, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -47,3 +49,17 @@ type SuppliesHandler interface {
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
 	IsInterfaceNil() bool
 }
+
+// AddressTransactionsHandler defines the interface of the transactions by address index
+type AddressTransactionsHandler interface {
+	RecordBlock(
+		blockHeaderHash []byte,
+		blockHeader data.HeaderHandler,
+		blockBody data.BodyHandler,
+		scrResultsFromPool map[string]data.TransactionHandler,
+		createdIntraShardMiniBlocks []*block.MiniBlock,
+	) error
+	RevertBlock(blockHeaderHash []byte) error
+	GetTransactions(query addressTransactions.Query) (*addressTransactions.Page, error)
+	IsInterfaceNil() bool
+}
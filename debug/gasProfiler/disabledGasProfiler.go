@@ -0,0 +1,34 @@
+package gasProfiler
+
+import "github.com/multiversx/mx-chain-go/process"
+
+type disabledGasProfiler struct {
+}
+
+// NewDisabledGasProfiler returns a new instance of a gas profiler which does nothing
+func NewDisabledGasProfiler() *disabledGasProfiler {
+	return &disabledGasProfiler{}
+}
+
+// ProfileExecution does nothing
+func (profiler *disabledGasProfiler) ProfileExecution(_ process.ProfiledExecution) {
+}
+
+// GetReport returns ErrGasProfilingDisabled
+func (profiler *disabledGasProfiler) GetReport() (*Report, error) {
+	return nil, ErrGasProfilingDisabled
+}
+
+// SaveReport returns ErrGasProfilingDisabled
+func (profiler *disabledGasProfiler) SaveReport(_ string) error {
+	return ErrGasProfilingDisabled
+}
+
+// Reset does nothing
+func (profiler *disabledGasProfiler) Reset() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (profiler *disabledGasProfiler) IsInterfaceNil() bool {
+	return profiler == nil
+}
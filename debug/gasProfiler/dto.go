@@ -0,0 +1,28 @@
+package gasProfiler
+
+import "math/big"
+
+// Distribution holds the total and the distribution of a value over all the profiled calls
+type Distribution struct {
+	Total *big.Int `json:"total"`
+	P50   *big.Int `json:"p50"`
+	P95   *big.Int `json:"p95"`
+	Max   *big.Int `json:"max"`
+}
+
+// EndpointReport holds the aggregated gas used, fee and refund of the calls of a contract endpoint
+type EndpointReport struct {
+	Contract       string        `json:"contract"`
+	Endpoint       string        `json:"endpoint"`
+	NumCalls       int           `json:"numCalls"`
+	NumFailedCalls int           `json:"numFailedCalls"`
+	GasUsed        *Distribution `json:"gasUsed"`
+	Fee            *Distribution `json:"fee"`
+	Refund         *Distribution `json:"refund"`
+}
+
+// Report holds the gas profile of all the called contract endpoints, sorted by contract and endpoint
+type Report struct {
+	NumProfiledTransactions int               `json:"numProfiledTransactions"`
+	Endpoints               []*EndpointReport `json:"endpoints"`
+}
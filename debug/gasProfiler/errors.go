@@ -0,0 +1,12 @@
+package gasProfiler
+
+import "errors"
+
+// ErrGasProfilingDisabled signals that the gas profiling is not enabled
+var ErrGasProfilingDisabled = errors.New("gas profiling is not enabled")
+
+// ErrNilPubkeyConverter signals that a nil public key converter was provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrInvalidMaxProfiledTransactions signals that an invalid maximum number of profiled transactions was provided
+var ErrInvalidMaxProfiledTransactions = errors.New("invalid maximum number of profiled transactions")
@@ -0,0 +1,313 @@
+package gasProfiler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	// JSONReportFileName is the name of the JSON report file written by SaveReport
+	JSONReportFileName = "gasProfile.json"
+	// CSVReportFileName is the name of the CSV report file written by SaveReport
+	CSVReportFileName = "gasProfile.csv"
+)
+
+var log = logger.GetOrCreate("debug/gasprofiler")
+
+var csvHeader = []string{
+	"contract", "endpoint", "numCalls", "numFailedCalls",
+	"gasUsedTotal", "gasUsedP50", "gasUsedP95", "gasUsedMax",
+	"feeTotal", "feeP50", "feeP95", "feeMax",
+	"refundTotal", "refundP50", "refundP95", "refundMax",
+}
+
+type endpointKey struct {
+	contract string
+	endpoint string
+}
+
+type profiledTransaction struct {
+	key      endpointKey
+	isFailed bool
+	gasUsed  *big.Int
+	fee      *big.Int
+	refund   *big.Int
+}
+
+type endpointSamples struct {
+	numCalls       int
+	numFailedCalls int
+	gasUsed        []*big.Int
+	fees           []*big.Int
+	refunds        []*big.Int
+}
+
+// ArgsGasProfiler is the DTO used to create a new instance of the gas profiler
+type ArgsGasProfiler struct {
+	PubkeyConverter         core.PubkeyConverter
+	MaxProfiledTransactions int
+}
+
+type gasProfiler struct {
+	pubkeyConverter         core.PubkeyConverter
+	maxProfiledTransactions int
+
+	mut           sync.RWMutex
+	profiled      map[string]*profiledTransaction
+	profiledOrder []string
+}
+
+// NewGasProfiler creates a new gas profiler. It aggregates, per contract address and endpoint, the gas used, the fee and
+// the refund of the last MaxProfiledTransactions contract calls and deployments executed by the smart contract processors
+func NewGasProfiler(args ArgsGasProfiler) (*gasProfiler, error) {
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if args.MaxProfiledTransactions < 1 {
+		return nil, ErrInvalidMaxProfiledTransactions
+	}
+
+	return &gasProfiler{
+		pubkeyConverter:         args.PubkeyConverter,
+		maxProfiledTransactions: args.MaxProfiledTransactions,
+		profiled:                make(map[string]*profiledTransaction),
+	}, nil
+}
+
+// ProfileExecution adds the provided execution to the profile, if its transaction was not profiled before. A transaction
+// executed again, as when a block is processed by several nodes, keeps its first profile. The oldest profiled transaction
+// is evicted when the limit is reached
+func (profiler *gasProfiler) ProfileExecution(execution process.ProfiledExecution) {
+	key := endpointKey{
+		contract: profiler.pubkeyConverter.SilentEncode(execution.Contract, log),
+		endpoint: execution.Endpoint,
+	}
+	txHash := string(execution.TxHash)
+
+	profiler.mut.Lock()
+	defer profiler.mut.Unlock()
+
+	_, alreadyProfiled := profiler.profiled[txHash]
+	if alreadyProfiled {
+		return
+	}
+
+	profiler.evictOldestTransactionIfNeeded()
+	profiler.profiled[txHash] = &profiledTransaction{
+		key:      key,
+		isFailed: execution.IsFailed,
+		gasUsed:  big.NewInt(0).SetUint64(execution.GasUsed),
+		fee:      valueOrZero(execution.Fee),
+		refund:   valueOrZero(execution.Refund),
+	}
+	profiler.profiledOrder = append(profiler.profiledOrder, txHash)
+
+	log.Trace("gasProfiler.ProfileExecution", "hash", execution.TxHash, "contract", key.contract, "endpoint", key.endpoint,
+		"gas used", execution.GasUsed, "fee", execution.Fee)
+}
+
+func (profiler *gasProfiler) evictOldestTransactionIfNeeded() {
+	if len(profiler.profiledOrder) < profiler.maxProfiledTransactions {
+		return
+	}
+
+	delete(profiler.profiled, profiler.profiledOrder[0])
+	profiler.profiledOrder = profiler.profiledOrder[1:]
+}
+
+// GetReport returns the gas profile of the transactions currently held by the profiler
+func (profiler *gasProfiler) GetReport() (*Report, error) {
+	profiler.mut.RLock()
+	defer profiler.mut.RUnlock()
+
+	endpoints := make(map[endpointKey]*endpointSamples)
+	for _, hash := range profiler.profiledOrder {
+		tx := profiler.profiled[hash]
+		samples, found := endpoints[tx.key]
+		if !found {
+			samples = &endpointSamples{}
+			endpoints[tx.key] = samples
+		}
+
+		samples.numCalls++
+		if tx.isFailed {
+			samples.numFailedCalls++
+		}
+		samples.gasUsed = append(samples.gasUsed, tx.gasUsed)
+		samples.fees = append(samples.fees, tx.fee)
+		samples.refunds = append(samples.refunds, tx.refund)
+	}
+
+	report := &Report{
+		NumProfiledTransactions: len(profiler.profiledOrder),
+		Endpoints:               make([]*EndpointReport, 0, len(endpoints)),
+	}
+	for key, samples := range endpoints {
+		report.Endpoints = append(report.Endpoints, &EndpointReport{
+			Contract:       key.contract,
+			Endpoint:       key.endpoint,
+			NumCalls:       samples.numCalls,
+			NumFailedCalls: samples.numFailedCalls,
+			GasUsed:        computeDistribution(samples.gasUsed),
+			Fee:            computeDistribution(samples.fees),
+			Refund:         computeDistribution(samples.refunds),
+		})
+	}
+	sort.Slice(report.Endpoints, func(i, j int) bool {
+		if report.Endpoints[i].Contract != report.Endpoints[j].Contract {
+			return report.Endpoints[i].Contract < report.Endpoints[j].Contract
+		}
+
+		return report.Endpoints[i].Endpoint < report.Endpoints[j].Endpoint
+	})
+
+	return report, nil
+}
+
+// SaveReport writes the JSON and the CSV reports in the provided folder
+func (profiler *gasProfiler) SaveReport(folder string) error {
+	report, err := profiler.GetReport()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(folder, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	err = writeFile(filepath.Join(folder, JSONReportFileName), report, WriteJSON)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(filepath.Join(folder, CSVReportFileName), report, WriteCSV)
+}
+
+// Reset removes all the profiled transactions
+func (profiler *gasProfiler) Reset() {
+	profiler.mut.Lock()
+	profiler.profiled = make(map[string]*profiledTransaction)
+	profiler.profiledOrder = nil
+	profiler.mut.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (profiler *gasProfiler) IsInterfaceNil() bool {
+	return profiler == nil
+}
+
+// WriteJSON writes the provided report in JSON format
+func WriteJSON(writer io.Writer, report *Report) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
+
+// WriteCSV writes the provided report in CSV format, one line for each contract endpoint
+func WriteCSV(writer io.Writer, report *Report) error {
+	csvWriter := csv.NewWriter(writer)
+	err := csvWriter.Write(csvHeader)
+	if err != nil {
+		return err
+	}
+
+	for _, endpoint := range report.Endpoints {
+		record := []string{
+			endpoint.Contract,
+			endpoint.Endpoint,
+			strconv.Itoa(endpoint.NumCalls),
+			strconv.Itoa(endpoint.NumFailedCalls),
+		}
+		record = append(record, distributionToStrings(endpoint.GasUsed)...)
+		record = append(record, distributionToStrings(endpoint.Fee)...)
+		record = append(record, distributionToStrings(endpoint.Refund)...)
+
+		err = csvWriter.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+
+	return csvWriter.Error()
+}
+
+func writeFile(filePath string, report *Report, write func(writer io.Writer, report *Report) error) error {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+
+	err = write(file, report)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func computeDistribution(samples []*big.Int) *Distribution {
+	sorted := append(make([]*big.Int, 0, len(samples)), samples...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
+
+	total := big.NewInt(0)
+	for _, sample := range sorted {
+		total.Add(total, sample)
+	}
+
+	return &Distribution{
+		Total: total,
+		P50:   percentile(sorted, 50),
+		P95:   percentile(sorted, 95),
+		Max:   percentile(sorted, 100),
+	}
+}
+
+// percentile uses the nearest-rank method on the provided sorted samples
+func percentile(sorted []*big.Int, percent int) *big.Int {
+	if len(sorted) == 0 {
+		return big.NewInt(0)
+	}
+
+	rank := (percent*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return big.NewInt(0).Set(sorted[rank-1])
+}
+
+func distributionToStrings(distribution *Distribution) []string {
+	return []string{
+		distribution.Total.String(),
+		distribution.P50.String(),
+		distribution.P95.String(),
+		distribution.Max.String(),
+	}
+}
+
+func valueOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(value)
+}
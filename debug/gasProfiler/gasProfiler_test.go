@@ -0,0 +1,231 @@
+package gasProfiler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	contract        = []byte("contract")
+	encodedContract = hex.EncodeToString(contract)
+)
+
+func createContractCall(hash string, endpoint string, gasUsed uint64, fee int64, initiallyPaidFee int64) process.ProfiledExecution {
+	return process.ProfiledExecution{
+		TxHash:   []byte(hash),
+		Contract: contract,
+		Endpoint: endpoint,
+		GasUsed:  gasUsed,
+		Fee:      big.NewInt(fee),
+		Refund:   big.NewInt(initiallyPaidFee - fee),
+	}
+}
+
+func createGasProfiler(t *testing.T, maxProfiledTransactions int) *gasProfiler {
+	profiler, err := NewGasProfiler(ArgsGasProfiler{
+		PubkeyConverter:         testscommon.NewPubkeyConverterMock(32),
+		MaxProfiledTransactions: maxProfiledTransactions,
+	})
+	require.Nil(t, err)
+
+	return profiler
+}
+
+func TestNewGasProfiler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		profiler, err := NewGasProfiler(ArgsGasProfiler{MaxProfiledTransactions: 1})
+		require.Equal(t, ErrNilPubkeyConverter, err)
+		require.Nil(t, profiler)
+	})
+	t.Run("invalid max profiled transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		profiler, err := NewGasProfiler(ArgsGasProfiler{PubkeyConverter: testscommon.NewPubkeyConverterMock(32)})
+		require.Equal(t, ErrInvalidMaxProfiledTransactions, err)
+		require.Nil(t, profiler)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		profiler, err := NewGasProfiler(ArgsGasProfiler{
+			PubkeyConverter:         testscommon.NewPubkeyConverterMock(32),
+			MaxProfiledTransactions: 1,
+		})
+		require.Nil(t, err)
+		require.False(t, profiler.IsInterfaceNil())
+	})
+}
+
+func TestGasProfiler_ProfileExecution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should aggregate per contract and endpoint", func(t *testing.T) {
+		t.Parallel()
+
+		profiler := createGasProfiler(t, 100)
+		for i := 1; i <= 20; i++ {
+			profiler.ProfileExecution(createContractCall(fmt.Sprintf("add%d", i), "add", uint64(i*100), int64(i*1000), 25000))
+		}
+		// the same transaction executed again should not be counted twice
+		profiler.ProfileExecution(createContractCall("add1", "add", 200, 2000, 25000))
+
+		failed := createContractCall("failed", "remove", 500, 5000, 5000)
+		failed.IsFailed = true
+		profiler.ProfileExecution(failed)
+
+		deploy := createContractCall("deploy", "deploy", 900, 9000, 9000)
+		deploy.Contract = []byte("deployed")
+		deploy.Refund = nil
+		profiler.ProfileExecution(deploy)
+
+		report, err := profiler.GetReport()
+		require.Nil(t, err)
+		require.Equal(t, 22, report.NumProfiledTransactions)
+		require.Equal(t, 3, len(report.Endpoints))
+
+		expectedAdd := &EndpointReport{
+			Contract: encodedContract,
+			Endpoint: "add",
+			NumCalls: 20,
+			GasUsed: &Distribution{
+				Total: big.NewInt(21000),
+				P50:   big.NewInt(1000),
+				P95:   big.NewInt(1900),
+				Max:   big.NewInt(2000),
+			},
+			Fee: &Distribution{
+				Total: big.NewInt(210000),
+				P50:   big.NewInt(10000),
+				P95:   big.NewInt(19000),
+				Max:   big.NewInt(20000),
+			},
+			Refund: &Distribution{
+				Total: big.NewInt(290000),
+				P50:   big.NewInt(14000),
+				P95:   big.NewInt(23000),
+				Max:   big.NewInt(24000),
+			},
+		}
+		require.Equal(t, expectedAdd, report.Endpoints[0])
+
+		require.Equal(t, encodedContract, report.Endpoints[1].Contract)
+		require.Equal(t, "remove", report.Endpoints[1].Endpoint)
+		require.Equal(t, 1, report.Endpoints[1].NumFailedCalls)
+		require.Equal(t, big.NewInt(0), report.Endpoints[1].Refund.Max)
+
+		require.Equal(t, hex.EncodeToString([]byte("deployed")), report.Endpoints[2].Contract)
+		require.Equal(t, "deploy", report.Endpoints[2].Endpoint)
+		require.Equal(t, big.NewInt(0), report.Endpoints[2].Refund.Total)
+
+		profiler.Reset()
+		report, _ = profiler.GetReport()
+		require.Equal(t, 0, report.NumProfiledTransactions)
+		require.Empty(t, report.Endpoints)
+	})
+	t.Run("should evict the oldest transactions", func(t *testing.T) {
+		t.Parallel()
+
+		profiler := createGasProfiler(t, 2)
+		profiler.ProfileExecution(createContractCall("add", "add", 100, 1000, 1000))
+		profiler.ProfileExecution(createContractCall("remove1", "remove", 200, 2000, 2000))
+		profiler.ProfileExecution(createContractCall("remove2", "remove", 300, 3000, 3000))
+		// an evicted transaction executed again is profiled again
+		profiler.ProfileExecution(createContractCall("add", "add", 100, 1000, 1000))
+
+		report, err := profiler.GetReport()
+		require.Nil(t, err)
+		require.Equal(t, 2, report.NumProfiledTransactions)
+		require.Equal(t, 2, len(report.Endpoints))
+		require.Equal(t, "add", report.Endpoints[0].Endpoint)
+		require.Equal(t, 1, report.Endpoints[0].NumCalls)
+		require.Equal(t, "remove", report.Endpoints[1].Endpoint)
+		require.Equal(t, 1, report.Endpoints[1].NumCalls)
+		require.Equal(t, big.NewInt(300), report.Endpoints[1].GasUsed.Total)
+	})
+}
+
+func TestGasProfiler_SaveReport(t *testing.T) {
+	t.Parallel()
+
+	profiler := createGasProfiler(t, 100)
+	profiler.ProfileExecution(createContractCall("hash1", "add", 100, 1000, 1500))
+	profiler.ProfileExecution(createContractCall("hash2", "add", 300, 3000, 3000))
+
+	folder := filepath.Join(t.TempDir(), "reports")
+	err := profiler.SaveReport(folder)
+	require.Nil(t, err)
+
+	jsonContent, err := os.ReadFile(filepath.Join(folder, JSONReportFileName))
+	require.Nil(t, err)
+	report := &Report{}
+	err = json.Unmarshal(jsonContent, report)
+	require.Nil(t, err)
+	require.Equal(t, 2, report.NumProfiledTransactions)
+	require.Equal(t, big.NewInt(400), report.Endpoints[0].GasUsed.Total)
+
+	csvContent, err := os.ReadFile(filepath.Join(folder, CSVReportFileName))
+	require.Nil(t, err)
+	records, err := csv.NewReader(bytes.NewReader(csvContent)).ReadAll()
+	require.Nil(t, err)
+	require.Equal(t, [][]string{
+		csvHeader,
+		{encodedContract, "add", "2", "0", "400", "100", "300", "300", "4000", "1000", "3000", "3000", "500", "0", "500", "500"},
+	}, records)
+}
+
+func TestGasProfiler_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	profiler := createGasProfiler(t, 100)
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 3 {
+			case 0:
+				profiler.ProfileExecution(createContractCall(fmt.Sprintf("hash%d", idx), "add", 100, 1000, 1000))
+			case 1:
+				_, _ = profiler.GetReport()
+			default:
+				_ = WriteCSV(&bytes.Buffer{}, &Report{})
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+func TestDisabledGasProfiler(t *testing.T) {
+	t.Parallel()
+
+	profiler := NewDisabledGasProfiler()
+	require.False(t, profiler.IsInterfaceNil())
+
+	profiler.ProfileExecution(createContractCall("hash", "add", 100, 1000, 1000))
+	profiler.Reset()
+
+	report, err := profiler.GetReport()
+	require.Equal(t, ErrGasProfilingDisabled, err)
+	require.Nil(t, report)
+	require.Equal(t, ErrGasProfilingDisabled, profiler.SaveReport(t.TempDir()))
+}
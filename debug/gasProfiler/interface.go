@@ -0,0 +1,12 @@
+package gasProfiler
+
+import "github.com/multiversx/mx-chain-go/process"
+
+// GasProfilerHandler defines the operations of a component aggregating the gas used and the fees of the contract calls
+type GasProfilerHandler interface {
+	ProfileExecution(execution process.ProfiledExecution)
+	GetReport() (*Report, error)
+	SaveReport(folder string) error
+	Reset()
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,311 @@
+package wasmCoverage
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// LcovReportFileName is the name of the lcov report file written by SaveReport
+const LcovReportFileName = "wasmCoverage.lcov"
+
+var log = logger.GetOrCreate("debug/wasmcoverage")
+
+type contractCoverage struct {
+	codeHash         []byte
+	module           *wasmModule
+	instrumentedCode []byte
+	probes           []coverageProbe
+	probeHits        []uint64
+	err              error
+}
+
+type coverageCollector struct {
+	mut           sync.RWMutex
+	contracts     map[string]*contractCoverage
+	contractsByID []*contractCoverage
+}
+
+// NewCoverageCollector creates a new coverage collector. The contract codes run by the executors it creates are
+// instrumented with probes recording, per contract code hash, the executions of the functions and the taken arms of
+// the branches
+func NewCoverageCollector() *coverageCollector {
+	return &coverageCollector{
+		contracts: make(map[string]*contractCoverage),
+	}
+}
+
+// CreateExecutorFactory returns a factory of executors running the instrumented contract codes created by the provided
+// factory. The hasher must be the one computing the contract code hashes
+func (collector *coverageCollector) CreateExecutorFactory(
+	executorFactory executor.ExecutorAbstractFactory,
+	hasher hashing.Hasher,
+) (executor.ExecutorAbstractFactory, error) {
+	if check.IfNil(executorFactory) {
+		return nil, ErrNilExecutorFactory
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &coverageExecutorFactory{
+		executorFactory: executorFactory,
+		hasher:          hasher,
+		recorder:        collector,
+	}, nil
+}
+
+// instrumentContractCode returns the instrumented contract code, instrumenting it on its first use. The contract codes
+// shared by several executors are instrumented only once, so their probes hold the same contract ID
+func (collector *coverageCollector) instrumentContractCode(codeHash []byte, code []byte) ([]byte, error) {
+	collector.mut.RLock()
+	contract, found := collector.contracts[string(codeHash)]
+	collector.mut.RUnlock()
+	if found {
+		return contract.instrumentedCode, contract.err
+	}
+
+	collector.mut.Lock()
+	defer collector.mut.Unlock()
+
+	contract, found = collector.contracts[string(codeHash)]
+	if found {
+		return contract.instrumentedCode, contract.err
+	}
+
+	contract = collector.createContractCoverage(codeHash, code)
+	collector.contracts[string(codeHash)] = contract
+
+	return contract.instrumentedCode, contract.err
+}
+
+func (collector *coverageCollector) createContractCoverage(codeHash []byte, code []byte) *contractCoverage {
+	contract := &contractCoverage{
+		codeHash: codeHash,
+	}
+	if len(collector.contractsByID) >= math.MaxInt32 {
+		contract.err = fmt.Errorf("%w: too many contract codes", ErrUnsupportedWasmModule)
+		return contract
+	}
+
+	contract.module, contract.err = parseWasmModule(code)
+	if contract.err != nil {
+		return contract
+	}
+
+	instrumented, err := instrumentWasmModule(code, int32(len(collector.contractsByID)))
+	if err != nil {
+		contract.err = err
+		return contract
+	}
+
+	contract.instrumentedCode = instrumented.code
+	contract.probes = instrumented.probes
+	contract.probeHits = make([]uint64, len(instrumented.probes))
+	collector.contractsByID = append(collector.contractsByID, contract)
+
+	return contract
+}
+
+// recordProbe records the execution of a probe. It returns false if the probe is unknown
+func (collector *coverageCollector) recordProbe(contractID int32, probeID int64) bool {
+	collector.mut.RLock()
+	defer collector.mut.RUnlock()
+
+	if contractID < 0 || int(contractID) >= len(collector.contractsByID) {
+		return false
+	}
+	contract := collector.contractsByID[contractID]
+	if probeID < 0 || probeID >= int64(len(contract.probeHits)) {
+		return false
+	}
+
+	atomic.AddUint64(&contract.probeHits[probeID], 1)
+
+	return true
+}
+
+// GetReport returns the coverage of the contract codes executed since the creation or the last reset
+func (collector *coverageCollector) GetReport() (*Report, error) {
+	collector.mut.RLock()
+	defer collector.mut.RUnlock()
+
+	report := &Report{
+		Contracts: make([]*ContractCoverage, 0, len(collector.contractsByID)),
+	}
+	for _, contract := range collector.contractsByID {
+		coverage := createContractCoverageReport(contract)
+		if coverage.NumExecutedFunctions == 0 {
+			continue
+		}
+
+		report.Contracts = append(report.Contracts, coverage)
+	}
+	sort.Slice(report.Contracts, func(i, j int) bool {
+		return report.Contracts[i].CodeHash < report.Contracts[j].CodeHash
+	})
+
+	return report, nil
+}
+
+// SaveReport writes the lcov report in the provided folder
+func (collector *coverageCollector) SaveReport(folder string) error {
+	report, err := collector.GetReport()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(folder, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(filepath.Join(folder, LcovReportFileName))
+	if err != nil {
+		return err
+	}
+
+	err = WriteLcov(file, report)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// Reset removes the recorded executions. The contract codes stay instrumented, as the VMs keep their instances
+func (collector *coverageCollector) Reset() {
+	collector.mut.RLock()
+	defer collector.mut.RUnlock()
+
+	for _, contract := range collector.contractsByID {
+		for i := range contract.probeHits {
+			atomic.StoreUint64(&contract.probeHits[i], 0)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (collector *coverageCollector) IsInterfaceNil() bool {
+	return collector == nil
+}
+
+// WriteLcov writes the provided report in the lcov tracefile format, one record for each contract code. The source
+// file of a record is the hex encoded code hash and the line of a function, and of its branches, is its index in the
+// Wasm function index space plus one. A branch is reported as not reached, with "-", if no arm of its block was taken
+func WriteLcov(writer io.Writer, report *Report) error {
+	for _, contract := range report.Contracts {
+		err := writeLcovRecord(writer, contract)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeLcovRecord(writer io.Writer, contract *ContractCoverage) error {
+	lines := make([]string, 0, 2*len(contract.Functions)+len(contract.Branches)+7)
+	lines = append(lines, "TN:", "SF:"+contract.CodeHash)
+	for _, function := range contract.Functions {
+		lines = append(lines, fmt.Sprintf("FN:%d,%s", function.Index+1, function.Name))
+	}
+	for _, function := range contract.Functions {
+		lines = append(lines, fmt.Sprintf("FNDA:%d,%s", function.NumCalls, function.Name))
+	}
+	lines = append(lines,
+		fmt.Sprintf("FNF:%d", contract.NumFunctions),
+		fmt.Sprintf("FNH:%d", contract.NumExecutedFunctions),
+	)
+
+	type blockKey struct {
+		functionIndex uint32
+		block         uint32
+	}
+	blockHits := make(map[blockKey]uint64)
+	for _, branch := range contract.Branches {
+		blockHits[blockKey{branch.FunctionIndex, branch.Block}] += branch.NumTaken
+	}
+	for _, branch := range contract.Branches {
+		numTaken := fmt.Sprintf("%d", branch.NumTaken)
+		if blockHits[blockKey{branch.FunctionIndex, branch.Block}] == 0 {
+			numTaken = "-"
+		}
+		lines = append(lines, fmt.Sprintf("BRDA:%d,%d,%d,%s", branch.FunctionIndex+1, branch.Block, branch.Branch, numTaken))
+	}
+	lines = append(lines,
+		fmt.Sprintf("BRF:%d", contract.NumBranches),
+		fmt.Sprintf("BRH:%d", contract.NumTakenBranches),
+		"end_of_record",
+	)
+
+	for _, line := range lines {
+		_, err := fmt.Fprintln(writer, line)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createContractCoverageReport(contract *contractCoverage) *ContractCoverage {
+	coverage := &ContractCoverage{
+		CodeHash:  hex.EncodeToString(contract.codeHash),
+		Functions: make([]*FunctionCoverage, 0, contract.module.numDefinedFunctions),
+		Branches:  make([]*BranchCoverage, 0, len(contract.probes)),
+	}
+
+	exportNames := contract.module.exportNamesByIndex()
+	for probeID, probe := range contract.probes {
+		numHits := atomic.LoadUint64(&contract.probeHits[probeID])
+		if probe.isBranch {
+			coverage.Branches = append(coverage.Branches, &BranchCoverage{
+				FunctionIndex: probe.functionIndex,
+				Block:         probe.block,
+				Branch:        probe.branch,
+				NumTaken:      numHits,
+			})
+			if numHits > 0 {
+				coverage.NumTakenBranches++
+			}
+			continue
+		}
+
+		coverage.Functions = append(coverage.Functions, &FunctionCoverage{
+			Index:    probe.functionIndex,
+			Name:     contract.module.functionName(probe.functionIndex, exportNames[probe.functionIndex]),
+			NumCalls: numHits,
+		})
+		if numHits > 0 {
+			coverage.NumExecutedFunctions++
+		}
+	}
+	sort.Slice(coverage.Branches, func(i, j int) bool {
+		first, second := coverage.Branches[i], coverage.Branches[j]
+		if first.FunctionIndex != second.FunctionIndex {
+			return first.FunctionIndex < second.FunctionIndex
+		}
+		if first.Block != second.Block {
+			return first.Block < second.Block
+		}
+
+		return first.Branch < second.Branch
+	})
+	coverage.NumFunctions = len(coverage.Functions)
+	coverage.NumBranches = len(coverage.Branches)
+
+	return coverage
+}
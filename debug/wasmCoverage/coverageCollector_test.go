@@ -0,0 +1,231 @@
+package wasmCoverage
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-vm-go/wasmer2"
+	"github.com/stretchr/testify/require"
+)
+
+var testCodeHash = []byte("test code hash")
+
+// recordExecutableTestModuleProbes records the probes hit by a call of the main function of the executable test module
+func recordExecutableTestModuleProbes(collector *coverageCollector, contractID int32) {
+	for _, probeID := range []int64{0, 1, 3, 5, 1, 2, 6, 9} {
+		collector.recordProbe(contractID, probeID)
+	}
+}
+
+func TestCoverageCollector_CreateExecutorFactory(t *testing.T) {
+	t.Parallel()
+
+	collector := NewCoverageCollector()
+	executorFactory, err := collector.CreateExecutorFactory(nil, &hashingMocks.HasherMock{})
+	require.Equal(t, ErrNilExecutorFactory, err)
+	require.Nil(t, executorFactory)
+
+	executorFactory, err = collector.CreateExecutorFactory(wasmer2.ExecutorFactory(), nil)
+	require.Equal(t, ErrNilHasher, err)
+	require.Nil(t, executorFactory)
+
+	executorFactory, err = collector.CreateExecutorFactory(wasmer2.ExecutorFactory(), &hashingMocks.HasherMock{})
+	require.Nil(t, err)
+	require.False(t, executorFactory.IsInterfaceNil())
+}
+
+func TestCoverageCollector_InstrumentContractCode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid code should error and not be reported", func(t *testing.T) {
+		t.Parallel()
+
+		collector := NewCoverageCollector()
+		instrumentedCode, err := collector.instrumentContractCode(testCodeHash, []byte("invalid code"))
+		require.True(t, errors.Is(err, ErrInvalidWasmModule))
+		require.Nil(t, instrumentedCode)
+
+		// the error is remembered and no contract ID is used
+		instrumentedCode, err = collector.instrumentContractCode(testCodeHash, createExecutableTestModule())
+		require.True(t, errors.Is(err, ErrInvalidWasmModule))
+		require.Nil(t, instrumentedCode)
+		require.Empty(t, collector.contractsByID)
+
+		report, err := collector.GetReport()
+		require.Nil(t, err)
+		require.Empty(t, report.Contracts)
+	})
+	t.Run("code instrumented twice should keep the contract ID", func(t *testing.T) {
+		t.Parallel()
+
+		collector := NewCoverageCollector()
+		firstCode, err := collector.instrumentContractCode(testCodeHash, createExecutableTestModule())
+		require.Nil(t, err)
+		secondCode, err := collector.instrumentContractCode(testCodeHash, createExecutableTestModule())
+		require.Nil(t, err)
+		require.Equal(t, firstCode, secondCode)
+
+		otherCode, err := collector.instrumentContractCode([]byte("other code hash"), createExecutableTestModule())
+		require.Nil(t, err)
+		require.NotEqual(t, firstCode, otherCode)
+		require.Len(t, collector.contractsByID, 2)
+	})
+}
+
+func TestCoverageCollector_RecordProbe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown probes should not be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		collector := NewCoverageCollector()
+		_, err := collector.instrumentContractCode(testCodeHash, createExecutableTestModule())
+		require.Nil(t, err)
+
+		require.False(t, collector.recordProbe(-1, 0))
+		require.False(t, collector.recordProbe(1, 0))
+		require.False(t, collector.recordProbe(0, -1))
+		require.False(t, collector.recordProbe(0, 11))
+		require.True(t, collector.recordProbe(0, 10))
+	})
+	t.Run("concurrent calls should work", func(t *testing.T) {
+		t.Parallel()
+
+		collector := NewCoverageCollector()
+		numCalls := 100
+		wg := sync.WaitGroup{}
+		wg.Add(numCalls)
+		for i := 0; i < numCalls; i++ {
+			go func(idx int) {
+				defer wg.Done()
+
+				codeHash := []byte(fmt.Sprintf("code hash %d", idx%5))
+				_, _ = collector.instrumentContractCode(codeHash, createExecutableTestModule())
+				collector.recordProbe(int32(idx%5), 1)
+				_, _ = collector.GetReport()
+				collector.Reset()
+			}(i)
+		}
+		wg.Wait()
+
+		require.Len(t, collector.contractsByID, 5)
+		report, err := collector.GetReport()
+		require.Nil(t, err)
+		require.Empty(t, report.Contracts)
+	})
+}
+
+func TestCoverageCollector_ReportsAndReset(t *testing.T) {
+	t.Parallel()
+
+	collector := NewCoverageCollector()
+	_, err := collector.instrumentContractCode([]byte("not executed code hash"), createExecutableTestModule())
+	require.Nil(t, err)
+	_, err = collector.instrumentContractCode(testCodeHash, createExecutableTestModule())
+	require.Nil(t, err)
+	recordExecutableTestModuleProbes(collector, 1)
+
+	report, err := collector.GetReport()
+	require.Nil(t, err)
+	expectedReport := &Report{
+		Contracts: []*ContractCoverage{
+			{
+				CodeHash:             hex.EncodeToString(testCodeHash),
+				NumFunctions:         4,
+				NumExecutedFunctions: 3,
+				NumBranches:          7,
+				NumTakenBranches:     4,
+				Functions: []*FunctionCoverage{
+					{Index: 0, Name: "main", NumCalls: 1},
+					{Index: 1, Name: "classify", NumCalls: 2},
+					{Index: 2, Name: "choose", NumCalls: 1},
+					{Index: 3, Name: "func_3", NumCalls: 0},
+				},
+				Branches: []*BranchCoverage{
+					{FunctionIndex: 1, Block: 0, Branch: 0, NumTaken: 1},
+					{FunctionIndex: 1, Block: 0, Branch: 1, NumTaken: 1},
+					{FunctionIndex: 1, Block: 1, Branch: 0, NumTaken: 0},
+					{FunctionIndex: 1, Block: 1, Branch: 1, NumTaken: 1},
+					{FunctionIndex: 2, Block: 0, Branch: 0, NumTaken: 0},
+					{FunctionIndex: 2, Block: 0, Branch: 1, NumTaken: 0},
+					{FunctionIndex: 2, Block: 0, Branch: 2, NumTaken: 1},
+				},
+			},
+		},
+	}
+	require.Equal(t, expectedReport, report)
+
+	report.Contracts[0].Branches[6].NumTaken = 0
+	buff := &bytes.Buffer{}
+	err = WriteLcov(buff, report)
+	require.Nil(t, err)
+	expectedLcov := "TN:\n" +
+		"SF:" + hex.EncodeToString(testCodeHash) + "\n" +
+		"FN:1,main\n" +
+		"FN:2,classify\n" +
+		"FN:3,choose\n" +
+		"FN:4,func_3\n" +
+		"FNDA:1,main\n" +
+		"FNDA:2,classify\n" +
+		"FNDA:1,choose\n" +
+		"FNDA:0,func_3\n" +
+		"FNF:4\n" +
+		"FNH:3\n" +
+		"BRDA:2,0,0,1\n" +
+		"BRDA:2,0,1,1\n" +
+		"BRDA:2,1,0,0\n" +
+		"BRDA:2,1,1,1\n" +
+		"BRDA:3,0,0,-\n" +
+		"BRDA:3,0,1,-\n" +
+		"BRDA:3,0,2,-\n" +
+		"BRF:7\n" +
+		"BRH:4\n" +
+		"end_of_record\n"
+	require.Equal(t, expectedLcov, buff.String())
+
+	folder := filepath.Join(t.TempDir(), "stats")
+	err = collector.SaveReport(folder)
+	require.Nil(t, err)
+	savedLcov, err := os.ReadFile(filepath.Join(folder, LcovReportFileName))
+	require.Nil(t, err)
+	require.Contains(t, string(savedLcov), "BRDA:3,0,2,1\n")
+
+	collector.Reset()
+	report, err = collector.GetReport()
+	require.Nil(t, err)
+	require.Empty(t, report.Contracts)
+
+	// the contract codes stay instrumented after the reset
+	require.True(t, collector.recordProbe(1, 0))
+	report, err = collector.GetReport()
+	require.Nil(t, err)
+	require.Len(t, report.Contracts, 1)
+	require.Equal(t, 1, report.Contracts[0].NumExecutedFunctions)
+}
+
+func TestDisabledCoverageCollector(t *testing.T) {
+	t.Parallel()
+
+	collector := NewDisabledCoverageCollector()
+	require.False(t, collector.IsInterfaceNil())
+
+	executorFactory := wasmer2.ExecutorFactory()
+	createdFactory, err := collector.CreateExecutorFactory(executorFactory, &hashingMocks.HasherMock{})
+	require.Nil(t, err)
+	require.True(t, executorFactory == createdFactory)
+	collector.Reset()
+
+	report, err := collector.GetReport()
+	require.Equal(t, ErrWasmCoverageDisabled, err)
+	require.Nil(t, report)
+
+	err = collector.SaveReport(t.TempDir())
+	require.Equal(t, ErrWasmCoverageDisabled, err)
+}
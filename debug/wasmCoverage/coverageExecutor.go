@@ -0,0 +1,152 @@
+package wasmCoverage
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// compiledCodeMarker prefixes the code hash handed to the VM instead of the compiled instrumented code. The VM stores
+// the compiled codes, possibly on disk, and the instrumented ones must not be used by a VM without coverage
+var compiledCodeMarker = []byte("wasmCoverage:")
+
+type coverageExecutorFactory struct {
+	executorFactory executor.ExecutorAbstractFactory
+	hasher          hashing.Hasher
+	recorder        probeRecorder
+}
+
+// CreateExecutor creates an executor running the instrumented contract codes, with VM hooks recording the probes
+func (factory *coverageExecutorFactory) CreateExecutor(args executor.ExecutorFactoryArgs) (executor.Executor, error) {
+	args.VMHooks = &coverageVMHooks{
+		VMHooks:  args.VMHooks,
+		recorder: factory.recorder,
+	}
+	wrappedExecutor, err := factory.executorFactory.CreateExecutor(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coverageExecutor{
+		Executor:      wrappedExecutor,
+		hasher:        factory.hasher,
+		recorder:      factory.recorder,
+		compiledCodes: make(map[string][]byte),
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (factory *coverageExecutorFactory) IsInterfaceNil() bool {
+	return factory == nil
+}
+
+type coverageExecutor struct {
+	executor.Executor
+	hasher           hashing.Hasher
+	recorder         probeRecorder
+	mutCompiledCodes sync.RWMutex
+	compiledCodes    map[string][]byte
+}
+
+// NewInstanceWithOptions creates an instance of the instrumented contract code. The contract code is used as it is if
+// it cannot be instrumented, so its coverage is not recorded
+func (exec *coverageExecutor) NewInstanceWithOptions(contractCode []byte, options executor.CompilationOptions) (executor.Instance, error) {
+	codeHash := exec.hasher.Compute(string(contractCode))
+	instrumentedCode, err := exec.recorder.instrumentContractCode(codeHash, contractCode)
+	if err != nil {
+		log.Debug("coverageExecutor.NewInstanceWithOptions: contract code not instrumented",
+			"code hash", codeHash, "error", err)
+		instrumentedCode = contractCode
+	}
+
+	instance, err := exec.Executor.NewInstanceWithOptions(instrumentedCode, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return exec.newCoverageInstance(instance, codeHash), nil
+}
+
+// NewInstanceFromCompiledCodeWithOptions creates an instance from the compiled code kept by the executor for the code
+// hash found in the provided compiled code. It errors on the compiled codes not produced by the executor, so the VM
+// creates the instance from the contract code
+func (exec *coverageExecutor) NewInstanceFromCompiledCodeWithOptions(compiledCode []byte, options executor.CompilationOptions) (executor.Instance, error) {
+	codeHash, isMarked := bytes.CutPrefix(compiledCode, compiledCodeMarker)
+	if !isMarked {
+		return nil, ErrCompiledCodeNotFound
+	}
+
+	exec.mutCompiledCodes.RLock()
+	wrappedCompiledCode, found := exec.compiledCodes[string(codeHash)]
+	exec.mutCompiledCodes.RUnlock()
+	if !found {
+		return nil, ErrCompiledCodeNotFound
+	}
+
+	instance, err := exec.Executor.NewInstanceFromCompiledCodeWithOptions(wrappedCompiledCode, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return exec.newCoverageInstance(instance, codeHash), nil
+}
+
+func (exec *coverageExecutor) newCoverageInstance(instance executor.Instance, codeHash []byte) *coverageInstance {
+	return &coverageInstance{
+		Instance: instance,
+		codeHash: codeHash,
+		executor: exec,
+	}
+}
+
+func (exec *coverageExecutor) saveCompiledCode(codeHash []byte, compiledCode []byte) {
+	exec.mutCompiledCodes.Lock()
+	exec.compiledCodes[string(codeHash)] = compiledCode
+	exec.mutCompiledCodes.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (exec *coverageExecutor) IsInterfaceNil() bool {
+	return exec == nil
+}
+
+type coverageInstance struct {
+	executor.Instance
+	codeHash []byte
+	executor *coverageExecutor
+}
+
+// Cache keeps the compiled code in the executor and returns the marked code hash in its place
+func (inst *coverageInstance) Cache() ([]byte, error) {
+	compiledCode, err := inst.Instance.Cache()
+	if err != nil {
+		return nil, err
+	}
+
+	inst.executor.saveCompiledCode(inst.codeHash, compiledCode)
+	markedCodeHash := make([]byte, 0, len(compiledCodeMarker)+len(inst.codeHash))
+	markedCodeHash = append(markedCodeHash, compiledCodeMarker...)
+
+	return append(markedCodeHash, inst.codeHash...), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (inst *coverageInstance) IsInterfaceNil() bool {
+	return inst == nil
+}
+
+type coverageVMHooks struct {
+	executor.VMHooks
+	recorder probeRecorder
+}
+
+// Int64storageStore records the probes of the instrumented contract codes and forwards the other calls
+func (hooks *coverageVMHooks) Int64storageStore(keyOffset executor.MemPtr, keyLength executor.MemLength, value int64) int32 {
+	if keyLength == probeKeyLength && hooks.recorder.recordProbe(int32(keyOffset), value) {
+		return 0
+	}
+
+	return hooks.VMHooks.Int64storageStore(keyOffset, keyLength, value)
+}
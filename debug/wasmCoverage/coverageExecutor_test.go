@@ -0,0 +1,125 @@
+//go:build !race
+
+package wasmCoverage
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/wasmer2"
+	"github.com/stretchr/testify/require"
+)
+
+type storageStoreVMHooksStub struct {
+	executor.VMHooks
+	numCalls int
+}
+
+func (stub *storageStoreVMHooksStub) Int64storageStore(_ executor.MemPtr, _ executor.MemLength, _ int64) int32 {
+	stub.numCalls++
+	return 1
+}
+
+func createCoverageExecutor(t *testing.T, collector *coverageCollector, vmHooks executor.VMHooks) executor.Executor {
+	executorFactory, err := collector.CreateExecutorFactory(wasmer2.ExecutorFactory(), &hashingMocks.HasherMock{})
+	require.Nil(t, err)
+
+	coverageExecutor, err := executorFactory.CreateExecutor(executor.ExecutorFactoryArgs{VMHooks: vmHooks})
+	require.Nil(t, err)
+
+	return coverageExecutor
+}
+
+func TestCoverageExecutor(t *testing.T) {
+	if runtime.GOARCH == "arm64" {
+		t.Skip("skipping test on arm64")
+	}
+	t.Parallel()
+
+	options := executor.CompilationOptions{
+		GasLimit:           1_000_000,
+		Metering:           true,
+		RuntimeBreakpoints: true,
+	}
+
+	t.Run("executed instrumented code should record the probes", func(t *testing.T) {
+		t.Parallel()
+
+		collector := NewCoverageCollector()
+		coverageExecutor := createCoverageExecutor(t, collector, &storageStoreVMHooksStub{})
+
+		code := createExecutableTestModule()
+		instance, err := coverageExecutor.NewInstanceWithOptions(code, options)
+		require.Nil(t, err)
+		instance.SetGasLimit(options.GasLimit)
+		err = instance.CallFunction("main")
+		require.Nil(t, err)
+
+		expectedCollector := NewCoverageCollector()
+		_, err = expectedCollector.instrumentContractCode((&hashingMocks.HasherMock{}).Compute(string(code)), code)
+		require.Nil(t, err)
+		recordExecutableTestModuleProbes(expectedCollector, 0)
+		expectedReport, _ := expectedCollector.GetReport()
+		report, err := collector.GetReport()
+		require.Nil(t, err)
+		require.Equal(t, expectedReport, report)
+	})
+	t.Run("compiled code should be kept by the executor", func(t *testing.T) {
+		t.Parallel()
+
+		coverageExecutor := createCoverageExecutor(t, NewCoverageCollector(), &storageStoreVMHooksStub{})
+		code := createExecutableTestModule()
+		instance, err := coverageExecutor.NewInstanceWithOptions(code, options)
+		require.Nil(t, err)
+
+		compiledCode, err := instance.Cache()
+		require.Nil(t, err)
+		codeHash := (&hashingMocks.HasherMock{}).Compute(string(code))
+		require.Equal(t, append(append([]byte{}, compiledCodeMarker...), codeHash...), compiledCode)
+
+		instance, err = coverageExecutor.NewInstanceFromCompiledCodeWithOptions(compiledCode, options)
+		require.Nil(t, err)
+		require.True(t, instance.HasFunction("main"))
+
+		instance, err = coverageExecutor.NewInstanceFromCompiledCodeWithOptions(append(compiledCodeMarker, []byte("unknown")...), options)
+		require.Equal(t, ErrCompiledCodeNotFound, err)
+		require.Nil(t, instance)
+
+		instance, err = coverageExecutor.NewInstanceFromCompiledCodeWithOptions([]byte("compiled without coverage"), options)
+		require.Equal(t, ErrCompiledCodeNotFound, err)
+		require.Nil(t, instance)
+	})
+	t.Run("code which cannot be instrumented should be compiled as it is", func(t *testing.T) {
+		t.Parallel()
+
+		collector := NewCoverageCollector()
+		coverageExecutor := createCoverageExecutor(t, collector, &storageStoreVMHooksStub{})
+
+		// the module imports the probe hook with another type, so it reaches wasmer unchanged, which rejects the import
+		code := createTestModuleWithFunctionImport(probeHookName, []byte{funcTypeForm, 0x00, 0x00})
+		instance, err := coverageExecutor.NewInstanceWithOptions(code, options)
+		require.ErrorContains(t, err, "incompatible import type")
+		require.Nil(t, instance)
+		require.Len(t, collector.contracts, 1)
+		require.Empty(t, collector.contractsByID)
+	})
+	t.Run("storage calls which are not probes should be forwarded", func(t *testing.T) {
+		t.Parallel()
+
+		vmHooks := &storageStoreVMHooksStub{}
+		collector := NewCoverageCollector()
+		_, err := collector.instrumentContractCode(testCodeHash, createExecutableTestModule())
+		require.Nil(t, err)
+		coverageHooks := &coverageVMHooks{
+			VMHooks:  vmHooks,
+			recorder: collector,
+		}
+
+		require.Equal(t, int32(0), coverageHooks.Int64storageStore(0, probeKeyLength, 1))
+		require.Equal(t, int32(1), coverageHooks.Int64storageStore(0, 32, 1))
+		require.Equal(t, int32(1), coverageHooks.Int64storageStore(1, probeKeyLength, 1))
+		require.Equal(t, 2, vmHooks.numCalls)
+	})
+}
@@ -0,0 +1,38 @@
+package wasmCoverage
+
+import (
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+type disabledCoverageCollector struct {
+}
+
+// NewDisabledCoverageCollector returns a new instance of a coverage collector which does nothing
+func NewDisabledCoverageCollector() *disabledCoverageCollector {
+	return &disabledCoverageCollector{}
+}
+
+// CreateExecutorFactory returns the provided executor factory, so the contract codes are not instrumented
+func (collector *disabledCoverageCollector) CreateExecutorFactory(executorFactory executor.ExecutorAbstractFactory, _ hashing.Hasher) (executor.ExecutorAbstractFactory, error) {
+	return executorFactory, nil
+}
+
+// GetReport returns ErrWasmCoverageDisabled
+func (collector *disabledCoverageCollector) GetReport() (*Report, error) {
+	return nil, ErrWasmCoverageDisabled
+}
+
+// SaveReport returns ErrWasmCoverageDisabled
+func (collector *disabledCoverageCollector) SaveReport(_ string) error {
+	return ErrWasmCoverageDisabled
+}
+
+// Reset does nothing
+func (collector *disabledCoverageCollector) Reset() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (collector *disabledCoverageCollector) IsInterfaceNil() bool {
+	return collector == nil
+}
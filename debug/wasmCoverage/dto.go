@@ -0,0 +1,33 @@
+package wasmCoverage
+
+// FunctionCoverage holds the number of executions of a function defined by a contract
+type FunctionCoverage struct {
+	Index    uint32 `json:"index"`
+	Name     string `json:"name"`
+	NumCalls uint64 `json:"numCalls"`
+}
+
+// BranchCoverage holds the number of times an arm of a conditional instruction was taken. The block is the index of
+// the if, br_if or br_table instruction within its function
+type BranchCoverage struct {
+	FunctionIndex uint32 `json:"functionIndex"`
+	Block         uint32 `json:"block"`
+	Branch        uint32 `json:"branch"`
+	NumTaken      uint64 `json:"numTaken"`
+}
+
+// ContractCoverage holds the coverage of the functions and branches of a contract code, sorted by function index
+type ContractCoverage struct {
+	CodeHash             string              `json:"codeHash"`
+	NumFunctions         int                 `json:"numFunctions"`
+	NumExecutedFunctions int                 `json:"numExecutedFunctions"`
+	NumBranches          int                 `json:"numBranches"`
+	NumTakenBranches     int                 `json:"numTakenBranches"`
+	Functions            []*FunctionCoverage `json:"functions"`
+	Branches             []*BranchCoverage   `json:"branches"`
+}
+
+// Report holds the coverage of all the executed contract codes, sorted by code hash
+type Report struct {
+	Contracts []*ContractCoverage `json:"contracts"`
+}
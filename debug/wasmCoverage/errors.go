@@ -0,0 +1,21 @@
+package wasmCoverage
+
+import "errors"
+
+// ErrWasmCoverageDisabled signals that the Wasm coverage collection is not enabled
+var ErrWasmCoverageDisabled = errors.New("wasm coverage collection is not enabled")
+
+// ErrInvalidWasmModule signals that the contract code is not a valid Wasm module
+var ErrInvalidWasmModule = errors.New("invalid wasm module")
+
+// ErrUnsupportedWasmModule signals that the contract code uses Wasm features which are not supported by the instrumenter
+var ErrUnsupportedWasmModule = errors.New("unsupported wasm module")
+
+// ErrNilExecutorFactory signals that a nil executor factory has been provided
+var ErrNilExecutorFactory = errors.New("nil executor factory")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrCompiledCodeNotFound signals that the compiled code was not produced by the coverage executor
+var ErrCompiledCodeNotFound = errors.New("compiled code not found")
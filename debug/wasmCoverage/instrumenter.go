@@ -0,0 +1,1083 @@
+package wasmCoverage
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+)
+
+const (
+	typeSectionID      = 1
+	globalSectionID    = 6
+	startSectionID     = 8
+	elementSectionID   = 9
+	codeSectionID      = 10
+	dataCountSectionID = 12
+
+	localNamesSubsection = 2
+
+	funcTypeForm   = 0x60
+	emptyBlockType = 0x40
+	i32ValueType   = 0x7f
+	i64ValueType   = 0x7e
+
+	passiveElementFlag       = 0x01
+	explicitTableElementFlag = 0x02
+	expressionsElementFlag   = 0x04
+)
+
+const (
+	opBlock              = 0x02
+	opLoop               = 0x03
+	opIf                 = 0x04
+	opElse               = 0x05
+	opEnd                = 0x0b
+	opBr                 = 0x0c
+	opBrIf               = 0x0d
+	opBrTable            = 0x0e
+	opReturn             = 0x0f
+	opCall               = 0x10
+	opCallIndirect       = 0x11
+	opReturnCall         = 0x12
+	opReturnCallIndirect = 0x13
+	opDrop               = 0x1a
+	opSelect             = 0x1b
+	opSelectTyped        = 0x1c
+	opLocalGet           = 0x20
+	opLocalSet           = 0x21
+	opLocalTee           = 0x22
+	opTableSet           = 0x26
+	opI32Load            = 0x28
+	opI64Store32         = 0x3e
+	opMemorySize         = 0x3f
+	opMemoryGrow         = 0x40
+	opI32Const           = 0x41
+	opI64Const           = 0x42
+	opF32Const           = 0x43
+	opF64Const           = 0x44
+	opI32Eqz             = 0x45
+	opI32Eq              = 0x46
+	opI32GeU             = 0x4f
+	opI64Extend32S       = 0xc4
+	opRefNull            = 0xd0
+	opRefIsNull          = 0xd1
+	opRefFunc            = 0xd2
+	opMiscPrefix         = 0xfc
+)
+
+const (
+	probeHookModule = "env"
+	probeHookName   = "int64storageStore"
+
+	// probeKeyLength is passed as key length to the probe calls. The storage hook fails the execution on a negative key
+	// length, so no contract relies on a call made with it
+	probeKeyLength int32 = -0x57434f56
+)
+
+var (
+	probeHookParams  = []byte{i32ValueType, i32ValueType, i64ValueType}
+	probeHookResults = []byte{i32ValueType}
+)
+
+// coverageProbe identifies the execution point recorded by a probe: the entry of a function or an arm of a branch
+type coverageProbe struct {
+	functionIndex uint32
+	isBranch      bool
+	block         uint32
+	branch        uint32
+}
+
+// instrumentedModule holds the instrumented code of a contract and its probes, indexed by probe ID
+type instrumentedModule struct {
+	code   []byte
+	probes []coverageProbe
+}
+
+type wasmSection struct {
+	id      byte
+	content []byte
+}
+
+type controlFrame struct {
+	isIf    bool
+	hasElse bool
+	block   uint32
+}
+
+type instrumenter struct {
+	contractID           int32
+	sections             []*wasmSection
+	typeNumParams        []uint32
+	isProbeType          []bool
+	probeTypeIndex       uint32
+	hasProbeType         bool
+	numImportedFunctions uint32
+	functionTypes        []uint32
+	probeFunctionIndex   uint32
+	// indexShift is 1 if the probe hook import is added, so the indexes of the functions defined by the module move
+	indexShift uint32
+	probes     []coverageProbe
+}
+
+// instrumentWasmModule returns the provided Wasm module with a probe at the entry of each function and on each arm of
+// the if, br_if and br_table instructions. A probe is a call of the storage hook with an invalid key length, carrying
+// the contract ID and the probe ID. The modules using instructions not known by the instrumenter are not instrumented
+func instrumentWasmModule(code []byte, contractID int32) (*instrumentedModule, error) {
+	if !bytes.HasPrefix(code, wasmPreamble) {
+		return nil, ErrInvalidWasmModule
+	}
+
+	ins := &instrumenter{
+		contractID: contractID,
+	}
+	err := ins.readSections(code)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ins.addProbeHook()
+	if err != nil {
+		return nil, err
+	}
+
+	output := bytes.NewBuffer(make([]byte, 0, 2*len(code)))
+	output.Write(wasmPreamble)
+	for _, section := range ins.sections {
+		content, errRewrite := ins.rewriteSection(section)
+		if errRewrite != nil {
+			return nil, fmt.Errorf("%w in section %d", errRewrite, section.id)
+		}
+
+		output.WriteByte(section.id)
+		writeUnsignedLEB128(output, uint64(len(content)))
+		output.Write(content)
+	}
+
+	return &instrumentedModule{
+		code:   output.Bytes(),
+		probes: ins.probes,
+	}, nil
+}
+
+func (ins *instrumenter) readSections(code []byte) error {
+	reader := &wasmReader{data: code, offset: len(wasmPreamble)}
+	for !reader.isEOF() {
+		sectionID, err := reader.readByte()
+		if err != nil {
+			return err
+		}
+		content, err := reader.readBytes()
+		if err != nil {
+			return err
+		}
+
+		ins.sections = append(ins.sections, &wasmSection{id: sectionID, content: content})
+	}
+
+	for _, section := range ins.sections {
+		err := ins.parseSection(section)
+		if err != nil {
+			return fmt.Errorf("%w in section %d", err, section.id)
+		}
+	}
+
+	return nil
+}
+
+func (ins *instrumenter) parseSection(section *wasmSection) error {
+	reader := &wasmReader{data: section.content}
+	switch section.id {
+	case typeSectionID:
+		return ins.parseTypeSection(reader)
+	case importSectionID:
+		return ins.parseImportSection(reader)
+	case functionSectionID:
+		return ins.parseFunctionSection(reader)
+	default:
+		return nil
+	}
+}
+
+func (ins *instrumenter) parseTypeSection(reader *wasmReader) error {
+	numTypes, err := reader.readUint32()
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < numTypes; i++ {
+		form, errForm := reader.readByte()
+		if errForm != nil {
+			return errForm
+		}
+		if form != funcTypeForm {
+			return fmt.Errorf("%w: type form %d", ErrUnsupportedWasmModule, form)
+		}
+
+		// the value types are encoded on a single byte, so the vectors are read as bytes
+		params, errParams := reader.readBytes()
+		if errParams != nil {
+			return errParams
+		}
+		results, errResults := reader.readBytes()
+		if errResults != nil {
+			return errResults
+		}
+
+		isProbeType := bytes.Equal(params, probeHookParams) && bytes.Equal(results, probeHookResults)
+		ins.typeNumParams = append(ins.typeNumParams, uint32(len(params)))
+		ins.isProbeType = append(ins.isProbeType, isProbeType)
+		if isProbeType && !ins.hasProbeType {
+			ins.probeTypeIndex = i
+			ins.hasProbeType = true
+		}
+	}
+
+	return nil
+}
+
+func (ins *instrumenter) parseImportSection(reader *wasmReader) error {
+	numImports, err := reader.readUint32()
+	if err != nil {
+		return err
+	}
+
+	isProbeHookImported := false
+	for i := uint32(0); i < numImports; i++ {
+		moduleName, errModule := reader.readBytes()
+		if errModule != nil {
+			return errModule
+		}
+		fieldName, errField := reader.readBytes()
+		if errField != nil {
+			return errField
+		}
+		kind, errKind := reader.readByte()
+		if errKind != nil {
+			return errKind
+		}
+
+		if kind != functionImportKind {
+			err = (&wasmModule{}).skipImportDescription(kind, reader)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		typeIndex, errType := reader.readUint32()
+		if errType != nil {
+			return errType
+		}
+		if string(moduleName) == probeHookModule && string(fieldName) == probeHookName {
+			if typeIndex >= uint32(len(ins.isProbeType)) || !ins.isProbeType[typeIndex] {
+				return fmt.Errorf("%w: unexpected type of the %s import", ErrUnsupportedWasmModule, probeHookName)
+			}
+			ins.probeFunctionIndex = ins.numImportedFunctions
+			isProbeHookImported = true
+		}
+		ins.numImportedFunctions++
+	}
+
+	if !isProbeHookImported {
+		ins.probeFunctionIndex = ins.numImportedFunctions
+		ins.indexShift = 1
+	}
+
+	return nil
+}
+
+func (ins *instrumenter) parseFunctionSection(reader *wasmReader) error {
+	numFunctions, err := reader.readUint32()
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < numFunctions; i++ {
+		typeIndex, errType := reader.readUint32()
+		if errType != nil {
+			return errType
+		}
+		if typeIndex >= uint32(len(ins.typeNumParams)) {
+			return fmt.Errorf("%w: unknown function type %d", ErrInvalidWasmModule, typeIndex)
+		}
+
+		ins.functionTypes = append(ins.functionTypes, typeIndex)
+	}
+
+	return nil
+}
+
+// addProbeHook adds the probe hook import, together with its type, if the module does not import it already
+func (ins *instrumenter) addProbeHook() error {
+	if !ins.hasSection(importSectionID) {
+		ins.probeFunctionIndex = 0
+		ins.indexShift = 1
+		ins.insertSection(&wasmSection{id: importSectionID, content: []byte{0}})
+	}
+	if ins.indexShift == 0 {
+		return nil
+	}
+
+	if !ins.hasProbeType {
+		if !ins.hasSection(typeSectionID) {
+			ins.insertSection(&wasmSection{id: typeSectionID, content: []byte{0}})
+		}
+
+		probeType := bytes.NewBuffer([]byte{funcTypeForm})
+		writeBytesVector(probeType, probeHookParams)
+		writeBytesVector(probeType, probeHookResults)
+
+		ins.probeTypeIndex = uint32(len(ins.typeNumParams))
+		ins.typeNumParams = append(ins.typeNumParams, uint32(len(probeHookParams)))
+		ins.isProbeType = append(ins.isProbeType, true)
+		ins.hasProbeType = true
+
+		err := ins.appendVectorEntry(typeSectionID, probeType.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	probeImport := &bytes.Buffer{}
+	writeBytesVector(probeImport, []byte(probeHookModule))
+	writeBytesVector(probeImport, []byte(probeHookName))
+	probeImport.WriteByte(functionImportKind)
+	writeUnsignedLEB128(probeImport, uint64(ins.probeTypeIndex))
+
+	return ins.appendVectorEntry(importSectionID, probeImport.Bytes())
+}
+
+func (ins *instrumenter) hasSection(sectionID byte) bool {
+	for _, section := range ins.sections {
+		if section.id == sectionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// insertSection inserts the provided section before the first known section which must follow it
+func (ins *instrumenter) insertSection(newSection *wasmSection) {
+	position := len(ins.sections)
+	for i, section := range ins.sections {
+		if section.id != customSectionID && sectionOrder(section.id) > sectionOrder(newSection.id) {
+			position = i
+			break
+		}
+	}
+
+	ins.sections = append(ins.sections[:position], append([]*wasmSection{newSection}, ins.sections[position:]...)...)
+}
+
+// sectionOrder returns the rank of a known section in a module, the data count section being placed before the code
+// section
+func sectionOrder(sectionID byte) int {
+	if sectionID == dataCountSectionID {
+		return 2*codeSectionID - 1
+	}
+
+	return 2 * int(sectionID)
+}
+
+// appendVectorEntry appends an encoded entry to the vector held by the section with the provided ID
+func (ins *instrumenter) appendVectorEntry(sectionID byte, entry []byte) error {
+	for _, section := range ins.sections {
+		if section.id != sectionID {
+			continue
+		}
+
+		reader := &wasmReader{data: section.content}
+		numEntries, err := reader.readUint32()
+		if err != nil {
+			return err
+		}
+
+		content := &bytes.Buffer{}
+		writeUnsignedLEB128(content, uint64(numEntries)+1)
+		content.Write(section.content[reader.offset:])
+		content.Write(entry)
+		section.content = content.Bytes()
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: missing section %d", ErrInvalidWasmModule, sectionID)
+}
+
+func (ins *instrumenter) rewriteSection(section *wasmSection) ([]byte, error) {
+	reader := &wasmReader{data: section.content}
+	switch {
+	case section.id == codeSectionID:
+		return ins.rewriteCodeSection(reader)
+	case ins.indexShift == 0:
+		return section.content, nil
+	case section.id == exportSectionID:
+		return ins.rewriteExportSection(reader)
+	case section.id == startSectionID:
+		return ins.rewriteStartSection(reader)
+	case section.id == elementSectionID:
+		return ins.rewriteElementSection(reader)
+	case section.id == globalSectionID:
+		return ins.rewriteGlobalSection(reader)
+	case section.id == customSectionID:
+		return ins.rewriteCustomSection(reader)
+	default:
+		return section.content, nil
+	}
+}
+
+func (ins *instrumenter) shiftFunctionIndex(index uint32) uint32 {
+	if index < ins.numImportedFunctions {
+		return index
+	}
+
+	return index + ins.indexShift
+}
+
+func (ins *instrumenter) copyFunctionIndex(reader *wasmReader, output *bytes.Buffer) error {
+	index, err := reader.readUint32()
+	if err != nil {
+		return err
+	}
+
+	writeUnsignedLEB128(output, uint64(ins.shiftFunctionIndex(index)))
+
+	return nil
+}
+
+func (ins *instrumenter) rewriteExportSection(reader *wasmReader) ([]byte, error) {
+	output := &bytes.Buffer{}
+	numExports, err := copyUint32(reader, output)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < numExports; i++ {
+		name, errName := reader.readBytes()
+		if errName != nil {
+			return nil, errName
+		}
+		kind, errKind := reader.readByte()
+		if errKind != nil {
+			return nil, errKind
+		}
+		writeBytesVector(output, name)
+		output.WriteByte(kind)
+
+		if kind == functionExportKind {
+			err = ins.copyFunctionIndex(reader, output)
+		} else {
+			_, err = copyUint32(reader, output)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output.Bytes(), nil
+}
+
+func (ins *instrumenter) rewriteStartSection(reader *wasmReader) ([]byte, error) {
+	output := &bytes.Buffer{}
+	err := ins.copyFunctionIndex(reader, output)
+
+	return output.Bytes(), err
+}
+
+func (ins *instrumenter) rewriteElementSection(reader *wasmReader) ([]byte, error) {
+	output := &bytes.Buffer{}
+	numSegments, err := copyUint32(reader, output)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < numSegments; i++ {
+		err = ins.rewriteElementSegment(reader, output)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output.Bytes(), nil
+}
+
+func (ins *instrumenter) rewriteElementSegment(reader *wasmReader, output *bytes.Buffer) error {
+	flags, err := copyUint32(reader, output)
+	if err != nil {
+		return err
+	}
+
+	if flags&passiveElementFlag == 0 {
+		if flags&explicitTableElementFlag != 0 {
+			_, err = copyUint32(reader, output)
+			if err != nil {
+				return err
+			}
+		}
+		err = ins.copyConstantExpression(reader, output)
+		if err != nil {
+			return err
+		}
+	}
+	if flags&(passiveElementFlag|explicitTableElementFlag) != 0 {
+		// element kind or reference type
+		_, err = copyBytes(reader, output, 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	numElements, err := copyUint32(reader, output)
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < numElements; i++ {
+		if flags&expressionsElementFlag != 0 {
+			err = ins.copyConstantExpression(reader, output)
+		} else {
+			err = ins.copyFunctionIndex(reader, output)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ins *instrumenter) rewriteGlobalSection(reader *wasmReader) ([]byte, error) {
+	output := &bytes.Buffer{}
+	numGlobals, err := copyUint32(reader, output)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < numGlobals; i++ {
+		// value type and mutability
+		_, err = copyBytes(reader, output, 2)
+		if err != nil {
+			return nil, err
+		}
+		err = ins.copyConstantExpression(reader, output)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output.Bytes(), nil
+}
+
+func (ins *instrumenter) copyConstantExpression(reader *wasmReader, output *bytes.Buffer) error {
+	for {
+		start := reader.offset
+		opcode, err := reader.readByte()
+		if err != nil {
+			return err
+		}
+		if opcode == opRefFunc {
+			output.WriteByte(opcode)
+			err = ins.copyFunctionIndex(reader, output)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = skipImmediates(opcode, reader)
+		if err != nil {
+			return err
+		}
+		output.Write(reader.data[start:reader.offset])
+		if opcode == opEnd {
+			return nil
+		}
+	}
+}
+
+// rewriteCustomSection shifts the function indexes of the function and local names of the name section. The other
+// custom sections are copied as they are
+func (ins *instrumenter) rewriteCustomSection(reader *wasmReader) ([]byte, error) {
+	sectionName, err := reader.readBytes()
+	if err != nil {
+		return nil, err
+	}
+	if string(sectionName) != nameSectionName {
+		return reader.data, nil
+	}
+
+	output := &bytes.Buffer{}
+	writeBytesVector(output, sectionName)
+	for !reader.isEOF() {
+		subsectionID, errID := reader.readByte()
+		if errID != nil {
+			return nil, errID
+		}
+		subsectionContent, errContent := reader.readBytes()
+		if errContent != nil {
+			return nil, errContent
+		}
+
+		if subsectionID == functionNamesSubsection || subsectionID == localNamesSubsection {
+			subsectionContent, err = ins.rewriteNamesSubsection(subsectionID, &wasmReader{data: subsectionContent})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		output.WriteByte(subsectionID)
+		writeBytesVector(output, subsectionContent)
+	}
+
+	return output.Bytes(), nil
+}
+
+func (ins *instrumenter) rewriteNamesSubsection(subsectionID byte, reader *wasmReader) ([]byte, error) {
+	output := &bytes.Buffer{}
+	numEntries, err := copyUint32(reader, output)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < numEntries; i++ {
+		err = ins.copyFunctionIndex(reader, output)
+		if err != nil {
+			return nil, err
+		}
+
+		if subsectionID == functionNamesSubsection {
+			_, err = copyBytesVector(reader, output)
+		} else {
+			err = copyNameMap(reader, output)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output.Bytes(), nil
+}
+
+func (ins *instrumenter) rewriteCodeSection(reader *wasmReader) ([]byte, error) {
+	output := &bytes.Buffer{}
+	numBodies, err := copyUint32(reader, output)
+	if err != nil {
+		return nil, err
+	}
+	if numBodies != uint32(len(ins.functionTypes)) {
+		return nil, fmt.Errorf("%w: %d function bodies for %d functions", ErrInvalidWasmModule, numBodies, len(ins.functionTypes))
+	}
+
+	for i := uint32(0); i < numBodies; i++ {
+		body, errBody := reader.readBytes()
+		if errBody != nil {
+			return nil, errBody
+		}
+
+		functionIndex := ins.numImportedFunctions + i
+		instrumentedBody, errInstrument := ins.instrumentFunction(functionIndex, ins.functionTypes[i], body)
+		if errInstrument != nil {
+			return nil, fmt.Errorf("%w in function %d", errInstrument, functionIndex)
+		}
+		writeBytesVector(output, instrumentedBody)
+	}
+
+	return output.Bytes(), nil
+}
+
+func (ins *instrumenter) instrumentFunction(functionIndex uint32, typeIndex uint32, body []byte) ([]byte, error) {
+	reader := &wasmReader{data: body}
+	numLocalDeclarations, err := reader.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	localDeclarationsStart := reader.offset
+
+	numLocals := uint64(ins.typeNumParams[typeIndex])
+	for i := uint32(0); i < numLocalDeclarations; i++ {
+		count, errCount := reader.readUint32()
+		if errCount != nil {
+			return nil, errCount
+		}
+		_, err = reader.readByte()
+		if err != nil {
+			return nil, err
+		}
+		numLocals += uint64(count)
+	}
+	if numLocals >= math.MaxUint32 {
+		return nil, fmt.Errorf("%w: too many locals", ErrInvalidWasmModule)
+	}
+	localDeclarations := body[localDeclarationsStart:reader.offset]
+
+	builder := &functionInstrumenter{
+		instrumenter:   ins,
+		reader:         reader,
+		functionIndex:  functionIndex,
+		conditionLocal: uint32(numLocals),
+	}
+	code, err := builder.instrumentCode()
+	if err != nil {
+		return nil, err
+	}
+
+	output := bytes.NewBuffer(make([]byte, 0, len(body)+len(code)))
+	if !builder.usesConditionLocal {
+		writeUnsignedLEB128(output, uint64(numLocalDeclarations))
+		output.Write(localDeclarations)
+		output.Write(code)
+
+		return output.Bytes(), nil
+	}
+
+	// the condition local is declared last, so the indexes of the other locals are kept
+	writeUnsignedLEB128(output, uint64(numLocalDeclarations)+1)
+	output.Write(localDeclarations)
+	writeUnsignedLEB128(output, 1)
+	output.WriteByte(i32ValueType)
+	output.Write(code)
+
+	return output.Bytes(), nil
+}
+
+type functionInstrumenter struct {
+	*instrumenter
+	reader             *wasmReader
+	output             bytes.Buffer
+	functionIndex      uint32
+	numBlocks          uint32
+	conditionLocal     uint32
+	usesConditionLocal bool
+}
+
+func (fi *functionInstrumenter) instrumentCode() ([]byte, error) {
+	fi.writeProbe(coverageProbe{functionIndex: fi.functionIndex})
+
+	frames := []controlFrame{{}}
+	for len(frames) > 0 {
+		start := fi.reader.offset
+		opcode, err := fi.reader.readByte()
+		if err != nil {
+			return nil, err
+		}
+
+		switch opcode {
+		case opIf:
+			err = fi.copyInstruction(opcode, start)
+			frames = append(frames, controlFrame{isIf: true, block: fi.newBlock()})
+			fi.writeBranchProbe(frames[len(frames)-1].block, 0)
+		case opElse:
+			frame := &frames[len(frames)-1]
+			if !frame.isIf || frame.hasElse {
+				return nil, fmt.Errorf("%w: unexpected else", ErrInvalidWasmModule)
+			}
+			frame.hasElse = true
+			fi.output.WriteByte(opcode)
+			fi.writeBranchProbe(frame.block, 1)
+		case opEnd:
+			frame := frames[len(frames)-1]
+			frames = frames[:len(frames)-1]
+			if frame.isIf && !frame.hasElse {
+				// the added else arm keeps the values on the stack, as the if block without else does
+				fi.output.WriteByte(opElse)
+				fi.writeBranchProbe(frame.block, 1)
+			}
+			fi.output.WriteByte(opcode)
+		case opBlock, opLoop:
+			err = fi.copyInstruction(opcode, start)
+			frames = append(frames, controlFrame{})
+		case opBrIf:
+			err = fi.instrumentBrIf(start)
+		case opBrTable:
+			err = fi.instrumentBrTable(start)
+		case opCall, opReturnCall, opRefFunc:
+			fi.output.WriteByte(opcode)
+			err = fi.copyFunctionIndex(fi.reader, &fi.output)
+		default:
+			err = fi.copyInstruction(opcode, start)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !fi.reader.isEOF() {
+		return nil, fmt.Errorf("%w: code after the end of the function", ErrInvalidWasmModule)
+	}
+
+	return fi.output.Bytes(), nil
+}
+
+func (fi *functionInstrumenter) copyInstruction(opcode byte, start int) error {
+	err := skipImmediates(opcode, fi.reader)
+	if err != nil {
+		return err
+	}
+
+	fi.output.Write(fi.reader.data[start:fi.reader.offset])
+
+	return nil
+}
+
+// instrumentBrIf records the taken arm of the br_if in an if block testing a copy of the condition, and the arm not
+// taken after it
+func (fi *functionInstrumenter) instrumentBrIf(start int) error {
+	_, err := fi.reader.readUint32()
+	if err != nil {
+		return err
+	}
+
+	block := fi.newBlock()
+	fi.writeLocalInstruction(opLocalTee)
+	fi.output.Write([]byte{opIf, emptyBlockType})
+	fi.writeBranchProbe(block, 0)
+	fi.output.WriteByte(opEnd)
+	fi.writeLocalInstruction(opLocalGet)
+	fi.output.Write(fi.reader.data[start:fi.reader.offset])
+	fi.writeBranchProbe(block, 1)
+
+	return nil
+}
+
+// instrumentBrTable records the arm selected by the br_table, the last arm being the default target
+func (fi *functionInstrumenter) instrumentBrTable(start int) error {
+	numTargets, err := fi.reader.readUint32()
+	if err != nil {
+		return err
+	}
+	err = skipLEB128Values(fi.reader, uint64(numTargets)+1)
+	if err != nil {
+		return err
+	}
+
+	block := fi.newBlock()
+	fi.writeLocalInstruction(opLocalSet)
+	for i := uint32(0); i <= numTargets; i++ {
+		fi.writeLocalInstruction(opLocalGet)
+		fi.output.WriteByte(opI32Const)
+		writeSignedLEB128(&fi.output, int64(int32(i)))
+		if i < numTargets {
+			fi.output.WriteByte(opI32Eq)
+		} else {
+			fi.output.WriteByte(opI32GeU)
+		}
+		fi.output.Write([]byte{opIf, emptyBlockType})
+		fi.writeBranchProbe(block, i)
+		fi.output.WriteByte(opEnd)
+	}
+	fi.writeLocalInstruction(opLocalGet)
+	fi.output.Write(fi.reader.data[start:fi.reader.offset])
+
+	return nil
+}
+
+func (fi *functionInstrumenter) newBlock() uint32 {
+	block := fi.numBlocks
+	fi.numBlocks++
+
+	return block
+}
+
+func (fi *functionInstrumenter) writeLocalInstruction(opcode byte) {
+	fi.usesConditionLocal = true
+	fi.output.WriteByte(opcode)
+	writeUnsignedLEB128(&fi.output, uint64(fi.conditionLocal))
+}
+
+func (fi *functionInstrumenter) writeBranchProbe(block uint32, branch uint32) {
+	fi.writeProbe(coverageProbe{
+		functionIndex: fi.functionIndex,
+		isBranch:      true,
+		block:         block,
+		branch:        branch,
+	})
+}
+
+// writeProbe writes the call of the probe hook, leaving the stack unchanged
+func (fi *functionInstrumenter) writeProbe(probe coverageProbe) {
+	probeID := len(fi.probes)
+	fi.probes = append(fi.probes, probe)
+
+	fi.output.WriteByte(opI32Const)
+	writeSignedLEB128(&fi.output, int64(fi.contractID))
+	fi.output.WriteByte(opI32Const)
+	writeSignedLEB128(&fi.output, int64(probeKeyLength))
+	fi.output.WriteByte(opI64Const)
+	writeSignedLEB128(&fi.output, int64(probeID))
+	fi.output.WriteByte(opCall)
+	writeUnsignedLEB128(&fi.output, uint64(fi.probeFunctionIndex))
+	fi.output.WriteByte(opDrop)
+}
+
+// skipImmediates advances the reader past the immediates of the instruction with the provided opcode
+func skipImmediates(opcode byte, reader *wasmReader) error {
+	switch {
+	case opcode == opBlock || opcode == opLoop || opcode == opIf:
+		return skipBlockType(reader)
+	case opcode == opBr || opcode == opBrIf || opcode == opCall || opcode == opReturnCall || opcode == opRefFunc,
+		opcode >= opLocalGet && opcode <= opTableSet,
+		opcode == opMemorySize || opcode == opMemoryGrow,
+		opcode == opI32Const || opcode == opI64Const:
+		return reader.skipLEB128()
+	case opcode == opBrTable:
+		numTargets, err := reader.readUint32()
+		if err != nil {
+			return err
+		}
+		return skipLEB128Values(reader, uint64(numTargets)+1)
+	case opcode == opCallIndirect || opcode == opReturnCallIndirect,
+		opcode >= opI32Load && opcode <= opI64Store32:
+		return skipLEB128Values(reader, 2)
+	case opcode == opSelectTyped:
+		_, err := reader.readBytes()
+		return err
+	case opcode == opF32Const:
+		return reader.skipBytes(4)
+	case opcode == opF64Const:
+		return reader.skipBytes(8)
+	case opcode == opRefNull:
+		return reader.skipBytes(1)
+	case opcode == opMiscPrefix:
+		return skipMiscImmediates(reader)
+	case opcode <= 0x01, opcode == opElse, opcode == opEnd, opcode == opReturn, opcode == opDrop, opcode == opSelect,
+		opcode >= opI32Eqz && opcode <= opI64Extend32S, opcode == opRefIsNull:
+		return nil
+	default:
+		return fmt.Errorf("%w: opcode 0x%x", ErrUnsupportedWasmModule, opcode)
+	}
+}
+
+func skipBlockType(reader *wasmReader) error {
+	if reader.isEOF() {
+		return ErrInvalidWasmModule
+	}
+
+	// the empty block type and the value types are single negative bytes, the type indexes are positive LEB128 values
+	blockType := reader.data[reader.offset]
+	if blockType == emptyBlockType || blockType >= 0x6f && blockType <= i32ValueType {
+		return reader.skipBytes(1)
+	}
+
+	return reader.skipLEB128()
+}
+
+// skipMiscImmediates skips the immediates of the saturating truncation, bulk memory and table instructions
+func skipMiscImmediates(reader *wasmReader) error {
+	miscOpcode, err := reader.readUint32()
+	if err != nil {
+		return err
+	}
+
+	switch miscOpcode {
+	case 0, 1, 2, 3, 4, 5, 6, 7:
+		// saturating truncations
+		return nil
+	case 8, 10, 12, 14:
+		// memory.init, memory.copy, table.init and table.copy
+		return skipLEB128Values(reader, 2)
+	case 9, 11, 13, 15, 16, 17:
+		// data.drop, memory.fill, elem.drop, table.grow, table.size and table.fill
+		return reader.skipLEB128()
+	default:
+		return fmt.Errorf("%w: opcode 0x%x 0x%x", ErrUnsupportedWasmModule, opMiscPrefix, miscOpcode)
+	}
+}
+
+func skipLEB128Values(reader *wasmReader, numValues uint64) error {
+	for i := uint64(0); i < numValues; i++ {
+		err := reader.skipLEB128()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func copyUint32(reader *wasmReader, output *bytes.Buffer) (uint32, error) {
+	start := reader.offset
+	value, err := reader.readUint32()
+	if err != nil {
+		return 0, err
+	}
+
+	output.Write(reader.data[start:reader.offset])
+
+	return value, nil
+}
+
+func copyBytes(reader *wasmReader, output *bytes.Buffer, numBytes int) ([]byte, error) {
+	start := reader.offset
+	err := reader.skipBytes(numBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	value := reader.data[start:reader.offset]
+	output.Write(value)
+
+	return value, nil
+}
+
+func copyBytesVector(reader *wasmReader, output *bytes.Buffer) ([]byte, error) {
+	value, err := reader.readBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	writeBytesVector(output, value)
+
+	return value, nil
+}
+
+func copyNameMap(reader *wasmReader, output *bytes.Buffer) error {
+	numNames, err := copyUint32(reader, output)
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < numNames; i++ {
+		_, err = copyUint32(reader, output)
+		if err != nil {
+			return err
+		}
+		_, err = copyBytesVector(reader, output)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeBytesVector(output *bytes.Buffer, value []byte) {
+	writeUnsignedLEB128(output, uint64(len(value)))
+	output.Write(value)
+}
+
+func writeUnsignedLEB128(output *bytes.Buffer, value uint64) {
+	for {
+		encoded := byte(value & 0x7f)
+		value >>= 7
+		if value == 0 {
+			output.WriteByte(encoded)
+			return
+		}
+
+		output.WriteByte(encoded | 0x80)
+	}
+}
+
+func writeSignedLEB128(output *bytes.Buffer, value int64) {
+	for {
+		encoded := byte(value & 0x7f)
+		value >>= 7
+		isLastByte := value == 0 && encoded&0x40 == 0 || value == -1 && encoded&0x40 != 0
+		if isLastByte {
+			output.WriteByte(encoded)
+			return
+		}
+
+		output.WriteByte(encoded | 0x80)
+	}
+}
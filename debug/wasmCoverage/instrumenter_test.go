@@ -0,0 +1,238 @@
+package wasmCoverage
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// createExecutableTestModule creates a module without imports, with an exported memory, defining the exported function main, which calls
+// classify(0), classify(1) and choose(2), the classify function holding a br_if and an if without else, the choose
+// function holding a br_table with 2 targets, and a function never called
+func createExecutableTestModule() []byte {
+	module := append(make([]byte, 0), wasmPreamble...)
+	module = append(module, encodeSection(typeSectionID,
+		encodeUint32(2),
+		[]byte{funcTypeForm, 0x00, 0x00},
+		[]byte{funcTypeForm, 0x01, i32ValueType, 0x01, i32ValueType},
+	)...)
+	module = append(module, encodeSection(functionSectionID, encodeUint32(4), []byte{0x00, 0x01, 0x01, 0x00})...)
+	module = append(module, encodeSection(5, encodeUint32(1), []byte{0x00, 0x01})...)
+	module = append(module, encodeSection(exportSectionID,
+		encodeUint32(2),
+		encodeName("memory"), []byte{0x02}, encodeUint32(0),
+		encodeName("main"), []byte{functionExportKind}, encodeUint32(0),
+	)...)
+	module = append(module, encodeSection(codeSectionID,
+		encodeUint32(4),
+		encodeVector([]byte{
+			0x00,
+			opI32Const, 0x00, opCall, 0x01, opDrop,
+			opI32Const, 0x01, opCall, 0x01, opDrop,
+			opI32Const, 0x02, opCall, 0x02, opDrop,
+			opEnd,
+		}),
+		encodeVector([]byte{
+			0x00,
+			opBlock, i32ValueType,
+			opI32Const, 0x07, opLocalGet, 0x00, opBrIf, 0x00, opDrop,
+			opLocalGet, 0x00, opIf, emptyBlockType, 0x01, opEnd,
+			opI32Const, 0x08,
+			opEnd,
+			opEnd,
+		}),
+		encodeVector([]byte{
+			0x00,
+			opBlock, emptyBlockType, opBlock, emptyBlockType,
+			opLocalGet, 0x00, opBrTable, 0x02, 0x00, 0x01, 0x01,
+			opEnd,
+			opI32Const, 0xe4, 0x00, opReturn,
+			opEnd,
+			opI32Const, 0xc8, 0x01,
+			opEnd,
+		}),
+		encodeVector([]byte{0x00, opEnd}),
+	)...)
+	module = append(module, encodeSection(customSectionID,
+		encodeName(nameSectionName),
+		[]byte{functionNamesSubsection}, encodeVector(
+			encodeUint32(2),
+			encodeUint32(1), encodeName("classify"),
+			encodeUint32(2), encodeName("choose"),
+		),
+	)...)
+
+	return module
+}
+
+func createTestModuleWithFunctionImport(importName string, importType []byte) []byte {
+	module := append(make([]byte, 0), wasmPreamble...)
+	module = append(module, encodeSection(typeSectionID,
+		encodeUint32(3),
+		[]byte{funcTypeForm, 0x00, 0x00},
+		[]byte{funcTypeForm, 0x00, 0x01, i32ValueType},
+		importType,
+	)...)
+	module = append(module, encodeSection(importSectionID,
+		encodeUint32(2),
+		encodeName("env"), encodeName("getNumArguments"), []byte{functionImportKind}, encodeUint32(1),
+		encodeName("env"), encodeName(importName), []byte{functionImportKind}, encodeUint32(2),
+	)...)
+	module = append(module, encodeSection(functionSectionID, encodeUint32(2), []byte{0x00, 0x01})...)
+	module = append(module, encodeSection(4, encodeUint32(1), []byte{0x70, 0x00, 0x02})...)
+	module = append(module, encodeSection(exportSectionID,
+		encodeUint32(2),
+		encodeName("init"), []byte{functionExportKind}, encodeUint32(2),
+		encodeName("other"), []byte{functionExportKind}, encodeUint32(3),
+	)...)
+	module = append(module, encodeSection(elementSectionID,
+		encodeUint32(1),
+		[]byte{0x00, opI32Const, 0x00, opEnd}, encodeVector([]byte{0x02, 0x03}),
+	)...)
+	module = append(module, encodeSection(codeSectionID,
+		encodeUint32(2),
+		encodeVector([]byte{0x00, opCall, 0x03, opDrop, opEnd}),
+		encodeVector([]byte{0x00, opCall, 0x00, opEnd}),
+	)...)
+	module = append(module, encodeSection(customSectionID,
+		encodeName(nameSectionName),
+		[]byte{functionNamesSubsection}, encodeVector(
+			encodeUint32(2),
+			encodeUint32(2), encodeName("init"),
+			encodeUint32(3), encodeName("other"),
+		),
+	)...)
+
+	return module
+}
+
+func TestInstrumentWasmModule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid module should error", func(t *testing.T) {
+		t.Parallel()
+
+		instrumented, err := instrumentWasmModule([]byte("not a wasm module"), 0)
+		require.Equal(t, ErrInvalidWasmModule, err)
+		require.Nil(t, instrumented)
+
+		code := createExecutableTestModule()
+		instrumented, err = instrumentWasmModule(code[:len(code)-3], 0)
+		require.True(t, errors.Is(err, ErrInvalidWasmModule))
+		require.Nil(t, instrumented)
+	})
+	t.Run("unsupported instruction should error", func(t *testing.T) {
+		t.Parallel()
+
+		code := append(make([]byte, 0), wasmPreamble...)
+		code = append(code, encodeSection(typeSectionID, encodeUint32(1), []byte{funcTypeForm, 0x00, 0x00})...)
+		code = append(code, encodeSection(functionSectionID, encodeUint32(1), []byte{0x00})...)
+		code = append(code, encodeSection(codeSectionID,
+			encodeUint32(1),
+			encodeVector([]byte{0x00, 0xfd, 0x0c, opEnd}),
+		)...)
+
+		instrumented, err := instrumentWasmModule(code, 0)
+		require.True(t, errors.Is(err, ErrUnsupportedWasmModule))
+		require.Nil(t, instrumented)
+	})
+	t.Run("module without imports should work", func(t *testing.T) {
+		t.Parallel()
+
+		instrumented, err := instrumentWasmModule(createExecutableTestModule(), 5)
+		require.Nil(t, err)
+		expectedProbes := []coverageProbe{
+			{functionIndex: 0},
+			{functionIndex: 1},
+			{functionIndex: 1, isBranch: true, block: 0, branch: 0},
+			{functionIndex: 1, isBranch: true, block: 0, branch: 1},
+			{functionIndex: 1, isBranch: true, block: 1, branch: 0},
+			{functionIndex: 1, isBranch: true, block: 1, branch: 1},
+			{functionIndex: 2},
+			{functionIndex: 2, isBranch: true, block: 0, branch: 0},
+			{functionIndex: 2, isBranch: true, block: 0, branch: 1},
+			{functionIndex: 2, isBranch: true, block: 0, branch: 2},
+			{functionIndex: 3},
+		}
+		require.Equal(t, expectedProbes, instrumented.probes)
+
+		module, err := parseWasmModule(instrumented.code)
+		require.Nil(t, err)
+		require.Equal(t, uint32(1), module.numImportedFunctions)
+		require.Equal(t, uint32(4), module.numDefinedFunctions)
+		require.Equal(t, map[string]uint32{"main": 1}, module.exports)
+		require.Equal(t, map[uint32]string{2: "classify", 3: "choose"}, module.names)
+
+		// the unused function holds only its entry probe: contract ID, key length, probe ID, call of the hook and drop
+		unusedBody := &bytes.Buffer{}
+		unusedBody.WriteByte(opI32Const)
+		writeSignedLEB128(unusedBody, 5)
+		unusedBody.WriteByte(opI32Const)
+		writeSignedLEB128(unusedBody, int64(probeKeyLength))
+		unusedBody.WriteByte(opI64Const)
+		writeSignedLEB128(unusedBody, 10)
+		unusedBody.Write([]byte{opCall, 0x00, opDrop, opEnd})
+		require.True(t, bytes.Contains(instrumented.code, encodeVector([]byte{0x00}, unusedBody.Bytes())))
+	})
+	t.Run("module with imports should shift the function indexes", func(t *testing.T) {
+		t.Parallel()
+
+		code := createTestModuleWithFunctionImport("bigIntAdd", []byte{funcTypeForm, 0x03, i32ValueType, i32ValueType, i32ValueType, 0x00})
+		instrumented, err := instrumentWasmModule(code, 0)
+		require.Nil(t, err)
+		require.Len(t, instrumented.probes, 2)
+		require.Equal(t, uint32(2), instrumented.probes[0].functionIndex)
+		require.Equal(t, uint32(3), instrumented.probes[1].functionIndex)
+
+		module, err := parseWasmModule(instrumented.code)
+		require.Nil(t, err)
+		require.Equal(t, uint32(3), module.numImportedFunctions)
+		require.Equal(t, map[string]uint32{"init": 3, "other": 4}, module.exports)
+		require.Equal(t, map[uint32]string{3: "init", 4: "other"}, module.names)
+
+		elementSection := encodeSection(elementSectionID,
+			encodeUint32(1),
+			[]byte{0x00, opI32Const, 0x00, opEnd}, encodeVector([]byte{0x03, 0x04}),
+		)
+		require.True(t, bytes.Contains(instrumented.code, elementSection))
+		// the call of the other function is shifted, the call of the imported function is kept
+		require.True(t, bytes.Contains(instrumented.code, []byte{opCall, 0x02, opDrop, opCall, 0x04, opDrop, opEnd}))
+		require.True(t, bytes.Contains(instrumented.code, []byte{opCall, 0x02, opDrop, opCall, 0x00, opEnd}))
+	})
+	t.Run("module importing the probe hook should keep the function indexes", func(t *testing.T) {
+		t.Parallel()
+
+		probeType := append([]byte{funcTypeForm}, encodeVector(probeHookParams)...)
+		probeType = append(probeType, encodeVector(probeHookResults)...)
+		code := createTestModuleWithFunctionImport(probeHookName, probeType)
+		instrumented, err := instrumentWasmModule(code, 0)
+		require.Nil(t, err)
+
+		module, err := parseWasmModule(instrumented.code)
+		require.Nil(t, err)
+		require.Equal(t, uint32(2), module.numImportedFunctions)
+		require.Equal(t, map[string]uint32{"init": 2, "other": 3}, module.exports)
+		require.True(t, bytes.Contains(instrumented.code, []byte{opCall, 0x01, opDrop, opCall, 0x03, opDrop, opEnd}))
+	})
+	t.Run("probe hook imported with another type should error", func(t *testing.T) {
+		t.Parallel()
+
+		code := createTestModuleWithFunctionImport(probeHookName, []byte{funcTypeForm, 0x00, 0x00})
+		instrumented, err := instrumentWasmModule(code, 0)
+		require.True(t, errors.Is(err, ErrUnsupportedWasmModule))
+		require.Nil(t, instrumented)
+	})
+}
+
+func TestWriteSignedLEB128(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	writeSignedLEB128(buff, 63)
+	writeSignedLEB128(buff, 64)
+	writeSignedLEB128(buff, -64)
+	writeSignedLEB128(buff, -65)
+	require.Equal(t, []byte{0x3f, 0xc0, 0x00, 0x40, 0xbf, 0x7f}, buff.Bytes())
+}
@@ -0,0 +1,21 @@
+package wasmCoverage
+
+import (
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// CoverageCollectorHandler defines the operations of a component recording the functions and branches executed by the
+// Wasm VM for each contract code
+type CoverageCollectorHandler interface {
+	CreateExecutorFactory(executorFactory executor.ExecutorAbstractFactory, hasher hashing.Hasher) (executor.ExecutorAbstractFactory, error)
+	GetReport() (*Report, error)
+	SaveReport(folder string) error
+	Reset()
+	IsInterfaceNil() bool
+}
+
+type probeRecorder interface {
+	instrumentContractCode(codeHash []byte, code []byte) ([]byte, error)
+	recordProbe(contractID int32, probeID int64) bool
+}
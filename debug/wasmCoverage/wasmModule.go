@@ -0,0 +1,354 @@
+package wasmCoverage
+
+import (
+	"bytes"
+	"fmt"
+)
+
+const (
+	customSectionID   = 0
+	importSectionID   = 2
+	functionSectionID = 3
+	exportSectionID   = 7
+
+	functionImportKind = 0
+	tableImportKind    = 1
+	memoryImportKind   = 2
+	globalImportKind   = 3
+	tagImportKind      = 4
+
+	functionExportKind = 0
+
+	nameSectionName         = "name"
+	functionNamesSubsection = 1
+
+	limitsHasMaximumFlag = 0x01
+	maxLEB128Length      = 10
+)
+
+var wasmPreamble = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+// wasmModule holds the function index space of a Wasm module, as much as needed to map the executed functions
+type wasmModule struct {
+	numImportedFunctions uint32
+	numDefinedFunctions  uint32
+	exports              map[string]uint32
+	names                map[uint32]string
+}
+
+// parseWasmModule reads the import, function, export and name sections of the provided Wasm binary. The other
+// sections are skipped
+func parseWasmModule(code []byte) (*wasmModule, error) {
+	if !bytes.HasPrefix(code, wasmPreamble) {
+		return nil, ErrInvalidWasmModule
+	}
+
+	module := &wasmModule{
+		exports: make(map[string]uint32),
+		names:   make(map[uint32]string),
+	}
+
+	reader := &wasmReader{data: code, offset: len(wasmPreamble)}
+	for !reader.isEOF() {
+		sectionID, err := reader.readByte()
+		if err != nil {
+			return nil, err
+		}
+		sectionContent, err := reader.readBytes()
+		if err != nil {
+			return nil, err
+		}
+
+		err = module.parseSection(sectionID, &wasmReader{data: sectionContent})
+		if err != nil {
+			return nil, fmt.Errorf("%w in section %d", err, sectionID)
+		}
+	}
+
+	return module, nil
+}
+
+func (module *wasmModule) parseSection(sectionID byte, reader *wasmReader) error {
+	switch sectionID {
+	case importSectionID:
+		return module.parseImportSection(reader)
+	case functionSectionID:
+		numFunctions, err := reader.readUint32()
+		module.numDefinedFunctions = numFunctions
+		return err
+	case exportSectionID:
+		return module.parseExportSection(reader)
+	case customSectionID:
+		return module.parseCustomSection(reader)
+	default:
+		return nil
+	}
+}
+
+func (module *wasmModule) parseImportSection(reader *wasmReader) error {
+	numImports, err := reader.readUint32()
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < numImports; i++ {
+		// module name and field name
+		_, err = reader.readBytes()
+		if err != nil {
+			return err
+		}
+		_, err = reader.readBytes()
+		if err != nil {
+			return err
+		}
+
+		kind, errKind := reader.readByte()
+		if errKind != nil {
+			return errKind
+		}
+
+		err = module.skipImportDescription(kind, reader)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (module *wasmModule) skipImportDescription(kind byte, reader *wasmReader) error {
+	switch kind {
+	case functionImportKind:
+		module.numImportedFunctions++
+		_, err := reader.readUint32()
+		return err
+	case tableImportKind:
+		_, err := reader.readByte()
+		if err != nil {
+			return err
+		}
+		return reader.skipLimits()
+	case memoryImportKind:
+		return reader.skipLimits()
+	case globalImportKind:
+		// value type and mutability
+		_, err := reader.readByte()
+		if err != nil {
+			return err
+		}
+		_, err = reader.readByte()
+		return err
+	case tagImportKind:
+		// attribute and type index
+		_, err := reader.readByte()
+		if err != nil {
+			return err
+		}
+		_, err = reader.readUint32()
+		return err
+	default:
+		return fmt.Errorf("%w: unknown import kind %d", ErrInvalidWasmModule, kind)
+	}
+}
+
+func (module *wasmModule) parseExportSection(reader *wasmReader) error {
+	numExports, err := reader.readUint32()
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < numExports; i++ {
+		name, errName := reader.readBytes()
+		if errName != nil {
+			return errName
+		}
+		kind, errKind := reader.readByte()
+		if errKind != nil {
+			return errKind
+		}
+		index, errIndex := reader.readUint32()
+		if errIndex != nil {
+			return errIndex
+		}
+
+		if kind == functionExportKind {
+			module.exports[string(name)] = index
+		}
+	}
+
+	return nil
+}
+
+func (module *wasmModule) parseCustomSection(reader *wasmReader) error {
+	sectionName, err := reader.readBytes()
+	if err != nil {
+		return err
+	}
+	if string(sectionName) != nameSectionName {
+		return nil
+	}
+
+	for !reader.isEOF() {
+		subsectionID, errID := reader.readByte()
+		if errID != nil {
+			return errID
+		}
+		subsectionContent, errContent := reader.readBytes()
+		if errContent != nil {
+			return errContent
+		}
+
+		if subsectionID == functionNamesSubsection {
+			return module.parseFunctionNames(&wasmReader{data: subsectionContent})
+		}
+	}
+
+	return nil
+}
+
+func (module *wasmModule) parseFunctionNames(reader *wasmReader) error {
+	numNames, err := reader.readUint32()
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < numNames; i++ {
+		index, errIndex := reader.readUint32()
+		if errIndex != nil {
+			return errIndex
+		}
+		name, errName := reader.readBytes()
+		if errName != nil {
+			return errName
+		}
+
+		module.names[index] = string(name)
+	}
+
+	return nil
+}
+
+// exportNamesByIndex returns the first export name, in lexicographic order, of each exported function
+func (module *wasmModule) exportNamesByIndex() map[uint32]string {
+	exportNames := make(map[uint32]string, len(module.exports))
+	for exportName, index := range module.exports {
+		previousName, found := exportNames[index]
+		if !found || exportName < previousName {
+			exportNames[index] = exportName
+		}
+	}
+
+	return exportNames
+}
+
+// functionName returns the name of the function from the name section, if present, otherwise the export name. The
+// functions neither named nor exported are named after their index
+func (module *wasmModule) functionName(index uint32, exportName string) string {
+	name, found := module.names[index]
+	if found && len(name) > 0 {
+		return name
+	}
+	if len(exportName) > 0 {
+		return exportName
+	}
+
+	return fmt.Sprintf("func_%d", index)
+}
+
+type wasmReader struct {
+	data   []byte
+	offset int
+}
+
+func (reader *wasmReader) isEOF() bool {
+	return reader.offset >= len(reader.data)
+}
+
+func (reader *wasmReader) readByte() (byte, error) {
+	if reader.isEOF() {
+		return 0, ErrInvalidWasmModule
+	}
+
+	value := reader.data[reader.offset]
+	reader.offset++
+
+	return value, nil
+}
+
+// readUint32 reads an unsigned LEB128 encoded value of at most 32 bits
+func (reader *wasmReader) readUint32() (uint32, error) {
+	result := uint32(0)
+	for shift := 0; shift < 35; shift += 7 {
+		value, err := reader.readByte()
+		if err != nil {
+			return 0, err
+		}
+
+		result |= uint32(value&0x7f) << shift
+		if value&0x80 == 0 {
+			return result, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: LEB128 value too long", ErrInvalidWasmModule)
+}
+
+// readBytes reads a vector of bytes prefixed by its length
+func (reader *wasmReader) readBytes() ([]byte, error) {
+	length, err := reader.readUint32()
+	if err != nil {
+		return nil, err
+	}
+
+	end := reader.offset + int(length)
+	if end > len(reader.data) || end < reader.offset {
+		return nil, ErrInvalidWasmModule
+	}
+
+	value := reader.data[reader.offset:end]
+	reader.offset = end
+
+	return value, nil
+}
+
+func (reader *wasmReader) skipLimits() error {
+	flags, err := reader.readByte()
+	if err != nil {
+		return err
+	}
+
+	_, err = reader.readUint32()
+	if err != nil {
+		return err
+	}
+	if flags&limitsHasMaximumFlag == 0 {
+		return nil
+	}
+
+	_, err = reader.readUint32()
+	return err
+}
+
+// skipLEB128 skips a signed or unsigned LEB128 encoded value of at most 64 bits
+func (reader *wasmReader) skipLEB128() error {
+	for i := 0; i < maxLEB128Length; i++ {
+		value, err := reader.readByte()
+		if err != nil {
+			return err
+		}
+		if value&0x80 == 0 {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: LEB128 value too long", ErrInvalidWasmModule)
+}
+
+func (reader *wasmReader) skipBytes(numBytes int) error {
+	if numBytes > len(reader.data)-reader.offset {
+		return ErrInvalidWasmModule
+	}
+	reader.offset += numBytes
+
+	return nil
+}
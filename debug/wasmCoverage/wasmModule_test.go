@@ -0,0 +1,167 @@
+package wasmCoverage
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const adderWasmPath = "../../integrationTests/realcomponents/testdata/adder/adder.wasm"
+
+func encodeVector(content ...[]byte) []byte {
+	result := make([]byte, 0)
+	for _, item := range content {
+		result = append(result, item...)
+	}
+
+	return append(encodeUint32(uint32(len(result))), result...)
+}
+
+func encodeUint32(value uint32) []byte {
+	result := make([]byte, 0)
+	for {
+		b := byte(value & 0x7f)
+		value >>= 7
+		if value == 0 {
+			return append(result, b)
+		}
+		result = append(result, b|0x80)
+	}
+}
+
+func encodeName(name string) []byte {
+	return encodeVector([]byte(name))
+}
+
+func encodeSection(id byte, content ...[]byte) []byte {
+	return append([]byte{id}, encodeVector(content...)...)
+}
+
+// createTestWasmModule creates a module importing 2 functions, a memory, a table and a global, defining 4 functions,
+// the first 3 of them being exported, and naming the functions 2 and 3 in the name section
+func createTestWasmModule(withNameSection bool) []byte {
+	module := append(make([]byte, 0), wasmPreamble...)
+	module = append(module, encodeSection(importSectionID,
+		encodeUint32(5),
+		encodeName("env"), encodeName("getNumArguments"), []byte{functionImportKind}, encodeUint32(0),
+		encodeName("env"), encodeName("memory"), []byte{memoryImportKind, 0x01}, encodeUint32(2), encodeUint32(300),
+		encodeName("env"), encodeName("table"), []byte{tableImportKind, 0x70, 0x00}, encodeUint32(1),
+		encodeName("env"), encodeName("global"), []byte{globalImportKind, 0x7f, 0x00},
+		encodeName("env"), encodeName("bigIntAdd"), []byte{functionImportKind}, encodeUint32(1),
+	)...)
+	module = append(module, encodeSection(functionSectionID, encodeUint32(4), []byte{0x00, 0x00, 0x00, 0x00})...)
+	module = append(module, encodeSection(exportSectionID,
+		encodeUint32(5),
+		encodeName("init"), []byte{functionExportKind}, encodeUint32(2),
+		encodeName("add"), []byte{functionExportKind}, encodeUint32(3),
+		encodeName("getSum"), []byte{functionExportKind}, encodeUint32(4),
+		encodeName("memory"), []byte{0x02}, encodeUint32(0),
+		encodeName("bigIntAdd"), []byte{functionExportKind}, encodeUint32(1),
+	)...)
+	module = append(module, encodeSection(customSectionID, encodeName("producers"), []byte{0x00})...)
+	if withNameSection {
+		functionNames := encodeVector(
+			encodeUint32(2),
+			encodeUint32(2), encodeName("adder::init"),
+			encodeUint32(3), encodeName("adder::add"),
+		)
+		module = append(module, encodeSection(customSectionID,
+			encodeName(nameSectionName),
+			[]byte{0x00}, encodeVector(encodeName("adder")),
+			[]byte{functionNamesSubsection}, functionNames,
+		)...)
+	}
+
+	return module
+}
+
+func TestParseWasmModule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid preamble should error", func(t *testing.T) {
+		t.Parallel()
+
+		module, err := parseWasmModule([]byte("not a wasm module"))
+		require.Equal(t, ErrInvalidWasmModule, err)
+		require.Nil(t, module)
+
+		module, err = parseWasmModule(nil)
+		require.Equal(t, ErrInvalidWasmModule, err)
+		require.Nil(t, module)
+	})
+	t.Run("truncated module should error", func(t *testing.T) {
+		t.Parallel()
+
+		code := createTestWasmModule(true)
+		module, err := parseWasmModule(code[:len(code)-3])
+		require.True(t, errors.Is(err, ErrInvalidWasmModule))
+		require.Nil(t, module)
+	})
+	t.Run("unknown import kind should error", func(t *testing.T) {
+		t.Parallel()
+
+		code := append(make([]byte, 0), wasmPreamble...)
+		code = append(code, encodeSection(importSectionID,
+			encodeUint32(1),
+			encodeName("env"), encodeName("unknown"), []byte{0x10}, encodeUint32(0),
+		)...)
+		module, err := parseWasmModule(code)
+		require.True(t, errors.Is(err, ErrInvalidWasmModule))
+		require.Nil(t, module)
+	})
+	t.Run("module without name section should work", func(t *testing.T) {
+		t.Parallel()
+
+		module, err := parseWasmModule(createTestWasmModule(false))
+		require.Nil(t, err)
+		require.Equal(t, uint32(2), module.numImportedFunctions)
+		require.Equal(t, uint32(4), module.numDefinedFunctions)
+		expectedExports := map[string]uint32{
+			"init":      2,
+			"add":       3,
+			"getSum":    4,
+			"bigIntAdd": 1,
+		}
+		require.Equal(t, expectedExports, module.exports)
+		require.Empty(t, module.names)
+		require.Equal(t, "add", module.functionName(3, "add"))
+	})
+	t.Run("module with name section should work", func(t *testing.T) {
+		t.Parallel()
+
+		module, err := parseWasmModule(createTestWasmModule(true))
+		require.Nil(t, err)
+		require.Equal(t, map[uint32]string{2: "adder::init", 3: "adder::add"}, module.names)
+		require.Equal(t, "adder::add", module.functionName(3, "add"))
+		require.Equal(t, "getSum", module.functionName(4, "getSum"))
+		require.Equal(t, "func_5", module.functionName(5, ""))
+	})
+	t.Run("compiled contract should work", func(t *testing.T) {
+		t.Parallel()
+
+		code, err := os.ReadFile(adderWasmPath)
+		require.Nil(t, err)
+
+		module, err := parseWasmModule(code)
+		require.Nil(t, err)
+		require.Contains(t, module.exports, "init")
+		require.Contains(t, module.exports, "add")
+		require.Contains(t, module.exports, "getSum")
+		require.Equal(t, "add", module.exportNamesByIndex()[module.exports["add"]])
+	})
+}
+
+func TestWasmReader_ReadUint32(t *testing.T) {
+	t.Parallel()
+
+	reader := &wasmReader{data: []byte{0xe5, 0x8e, 0x26, 0x80, 0x80, 0x80, 0x80, 0x80, 0x01}}
+	value, err := reader.readUint32()
+	require.Nil(t, err)
+	require.Equal(t, uint32(624485), value)
+
+	value, err = reader.readUint32()
+	require.True(t, errors.Is(err, ErrInvalidWasmModule))
+	require.Zero(t, value)
+}
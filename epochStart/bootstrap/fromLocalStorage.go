@@ -115,6 +115,7 @@ func (e *epochStartBootstrap) prepareEpochFromStorage() (Parameters, error) {
 		e.coreComponentsHolder,
 		e.storageService,
 		e.stateStatsHandler,
+		false,
 	)
 	if err != nil {
 		return Parameters{}, err
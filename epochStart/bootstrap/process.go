@@ -46,6 +46,7 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
 	"github.com/multiversx/mx-chain-go/state/syncer"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
@@ -532,6 +533,7 @@ func (e *epochStartBootstrap) prepareComponentsToSyncFromNetwork() error {
 		e.coreComponentsHolder,
 		e.storageService,
 		e.stateStatsHandler,
+		false,
 	)
 	if err != nil {
 		return err
@@ -861,6 +863,7 @@ func (e *epochStartBootstrap) requestAndProcessForMeta(peerMiniBlocks []*block.M
 		e.coreComponentsHolder,
 		storageHandlerComponent.storageService,
 		e.stateStatsHandler,
+		false,
 	)
 	if err != nil {
 		return err
@@ -1084,6 +1087,7 @@ func (e *epochStartBootstrap) requestAndProcessForShard(peerMiniBlocks []*block.
 		e.coreComponentsHolder,
 		storageHandlerComponent.storageService,
 		e.stateStatsHandler,
+		false,
 	)
 	if err != nil {
 		return err
@@ -1203,18 +1207,20 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	trieStorageManager := e.trieStorageManagers[dataRetriever.UserAccountsUnit.String()]
 	e.mutTrieStorageManagers.RUnlock()
 
+	requestHandler, checkNodesOnDisk := e.getTrieNodesSource(stateSnapshot.AccountsTrie, rootHash, trieStorageManager)
+
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                            e.coreComponentsHolder.Hasher(),
 			Marshalizer:                       e.coreComponentsHolder.InternalMarshalizer(),
 			TrieStorageManager:                trieStorageManager,
-			RequestHandler:                    e.requestHandler,
+			RequestHandler:                    requestHandler,
 			Timeout:                           common.TimeoutGettingTrieNodes,
 			Cacher:                            e.dataPool.TrieNodes(),
 			MaxTrieLevelInMemory:              e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
 			MaxHardCapForMissingNodes:         e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:                 e.trieSyncerVersion,
-			CheckNodesOnDisk:                  e.checkNodesOnDisk,
+			CheckNodesOnDisk:                  checkNodesOnDisk,
 			UserAccountsSyncStatisticsHandler: e.trieSyncStatisticsProvider,
 			AppStatusHandler:                  e.statusHandler,
 			EnableEpochsHandler:               e.coreComponentsHolder.EnableEpochsHandler(),
@@ -1277,18 +1283,20 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 	peerTrieStorageManager := e.trieStorageManagers[dataRetriever.PeerAccountsUnit.String()]
 	e.mutTrieStorageManagers.RUnlock()
 
+	requestHandler, checkNodesOnDisk := e.getTrieNodesSource(stateSnapshot.PeerTrie, rootHash, peerTrieStorageManager)
+
 	argsValidatorAccountsSyncer := syncer.ArgsNewValidatorAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                            e.coreComponentsHolder.Hasher(),
 			Marshalizer:                       e.coreComponentsHolder.InternalMarshalizer(),
 			TrieStorageManager:                peerTrieStorageManager,
-			RequestHandler:                    e.requestHandler,
+			RequestHandler:                    requestHandler,
 			Timeout:                           common.TimeoutGettingTrieNodes,
 			Cacher:                            e.dataPool.TrieNodes(),
 			MaxTrieLevelInMemory:              e.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
 			MaxHardCapForMissingNodes:         e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:                 e.trieSyncerVersion,
-			CheckNodesOnDisk:                  e.checkNodesOnDisk,
+			CheckNodesOnDisk:                  checkNodesOnDisk,
 			UserAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 			AppStatusHandler:                  disabledCommon.NewAppStatusHandler(),
 			EnableEpochsHandler:               e.coreComponentsHolder.EnableEpochsHandler(),
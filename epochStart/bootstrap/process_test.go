@@ -1074,6 +1074,7 @@ func TestSyncValidatorAccountsState_NilRequestHandlerErr(t *testing.T) {
 		coreComp,
 		disabled.NewChainStorer(),
 		disabledStatistics.NewStateStatistics(),
+		false,
 	)
 	assert.Nil(t, err)
 	epochStartProvider.trieContainer = triesContainer
@@ -1094,6 +1095,7 @@ func TestCreateTriesForNewShardID(t *testing.T) {
 		coreComp,
 		disabled.NewChainStorer(),
 		disabledStatistics.NewStateStatistics(),
+		false,
 	)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(triesContainer.GetAll()))
@@ -1121,6 +1123,7 @@ func TestSyncUserAccountsState(t *testing.T) {
 		coreComp,
 		disabled.NewChainStorer(),
 		disabledStatistics.NewStateStatistics(),
+		false,
 	)
 	assert.Nil(t, err)
 	epochStartProvider.trieContainer = triesContainer
@@ -0,0 +1,80 @@
+package bootstrap
+
+import (
+	"os"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
+)
+
+// getTrieNodesSource returns the request handler and the check nodes on disk flag to be used by the trie syncer of
+// the given trie. If a state snapshot file was provided and its trie nodes were imported, the returned request handler
+// serves the trie nodes from the local storage and requests from the network only the missing ones
+func (e *epochStartBootstrap) getTrieNodesSource(
+	trieType string,
+	rootHash []byte,
+	trieStorageManager common.StorageManager,
+) (process.RequestHandler, bool) {
+	if len(e.flagsConfig.StateSnapshotFile) == 0 {
+		return e.requestHandler, e.checkNodesOnDisk
+	}
+
+	err := e.importStateSnapshot(trieType, rootHash, trieStorageManager)
+	if err != nil {
+		log.Warn("could not import the state snapshot, the trie will be synced from the network",
+			"file", e.flagsConfig.StateSnapshotFile, "trie", trieType, "rootHash", rootHash, "error", err)
+		return e.requestHandler, e.checkNodesOnDisk
+	}
+
+	localRequestHandler, err := stateSnapshot.NewLocalTrieNodesRequestHandler(stateSnapshot.ArgsLocalTrieNodesRequestHandler{
+		RequestHandler:   e.requestHandler,
+		TrieStorage:      trieStorageManager,
+		InterceptedNodes: e.dataPool.TrieNodes(),
+		Hasher:           e.coreComponentsHolder.Hasher(),
+	})
+	if err != nil {
+		log.Warn("could not create the local trie nodes request handler", "error", err)
+		return e.requestHandler, e.checkNodesOnDisk
+	}
+
+	return localRequestHandler, true
+}
+
+func (e *epochStartBootstrap) importStateSnapshot(trieType string, rootHash []byte, trieStorageManager common.StorageManager) error {
+	file, err := os.Open(e.flagsConfig.StateSnapshotFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := file.Close()
+		if errClose != nil {
+			log.Warn("could not close the state snapshot file", "error", errClose)
+		}
+	}()
+
+	reader, err := stateSnapshot.NewSnapshotReader(stateSnapshot.ArgsSnapshotReader{
+		Reader: file,
+		Hasher: e.coreComponentsHolder.Hasher(),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("importing the state snapshot", "file", e.flagsConfig.StateSnapshotFile, "trie", trieType,
+		"rootHash", rootHash, "snapshot epoch", reader.Manifest().Epoch)
+
+	numNodes, err := reader.ImportTrie(stateSnapshot.ArgsImportTrie{
+		TrieType: trieType,
+		RootHash: rootHash,
+		ShardID:  e.shardCoordinator.SelfId(),
+		Storer:   trieStorageManager,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("imported the state snapshot", "trie", trieType, "num trie nodes", numNodes)
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package bootstrap
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/epochStart/mock"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cache"
+	dataRetrieverMock "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	stateTest "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/require"
+)
+
+// createStateSnapshotFile creates a trie and exports it in a state snapshot file
+func createStateSnapshotFile(t *testing.T, shardID uint32) (string, []byte) {
+	trieStorageManager, marshaller, hasher := stateTest.GetDefaultTrieParameters()
+	tr, err := trie.NewTrie(trieStorageManager, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+	stateTest.AddDataToTrie(tr, 100)
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	filePath := filepath.Join(t.TempDir(), "state.snapshot")
+	file, err := os.Create(filePath)
+	require.Nil(t, err)
+
+	snapshotWriter, err := stateSnapshot.NewSnapshotWriter(stateSnapshot.ArgsSnapshotWriter{
+		Writer:       file,
+		Hasher:       hasher,
+		Marshaller:   marshaller,
+		ShardID:      shardID,
+		MaxChunkSize: stateSnapshot.DefaultMaxChunkSize,
+	})
+	require.Nil(t, err)
+	trieWriter, err := snapshotWriter.TrieWriter(stateSnapshot.AccountsTrie)
+	require.Nil(t, err)
+	require.Nil(t, trieWriter.WriteMainTrie(rootHash, trieStorageManager, nil))
+	_, err = snapshotWriter.Finish()
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	return filePath, rootHash
+}
+
+func createEpochStartBootstrapForStateSnapshot(stateSnapshotFile string) *epochStartBootstrap {
+	_, _, hasher := stateTest.GetDefaultTrieParameters()
+
+	return &epochStartBootstrap{
+		flagsConfig: config.ContextFlagsConfig{
+			StateSnapshotFile: stateSnapshotFile,
+		},
+		requestHandler:       &testscommon.RequestHandlerStub{},
+		checkNodesOnDisk:     false,
+		coreComponentsHolder: &mock.CoreComponentsMock{Hash: hasher},
+		shardCoordinator:     mock.NewMultipleShardsCoordinatorMock(),
+		dataPool: &dataRetrieverMock.PoolsHolderStub{
+			TrieNodesCalled: func() storage.Cacher {
+				return cache.NewCacherMock()
+			},
+		},
+	}
+}
+
+func TestEpochStartBootstrap_GetTrieNodesSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no state snapshot should sync from the network", func(t *testing.T) {
+		t.Parallel()
+
+		e := createEpochStartBootstrapForStateSnapshot("")
+		trieStorageManager, _, _ := stateTest.GetDefaultTrieParameters()
+		requestHandler, checkNodesOnDisk := e.getTrieNodesSource(stateSnapshot.AccountsTrie, []byte("rootHash"), trieStorageManager)
+		require.Equal(t, e.requestHandler, requestHandler)
+		require.False(t, checkNodesOnDisk)
+	})
+	t.Run("missing state snapshot file should sync from the network", func(t *testing.T) {
+		t.Parallel()
+
+		e := createEpochStartBootstrapForStateSnapshot(filepath.Join(t.TempDir(), "missing"))
+		trieStorageManager, _, _ := stateTest.GetDefaultTrieParameters()
+		requestHandler, checkNodesOnDisk := e.getTrieNodesSource(stateSnapshot.AccountsTrie, []byte("rootHash"), trieStorageManager)
+		require.Equal(t, e.requestHandler, requestHandler)
+		require.False(t, checkNodesOnDisk)
+	})
+	t.Run("state snapshot for another root hash should sync from the network", func(t *testing.T) {
+		t.Parallel()
+
+		e := createEpochStartBootstrapForStateSnapshot("")
+		filePath, _ := createStateSnapshotFile(t, e.shardCoordinator.SelfId())
+		e.flagsConfig.StateSnapshotFile = filePath
+
+		trieStorageManager, _, _ := stateTest.GetDefaultTrieParameters()
+		requestHandler, checkNodesOnDisk := e.getTrieNodesSource(stateSnapshot.AccountsTrie, []byte("rootHash"), trieStorageManager)
+		require.Equal(t, e.requestHandler, requestHandler)
+		require.False(t, checkNodesOnDisk)
+	})
+	t.Run("state snapshot for another shard should sync from the network", func(t *testing.T) {
+		t.Parallel()
+
+		e := createEpochStartBootstrapForStateSnapshot("")
+		filePath, rootHash := createStateSnapshotFile(t, e.shardCoordinator.SelfId()+1)
+		e.flagsConfig.StateSnapshotFile = filePath
+
+		trieStorageManager, _, _ := stateTest.GetDefaultTrieParameters()
+		requestHandler, checkNodesOnDisk := e.getTrieNodesSource(stateSnapshot.AccountsTrie, rootHash, trieStorageManager)
+		require.Equal(t, e.requestHandler, requestHandler)
+		require.False(t, checkNodesOnDisk)
+		_, err := trieStorageManager.Get(rootHash)
+		require.NotNil(t, err)
+	})
+	t.Run("valid state snapshot should import the trie nodes", func(t *testing.T) {
+		t.Parallel()
+
+		e := createEpochStartBootstrapForStateSnapshot("")
+		filePath, rootHash := createStateSnapshotFile(t, e.shardCoordinator.SelfId())
+		e.flagsConfig.StateSnapshotFile = filePath
+
+		trieStorageManager, _, _ := stateTest.GetDefaultTrieParameters()
+		requestHandler, checkNodesOnDisk := e.getTrieNodesSource(stateSnapshot.AccountsTrie, rootHash, trieStorageManager)
+		require.NotEqual(t, e.requestHandler, requestHandler)
+		require.True(t, checkNodesOnDisk)
+
+		_, marshaller, hasher := stateTest.GetDefaultTrieParameters()
+		tr, _ := trie.NewTrie(trieStorageManager, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+		importedTrie, err := tr.Recreate(holders.NewDefaultRootHashesHolder(rootHash))
+		require.Nil(t, err)
+		for i := 0; i < 100; i++ {
+			value, _, errGet := importedTrie.Get([]byte(fmt.Sprintf("value%v", i)))
+			require.Nil(t, errGet)
+			require.Equal(t, []byte(fmt.Sprintf("value%v", i)), value)
+		}
+	})
+}
@@ -155,6 +155,7 @@ func (sesb *storageEpochStartBootstrap) prepareComponentsToSync() error {
 		sesb.coreComponentsHolder,
 		sesb.storageService,
 		sesb.stateStatsHandler,
+		false,
 	)
 	if err != nil {
 		return err
@@ -763,6 +763,7 @@ func createAccountsDB(
 		StoragePruningManager: spm,
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+		AppStatusHandler:      &statusHandlerMock.AppStatusHandlerStub{},
 	}
 	adb, _ := state.NewAccountsDB(args)
 	return adb
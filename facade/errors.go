@@ -29,6 +29,9 @@ var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator
 // ErrNilBlockchain signals that a nil blockchain has been provided
 var ErrNilBlockchain = errors.New("nil blockchain")
 
+// ErrNilSubscriptionsHub signals that a nil subscriptions hub has been provided
+var ErrNilSubscriptionsHub = errors.New("nil subscriptions hub")
+
 // ErrEmptyRootHash signals that the current root hash is empty
 var ErrEmptyRootHash = errors.New("empty current root hash")
 
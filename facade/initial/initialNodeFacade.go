@@ -19,7 +19,9 @@ import (
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/ntp"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 )
@@ -227,6 +229,11 @@ func (inf *initialNodeFacade) ExecuteSCQuery(_ *process.SCQuery) (*vm.VMOutputAp
 	return nil, api.BlockInfo{}, errNodeStarting
 }
 
+// ExecuteSCQueries returns nil and error
+func (inf *initialNodeFacade) ExecuteSCQueries(_ []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
 // PprofEnabled returns false
 func (inf *initialNodeFacade) PprofEnabled() bool {
 	return inf.pprofEnabled
@@ -357,6 +364,21 @@ func (inf *initialNodeFacade) IterateKeys(_ string, _ uint, _ [][]byte, _ api.Ac
 	return nil, nil, api.BlockInfo{}, errNodeStarting
 }
 
+// GetAccountStateDiff returns nil and error
+func (inf *initialNodeFacade) GetAccountStateDiff(_ string, _ common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetStateDiff returns nil and error
+func (inf *initialNodeFacade) GetStateDiff(_ common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// ExportStateSnapshot returns empty string and error
+func (inf *initialNodeFacade) ExportStateSnapshot(_ uint32) (string, error) {
+	return "", errNodeStarting
+}
+
 // GetGuardianData returns error
 func (inf *initialNodeFacade) GetGuardianData(_ string, _ api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error) {
 	return api.GuardianData{}, api.BlockInfo{}, errNodeStarting
@@ -422,6 +444,11 @@ func (inf *initialNodeFacade) GetTransactionsPoolForSender(_, _ string) (*common
 	return nil, errNodeStarting
 }
 
+// GetTransactionsForAddress returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsForAddress(_ string, _ common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetGasConfigs return a nil map and error
 func (inf *initialNodeFacade) GetGasConfigs() (map[string]map[string]uint64, error) {
 	return nil, errNodeStarting
@@ -437,6 +464,16 @@ func (inf *initialNodeFacade) GetSCRsByTxHash(_ string, _ string) ([]*transactio
 	return nil, errNodeStarting
 }
 
+// GetTransactionTrace returns nil and error
+func (inf *initialNodeFacade) GetTransactionTrace(_ string) (*tracing.TransactionTrace, error) {
+	return nil, errNodeStarting
+}
+
+// Subscribe returns nil and error
+func (inf *initialNodeFacade) Subscribe(_ subscriptions.Filter) (*subscriptions.Subscription, error) {
+	return nil, errNodeStarting
+}
+
 // GetManagedKeysCount returns 0
 func (inf *initialNodeFacade) GetManagedKeysCount() int {
 	return 0
@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
@@ -130,6 +132,10 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, vo)
 	assert.Equal(t, errNodeStarting, err)
 
+	queriesResults, _, err := inf.ExecuteSCQueries(nil)
+	assert.Nil(t, queriesResults)
+	assert.Equal(t, errNodeStarting, err)
+
 	b = inf.PprofEnabled()
 	assert.True(t, b)
 
@@ -241,6 +247,22 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, txs)
 	assert.Equal(t, errNodeStarting, err)
 
+	addressTxs, err := inf.GetTransactionsForAddress("", common.AddressTransactionsQueryOptions{})
+	assert.Nil(t, addressTxs)
+	assert.Equal(t, errNodeStarting, err)
+
+	stateDiff, err := inf.GetAccountStateDiff("", common.StateDiffQueryOptions{})
+	assert.Nil(t, stateDiff)
+	assert.Equal(t, errNodeStarting, err)
+
+	stateDiff, err = inf.GetStateDiff(common.StateDiffQueryOptions{})
+	assert.Nil(t, stateDiff)
+	assert.Equal(t, errNodeStarting, err)
+
+	stateSnapshotFile, err := inf.ExportStateSnapshot(0)
+	assert.Empty(t, stateSnapshotFile)
+	assert.Equal(t, errNodeStarting, err)
+
 	nonce, err := inf.GetLastPoolNonceForSender("")
 	assert.Equal(t, uint64(0), nonce)
 	assert.Equal(t, errNodeStarting, err)
@@ -354,6 +376,10 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Zero(t, left)
 	assert.Equal(t, errNodeStarting, err)
 
+	subscription, err := inf.Subscribe(subscriptions.Filter{})
+	assert.Nil(t, subscription)
+	assert.Equal(t, errNodeStarting, err)
+
 	assert.NotNil(t, inf)
 }
 
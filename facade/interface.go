@@ -16,7 +16,9 @@ import (
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -45,6 +47,15 @@ type NodeHandler interface {
 	// IterateKeys returns the key-value pairs under a given address starting from a given state
 	IterateKeys(address string, numKeys uint, iteratorState [][]byte, options api.AccountQueryOptions, ctx context.Context) (map[string]string, [][]byte, api.BlockInfo, error)
 
+	// GetAccountStateDiff returns the differences of a given address between two blocks
+	GetAccountStateDiff(address string, options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffApiResponse, error)
+
+	// GetStateDiff returns the differences of all the accounts between two blocks
+	GetStateDiff(options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffApiResponse, error)
+
+	// ExportStateSnapshot starts exporting the state of the given epoch in a state snapshot file
+	ExportStateSnapshot(epoch uint32) (string, error)
+
 	// GetAllIssuedESDTs returns all the issued esdt tokens from esdt system smart contract
 	GetAllIssuedESDTs(tokenType string, ctx context.Context) ([]string, error)
 
@@ -125,6 +136,7 @@ type TransactionSimulatorProcessor interface {
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
 	SimulateSCRExecutionCost(scr *smartContractResult.SmartContractResult) (*transaction.CostResponse, error)
@@ -134,8 +146,10 @@ type ApiResolver interface {
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetTransactionTrace(hash string) (*tracing.TransactionTrace, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
+	GetTransactionsForAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
@@ -170,3 +184,9 @@ type HardforkTrigger interface {
 	IsSelfTrigger() bool
 	IsInterfaceNil() bool
 }
+
+// SubscriptionsHub defines the structure used to register subscribers for block, transaction and event notifications
+type SubscriptionsHub interface {
+	Subscribe(filter subscriptions.Filter) (*subscriptions.Subscription, error)
+	IsInterfaceNil() bool
+}
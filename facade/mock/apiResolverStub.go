@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -18,6 +19,7 @@ import (
 // ApiResolverStub -
 type ApiResolverStub struct {
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteSCQueriesHandler                     func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
@@ -42,6 +44,7 @@ type ApiResolverStub struct {
 	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
+	GetTransactionsForAddressCalled             func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
 	GetGasConfigsCalled                         func() map[string]map[string]uint64
@@ -52,6 +55,7 @@ type ApiResolverStub struct {
 	GetWaitingManagedKeysCalled                 func() ([]string, error)
 	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
 	GetSCRsByTxHashCalled                       func(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetTransactionTraceCalled                   func(hash string) (*tracing.TransactionTrace, error)
 	SimulateSCRExecutionCostCalled              func(scr *smartContractResult.SmartContractResult) (*transaction.CostResponse, error)
 }
 
@@ -64,6 +68,15 @@ func (ars *ApiResolverStub) SimulateSCRExecutionCost(scr *smartContractResult.Sm
 	return nil, nil
 }
 
+// GetTransactionTrace -
+func (ars *ApiResolverStub) GetTransactionTrace(hash string) (*tracing.TransactionTrace, error) {
+	if ars.GetTransactionTraceCalled != nil {
+		return ars.GetTransactionTraceCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // GetSCRsByTxHash -
 func (ars *ApiResolverStub) GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error) {
 	if ars.GetSCRsByTxHashCalled != nil {
@@ -127,6 +140,15 @@ func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nil, nil, nil
 }
 
+// ExecuteSCQueries -
+func (ars *ApiResolverStub) ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if ars.ExecuteSCQueriesHandler != nil {
+		return ars.ExecuteSCQueriesHandler(queries)
+	}
+
+	return nil, nil, nil
+}
+
 // StatusMetrics -
 func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 	if ars.StatusMetricsHandler != nil {
@@ -368,6 +390,15 @@ func (ars *ApiResolverStub) Close() error {
 	return nil
 }
 
+// GetTransactionsForAddress -
+func (ars *ApiResolverStub) GetTransactionsForAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	if ars.GetTransactionsForAddressCalled != nil {
+		return ars.GetTransactionsForAddressCalled(address, options)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	return ars == nil
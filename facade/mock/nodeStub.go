@@ -50,6 +50,9 @@ type NodeStub struct {
 	GetESDTsRolesCalled                            func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string][]string, api.BlockInfo, error)
 	GetKeyValuePairsCalled                         func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
 	IterateKeysCalled                              func(address string, numKeys uint, iteratorState [][]byte, options api.AccountQueryOptions, ctx context.Context) (map[string]string, [][]byte, api.BlockInfo, error)
+	GetAccountStateDiffCalled                      func(address string, options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffApiResponse, error)
+	GetStateDiffCalled                             func(options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffApiResponse, error)
+	ExportStateSnapshotCalled                      func(epoch uint32) (string, error)
 	GetAllIssuedESDTsCalled                        func(tokenType string, ctx context.Context) ([]string, error)
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
@@ -122,6 +125,33 @@ func (ns *NodeStub) IterateKeys(address string, numKeys uint, iteratorState [][]
 	return nil, nil, api.BlockInfo{}, nil
 }
 
+// GetAccountStateDiff -
+func (ns *NodeStub) GetAccountStateDiff(address string, options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffApiResponse, error) {
+	if ns.GetAccountStateDiffCalled != nil {
+		return ns.GetAccountStateDiffCalled(address, options, ctx)
+	}
+
+	return nil, nil
+}
+
+// GetStateDiff -
+func (ns *NodeStub) GetStateDiff(options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffApiResponse, error) {
+	if ns.GetStateDiffCalled != nil {
+		return ns.GetStateDiffCalled(options, ctx)
+	}
+
+	return nil, nil
+}
+
+// ExportStateSnapshot -
+func (ns *NodeStub) ExportStateSnapshot(epoch uint32) (string, error) {
+	if ns.ExportStateSnapshotCalled != nil {
+		return ns.ExportStateSnapshotCalled(epoch)
+	}
+
+	return "", nil
+}
+
 // GetValueForKey -
 func (ns *NodeStub) GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if ns.GetValueForKeyCalled != nil {
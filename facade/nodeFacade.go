@@ -26,7 +26,9 @@ import (
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/ntp"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -53,6 +55,7 @@ type ArgNodeFacade struct {
 	AccountsState          state.AccountsAdapter
 	PeerState              state.AccountsAdapter
 	Blockchain             chainData.ChainHandler
+	SubscriptionsHub       SubscriptionsHub
 }
 
 // nodeFacade represents a facade for grouping the functionality for the node
@@ -68,6 +71,7 @@ type nodeFacade struct {
 	accountsState          state.AccountsAdapter
 	peerState              state.AccountsAdapter
 	blockchain             chainData.ChainHandler
+	subscriptionsHub       SubscriptionsHub
 }
 
 // NewNodeFacade creates a new Facade with a NodeWrapper
@@ -94,6 +98,9 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.Blockchain) {
 		return nil, ErrNilBlockchain
 	}
+	if check.IfNil(arg.SubscriptionsHub) {
+		return nil, ErrNilSubscriptionsHub
+	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
 
@@ -108,6 +115,7 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		accountsState:          arg.AccountsState,
 		peerState:              arg.PeerState,
 		blockchain:             arg.Blockchain,
+		subscriptionsHub:       arg.SubscriptionsHub,
 	}
 
 	return nf, nil
@@ -238,6 +246,27 @@ func (nf *nodeFacade) IterateKeys(address string, numKeys uint, iteratorState []
 	return nf.node.IterateKeys(address, numKeys, iteratorState, options, ctx)
 }
 
+// GetAccountStateDiff returns the differences of the provided address between two blocks
+func (nf *nodeFacade) GetAccountStateDiff(address string, options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetAccountStateDiff(address, options, ctx)
+}
+
+// GetStateDiff returns the differences of all the accounts between two blocks
+func (nf *nodeFacade) GetStateDiff(options common.StateDiffQueryOptions) (*common.StateDiffApiResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetStateDiff(options, ctx)
+}
+
+// ExportStateSnapshot starts exporting the state of the given epoch in a state snapshot file, returning its path
+func (nf *nodeFacade) ExportStateSnapshot(epoch uint32) (string, error) {
+	return nf.node.ExportStateSnapshot(epoch)
+}
+
 // GetGuardianData returns the guardian data for the provided address
 func (nf *nodeFacade) GetGuardianData(address string, options apiData.AccountQueryOptions) (apiData.GuardianData, apiData.BlockInfo, error) {
 	return nf.node.GetGuardianData(address, options)
@@ -323,6 +352,16 @@ func (nf *nodeFacade) GetSCRsByTxHash(txHash string, scrHash string) ([]*transac
 	return nf.apiResolver.GetSCRsByTxHash(txHash, scrHash)
 }
 
+// GetTransactionTrace will return the call frames of the smart contract executions triggered by the provided transaction hash
+func (nf *nodeFacade) GetTransactionTrace(hash string) (*tracing.TransactionTrace, error) {
+	return nf.apiResolver.GetTransactionTrace(hash)
+}
+
+// Subscribe registers a new subscriber for the block, transaction and event notifications matching the provided filter
+func (nf *nodeFacade) Subscribe(filter subscriptions.Filter) (*subscriptions.Subscription, error) {
+	return nf.subscriptionsHub.Subscribe(filter)
+}
+
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
 func (nf *nodeFacade) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
 	return nf.apiResolver.GetTransactionsPool(fields)
@@ -333,6 +372,11 @@ func (nf *nodeFacade) GetTransactionsPoolForSender(sender, fields string) (*comm
 	return nf.apiResolver.GetTransactionsPoolForSender(sender, fields)
 }
 
+// GetTransactionsForAddress will return a page of the transactions involving the provided address
+func (nf *nodeFacade) GetTransactionsForAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	return nf.apiResolver.GetTransactionsForAddress(address, options)
+}
+
 // GetLastPoolNonceForSender will return the last nonce from pool for sender that is to be returned on API calls
 func (nf *nodeFacade) GetLastPoolNonceForSender(sender string) (uint64, error) {
 	return nf.apiResolver.GetLastPoolNonceForSender(sender)
@@ -463,6 +507,26 @@ func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, a
 	return nf.convertVmOutputToApiResponse(vmOutput), queryBlockInfoToApiResource(blockInfo), nil
 }
 
+// ExecuteSCQueries retrieves data from existing SC tries, executing all the queries against the same block
+func (nf *nodeFacade) ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultApi, apiData.BlockInfo, error) {
+	results, blockInfo, err := nf.apiResolver.ExecuteSCQueries(queries)
+	if err != nil {
+		return nil, apiData.BlockInfo{}, err
+	}
+
+	apiResults := make([]*common.SCQueryResultApi, 0, len(results))
+	for _, result := range results {
+		if result.Err != nil {
+			apiResults = append(apiResults, &common.SCQueryResultApi{Error: result.Err.Error()})
+			continue
+		}
+
+		apiResults = append(apiResults, &common.SCQueryResultApi{Data: nf.convertVmOutputToApiResponse(result.VMOutput)})
+	}
+
+	return apiResults, queryBlockInfoToApiResource(blockInfo), nil
+}
+
 // PprofEnabled returns if profiling mode should be active or not on the application
 func (nf *nodeFacade) PprofEnabled() bool {
 	return nf.config.PprofEnabled
@@ -21,15 +21,19 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/facade/mock"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
+	outportDisabled "github.com/multiversx/mx-chain-go/outport/disabled"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
@@ -71,6 +75,7 @@ func createMockArguments() ArgNodeFacade {
 				return []byte("root hash")
 			},
 		},
+		SubscriptionsHub: outportDisabled.NewDisabledSubscriptionsHub(),
 	}
 }
 
@@ -182,6 +187,16 @@ func TestNewNodeFacade(t *testing.T) {
 		require.Nil(t, nf)
 		require.Equal(t, ErrNilBlockchain, err)
 	})
+	t.Run("nil SubscriptionsHub should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.SubscriptionsHub = nil
+		nf, err := NewNodeFacade(arg)
+
+		require.Nil(t, nf)
+		require.Equal(t, ErrNilSubscriptionsHub, err)
+	})
 
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
@@ -1412,6 +1427,59 @@ func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_ExecuteSCQueries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+				return nil, nil, expectedErr
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+
+		results, _, err := nf.ExecuteSCQueries([]*process.SCQuery{{}})
+		require.Nil(t, results)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedQueries := []*process.SCQuery{{FuncName: "first"}, {FuncName: "second"}}
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+				require.Equal(t, providedQueries, queries)
+
+				return []*process.SCQueryResult{
+					{VMOutput: &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("data")}, ReturnCode: vmcommon.Ok}},
+					{Err: expectedErr},
+				}, holders.NewBlockInfo([]byte("hash"), 5, []byte("root hash")), nil
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+
+		results, blockInfo, err := nf.ExecuteSCQueries(providedQueries)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, [][]byte{[]byte("data")}, results[0].Data.ReturnData)
+		require.Equal(t, vmcommon.Ok.String(), results[0].Data.ReturnCode)
+		require.Empty(t, results[0].Error)
+		require.Nil(t, results[1].Data)
+		require.Equal(t, expectedErr.Error(), results[1].Error)
+		require.Equal(t, api.BlockInfo{
+			Nonce:    5,
+			Hash:     hex.EncodeToString([]byte("hash")),
+			RootHash: hex.EncodeToString([]byte("root hash")),
+		}, blockInfo)
+	})
+}
+
 func TestNodeFacade_GetBlockByRoundShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1688,6 +1756,37 @@ func testConvertVmOutput(t *testing.T, logData [][]byte, expectedLogData []byte,
 	require.Equal(t, expectedLogs, res.Logs)
 }
 
+func TestNodeFacade_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled hub should error", func(t *testing.T) {
+		t.Parallel()
+
+		nf, _ := NewNodeFacade(createMockArguments())
+		subscription, err := nf.Subscribe(subscriptions.Filter{})
+		require.Nil(t, subscription)
+		require.Equal(t, subscriptions.ErrSubscriptionsNotEnabled, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(subscriptions.ArgsSubscriptionsHub{
+			Marshaller:              &marshallerMock.MarshalizerMock{},
+			AddressConverter:        testscommon.RealWorldBech32PubkeyConverter,
+			MaxSubscribers:          1,
+			NotificationsBufferSize: 1,
+		})
+		arg := createMockArguments()
+		arg.SubscriptionsHub = hub
+
+		nf, _ := NewNodeFacade(arg)
+		subscription, err := nf.Subscribe(subscriptions.Filter{})
+		require.Nil(t, err)
+		require.NotNil(t, subscription)
+		require.Equal(t, 1, hub.NumSubscribers())
+	})
+}
+
 func TestNodeFacade_GetTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -1962,6 +2061,120 @@ func TestNodeFacade_GetTransactionsPoolForSender(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_GetTransactionsForAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			GetTransactionsForAddressCalled: func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+		res, err := nf.GetTransactionsForAddress("", common.AddressTransactionsQueryOptions{})
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		expectedAddress := "alice"
+		providedOptions := common.AddressTransactionsQueryOptions{Size: 10, Descending: true}
+		expectedResponse := &common.AddressTransactionsApiResponse{
+			Transactions: []*common.AddressTransactionApiResponse{
+				{
+					Hash:   "txhash1",
+					Sender: expectedAddress,
+				},
+			},
+			NextCursor: "5",
+		}
+		arg.ApiResolver = &mock.ApiResolverStub{
+			GetTransactionsForAddressCalled: func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+				require.Equal(t, expectedAddress, address)
+				require.Equal(t, providedOptions, options)
+				return expectedResponse, nil
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+		res, err := nf.GetTransactionsForAddress(expectedAddress, providedOptions)
+		require.NoError(t, err)
+		require.Equal(t, expectedResponse, res)
+	})
+}
+
+func TestNodeFacade_GetAccountStateDiff(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedAddress := "alice"
+	providedOptions := common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 5}
+	expectedResponse := &common.StateDiffApiResponse{
+		Accounts: []*common.AccountStateDiffApiResponse{
+			{
+				Address: expectedAddress,
+				Status:  common.AccountStateChanged,
+			},
+		},
+	}
+	arg.Node = &mock.NodeStub{
+		GetAccountStateDiffCalled: func(address string, options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffApiResponse, error) {
+			require.Equal(t, expectedAddress, address)
+			require.Equal(t, providedOptions, options)
+			require.NotNil(t, ctx)
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.GetAccountStateDiff(expectedAddress, providedOptions)
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, res)
+}
+
+func TestNodeFacade_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	providedOptions := common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 5}
+	arg.Node = &mock.NodeStub{
+		GetStateDiffCalled: func(options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffApiResponse, error) {
+			require.Equal(t, providedOptions, options)
+			require.NotNil(t, ctx)
+			return nil, expectedErr
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.GetStateDiff(providedOptions)
+	require.Nil(t, res)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestNodeFacade_ExportStateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		ExportStateSnapshotCalled: func(epoch uint32) (string, error) {
+			require.Equal(t, uint32(4), epoch)
+			return "file", nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	file, err := nf.ExportStateSnapshot(4)
+	require.Nil(t, err)
+	require.Equal(t, "file", file)
+}
+
 func TestNodeFacade_GetLastPoolNonceForSender(t *testing.T) {
 	t.Parallel()
 
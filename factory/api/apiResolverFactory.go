@@ -33,6 +33,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks/counters"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
@@ -69,6 +70,7 @@ type ApiResolverArgs struct {
 	Bootstrapper         process.Bootstrapper
 	AllowVMQueriesChan   chan struct{}
 	ProcessingMode       common.NodeProcessingMode
+	ExecutionTracer      tracing.ExecutionTracerHandler
 }
 
 type scQueryServiceArgs struct {
@@ -280,6 +282,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		PublicKey:                args.CryptoComponents.PublicKeyString(),
 		NodesCoordinator:         args.ProcessComponents.NodesCoordinator(),
 		StorageManagers:          storageManagers,
+		ExecutionTracer:          args.ExecutionTracer,
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
@@ -313,10 +316,15 @@ func createScQueryService(
 		isInHistoricalBalancesMode: args.isInHistoricalBalancesMode,
 	}
 
+	numPinnedStateViews := args.generalConfig.VirtualMachine.Querying.NumPinnedStateViews
+	if numPinnedStateViews < 0 {
+		return nil, nil, fmt.Errorf("VirtualMachine.Querying.NumPinnedStateViews should not be a negative number")
+	}
+
 	var err error
-	var scQueryService process.SCQueryService
+	var scQueryService process.SCQueryStateView
 	var storageManager common.StorageManager
-	storageManagers := make([]common.StorageManager, 0, numConcurrentVms)
+	storageManagers := make([]common.StorageManager, 0, numConcurrentVms+numPinnedStateViews)
 
 	list := make([]process.SCQueryService, 0, numConcurrentVms)
 	for i := 0; i < numConcurrentVms; i++ {
@@ -330,7 +338,36 @@ func createScQueryService(
 		storageManagers = append(storageManagers, storageManager)
 	}
 
-	sqQueryDispatcher, err := smartContract.NewScQueryServiceDispatcher(list)
+	if numPinnedStateViews == 0 {
+		sqQueryDispatcher, errDispatcher := smartContract.NewScQueryServiceDispatcher(list)
+		if errDispatcher != nil {
+			return nil, nil, errDispatcher
+		}
+
+		return sqQueryDispatcher, storageManagers, nil
+	}
+
+	stateViews := make([]process.SCQueryStateView, 0, numPinnedStateViews)
+	for i := 0; i < numPinnedStateViews; i++ {
+		argsQueryElem.index = numConcurrentVms + i
+		scQueryService, storageManager, err = createScQueryElement(*argsQueryElem)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		stateViews = append(stateViews, scQueryService)
+		storageManagers = append(storageManagers, storageManager)
+	}
+
+	stateViewsPool, err := smartContract.NewStateViewsPool(smartContract.ArgsStateViewsPool{
+		StateViews:    stateViews,
+		StatusHandler: args.statusCoreComponents.AppStatusHandler(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sqQueryDispatcher, err := smartContract.NewScQueryServiceDispatcherWithStateViews(list, stateViewsPool)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -340,7 +377,7 @@ func createScQueryService(
 
 func createScQueryElement(
 	args scQueryElementArgs,
-) (process.SCQueryService, common.StorageManager, error) {
+) (process.SCQueryStateView, common.StorageManager, error) {
 	var err error
 
 	selfShardID := args.processComponents.ShardCoordinator().SelfId()
@@ -603,6 +640,7 @@ func createNewAccountsAdapterApi(args scQueryElementArgs, chainHandler data.Chai
 		StoragePruningManager: storagePruning,
 		AddressConverter:      args.coreComponents.AddressPubKeyConverter(),
 		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+		AppStatusHandler:      disabled.NewAppStatusHandler(),
 	}
 
 	provider, err := blockInfoProviders.NewCurrentBlockInfo(chainHandler)
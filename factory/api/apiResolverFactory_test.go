@@ -169,6 +169,24 @@ func TestCreateApiResolver(t *testing.T) {
 		require.True(t, strings.Contains(err.Error(), "VirtualMachine.Querying.NumConcurrentVms"))
 		require.True(t, check.IfNil(apiResolver))
 	})
+	t.Run("negative number of pinned state views should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Configs.GeneralConfig.VirtualMachine.Querying.NumPinnedStateViews = -1
+		apiResolver, err := api.CreateApiResolver(args)
+		require.True(t, strings.Contains(err.Error(), "VirtualMachine.Querying.NumPinnedStateViews"))
+		require.True(t, check.IfNil(apiResolver))
+	})
+	t.Run("should work with pinned state views", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Configs.GeneralConfig.VirtualMachine.Querying.NumPinnedStateViews = 2
+		apiResolver, err := api.CreateApiResolver(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(apiResolver))
+	})
 
 	failingStepsInstance := &failingSteps{}
 	failingArgs := createFailingMockArgs(t, failingStepsInstance)
@@ -263,6 +263,8 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
 		VMOutputCacher:      txcache.NewDisabledCache(),
 		WasmVMChangeLocker:  wasmVMChangeLocker,
+		ExecutionTracer:     pcf.executionTracer,
+		GasProfiler:         pcf.gasProfiler,
 	}
 
 	scProcessorProxy, err := processProxy.NewSmartContractProcessorProxy(argsNewScProcessor, pcf.epochNotifier)
@@ -593,6 +595,8 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
 		VMOutputCacher:      txcache.NewDisabledCache(),
 		WasmVMChangeLocker:  wasmVMChangeLocker,
+		ExecutionTracer:     pcf.executionTracer,
+		GasProfiler:         pcf.gasProfiler,
 	}
 
 	scProcessorProxy, err := processProxy.NewSmartContractProcessorProxy(argsNewScProcessor, pcf.epochNotifier)
@@ -1100,6 +1104,8 @@ func (pcf *processComponentsFactory) createVMFactoryShard(
 		ESDTTransferParser:  esdtTransferParser,
 		Hasher:              pcf.coreData.Hasher(),
 		PubKeyConverter:     pcf.coreData.AddressPubKeyConverter(),
+
+		WasmCoverageCollector: pcf.wasmCoverageCollector,
 	}
 
 	return shard.NewVMContainerFactory(argsNewVMFactory)
@@ -158,6 +158,9 @@ type ProcessComponentsFactoryArgs struct {
 	ImportStartHandler     update.ImportStartHandler
 	HistoryRepo            dblookupext.HistoryRepository
 	FlagsConfig            config.ContextFlagsConfig
+	ExecutionTracer        process.ExecutionTracer
+	GasProfiler            process.GasProfiler
+	WasmCoverageCollector  process.WasmCoverageCollector
 
 	Data                    factory.DataComponentsHolder
 	CoreData                factory.CoreComponentsHolder
@@ -190,6 +193,9 @@ type processComponentsFactory struct {
 	maxRating              uint32
 	systemSCConfig         *config.SystemSmartContractsConfig
 	txLogsProcessor        process.TransactionLogProcessor
+	executionTracer        process.ExecutionTracer
+	gasProfiler            process.GasProfiler
+	wasmCoverageCollector  process.WasmCoverageCollector
 	importStartHandler     update.ImportStartHandler
 	historyRepo            dblookupext.HistoryRepository
 	epochNotifier          process.EpochNotifier
@@ -254,6 +260,9 @@ func NewProcessComponentsFactory(args ProcessComponentsFactoryArgs) (*processCom
 		epochNotifier:                  args.CoreData.EpochNotifier(),
 		statusCoreComponents:           args.StatusCoreComponents,
 		flagsConfig:                    args.FlagsConfig,
+		executionTracer:                args.ExecutionTracer,
+		gasProfiler:                    args.GasProfiler,
+		wasmCoverageCollector:          args.WasmCoverageCollector,
 		txExecutionOrderHandler:        args.TxExecutionOrderHandler,
 		genesisNonce:                   args.GenesisNonce,
 		genesisRound:                   args.GenesisRound,
@@ -5,6 +5,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
+	commonDisabled "github.com/multiversx/mx-chain-go/common/disabled"
 	"github.com/multiversx/mx-chain-go/state"
 	disabledState "github.com/multiversx/mx-chain-go/state/disabled"
 	"github.com/multiversx/mx-chain-go/state/storagePruningManager/disabled"
@@ -34,6 +35,7 @@ func createAccountAdapter(
 		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
 		AddressConverter:      addressConverter,
 		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+		AppStatusHandler:      commonDisabled.NewAppStatusHandler(),
 	}
 
 	adb, err := state.NewAccountsDB(args)
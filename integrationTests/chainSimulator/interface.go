@@ -26,5 +26,8 @@ type ChainSimulator interface {
 	ForceResetValidatorStatisticsCache() error
 	GetValidatorPrivateKeys() []crypto.PrivateKey
 	SetKeyValueForAddress(address string, keyValueMap map[string]string) error
+	CreateESDTToken(token *dtos.ESDTToken) error
+	SetESDTBalance(balance *dtos.ESDTBalance) error
+	SetESDTRoles(roles *dtos.ESDTRoles) error
 	Close()
 }
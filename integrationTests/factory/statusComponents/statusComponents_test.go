@@ -10,9 +10,11 @@ import (
 
 	"github.com/multiversx/mx-chain-go/common/forking"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/debug/gasProfiler"
 	bootstrapComp "github.com/multiversx/mx-chain-go/factory/bootstrap"
 	"github.com/multiversx/mx-chain-go/integrationTests/factory"
 	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/testscommon/goroutines"
 )
 
@@ -115,6 +117,9 @@ func TestStatusComponents_Create_Close_ShouldWork(t *testing.T) {
 		managedStatusCoreComponents,
 		gasScheduleNotifier,
 		nodesCoordinator,
+		tracing.NewDisabledExecutionTracer(),
+		gasProfiler.NewDisabledGasProfiler(),
+		nil,
 	)
 	require.Nil(t, err)
 	time.Sleep(2 * time.Second)
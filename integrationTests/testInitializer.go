@@ -494,6 +494,7 @@ func CreateAccountsDBWithEnableEpochsHandler(
 		StoragePruningManager: spm,
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      snapshotsManager,
+		AppStatusHandler:      &statusHandlerMock.AppStatusHandlerStub{},
 	}
 	adb, _ := state.NewAccountsDB(args)
 
@@ -110,7 +110,7 @@ func createTestApiConfig() config.ApiRoutesConfig {
 		"network":     {"/status", "/total-staked", "/economics", "/config"},
 		"log":         {"/log"},
 		"validator":   {"/statistics"},
-		"vm-values":   {"/hex", "/string", "/int", "/query"},
+		"vm-values":   {"/hex", "/string", "/int", "/query", "/query-multiple"},
 		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash", "/pool"},
 		"block":       {"/by-nonce/:nonce", "/by-hash/:hash", "/by-round/:round"},
 	}
@@ -342,6 +342,11 @@ func createGroups(facade Facade) map[string]shared.GroupHandler {
 		groupsMap["proof"] = proofGroup
 	}
 
+	stateGroup, err := groups.NewStateGroup(facade)
+	if err == nil {
+		groupsMap["state"] = stateGroup
+	}
+
 	transactionGroup, err := groups.NewTransactionGroup(facade)
 	if err == nil {
 		groupsMap["transaction"] = transactionGroup
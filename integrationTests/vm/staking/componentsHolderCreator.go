@@ -219,6 +219,7 @@ func createAccountsDB(
 		StoragePruningManager: spm,
 		AddressConverter:      coreComponents.AddressPubKeyConverter(),
 		SnapshotsManager:      &stateTests.SnapshotsManagerStub{},
+		AppStatusHandler:      &statusHandlerMock.AppStatusHandlerStub{},
 	}
 	adb, _ := state.NewAccountsDB(argsAccountsDb)
 	return adb
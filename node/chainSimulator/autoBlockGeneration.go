@@ -0,0 +1,109 @@
+package chainSimulator
+
+import (
+	"context"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+)
+
+// StartAutoBlockGeneration starts generating blocks in the background, every args.IntervalInMillis milliseconds if set,
+// and every time a transaction is added in the transactions pool of a node if args.OnTransactionReceived is set
+func (s *simulator) StartAutoBlockGeneration(args dtos.AutoBlockGeneration) error {
+	if args.IntervalInMillis == 0 && !args.OnTransactionReceived {
+		return errInvalidAutoBlockGeneration
+	}
+
+	s.mutAutoBlockGeneration.Lock()
+	defer s.mutAutoBlockGeneration.Unlock()
+
+	if s.cancelAutoBlockGeneration != nil {
+		return errAutoBlockGenerationAlreadyStarted
+	}
+
+	if args.OnTransactionReceived {
+		s.registerTransactionReceivedHandlers()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancelAutoBlockGeneration = cancel
+	s.chanAutoBlockGenerationDone = make(chan struct{})
+
+	log.Debug("chain simulator: starting automatic block generation",
+		"interval in millis", args.IntervalInMillis, "on transaction received", args.OnTransactionReceived)
+
+	go s.autoGenerateBlocks(ctx, args, s.chanAutoBlockGenerationDone)
+
+	return nil
+}
+
+// StopAutoBlockGeneration stops the automatic block generation, waiting for the block in progress, if any
+func (s *simulator) StopAutoBlockGeneration() {
+	s.mutAutoBlockGeneration.Lock()
+	defer s.mutAutoBlockGeneration.Unlock()
+
+	if s.cancelAutoBlockGeneration == nil {
+		return
+	}
+
+	s.cancelAutoBlockGeneration()
+	<-s.chanAutoBlockGenerationDone
+
+	s.cancelAutoBlockGeneration = nil
+	s.chanAutoBlockGenerationDone = nil
+
+	log.Debug("chain simulator: stopped automatic block generation")
+}
+
+// registerTransactionReceivedHandlers subscribes, only once, to the transactions pools of all the nodes, as the pools do
+// not support unregistering the handlers
+func (s *simulator) registerTransactionReceivedHandlers() {
+	if s.chanTransactionReceived != nil {
+		return
+	}
+
+	s.chanTransactionReceived = make(chan struct{}, 1)
+	for _, node := range s.nodes {
+		node.GetDataComponents().Datapool().Transactions().RegisterOnAdded(s.notifyTransactionReceived)
+	}
+}
+
+// notifyTransactionReceived is called by the transactions pools, possibly while a block is being generated, so it must
+// never block. Multiple notifications received before the next block is generated are collapsed into one
+func (s *simulator) notifyTransactionReceived(_ []byte, _ interface{}) {
+	select {
+	case s.chanTransactionReceived <- struct{}{}:
+	default:
+	}
+}
+
+func (s *simulator) autoGenerateBlocks(ctx context.Context, args dtos.AutoBlockGeneration, chanDone chan struct{}) {
+	defer close(chanDone)
+
+	var chanTick <-chan time.Time
+	if args.IntervalInMillis > 0 {
+		ticker := time.NewTicker(time.Duration(args.IntervalInMillis) * time.Millisecond)
+		defer ticker.Stop()
+
+		chanTick = ticker.C
+	}
+
+	var chanTransactionReceived <-chan struct{}
+	if args.OnTransactionReceived {
+		chanTransactionReceived = s.chanTransactionReceived
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-chanTick:
+		case <-chanTransactionReceived:
+		}
+
+		err := s.GenerateBlocks(1)
+		if err != nil {
+			log.Warn("chain simulator: automatic block generation failed", "error", err)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"math/big"
+	"net/http"
 	"sync"
 	"time"
 
@@ -23,6 +24,8 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/debug/gasProfiler"
+	"github.com/multiversx/mx-chain-go/debug/wasmCoverage"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/components"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/heartbeat"
@@ -30,6 +33,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
 	chainSimulatorErrors "github.com/multiversx/mx-chain-go/node/chainSimulator/errors"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -63,6 +67,15 @@ type ArgsChainSimulator struct {
 	ApiInterface               components.APIConfigurator
 	AlterConfigsFunction       func(cfg *config.Configs)
 	VmQueryDelayAfterStartInMs uint64
+	Fork                       *ForkArgs
+	// ValidatorsPrivateKeys (e.g. generated with GenerateBlsPrivateKeys) and InitialWalletKeys are optional and replace
+	// the randomly generated key material, so the same chain can be created again
+	ValidatorsPrivateKeys [][]byte
+	InitialWalletKeys     *dtos.InitialWalletKeys
+	// RecordScenario enables the recording of all the operations changing the chain, see SaveRecordedScenario
+	RecordScenario bool
+	// EnableSnapshots enables Snapshot and RevertTo. The state pruning is disabled, so the old trie nodes are kept
+	EnableSnapshots bool
 }
 
 // ArgsBaseChainSimulator holds the arguments needed to create a new instance of simulator
@@ -81,7 +94,24 @@ type simulator struct {
 	validatorsPrivateKeys  []crypto.PrivateKey
 	nodes                  map[uint32]process.NodeHandler
 	numOfShards            uint32
+	snapshotsEnabled       bool
+	snapshots              map[string]*simulatorSnapshot
+	snapshotsCounter       uint64
+	impersonatedAccounts   impersonatedAccountsHandler
+	executionTracer        tracing.ExecutionTracerHandler
+	gasProfiler            gasProfiler.GasProfilerHandler
+	wasmCoverageCollector  wasmCoverage.CoverageCollectorHandler
+	scenario               *dtos.Scenario
+	mutScenario            sync.RWMutex
 	mutex                  sync.RWMutex
+
+	chanTransactionReceived     chan struct{}
+	cancelAutoBlockGeneration   func()
+	chanAutoBlockGenerationDone chan struct{}
+	mutAutoBlockGeneration      sync.Mutex
+
+	controlAPIServer    *http.Server
+	mutControlAPIServer sync.Mutex
 }
 
 // NewChainSimulator will create a new instance of simulator
@@ -95,6 +125,10 @@ func NewChainSimulator(args ArgsChainSimulator) (*simulator, error) {
 
 // NewBaseChainSimulator will create a new instance of simulator
 func NewBaseChainSimulator(args ArgsBaseChainSimulator) (*simulator, error) {
+	if args.RecordScenario && args.Fork != nil {
+		return nil, errScenarioRecordingWithFork
+	}
+
 	instance := &simulator{
 		syncedBroadcastNetwork: components.NewSyncedBroadcastNetwork(),
 		nodes:                  make(map[uint32]process.NodeHandler),
@@ -103,6 +137,9 @@ func NewBaseChainSimulator(args ArgsBaseChainSimulator) (*simulator, error) {
 		chanStopNodeProcess:    make(chan endProcess.ArgEndProcess),
 		mutex:                  sync.RWMutex{},
 		initialStakedKeys:      make(map[string]*dtos.BLSKey),
+		snapshotsEnabled:       args.EnableSnapshots,
+		snapshots:              make(map[string]*simulatorSnapshot),
+		impersonatedAccounts:   components.NewImpersonatedAccounts(),
 	}
 
 	err := instance.createChainHandlers(args)
@@ -110,6 +147,13 @@ func NewBaseChainSimulator(args ArgsBaseChainSimulator) (*simulator, error) {
 		return nil, err
 	}
 
+	if args.RecordScenario {
+		err = instance.startScenarioRecording(args)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return instance, nil
 }
 
@@ -130,11 +174,24 @@ func (s *simulator) createChainHandlers(args ArgsBaseChainSimulator) error {
 		AlterConfigsFunction:        args.AlterConfigsFunction,
 		NumNodesWaitingListShard:    args.NumNodesWaitingListShard,
 		NumNodesWaitingListMeta:     args.NumNodesWaitingListMeta,
+		ValidatorsPrivateKeys:       args.ValidatorsPrivateKeys,
+		InitialWallets:              args.InitialWalletKeys,
+		EnableSnapshots:             args.EnableSnapshots,
 	})
 	if err != nil {
 		return err
 	}
 
+	s.executionTracer, err = createExecutionTracer(outputConfigs.Configs.GeneralConfig)
+	if err != nil {
+		return err
+	}
+	s.gasProfiler, err = createGasProfiler(outputConfigs.Configs.GeneralConfig)
+	if err != nil {
+		return err
+	}
+	s.wasmCoverageCollector = createWasmCoverageCollector(outputConfigs.Configs.GeneralConfig)
+
 	monitor := heartbeat.NewHeartbeatMonitor()
 
 	for idx := -1; idx < int(args.NumOfShards); idx++ {
@@ -143,7 +200,12 @@ func (s *simulator) createChainHandlers(args ArgsBaseChainSimulator) error {
 			shardIDStr = "metachain"
 		}
 
-		node, errCreate := s.createTestNode(*outputConfigs, args, shardIDStr, monitor)
+		shardID := core.MetachainShardId
+		if idx != -1 {
+			shardID = uint32(idx)
+		}
+
+		node, errCreate := s.createTestNode(*outputConfigs, args, shardIDStr, args.Fork.trieStoragePaths(shardID), monitor)
 		if errCreate != nil {
 			return errCreate
 		}
@@ -153,7 +215,6 @@ func (s *simulator) createChainHandlers(args ArgsBaseChainSimulator) error {
 			return errCreate
 		}
 
-		shardID := node.GetShardCoordinator().SelfId()
 		s.nodes[shardID] = node
 		s.handlers = append(s.handlers, chainHandler)
 
@@ -212,6 +273,11 @@ func (s *simulator) createChainHandlers(args ArgsBaseChainSimulator) error {
 	s.addProofs()
 	s.setBasePeerIds()
 
+	err = s.applyFork(args.Fork, outputConfigs.Configs.GeneralConfig.Hardfork)
+	if err != nil {
+		return err
+	}
+
 	log.Info("running the chain simulator with the following parameters",
 		"number of shards (including meta)", args.NumOfShards+1,
 		"round per epoch", outputConfigs.Configs.GeneralConfig.EpochStartConfig.RoundsPerEpoch,
@@ -261,7 +327,11 @@ func computeStartTimeBaseOnInitialRound(args ArgsChainSimulator) int64 {
 }
 
 func (s *simulator) createTestNode(
-	outputConfigs configs.ArgsConfigsSimulator, args ArgsBaseChainSimulator, shardIDStr string, monitor factory.HeartbeatV2Monitor,
+	outputConfigs configs.ArgsConfigsSimulator,
+	args ArgsBaseChainSimulator,
+	shardIDStr string,
+	forkedTrieStoragePaths []string,
+	monitor factory.HeartbeatV2Monitor,
 ) (process.NodeHandler, error) {
 	argsTestOnlyProcessorNode := components.ArgsTestOnlyProcessingNode{
 		Configs:                     outputConfigs.Configs,
@@ -281,6 +351,11 @@ func (s *simulator) createTestNode(
 		RoundDurationInMillis:       args.RoundDurationInMillis,
 		VmQueryDelayAfterStartInMs:  args.VmQueryDelayAfterStartInMs,
 		Monitor:                     monitor,
+		ForkedTrieStoragePaths:      forkedTrieStoragePaths,
+		ImpersonatedAccounts:        s.impersonatedAccounts,
+		ExecutionTracer:             s.executionTracer,
+		GasProfiler:                 s.gasProfiler,
+		WasmCoverageCollector:       s.getVMCoverageCollector(outputConfigs.Configs.GeneralConfig),
 	}
 
 	return components.NewTestOnlyProcessingNode(argsTestOnlyProcessorNode)
@@ -291,6 +366,20 @@ func (s *simulator) GenerateBlocks(numOfBlocks int) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
+	err := s.generateBlocks(numOfBlocks)
+	if err != nil {
+		return err
+	}
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:      dtos.GenerateBlocksAction,
+		NumOfBlocks: numOfBlocks,
+	})
+
+	return nil
+}
+
+func (s *simulator) generateBlocks(numOfBlocks int) error {
 	for idx := 0; idx < numOfBlocks; idx++ {
 		s.incrementRoundOnAllValidators()
 		err := s.allNodesCreateBlocks()
@@ -306,6 +395,20 @@ func (s *simulator) GenerateBlocksUntilEpochIsReached(targetEpoch int32) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
+	err := s.generateBlocksUntilEpochIsReached(targetEpoch)
+	if err != nil {
+		return err
+	}
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:      dtos.GenerateBlocksUntilEpochAction,
+		TargetEpoch: targetEpoch,
+	})
+
+	return nil
+}
+
+func (s *simulator) generateBlocksUntilEpochIsReached(targetEpoch int32) error {
 	maxNumberOfRounds := 10000
 	for idx := 0; idx < maxNumberOfRounds; idx++ {
 		s.incrementRoundOnAllValidators()
@@ -366,26 +469,34 @@ func (s *simulator) incrementRoundOnAllValidators() {
 // This method will call the epoch change trigger and generate block till a new epoch is reached
 func (s *simulator) ForceChangeOfEpoch() error {
 	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	log.Info("force change of epoch")
 	for shardID, node := range s.nodes {
 		err := node.ForceChangeOfEpoch()
 		if err != nil {
-			s.mutex.Unlock()
 			return fmt.Errorf("force change of epoch shardID-%d: error-%w", shardID, err)
 		}
 	}
 
 	epoch := s.nodes[core.MetachainShardId].GetProcessComponents().EpochStartTrigger().Epoch()
-	s.mutex.Unlock()
-
-	err := s.GenerateBlocksUntilEpochIsReached(int32(epoch + 1))
+	err := s.generateBlocksUntilEpochIsReached(int32(epoch + 1))
 	if err != nil {
 		return err
 	}
 
 	s.incrementRoundOnAllValidators()
 
-	return s.allNodesCreateBlocks()
+	err = s.allNodesCreateBlocks()
+	if err != nil {
+		return err
+	}
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action: dtos.ForceChangeOfEpochAction,
+	})
+
+	return nil
 }
 
 func (s *simulator) allNodesCreateBlocks() error {
@@ -440,6 +551,11 @@ func (s *simulator) AddValidatorKeys(validatorsPrivateKeys [][]byte) error {
 		}
 	}
 
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:                dtos.AddValidatorKeysAction,
+		ValidatorsPrivateKeys: encodeHexSlice(validatorsPrivateKeys),
+	})
+
 	return nil
 }
 
@@ -518,6 +634,21 @@ func (s *simulator) SetKeyValueForAddress(address string, keyValueMap map[string
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
+	err := s.setKeyValueForAddress(address, keyValueMap)
+	if err != nil {
+		return err
+	}
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:    dtos.SetKeyValuesAction,
+		Address:   address,
+		KeyValues: keyValueMap,
+	})
+
+	return nil
+}
+
+func (s *simulator) setKeyValueForAddress(address string, keyValueMap map[string]string) error {
 	addressConverter := s.nodes[core.MetachainShardId].GetCoreComponents().AddressPubKeyConverter()
 	addressBytes, err := addressConverter.Decode(address)
 	if err != nil {
@@ -553,6 +684,20 @@ func (s *simulator) SetStateMultiple(stateSlice []*dtos.AddressState) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
+	err := s.setStateMultiple(stateSlice)
+	if err != nil {
+		return err
+	}
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action: dtos.SetStateAction,
+		State:  stateSlice,
+	})
+
+	return nil
+}
+
+func (s *simulator) setStateMultiple(stateSlice []*dtos.AddressState) error {
 	addressConverter := s.nodes[core.MetachainShardId].GetCoreComponents().AddressPubKeyConverter()
 	for _, stateValue := range stateSlice {
 		addressBytes, err := addressConverter.Decode(stateValue.Address)
@@ -579,6 +724,20 @@ func (s *simulator) RemoveAccounts(addresses []string) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
+	err := s.removeAccounts(addresses)
+	if err != nil {
+		return err
+	}
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:    dtos.RemoveAccountsAction,
+		Addresses: addresses,
+	})
+
+	return nil
+}
+
+func (s *simulator) removeAccounts(addresses []string) error {
 	addressConverter := s.nodes[core.MetachainShardId].GetCoreComponents().AddressPubKeyConverter()
 	for _, address := range addresses {
 		addressBytes, err := addressConverter.Decode(address)
@@ -619,23 +778,33 @@ func (s *simulator) SendTxsAndGenerateBlocksTilAreExecuted(txsToSend []*transact
 		return nil, chainSimulatorErrors.ErrInvalidMaxNumOfBlocks
 	}
 
+	sentTxs := make([]*transaction.Transaction, 0, len(txsToSend))
 	transactionStatus := make([]*transactionWithResult, 0, len(txsToSend))
 	for idx, tx := range txsToSend {
 		if tx == nil {
+			// the transactions already sent are recorded, as they changed the chain anyway
+			s.recordSentTransactions(sentTxs)
 			return nil, fmt.Errorf("%w on position %d", chainSimulatorErrors.ErrNilTransaction, idx)
 		}
 
+		txToRecord := *tx
 		txHashHex, err := s.sendTx(tx)
 		if err != nil {
+			s.recordSentTransactions(sentTxs)
 			return nil, err
 		}
 
+		sentTxs = append(sentTxs, &txToRecord)
+
 		transactionStatus = append(transactionStatus, &transactionWithResult{
 			hexHash: txHashHex,
 			tx:      tx,
 		})
 	}
 
+	// the transactions are recorded before any block is generated, each generated block being recorded as its own step
+	s.recordSentTransactions(sentTxs)
+
 	time.Sleep(delaySendTxs)
 
 	for count := 0; count < maxNumOfBlocksToGenerateWhenExecutingTx; count++ {
@@ -653,6 +822,27 @@ func (s *simulator) SendTxsAndGenerateBlocksTilAreExecuted(txsToSend []*transact
 	return nil, errors.New("something went wrong. Transaction(s) is/are still in pending")
 }
 
+func (s *simulator) generateBlocksWithLock(numOfBlocks int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.generateBlocks(numOfBlocks)
+}
+
+func (s *simulator) recordSentTransactions(sentTxs []*transaction.Transaction) {
+	if len(sentTxs) == 0 {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:       dtos.SendTransactionsAction,
+		Transactions: sentTxs,
+	})
+}
+
 func (s *simulator) computeTransactionsStatus(txsWithResult []*transactionWithResult) bool {
 	allAreExecuted := true
 	contractDeploySCAddress := make([]byte, s.GetNodeHandler(0).GetCoreComponents().AddressPubKeyConverter().Len())
@@ -690,8 +880,13 @@ func getApiTransactionsFromResult(txWithResult []*transactionWithResult) []*tran
 }
 
 func (s *simulator) sendTx(tx *transaction.Transaction) (string, error) {
+	tx, err := s.guardImpersonatedTransaction(tx)
+	if err != nil {
+		return "", err
+	}
+
 	shardID := s.GetNodeHandler(0).GetShardCoordinator().ComputeId(tx.SndAddr)
-	err := s.GetNodeHandler(shardID).GetFacadeHandler().ValidateTransaction(tx)
+	err = s.GetNodeHandler(shardID).GetFacadeHandler().ValidateTransaction(tx)
 	if err != nil {
 		return "", err
 	}
@@ -751,6 +946,9 @@ func (s *simulator) GetAccount(address dtos.WalletAddress) (api.AccountResponse,
 
 // Close will stop and close the simulator
 func (s *simulator) Close() {
+	s.StopAutoBlockGeneration()
+	s.stopControlAPI()
+
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -1,6 +1,7 @@
 package chainSimulator
 
 import (
+	"bytes"
 	"math/big"
 	"strings"
 	"testing"
@@ -445,3 +446,133 @@ func TestSimulator_SentMoveBalanceNoGasForFee(t *testing.T) {
 	_, err = chainSimulator.sendTx(ftx)
 	require.True(t, strings.Contains(err.Error(), errors.ErrInsufficientFunds.Error()))
 }
+
+func TestSimulator_SnapshotsNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	chainSimulator := &simulator{}
+
+	id, err := chainSimulator.Snapshot()
+	require.Equal(t, errSnapshotsNotEnabled, err)
+	require.Empty(t, id)
+
+	err = chainSimulator.RevertTo("0")
+	require.Equal(t, errSnapshotsNotEnabled, err)
+}
+
+func TestSimulator_SnapshotAndRevert(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    100,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+		EnableSnapshots:        true,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(5)
+	require.Nil(t, err)
+
+	initialBalance := big.NewInt(0).Mul(chainSimulatorCommon.OneEGLD, big.NewInt(10))
+	sender, err := chainSimulator.GenerateAndMintWalletAddress(0, initialBalance)
+	require.Nil(t, err)
+	receiver, err := chainSimulator.GenerateAndMintWalletAddress(1, big.NewInt(0))
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	err = chainSimulator.RevertTo("missing")
+	require.ErrorIs(t, err, errSnapshotNotFound)
+
+	snapshotID, err := chainSimulator.Snapshot()
+	require.Nil(t, err)
+
+	nonceAtSnapshot := chainSimulator.GetNodeHandler(0).GetChainHandler().GetCurrentBlockHeader().GetNonce()
+	roundAtSnapshot := chainSimulator.GetNodeHandler(0).GetCoreComponents().RoundHandler().Index()
+
+	sendAndCheckTransfer := func(txNonce uint64) {
+		tx := &transaction.Transaction{
+			Nonce:     txNonce,
+			Value:     chainSimulatorCommon.OneEGLD,
+			SndAddr:   sender.Bytes,
+			RcvAddr:   receiver.Bytes,
+			GasLimit:  50_000,
+			GasPrice:  1_000_000_000,
+			ChainID:   []byte(configs.ChainID),
+			Version:   1,
+			Signature: []byte("010101"),
+		}
+
+		result, errSend := chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 10)
+		require.Nil(t, errSend)
+		require.Equal(t, transaction.TxStatusSuccess, result.Status)
+
+		err = chainSimulator.GenerateBlocks(5)
+		require.Nil(t, err)
+
+		account, errGet := chainSimulator.GetAccount(receiver)
+		require.Nil(t, errGet)
+		require.Equal(t, chainSimulatorCommon.OneEGLD.String(), account.Balance)
+	}
+
+	revertWithMissingTrieShouldNotChangeTheNodes := func() {
+		metaSnapshot := chainSimulator.snapshots[snapshotID].nodes[core.MetachainShardId]
+		accountsRootHash := metaSnapshot.accountsRootHash
+		metaSnapshot.accountsRootHash = bytes.Repeat([]byte{1}, len(accountsRootHash))
+		defer func() {
+			metaSnapshot.accountsRootHash = accountsRootHash
+		}()
+
+		errRevert := chainSimulator.RevertTo(snapshotID)
+		require.NotNil(t, errRevert)
+
+		currentHeader := chainSimulator.GetNodeHandler(0).GetChainHandler().GetCurrentBlockHeader()
+		require.Greater(t, currentHeader.GetNonce(), nonceAtSnapshot)
+		account, errGet := chainSimulator.GetAccount(receiver)
+		require.Nil(t, errGet)
+		require.Equal(t, chainSimulatorCommon.OneEGLD.String(), account.Balance)
+	}
+
+	for i := 0; i < 2; i++ {
+		sendAndCheckTransfer(0)
+		if i == 0 {
+			revertWithMissingTrieShouldNotChangeTheNodes()
+		}
+
+		err = chainSimulator.RevertTo(snapshotID)
+		require.Nil(t, err)
+
+		currentHeader := chainSimulator.GetNodeHandler(0).GetChainHandler().GetCurrentBlockHeader()
+		require.Equal(t, nonceAtSnapshot, currentHeader.GetNonce())
+		require.Equal(t, roundAtSnapshot, chainSimulator.GetNodeHandler(0).GetCoreComponents().RoundHandler().Index())
+
+		account, errGet := chainSimulator.GetAccount(sender)
+		require.Nil(t, errGet)
+		require.Equal(t, initialBalance.String(), account.Balance)
+		require.Equal(t, uint64(0), account.Nonce)
+
+		account, errGet = chainSimulator.GetAccount(receiver)
+		require.Nil(t, errGet)
+		require.Equal(t, "0", account.Balance)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing/disabled/singlesig"
 	"github.com/multiversx/mx-chain-go/common"
@@ -24,6 +25,7 @@ type ArgsCryptoComponentsHolder struct {
 	CoreComponentsHolder        factory.CoreComponentsHolder
 	AllValidatorKeysPemFileName string
 	BypassTxSignatureCheck      bool
+	ImpersonatedAccounts        ImpersonatedAccountsHandler
 }
 
 type cryptoComponentsHolder struct {
@@ -108,9 +110,15 @@ func CreateCryptoComponents(args ArgsCryptoComponentsHolder) (*cryptoComponentsH
 	instance.keysHandler = managedCryptoComponents.KeysHandler()
 	instance.managedCryptoComponentsCloser = managedCryptoComponents
 
-	if args.BypassTxSignatureCheck {
+	switch {
+	case args.BypassTxSignatureCheck:
 		instance.txSingleSigner = &singlesig.DisabledSingleSig{}
-	} else {
+	case !check.IfNil(args.ImpersonatedAccounts):
+		instance.txSingleSigner = &impersonationSingleSigner{
+			SingleSigner:         managedCryptoComponents.TxSingleSigner(),
+			impersonatedAccounts: args.ImpersonatedAccounts,
+		}
+	default:
 		instance.txSingleSigner = managedCryptoComponents.TxSingleSigner()
 	}
 
@@ -0,0 +1,148 @@
+package components
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+)
+
+var errInvalidTrieStoragePath = errors.New("the trie storage path is not a directory")
+
+const (
+	forkedStorageBatchDelaySeconds = 1
+	forkedStorageMaxBatchSize      = 100
+	forkedStorageMaxOpenFiles      = 10
+)
+
+// readThroughPersister writes only in the local persister and reads from the fallback persisters the keys which are
+// not found locally. The fallback persisters are never altered
+type readThroughPersister struct {
+	storage.Persister
+	fallbacks []storage.Persister
+}
+
+// Get returns the value from the local persister or, if missing, from the first fallback persister holding the key
+func (persister *readThroughPersister) Get(key []byte) ([]byte, error) {
+	value, err := persister.Persister.Get(key)
+	if err == nil {
+		return value, nil
+	}
+
+	for _, fallback := range persister.fallbacks {
+		value, errGet := fallback.Get(key)
+		if errGet == nil {
+			return value, nil
+		}
+	}
+
+	return nil, err
+}
+
+// Has returns nil if the key is found in the local persister or in any of the fallback persisters
+func (persister *readThroughPersister) Has(key []byte) error {
+	err := persister.Persister.Has(key)
+	if err == nil {
+		return nil
+	}
+
+	for _, fallback := range persister.fallbacks {
+		if fallback.Has(key) == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// Close closes the local persister and the fallback persisters
+func (persister *readThroughPersister) Close() error {
+	err := persister.Persister.Close()
+	for _, fallback := range persister.fallbacks {
+		errClose := fallback.Close()
+		if errClose != nil {
+			err = errClose
+		}
+	}
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (persister *readThroughPersister) IsInterfaceNil() bool {
+	return persister == nil
+}
+
+// CreateForkedMemUnitForTries returns a trie storer that keeps the new data in memory and reads the missing trie
+// nodes from the provided node trie storage directories
+func CreateForkedMemUnitForTries(trieStoragePaths []string) (storage.Storer, error) {
+	fallbacks := make([]storage.Persister, 0, len(trieStoragePaths))
+	for _, trieStoragePath := range trieStoragePaths {
+		fallback, err := openTrieStorageDirectory(trieStoragePath)
+		if err != nil {
+			closePersisters(fallbacks)
+			return nil, err
+		}
+
+		fallbacks = append(fallbacks, fallback)
+	}
+
+	cache, err := storageunit.NewCache(storageunit.CacheConfig{Type: storageunit.LRUCache, Capacity: 10_000_000, Shards: 1})
+	if err != nil {
+		closePersisters(fallbacks)
+		return nil, err
+	}
+
+	persist, err := database.NewlruDB(10_000_000)
+	if err != nil {
+		closePersisters(fallbacks)
+		return nil, err
+	}
+
+	unit, err := storageunit.NewStorageUnit(cache, &readThroughPersister{
+		Persister: persist,
+		fallbacks: fallbacks,
+	})
+	if err != nil {
+		closePersisters(fallbacks)
+		return nil, err
+	}
+
+	return &trieStorage{
+		Storer: unit,
+	}, nil
+}
+
+func openTrieStorageDirectory(trieStoragePath string) (storage.Persister, error) {
+	info, err := os.Stat(trieStoragePath)
+	if err != nil {
+		return nil, fmt.Errorf("%w while opening the forked trie storage %s", err, trieStoragePath)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%w, path: %s", errInvalidTrieStoragePath, trieStoragePath)
+	}
+
+	// the type and the sharding of the persister are read from the config.toml file of the directory, if present
+	persisterFactory, err := factory.NewPersisterFactory(config.DBConfig{
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: forkedStorageBatchDelaySeconds,
+		MaxBatchSize:      forkedStorageMaxBatchSize,
+		MaxOpenFiles:      forkedStorageMaxOpenFiles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return persisterFactory.CreateReadOnly(trieStoragePath)
+}
+
+func closePersisters(persisters []storage.Persister) {
+	for _, persister := range persisters {
+		_ = persister.Close()
+	}
+}
@@ -0,0 +1,109 @@
+package components
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/stretchr/testify/require"
+)
+
+func createTrieStorageDirectory(t *testing.T, pairs map[string]string) string {
+	dir := t.TempDir()
+	db, err := database.NewLevelDB(dir, forkedStorageBatchDelaySeconds, forkedStorageMaxBatchSize, forkedStorageMaxOpenFiles)
+	require.NoError(t, err)
+
+	for key, value := range pairs {
+		require.NoError(t, db.Put([]byte(key), []byte(value)))
+	}
+	require.NoError(t, db.Close())
+
+	return dir
+}
+
+func TestCreateForkedMemUnitForTries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := CreateForkedMemUnitForTries([]string{filepath.Join(t.TempDir(), "missing")})
+		require.True(t, errors.Is(err, os.ErrNotExist))
+		require.Nil(t, storer)
+	})
+	t.Run("file instead of directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		file := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(file, []byte("data"), os.ModePerm))
+
+		storer, err := CreateForkedMemUnitForTries([]string{file})
+		require.True(t, errors.Is(err, errInvalidTrieStoragePath))
+		require.Nil(t, storer)
+	})
+	t.Run("should read through and write only in memory", func(t *testing.T) {
+		t.Parallel()
+
+		dir1 := createTrieStorageDirectory(t, map[string]string{"key1": "value1"})
+		dir2 := createTrieStorageDirectory(t, map[string]string{"key1": "other", "key2": "value2"})
+
+		storer, err := CreateForkedMemUnitForTries([]string{dir1, dir2})
+		require.NoError(t, err)
+		_, ok := storer.(*trieStorage)
+		require.True(t, ok)
+
+		value, err := storer.Get([]byte("key1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1"), value)
+
+		value, err = storer.Get([]byte("key2"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value2"), value)
+		require.NoError(t, storer.Has([]byte("key2")))
+
+		_, err = storer.Get([]byte("key3"))
+		require.Error(t, err)
+		require.Error(t, storer.Has([]byte("key3")))
+
+		require.NoError(t, storer.Put([]byte("key3"), []byte("value3")))
+		value, err = storer.Get([]byte("key3"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value3"), value)
+
+		require.NoError(t, storer.Close())
+
+		db, err := database.NewLevelDB(dir1, forkedStorageBatchDelaySeconds, forkedStorageMaxBatchSize, forkedStorageMaxOpenFiles)
+		require.NoError(t, err)
+		require.Error(t, db.Has([]byte("key3")))
+		require.NoError(t, db.Close())
+	})
+	t.Run("should open the sharded pebble persister described by the config file of the directory", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		persisterFactory, _ := factory.NewPersisterFactory(config.DBConfig{
+			Type:                "PebbleDB",
+			BatchDelaySeconds:   1,
+			MaxBatchSize:        1,
+			MaxOpenFiles:        10,
+			NumShards:           4,
+			ShardIDProviderType: "BinarySplit",
+		})
+		db, err := persisterFactory.Create(dir)
+		require.NoError(t, err)
+		require.NoError(t, db.Put([]byte("key1"), []byte("value1")))
+		require.NoError(t, db.Close())
+
+		storer, err := CreateForkedMemUnitForTries([]string{dir})
+		require.NoError(t, err)
+
+		value, err := storer.Get([]byte("key1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1"), value)
+		require.NoError(t, storer.Close())
+	})
+}
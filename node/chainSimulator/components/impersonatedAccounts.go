@@ -0,0 +1,121 @@
+package components
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+type impersonatedAccounts struct {
+	mut       sync.RWMutex
+	addresses map[string]struct{}
+}
+
+// NewImpersonatedAccounts returns a new instance of the impersonated accounts holder, shared by all the nodes
+func NewImpersonatedAccounts() *impersonatedAccounts {
+	return &impersonatedAccounts{
+		addresses: make(map[string]struct{}),
+	}
+}
+
+// Add will mark the provided address as impersonated
+func (ia *impersonatedAccounts) Add(address []byte) {
+	ia.mut.Lock()
+	ia.addresses[string(address)] = struct{}{}
+	ia.mut.Unlock()
+}
+
+// Remove will stop impersonating the provided address
+func (ia *impersonatedAccounts) Remove(address []byte) {
+	ia.mut.Lock()
+	delete(ia.addresses, string(address))
+	ia.mut.Unlock()
+}
+
+// IsImpersonated returns true if the provided address is impersonated
+func (ia *impersonatedAccounts) IsImpersonated(address []byte) bool {
+	ia.mut.RLock()
+	defer ia.mut.RUnlock()
+
+	_, found := ia.addresses[string(address)]
+	return found
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ia *impersonatedAccounts) IsInterfaceNil() bool {
+	return ia == nil
+}
+
+// impersonationSingleSigner accepts any signature of the impersonated accounts, the other signatures being verified
+// by the wrapped signer
+type impersonationSingleSigner struct {
+	crypto.SingleSigner
+	impersonatedAccounts ImpersonatedAccountsHandler
+}
+
+// Verify returns nil for the impersonated accounts, otherwise it verifies the signature
+func (signer *impersonationSingleSigner) Verify(public crypto.PublicKey, msg []byte, sig []byte) error {
+	publicKeyBytes, err := public.ToByteArray()
+	if err == nil && signer.impersonatedAccounts.IsImpersonated(publicKeyBytes) {
+		return nil
+	}
+
+	return signer.SingleSigner.Verify(public, msg, sig)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (signer *impersonationSingleSigner) IsInterfaceNil() bool {
+	return signer == nil
+}
+
+// impersonationWhiteListHandler marks as already verified the transactions whose signers are all impersonated, so
+// the guardian's signature is not checked either. The relayed v1 and v2 transactions are not white listed, their
+// signatures being handled by the impersonationSingleSigner
+type impersonationWhiteListHandler struct {
+	process.WhiteListHandler
+	impersonatedAccounts ImpersonatedAccountsHandler
+}
+
+// IsWhiteListed returns true if the intercepted data is white listed or it is a transaction of an impersonated account
+func (handler *impersonationWhiteListHandler) IsWhiteListed(interceptedData process.InterceptedData) bool {
+	if handler.WhiteListHandler.IsWhiteListed(interceptedData) {
+		return true
+	}
+
+	interceptedTx, ok := interceptedData.(process.InterceptedTransactionHandler)
+	if !ok {
+		return false
+	}
+
+	tx, ok := interceptedTx.Transaction().(*transaction.Transaction)
+	if !ok {
+		return false
+	}
+
+	return handler.areAllSignersImpersonated(tx)
+}
+
+func (handler *impersonationWhiteListHandler) areAllSignersImpersonated(tx *transaction.Transaction) bool {
+	if !handler.impersonatedAccounts.IsImpersonated(tx.SndAddr) {
+		return false
+	}
+	if common.IsRelayedTxV3(tx) && !handler.impersonatedAccounts.IsImpersonated(tx.RelayerAddr) {
+		return false
+	}
+
+	data := string(tx.Data)
+	isRelayedV1OrV2 := strings.HasPrefix(data, core.RelayedTransaction+"@") ||
+		strings.HasPrefix(data, core.RelayedTransactionV2+"@")
+
+	return !isRelayedV1OrV2
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *impersonationWhiteListHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,144 @@
+package components
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/interceptedTxMocks"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	impersonatedAddress = []byte("impersonated address")
+	regularAddress      = []byte("regular address")
+)
+
+type interceptedTxStub struct {
+	*testscommon.InterceptedDataStub
+	*interceptedTxMocks.InterceptedTxHandlerStub
+}
+
+func createInterceptedTx(tx *transaction.Transaction) process.InterceptedData {
+	return &interceptedTxStub{
+		InterceptedDataStub: &testscommon.InterceptedDataStub{},
+		InterceptedTxHandlerStub: &interceptedTxMocks.InterceptedTxHandlerStub{
+			TransactionCalled: func() data.TransactionHandler {
+				return tx
+			},
+		},
+	}
+}
+
+func TestImpersonatedAccounts(t *testing.T) {
+	t.Parallel()
+
+	var holder *impersonatedAccounts
+	require.True(t, holder.IsInterfaceNil())
+
+	holder = NewImpersonatedAccounts()
+	require.False(t, holder.IsInterfaceNil())
+	require.False(t, holder.IsImpersonated(impersonatedAddress))
+
+	holder.Add(impersonatedAddress)
+	require.True(t, holder.IsImpersonated(impersonatedAddress))
+	require.False(t, holder.IsImpersonated(regularAddress))
+
+	holder.Remove(impersonatedAddress)
+	require.False(t, holder.IsImpersonated(impersonatedAddress))
+}
+
+func TestImpersonationSingleSigner_Verify(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("invalid signature")
+	holder := NewImpersonatedAccounts()
+	holder.Add(impersonatedAddress)
+	signer := &impersonationSingleSigner{
+		SingleSigner: &cryptoMocks.SingleSignerStub{
+			VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				return expectedErr
+			},
+		},
+		impersonatedAccounts: holder,
+	}
+	require.False(t, signer.IsInterfaceNil())
+
+	createPublicKey := func(address []byte) crypto.PublicKey {
+		return &cryptoMocks.PublicKeyStub{
+			ToByteArrayStub: func() ([]byte, error) {
+				return address, nil
+			},
+		}
+	}
+
+	require.Nil(t, signer.Verify(createPublicKey(impersonatedAddress), []byte("msg"), []byte("sig")))
+	require.Equal(t, expectedErr, signer.Verify(createPublicKey(regularAddress), []byte("msg"), []byte("sig")))
+
+	holder.Remove(impersonatedAddress)
+	require.Equal(t, expectedErr, signer.Verify(createPublicKey(impersonatedAddress), []byte("msg"), []byte("sig")))
+}
+
+func TestImpersonationWhiteListHandler_IsWhiteListed(t *testing.T) {
+	t.Parallel()
+
+	holder := NewImpersonatedAccounts()
+	holder.Add(impersonatedAddress)
+	handler := &impersonationWhiteListHandler{
+		WhiteListHandler: &testscommon.WhiteListHandlerStub{
+			IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+				return false
+			},
+		},
+		impersonatedAccounts: holder,
+	}
+	require.False(t, handler.IsInterfaceNil())
+
+	t.Run("not a transaction should not white list", func(t *testing.T) {
+		require.False(t, handler.IsWhiteListed(&testscommon.InterceptedDataStub{}))
+	})
+	t.Run("already white listed should white list", func(t *testing.T) {
+		alreadyWhiteListed := &impersonationWhiteListHandler{
+			WhiteListHandler: &testscommon.WhiteListHandlerStub{
+				IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+					return true
+				},
+			},
+			impersonatedAccounts: holder,
+		}
+		require.True(t, alreadyWhiteListed.IsWhiteListed(createInterceptedTx(&transaction.Transaction{SndAddr: regularAddress})))
+	})
+	t.Run("transaction of a regular account should not white list", func(t *testing.T) {
+		require.False(t, handler.IsWhiteListed(createInterceptedTx(&transaction.Transaction{SndAddr: regularAddress})))
+	})
+	t.Run("transaction of an impersonated account should white list", func(t *testing.T) {
+		require.True(t, handler.IsWhiteListed(createInterceptedTx(&transaction.Transaction{SndAddr: impersonatedAddress})))
+	})
+	t.Run("relayed v3 transaction should white list only if the relayer is impersonated", func(t *testing.T) {
+		tx := &transaction.Transaction{
+			SndAddr:          impersonatedAddress,
+			RelayerAddr:      regularAddress,
+			RelayerSignature: []byte("sig"),
+		}
+		require.False(t, handler.IsWhiteListed(createInterceptedTx(tx)))
+
+		tx.RelayerAddr = impersonatedAddress
+		require.True(t, handler.IsWhiteListed(createInterceptedTx(tx)))
+	})
+	t.Run("relayed v1 and v2 transactions should not white list", func(t *testing.T) {
+		tx := &transaction.Transaction{
+			SndAddr: impersonatedAddress,
+			Data:    []byte(core.RelayedTransaction + "@aa"),
+		}
+		require.False(t, handler.IsWhiteListed(createInterceptedTx(tx)))
+
+		tx.Data = []byte(core.RelayedTransactionV2 + "@aa")
+		require.False(t, handler.IsWhiteListed(createInterceptedTx(tx)))
+	})
+}
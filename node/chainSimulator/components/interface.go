@@ -22,3 +22,9 @@ type NetworkMessenger interface {
 	Broadcast(topic string, buff []byte)
 	IsInterfaceNil() bool
 }
+
+// ImpersonatedAccountsHandler defines the accounts whose transactions are executed without checking their signatures
+type ImpersonatedAccountsHandler interface {
+	IsImpersonated(address []byte) bool
+	IsInterfaceNil() bool
+}
@@ -10,6 +10,7 @@ type manualRoundHandler struct {
 	genesisTimeStamp int64
 	roundDuration    time.Duration
 	initialRound     int64
+	timeOffset       int64
 }
 
 // NewManualRoundHandler returns a manual round handler instance
@@ -32,6 +33,11 @@ func (handler *manualRoundHandler) RevertOneRound() {
 	atomic.AddInt64(&handler.index, -1)
 }
 
+// SetIndex will set the current round index
+func (handler *manualRoundHandler) SetIndex(index int64) {
+	atomic.StoreInt64(&handler.index, index)
+}
+
 // Index returns the current index
 func (handler *manualRoundHandler) Index() int64 {
 	return atomic.LoadInt64(&handler.index)
@@ -46,13 +52,36 @@ func (handler *manualRoundHandler) BeforeGenesis() bool {
 func (handler *manualRoundHandler) UpdateRound(_ time.Time, _ time.Time) {
 }
 
-// TimeStamp returns the time based of the genesis timestamp and the current round
+// TimeStamp returns the time based of the genesis timestamp, the current round and the time offset
 func (handler *manualRoundHandler) TimeStamp() time.Time {
 	rounds := atomic.LoadInt64(&handler.index)
 	timeFromGenesis := handler.roundDuration * time.Duration(rounds)
 	timestamp := time.Unix(handler.genesisTimeStamp, 0).Add(timeFromGenesis)
 	timestamp = time.Unix(timestamp.Unix()-int64(handler.roundDuration.Seconds())*handler.initialRound, 0)
-	return timestamp
+	return timestamp.Add(handler.TimeOffset())
+}
+
+// SetTimeOffset sets the offset added to the time stamps of all the rounds
+func (handler *manualRoundHandler) SetTimeOffset(offset time.Duration) {
+	atomic.StoreInt64(&handler.timeOffset, int64(offset))
+}
+
+// TimeOffset returns the offset added to the time stamps of all the rounds
+func (handler *manualRoundHandler) TimeOffset() time.Duration {
+	return time.Duration(atomic.LoadInt64(&handler.timeOffset))
+}
+
+// IndexForTimeStamp returns the first round index whose time stamp is not before the provided one
+func (handler *manualRoundHandler) IndexForTimeStamp(timestamp time.Time) int64 {
+	genesisTime := time.Unix(handler.genesisTimeStamp, 0).Add(handler.TimeOffset())
+	elapsed := timestamp.Sub(genesisTime)
+
+	rounds := int64(elapsed / handler.roundDuration)
+	if elapsed%handler.roundDuration > 0 {
+		rounds++
+	}
+
+	return handler.initialRound + rounds
 }
 
 // TimeDuration returns the provided time duration for this instance
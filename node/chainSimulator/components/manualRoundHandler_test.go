@@ -41,4 +41,29 @@ func TestManualRoundHandler_Operations(t *testing.T) {
 	require.Equal(t, providedMaxTime, handler.RemainingTime(time.Now(), providedMaxTime))
 	require.False(t, handler.BeforeGenesis())
 	handler.UpdateRound(time.Now(), time.Now()) // for coverage only
+	handler.SetIndex(providedIndex + 10)
+	require.Equal(t, providedIndex+10, handler.Index())
+}
+
+func TestManualRoundHandler_TimeOffset(t *testing.T) {
+	t.Parallel()
+
+	genesisTimeStamp := int64(1000)
+	roundDuration := 6 * time.Second
+	initialRound := int64(5)
+	handler := NewManualRoundHandler(genesisTimeStamp, roundDuration, initialRound)
+	require.Equal(t, time.Unix(genesisTimeStamp, 0), handler.TimeStamp())
+	require.Equal(t, initialRound, handler.IndexForTimeStamp(time.Unix(genesisTimeStamp, 0)))
+	require.Equal(t, initialRound+1, handler.IndexForTimeStamp(time.Unix(genesisTimeStamp+1, 0)))
+	require.Equal(t, initialRound+1, handler.IndexForTimeStamp(time.Unix(genesisTimeStamp+6, 0)))
+	require.Equal(t, initialRound+2, handler.IndexForTimeStamp(time.Unix(genesisTimeStamp+7, 0)))
+
+	handler.SetTimeOffset(time.Minute)
+	require.Equal(t, time.Minute, handler.TimeOffset())
+	require.Equal(t, time.Unix(genesisTimeStamp+60, 0), handler.TimeStamp())
+	require.Equal(t, initialRound+1, handler.IndexForTimeStamp(time.Unix(genesisTimeStamp+66, 0)))
+
+	handler.SetIndex(initialRound + 10)
+	require.Equal(t, time.Unix(genesisTimeStamp+120, 0), handler.TimeStamp())
+	require.Equal(t, initialRound+10, handler.IndexForTimeStamp(handler.TimeStamp()))
 }
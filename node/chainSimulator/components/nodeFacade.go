@@ -17,10 +17,18 @@ import (
 	nodePack "github.com/multiversx/mx-chain-go/node"
 	simulatorHeartbeat "github.com/multiversx/mx-chain-go/node/chainSimulator/components/heartbeat"
 	"github.com/multiversx/mx-chain-go/node/metrics"
+	outportFactory "github.com/multiversx/mx-chain-go/outport/factory"
 	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 )
 
-func (node *testOnlyProcessingNode) createFacade(configs config.Configs, apiInterface APIConfigurator, vmQueryDelayAfterStartInMs uint64, monitor factory.HeartbeatV2Monitor) error {
+func (node *testOnlyProcessingNode) createFacade(
+	configs config.Configs,
+	apiInterface APIConfigurator,
+	vmQueryDelayAfterStartInMs uint64,
+	monitor factory.HeartbeatV2Monitor,
+	executionTracer tracing.ExecutionTracerHandler,
+) error {
 	log.Debug("creating api resolver structure")
 
 	err := node.createMetrics(configs)
@@ -64,6 +72,7 @@ func (node *testOnlyProcessingNode) createFacade(configs config.Configs, apiInte
 		AllowVMQueriesChan: allowVMQueriesChan,
 		StatusComponents:   node.StatusComponentsHolder,
 		ProcessingMode:     common.GetNodeProcessingMode(configs.ImportDbConfig),
+		ExecutionTracer:    executionTracer,
 	}
 
 	apiResolver, err := apiComp.CreateApiResolver(apiResolverArgs)
@@ -109,6 +118,19 @@ func (node *testOnlyProcessingNode) createFacade(configs config.Configs, apiInte
 		return errors.New("error creating node: " + err.Error())
 	}
 
+	subscriptionsHubConfig := configs.ExternalConfig.SubscriptionsHub
+	subscriptionsHub, err := outportFactory.CreateSubscriptionsHub(&outportFactory.SubscriptionsHubFactoryArgs{
+		Enabled:                 subscriptionsHubConfig.Enabled,
+		MaxSubscribers:          subscriptionsHubConfig.MaxSubscribers,
+		NotificationsBufferSize: subscriptionsHubConfig.NotificationsBufferSize,
+		Marshaller:              node.CoreComponentsHolder.InternalMarshalizer(),
+		AddressConverter:        node.CoreComponentsHolder.AddressPubKeyConverter(),
+		OutportHandler:          node.StatusComponentsHolder.OutportHandler(),
+	})
+	if err != nil {
+		return err
+	}
+
 	shardID := node.GetShardCoordinator().SelfId()
 	restApiInterface := apiInterface.RestApiInterface(shardID)
 
@@ -121,10 +143,11 @@ func (node *testOnlyProcessingNode) createFacade(configs config.Configs, apiInte
 			RestApiInterface: restApiInterface,
 			PprofEnabled:     flagsConfig.EnablePprof,
 		},
-		ApiRoutesConfig: *configs.ApiRoutesConfig,
-		AccountsState:   node.StateComponentsHolder.AccountsAdapter(),
-		PeerState:       node.StateComponentsHolder.PeerAccounts(),
-		Blockchain:      node.DataComponentsHolder.Blockchain(),
+		ApiRoutesConfig:  *configs.ApiRoutesConfig,
+		AccountsState:    node.StateComponentsHolder.AccountsAdapter(),
+		PeerState:        node.StateComponentsHolder.PeerAccounts(),
+		Blockchain:       node.DataComponentsHolder.Blockchain(),
+		SubscriptionsHub: subscriptionsHub,
 	}
 
 	ef, err := facade.NewNodeFacade(argNodeFacade)
@@ -144,6 +167,7 @@ func (node *testOnlyProcessingNode) createHttpServer(configs config.Configs) err
 		Facade:          node.facadeHandler,
 		ApiConfig:       *configs.ApiRoutesConfig,
 		AntiFloodConfig: configs.GeneralConfig.WebServerAntiflood,
+		StatusHandler:   node.StatusCoreComponents.AppStatusHandler(),
 	}
 
 	httpServerWrapper, err := gin.NewGinWebServerHandler(httpServerArgs)
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/core/partitioning"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/forking"
@@ -42,6 +43,11 @@ type ArgsProcessComponentsHolder struct {
 	StatusComponents     factory.StatusComponentsHolder
 	StatusCoreComponents factory.StatusCoreComponentsHolder
 	NodesCoordinator     nodesCoordinator.NodesCoordinator
+	ImpersonatedAccounts ImpersonatedAccountsHandler
+	ExecutionTracer      process.ExecutionTracer
+	GasProfiler          process.GasProfiler
+	// WasmCoverageCollector is optional, nil when the Wasm coverage collection is not enabled
+	WasmCoverageCollector process.WasmCoverageCollector
 
 	EpochConfig              config.EpochConfig
 	RoundConfig              config.RoundConfig
@@ -169,10 +175,17 @@ func CreateProcessComponents(args ArgsProcessComponentsHolder) (*processComponen
 		return nil, err
 
 	}
-	whiteListVerifiedTxs, err := interceptors.NewWhiteListDataVerifier(lruCacheTx)
+	var whiteListVerifiedTxs process.WhiteListHandler
+	whiteListVerifiedTxs, err = interceptors.NewWhiteListDataVerifier(lruCacheTx)
 	if err != nil {
 		return nil, err
 	}
+	if !check.IfNil(args.ImpersonatedAccounts) {
+		whiteListVerifiedTxs = &impersonationWhiteListHandler{
+			WhiteListHandler:     whiteListVerifiedTxs,
+			impersonatedAccounts: args.ImpersonatedAccounts,
+		}
+	}
 
 	historyRepository, err := historyRepositoryFactory.Create()
 	if err != nil {
@@ -225,6 +238,9 @@ func CreateProcessComponents(args ArgsProcessComponentsHolder) (*processComponen
 		TxExecutionOrderHandler: txExecutionOrderHandler,
 		GenesisNonce:            args.GenesisNonce,
 		GenesisRound:            args.GenesisRound,
+		ExecutionTracer:         args.ExecutionTracer,
+		GasProfiler:             args.GasProfiler,
+		WasmCoverageCollector:   args.WasmCoverageCollector,
 	}
 	processComponentsFactory, err := processComp.NewProcessComponentsFactory(processArgs)
 	if err != nil {
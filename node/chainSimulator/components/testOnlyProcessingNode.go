@@ -21,6 +21,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/block/postprocess"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
@@ -51,6 +52,11 @@ type ArgsTestOnlyProcessingNode struct {
 	MetaChainConsensusGroupSize uint32
 	RoundDurationInMillis       uint64
 	VmQueryDelayAfterStartInMs  uint64
+	ForkedTrieStoragePaths      []string
+	ImpersonatedAccounts        ImpersonatedAccountsHandler
+	ExecutionTracer             tracing.ExecutionTracerHandler
+	GasProfiler                 process.GasProfiler
+	WasmCoverageCollector       process.WasmCoverageCollector
 }
 
 type testOnlyProcessingNode struct {
@@ -88,6 +94,15 @@ func NewTestOnlyProcessingNode(args ArgsTestOnlyProcessingNode) (*testOnlyProces
 	}
 
 	var err error
+	if len(args.ForkedTrieStoragePaths) > 0 {
+		forkedTrieStorer, errCreate := CreateForkedMemUnitForTries(args.ForkedTrieStoragePaths)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		instance.StoreService.AddStorer(dataRetriever.UserAccountsUnit, forkedTrieStorer)
+	}
+
 	instance.TransactionFeeHandler = postprocess.NewFeeAccumulator()
 
 	instance.CoreComponentsHolder, err = CreateCoreComponents(ArgsCoreComponentsHolder{
@@ -123,6 +138,7 @@ func NewTestOnlyProcessingNode(args ArgsTestOnlyProcessingNode) (*testOnlyProces
 		Preferences:                 *args.Configs.PreferencesConfig,
 		CoreComponentsHolder:        instance.CoreComponentsHolder,
 		BypassTxSignatureCheck:      args.BypassTxSignatureCheck,
+		ImpersonatedAccounts:        args.ImpersonatedAccounts,
 		AllValidatorKeysPemFileName: args.Configs.ConfigurationPathsHolder.AllValidatorKeys,
 	})
 	if err != nil {
@@ -230,6 +246,10 @@ func NewTestOnlyProcessingNode(args ArgsTestOnlyProcessingNode) (*testOnlyProces
 		DataComponents:           instance.DataComponentsHolder,
 		GenesisNonce:             args.InitialNonce,
 		GenesisRound:             uint64(args.InitialRound),
+		ImpersonatedAccounts:     args.ImpersonatedAccounts,
+		ExecutionTracer:          args.ExecutionTracer,
+		GasProfiler:              args.GasProfiler,
+		WasmCoverageCollector:    args.WasmCoverageCollector,
 	})
 	if err != nil {
 		return nil, err
@@ -250,7 +270,7 @@ func NewTestOnlyProcessingNode(args ArgsTestOnlyProcessingNode) (*testOnlyProces
 		return nil, err
 	}
 
-	err = instance.createFacade(args.Configs, args.APIInterface, args.VmQueryDelayAfterStartInMs, args.Monitor)
+	err = instance.createFacade(args.Configs, args.APIInterface, args.VmQueryDelayAfterStartInMs, args.Monitor, args.ExecutionTracer)
 	if err != nil {
 		return nil, err
 	}
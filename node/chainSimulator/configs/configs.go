@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
+	"fmt"
 	"math/big"
 	"os"
 	"path"
@@ -58,6 +59,11 @@ type ArgsChainSimulatorConfigs struct {
 	NumNodesWaitingListShard    uint32
 	NumNodesWaitingListMeta     uint32
 	AlterConfigsFunction        func(cfg *config.Configs)
+	// ValidatorsPrivateKeys and InitialWallets are optional, when provided they replace the randomly generated keys
+	ValidatorsPrivateKeys [][]byte
+	InitialWallets        *dtos.InitialWalletKeys
+	// EnableSnapshots disables the state pruning, so the simulator can revert to any of its snapshots
+	EnableSnapshots bool
 }
 
 // ArgsConfigsSimulator holds the configs for the chain simulator
@@ -116,6 +122,11 @@ func CreateChainSimulatorConfigs(args ArgsChainSimulatorConfigs) (*ArgsConfigsSi
 
 	// set compatible trie configs
 	configs.GeneralConfig.StateTriesConfig.SnapshotsEnabled = false
+	if args.EnableSnapshots {
+		// keep the old trie nodes so the simulator can revert to any of its snapshots
+		configs.GeneralConfig.StateTriesConfig.AccountsStatePruningEnabled = false
+		configs.GeneralConfig.StateTriesConfig.PeerStatePruningEnabled = false
+	}
 	// enable db lookup extension
 	configs.GeneralConfig.DbLookupExtensions.Enabled = true
 
@@ -220,9 +231,14 @@ func generateGenesisFile(args ArgsChainSimulatorConfigs, configs *config.Configs
 	}
 
 	addresses := make([]data.InitialAccount, 0)
-	numOfNodes := int((args.NumNodesWaitingListShard+args.MinNodesPerShard)*args.NumOfShards + args.NumNodesWaitingListMeta + args.MetaChainMinNodes)
+	numOfNodes := computeNumOfNodes(args)
+	err = checkInitialWallets(args.InitialWallets, numOfNodes, args.NumOfShards)
+	if err != nil {
+		return nil, err
+	}
+
 	for i := 0; i < numOfNodes; i++ {
-		wallet, errGenerate := generateWalletKey(addressConverter)
+		wallet, errGenerate := getStakeWalletKey(args.InitialWallets, i, addressConverter)
 		if errGenerate != nil {
 			return nil, errGenerate
 		}
@@ -251,7 +267,7 @@ func generateGenesisFile(args ArgsChainSimulatorConfigs, configs *config.Configs
 	remainder.Mod(remainder, big.NewInt(int64(args.NumOfShards)))
 
 	for shardID := uint32(0); shardID < args.NumOfShards; shardID++ {
-		walletKey, errG := generateWalletKeyForShard(shardID, args.NumOfShards, addressConverter)
+		walletKey, errG := getBalanceWalletKey(args.InitialWallets, shardID, args.NumOfShards, addressConverter)
 		if errG != nil {
 			return nil, errG
 		}
@@ -281,6 +297,42 @@ func generateGenesisFile(args ArgsChainSimulatorConfigs, configs *config.Configs
 	return initialWalletKeys, nil
 }
 
+func computeNumOfNodes(args ArgsChainSimulatorConfigs) int {
+	return int((args.NumNodesWaitingListShard+args.MinNodesPerShard)*args.NumOfShards + args.NumNodesWaitingListMeta + args.MetaChainMinNodes)
+}
+
+func checkInitialWallets(initialWallets *dtos.InitialWalletKeys, numOfNodes int, numOfShards uint32) error {
+	if initialWallets == nil {
+		return nil
+	}
+	if len(initialWallets.StakeWallets) != numOfNodes {
+		return fmt.Errorf("%w: provided %d stake wallets, expected %d", errInvalidInitialWalletKeys, len(initialWallets.StakeWallets), numOfNodes)
+	}
+	for shardID := uint32(0); shardID < numOfShards; shardID++ {
+		if initialWallets.BalanceWallets[shardID] == nil {
+			return fmt.Errorf("%w: missing balance wallet for shard %d", errInvalidInitialWalletKeys, shardID)
+		}
+	}
+
+	return nil
+}
+
+func getStakeWalletKey(initialWallets *dtos.InitialWalletKeys, index int, converter core.PubkeyConverter) (*dtos.WalletKey, error) {
+	if initialWallets == nil {
+		return generateWalletKey(converter)
+	}
+
+	return initialWallets.StakeWallets[index], nil
+}
+
+func getBalanceWalletKey(initialWallets *dtos.InitialWalletKeys, shardID, numOfShards uint32, converter core.PubkeyConverter) (*dtos.WalletKey, error) {
+	if initialWallets == nil {
+		return generateWalletKeyForShard(shardID, numOfShards, converter)
+	}
+
+	return initialWallets.BalanceWallets[shardID], nil
+}
+
 func generateValidatorsKeyAndUpdateFiles(
 	configs *config.Configs,
 	stakeWallets []*dtos.WalletKey,
@@ -300,6 +352,11 @@ func generateValidatorsKeyAndUpdateFiles(
 
 	nodes.Hysteresis = 0
 
+	numOfNodes := computeNumOfNodes(args)
+	if len(args.ValidatorsPrivateKeys) > 0 && len(args.ValidatorsPrivateKeys) != numOfNodes {
+		return nil, nil, fmt.Errorf("%w: provided %d, expected %d", errInvalidNumOfValidatorsPrivateKeys, len(args.ValidatorsPrivateKeys), numOfNodes)
+	}
+
 	nodes.InitialNodes = make([]*sharding.InitialNode, 0)
 	configs.NodesConfig.InitialNodes = make([]*config.InitialNodeConfig, 0)
 	privateKeys := make([]crypto.PrivateKey, 0)
@@ -307,7 +364,11 @@ func generateValidatorsKeyAndUpdateFiles(
 	walletIndex := 0
 	// generate meta keys
 	for idx := uint32(0); idx < args.NumNodesWaitingListMeta+args.MetaChainMinNodes; idx++ {
-		sk, pk := blockSigningGenerator.GeneratePair()
+		sk, pk, errK := getValidatorKeyPair(blockSigningGenerator, args.ValidatorsPrivateKeys, walletIndex)
+		if errK != nil {
+			return nil, nil, errK
+		}
+
 		privateKeys = append(privateKeys, sk)
 		publicKeys = append(publicKeys, pk)
 
@@ -333,7 +394,11 @@ func generateValidatorsKeyAndUpdateFiles(
 	// generate shard keys
 	for idx1 := uint32(0); idx1 < args.NumOfShards; idx1++ {
 		for idx2 := uint32(0); idx2 < args.NumNodesWaitingListShard+args.MinNodesPerShard; idx2++ {
-			sk, pk := blockSigningGenerator.GeneratePair()
+			sk, pk, errK := getValidatorKeyPair(blockSigningGenerator, args.ValidatorsPrivateKeys, walletIndex)
+			if errK != nil {
+				return nil, nil, errK
+			}
+
 			privateKeys = append(privateKeys, sk)
 			publicKeys = append(publicKeys, pk)
 
@@ -370,6 +435,20 @@ func generateValidatorsKeyAndUpdateFiles(
 	return privateKeys, publicKeys, nil
 }
 
+func getValidatorKeyPair(keyGenerator crypto.KeyGenerator, providedPrivateKeys [][]byte, index int) (crypto.PrivateKey, crypto.PublicKey, error) {
+	if len(providedPrivateKeys) == 0 {
+		sk, pk := keyGenerator.GeneratePair()
+		return sk, pk, nil
+	}
+
+	sk, err := keyGenerator.PrivateKeyFromByteArray(providedPrivateKeys[index])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sk, sk.GeneratePublic(), nil
+}
+
 func generateValidatorsPem(validatorsFile string, publicKeys []crypto.PublicKey, privateKey []crypto.PrivateKey) error {
 	validatorPubKeyConverter, err := pubkeyConverter.NewHexPubkeyConverter(96)
 	if err != nil {
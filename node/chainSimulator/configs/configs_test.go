@@ -25,7 +25,28 @@ func TestNewProcessorRunnerChainArguments(t *testing.T) {
 		MetaChainConsensusGroupSize: 1,
 	})
 	require.Nil(t, err)
+	// the state pruning is kept as configured when the snapshots are not enabled
+	require.True(t, outputConfig.Configs.GeneralConfig.StateTriesConfig.PeerStatePruningEnabled)
 
 	pr := realcomponents.NewProcessorRunner(t, outputConfig.Configs)
 	pr.Close(t)
 }
+
+func TestCreateChainSimulatorConfigs_EnableSnapshots(t *testing.T) {
+	t.Parallel()
+
+	outputConfig, err := CreateChainSimulatorConfigs(ArgsChainSimulatorConfigs{
+		NumOfShards:                 1,
+		OriginalConfigsPath:         "../../../cmd/node/config",
+		RoundDurationInMillis:       6000,
+		TempDir:                     t.TempDir(),
+		MetaChainMinNodes:           1,
+		MinNodesPerShard:            1,
+		ConsensusGroupSize:          1,
+		MetaChainConsensusGroupSize: 1,
+		EnableSnapshots:             true,
+	})
+	require.Nil(t, err)
+	require.False(t, outputConfig.Configs.GeneralConfig.StateTriesConfig.AccountsStatePruningEnabled)
+	require.False(t, outputConfig.Configs.GeneralConfig.StateTriesConfig.PeerStatePruningEnabled)
+}
@@ -0,0 +1,8 @@
+package configs
+
+import "errors"
+
+var (
+	errInvalidNumOfValidatorsPrivateKeys = errors.New("invalid number of validators private keys")
+	errInvalidInitialWalletKeys          = errors.New("invalid initial wallet keys")
+)
@@ -0,0 +1,94 @@
+package chainSimulator
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/groups"
+)
+
+const (
+	controlAPIGroupName       = "simulator"
+	controlAPIDefaultHost     = "localhost"
+	controlAPIShutdownTimeout = time.Second
+)
+
+// StartControlAPI starts serving the chain simulator control endpoints under the /simulator route, on the provided
+// interface. An interface without a host, such as ":8085", binds to localhost only, as the endpoints alter the chain
+// state without any authentication. It returns the address the API listens on, which is useful when the provided
+// interface uses the port 0
+func (s *simulator) StartControlAPI(restApiInterface string) (string, error) {
+	s.mutControlAPIServer.Lock()
+	defer s.mutControlAPIServer.Unlock()
+
+	if s.controlAPIServer != nil {
+		return "", errControlAPIAlreadyStarted
+	}
+
+	simulatorGroup, err := groups.NewSimulatorGroup(s)
+	if err != nil {
+		return "", err
+	}
+
+	address, err := addDefaultControlAPIHost(restApiInterface)
+	if err != nil {
+		return "", err
+	}
+
+	engine := gin.New()
+	engine.Use(gin.Recovery())
+	simulatorGroup.RegisterRoutes(engine.Group("/" + controlAPIGroupName))
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return "", err
+	}
+
+	server := &http.Server{Handler: engine}
+	go func() {
+		errServe := server.Serve(listener)
+		if errServe != nil && !errors.Is(errServe, http.ErrServerClosed) {
+			log.Error("chain simulator: control API stopped", "error", errServe)
+		}
+	}()
+	s.controlAPIServer = server
+
+	address = listener.Addr().String()
+	log.Info("chain simulator: started the control API", "address", address)
+
+	return address, nil
+}
+
+func addDefaultControlAPIHost(restApiInterface string) (string, error) {
+	host, port, err := net.SplitHostPort(restApiInterface)
+	if err != nil {
+		return "", err
+	}
+	if len(host) == 0 {
+		host = controlAPIDefaultHost
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
+
+func (s *simulator) stopControlAPI() {
+	s.mutControlAPIServer.Lock()
+	defer s.mutControlAPIServer.Unlock()
+
+	if s.controlAPIServer == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), controlAPIShutdownTimeout)
+	defer cancel()
+
+	err := s.controlAPIServer.Shutdown(ctx)
+	if err != nil {
+		log.Warn("chain simulator: error stopping the control API", "error", err)
+	}
+	s.controlAPIServer = nil
+}
@@ -0,0 +1,161 @@
+package chainSimulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/configs"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDefaultControlAPIHost(t *testing.T) {
+	t.Parallel()
+
+	address, err := addDefaultControlAPIHost(":8085")
+	require.Nil(t, err)
+	require.Equal(t, "localhost:8085", address)
+
+	address, err = addDefaultControlAPIHost("0.0.0.0:8085")
+	require.Nil(t, err)
+	require.Equal(t, "0.0.0.0:8085", address)
+
+	address, err = addDefaultControlAPIHost("invalid")
+	require.NotNil(t, err)
+	require.Empty(t, address)
+}
+
+func TestSimulator_AutoBlockGenerationErrors(t *testing.T) {
+	t.Parallel()
+
+	chainSimulator := &simulator{}
+	err := chainSimulator.StartAutoBlockGeneration(dtos.AutoBlockGeneration{})
+	require.Equal(t, errInvalidAutoBlockGeneration, err)
+
+	// stopping a not started automatic block generation is a no-op
+	chainSimulator.StopAutoBlockGeneration()
+}
+
+func TestSimulator_ControlAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       time.Now().Unix(),
+		RoundDurationInMillis:  uint64(6000),
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    20,
+		},
+		ApiInterface:      api.NewNoApiInterface(),
+		MinNodesPerShard:  1,
+		MetaChainMinNodes: 1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	address, err := chainSimulator.StartControlAPI(":0")
+	require.Nil(t, err)
+	host, _, err := net.SplitHostPort(address)
+	require.Nil(t, err)
+	require.True(t, net.ParseIP(host).IsLoopback())
+
+	_, err = chainSimulator.StartControlAPI("localhost:0")
+	require.Equal(t, errControlAPIAlreadyStarted, err)
+
+	post := func(path string, body string) *shared.GenericAPIResponse {
+		resp, errPost := http.Post(fmt.Sprintf("http://%s/simulator%s", address, path), "application/json", bytes.NewBufferString(body))
+		require.Nil(t, errPost)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		response := &shared.GenericAPIResponse{}
+		errPost = json.NewDecoder(resp.Body).Decode(response)
+		require.Nil(t, errPost)
+		require.Equal(t, http.StatusOK, resp.StatusCode, response.Error)
+
+		return response
+	}
+	getShardNonce := func(shardID uint32) uint64 {
+		return chainSimulator.GetNodeHandler(shardID).GetChainHandler().GetCurrentBlockHeader().GetNonce()
+	}
+
+	nonceBefore := getShardNonce(0)
+	post("/generate-blocks/2", "")
+	require.Equal(t, nonceBefore+2, getShardNonce(0))
+
+	response := post("/generate-and-mint-wallet", `{"shardID": 0, "value": "1000000000000000000"}`)
+	walletData, err := json.Marshal(response.Data.(map[string]interface{})["address"])
+	require.Nil(t, err)
+	wallet := dtos.WalletAddress{}
+	err = json.Unmarshal(walletData, &wallet)
+	require.Nil(t, err)
+	require.Equal(t, uint32(0), chainSimulator.GetNodeHandler(0).GetShardCoordinator().ComputeId(wallet.Bytes))
+
+	post("/set-key-values", fmt.Sprintf(`{"address": "%s", "keyValues": {"01": "02"}}`, wallet.Bech32))
+	post("/generate-blocks/1", "")
+	account, err := chainSimulator.GetAccount(wallet)
+	require.Nil(t, err)
+	require.Equal(t, "1000000000000000000", account.Balance)
+
+	response = post("/auto-generate-blocks/start", `{"onTransactionReceived": true}`)
+	require.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	receiver := chainSimulator.GenerateAddressInShard(0)
+	tx := &transaction.Transaction{
+		Nonce:     0,
+		Value:     big.NewInt(1),
+		SndAddr:   wallet.Bytes,
+		RcvAddr:   receiver.Bytes,
+		GasLimit:  50_000,
+		GasPrice:  1_000_000_000,
+		ChainID:   []byte(configs.ChainID),
+		Version:   1,
+		Signature: []byte("signature"),
+	}
+	node := chainSimulator.GetNodeHandler(0)
+	txHash, err := core.CalculateHash(node.GetCoreComponents().InternalMarshalizer(), node.GetCoreComponents().Hasher(), tx)
+	require.Nil(t, err)
+	_, err = node.GetFacadeHandler().SendBulkTransactions([]*transaction.Transaction{tx})
+	require.Nil(t, err)
+
+	require.Eventually(t, func() bool {
+		result, errGet := node.GetFacadeHandler().GetTransaction(hex.EncodeToString(txHash), true)
+		return errGet == nil && result.Status == transaction.TxStatusSuccess
+	}, 20*time.Second, 50*time.Millisecond)
+
+	post("/auto-generate-blocks/stop", "")
+	nonceAfterStop := getShardNonce(0)
+	time.Sleep(200 * time.Millisecond)
+	require.Equal(t, nonceAfterStop, getShardNonce(0))
+
+	post("/auto-generate-blocks/start", `{"intervalInMillis": 20}`)
+	require.Eventually(t, func() bool {
+		return getShardNonce(0) >= nonceAfterStop+3
+	}, 20*time.Second, 20*time.Millisecond)
+
+	err = chainSimulator.StartAutoBlockGeneration(dtos.AutoBlockGeneration{IntervalInMillis: 20})
+	require.Equal(t, errAutoBlockGenerationAlreadyStarted, err)
+}
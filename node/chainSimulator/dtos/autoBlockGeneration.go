@@ -0,0 +1,8 @@
+package dtos
+
+// AutoBlockGeneration holds the settings of the chain simulator automatic block generation mode. A block is generated
+// every IntervalInMillis milliseconds, if set, and on every transaction received, if OnTransactionReceived is set
+type AutoBlockGeneration struct {
+	IntervalInMillis      uint64 `json:"intervalInMillis"`
+	OnTransactionReceived bool   `json:"onTransactionReceived"`
+}
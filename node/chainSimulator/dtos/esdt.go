@@ -0,0 +1,34 @@
+package dtos
+
+// ESDTToken holds the properties of an ESDT token created by the chain simulator. The type is one of the token types
+// of the ESDT system smart contract, for example FungibleESDT, SemiFungibleESDT or DynamicNonFungibleESDT
+type ESDTToken struct {
+	Identifier  string `json:"identifier"`
+	Name        string `json:"name,omitempty"`
+	Type        string `json:"type"`
+	Owner       string `json:"owner"`
+	NumDecimals uint32 `json:"numDecimals,omitempty"`
+	IsPaused    bool   `json:"isPaused,omitempty"`
+}
+
+// ESDTBalance holds the balance of an address for an ESDT token. The metadata fields are used only for the tokens
+// with nonce, the hash and the attributes being hex encoded
+type ESDTBalance struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Nonce      uint64   `json:"nonce,omitempty"`
+	Value      string   `json:"value"`
+	Name       string   `json:"name,omitempty"`
+	Creator    string   `json:"creator,omitempty"`
+	Royalties  uint32   `json:"royalties,omitempty"`
+	Hash       string   `json:"hash,omitempty"`
+	Attributes string   `json:"attributes,omitempty"`
+	URIs       []string `json:"uris,omitempty"`
+}
+
+// ESDTRoles holds the roles of an address for an ESDT token
+type ESDTRoles struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Roles      []string `json:"roles"`
+}
@@ -0,0 +1,97 @@
+package dtos
+
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+// ScenarioVersion is the version of the scenario files written by the chain simulator
+const ScenarioVersion = 1
+
+// ScenarioAction defines a chain simulator operation recorded in a scenario
+type ScenarioAction string
+
+const (
+	// SetStateAction records a SetStateMultiple call
+	SetStateAction ScenarioAction = "setState"
+	// SetKeyValuesAction records a SetKeyValueForAddress call
+	SetKeyValuesAction ScenarioAction = "setKeyValues"
+	// RemoveAccountsAction records a RemoveAccounts call
+	RemoveAccountsAction ScenarioAction = "removeAccounts"
+	// SendTransactionsAction records the transactions sent, before generating any block. The blocks generated to execute
+	// them are recorded as separate steps
+	SendTransactionsAction ScenarioAction = "sendTransactions"
+	// GenerateBlocksAction records a GenerateBlocks call
+	GenerateBlocksAction ScenarioAction = "generateBlocks"
+	// GenerateBlocksUntilEpochAction records a GenerateBlocksUntilEpochIsReached call
+	GenerateBlocksUntilEpochAction ScenarioAction = "generateBlocksUntilEpoch"
+	// ForceChangeOfEpochAction records a ForceChangeOfEpoch call
+	ForceChangeOfEpochAction ScenarioAction = "forceChangeOfEpoch"
+	// WarpToRoundAction records a WarpToRound or a WarpToTimestamp call
+	WarpToRoundAction ScenarioAction = "warpToRound"
+	// SetNextBlockTimestampAction records a SetNextBlockTimestamp call
+	SetNextBlockTimestampAction ScenarioAction = "setNextBlockTimestamp"
+	// SnapshotAction records a Snapshot call
+	SnapshotAction ScenarioAction = "snapshot"
+	// RevertToSnapshotAction records a RevertTo call
+	RevertToSnapshotAction ScenarioAction = "revertToSnapshot"
+	// ImpersonateAction records an Impersonate call
+	ImpersonateAction ScenarioAction = "impersonate"
+	// StopImpersonatingAction records a StopImpersonating call
+	StopImpersonatingAction ScenarioAction = "stopImpersonating"
+	// AddValidatorKeysAction records an AddValidatorKeys call
+	AddValidatorKeysAction ScenarioAction = "addValidatorKeys"
+	// CreateESDTTokenAction records a CreateESDTToken call
+	CreateESDTTokenAction ScenarioAction = "createESDTToken"
+	// SetESDTBalanceAction records a SetESDTBalance call
+	SetESDTBalanceAction ScenarioAction = "setESDTBalance"
+	// SetESDTRolesAction records a SetESDTRoles call
+	SetESDTRolesAction ScenarioAction = "setESDTRoles"
+)
+
+// ScenarioConfig holds the chain simulator arguments and the key material needed to recreate the recorded chain
+type ScenarioConfig struct {
+	BypassTxSignatureCheck      bool               `json:"bypassTxSignatureCheck"`
+	NumOfShards                 uint32             `json:"numOfShards"`
+	MinNodesPerShard            uint32             `json:"minNodesPerShard"`
+	ConsensusGroupSize          uint32             `json:"consensusGroupSize"`
+	MetaChainMinNodes           uint32             `json:"metaChainMinNodes"`
+	MetaChainConsensusGroupSize uint32             `json:"metaChainConsensusGroupSize"`
+	Hysteresis                  float32            `json:"hysteresis"`
+	NumNodesWaitingListShard    uint32             `json:"numNodesWaitingListShard"`
+	NumNodesWaitingListMeta     uint32             `json:"numNodesWaitingListMeta"`
+	GenesisTimestamp            int64              `json:"genesisTimestamp"`
+	InitialRound                int64              `json:"initialRound"`
+	InitialEpoch                uint32             `json:"initialEpoch"`
+	InitialNonce                uint64             `json:"initialNonce"`
+	RoundDurationInMillis       uint64             `json:"roundDurationInMillis"`
+	RoundsPerEpoch              uint64             `json:"roundsPerEpoch,omitempty"`
+	VmQueryDelayAfterStartInMs  uint64             `json:"vmQueryDelayAfterStartInMs"`
+	EnableSnapshots             bool               `json:"enableSnapshots,omitempty"`
+	ValidatorsPrivateKeys       []string           `json:"validatorsPrivateKeys"`
+	InitialWallets              *InitialWalletKeys `json:"initialWallets"`
+}
+
+// ScenarioStep holds a recorded chain simulator operation and the accounts root hashes of all the shards after it
+type ScenarioStep struct {
+	Action                ScenarioAction             `json:"action"`
+	State                 []*AddressState            `json:"state,omitempty"`
+	Address               string                     `json:"address,omitempty"`
+	KeyValues             map[string]string          `json:"keyValues,omitempty"`
+	Addresses             []string                   `json:"addresses,omitempty"`
+	Transactions          []*transaction.Transaction `json:"transactions,omitempty"`
+	NumOfBlocks           int                        `json:"numOfBlocks,omitempty"`
+	TargetEpoch           int32                      `json:"targetEpoch,omitempty"`
+	Round                 int64                      `json:"round,omitempty"`
+	Timestamp             int64                      `json:"timestamp,omitempty"`
+	SnapshotID            string                     `json:"snapshotID,omitempty"`
+	ValidatorsPrivateKeys []string                   `json:"validatorsPrivateKeys,omitempty"`
+	ESDTToken             *ESDTToken                 `json:"esdtToken,omitempty"`
+	ESDTBalance           *ESDTBalance               `json:"esdtBalance,omitempty"`
+	ESDTRoles             *ESDTRoles                 `json:"esdtRoles,omitempty"`
+	RootHashes            map[uint32]string          `json:"rootHashes"`
+}
+
+// Scenario holds everything a chain simulator session did, so it can be replayed deterministically
+type Scenario struct {
+	Version uint32          `json:"version"`
+	Config  *ScenarioConfig `json:"config"`
+	Steps   []*ScenarioStep `json:"steps"`
+}
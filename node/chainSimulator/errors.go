@@ -6,4 +6,44 @@ var (
 	errNilChainSimulator = errors.New("nil chain simulator")
 	errNilMetachainNode  = errors.New("nil metachain node")
 	errShardSetupError   = errors.New("shard setup error")
+
+	errSnapshotsNotEnabled      = errors.New("snapshots are not enabled")
+	errSnapshotNotFound         = errors.New("snapshot not found")
+	errNilNodeSnapshot          = errors.New("nil node snapshot")
+	errSnapshotFromAnotherEpoch = errors.New("can not revert to a snapshot from another epoch")
+	errWrongTypeAssertion       = errors.New("wrong type assertion")
+
+	errInvalidForkShard        = errors.New("invalid shard for the forked trie storage")
+	errMissingExportedDataTrie = errors.New("missing exported data trie")
+
+	errRoundNotInTheFuture     = errors.New("the target round is not in the future")
+	errTimestampNotInTheFuture = errors.New("the timestamp is not in the future")
+
+	errNilScenario                 = errors.New("nil scenario")
+	errNilScenarioConfig           = errors.New("nil scenario config")
+	errNilScenarioStep             = errors.New("nil scenario step")
+	errNilScenarioTransaction      = errors.New("nil scenario transaction")
+	errUnsupportedScenarioVersion  = errors.New("unsupported scenario version")
+	errUnknownScenarioAction       = errors.New("unknown scenario action")
+	errScenarioRecordingNotEnabled = errors.New("scenario recording is not enabled")
+	errScenarioRecordingWithFork   = errors.New("can not record a scenario for a forked chain")
+	errScenarioRootHashMismatch    = errors.New("scenario root hash mismatch")
+	errScenarioSnapshotMismatch    = errors.New("scenario snapshot identifier mismatch")
+
+	errInvalidAutoBlockGeneration        = errors.New("either the interval or the generation on transaction received must be set")
+	errAutoBlockGenerationAlreadyStarted = errors.New("automatic block generation already started")
+	errControlAPIAlreadyStarted          = errors.New("control API already started")
+
+	errNilESDTToken           = errors.New("nil ESDT token")
+	errNilESDTBalance         = errors.New("nil ESDT balance")
+	errNilESDTRoles           = errors.New("nil ESDT roles")
+	errInvalidESDTIdentifier  = errors.New("invalid ESDT token identifier")
+	errInvalidESDTType        = errors.New("invalid ESDT token type")
+	errInvalidESDTNumDecimals = errors.New("invalid ESDT number of decimals")
+	errInvalidESDTNonce       = errors.New("invalid ESDT nonce")
+	errInvalidESDTValue       = errors.New("invalid ESDT value")
+	errInvalidESDTRoyalties   = errors.New("invalid ESDT royalties")
+	errInvalidESDTRole        = errors.New("invalid ESDT role")
+	errESDTTokenAlreadyExists = errors.New("ESDT token already exists")
+	errESDTTokenNotFound      = errors.New("ESDT token not found")
 )
@@ -0,0 +1,638 @@
+package chainSimulator
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/sharding"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/vm"
+	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts"
+	"github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
+)
+
+const (
+	maxESDTNumDecimals        = 18
+	esdtRandomSequenceLength  = 6
+	esdtIdentifierSeparator   = "-"
+	esdtSystemAccountReserved = 1
+)
+
+// globalSettingsTokenTypes maps the token types of the ESDT system SC to the token types saved by the global settings
+// handler on the system account, which uses its own enumeration with 0 reserved for a not set token type
+var globalSettingsTokenTypes = map[string]byte{
+	core.FungibleESDT:      1,
+	core.NonFungibleESDT:   2,
+	core.NonFungibleESDTv2: 3,
+	core.MetaESDT:          4,
+	core.SemiFungibleESDT:  5,
+	core.DynamicNFTESDT:    6,
+	core.DynamicSFTESDT:    7,
+	core.DynamicMetaESDT:   8,
+}
+
+var esdtRoles = map[string]struct{}{
+	core.ESDTRoleLocalMint:           {},
+	core.ESDTRoleLocalBurn:           {},
+	core.ESDTRoleNFTCreate:           {},
+	core.ESDTRoleNFTCreateMultiShard: {},
+	core.ESDTRoleNFTAddQuantity:      {},
+	core.ESDTRoleNFTBurn:             {},
+	core.ESDTRoleNFTAddURI:           {},
+	core.ESDTRoleNFTUpdateAttributes: {},
+	core.ESDTRoleTransfer:            {},
+	core.ESDTRoleSetNewURI:           {},
+	core.ESDTRoleModifyRoyalties:     {},
+	core.ESDTRoleModifyCreator:       {},
+	core.ESDTRoleNFTRecreate:         {},
+	core.ESDTRoleNFTUpdate:           {},
+}
+
+// CreateESDTToken will register the provided token on the ESDT system SC and will set its global settings on the
+// system accounts, as if it was issued by its owner. The token has no supply until balances are set for it
+func (s *simulator) CreateESDTToken(token *dtos.ESDTToken) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	err := s.createESDTToken(token)
+	if err != nil {
+		return err
+	}
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:    dtos.CreateESDTTokenAction,
+		ESDTToken: token,
+	})
+
+	return nil
+}
+
+func (s *simulator) createESDTToken(token *dtos.ESDTToken) error {
+	if token == nil {
+		return errNilESDTToken
+	}
+
+	ticker, err := getESDTTicker(token.Identifier)
+	if err != nil {
+		return err
+	}
+	globalSettingsTokenType, ok := globalSettingsTokenTypes[token.Type]
+	if !ok {
+		return fmt.Errorf("%w: %s", errInvalidESDTType, token.Type)
+	}
+	if token.NumDecimals > maxESDTNumDecimals || (token.NumDecimals > 0 && !hasESDTDecimals(token.Type)) {
+		return fmt.Errorf("%w: %d for a %s token", errInvalidESDTNumDecimals, token.NumDecimals, token.Type)
+	}
+
+	owner, err := s.decodeAddressUnprotected(token.Owner)
+	if err != nil {
+		return err
+	}
+
+	tokenData, err := s.getESDTTokenData(token.Identifier)
+	if err != nil {
+		return err
+	}
+	if tokenData != nil {
+		return fmt.Errorf("%w: %s", errESDTTokenAlreadyExists, token.Identifier)
+	}
+
+	name := token.Name
+	if len(name) == 0 {
+		name = ticker
+	}
+
+	isFungible := token.Type == core.FungibleESDT
+	tokenData = &systemSmartContracts.ESDTDataV2{
+		OwnerAddress:             owner,
+		TokenName:                []byte(name),
+		TickerName:               []byte(ticker),
+		TokenType:                []byte(token.Type),
+		Mintable:                 isFungible,
+		Burnable:                 isFungible,
+		CanPause:                 true,
+		CanFreeze:                true,
+		CanWipe:                  true,
+		Upgradable:               true,
+		CanChangeOwner:           true,
+		IsPaused:                 token.IsPaused,
+		MintedValue:              big.NewInt(0),
+		BurntValue:               big.NewInt(0),
+		NumDecimals:              token.NumDecimals,
+		CanAddSpecialRoles:       true,
+		CanTransferNFTCreateRole: !isFungible,
+	}
+	err = s.saveESDTTokenData(token.Identifier, tokenData)
+	if err != nil {
+		return err
+	}
+
+	globalMetadata := &builtInFunctions.ESDTGlobalMetadata{
+		Paused:    token.IsPaused,
+		TokenType: globalSettingsTokenType,
+	}
+	tokenKey := computeESDTKey(token.Identifier, 0)
+
+	return s.setKeyValueSystemAccount(map[string]string{
+		hex.EncodeToString(tokenKey): hex.EncodeToString(globalMetadata.ToBytes()),
+	})
+}
+
+// SetESDTRoles will replace the roles of an address for the provided token, both on the address and on the ESDT
+// system SC. An empty roles list removes all the roles of the address
+func (s *simulator) SetESDTRoles(roles *dtos.ESDTRoles) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	err := s.setESDTRoles(roles)
+	if err != nil {
+		return err
+	}
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:    dtos.SetESDTRolesAction,
+		ESDTRoles: roles,
+	})
+
+	return nil
+}
+
+func (s *simulator) setESDTRoles(roles *dtos.ESDTRoles) error {
+	if roles == nil {
+		return errNilESDTRoles
+	}
+
+	tokenData, err := s.getExistingESDTTokenData(roles.Identifier)
+	if err != nil {
+		return err
+	}
+	address, err := s.decodeAddressUnprotected(roles.Address)
+	if err != nil {
+		return err
+	}
+
+	rolesBytes := make([][]byte, 0, len(roles.Roles))
+	for _, role := range roles.Roles {
+		_, ok := esdtRoles[role]
+		if !ok {
+			return fmt.Errorf("%w: %s", errInvalidESDTRole, role)
+		}
+
+		rolesBytes = append(rolesBytes, []byte(role))
+	}
+
+	rolesValue := make([]byte, 0)
+	if len(rolesBytes) > 0 {
+		rolesValue, err = s.nodes[core.MetachainShardId].GetCoreComponents().InternalMarshalizer().Marshal(&esdt.ESDTRoles{Roles: rolesBytes})
+		if err != nil {
+			return err
+		}
+	}
+
+	rolesKey := []byte(core.ProtectedKeyPrefix + core.ESDTRoleIdentifier + core.ESDTKeyIdentifier + roles.Identifier)
+	err = s.getNodeForAddress(address).SetKeyValueForAddress(address, map[string]string{
+		hex.EncodeToString(rolesKey): hex.EncodeToString(rolesValue),
+	})
+	if err != nil {
+		return err
+	}
+
+	specialRoles := make([]*systemSmartContracts.ESDTRoles, 0, len(tokenData.SpecialRoles)+1)
+	for _, specialRole := range tokenData.SpecialRoles {
+		if string(specialRole.Address) != string(address) {
+			specialRoles = append(specialRoles, specialRole)
+		}
+	}
+	if len(rolesBytes) > 0 {
+		specialRoles = append(specialRoles, &systemSmartContracts.ESDTRoles{
+			Address: address,
+			Roles:   rolesBytes,
+		})
+	}
+	tokenData.SpecialRoles = specialRoles
+
+	return s.saveESDTTokenData(roles.Identifier, tokenData)
+}
+
+// SetESDTBalance will set the balance of an address for the provided token. For the tokens with nonce the metadata
+// is saved on the account or on the system accounts, depending on the token type, and the latest nonce of the
+// addresses with the NFT create role is advanced when needed. The supplies of the token and the minted and burnt
+// values of the ESDT system SC are updated with the balance difference
+func (s *simulator) SetESDTBalance(balance *dtos.ESDTBalance) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	err := s.setESDTBalance(balance)
+	if err != nil {
+		return err
+	}
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:      dtos.SetESDTBalanceAction,
+		ESDTBalance: balance,
+	})
+
+	return nil
+}
+
+func (s *simulator) setESDTBalance(balance *dtos.ESDTBalance) error {
+	if balance == nil {
+		return errNilESDTBalance
+	}
+
+	tokenData, err := s.getExistingESDTTokenData(balance.Identifier)
+	if err != nil {
+		return err
+	}
+	tokenType := string(tokenData.TokenType)
+	esdtType, err := core.ConvertESDTTypeToUint32(tokenType)
+	if err != nil {
+		return err
+	}
+
+	err = checkESDTBalance(balance, tokenType)
+	if err != nil {
+		return err
+	}
+	value, _ := big.NewInt(0).SetString(balance.Value, 10)
+
+	address, err := s.decodeAddressUnprotected(balance.Address)
+	if err != nil {
+		return err
+	}
+	node := s.getNodeForAddress(address)
+	tokenKey := computeESDTKey(balance.Identifier, balance.Nonce)
+	oldValue, err := getESDTValue(node, address, tokenKey)
+	if err != nil {
+		return err
+	}
+
+	esdtData := &esdt.ESDigitalToken{
+		Type:  esdtType,
+		Value: value,
+	}
+	if balance.Nonce > 0 {
+		esdtData.TokenMetaData, err = createESDTMetaData(balance, address, node.GetCoreComponents().AddressPubKeyConverter())
+		if err != nil {
+			return err
+		}
+	}
+
+	enableEpochsHandler := node.GetCoreComponents().EnableEpochsHandler()
+	isMetaDataOnSystemAccount := enableEpochsHandler.IsFlagEnabled(common.SaveToSystemAccountFlag) && !isESDTMetaDataOnUserAccount(esdtType)
+	if balance.Nonce > 0 && isMetaDataOnSystemAccount {
+		err = s.saveESDTMetaDataOnSystemAccount(tokenKey, esdtData, enableEpochsHandler.IsFlagEnabled(common.SendAlwaysFlag))
+		if err != nil {
+			return err
+		}
+
+		esdtData.TokenMetaData = nil
+	}
+
+	esdtValue := make([]byte, 0)
+	if value.Sign() > 0 {
+		esdtValue, err = node.GetCoreComponents().InternalMarshalizer().Marshal(esdtData)
+		if err != nil {
+			return err
+		}
+	}
+	err = node.SetKeyValueForAddress(address, map[string]string{
+		hex.EncodeToString(tokenKey): hex.EncodeToString(esdtValue),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.updateESDTLatestNonce(tokenData, balance.Identifier, balance.Nonce)
+	if err != nil {
+		return err
+	}
+
+	difference := big.NewInt(0).Sub(value, oldValue)
+	if difference.Sign() == 0 {
+		return nil
+	}
+
+	err = updateESDTSupplies(node, balance.Identifier, balance.Nonce, difference)
+	if err != nil {
+		return err
+	}
+
+	if difference.Sign() > 0 {
+		tokenData.MintedValue = big.NewInt(0).Add(getBigIntOrZero(tokenData.MintedValue), difference)
+	} else {
+		tokenData.BurntValue = big.NewInt(0).Sub(getBigIntOrZero(tokenData.BurntValue), difference)
+	}
+
+	return s.saveESDTTokenData(balance.Identifier, tokenData)
+}
+
+func checkESDTBalance(balance *dtos.ESDTBalance, tokenType string) error {
+	isFungible := tokenType == core.FungibleESDT
+	if isFungible != (balance.Nonce == 0) {
+		return fmt.Errorf("%w: %d for a %s token", errInvalidESDTNonce, balance.Nonce, tokenType)
+	}
+
+	value, ok := big.NewInt(0).SetString(balance.Value, 10)
+	if !ok || value.Sign() < 0 {
+		return fmt.Errorf("%w: %s", errInvalidESDTValue, balance.Value)
+	}
+	if isNonFungibleESDT(tokenType) && value.Cmp(big.NewInt(1)) > 0 {
+		return fmt.Errorf("%w: %s for a %s token", errInvalidESDTValue, balance.Value, tokenType)
+	}
+	if balance.Royalties > core.MaxRoyalty {
+		return fmt.Errorf("%w: %d", errInvalidESDTRoyalties, balance.Royalties)
+	}
+
+	return nil
+}
+
+func createESDTMetaData(balance *dtos.ESDTBalance, holder []byte, addressConverter core.PubkeyConverter) (*esdt.MetaData, error) {
+	creator := holder
+	var err error
+	if len(balance.Creator) > 0 {
+		creator, err = addressConverter.Decode(balance.Creator)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hash, err := hex.DecodeString(balance.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode hash, error: %w", err)
+	}
+	attributes, err := hex.DecodeString(balance.Attributes)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode attributes, error: %w", err)
+	}
+
+	uris := make([][]byte, 0, len(balance.URIs))
+	for _, uri := range balance.URIs {
+		uris = append(uris, []byte(uri))
+	}
+
+	return &esdt.MetaData{
+		Nonce:      balance.Nonce,
+		Name:       []byte(balance.Name),
+		Creator:    creator,
+		Royalties:  balance.Royalties,
+		Hash:       hash,
+		URIs:       uris,
+		Attributes: attributes,
+	}, nil
+}
+
+func (s *simulator) saveESDTMetaDataOnSystemAccount(tokenKey []byte, esdtData *esdt.ESDigitalToken, isSendAlwaysEnabled bool) error {
+	esdtDataOnSystemAccount := &esdt.ESDigitalToken{
+		Type:          esdtData.Type,
+		Value:         big.NewInt(0),
+		TokenMetaData: esdtData.TokenMetaData,
+		Properties:    make([]byte, s.numOfShards),
+	}
+	if isSendAlwaysEnabled {
+		esdtDataOnSystemAccount.Properties = nil
+		esdtDataOnSystemAccount.Reserved = []byte{esdtSystemAccountReserved}
+	}
+	esdtDataBytes, err := s.nodes[core.MetachainShardId].GetCoreComponents().InternalMarshalizer().Marshal(esdtDataOnSystemAccount)
+	if err != nil {
+		return err
+	}
+
+	return s.setKeyValueSystemAccount(map[string]string{
+		hex.EncodeToString(tokenKey): hex.EncodeToString(esdtDataBytes),
+	})
+}
+
+// updateESDTLatestNonce advances the latest NFT nonce of the addresses with the NFT create role, so the tokens created
+// afterward through transactions do not overwrite the ones set by the chain simulator
+func (s *simulator) updateESDTLatestNonce(tokenData *systemSmartContracts.ESDTDataV2, identifier string, nonce uint64) error {
+	if nonce == 0 {
+		return nil
+	}
+
+	nonceKey := []byte(core.ProtectedKeyPrefix + core.ESDTNFTLatestNonceIdentifier + identifier)
+	for _, specialRole := range tokenData.SpecialRoles {
+		if !hasRole(specialRole.Roles, core.ESDTRoleNFTCreate) {
+			continue
+		}
+
+		node := s.getNodeForAddress(specialRole.Address)
+		latestNonce, err := getAccountValue(node, specialRole.Address, nonceKey)
+		if err != nil {
+			return err
+		}
+		if big.NewInt(0).SetBytes(latestNonce).Uint64() >= nonce {
+			continue
+		}
+
+		err = node.SetKeyValueForAddress(specialRole.Address, map[string]string{
+			hex.EncodeToString(nonceKey): hex.EncodeToString(big.NewInt(0).SetUint64(nonce).Bytes()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateESDTSupplies applies the balance difference on the supplies kept by the node of the holder's shard, on the
+// token with nonce and on its collection, the same as the supplies processor does for the mint and burn events
+func updateESDTSupplies(node process.NodeHandler, identifier string, nonce uint64, difference *big.Int) error {
+	suppliesStorer, err := node.GetDataComponents().StorageService().GetStorer(dataRetriever.ESDTSuppliesUnit)
+	if err != nil {
+		return err
+	}
+
+	identifiers := []string{identifier}
+	if nonce > 0 {
+		nonceHex := hex.EncodeToString(big.NewInt(0).SetUint64(nonce).Bytes())
+		identifiers = append(identifiers, identifier+esdtIdentifierSeparator+nonceHex)
+	}
+
+	marshaller := node.GetCoreComponents().InternalMarshalizer()
+	for _, supplyIdentifier := range identifiers {
+		supply := &esdtSupply.SupplyESDT{}
+		supplyBytes, errGet := suppliesStorer.Get([]byte(supplyIdentifier))
+		if errGet != nil && !errors.Is(errGet, storage.ErrKeyNotFound) {
+			return errGet
+		}
+		if errGet == nil {
+			err = marshaller.Unmarshal(supply, supplyBytes)
+			if err != nil {
+				return err
+			}
+		}
+
+		supply.Supply = big.NewInt(0).Add(getBigIntOrZero(supply.Supply), difference)
+		supply.Minted = getBigIntOrZero(supply.Minted)
+		supply.Burned = getBigIntOrZero(supply.Burned)
+		if difference.Sign() > 0 {
+			supply.Minted = big.NewInt(0).Add(supply.Minted, difference)
+		} else {
+			supply.Burned = big.NewInt(0).Sub(supply.Burned, difference)
+		}
+
+		supplyBytes, err = marshaller.Marshal(supply)
+		if err != nil {
+			return err
+		}
+		err = suppliesStorer.Put([]byte(supplyIdentifier), supplyBytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *simulator) getExistingESDTTokenData(identifier string) (*systemSmartContracts.ESDTDataV2, error) {
+	tokenData, err := s.getESDTTokenData(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if tokenData == nil {
+		return nil, fmt.Errorf("%w: %s", errESDTTokenNotFound, identifier)
+	}
+
+	return tokenData, nil
+}
+
+func (s *simulator) getESDTTokenData(identifier string) (*systemSmartContracts.ESDTDataV2, error) {
+	metachainNode := s.nodes[core.MetachainShardId]
+	tokenDataBytes, err := getAccountValue(metachainNode, vm.ESDTSCAddress, []byte(identifier))
+	if err != nil {
+		return nil, err
+	}
+	if len(tokenDataBytes) == 0 {
+		return nil, nil
+	}
+
+	tokenData := &systemSmartContracts.ESDTDataV2{}
+	err = metachainNode.GetCoreComponents().InternalMarshalizer().Unmarshal(tokenData, tokenDataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenData, nil
+}
+
+func (s *simulator) saveESDTTokenData(identifier string, tokenData *systemSmartContracts.ESDTDataV2) error {
+	metachainNode := s.nodes[core.MetachainShardId]
+	tokenDataBytes, err := metachainNode.GetCoreComponents().InternalMarshalizer().Marshal(tokenData)
+	if err != nil {
+		return err
+	}
+
+	return metachainNode.SetKeyValueForAddress(vm.ESDTSCAddress, map[string]string{
+		hex.EncodeToString([]byte(identifier)): hex.EncodeToString(tokenDataBytes),
+	})
+}
+
+func getESDTValue(node process.NodeHandler, address []byte, tokenKey []byte) (*big.Int, error) {
+	esdtDataBytes, err := getAccountValue(node, address, tokenKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(esdtDataBytes) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	esdtData := &esdt.ESDigitalToken{}
+	err = node.GetCoreComponents().InternalMarshalizer().Unmarshal(esdtData, esdtDataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return getBigIntOrZero(esdtData.Value), nil
+}
+
+func (s *simulator) getNodeForAddress(address []byte) process.NodeHandler {
+	return s.nodes[sharding.ComputeShardID(address, s.numOfShards)]
+}
+
+// decodeAddressUnprotected decodes the provided bech32 address without acquiring the mutex, so it can be called while
+// the mutex is held
+func (s *simulator) decodeAddressUnprotected(address string) ([]byte, error) {
+	return s.nodes[core.MetachainShardId].GetCoreComponents().AddressPubKeyConverter().Decode(address)
+}
+
+func getAccountValue(node process.NodeHandler, address []byte, key []byte) ([]byte, error) {
+	account, err := node.GetStateComponents().AccountsAdapter().GetExistingAccount(address)
+	if errors.Is(err, state.ErrAccNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, errWrongTypeAssertion
+	}
+
+	value, _, err := userAccount.RetrieveValue(key)
+
+	return value, err
+}
+
+func getESDTTicker(identifier string) (string, error) {
+	ticker, randomSequence, found := strings.Cut(identifier, esdtIdentifierSeparator)
+	if !found || len(ticker) == 0 || len(randomSequence) != esdtRandomSequenceLength {
+		return "", fmt.Errorf("%w: %s", errInvalidESDTIdentifier, identifier)
+	}
+
+	return ticker, nil
+}
+
+func computeESDTKey(identifier string, nonce uint64) []byte {
+	tokenKey := []byte(core.ProtectedKeyPrefix + core.ESDTKeyIdentifier + identifier)
+	if nonce == 0 {
+		return tokenKey
+	}
+
+	return append(tokenKey, big.NewInt(0).SetUint64(nonce).Bytes()...)
+}
+
+func hasESDTDecimals(tokenType string) bool {
+	return tokenType == core.FungibleESDT || tokenType == core.MetaESDT || tokenType == core.DynamicMetaESDT
+}
+
+func isNonFungibleESDT(tokenType string) bool {
+	return tokenType == core.NonFungibleESDT || tokenType == core.NonFungibleESDTv2 || tokenType == core.DynamicNFTESDT
+}
+
+// isESDTMetaDataOnUserAccount mirrors the ESDT data storage, which keeps the metadata of the other token types with
+// nonce on the system account once the NFT store optimization is enabled
+func isESDTMetaDataOnUserAccount(esdtType uint32) bool {
+	return esdtType == uint32(core.NonFungibleV2) || esdtType == uint32(core.DynamicNFT) || esdtType == uint32(core.Fungible)
+}
+
+func hasRole(roles [][]byte, role string) bool {
+	for _, currentRole := range roles {
+		if string(currentRole) == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getBigIntOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value
+}
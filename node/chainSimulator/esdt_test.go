@@ -0,0 +1,296 @@
+package chainSimulator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	coreAPI "github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	chainSimulatorCommon "github.com/multiversx/mx-chain-go/integrationTests/chainSimulator"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/configs"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulator_ESDTHelpers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       time.Now().Unix(),
+		RoundDurationInMillis:  uint64(6000),
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    20,
+		},
+		ApiInterface:      api.NewNoApiInterface(),
+		MinNodesPerShard:  1,
+		MetaChainMinNodes: 1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	initialBalance := big.NewInt(0).Mul(chainSimulatorCommon.OneEGLD, big.NewInt(10))
+	owner, err := chainSimulator.GenerateAndMintWalletAddress(0, initialBalance)
+	require.Nil(t, err)
+	holder, err := chainSimulator.GenerateAndMintWalletAddress(0, initialBalance)
+	require.Nil(t, err)
+	receiver, err := chainSimulator.GenerateAndMintWalletAddress(1, big.NewInt(0))
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	nonces := make(map[string]uint64)
+	sendTx := func(sender dtos.WalletAddress, receiver dtos.WalletAddress, data string) {
+		tx := &transaction.Transaction{
+			Nonce:     nonces[sender.Bech32],
+			Value:     big.NewInt(0),
+			SndAddr:   sender.Bytes,
+			RcvAddr:   receiver.Bytes,
+			Data:      []byte(data),
+			GasLimit:  5_000_000,
+			GasPrice:  1_000_000_000,
+			ChainID:   []byte(configs.ChainID),
+			Version:   1,
+			Signature: []byte("signature"),
+		}
+		result, errSend := chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 10)
+		require.Nil(t, errSend)
+		require.Equal(t, transaction.TxStatusSuccess, result.Status)
+		nonces[sender.Bech32]++
+	}
+	getESDT := func(address dtos.WalletAddress, identifier string, nonce uint64) (string, string, uint32) {
+		shardID := chainSimulator.GetNodeHandler(0).GetShardCoordinator().ComputeId(address.Bytes)
+		facade := chainSimulator.GetNodeHandler(shardID).GetFacadeHandler()
+		esdtData, _, errGet := facade.GetESDTData(address.Bech32, identifier, nonce, coreAPI.AccountQueryOptions{})
+		require.Nil(t, errGet)
+		if esdtData.TokenMetaData == nil {
+			return esdtData.Value.String(), "", 0
+		}
+
+		return esdtData.Value.String(), string(esdtData.TokenMetaData.Attributes), esdtData.TokenMetaData.Royalties
+	}
+	getSupply := func(shardID uint32, token string) (string, string, string) {
+		supply, errGet := chainSimulator.GetNodeHandler(shardID).GetFacadeHandler().GetTokenSupply(token)
+		require.Nil(t, errGet)
+
+		return supply.Supply, supply.Minted, supply.Burned
+	}
+
+	// invalid arguments
+	err = chainSimulator.CreateESDTToken(nil)
+	require.Equal(t, errNilESDTToken, err)
+	err = chainSimulator.CreateESDTToken(&dtos.ESDTToken{Identifier: "TOKEN", Type: core.FungibleESDT, Owner: owner.Bech32})
+	require.ErrorIs(t, err, errInvalidESDTIdentifier)
+	err = chainSimulator.CreateESDTToken(&dtos.ESDTToken{Identifier: "TOKEN-abcdef", Type: "unknown", Owner: owner.Bech32})
+	require.ErrorIs(t, err, errInvalidESDTType)
+	err = chainSimulator.CreateESDTToken(&dtos.ESDTToken{Identifier: "TOKEN-abcdef", Type: core.FungibleESDT, Owner: owner.Bech32, NumDecimals: 19})
+	require.ErrorIs(t, err, errInvalidESDTNumDecimals)
+	err = chainSimulator.CreateESDTToken(&dtos.ESDTToken{Identifier: "TOKEN-abcdef", Type: core.NonFungibleESDTv2, Owner: owner.Bech32, NumDecimals: 2})
+	require.ErrorIs(t, err, errInvalidESDTNumDecimals)
+	err = chainSimulator.SetESDTBalance(nil)
+	require.Equal(t, errNilESDTBalance, err)
+	err = chainSimulator.SetESDTBalance(&dtos.ESDTBalance{Address: holder.Bech32, Identifier: "MISSING-abcdef", Value: "1"})
+	require.ErrorIs(t, err, errESDTTokenNotFound)
+	err = chainSimulator.SetESDTRoles(nil)
+	require.Equal(t, errNilESDTRoles, err)
+
+	// fungible token
+	token := "FUNG-abcdef"
+	err = chainSimulator.CreateESDTToken(&dtos.ESDTToken{
+		Identifier:  token,
+		Name:        "Fungible",
+		Type:        core.FungibleESDT,
+		Owner:       owner.Bech32,
+		NumDecimals: 6,
+	})
+	require.Nil(t, err)
+	err = chainSimulator.CreateESDTToken(&dtos.ESDTToken{Identifier: token, Type: core.FungibleESDT, Owner: owner.Bech32})
+	require.ErrorIs(t, err, errESDTTokenAlreadyExists)
+
+	err = chainSimulator.SetESDTBalance(&dtos.ESDTBalance{Address: holder.Bech32, Identifier: token, Nonce: 1, Value: "1"})
+	require.ErrorIs(t, err, errInvalidESDTNonce)
+	err = chainSimulator.SetESDTBalance(&dtos.ESDTBalance{Address: holder.Bech32, Identifier: token, Value: "-1"})
+	require.ErrorIs(t, err, errInvalidESDTValue)
+	err = chainSimulator.SetESDTRoles(&dtos.ESDTRoles{Address: holder.Bech32, Identifier: token, Roles: []string{"unknown"}})
+	require.ErrorIs(t, err, errInvalidESDTRole)
+
+	err = chainSimulator.SetESDTRoles(&dtos.ESDTRoles{
+		Address:    holder.Bech32,
+		Identifier: token,
+		Roles:      []string{core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn},
+	})
+	require.Nil(t, err)
+	err = chainSimulator.SetESDTBalance(&dtos.ESDTBalance{Address: holder.Bech32, Identifier: token, Value: "1000"})
+	require.Nil(t, err)
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	value, _, _ := getESDT(holder, token, 0)
+	assert.Equal(t, "1000", value)
+	supply, minted, burned := getSupply(0, token)
+	assert.Equal(t, "1000", supply)
+	assert.Equal(t, "1000", minted)
+	assert.Equal(t, "0", burned)
+
+	roles, _, err := chainSimulator.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().GetESDTsRoles(holder.Bech32, coreAPI.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, []string{core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn}, roles[token])
+
+	tokenHex := hex.EncodeToString([]byte(token))
+	sendTx(holder, receiver, core.BuiltInFunctionESDTTransfer+"@"+tokenHex+"@"+hex.EncodeToString(big.NewInt(100).Bytes()))
+	sendTx(holder, holder, core.BuiltInFunctionESDTLocalMint+"@"+tokenHex+"@"+hex.EncodeToString(big.NewInt(50).Bytes()))
+
+	value, _, _ = getESDT(holder, token, 0)
+	assert.Equal(t, "950", value)
+	value, _, _ = getESDT(receiver, token, 0)
+	assert.Equal(t, "100", value)
+	supply, minted, _ = getSupply(0, token)
+	assert.Equal(t, "1050", supply)
+	assert.Equal(t, "1050", minted)
+
+	err = chainSimulator.SetESDTBalance(&dtos.ESDTBalance{Address: holder.Bech32, Identifier: token, Value: "0"})
+	require.Nil(t, err)
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+	value, _, _ = getESDT(holder, token, 0)
+	assert.Equal(t, "0", value)
+	supply, _, burned = getSupply(0, token)
+	assert.Equal(t, "100", supply)
+	assert.Equal(t, "950", burned)
+
+	tokenData, err := chainSimulator.getESDTTokenData(token)
+	require.Nil(t, err)
+	assert.Equal(t, owner.Bytes, tokenData.OwnerAddress)
+	assert.Equal(t, uint32(6), tokenData.NumDecimals)
+	assert.Equal(t, big.NewInt(1000), tokenData.MintedValue)
+	assert.Equal(t, big.NewInt(950), tokenData.BurntValue)
+	require.Len(t, tokenData.SpecialRoles, 1)
+	assert.Equal(t, holder.Bytes, tokenData.SpecialRoles[0].Address)
+
+	err = chainSimulator.SetESDTRoles(&dtos.ESDTRoles{Address: holder.Bech32, Identifier: token})
+	require.Nil(t, err)
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+	roles, _, err = chainSimulator.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().GetESDTsRoles(holder.Bech32, coreAPI.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Empty(t, roles[token])
+	tokenData, err = chainSimulator.getESDTTokenData(token)
+	require.Nil(t, err)
+	assert.Empty(t, tokenData.SpecialRoles)
+
+	// non fungible tokens
+	nft := "NFT-abcdef"
+	sft := "SFT-abcdef"
+	err = chainSimulator.CreateESDTToken(&dtos.ESDTToken{Identifier: nft, Type: core.NonFungibleESDTv2, Owner: owner.Bech32})
+	require.Nil(t, err)
+	err = chainSimulator.CreateESDTToken(&dtos.ESDTToken{Identifier: sft, Type: core.SemiFungibleESDT, Owner: owner.Bech32})
+	require.Nil(t, err)
+
+	err = chainSimulator.SetESDTBalance(&dtos.ESDTBalance{Address: holder.Bech32, Identifier: nft, Value: "1"})
+	require.ErrorIs(t, err, errInvalidESDTNonce)
+	err = chainSimulator.SetESDTBalance(&dtos.ESDTBalance{Address: holder.Bech32, Identifier: nft, Nonce: 1, Value: "2"})
+	require.ErrorIs(t, err, errInvalidESDTValue)
+	err = chainSimulator.SetESDTBalance(&dtos.ESDTBalance{Address: holder.Bech32, Identifier: nft, Nonce: 1, Value: "1", Royalties: core.MaxRoyalty + 1})
+	require.ErrorIs(t, err, errInvalidESDTRoyalties)
+
+	err = chainSimulator.SetESDTBalance(&dtos.ESDTBalance{
+		Address:    holder.Bech32,
+		Identifier: nft,
+		Nonce:      1,
+		Value:      "1",
+		Name:       "first",
+		Royalties:  500,
+		Attributes: hex.EncodeToString([]byte("nft attributes")),
+		URIs:       []string{"https://uri"},
+	})
+	require.Nil(t, err)
+
+	err = chainSimulator.SetESDTRoles(&dtos.ESDTRoles{Address: holder.Bech32, Identifier: sft, Roles: []string{core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity}})
+	require.Nil(t, err)
+	err = chainSimulator.SetESDTBalance(&dtos.ESDTBalance{
+		Address:    holder.Bech32,
+		Identifier: sft,
+		Nonce:      5,
+		Value:      "10",
+		Royalties:  1000,
+		Attributes: hex.EncodeToString([]byte("sft attributes")),
+	})
+	require.Nil(t, err)
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	value, attributes, royalties := getESDT(holder, nft, 1)
+	assert.Equal(t, "1", value)
+	assert.Equal(t, "nft attributes", attributes)
+	assert.Equal(t, uint32(500), royalties)
+	value, attributes, royalties = getESDT(holder, sft, 5)
+	assert.Equal(t, "10", value)
+	assert.Equal(t, "sft attributes", attributes)
+	assert.Equal(t, uint32(1000), royalties)
+
+	supply, _, _ = getSupply(0, sft+"-05")
+	assert.Equal(t, "10", supply)
+	supply, _, _ = getSupply(0, sft)
+	assert.Equal(t, "10", supply)
+
+	// the metadata set by the chain simulator travels with the transferred tokens
+	sftHex := hex.EncodeToString([]byte(sft))
+	transferData := core.BuiltInFunctionESDTNFTTransfer + "@" + sftHex + "@05@03@" + hex.EncodeToString(receiver.Bytes)
+	sendTx(holder, holder, transferData)
+	err = chainSimulator.GenerateBlocks(5)
+	require.Nil(t, err)
+	value, attributes, _ = getESDT(receiver, sft, 5)
+	assert.Equal(t, "3", value)
+	assert.Equal(t, "sft attributes", attributes)
+
+	// the latest nonce was advanced, so the next created token does not overwrite the one set before
+	createData := core.BuiltInFunctionESDTNFTCreate + "@" + sftHex + "@02@" + hex.EncodeToString([]byte("created")) +
+		"@@@" + hex.EncodeToString([]byte("created attributes")) + "@"
+	sendTx(holder, holder, createData)
+	value, attributes, _ = getESDT(holder, sft, 6)
+	assert.Equal(t, "2", value)
+	assert.Equal(t, "created attributes", attributes)
+	value, attributes, _ = getESDT(holder, sft, 5)
+	assert.Equal(t, "7", value)
+	assert.Equal(t, "sft attributes", attributes)
+
+	// once the NFT store optimization is enabled, the SFT metadata is kept on the system account
+	err = chainSimulator.GenerateBlocksUntilEpochIsReached(2)
+	require.Nil(t, err)
+	err = chainSimulator.SetESDTBalance(&dtos.ESDTBalance{
+		Address:    receiver.Bech32,
+		Identifier: sft,
+		Nonce:      7,
+		Value:      "4",
+		Attributes: hex.EncodeToString([]byte("system account attributes")),
+	})
+	require.Nil(t, err)
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	value, attributes, _ = getESDT(receiver, sft, 7)
+	assert.Equal(t, "4", value)
+	assert.Equal(t, "system account attributes", attributes)
+
+	receiverNode := chainSimulator.GetNodeHandler(1)
+	systemAccountValue, err := getAccountValue(receiverNode, core.SystemAccountAddress, computeESDTKey(sft, 7))
+	require.Nil(t, err)
+	assert.Contains(t, string(systemAccountValue), "system account attributes")
+	receiverValue, err := getAccountValue(receiverNode, receiver.Bytes, computeESDTKey(sft, 7))
+	require.Nil(t, err)
+	assert.NotContains(t, string(receiverValue), "system account attributes")
+}
@@ -0,0 +1,39 @@
+package chainSimulator
+
+import (
+	"encoding/hex"
+
+	commonFactory "github.com/multiversx/mx-chain-go/common/factory"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
+)
+
+// createExecutionTracer creates the execution tracer shared by all the nodes, so the trace of a transaction gathers the
+// call frames executed in all the shards. The tracer is enabled through the Debug.ExecutionTracer section of the config
+func createExecutionTracer(generalConfig *config.Config) (tracing.ExecutionTracerHandler, error) {
+	tracerConfig := generalConfig.Debug.ExecutionTracer
+	if !tracerConfig.Enabled {
+		return tracing.NewDisabledExecutionTracer(), nil
+	}
+
+	addressConverter, err := commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracing.NewExecutionTracer(tracing.ArgsExecutionTracer{
+		PubkeyConverter:       addressConverter,
+		MaxTracedTransactions: tracerConfig.MaxTracedTransactions,
+	})
+}
+
+// GetTransactionTrace returns the call frames of the smart contract executions triggered, in all the shards, by the
+// transaction with the provided hex encoded hash
+func (s *simulator) GetTransactionTrace(txHash string) (*tracing.TransactionTrace, error) {
+	txHashBytes, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.executionTracer.GetTransactionTrace(txHashBytes)
+}
@@ -0,0 +1,487 @@
+package chainSimulator
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/update"
+	"github.com/multiversx/mx-chain-go/update/genesis"
+	"github.com/multiversx/mx-chain-go/update/storing"
+)
+
+const (
+	trieIdentifierPrefix = genesis.TrieIdentifier + "@"
+	// exportedAccountsBatchSize is the number of exported accounts read and applied at once
+	exportedAccountsBatchSize = 100
+)
+
+// ForkArgs holds the arguments needed to start the chain simulator from the state of an existing chain.
+// The state can be loaded eagerly from a hardfork state export and/or lazily from a node's trie storage directories
+type ForkArgs struct {
+	// StateExportFolder is the folder holding a hardfork state export. If empty, no account is loaded from an export
+	StateExportFolder string
+	// ExportedShards are the shards from the state export to be loaded. If empty, all the exported shards are loaded
+	ExportedShards []uint32
+	// ExportedAddresses are the bech32 addresses from the state export to be loaded. If empty, all the accounts are loaded
+	ExportedAddresses []string
+	// TrieStorages are the node trie storage directories to be used, for each shard, as the backing accounts state
+	TrieStorages map[uint32]TrieStorageFork
+}
+
+// TrieStorageFork defines a node trie storage to be used as the backing accounts state of a shard
+type TrieStorageFork struct {
+	// Paths are the trie storage directories (e.g. db/<chain>/Epoch_<n>/Shard_<id>/AccountsTrie). The directories are
+	// only read, so each shard should use its own copy since a directory can only be opened once
+	Paths []string
+	// RootHash is the hex encoded accounts root hash the shard starts from
+	RootHash string
+}
+
+func (args *ForkArgs) trieStoragePaths(shardID uint32) []string {
+	if args == nil {
+		return nil
+	}
+
+	return args.TrieStorages[shardID].Paths
+}
+
+func (s *simulator) applyFork(args *ForkArgs, hardforkConfig config.HardforkConfig) error {
+	if args == nil {
+		return nil
+	}
+
+	err := s.recreateForkedTries(args.TrieStorages)
+	if err != nil {
+		return err
+	}
+
+	if len(args.StateExportFolder) == 0 {
+		return nil
+	}
+
+	return s.loadExportedState(args, hardforkConfig)
+}
+
+func (s *simulator) recreateForkedTries(trieStorages map[uint32]TrieStorageFork) error {
+	for shardID, trieStorageFork := range trieStorages {
+		node, ok := s.nodes[shardID]
+		if !ok {
+			return fmt.Errorf("%w: %d", errInvalidForkShard, shardID)
+		}
+
+		rootHash, err := hex.DecodeString(trieStorageFork.RootHash)
+		if err != nil {
+			return fmt.Errorf("%w while decoding the forked root hash for shard %d", err, shardID)
+		}
+
+		err = node.GetStateComponents().AccountsAdapter().RecreateTrie(holders.NewDefaultRootHashesHolder(rootHash))
+		if err != nil {
+			return fmt.Errorf("%w while recreating the forked trie for shard %d", err, shardID)
+		}
+
+		log.Info("chain simulator forked the accounts state", "shard", shardID, "root hash", rootHash)
+	}
+
+	return nil
+}
+
+func (s *simulator) loadExportedState(args *ForkArgs, hardforkConfig config.HardforkConfig) error {
+	metachainNode := s.nodes[core.MetachainShardId]
+	marshaller := metachainNode.GetCoreComponents().InternalMarshalizer()
+	addressConverter := metachainNode.GetCoreComponents().AddressPubKeyConverter()
+
+	hardforkStorer, err := createHardforkStorer(args.StateExportFolder, hardforkConfig, marshaller)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = hardforkStorer.Close()
+	}()
+
+	loader, err := newExportedStateLoader(args, hardforkStorer, marshaller, addressConverter)
+	if err != nil {
+		return err
+	}
+
+	numAccounts, err := loader.load(s.SetStateMultiple)
+	if err != nil {
+		return err
+	}
+
+	log.Info("chain simulator loaded the exported state", "folder", args.StateExportFolder, "num accounts", numAccounts)
+
+	return nil
+}
+
+func createHardforkStorer(folder string, hardforkConfig config.HardforkConfig, marshaller marshal.Marshalizer) (update.HardforkStorer, error) {
+	keysStorer, err := createStorer(hardforkConfig.ImportKeysStorageConfig, folder)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the keys storer", err)
+	}
+
+	keysVals, err := createStorer(hardforkConfig.ImportStateStorageConfig, folder)
+	if err != nil {
+		_ = keysStorer.Close()
+		return nil, fmt.Errorf("%w while creating the state storer", err)
+	}
+
+	return storing.NewHardforkStorer(storing.ArgHardforkStorer{
+		KeysStore:   keysStorer,
+		KeyValue:    keysVals,
+		Marshalizer: marshaller,
+	})
+}
+
+func createStorer(storageConfig config.StorageConfig, folder string) (storage.Storer, error) {
+	dbConfig := factory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = path.Join(folder, storageConfig.DB.FilePath)
+
+	persisterFactory, err := factory.NewPersisterFactory(storageConfig.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageunit.NewStorageUnitFromConf(
+		factory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+}
+
+// exportedEntry locates a value in the state export
+type exportedEntry struct {
+	identifier string
+	key        []byte
+}
+
+type exportedAccount struct {
+	shardID uint32
+	entry   exportedEntry
+}
+
+// exportedStateLoader indexes the state export and then reads the selected accounts, together with their code and
+// data trie, in batches, so only the locations of the exported values are kept in memory
+type exportedStateLoader struct {
+	hardforkStorer   update.HardforkStorer
+	marshaller       marshal.Marshalizer
+	addressConverter core.PubkeyConverter
+	shards           map[uint32]struct{}
+	addresses        map[string]struct{}
+	accounts         map[string]*exportedAccount
+	codes            map[string]exportedEntry
+	dataTries        map[string][][]byte
+}
+
+func newExportedStateLoader(
+	args *ForkArgs,
+	hardforkStorer update.HardforkStorer,
+	marshaller marshal.Marshalizer,
+	addressConverter core.PubkeyConverter,
+) (*exportedStateLoader, error) {
+	loader := &exportedStateLoader{
+		hardforkStorer:   hardforkStorer,
+		marshaller:       marshaller,
+		addressConverter: addressConverter,
+		shards:           make(map[uint32]struct{}, len(args.ExportedShards)),
+		addresses:        make(map[string]struct{}, len(args.ExportedAddresses)),
+		accounts:         make(map[string]*exportedAccount),
+		codes:            make(map[string]exportedEntry),
+		dataTries:        make(map[string][][]byte),
+	}
+
+	for _, shardID := range args.ExportedShards {
+		loader.shards[shardID] = struct{}{}
+	}
+
+	for _, address := range args.ExportedAddresses {
+		addressBytes, err := addressConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for exported address %s", err, address)
+		}
+
+		loader.addresses[string(addressBytes)] = struct{}{}
+	}
+
+	return loader, nil
+}
+
+// load indexes the state export and provides the selected accounts state to the handler in batches, sorted by address.
+// It returns the number of loaded accounts
+func (loader *exportedStateLoader) load(handler func(addressesState []*dtos.AddressState) error) (int, error) {
+	var err error
+	loader.hardforkStorer.RangeKeys(func(identifier string, keys [][]byte) bool {
+		err = loader.processIdentifier(identifier, keys)
+		return err == nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	addresses := make([]string, 0, len(loader.accounts))
+	for address := range loader.accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for start := 0; start < len(addresses); start += exportedAccountsBatchSize {
+		end := start + exportedAccountsBatchSize
+		if end > len(addresses) {
+			end = len(addresses)
+		}
+
+		addressesState := make([]*dtos.AddressState, 0, end-start)
+		for _, address := range addresses[start:end] {
+			addressState, errCreate := loader.createAddressState([]byte(address), loader.accounts[address])
+			if errCreate != nil {
+				return 0, errCreate
+			}
+
+			addressesState = append(addressesState, addressState)
+		}
+
+		err = handler(addressesState)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(addresses), nil
+}
+
+func (loader *exportedStateLoader) processIdentifier(identifier string, keys [][]byte) error {
+	if !strings.HasPrefix(identifier, trieIdentifierPrefix) {
+		return nil
+	}
+
+	accType, shardID, err := genesis.GetTrieTypeAndShId(identifier)
+	if err != nil {
+		return err
+	}
+
+	if !loader.isShardSelected(shardID) {
+		return nil
+	}
+
+	switch accType {
+	case genesis.UserAccount:
+		return loader.processAccounts(identifier, shardID, keys)
+	case genesis.DataTrie:
+		loader.dataTries[identifier] = keys
+	}
+
+	return nil
+}
+
+func (loader *exportedStateLoader) processAccounts(identifier string, shardID uint32, keys [][]byte) error {
+	for _, key := range keys {
+		keyType, address, err := genesis.GetKeyTypeAndHash(string(key))
+		if err != nil {
+			return err
+		}
+		if keyType != genesis.UserAccount {
+			continue
+		}
+
+		value, err := loader.hardforkStorer.Get(identifier, key)
+		if err != nil {
+			return err
+		}
+
+		entry := exportedEntry{
+			identifier: identifier,
+			key:        key,
+		}
+		accountData := &accounts.UserAccountData{}
+		err = loader.marshaller.Unmarshal(accountData, value)
+		if err != nil || !bytes.Equal(accountData.Address, address) {
+			// the main trie also holds the contracts code, under the code hash
+			loader.codes[string(address)] = entry
+
+			continue
+		}
+
+		if !loader.isAddressSelected(address) {
+			continue
+		}
+
+		_, exists := loader.accounts[string(address)]
+		if exists {
+			// the system account is exported once for each shard, the first one is kept
+			continue
+		}
+
+		loader.accounts[string(address)] = &exportedAccount{
+			shardID: shardID,
+			entry:   entry,
+		}
+	}
+
+	return nil
+}
+
+func (loader *exportedStateLoader) getCode(codeHash []byte) ([]byte, error) {
+	entry, found := loader.codes[string(codeHash)]
+	if !found {
+		return nil, nil
+	}
+
+	value, err := loader.hardforkStorer.Get(entry.identifier, entry.key)
+	if err != nil {
+		return nil, err
+	}
+
+	codeEntry := &state.CodeEntry{}
+	err = loader.marshaller.Unmarshal(codeEntry, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the exported leaf %s", err, hex.EncodeToString(codeHash))
+	}
+
+	return codeEntry.Code, nil
+}
+
+func (loader *exportedStateLoader) createAddressState(address []byte, account *exportedAccount) (*dtos.AddressState, error) {
+	bech32Address, err := loader.addressConverter.Encode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := loader.hardforkStorer.Get(account.entry.identifier, account.entry.key)
+	if err != nil {
+		return nil, err
+	}
+
+	accountData := &accounts.UserAccountData{}
+	err = loader.marshaller.Unmarshal(accountData, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, bech32Address)
+	}
+
+	nonce := accountData.Nonce
+	addressState := &dtos.AddressState{
+		Address: bech32Address,
+		Nonce:   &nonce,
+		Balance: "0",
+	}
+
+	if accountData.Balance != nil {
+		addressState.Balance = accountData.Balance.String()
+	}
+	if len(accountData.CodeHash) > 0 {
+		code, errCode := loader.getCode(accountData.CodeHash)
+		if errCode != nil {
+			return nil, fmt.Errorf("%w for address %s", errCode, bech32Address)
+		}
+
+		addressState.Code = hex.EncodeToString(code)
+		addressState.CodeHash = base64.StdEncoding.EncodeToString(accountData.CodeHash)
+	}
+	if len(accountData.CodeMetadata) > 0 {
+		addressState.CodeMetadata = base64.StdEncoding.EncodeToString(accountData.CodeMetadata)
+	}
+	if len(accountData.OwnerAddress) > 0 {
+		addressState.Owner, err = loader.addressConverter.Encode(accountData.OwnerAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if accountData.DeveloperReward != nil && accountData.DeveloperReward.Sign() > 0 {
+		addressState.DeveloperRewards = accountData.DeveloperReward.String()
+	}
+
+	addressState.Pairs, err = loader.getPairs(address, account.shardID, accountData.RootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, bech32Address)
+	}
+
+	return addressState, nil
+}
+
+func (loader *exportedStateLoader) getPairs(address []byte, shardID uint32, rootHash []byte) (map[string]string, error) {
+	if common.IsEmptyTrie(rootHash) {
+		return nil, nil
+	}
+
+	dataTrieIdentifier := genesis.CreateTrieIdentifier(shardID, genesis.DataTrie)
+	identifier := trieIdentifierPrefix + genesis.AddRootHashToIdentifier(dataTrieIdentifier, string(rootHash))
+	keys, found := loader.dataTries[identifier]
+	if !found {
+		return nil, fmt.Errorf("%w, root hash %s", errMissingExportedDataTrie, hex.EncodeToString(rootHash))
+	}
+
+	pairs := make(map[string]string, len(keys))
+	for _, key := range keys {
+		keyType, trieKey, err := genesis.GetKeyTypeAndHash(string(key))
+		if err != nil {
+			return nil, err
+		}
+		if keyType != genesis.DataTrie {
+			continue
+		}
+
+		value, err := loader.hardforkStorer.Get(identifier, key)
+		if err != nil {
+			return nil, err
+		}
+
+		pairKey, pairValue, err := loader.parseDataTrieLeaf(address, trieKey, value)
+		if err != nil {
+			return nil, err
+		}
+
+		pairs[hex.EncodeToString(pairKey)] = hex.EncodeToString(pairValue)
+	}
+
+	return pairs, nil
+}
+
+// parseDataTrieLeaf extracts the original key and value from an exported data trie leaf, which is either a
+// value suffixed with the key and the address or, for auto-balanced data tries, a marshalled TrieLeafData
+func (loader *exportedStateLoader) parseDataTrieLeaf(address []byte, trieKey []byte, value []byte) ([]byte, []byte, error) {
+	suffix := append(append(make([]byte, 0, len(trieKey)+len(address)), trieKey...), address...)
+	if bytes.HasSuffix(value, suffix) {
+		return trieKey, value[:len(value)-len(suffix)], nil
+	}
+
+	leafData := &dataTrieValue.TrieLeafData{}
+	err := loader.marshaller.Unmarshal(leafData, value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while reading the data trie leaf %s", err, hex.EncodeToString(trieKey))
+	}
+
+	return leafData.Key, leafData.Value, nil
+}
+
+func (loader *exportedStateLoader) isShardSelected(shardID uint32) bool {
+	if len(loader.shards) == 0 {
+		return true
+	}
+
+	_, found := loader.shards[shardID]
+	return found
+}
+
+func (loader *exportedStateLoader) isAddressSelected(address []byte) bool {
+	if len(loader.addresses) == 0 {
+		return true
+	}
+
+	_, found := loader.addresses[string(address)]
+	return found
+}
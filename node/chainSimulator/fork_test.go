@@ -0,0 +1,211 @@
+package chainSimulator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	chainSimulatorCommon "github.com/multiversx/mx-chain-go/integrationTests/chainSimulator"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/update/genesis"
+	"github.com/stretchr/testify/require"
+)
+
+func createForkTestSimulator(t *testing.T, fork *ForkArgs) *simulator {
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       time.Now().Unix(),
+		RoundDurationInMillis:  uint64(6000),
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    100,
+		},
+		ApiInterface:      api.NewNoApiInterface(),
+		MinNodesPerShard:  1,
+		MetaChainMinNodes: 1,
+		Fork:              fork,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	return chainSimulator
+}
+
+func requireAccountValue(t *testing.T, chainSimulator *simulator, address dtos.WalletAddress, key []byte, expectedValue []byte) {
+	account, err := chainSimulator.GetNodeHandler(0).GetStateComponents().AccountsAdapter().GetExistingAccount(address.Bytes)
+	require.Nil(t, err)
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	require.True(t, ok)
+
+	value, _, err := userAccount.RetrieveValue(key)
+	require.Nil(t, err)
+	require.Equal(t, expectedValue, value)
+}
+
+func TestSimulator_ForkFromTrieStorage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	originalSimulator := createForkTestSimulator(t, nil)
+	defer originalSimulator.Close()
+
+	balance := big.NewInt(0).Mul(chainSimulatorCommon.OneEGLD, big.NewInt(7))
+	address, err := originalSimulator.GenerateAndMintWalletAddress(0, balance)
+	require.Nil(t, err)
+
+	err = originalSimulator.SetKeyValueForAddress(address.Bech32, map[string]string{
+		hex.EncodeToString([]byte("key")): hex.EncodeToString([]byte("value")),
+	})
+	require.Nil(t, err)
+
+	err = originalSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	originalNode := originalSimulator.GetNodeHandler(0)
+	rootHash, err := originalNode.GetStateComponents().AccountsAdapter().RootHash()
+	require.Nil(t, err)
+
+	trieStorer, err := originalNode.GetDataComponents().StorageService().GetStorer(dataRetriever.UserAccountsUnit)
+	require.Nil(t, err)
+
+	trieStorageDir := t.TempDir()
+	db, err := database.NewLevelDB(trieStorageDir, 1, 100, 10)
+	require.Nil(t, err)
+	trieStorer.RangeKeys(func(key []byte, val []byte) bool {
+		require.Nil(t, db.Put(key, val))
+		return true
+	})
+	require.Nil(t, db.Close())
+
+	forkedSimulator := createForkTestSimulator(t, &ForkArgs{
+		TrieStorages: map[uint32]TrieStorageFork{
+			0: {
+				Paths:    []string{trieStorageDir},
+				RootHash: hex.EncodeToString(rootHash),
+			},
+		},
+	})
+	defer forkedSimulator.Close()
+
+	err = forkedSimulator.GenerateBlocks(2)
+	require.Nil(t, err)
+
+	account, err := forkedSimulator.GetAccount(address)
+	require.Nil(t, err)
+	require.Equal(t, balance.String(), account.Balance)
+	requireAccountValue(t, forkedSimulator, address, []byte("key"), []byte("value"))
+}
+
+func TestSimulator_ForkFromStateExport(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	exportFolder := t.TempDir()
+	mainConfig, err := common.LoadMainConfig(defaultPathToInitialConfig + "config.toml")
+	require.Nil(t, err)
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hardforkStorer, err := createHardforkStorer(exportFolder, mainConfig.Hardfork, marshaller)
+	require.Nil(t, err)
+
+	// any simulator instance can be used to generate addresses in the desired shard
+	addressesSimulator := createForkTestSimulator(t, nil)
+	contract := addressesSimulator.GenerateAddressInShard(0)
+	contract.Bytes = append(make([]byte, 10), contract.Bytes[10:]...)
+	contract.Bech32, err = addressesSimulator.GetNodeHandler(0).GetCoreComponents().AddressPubKeyConverter().Encode(contract.Bytes)
+	require.Nil(t, err)
+	owner := addressesSimulator.GenerateAddressInShard(0)
+	skipped := addressesSimulator.GenerateAddressInShard(0)
+	addressesSimulator.Close()
+
+	code := []byte("contract code")
+	codeHash := []byte("contract code hash")
+	dataTrieRootHash := []byte("data trie root hash")
+	contractData := &accounts.UserAccountData{
+		Nonce:        3,
+		Balance:      big.NewInt(1000),
+		CodeHash:     codeHash,
+		RootHash:     dataTrieRootHash,
+		Address:      contract.Bytes,
+		OwnerAddress: owner.Bytes,
+		CodeMetadata: []byte{5, 0},
+	}
+	skippedData := &accounts.UserAccountData{
+		Balance: big.NewInt(2000),
+		Address: skipped.Bytes,
+	}
+
+	write := func(identifier string, key string, value []byte) {
+		require.Nil(t, hardforkStorer.Write(identifier, []byte(key), value))
+	}
+	marshalObject := func(obj interface{}) []byte {
+		buff, errMarshal := marshaller.Marshal(obj)
+		require.Nil(t, errMarshal)
+		return buff
+	}
+
+	accountsTrieIdentifier := genesis.CreateTrieIdentifier(0, genesis.UserAccount)
+	accountsIdentifier := genesis.TrieIdentifier + "@" + accountsTrieIdentifier
+	write(accountsIdentifier, genesis.CreateRootHashKey(accountsTrieIdentifier), []byte("root hash"))
+	write(accountsIdentifier, genesis.CreateAccountKey(genesis.UserAccount, 0, contract.Bytes), marshalObject(contractData))
+	write(accountsIdentifier, genesis.CreateAccountKey(genesis.UserAccount, 0, skipped.Bytes), marshalObject(skippedData))
+	write(accountsIdentifier, genesis.CreateAccountKey(genesis.UserAccount, 0, codeHash), marshalObject(&state.CodeEntry{Code: code, NumReferences: 1}))
+	require.Nil(t, hardforkStorer.FinishedIdentifier(accountsIdentifier))
+
+	dataTrieIdentifier := genesis.AddRootHashToIdentifier(genesis.CreateTrieIdentifier(0, genesis.DataTrie), string(dataTrieRootHash))
+	dataIdentifier := genesis.TrieIdentifier + "@" + dataTrieIdentifier
+	autoBalancedLeaf := &dataTrieValue.TrieLeafData{
+		Value:   []byte("value1"),
+		Key:     []byte("key1"),
+		Address: contract.Bytes,
+	}
+	notAutoBalancedLeaf := append([]byte("value2"), append([]byte("key2"), contract.Bytes...)...)
+	write(dataIdentifier, genesis.CreateRootHashKey(dataTrieIdentifier), dataTrieRootHash)
+	write(dataIdentifier, genesis.CreateAccountKey(genesis.DataTrie, 0, []byte("hashed key1")), marshalObject(autoBalancedLeaf))
+	write(dataIdentifier, genesis.CreateAccountKey(genesis.DataTrie, 0, []byte("key2")), notAutoBalancedLeaf)
+	require.Nil(t, hardforkStorer.FinishedIdentifier(dataIdentifier))
+	require.Nil(t, hardforkStorer.Close())
+
+	forkedSimulator := createForkTestSimulator(t, &ForkArgs{
+		StateExportFolder: exportFolder,
+		ExportedShards:    []uint32{0},
+		ExportedAddresses: []string{contract.Bech32},
+	})
+	defer forkedSimulator.Close()
+
+	err = forkedSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	account, err := forkedSimulator.GetAccount(contract)
+	require.Nil(t, err)
+	require.Equal(t, "1000", account.Balance)
+	require.Equal(t, uint64(3), account.Nonce)
+	require.Equal(t, owner.Bech32, account.OwnerAddress)
+	requireAccountValue(t, forkedSimulator, contract, []byte("key1"), []byte("value1"))
+	requireAccountValue(t, forkedSimulator, contract, []byte("key2"), []byte("value2"))
+
+	accountsAdapter := forkedSimulator.GetNodeHandler(0).GetStateComponents().AccountsAdapter()
+	loadedContract, err := accountsAdapter.GetExistingAccount(contract.Bytes)
+	require.Nil(t, err)
+	require.Equal(t, code, accountsAdapter.GetCode(loadedContract.(state.UserAccountHandler).GetCodeHash()))
+
+	account, err = forkedSimulator.GetAccount(skipped)
+	require.Nil(t, err)
+	require.Equal(t, "0", account.Balance)
+}
@@ -0,0 +1,42 @@
+package chainSimulator
+
+import (
+	commonFactory "github.com/multiversx/mx-chain-go/common/factory"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/debug/gasProfiler"
+)
+
+// createGasProfiler creates the gas profiler shared by all the nodes. The profiler is enabled through the
+// Debug.GasProfiler section of the config
+func createGasProfiler(generalConfig *config.Config) (gasProfiler.GasProfilerHandler, error) {
+	profilerConfig := generalConfig.Debug.GasProfiler
+	if !profilerConfig.Enabled {
+		return gasProfiler.NewDisabledGasProfiler(), nil
+	}
+
+	addressConverter, err := commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return gasProfiler.NewGasProfiler(gasProfiler.ArgsGasProfiler{
+		PubkeyConverter:         addressConverter,
+		MaxProfiledTransactions: profilerConfig.MaxProfiledTransactions,
+	})
+}
+
+// GetGasProfileReport returns the gas used, the fee and the refund per contract endpoint, aggregated over the
+// transactions executed so far
+func (s *simulator) GetGasProfileReport() (*gasProfiler.Report, error) {
+	return s.gasProfiler.GetReport()
+}
+
+// SaveGasProfileReport writes the JSON and the CSV gas profile reports in the provided folder
+func (s *simulator) SaveGasProfileReport(folder string) error {
+	return s.gasProfiler.SaveReport(folder)
+}
+
+// ResetGasProfile removes all the profiled transactions
+func (s *simulator) ResetGasProfile() {
+	s.gasProfiler.Reset()
+}
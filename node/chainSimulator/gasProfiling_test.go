@@ -0,0 +1,168 @@
+package chainSimulator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/debug/gasProfiler"
+	chainSimulatorCommon "github.com/multiversx/mx-chain-go/integrationTests/chainSimulator"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/configs"
+	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulator_GasProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max profiled transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		generalConfig := &config.Config{}
+		generalConfig.AddressPubkeyConverter = config.PubkeyConfig{Length: 32, Type: "bech32", Hrp: "erd"}
+		generalConfig.Debug.GasProfiler.Enabled = true
+		profiler, err := createGasProfiler(generalConfig)
+		require.Equal(t, gasProfiler.ErrInvalidMaxProfiledTransactions, err)
+		require.Nil(t, profiler)
+	})
+	t.Run("disabled profiler should error", func(t *testing.T) {
+		t.Parallel()
+
+		profiler, err := createGasProfiler(&config.Config{})
+		require.Nil(t, err)
+		chainSimulator := &simulator{
+			gasProfiler: profiler,
+		}
+
+		report, err := chainSimulator.GetGasProfileReport()
+		require.Equal(t, gasProfiler.ErrGasProfilingDisabled, err)
+		require.Nil(t, report)
+
+		err = chainSimulator.SaveGasProfileReport(t.TempDir())
+		require.Equal(t, gasProfiler.ErrGasProfilingDisabled, err)
+	})
+}
+
+func TestSimulator_GasProfileOfExecutedContract(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       time.Now().Unix(),
+		RoundDurationInMillis:  uint64(6000),
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    20,
+		},
+		ApiInterface:      api.NewNoApiInterface(),
+		MinNodesPerShard:  1,
+		MetaChainMinNodes: 1,
+		AlterConfigsFunction: func(cfg *config.Configs) {
+			cfg.GeneralConfig.Debug.GasProfiler.Enabled = true
+			cfg.GeneralConfig.Debug.GasProfiler.MaxProfiledTransactions = 100
+		},
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	initialBalance := big.NewInt(0).Mul(chainSimulatorCommon.OneEGLD, big.NewInt(10))
+	owner, err := chainSimulator.GenerateAndMintWalletAddress(1, initialBalance)
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	code, err := os.ReadFile(adderWasmPath)
+	require.Nil(t, err)
+
+	nonce := uint64(0)
+	sendTx := func(receiver []byte, data string, gasLimit uint64) *transaction.ApiTransactionResult {
+		tx := &transaction.Transaction{
+			Nonce:     nonce,
+			Value:     big.NewInt(0),
+			SndAddr:   owner.Bytes,
+			RcvAddr:   receiver,
+			Data:      []byte(data),
+			GasLimit:  gasLimit,
+			GasPrice:  1_000_000_000,
+			ChainID:   []byte(configs.ChainID),
+			Version:   1,
+			Signature: []byte("signature"),
+		}
+		result, errSend := chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 10)
+		require.Nil(t, errSend)
+		require.Equal(t, transaction.TxStatusSuccess, result.Status)
+		nonce++
+
+		return result
+	}
+
+	deployData := strings.Join([]string{hex.EncodeToString(code), "0500", "0500", "00"}, "@")
+	deployResult := sendTx(make([]byte, 32), deployData, 5_000_000)
+	encodedContract := deployResult.Logs.Events[0].Address
+	contractAddress, err := chainSimulator.GetNodeHandler(0).GetCoreComponents().AddressPubKeyConverter().Decode(encodedContract)
+	require.Nil(t, err)
+
+	addResults := []*transaction.ApiTransactionResult{
+		sendTx(contractAddress, "add@05", 5_000_000),
+		sendTx(contractAddress, "add@07", 5_000_000),
+	}
+
+	report, err := chainSimulator.GetGasProfileReport()
+	require.Nil(t, err)
+	require.Equal(t, 3, report.NumProfiledTransactions)
+	require.Len(t, report.Endpoints, 2)
+
+	requireEndpointMatchesResults := func(endpointReport *gasProfiler.EndpointReport, endpoint string, results ...*transaction.ApiTransactionResult) {
+		require.Equal(t, encodedContract, endpointReport.Contract)
+		require.Equal(t, endpoint, endpointReport.Endpoint)
+		require.Equal(t, len(results), endpointReport.NumCalls)
+		require.Equal(t, 0, endpointReport.NumFailedCalls)
+
+		gasUsed, fee, refund := big.NewInt(0), big.NewInt(0), big.NewInt(0)
+		for _, result := range results {
+			initiallyPaidFee, ok := big.NewInt(0).SetString(result.InitiallyPaidFee, 10)
+			require.True(t, ok)
+			resultFee, ok := big.NewInt(0).SetString(result.Fee, 10)
+			require.True(t, ok)
+
+			gasUsed.Add(gasUsed, big.NewInt(0).SetUint64(result.GasUsed))
+			fee.Add(fee, resultFee)
+			refund.Add(refund, big.NewInt(0).Sub(initiallyPaidFee, resultFee))
+		}
+		require.Equal(t, gasUsed, endpointReport.GasUsed.Total)
+		require.Equal(t, fee, endpointReport.Fee.Total)
+		require.Equal(t, refund, endpointReport.Refund.Total)
+		require.Positive(t, refund.Sign())
+	}
+	requireEndpointMatchesResults(report.Endpoints[0], "add", addResults...)
+	requireEndpointMatchesResults(report.Endpoints[1], scrCommon.ProfiledDeployEndpoint, deployResult)
+
+	folder := t.TempDir()
+	err = chainSimulator.SaveGasProfileReport(folder)
+	require.Nil(t, err)
+	csvReport, err := os.ReadFile(filepath.Join(folder, gasProfiler.CSVReportFileName))
+	require.Nil(t, err)
+	require.Contains(t, string(csvReport), encodedContract+",add,2,0,")
+
+	chainSimulator.ResetGasProfile()
+	report, err = chainSimulator.GetGasProfileReport()
+	require.Nil(t, err)
+	require.Equal(t, 0, report.NumProfiledTransactions)
+	require.Empty(t, report.Endpoints)
+}
@@ -0,0 +1,27 @@
+package groups
+
+import "errors"
+
+// ErrInvalidNumberOfBlocks signals that an invalid number of blocks was provided
+var ErrInvalidNumberOfBlocks = errors.New("invalid number of blocks")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrGenerateBlocks signals an error in generating blocks with the chain simulator
+var ErrGenerateBlocks = errors.New("generating blocks error")
+
+// ErrForceEpochChange signals an error in forcing the change of epoch with the chain simulator
+var ErrForceEpochChange = errors.New("forcing the change of epoch error")
+
+// ErrSetState signals an error in setting the state of accounts with the chain simulator
+var ErrSetState = errors.New("setting state error")
+
+// ErrAddValidatorKeys signals an error in adding validator keys to the chain simulator
+var ErrAddValidatorKeys = errors.New("adding validator keys error")
+
+// ErrGenerateWallet signals an error in generating and minting a wallet with the chain simulator
+var ErrGenerateWallet = errors.New("generating wallet error")
+
+// ErrAutoBlockGeneration signals an error in starting the automatic block generation of the chain simulator
+var ErrAutoBlockGeneration = errors.New("automatic block generation error")
@@ -0,0 +1,357 @@
+package groups
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+)
+
+const (
+	generateBlocksPath           = "/generate-blocks/:num"
+	generateBlocksUntilEpochPath = "/generate-blocks-until-epoch-reached/:epoch"
+	forceEpochChangePath         = "/force-epoch-change"
+	setStatePath                 = "/set-state"
+	setKeyValuesPath             = "/set-key-values"
+	addValidatorKeysPath         = "/add-keys"
+	generateAndMintWalletPath    = "/generate-and-mint-wallet"
+	observersPath                = "/observers"
+	startAutoBlockGenerationPath = "/auto-generate-blocks/start"
+	stopAutoBlockGenerationPath  = "/auto-generate-blocks/stop"
+)
+
+// simulatorFacadeHandler defines the methods to be implemented by a chain simulator for handling the control requests
+type simulatorFacadeHandler interface {
+	GenerateBlocks(numOfBlocks int) error
+	GenerateBlocksUntilEpochIsReached(targetEpoch int32) error
+	ForceChangeOfEpoch() error
+	SetStateMultiple(stateSlice []*dtos.AddressState) error
+	SetKeyValueForAddress(address string, keyValueMap map[string]string) error
+	AddValidatorKeys(validatorsPrivateKeys [][]byte) error
+	GenerateAndMintWalletAddress(targetShardID uint32, value *big.Int) (dtos.WalletAddress, error)
+	GetRestAPIInterfaces() map[uint32]string
+	StartAutoBlockGeneration(args dtos.AutoBlockGeneration) error
+	StopAutoBlockGeneration()
+	IsInterfaceNil() bool
+}
+
+// simulatorGroup serves the chain simulator control endpoints. Unlike the node API groups, all its endpoints are
+// always open and the facade is never replaced, as it is the simulator itself
+type simulatorGroup struct {
+	facade    simulatorFacadeHandler
+	endpoints []*shared.EndpointHandlerData
+}
+
+// NewSimulatorGroup returns a new instance of simulatorGroup
+func NewSimulatorGroup(facade simulatorFacadeHandler) (*simulatorGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for simulator group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &simulatorGroup{
+		facade: facade,
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    generateBlocksPath,
+			Method:  http.MethodPost,
+			Handler: sg.generateBlocks,
+			Schema: &shared.EndpointSchema{
+				Summary: "generates the provided number of blocks on all the shards",
+			},
+		},
+		{
+			Path:    generateBlocksUntilEpochPath,
+			Method:  http.MethodPost,
+			Handler: sg.generateBlocksUntilEpochReached,
+			Schema: &shared.EndpointSchema{
+				Summary: "generates blocks until the provided epoch is reached on all the shards",
+			},
+		},
+		{
+			Path:    forceEpochChangePath,
+			Method:  http.MethodPost,
+			Handler: sg.forceEpochChange,
+			Schema: &shared.EndpointSchema{
+				Summary: "generates blocks until the next epoch is reached on all the shards",
+			},
+		},
+		{
+			Path:    setStatePath,
+			Method:  http.MethodPost,
+			Handler: sg.setState,
+			Schema: &shared.EndpointSchema{
+				Summary: "sets the state of the provided accounts",
+				Request: []*dtos.AddressState{},
+			},
+		},
+		{
+			Path:    setKeyValuesPath,
+			Method:  http.MethodPost,
+			Handler: sg.setKeyValues,
+			Schema: &shared.EndpointSchema{
+				Summary: "sets the provided hex encoded key-value pairs in the data trie of the provided address",
+				Request: SimulatorSetKeyValuesRequest{},
+			},
+		},
+		{
+			Path:    addValidatorKeysPath,
+			Method:  http.MethodPost,
+			Handler: sg.addValidatorKeys,
+			Schema: &shared.EndpointSchema{
+				Summary: "adds the provided hex encoded BLS private keys to the keys handled by the simulator nodes",
+				Request: SimulatorAddKeysRequest{},
+			},
+		},
+		{
+			Path:    generateAndMintWalletPath,
+			Method:  http.MethodPost,
+			Handler: sg.generateAndMintWallet,
+			Schema: &shared.EndpointSchema{
+				Summary:  "generates a new wallet in the provided shard and mints the provided value to it",
+				Request:  SimulatorGenerateWalletRequest{},
+				Response: gin.H{"address": dtos.WalletAddress{}},
+			},
+		},
+		{
+			Path:    observersPath,
+			Method:  http.MethodGet,
+			Handler: sg.getObservers,
+			Schema: &shared.EndpointSchema{
+				Summary:  "returns the REST API interface of the node of each shard",
+				Response: gin.H{"observers": map[uint32]string{}},
+			},
+		},
+		{
+			Path:    startAutoBlockGenerationPath,
+			Method:  http.MethodPost,
+			Handler: sg.startAutoBlockGeneration,
+			Schema: &shared.EndpointSchema{
+				Summary: "starts generating blocks periodically and/or on every received transaction",
+				Request: dtos.AutoBlockGeneration{},
+			},
+		},
+		{
+			Path:    stopAutoBlockGenerationPath,
+			Method:  http.MethodPost,
+			Handler: sg.stopAutoBlockGeneration,
+			Schema: &shared.EndpointSchema{
+				Summary: "stops the automatic block generation",
+			},
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// GetEndpoints returns all the endpoints of the group
+func (sg *simulatorGroup) GetEndpoints() []*shared.EndpointHandlerData {
+	return sg.endpoints
+}
+
+// RegisterRoutes registers all the endpoints of the group on the provided router group
+func (sg *simulatorGroup) RegisterRoutes(ws *gin.RouterGroup) {
+	for _, handlerData := range sg.endpoints {
+		ws.Handle(handlerData.Method, handlerData.Path, handlerData.Handler)
+	}
+}
+
+// SimulatorSetKeyValuesRequest represents the structure of a request for setting key-value pairs for an address
+type SimulatorSetKeyValuesRequest struct {
+	Address   string            `json:"address"`
+	KeyValues map[string]string `json:"keyValues"`
+}
+
+// SimulatorAddKeysRequest represents the structure of a request for adding validator keys
+type SimulatorAddKeysRequest struct {
+	PrivateKeys []string `json:"privateKeys"`
+}
+
+// SimulatorGenerateWalletRequest represents the structure of a request for generating and minting a wallet
+type SimulatorGenerateWalletRequest struct {
+	ShardID uint32 `json:"shardID"`
+	Value   string `json:"value"`
+}
+
+// generateBlocks generates the number of blocks provided in the URL
+func (sg *simulatorGroup) generateBlocks(c *gin.Context) {
+	numOfBlocks, err := strconv.ParseUint(c.Param("num"), 10, 32)
+	if err != nil || numOfBlocks == 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, ErrInvalidNumberOfBlocks)
+		return
+	}
+
+	err = sg.facade.GenerateBlocks(int(numOfBlocks))
+	if err != nil {
+		shared.RespondWithInternalError(c, ErrGenerateBlocks, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, nil)
+}
+
+// generateBlocksUntilEpochReached generates blocks until the epoch provided in the URL is reached
+func (sg *simulatorGroup) generateBlocksUntilEpochReached(c *gin.Context) {
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil || epoch > math.MaxInt32 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrInvalidEpoch)
+		return
+	}
+
+	err = sg.facade.GenerateBlocksUntilEpochIsReached(int32(epoch))
+	if err != nil {
+		shared.RespondWithInternalError(c, ErrGenerateBlocks, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, nil)
+}
+
+// forceEpochChange generates blocks until the next epoch is reached
+func (sg *simulatorGroup) forceEpochChange(c *gin.Context) {
+	err := sg.facade.ForceChangeOfEpoch()
+	if err != nil {
+		shared.RespondWithInternalError(c, ErrForceEpochChange, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, nil)
+}
+
+// setState sets the state of the accounts provided in the request body
+func (sg *simulatorGroup) setState(c *gin.Context) {
+	var state []*dtos.AddressState
+	err := c.ShouldBindJSON(&state)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = sg.facade.SetStateMultiple(state)
+	if err != nil {
+		shared.RespondWithInternalError(c, ErrSetState, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, nil)
+}
+
+// setKeyValues sets the key-value pairs provided in the request body
+func (sg *simulatorGroup) setKeyValues(c *gin.Context) {
+	request := SimulatorSetKeyValuesRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if len(request.Address) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyAddress)
+		return
+	}
+
+	err = sg.facade.SetKeyValueForAddress(request.Address, request.KeyValues)
+	if err != nil {
+		shared.RespondWithInternalError(c, ErrSetState, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, nil)
+}
+
+// addValidatorKeys adds the validator keys provided in the request body
+func (sg *simulatorGroup) addValidatorKeys(c *gin.Context) {
+	request := SimulatorAddKeysRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	privateKeys := make([][]byte, 0, len(request.PrivateKeys))
+	for _, privateKeyHex := range request.PrivateKeys {
+		privateKey, errDecode := hex.DecodeString(privateKeyHex)
+		if errDecode != nil {
+			shared.RespondWithValidationError(c, errors.ErrValidation, errDecode)
+			return
+		}
+
+		privateKeys = append(privateKeys, privateKey)
+	}
+
+	err = sg.facade.AddValidatorKeys(privateKeys)
+	if err != nil {
+		shared.RespondWithInternalError(c, ErrAddValidatorKeys, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, nil)
+}
+
+// generateAndMintWallet generates a wallet in the shard provided in the request body and mints the provided value to it
+func (sg *simulatorGroup) generateAndMintWallet(c *gin.Context) {
+	request := SimulatorGenerateWalletRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	value, ok := big.NewInt(0).SetString(request.Value, 10)
+	if !ok || value.Sign() < 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, ErrInvalidValue)
+		return
+	}
+
+	address, err := sg.facade.GenerateAndMintWalletAddress(request.ShardID, value)
+	if err != nil {
+		shared.RespondWithInternalError(c, ErrGenerateWallet, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"address": address})
+}
+
+// getObservers returns the REST API interface of the node of each shard
+func (sg *simulatorGroup) getObservers(c *gin.Context) {
+	shared.RespondWithSuccess(c, gin.H{"observers": sg.facade.GetRestAPIInterfaces()})
+}
+
+// startAutoBlockGeneration starts the automatic block generation with the settings provided in the request body
+func (sg *simulatorGroup) startAutoBlockGeneration(c *gin.Context) {
+	request := dtos.AutoBlockGeneration{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = sg.facade.StartAutoBlockGeneration(request)
+	if err != nil {
+		shared.RespondWithInternalError(c, ErrAutoBlockGeneration, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, nil)
+}
+
+// stopAutoBlockGeneration stops the automatic block generation
+func (sg *simulatorGroup) stopAutoBlockGeneration(c *gin.Context) {
+	sg.facade.StopAutoBlockGeneration()
+
+	shared.RespondWithSuccess(c, nil)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *simulatorGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
@@ -0,0 +1,336 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/groups"
+	"github.com/multiversx/mx-chain-go/testscommon/chainSimulator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+type generateWalletResponse struct {
+	Data struct {
+		Address dtos.WalletAddress `json:"address"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type observersResponse struct {
+	Data struct {
+		Observers map[uint32]string `json:"observers"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewSimulatorGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewSimulatorGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewSimulatorGroup(&chainSimulator.SimulatorFacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+		require.Len(t, sg.GetEndpoints(), 10)
+	})
+}
+
+func TestSimulatorGroup_generateBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, code := requestSimulator(t, &chainSimulator.SimulatorFacadeStub{}, http.MethodPost, "/simulator/generate-blocks/0", "")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, groups.ErrInvalidNumberOfBlocks.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &chainSimulator.SimulatorFacadeStub{
+			GenerateBlocksCalled: func(numOfBlocks int) error {
+				return expectedErr
+			},
+		}
+
+		response, code := requestSimulator(t, facade, http.MethodPost, "/simulator/generate-blocks/2", "")
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, formatExpectedErr(groups.ErrGenerateBlocks, expectedErr), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		generatedBlocks := 0
+		facade := &chainSimulator.SimulatorFacadeStub{
+			GenerateBlocksCalled: func(numOfBlocks int) error {
+				generatedBlocks = numOfBlocks
+				return nil
+			},
+		}
+
+		response, code := requestSimulator(t, facade, http.MethodPost, "/simulator/generate-blocks/2", "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+		assert.Equal(t, 2, generatedBlocks)
+	})
+}
+
+func TestSimulatorGroup_generateBlocksUntilEpochReached(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, code := requestSimulator(t, &chainSimulator.SimulatorFacadeStub{}, http.MethodPost, "/simulator/generate-blocks-until-epoch-reached/4294967295", "")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidEpoch.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		targetEpoch := int32(0)
+		facade := &chainSimulator.SimulatorFacadeStub{
+			GenerateBlocksUntilEpochIsReachedCalled: func(epoch int32) error {
+				targetEpoch = epoch
+				return nil
+			},
+		}
+
+		_, code := requestSimulator(t, facade, http.MethodPost, "/simulator/generate-blocks-until-epoch-reached/5", "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int32(5), targetEpoch)
+	})
+}
+
+func TestSimulatorGroup_forceEpochChange(t *testing.T) {
+	t.Parallel()
+
+	facade := &chainSimulator.SimulatorFacadeStub{
+		ForceChangeOfEpochCalled: func() error {
+			return expectedErr
+		},
+	}
+
+	response, code := requestSimulator(t, facade, http.MethodPost, "/simulator/force-epoch-change", "")
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, formatExpectedErr(groups.ErrForceEpochChange, expectedErr), response.Error)
+}
+
+func TestSimulatorGroup_setState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, code := requestSimulator(t, &chainSimulator.SimulatorFacadeStub{}, http.MethodPost, "/simulator/set-state", "invalid")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedState []*dtos.AddressState
+		facade := &chainSimulator.SimulatorFacadeStub{
+			SetStateMultipleCalled: func(stateSlice []*dtos.AddressState) error {
+				providedState = stateSlice
+				return nil
+			},
+		}
+
+		_, code := requestSimulator(t, facade, http.MethodPost, "/simulator/set-state", `[{"address": "erd1a", "balance": "10"}]`)
+		assert.Equal(t, http.StatusOK, code)
+		require.Equal(t, 1, len(providedState))
+		assert.Equal(t, "erd1a", providedState[0].Address)
+		assert.Equal(t, "10", providedState[0].Balance)
+	})
+}
+
+func TestSimulatorGroup_setKeyValues(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, code := requestSimulator(t, &chainSimulator.SimulatorFacadeStub{}, http.MethodPost, "/simulator/set-key-values", `{"keyValues": {"01": "02"}}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidationEmptyAddress.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &chainSimulator.SimulatorFacadeStub{
+			SetKeyValueForAddressCalled: func(address string, keyValueMap map[string]string) error {
+				assert.Equal(t, "erd1a", address)
+				assert.Equal(t, map[string]string{"01": "02"}, keyValueMap)
+				return nil
+			},
+		}
+
+		_, code := requestSimulator(t, facade, http.MethodPost, "/simulator/set-key-values", `{"address": "erd1a", "keyValues": {"01": "02"}}`)
+		assert.Equal(t, http.StatusOK, code)
+	})
+}
+
+func TestSimulatorGroup_addValidatorKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hex key should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, code := requestSimulator(t, &chainSimulator.SimulatorFacadeStub{}, http.MethodPost, "/simulator/add-keys", `{"privateKeys": ["not hex"]}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedKeys [][]byte
+		facade := &chainSimulator.SimulatorFacadeStub{
+			AddValidatorKeysCalled: func(validatorsPrivateKeys [][]byte) error {
+				providedKeys = validatorsPrivateKeys
+				return nil
+			},
+		}
+
+		_, code := requestSimulator(t, facade, http.MethodPost, "/simulator/add-keys", `{"privateKeys": ["0102", "0304"]}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, [][]byte{{1, 2}, {3, 4}}, providedKeys)
+	})
+}
+
+func TestSimulatorGroup_generateAndMintWallet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid value should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &generateWalletResponse{}
+		code := requestSimulatorInto(t, &chainSimulator.SimulatorFacadeStub{}, http.MethodPost, "/simulator/generate-and-mint-wallet", `{"shardID": 1, "value": "-5"}`, response)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, groups.ErrInvalidValue.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &chainSimulator.SimulatorFacadeStub{
+			GenerateAndMintWalletAddressCalled: func(targetShardID uint32, value *big.Int) (dtos.WalletAddress, error) {
+				assert.Equal(t, uint32(1), targetShardID)
+				assert.Equal(t, big.NewInt(1000), value)
+
+				return dtos.WalletAddress{Bech32: "erd1a", Bytes: []byte("a")}, nil
+			},
+		}
+
+		response := &generateWalletResponse{}
+		code := requestSimulatorInto(t, facade, http.MethodPost, "/simulator/generate-and-mint-wallet", `{"shardID": 1, "value": "1000"}`, response)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, dtos.WalletAddress{Bech32: "erd1a", Bytes: []byte("a")}, response.Data.Address)
+	})
+}
+
+func TestSimulatorGroup_getObservers(t *testing.T) {
+	t.Parallel()
+
+	facade := &chainSimulator.SimulatorFacadeStub{
+		GetRestAPIInterfacesCalled: func() map[uint32]string {
+			return map[uint32]string{0: "localhost:1", 4294967295: "localhost:2"}
+		},
+	}
+
+	response := &observersResponse{}
+	code := requestSimulatorInto(t, facade, http.MethodGet, "/simulator/observers", "", response)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[uint32]string{0: "localhost:1", 4294967295: "localhost:2"}, response.Data.Observers)
+}
+
+func TestSimulatorGroup_autoBlockGeneration(t *testing.T) {
+	t.Parallel()
+
+	var providedArgs dtos.AutoBlockGeneration
+	stopCalled := false
+	facade := &chainSimulator.SimulatorFacadeStub{
+		StartAutoBlockGenerationCalled: func(args dtos.AutoBlockGeneration) error {
+			providedArgs = args
+			return nil
+		},
+		StopAutoBlockGenerationCalled: func() {
+			stopCalled = true
+		},
+	}
+
+	_, code := requestSimulator(t, facade, http.MethodPost, "/simulator/auto-generate-blocks/start", `{"intervalInMillis": 500, "onTransactionReceived": true}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, dtos.AutoBlockGeneration{IntervalInMillis: 500, OnTransactionReceived: true}, providedArgs)
+
+	_, code = requestSimulator(t, facade, http.MethodPost, "/simulator/auto-generate-blocks/stop", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, stopCalled)
+
+	facade.StartAutoBlockGenerationCalled = func(args dtos.AutoBlockGeneration) error {
+		return expectedErr
+	}
+	response, code := requestSimulator(t, facade, http.MethodPost, "/simulator/auto-generate-blocks/start", `{}`)
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, formatExpectedErr(groups.ErrAutoBlockGeneration, expectedErr), response.Error)
+}
+
+func TestSimulatorGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	sg, _ := groups.NewSimulatorGroup(nil)
+	assert.True(t, sg.IsInterfaceNil())
+
+	sg, _ = groups.NewSimulatorGroup(&chainSimulator.SimulatorFacadeStub{})
+	assert.False(t, sg.IsInterfaceNil())
+}
+
+func requestSimulator(t *testing.T, facade *chainSimulator.SimulatorFacadeStub, method string, url string, body string) (*shared.GenericAPIResponse, int) {
+	response := &shared.GenericAPIResponse{}
+	code := requestSimulatorInto(t, facade, method, url, body, response)
+
+	return response, code
+}
+
+func requestSimulatorInto(t *testing.T, facade *chainSimulator.SimulatorFacadeStub, method string, url string, body string, response interface{}) int {
+	sg, err := groups.NewSimulatorGroup(facade)
+	require.NoError(t, err)
+
+	ws := gin.New()
+	sg.RegisterRoutes(ws.Group("/simulator"))
+
+	req, _ := http.NewRequest(method, url, bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	err = json.NewDecoder(resp.Body).Decode(response)
+	require.NoError(t, err)
+
+	return resp.Code
+}
+
+func formatExpectedErr(err, innerErr error) string {
+	return fmt.Sprintf("%s: %s", err.Error(), innerErr.Error())
+}
@@ -0,0 +1,106 @@
+package chainSimulator
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+)
+
+type impersonatedAccountsHandler interface {
+	Add(address []byte)
+	Remove(address []byte)
+	IsImpersonated(address []byte) bool
+	IsInterfaceNil() bool
+}
+
+const (
+	guardedTxVersion                    = 2
+	impersonatedGuardianSignatureLength = 64
+)
+
+// Impersonate will make the network accept the transactions of the provided bech32 address without checking their
+// signatures. For guarded accounts the guardian's signature is not checked either, and the transactions sent through
+// the chain simulator are co-signed on behalf of the active guardian when needed. The transactions sent directly to a
+// node, as through its /transaction/send route, are not co-signed: they have to carry the guardian address and the
+// guarded option, otherwise they are never executed. An impersonated relayer of relayed v3 transactions does not need
+// to sign them
+func (s *simulator) Impersonate(address string) error {
+	addressBytes, err := s.decodeAddress(address)
+	if err != nil {
+		return err
+	}
+
+	s.impersonatedAccounts.Add(addressBytes)
+	log.Debug("chain simulator impersonates", "address", address)
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:  dtos.ImpersonateAction,
+		Address: address,
+	})
+
+	return nil
+}
+
+// StopImpersonating will restore the signatures check for the transactions of the provided bech32 address
+func (s *simulator) StopImpersonating(address string) error {
+	addressBytes, err := s.decodeAddress(address)
+	if err != nil {
+		return err
+	}
+
+	s.impersonatedAccounts.Remove(addressBytes)
+	log.Debug("chain simulator stopped impersonating", "address", address)
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:  dtos.StopImpersonatingAction,
+		Address: address,
+	})
+
+	return nil
+}
+
+func (s *simulator) decodeAddress(address string) ([]byte, error) {
+	return s.GetNodeHandler(0).GetCoreComponents().AddressPubKeyConverter().Decode(address)
+}
+
+// guardImpersonatedTransaction returns a copy of the not guarded transaction of an impersonated guarded sender, on
+// which the active guardian is set, so it is executed as a co-signed transaction. The provided transaction is not altered
+func (s *simulator) guardImpersonatedTransaction(tx *transaction.Transaction) (*transaction.Transaction, error) {
+	if !s.impersonatedAccounts.IsImpersonated(tx.SndAddr) {
+		return tx, nil
+	}
+
+	node := s.GetNodeHandler(s.GetNodeHandler(0).GetShardCoordinator().ComputeId(tx.SndAddr))
+	if node.GetCoreComponents().TxVersionChecker().IsGuardedTransaction(tx) {
+		return tx, nil
+	}
+
+	addressConverter := node.GetCoreComponents().AddressPubKeyConverter()
+	sender, err := addressConverter.Encode(tx.SndAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	guardianData, _, err := node.GetFacadeHandler().GetGuardianData(sender, api.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !guardianData.Guarded || guardianData.ActiveGuardian == nil {
+		return tx, nil
+	}
+
+	guardianAddress, err := addressConverter.Decode(guardianData.ActiveGuardian.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	guardedTx := *tx
+	guardedTx.GuardianAddr = guardianAddress
+	guardedTx.GuardianSignature = make([]byte, impersonatedGuardianSignatureLength)
+	guardedTx.Options |= transaction.MaskGuardedTransaction
+	if guardedTx.Version < guardedTxVersion {
+		guardedTx.Version = guardedTxVersion
+	}
+
+	return &guardedTx, nil
+}
@@ -0,0 +1,169 @@
+package chainSimulator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	coreAPI "github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	chainSimulatorCommon "github.com/multiversx/mx-chain-go/integrationTests/chainSimulator"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/configs"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulator_Impersonate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	roundsPerEpoch := uint64(20)
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: false,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       time.Now().Unix(),
+		RoundDurationInMillis:  uint64(6000),
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    roundsPerEpoch,
+		},
+		ApiInterface:      api.NewNoApiInterface(),
+		MinNodesPerShard:  1,
+		MetaChainMinNodes: 1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	initialBalance := big.NewInt(0).Mul(chainSimulatorCommon.OneEGLD, big.NewInt(10))
+	impersonated, err := chainSimulator.GenerateAndMintWalletAddress(0, initialBalance)
+	require.Nil(t, err)
+	regular, err := chainSimulator.GenerateAndMintWalletAddress(0, initialBalance)
+	require.Nil(t, err)
+	guardian, err := chainSimulator.GenerateAndMintWalletAddress(0, initialBalance)
+	require.Nil(t, err)
+	receiver, err := chainSimulator.GenerateAndMintWalletAddress(1, big.NewInt(0))
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	impersonatedNonce := uint64(0)
+	createTx := func(sender dtos.WalletAddress, nonce uint64, receiver dtos.WalletAddress, data string, gasLimit uint64) *transaction.Transaction {
+		return &transaction.Transaction{
+			Nonce:     nonce,
+			Value:     big.NewInt(0),
+			SndAddr:   sender.Bytes,
+			RcvAddr:   receiver.Bytes,
+			Data:      []byte(data),
+			GasLimit:  gasLimit,
+			GasPrice:  1_000_000_000,
+			ChainID:   []byte(configs.ChainID),
+			Version:   1,
+			Signature: []byte("invalid signature"),
+		}
+	}
+	sendImpersonatedTx := func(receiver dtos.WalletAddress, data string, gasLimit uint64) *transaction.ApiTransactionResult {
+		tx := createTx(impersonated, impersonatedNonce, receiver, data, gasLimit)
+		result, errSend := chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 10)
+		require.Nil(t, errSend)
+		require.Equal(t, transaction.TxStatusSuccess, result.Status)
+		impersonatedNonce++
+
+		return result
+	}
+
+	_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(createTx(regular, 0, receiver, "", 50_000), 10)
+	require.NotNil(t, err)
+	_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(createTx(impersonated, 0, receiver, "", 50_000), 10)
+	require.NotNil(t, err)
+
+	err = chainSimulator.Impersonate("invalid address")
+	require.NotNil(t, err)
+	err = chainSimulator.Impersonate(impersonated.Bech32)
+	require.Nil(t, err)
+
+	sendImpersonatedTx(receiver, "", 50_000)
+	_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(createTx(regular, 0, receiver, "", 50_000), 10)
+	require.NotNil(t, err)
+
+	warpOneEpoch := func() {
+		currentRound := chainSimulator.GetNodeHandler(core.MetachainShardId).GetCoreComponents().RoundHandler().Index()
+		errWarp := chainSimulator.WarpToRound(currentRound + int64(roundsPerEpoch) + 1)
+		require.Nil(t, errWarp)
+		errWarp = chainSimulator.GenerateBlocks(3)
+		require.Nil(t, errWarp)
+	}
+
+	// the guardians are enabled starting with epoch 1 and become active after 2 epochs
+	warpOneEpoch()
+	setGuardianData := "SetGuardian@" + hex.EncodeToString(guardian.Bytes) + "@" + hex.EncodeToString([]byte("uuid"))
+	sendImpersonatedTx(impersonated, setGuardianData, 1_000_000)
+	for epoch := 0; epoch < 3; epoch++ {
+		warpOneEpoch()
+	}
+	sendImpersonatedTx(impersonated, "GuardAccount", 1_000_000)
+
+	guardianData, _, err := chainSimulator.GetNodeHandler(0).GetFacadeHandler().GetGuardianData(impersonated.Bech32, coreAPI.AccountQueryOptions{})
+	require.Nil(t, err)
+	require.True(t, guardianData.Guarded)
+
+	tx := createTx(impersonated, impersonatedNonce, receiver, "", 100_000)
+	result, err := chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 10)
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, result.Status)
+	require.Equal(t, guardian.Bech32, result.GuardianAddr)
+	impersonatedNonce++
+
+	// the provided transaction should have not been altered
+	require.Nil(t, tx.GuardianAddr)
+	require.Nil(t, tx.GuardianSignature)
+	require.Equal(t, uint32(0), tx.Options)
+	require.Equal(t, uint32(1), tx.Version)
+
+	// the transactions sent directly to a node, as through its /transaction/send route, are not co-signed
+	node := chainSimulator.GetNodeHandler(0)
+	sendTxThroughNode := func(tx *transaction.Transaction) *transaction.ApiTransactionResult {
+		txHash, errHash := core.CalculateHash(node.GetCoreComponents().InternalMarshalizer(), node.GetCoreComponents().Hasher(), tx)
+		require.Nil(t, errHash)
+		errSend := node.GetFacadeHandler().ValidateTransaction(tx)
+		require.Nil(t, errSend)
+		_, errSend = node.GetFacadeHandler().SendBulkTransactions([]*transaction.Transaction{tx})
+		require.Nil(t, errSend)
+		errSend = chainSimulator.GenerateBlocks(5)
+		require.Nil(t, errSend)
+
+		result, errGet := node.GetFacadeHandler().GetTransaction(hex.EncodeToString(txHash), true)
+		require.Nil(t, errGet)
+
+		return result
+	}
+	// the not guarded transaction of the guarded account is never executed, so its nonce is reused below
+	result = sendTxThroughNode(createTx(impersonated, impersonatedNonce, receiver, "", 100_000))
+	require.Equal(t, transaction.TxStatusPending, result.Status)
+
+	guardedTx := createTx(impersonated, impersonatedNonce, receiver, "", 100_000)
+	guardedTx.GuardianAddr = guardian.Bytes
+	guardedTx.GuardianSignature = []byte("invalid guardian signature")
+	guardedTx.Options = transaction.MaskGuardedTransaction
+	guardedTx.Version = guardedTxVersion
+	result = sendTxThroughNode(guardedTx)
+	require.Equal(t, transaction.TxStatusSuccess, result.Status)
+	impersonatedNonce++
+
+	account, err := chainSimulator.GetAccount(receiver)
+	require.Nil(t, err)
+	require.Equal(t, "0", account.Balance)
+
+	err = chainSimulator.StopImpersonating(impersonated.Bech32)
+	require.Nil(t, err)
+	_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(createTx(impersonated, impersonatedNonce, receiver, "", 100_000), 10)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,396 @@
+package chainSimulator
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+)
+
+// ArgsReplayScenario holds the arguments needed to replay a recorded scenario
+type ArgsReplayScenario struct {
+	Scenario            *dtos.Scenario
+	TempDir             string
+	PathToInitialConfig string
+	ApiInterface        components.APIConfigurator
+	// AlterConfigsFunction should apply the same changes as the one used when the scenario was recorded
+	AlterConfigsFunction func(cfg *config.Configs)
+}
+
+// LoadScenario will read a scenario file written by SaveRecordedScenario
+func LoadScenario(filePath string) (*dtos.Scenario, error) {
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	scenario := &dtos.Scenario{}
+	err = json.Unmarshal(buff, scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkScenario(scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	return scenario, nil
+}
+
+func checkScenario(scenario *dtos.Scenario) error {
+	if scenario == nil {
+		return errNilScenario
+	}
+	if scenario.Version != dtos.ScenarioVersion {
+		return fmt.Errorf("%w: provided %d, supported %d", errUnsupportedScenarioVersion, scenario.Version, dtos.ScenarioVersion)
+	}
+	if scenario.Config == nil {
+		return errNilScenarioConfig
+	}
+
+	return nil
+}
+
+// ReplayScenario will create a new chain simulator using the recorded arguments and key material, will execute all the
+// recorded steps and will check the accounts root hashes of all the shards after each of them. The returned simulator
+// can be further used, as it is left in the state reached by the last step
+func ReplayScenario(args ArgsReplayScenario) (*simulator, error) {
+	err := checkScenario(args.Scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	argsSimulator, err := createArgsFromScenarioConfig(args)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := NewBaseChainSimulator(argsSimulator)
+	if err != nil {
+		return nil, err
+	}
+
+	err = instance.replayScenarioSteps(args.Scenario.Steps)
+	if err != nil {
+		instance.Close()
+		return nil, err
+	}
+
+	return instance, nil
+}
+
+func createArgsFromScenarioConfig(args ArgsReplayScenario) (ArgsBaseChainSimulator, error) {
+	scenarioConfig := args.Scenario.Config
+	validatorsPrivateKeys, err := decodeHexSlice(scenarioConfig.ValidatorsPrivateKeys)
+	if err != nil {
+		return ArgsBaseChainSimulator{}, err
+	}
+
+	roundsPerEpoch := core.OptionalUint64{}
+	if scenarioConfig.RoundsPerEpoch > 0 {
+		roundsPerEpoch = core.OptionalUint64{
+			HasValue: true,
+			Value:    scenarioConfig.RoundsPerEpoch,
+		}
+	}
+
+	return ArgsBaseChainSimulator{
+		ArgsChainSimulator: ArgsChainSimulator{
+			BypassTxSignatureCheck:     scenarioConfig.BypassTxSignatureCheck,
+			TempDir:                    args.TempDir,
+			PathToInitialConfig:        args.PathToInitialConfig,
+			NumOfShards:                scenarioConfig.NumOfShards,
+			MinNodesPerShard:           scenarioConfig.MinNodesPerShard,
+			MetaChainMinNodes:          scenarioConfig.MetaChainMinNodes,
+			Hysteresis:                 scenarioConfig.Hysteresis,
+			NumNodesWaitingListShard:   scenarioConfig.NumNodesWaitingListShard,
+			NumNodesWaitingListMeta:    scenarioConfig.NumNodesWaitingListMeta,
+			GenesisTimestamp:           scenarioConfig.GenesisTimestamp,
+			InitialRound:               scenarioConfig.InitialRound,
+			InitialEpoch:               scenarioConfig.InitialEpoch,
+			InitialNonce:               scenarioConfig.InitialNonce,
+			RoundDurationInMillis:      scenarioConfig.RoundDurationInMillis,
+			RoundsPerEpoch:             roundsPerEpoch,
+			ApiInterface:               args.ApiInterface,
+			AlterConfigsFunction:       args.AlterConfigsFunction,
+			VmQueryDelayAfterStartInMs: scenarioConfig.VmQueryDelayAfterStartInMs,
+			ValidatorsPrivateKeys:      validatorsPrivateKeys,
+			InitialWalletKeys:          scenarioConfig.InitialWallets,
+			EnableSnapshots:            scenarioConfig.EnableSnapshots,
+		},
+		ConsensusGroupSize:          scenarioConfig.ConsensusGroupSize,
+		MetaChainConsensusGroupSize: scenarioConfig.MetaChainConsensusGroupSize,
+	}, nil
+}
+
+func (s *simulator) replayScenarioSteps(steps []*dtos.ScenarioStep) error {
+	for idx, step := range steps {
+		if step == nil {
+			return fmt.Errorf("%w at step %d", errNilScenarioStep, idx)
+		}
+
+		err := s.replayScenarioStep(step)
+		if err != nil {
+			return fmt.Errorf("%w while replaying step %d (%s)", err, idx, step.Action)
+		}
+
+		err = s.checkScenarioRootHashes(step.RootHashes)
+		if err != nil {
+			return fmt.Errorf("%w after step %d (%s)", err, idx, step.Action)
+		}
+
+		log.Debug("chain simulator replayed scenario step", "index", idx, "action", step.Action)
+	}
+
+	return nil
+}
+
+func (s *simulator) replayScenarioStep(step *dtos.ScenarioStep) error {
+	switch step.Action {
+	case dtos.SetStateAction:
+		return s.SetStateMultiple(step.State)
+	case dtos.SetKeyValuesAction:
+		return s.SetKeyValueForAddress(step.Address, step.KeyValues)
+	case dtos.RemoveAccountsAction:
+		return s.RemoveAccounts(step.Addresses)
+	case dtos.SendTransactionsAction:
+		return s.sendTxsAndGenerateBlocks(step.Transactions, step.NumOfBlocks)
+	case dtos.GenerateBlocksAction:
+		return s.GenerateBlocks(step.NumOfBlocks)
+	case dtos.GenerateBlocksUntilEpochAction:
+		return s.GenerateBlocksUntilEpochIsReached(step.TargetEpoch)
+	case dtos.ForceChangeOfEpochAction:
+		return s.ForceChangeOfEpoch()
+	case dtos.WarpToRoundAction:
+		return s.WarpToRound(step.Round)
+	case dtos.SetNextBlockTimestampAction:
+		return s.SetNextBlockTimestamp(step.Timestamp)
+	case dtos.SnapshotAction:
+		return s.replaySnapshot(step.SnapshotID)
+	case dtos.RevertToSnapshotAction:
+		return s.RevertTo(step.SnapshotID)
+	case dtos.ImpersonateAction:
+		return s.Impersonate(step.Address)
+	case dtos.StopImpersonatingAction:
+		return s.StopImpersonating(step.Address)
+	case dtos.AddValidatorKeysAction:
+		validatorsPrivateKeys, err := decodeHexSlice(step.ValidatorsPrivateKeys)
+		if err != nil {
+			return err
+		}
+
+		return s.AddValidatorKeys(validatorsPrivateKeys)
+	case dtos.CreateESDTTokenAction:
+		return s.CreateESDTToken(step.ESDTToken)
+	case dtos.SetESDTBalanceAction:
+		return s.SetESDTBalance(step.ESDTBalance)
+	case dtos.SetESDTRolesAction:
+		return s.SetESDTRoles(step.ESDTRoles)
+	default:
+		return fmt.Errorf("%w: %s", errUnknownScenarioAction, step.Action)
+	}
+}
+
+func (s *simulator) replaySnapshot(recordedID string) error {
+	id, err := s.Snapshot()
+	if err != nil {
+		return err
+	}
+	if id != recordedID {
+		return fmt.Errorf("%w: recorded %s, replayed %s", errScenarioSnapshotMismatch, recordedID, id)
+	}
+
+	return nil
+}
+
+// sendTxsAndGenerateBlocks sends the provided transactions and generates exactly the provided number of blocks. The
+// blocks are recorded as separate steps, so the number of blocks is set only by the older scenario files
+func (s *simulator) sendTxsAndGenerateBlocks(txsToSend []*transaction.Transaction, numOfBlocks int) error {
+	for idx, tx := range txsToSend {
+		if tx == nil {
+			return fmt.Errorf("%w on position %d", errNilScenarioTransaction, idx)
+		}
+
+		_, err := s.sendTx(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	time.Sleep(delaySendTxs)
+
+	return s.generateBlocksWithLock(numOfBlocks)
+}
+
+func (s *simulator) checkScenarioRootHashes(expectedRootHashes map[uint32]string) error {
+	rootHashes, err := s.getAccountsRootHashes()
+	if err != nil {
+		return err
+	}
+
+	for _, shardID := range sortedShardIDs(expectedRootHashes) {
+		if rootHashes[shardID] != expectedRootHashes[shardID] {
+			return fmt.Errorf("%w for shard %d: expected %s, got %s",
+				errScenarioRootHashMismatch, shardID, expectedRootHashes[shardID], rootHashes[shardID])
+		}
+	}
+
+	return nil
+}
+
+// GetRecordedScenario returns the scenario recorded so far. The recording is enabled by the RecordScenario argument
+func (s *simulator) GetRecordedScenario() (*dtos.Scenario, error) {
+	s.mutScenario.RLock()
+	defer s.mutScenario.RUnlock()
+
+	if s.scenario == nil {
+		return nil, errScenarioRecordingNotEnabled
+	}
+
+	return &dtos.Scenario{
+		Version: s.scenario.Version,
+		Config:  s.scenario.Config,
+		Steps:   append(make([]*dtos.ScenarioStep, 0, len(s.scenario.Steps)), s.scenario.Steps...),
+	}, nil
+}
+
+// SaveRecordedScenario will write the scenario recorded so far in the provided file
+func (s *simulator) SaveRecordedScenario(filePath string) error {
+	scenario, err := s.GetRecordedScenario()
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(scenario, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, buff, core.FileModeUserReadWrite)
+}
+
+func (s *simulator) startScenarioRecording(args ArgsBaseChainSimulator) error {
+	validatorsPrivateKeys := make([]string, 0, len(s.validatorsPrivateKeys))
+	for _, privateKey := range s.validatorsPrivateKeys {
+		privateKeyBytes, err := privateKey.ToByteArray()
+		if err != nil {
+			return err
+		}
+
+		validatorsPrivateKeys = append(validatorsPrivateKeys, hex.EncodeToString(privateKeyBytes))
+	}
+
+	roundsPerEpoch := uint64(0)
+	if args.RoundsPerEpoch.HasValue {
+		roundsPerEpoch = args.RoundsPerEpoch.Value
+	}
+
+	s.mutScenario.Lock()
+	s.scenario = &dtos.Scenario{
+		Version: dtos.ScenarioVersion,
+		Config: &dtos.ScenarioConfig{
+			BypassTxSignatureCheck:      args.BypassTxSignatureCheck,
+			NumOfShards:                 args.NumOfShards,
+			MinNodesPerShard:            args.MinNodesPerShard,
+			ConsensusGroupSize:          args.ConsensusGroupSize,
+			MetaChainMinNodes:           args.MetaChainMinNodes,
+			MetaChainConsensusGroupSize: args.MetaChainConsensusGroupSize,
+			Hysteresis:                  args.Hysteresis,
+			NumNodesWaitingListShard:    args.NumNodesWaitingListShard,
+			NumNodesWaitingListMeta:     args.NumNodesWaitingListMeta,
+			GenesisTimestamp:            args.GenesisTimestamp,
+			InitialRound:                args.InitialRound,
+			InitialEpoch:                args.InitialEpoch,
+			InitialNonce:                args.InitialNonce,
+			RoundDurationInMillis:       args.RoundDurationInMillis,
+			RoundsPerEpoch:              roundsPerEpoch,
+			VmQueryDelayAfterStartInMs:  args.VmQueryDelayAfterStartInMs,
+			EnableSnapshots:             args.EnableSnapshots,
+			ValidatorsPrivateKeys:       validatorsPrivateKeys,
+			InitialWallets:              s.initialWalletKeys,
+		},
+		Steps: make([]*dtos.ScenarioStep, 0),
+	}
+	s.mutScenario.Unlock()
+
+	log.Info("chain simulator records the scenario")
+
+	return nil
+}
+
+// recordScenarioStep should be called after the step was successfully executed
+func (s *simulator) recordScenarioStep(step *dtos.ScenarioStep) {
+	s.mutScenario.Lock()
+	defer s.mutScenario.Unlock()
+
+	if s.scenario == nil {
+		return
+	}
+
+	rootHashes, err := s.getAccountsRootHashes()
+	if err != nil {
+		log.Warn("chain simulator could not record the scenario step", "action", step.Action, "error", err)
+		return
+	}
+
+	step.RootHashes = rootHashes
+	s.scenario.Steps = append(s.scenario.Steps, step)
+}
+
+func (s *simulator) getAccountsRootHashes() (map[uint32]string, error) {
+	rootHashes := make(map[uint32]string, len(s.nodes))
+	for shardID, node := range s.nodes {
+		rootHash, err := node.GetStateComponents().AccountsAdapter().RootHash()
+		if err != nil {
+			return nil, fmt.Errorf("%w for shard %d", err, shardID)
+		}
+
+		rootHashes[shardID] = hex.EncodeToString(rootHash)
+	}
+
+	return rootHashes, nil
+}
+
+func sortedShardIDs(rootHashes map[uint32]string) []uint32 {
+	shardIDs := make([]uint32, 0, len(rootHashes))
+	for shardID := range rootHashes {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	return shardIDs
+}
+
+func decodeHexSlice(values []string) ([][]byte, error) {
+	decoded := make([][]byte, 0, len(values))
+	for _, value := range values {
+		buff, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, err
+		}
+
+		decoded = append(decoded, buff)
+	}
+
+	return decoded, nil
+}
+
+func encodeHexSlice(values [][]byte) []string {
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded = append(encoded, hex.EncodeToString(value))
+	}
+
+	return encoded
+}
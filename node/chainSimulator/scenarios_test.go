@@ -0,0 +1,225 @@
+package chainSimulator
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/configs"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/stretchr/testify/require"
+)
+
+const scenarioGenesisTimestamp = int64(1700000000)
+
+func TestLoadScenario(t *testing.T) {
+	t.Parallel()
+
+	writeScenario := func(content string) string {
+		filePath := filepath.Join(t.TempDir(), "scenario.json")
+		err := os.WriteFile(filePath, []byte(content), os.ModePerm)
+		require.Nil(t, err)
+
+		return filePath
+	}
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		scenario, err := LoadScenario(filepath.Join(t.TempDir(), "missing.json"))
+		require.NotNil(t, err)
+		require.Nil(t, scenario)
+	})
+	t.Run("invalid content should error", func(t *testing.T) {
+		t.Parallel()
+
+		scenario, err := LoadScenario(writeScenario("not a json"))
+		require.NotNil(t, err)
+		require.Nil(t, scenario)
+	})
+	t.Run("unsupported version should error", func(t *testing.T) {
+		t.Parallel()
+
+		scenario, err := LoadScenario(writeScenario(`{"version": 100, "config": {}}`))
+		require.ErrorIs(t, err, errUnsupportedScenarioVersion)
+		require.Nil(t, scenario)
+	})
+	t.Run("missing config should error", func(t *testing.T) {
+		t.Parallel()
+
+		scenario, err := LoadScenario(writeScenario(`{"version": 1}`))
+		require.Equal(t, errNilScenarioConfig, err)
+		require.Nil(t, scenario)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		scenario, err := LoadScenario(writeScenario(`{"version": 1, "config": {"numOfShards": 3}, "steps": [{"action": "generateBlocks", "numOfBlocks": 2}]}`))
+		require.Nil(t, err)
+		require.Equal(t, uint32(3), scenario.Config.NumOfShards)
+		require.Equal(t, 1, len(scenario.Steps))
+		require.Equal(t, dtos.GenerateBlocksAction, scenario.Steps[0].Action)
+		require.Equal(t, 2, scenario.Steps[0].NumOfBlocks)
+	})
+}
+
+func TestSimulator_ScenarioRecordingErrors(t *testing.T) {
+	t.Parallel()
+
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		RecordScenario: true,
+		Fork:           &ForkArgs{},
+	})
+	require.Equal(t, errScenarioRecordingWithFork, err)
+	require.Nil(t, chainSimulator)
+
+	replayed, err := ReplayScenario(ArgsReplayScenario{})
+	require.Equal(t, errNilScenario, err)
+	require.Nil(t, replayed)
+
+	err = (&simulator{}).replayScenarioStep(&dtos.ScenarioStep{Action: "unknown"})
+	require.ErrorIs(t, err, errUnknownScenarioAction)
+
+	err = (&simulator{}).replayScenarioSteps([]*dtos.ScenarioStep{nil})
+	require.ErrorIs(t, err, errNilScenarioStep)
+}
+
+func TestSimulator_RecordAndReplayScenario(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numOfShards := uint32(3)
+	validatorsPrivateKeys, _, err := GenerateBlsPrivateKeys(int(numOfShards + 1))
+	require.Nil(t, err)
+
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            numOfShards,
+		GenesisTimestamp:       scenarioGenesisTimestamp,
+		RoundDurationInMillis:  uint64(6000),
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    20,
+		},
+		ApiInterface:          api.NewNoApiInterface(),
+		MinNodesPerShard:      1,
+		MetaChainMinNodes:     1,
+		ValidatorsPrivateKeys: validatorsPrivateKeys,
+		RecordScenario:        true,
+		EnableSnapshots:       true,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	sender, err := chainSimulator.GenerateAndMintWalletAddress(0, big.NewInt(1_000_000_000_000_000_000))
+	require.Nil(t, err)
+	receiver := chainSimulator.GenerateAddressInShard(1)
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	snapshotID, err := chainSimulator.Snapshot()
+	require.Nil(t, err)
+
+	tx := &transaction.Transaction{
+		Nonce:     0,
+		Value:     big.NewInt(1_000_000),
+		SndAddr:   sender.Bytes,
+		RcvAddr:   receiver.Bytes,
+		GasLimit:  50_000,
+		GasPrice:  1_000_000_000,
+		ChainID:   []byte(configs.ChainID),
+		Version:   1,
+		Signature: []byte("signature"),
+	}
+	result, err := chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 10)
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, result.Status)
+
+	err = chainSimulator.SetKeyValueForAddress(sender.Bech32, map[string]string{"01": "02"})
+	require.Nil(t, err)
+	err = chainSimulator.ForceChangeOfEpoch()
+	require.Nil(t, err)
+	err = chainSimulator.GenerateBlocks(2)
+	require.Nil(t, err)
+
+	expectedRootHashes, err := chainSimulator.getAccountsRootHashes()
+	require.Nil(t, err)
+
+	scenarioFile := filepath.Join(t.TempDir(), "scenario.json")
+	err = chainSimulator.SaveRecordedScenario(scenarioFile)
+	require.Nil(t, err)
+	chainSimulator.Close()
+
+	scenario, err := LoadScenario(scenarioFile)
+	require.Nil(t, err)
+	require.Equal(t, dtos.SetStateAction, scenario.Steps[1].Action)
+	require.Equal(t, snapshotID, scenario.Steps[3].SnapshotID)
+	// the transactions are recorded before the blocks generated to execute them, one step for each block
+	require.Equal(t, dtos.SendTransactionsAction, scenario.Steps[4].Action)
+	require.Zero(t, scenario.Steps[4].NumOfBlocks)
+	numOfExecutionBlocks := len(scenario.Steps) - 8
+	require.Greater(t, numOfExecutionBlocks, 0)
+	for _, step := range scenario.Steps[5 : 5+numOfExecutionBlocks] {
+		require.Equal(t, dtos.GenerateBlocksAction, step.Action)
+		require.Equal(t, 1, step.NumOfBlocks)
+	}
+	require.Equal(t, dtos.SetKeyValuesAction, scenario.Steps[5+numOfExecutionBlocks].Action)
+	require.Equal(t, expectedRootHashes, scenario.Steps[len(scenario.Steps)-1].RootHashes)
+
+	t.Run("replay should reach the same state", func(t *testing.T) {
+		replayed, errReplay := ReplayScenario(ArgsReplayScenario{
+			Scenario:            scenario,
+			TempDir:             t.TempDir(),
+			PathToInitialConfig: defaultPathToInitialConfig,
+			ApiInterface:        api.NewNoApiInterface(),
+		})
+		require.Nil(t, errReplay)
+		defer replayed.Close()
+
+		rootHashes, errReplay := replayed.getAccountsRootHashes()
+		require.Nil(t, errReplay)
+		require.Equal(t, expectedRootHashes, rootHashes)
+
+		account, errReplay := replayed.GetAccount(receiver)
+		require.Nil(t, errReplay)
+		require.Equal(t, "1000000", account.Balance)
+	})
+	t.Run("replay should detect a different state", func(t *testing.T) {
+		scenario.Steps[4].RootHashes[0] = "00"
+
+		replayed, errReplay := ReplayScenario(ArgsReplayScenario{
+			Scenario:            scenario,
+			TempDir:             t.TempDir(),
+			PathToInitialConfig: defaultPathToInitialConfig,
+			ApiInterface:        api.NewNoApiInterface(),
+		})
+		require.ErrorIs(t, errReplay, errScenarioRootHashMismatch)
+		require.Contains(t, errReplay.Error(), "after step 4")
+		require.Nil(t, replayed)
+	})
+}
+
+func TestSimulator_GetRecordedScenarioNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	chainSimulator := &simulator{}
+	scenario, err := chainSimulator.GetRecordedScenario()
+	require.Equal(t, errScenarioRecordingNotEnabled, err)
+	require.Nil(t, scenario)
+
+	err = chainSimulator.SaveRecordedScenario(filepath.Join(t.TempDir(), "scenario.json"))
+	require.Equal(t, errScenarioRecordingNotEnabled, err)
+
+	// recording a step on a simulator not recording is a no-op
+	chainSimulator.recordScenarioStep(&dtos.ScenarioStep{Action: dtos.GenerateBlocksAction})
+}
@@ -0,0 +1,482 @@
+package chainSimulator
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+	nodeProcess "github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+	"github.com/multiversx/mx-chain-go/process/track"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+type trackedHeadersRestorer interface {
+	RestoreHeaders(crossNotarizedHeaders map[uint32][]*track.HeaderInfo, selfNotarizedHeaders map[uint32][]*track.HeaderInfo, trackedHeaders []*track.HeaderInfo)
+}
+
+type sizeHandler interface {
+	Size() int
+}
+
+type simulatorSnapshot struct {
+	index uint64
+	nodes map[uint32]*nodeSnapshot
+}
+
+type poolEntry struct {
+	key     []byte
+	value   interface{}
+	cacheID string
+}
+
+// nodeSnapshot holds everything that changes on a node when blocks are generated or the state is altered, inside an epoch
+type nodeSnapshot struct {
+	epoch                 uint32
+	roundIndex            int64
+	timeOffset            time.Duration
+	accountsRootHash      []byte
+	peerAccountsRootHash  []byte
+	currentHeader         data.HeaderHandler
+	currentHeaderHash     []byte
+	currentRootHash       []byte
+	finalBlockNonce       uint64
+	finalBlockHash        []byte
+	finalBlockRootHash    []byte
+	scheduledInfo         *nodeProcess.ScheduledInfo
+	processedMiniBlocks   []bootstrapStorage.MiniBlocksInMeta
+	crossNotarizedHeaders map[uint32][]*track.HeaderInfo
+	selfNotarizedHeaders  map[uint32][]*track.HeaderInfo
+	trackedHeaders        []*track.HeaderInfo
+	poolHeaders           []*track.HeaderInfo
+	proofs                []data.HeaderProofHandler
+	transactions          []*poolEntry
+	unsignedTransactions  []*poolEntry
+	rewardTransactions    []*poolEntry
+	miniBlocks            []*poolEntry
+}
+
+// Snapshot will capture the state of all the nodes (accounts and data tries, blockchain, pools, trackers and current round)
+// and returns the identifier to be used when reverting to it. The snapshots are enabled by the EnableSnapshots argument
+func (s *simulator) Snapshot() (string, error) {
+	if !s.snapshotsEnabled {
+		return "", errSnapshotsNotEnabled
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	snapshot := &simulatorSnapshot{
+		index: s.snapshotsCounter,
+		nodes: make(map[uint32]*nodeSnapshot, len(s.nodes)),
+	}
+	for shardID, node := range s.nodes {
+		nodeSnap, err := s.createNodeSnapshot(node)
+		if err != nil {
+			return "", fmt.Errorf("%w for shard %d", err, shardID)
+		}
+
+		snapshot.nodes[shardID] = nodeSnap
+	}
+
+	id := strconv.FormatUint(snapshot.index, 10)
+	s.snapshots[id] = snapshot
+	s.snapshotsCounter++
+
+	log.Debug("chain simulator snapshot created", "id", id)
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:     dtos.SnapshotAction,
+		SnapshotID: id,
+	})
+
+	return id, nil
+}
+
+// RevertTo will bring all the nodes back to the state captured by the provided snapshot. The snapshots created after
+// the provided one are removed. Reverting is possible only if the nodes did not change the epoch since the snapshot.
+// All the shards are validated before any of them is reverted, so a failed validation leaves the nodes untouched
+func (s *simulator) RevertTo(id string) error {
+	if !s.snapshotsEnabled {
+		return errSnapshotsNotEnabled
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	snapshot, found := s.snapshots[id]
+	if !found {
+		return fmt.Errorf("%w, id: %s", errSnapshotNotFound, id)
+	}
+
+	for shardID, node := range s.nodes {
+		err := validateNodeRevert(node, snapshot.nodes[shardID])
+		if err != nil {
+			return fmt.Errorf("%w for shard %d", err, shardID)
+		}
+	}
+
+	for shardID, node := range s.nodes {
+		err := s.revertNode(node, snapshot.nodes[shardID])
+		if err != nil {
+			return fmt.Errorf("%w for shard %d", err, shardID)
+		}
+	}
+
+	for snapshotID, snap := range s.snapshots {
+		if snap.index > snapshot.index {
+			delete(s.snapshots, snapshotID)
+		}
+	}
+
+	log.Debug("chain simulator reverted to snapshot", "id", id)
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:     dtos.RevertToSnapshotAction,
+		SnapshotID: id,
+	})
+
+	return nil
+}
+
+func (s *simulator) allShardIDs() []uint32 {
+	shardIDs := make([]uint32, 0, s.numOfShards+1)
+	for shardID := uint32(0); shardID < s.numOfShards; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+
+	return append(shardIDs, core.MetachainShardId)
+}
+
+func (s *simulator) createNodeSnapshot(node process.NodeHandler) (*nodeSnapshot, error) {
+	processComponents := node.GetProcessComponents()
+	stateComponents := node.GetStateComponents()
+	dataPool := node.GetDataComponents().Datapool()
+	chainHandler := node.GetChainHandler()
+	shardIDs := s.allShardIDs()
+
+	accountsRootHash, err := stateComponents.AccountsAdapter().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	var peerAccountsRootHash []byte
+	if node.GetShardCoordinator().SelfId() == core.MetachainShardId {
+		peerAccountsRootHash, err = stateComponents.PeerAccounts().RootHash()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	scheduledTxsExecutionHandler := processComponents.ScheduledTxsExecutionHandler()
+	finalBlockNonce, finalBlockHash, finalBlockRootHash := chainHandler.GetFinalBlockInfo()
+	blockTracker := processComponents.BlockTracker()
+	roundHandler, ok := node.GetCoreComponents().RoundHandler().(manualRoundHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w for the round handler", errWrongTypeAssertion)
+	}
+
+	snapshot := &nodeSnapshot{
+		epoch:                processComponents.EpochStartTrigger().Epoch(),
+		roundIndex:           roundHandler.Index(),
+		timeOffset:           roundHandler.TimeOffset(),
+		accountsRootHash:     accountsRootHash,
+		peerAccountsRootHash: peerAccountsRootHash,
+		currentHeader:        chainHandler.GetCurrentBlockHeader(),
+		currentHeaderHash:    chainHandler.GetCurrentBlockHeaderHash(),
+		currentRootHash:      chainHandler.GetCurrentBlockRootHash(),
+		finalBlockNonce:      finalBlockNonce,
+		finalBlockHash:       finalBlockHash,
+		finalBlockRootHash:   finalBlockRootHash,
+		scheduledInfo: &nodeProcess.ScheduledInfo{
+			RootHash:        scheduledTxsExecutionHandler.GetScheduledRootHash(),
+			IntermediateTxs: scheduledTxsExecutionHandler.GetScheduledIntermediateTxs(),
+			GasAndFees:      scheduledTxsExecutionHandler.GetScheduledGasAndFees(),
+			MiniBlocks:      scheduledTxsExecutionHandler.GetScheduledMiniBlocks(),
+		},
+		processedMiniBlocks:   processComponents.ProcessedMiniBlocksTracker().ConvertProcessedMiniBlocksMapToSlice(),
+		crossNotarizedHeaders: getNotarizedHeaders(blockTracker.GetCrossNotarizedHeader, shardIDs),
+		selfNotarizedHeaders:  getNotarizedHeaders(blockTracker.GetSelfNotarizedHeader, shardIDs),
+		trackedHeaders:        getTrackedHeaders(blockTracker, shardIDs),
+		poolHeaders:           getPoolHeaders(dataPool.Headers(), shardIDs),
+	}
+
+	snapshot.proofs = getProofs(dataPool.Proofs(), snapshot)
+
+	selfShardID := node.GetShardCoordinator().SelfId()
+	cacheIDs := make([]string, 0, 2*len(shardIDs))
+	for _, shardID := range shardIDs {
+		cacheIDs = append(cacheIDs, nodeProcess.ShardCacherIdentifier(selfShardID, shardID))
+		if shardID != selfShardID {
+			cacheIDs = append(cacheIDs, nodeProcess.ShardCacherIdentifier(shardID, selfShardID))
+		}
+	}
+
+	snapshot.transactions = getShardedPoolEntries(dataPool.Transactions(), cacheIDs)
+	snapshot.unsignedTransactions = getShardedPoolEntries(dataPool.UnsignedTransactions(), cacheIDs)
+	snapshot.rewardTransactions = getShardedPoolEntries(dataPool.RewardTransactions(), cacheIDs)
+	snapshot.miniBlocks = getCacherEntries(dataPool.MiniBlocks(), "")
+
+	return snapshot, nil
+}
+
+func getNotarizedHeaders(
+	getNotarizedHeader func(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error),
+	shardIDs []uint32,
+) map[uint32][]*track.HeaderInfo {
+	notarizedHeaders := make(map[uint32][]*track.HeaderInfo, len(shardIDs))
+	for _, shardID := range shardIDs {
+		for offset := uint64(0); ; offset++ {
+			header, hash, err := getNotarizedHeader(shardID, offset)
+			if err != nil {
+				break
+			}
+
+			notarizedHeaders[shardID] = append(notarizedHeaders[shardID], &track.HeaderInfo{Header: header, Hash: hash})
+		}
+	}
+
+	return notarizedHeaders
+}
+
+func getTrackedHeaders(blockTracker nodeProcess.BlockTracker, shardIDs []uint32) []*track.HeaderInfo {
+	trackedHeaders := make([]*track.HeaderInfo, 0)
+	for _, shardID := range shardIDs {
+		headers, hashes := blockTracker.GetTrackedHeaders(shardID)
+		for idx := range headers {
+			trackedHeaders = append(trackedHeaders, &track.HeaderInfo{Header: headers[idx], Hash: hashes[idx]})
+		}
+	}
+
+	return trackedHeaders
+}
+
+func getPoolHeaders(headersPool dataRetriever.HeadersPool, shardIDs []uint32) []*track.HeaderInfo {
+	poolHeaders := make([]*track.HeaderInfo, 0, headersPool.Len())
+	for _, shardID := range shardIDs {
+		for _, nonce := range headersPool.Nonces(shardID) {
+			headers, hashes, err := headersPool.GetHeadersByNonceAndShardId(nonce, shardID)
+			if err != nil {
+				continue
+			}
+
+			for idx := range headers {
+				poolHeaders = append(poolHeaders, &track.HeaderInfo{Header: headers[idx], Hash: hashes[idx]})
+			}
+		}
+	}
+
+	return poolHeaders
+}
+
+// getProofs returns the proofs of all the headers referenced by the snapshot, as the proofs pool gets cleaned
+// behind the final nonces while new blocks are generated
+func getProofs(proofsPool dataRetriever.ProofsPool, snapshot *nodeSnapshot) []data.HeaderProofHandler {
+	headersInfo := append(make([]*track.HeaderInfo, 0), snapshot.poolHeaders...)
+	headersInfo = append(headersInfo, snapshot.trackedHeaders...)
+	for _, notarizedHeaders := range snapshot.crossNotarizedHeaders {
+		headersInfo = append(headersInfo, notarizedHeaders...)
+	}
+	for _, notarizedHeaders := range snapshot.selfNotarizedHeaders {
+		headersInfo = append(headersInfo, notarizedHeaders...)
+	}
+
+	proofs := make([]data.HeaderProofHandler, 0, len(headersInfo))
+	addedProofs := make(map[string]struct{}, len(headersInfo))
+	for _, headerInfo := range headersInfo {
+		_, alreadyAdded := addedProofs[string(headerInfo.Hash)]
+		if alreadyAdded {
+			continue
+		}
+
+		proof, err := proofsPool.GetProof(headerInfo.Header.GetShardID(), headerInfo.Hash)
+		if err != nil {
+			continue
+		}
+
+		addedProofs[string(headerInfo.Hash)] = struct{}{}
+		proofs = append(proofs, proof)
+	}
+
+	return proofs
+}
+
+func getShardedPoolEntries(pool dataRetriever.ShardedDataCacherNotifier, cacheIDs []string) []*poolEntry {
+	entries := make([]*poolEntry, 0)
+	addedKeys := make(map[string]struct{})
+	for _, cacheID := range cacheIDs {
+		for _, entry := range getCacherEntries(pool.ShardDataStore(cacheID), cacheID) {
+			// several cache identifiers might point to the same cache
+			_, alreadyAdded := addedKeys[string(entry.key)]
+			if alreadyAdded {
+				continue
+			}
+
+			addedKeys[string(entry.key)] = struct{}{}
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+func getCacherEntries(cacher storage.Cacher, cacheID string) []*poolEntry {
+	if check.IfNil(cacher) {
+		return nil
+	}
+
+	keys := cacher.Keys()
+	entries := make([]*poolEntry, 0, len(keys))
+	for _, key := range keys {
+		value, ok := cacher.Peek(key)
+		if !ok {
+			continue
+		}
+
+		entries = append(entries, &poolEntry{
+			key:     key,
+			value:   value,
+			cacheID: cacheID,
+		})
+	}
+
+	return entries
+}
+
+// validateNodeRevert checks that the node can be reverted to the provided snapshot: the epoch did not change, the
+// tries of the recorded root hashes can be loaded from the storers and the components have the expected types
+func validateNodeRevert(node process.NodeHandler, snapshot *nodeSnapshot) error {
+	if snapshot == nil {
+		return errNilNodeSnapshot
+	}
+
+	currentEpoch := node.GetProcessComponents().EpochStartTrigger().Epoch()
+	if currentEpoch != snapshot.epoch {
+		return fmt.Errorf("%w, snapshot epoch %d, current epoch %d", errSnapshotFromAnotherEpoch, snapshot.epoch, currentEpoch)
+	}
+
+	stateComponents := node.GetStateComponents()
+	_, err := stateComponents.AccountsAdapter().GetTrie(snapshot.accountsRootHash)
+	if err != nil {
+		return fmt.Errorf("%w while loading the accounts trie, root hash %x", err, snapshot.accountsRootHash)
+	}
+	if len(snapshot.peerAccountsRootHash) > 0 {
+		_, err = stateComponents.PeerAccounts().GetTrie(snapshot.peerAccountsRootHash)
+		if err != nil {
+			return fmt.Errorf("%w while loading the peer accounts trie, root hash %x", err, snapshot.peerAccountsRootHash)
+		}
+	}
+
+	_, ok := node.GetProcessComponents().BlockTracker().(trackedHeadersRestorer)
+	if !ok {
+		return fmt.Errorf("%w for the block tracker", errWrongTypeAssertion)
+	}
+	_, ok = node.GetCoreComponents().RoundHandler().(manualRoundHandler)
+	if !ok {
+		return fmt.Errorf("%w for the round handler", errWrongTypeAssertion)
+	}
+
+	return nil
+}
+
+func (s *simulator) revertNode(node process.NodeHandler, snapshot *nodeSnapshot) error {
+	processComponents := node.GetProcessComponents()
+	stateComponents := node.GetStateComponents()
+	dataPool := node.GetDataComponents().Datapool()
+
+	err := stateComponents.AccountsAdapter().RecreateTrie(holders.NewDefaultRootHashesHolder(snapshot.accountsRootHash))
+	if err != nil {
+		return err
+	}
+
+	if len(snapshot.peerAccountsRootHash) > 0 {
+		err = stateComponents.PeerAccounts().RecreateTrie(holders.NewDefaultRootHashesHolder(snapshot.peerAccountsRootHash))
+		if err != nil {
+			return err
+		}
+	}
+
+	chainHandler := node.GetChainHandler()
+	err = chainHandler.SetCurrentBlockHeaderAndRootHash(snapshot.currentHeader, snapshot.currentRootHash)
+	if err != nil {
+		return err
+	}
+	chainHandler.SetCurrentBlockHeaderHash(snapshot.currentHeaderHash)
+	chainHandler.SetFinalBlockInfo(snapshot.finalBlockNonce, snapshot.finalBlockHash, snapshot.finalBlockRootHash)
+
+	processComponents.ScheduledTxsExecutionHandler().SetScheduledInfo(snapshot.scheduledInfo)
+
+	processedMiniBlocksTracker := processComponents.ProcessedMiniBlocksTracker()
+	for _, miniBlocksInMeta := range processedMiniBlocksTracker.ConvertProcessedMiniBlocksMapToSlice() {
+		processedMiniBlocksTracker.RemoveMetaBlockHash(miniBlocksInMeta.MetaHash)
+	}
+	processedMiniBlocksTracker.ConvertSliceToProcessedMiniBlocksMap(snapshot.processedMiniBlocks)
+
+	dataPool.Headers().Clear()
+	for _, headerInfo := range snapshot.poolHeaders {
+		dataPool.Headers().AddHeader(headerInfo.Hash, headerInfo.Header)
+	}
+	for _, proof := range snapshot.proofs {
+		_ = dataPool.Proofs().AddProof(proof)
+	}
+
+	headersRestorer, ok := processComponents.BlockTracker().(trackedHeadersRestorer)
+	if !ok {
+		return fmt.Errorf("%w for the block tracker", errWrongTypeAssertion)
+	}
+	headersRestorer.RestoreHeaders(snapshot.crossNotarizedHeaders, snapshot.selfNotarizedHeaders, snapshot.trackedHeaders)
+
+	forkDetector := processComponents.ForkDetector()
+	forkDetector.RestoreToGenesis()
+	if !check.IfNil(snapshot.currentHeader) {
+		forkDetector.AddCheckpoint(snapshot.currentHeader.GetNonce(), snapshot.currentHeader.GetRound(), snapshot.currentHeaderHash)
+		forkDetector.SetFinalToLastCheckpoint()
+	}
+
+	restoreShardedPool(dataPool.Transactions(), snapshot.transactions)
+	restoreShardedPool(dataPool.UnsignedTransactions(), snapshot.unsignedTransactions)
+	restoreShardedPool(dataPool.RewardTransactions(), snapshot.rewardTransactions)
+	dataPool.MiniBlocks().Clear()
+	for _, entry := range snapshot.miniBlocks {
+		_ = dataPool.MiniBlocks().Put(entry.key, entry.value, computeSize(entry.value))
+	}
+
+	roundHandler, ok := node.GetCoreComponents().RoundHandler().(manualRoundHandler)
+	if !ok {
+		return fmt.Errorf("%w for the round handler", errWrongTypeAssertion)
+	}
+	roundHandler.SetIndex(snapshot.roundIndex)
+	roundHandler.SetTimeOffset(snapshot.timeOffset)
+
+	appStatusHandler := node.GetStatusCoreComponents().AppStatusHandler()
+	appStatusHandler.SetUInt64Value(common.MetricCurrentRound, uint64(snapshot.roundIndex))
+	if !check.IfNil(snapshot.currentHeader) {
+		appStatusHandler.SetUInt64Value(common.MetricNonce, snapshot.currentHeader.GetNonce())
+	}
+
+	return nil
+}
+
+func restoreShardedPool(pool dataRetriever.ShardedDataCacherNotifier, entries []*poolEntry) {
+	pool.Clear()
+	for _, entry := range entries {
+		pool.AddData(entry.key, entry.value, computeSize(entry.value), entry.cacheID)
+	}
+}
+
+func computeSize(value interface{}) int {
+	sizedValue, ok := value.(sizeHandler)
+	if !ok {
+		return 0
+	}
+
+	return sizedValue.Size()
+}
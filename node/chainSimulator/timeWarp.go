@@ -0,0 +1,132 @@
+package chainSimulator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+)
+
+type manualRoundHandler interface {
+	Index() int64
+	SetIndex(index int64)
+	TimeStamp() time.Time
+	TimeDuration() time.Duration
+	SetTimeOffset(offset time.Duration)
+	TimeOffset() time.Duration
+	IndexForTimeStamp(timestamp time.Time) int64
+}
+
+// WarpToRound will move all the nodes to the provided round and will generate a block in that round. The rounds in
+// between are left empty, as if no block was proposed in them, so at most one epoch change can happen on the warp,
+// the same as on a real chain which did not produce blocks for a while
+func (s *simulator) WarpToRound(targetRound int64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.warpToRoundAndRecord(targetRound)
+}
+
+// WarpToTimestamp will move all the nodes to the first round starting at or after the provided unix timestamp and
+// will generate a block in that round
+func (s *simulator) WarpToTimestamp(timestamp int64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	roundHandler, err := s.getManualRoundHandler(core.MetachainShardId)
+	if err != nil {
+		return err
+	}
+
+	return s.warpToRoundAndRecord(roundHandler.IndexForTimeStamp(time.Unix(timestamp, 0)))
+}
+
+// SetNextBlockTimestamp will set the unix timestamp of the next generated blocks, the one returned to the smart
+// contracts as the current block timestamp. All the following blocks keep their round duration relative to it
+func (s *simulator) SetNextBlockTimestamp(timestamp int64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	metaRoundHandler, err := s.getManualRoundHandler(core.MetachainShardId)
+	if err != nil {
+		return err
+	}
+
+	currentTimestamp := metaRoundHandler.TimeStamp()
+	if timestamp <= currentTimestamp.Unix() {
+		return fmt.Errorf("%w: provided %d, current %d", errTimestampNotInTheFuture, timestamp, currentTimestamp.Unix())
+	}
+
+	nextTimestamp := currentTimestamp.Add(metaRoundHandler.TimeDuration())
+	offset := metaRoundHandler.TimeOffset() + time.Unix(timestamp, 0).Sub(nextTimestamp)
+	for shardID := range s.nodes {
+		roundHandler, errGet := s.getManualRoundHandler(shardID)
+		if errGet != nil {
+			return errGet
+		}
+
+		roundHandler.SetTimeOffset(offset)
+	}
+
+	log.Info("chain simulator set the next block timestamp", "timestamp", timestamp)
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action:    dtos.SetNextBlockTimestampAction,
+		Timestamp: timestamp,
+	})
+
+	return nil
+}
+
+func (s *simulator) warpToRoundAndRecord(targetRound int64) error {
+	err := s.warpToRound(targetRound)
+	if err != nil {
+		return err
+	}
+
+	s.recordScenarioStep(&dtos.ScenarioStep{
+		Action: dtos.WarpToRoundAction,
+		Round:  targetRound,
+	})
+
+	return nil
+}
+
+func (s *simulator) warpToRound(targetRound int64) error {
+	metaRoundHandler, err := s.getManualRoundHandler(core.MetachainShardId)
+	if err != nil {
+		return err
+	}
+
+	currentRound := metaRoundHandler.Index()
+	if targetRound <= currentRound {
+		return fmt.Errorf("%w: target round %d, current round %d", errRoundNotInTheFuture, targetRound, currentRound)
+	}
+
+	for shardID, node := range s.nodes {
+		roundHandler, errGet := s.getManualRoundHandler(shardID)
+		if errGet != nil {
+			return errGet
+		}
+
+		roundHandler.SetIndex(targetRound - 1)
+		node.GetStatusCoreComponents().AppStatusHandler().SetUInt64Value(common.MetricCurrentRound, uint64(targetRound-1))
+	}
+
+	log.Info("chain simulator warps", "target round", targetRound)
+
+	s.incrementRoundOnAllValidators()
+
+	return s.allNodesCreateBlocks()
+}
+
+func (s *simulator) getManualRoundHandler(shardID uint32) (manualRoundHandler, error) {
+	roundHandler, ok := s.nodes[shardID].GetCoreComponents().RoundHandler().(manualRoundHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w for the round handler of shard %d", errWrongTypeAssertion, shardID)
+	}
+
+	return roundHandler, nil
+}
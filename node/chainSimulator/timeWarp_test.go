@@ -0,0 +1,92 @@
+package chainSimulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulator_WarpToRoundAndTimestamp(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	roundsPerEpoch := uint64(20)
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       time.Now().Unix(),
+		RoundDurationInMillis:  uint64(6000),
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    roundsPerEpoch,
+		},
+		ApiInterface:      api.NewNoApiInterface(),
+		MinNodesPerShard:  1,
+		MetaChainMinNodes: 1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(3)
+	require.Nil(t, err)
+
+	requireAllShardsAt := func(round uint64, nonce uint64) {
+		for shardID, node := range chainSimulator.nodes {
+			header := node.GetChainHandler().GetCurrentBlockHeader()
+			require.Equal(t, round, header.GetRound(), "shard %d", shardID)
+			require.Equal(t, nonce, header.GetNonce(), "shard %d", shardID)
+			require.Equal(t, int64(round), node.GetCoreComponents().RoundHandler().Index(), "shard %d", shardID)
+		}
+	}
+
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	currentRound := metaNode.GetCoreComponents().RoundHandler().Index()
+	currentNonce := metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+
+	err = chainSimulator.WarpToRound(currentRound)
+	require.ErrorIs(t, err, errRoundNotInTheFuture)
+
+	targetRound := currentRound + 10
+	err = chainSimulator.WarpToRound(targetRound)
+	require.Nil(t, err)
+	requireAllShardsAt(uint64(targetRound), currentNonce+1)
+
+	roundHandler := metaNode.GetCoreComponents().RoundHandler()
+	targetTimestamp := roundHandler.TimeStamp().Add(5*roundHandler.TimeDuration() + time.Second).Unix()
+	err = chainSimulator.WarpToTimestamp(targetTimestamp)
+	require.Nil(t, err)
+	requireAllShardsAt(uint64(targetRound+6), currentNonce+2)
+	require.GreaterOrEqual(t, metaNode.GetChainHandler().GetCurrentBlockHeader().GetTimeStamp(), uint64(targetTimestamp))
+
+	err = chainSimulator.SetNextBlockTimestamp(int64(metaNode.GetChainHandler().GetCurrentBlockHeader().GetTimeStamp()))
+	require.ErrorIs(t, err, errTimestampNotInTheFuture)
+
+	nextTimestamp := metaNode.GetChainHandler().GetCurrentBlockHeader().GetTimeStamp() + 3600
+	err = chainSimulator.SetNextBlockTimestamp(int64(nextTimestamp))
+	require.Nil(t, err)
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+	for _, node := range chainSimulator.nodes {
+		require.Equal(t, nextTimestamp, node.GetChainHandler().GetCurrentBlockHeader().GetTimeStamp())
+		require.Equal(t, nextTimestamp, node.GetProcessComponents().BlockchainHook().LastTimeStamp())
+	}
+
+	epoch := metaNode.GetProcessComponents().EpochStartTrigger().Epoch()
+	err = chainSimulator.WarpToRound(metaNode.GetCoreComponents().RoundHandler().Index() + int64(3*roundsPerEpoch))
+	require.Nil(t, err)
+	require.Equal(t, epoch+1, metaNode.GetProcessComponents().EpochStartTrigger().Epoch())
+
+	err = chainSimulator.GenerateBlocks(5)
+	require.Nil(t, err)
+	for shardID, node := range chainSimulator.nodes {
+		require.Equal(t, epoch+1, node.GetChainHandler().GetCurrentBlockHeader().GetEpoch(), "shard %d", shardID)
+	}
+}
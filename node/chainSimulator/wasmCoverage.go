@@ -0,0 +1,43 @@
+package chainSimulator
+
+import (
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/debug/wasmCoverage"
+	mxProcess "github.com/multiversx/mx-chain-go/process"
+)
+
+// createWasmCoverageCollector creates the Wasm coverage collector shared by all the nodes, so the coverage of a contract
+// code gathers the executions from all the shards. The collector is enabled through the Debug.WasmCoverage section of
+// the config
+func createWasmCoverageCollector(generalConfig *config.Config) wasmCoverage.CoverageCollectorHandler {
+	if !generalConfig.Debug.WasmCoverage.Enabled {
+		return wasmCoverage.NewDisabledCoverageCollector()
+	}
+
+	return wasmCoverage.NewCoverageCollector()
+}
+
+// getVMCoverageCollector returns the coverage collector to be set on the Wasm VMs of the nodes. It returns nil if the
+// collection is not enabled, so the VMs are not wrapped
+func (s *simulator) getVMCoverageCollector(generalConfig *config.Config) mxProcess.WasmCoverageCollector {
+	if !generalConfig.Debug.WasmCoverage.Enabled {
+		return nil
+	}
+
+	return s.wasmCoverageCollector
+}
+
+// GetWasmCoverageReport returns the coverage of the functions and branches of the contract codes executed so far
+func (s *simulator) GetWasmCoverageReport() (*wasmCoverage.Report, error) {
+	return s.wasmCoverageCollector.GetReport()
+}
+
+// SaveWasmCoverageReport writes the lcov coverage report in the provided folder
+func (s *simulator) SaveWasmCoverageReport(folder string) error {
+	return s.wasmCoverageCollector.SaveReport(folder)
+}
+
+// ResetWasmCoverage removes the recorded executions
+func (s *simulator) ResetWasmCoverage() {
+	s.wasmCoverageCollector.Reset()
+}
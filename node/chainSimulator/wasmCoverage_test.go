@@ -0,0 +1,185 @@
+package chainSimulator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/debug/wasmCoverage"
+	chainSimulatorCommon "github.com/multiversx/mx-chain-go/integrationTests/chainSimulator"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/configs"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+const adderWasmPath = "../../integrationTests/realcomponents/testdata/adder/adder.wasm"
+
+func TestSimulator_WasmCoverage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled collector should error", func(t *testing.T) {
+		t.Parallel()
+
+		generalConfig := &config.Config{}
+		chainSimulator := &simulator{
+			wasmCoverageCollector: createWasmCoverageCollector(generalConfig),
+		}
+		require.Nil(t, chainSimulator.getVMCoverageCollector(generalConfig))
+
+		report, err := chainSimulator.GetWasmCoverageReport()
+		require.Equal(t, wasmCoverage.ErrWasmCoverageDisabled, err)
+		require.Nil(t, report)
+
+		err = chainSimulator.SaveWasmCoverageReport(t.TempDir())
+		require.Equal(t, wasmCoverage.ErrWasmCoverageDisabled, err)
+	})
+	t.Run("enabled collector should work", func(t *testing.T) {
+		t.Parallel()
+
+		generalConfig := &config.Config{}
+		generalConfig.Debug.WasmCoverage.Enabled = true
+		chainSimulator := &simulator{
+			wasmCoverageCollector: createWasmCoverageCollector(generalConfig),
+		}
+		require.NotNil(t, chainSimulator.getVMCoverageCollector(generalConfig))
+
+		report, err := chainSimulator.GetWasmCoverageReport()
+		require.Nil(t, err)
+		require.Empty(t, report.Contracts)
+
+		folder := t.TempDir()
+		err = chainSimulator.SaveWasmCoverageReport(folder)
+		require.Nil(t, err)
+		_, err = os.Stat(filepath.Join(folder, wasmCoverage.LcovReportFileName))
+		require.Nil(t, err)
+
+		chainSimulator.ResetWasmCoverage()
+	})
+}
+
+func TestSimulator_WasmCoverageOfDeployedContract(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       time.Now().Unix(),
+		RoundDurationInMillis:  uint64(6000),
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    20,
+		},
+		ApiInterface:      api.NewNoApiInterface(),
+		MinNodesPerShard:  1,
+		MetaChainMinNodes: 1,
+		AlterConfigsFunction: func(cfg *config.Configs) {
+			cfg.GeneralConfig.Debug.WasmCoverage.Enabled = true
+		},
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	initialBalance := big.NewInt(0).Mul(chainSimulatorCommon.OneEGLD, big.NewInt(10))
+	owner, err := chainSimulator.GenerateAndMintWalletAddress(1, initialBalance)
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	code, err := os.ReadFile(adderWasmPath)
+	require.Nil(t, err)
+
+	nonce := uint64(0)
+	sendTx := func(receiver []byte, data string) *transaction.ApiTransactionResult {
+		tx := &transaction.Transaction{
+			Nonce:     nonce,
+			Value:     big.NewInt(0),
+			SndAddr:   owner.Bytes,
+			RcvAddr:   receiver,
+			Data:      []byte(data),
+			GasLimit:  100_000_000,
+			GasPrice:  1_000_000_000,
+			ChainID:   []byte(configs.ChainID),
+			Version:   1,
+			Signature: []byte("signature"),
+		}
+		result, errSend := chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 10)
+		require.Nil(t, errSend)
+		require.Equal(t, transaction.TxStatusSuccess, result.Status)
+		nonce++
+
+		return result
+	}
+
+	deployData := strings.Join([]string{hex.EncodeToString(code), "0500", "0500", "00"}, "@")
+	result := sendTx(make([]byte, 32), deployData)
+	contractAddress, err := chainSimulator.GetNodeHandler(0).GetCoreComponents().AddressPubKeyConverter().Decode(result.Logs.Events[0].Address)
+	require.Nil(t, err)
+
+	sendTx(contractAddress, "add@05")
+	sendTx(contractAddress, "add@07")
+
+	// the queries are not executed by the transaction processing VMs, so they are not covered
+	_, _, err = chainSimulator.GetNodeHandler(1).GetFacadeHandler().ExecuteSCQuery(&process.SCQuery{
+		ScAddress:  contractAddress,
+		FuncName:   "getSum",
+		CallerAddr: owner.Bytes,
+		CallValue:  big.NewInt(0),
+	})
+	require.Nil(t, err)
+
+	report, err := chainSimulator.GetWasmCoverageReport()
+	require.Nil(t, err)
+	require.Len(t, report.Contracts, 1)
+
+	contractCoverage := report.Contracts[0]
+	numCallsPerFunction := make(map[string]uint64)
+	for _, function := range contractCoverage.Functions {
+		numCallsPerFunction[function.Name] = function.NumCalls
+	}
+	require.Equal(t, uint64(1), numCallsPerFunction["init"])
+	require.Equal(t, uint64(2), numCallsPerFunction["add"])
+	require.Equal(t, uint64(0), numCallsPerFunction["getSum"])
+	// the internal functions called by the endpoints are covered as well
+	require.Greater(t, contractCoverage.NumExecutedFunctions, 2)
+	require.Greater(t, contractCoverage.NumFunctions, contractCoverage.NumExecutedFunctions)
+	require.Greater(t, contractCoverage.NumTakenBranches, 0)
+	require.Greater(t, contractCoverage.NumBranches, contractCoverage.NumTakenBranches)
+
+	folder := t.TempDir()
+	err = chainSimulator.SaveWasmCoverageReport(folder)
+	require.Nil(t, err)
+	lcovReport, err := os.ReadFile(filepath.Join(folder, wasmCoverage.LcovReportFileName))
+	require.Nil(t, err)
+	require.Contains(t, string(lcovReport), "SF:"+contractCoverage.CodeHash)
+	require.Contains(t, string(lcovReport), "FNDA:2,add")
+	require.Contains(t, string(lcovReport), fmt.Sprintf("FNH:%d", contractCoverage.NumExecutedFunctions))
+	require.Contains(t, string(lcovReport), fmt.Sprintf("BRH:%d", contractCoverage.NumTakenBranches))
+
+	chainSimulator.ResetWasmCoverage()
+	sendTx(contractAddress, "add@01")
+
+	report, err = chainSimulator.GetWasmCoverageReport()
+	require.Nil(t, err)
+	require.Len(t, report.Contracts, 1)
+	for _, function := range report.Contracts[0].Functions {
+		if function.Name == "add" {
+			require.Equal(t, uint64(1), function.NumCalls)
+		}
+	}
+}
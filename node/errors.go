@@ -138,3 +138,15 @@ var ErrNilStatusHandler = errors.New("nil status handler")
 
 // ErrNilCreateTransactionArgs signals that create transaction args is nil
 var ErrNilCreateTransactionArgs = errors.New("nil args for create transaction")
+
+// ErrStateSnapshotForGenesis signals that a state snapshot was requested for the genesis epoch
+var ErrStateSnapshotForGenesis = errors.New("state snapshots can not be exported for the genesis epoch")
+
+// ErrStateSnapshotNotSupported signals that the accounts adapter can not export state snapshots
+var ErrStateSnapshotNotSupported = errors.New("state snapshot export not supported")
+
+// ErrStateSnapshotExportInProgress signals that another state snapshot export is in progress
+var ErrStateSnapshotExportInProgress = errors.New("state snapshot export already in progress")
+
+// ErrNotarizedShardHeaderNotFound signals that the epoch start meta block does not notarize a header of the shard
+var ErrNotarizedShardHeaderNotFound = errors.New("notarized shard header not found in the epoch start meta block")
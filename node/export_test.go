@@ -54,3 +54,8 @@ func (n *Node) GetPendingAndActiveGuardians(
 ) (activeGuardian *api.Guardian, pendingGuardian *api.Guardian, err error) {
 	return n.getPendingAndActiveGuardians(userAccount)
 }
+
+// IsExportingStateSnapshot -
+func (n *Node) IsExportingStateSnapshot() bool {
+	return n.isExportingStateSnapshot.IsSet()
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-go/genesis"
 	"github.com/multiversx/mx-chain-go/node/external/blockAPI"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
@@ -44,6 +45,7 @@ type ArgNodeApiResolver struct {
 	PublicKey                string
 	NodesCoordinator         nodesCoordinator.NodesCoordinator
 	StorageManagers          []common.StorageManager
+	ExecutionTracer          tracing.ExecutionTracerHandler
 }
 
 // nodeApiResolver can resolve API requests
@@ -65,6 +67,7 @@ type nodeApiResolver struct {
 	publicKey                string
 	nodesCoordinator         nodesCoordinator.NodesCoordinator
 	storageManagers          []common.StorageManager
+	executionTracer          tracing.ExecutionTracerHandler
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -114,6 +117,10 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.NodesCoordinator) {
 		return nil, ErrNilNodesCoordinator
 	}
+	executionTracer := arg.ExecutionTracer
+	if check.IfNil(executionTracer) {
+		executionTracer = tracing.NewDisabledExecutionTracer()
+	}
 
 	return &nodeApiResolver{
 		scQueryService:           arg.SCQueryService,
@@ -133,6 +140,7 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		publicKey:                arg.PublicKey,
 		nodesCoordinator:         arg.NodesCoordinator,
 		storageManagers:          arg.StorageManagers,
+		executionTracer:          executionTracer,
 	}, nil
 }
 
@@ -141,6 +149,11 @@ func (nar *nodeApiResolver) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nar.scQueryService.ExecuteQuery(query)
 }
 
+// ExecuteSCQueries retrieves data stored in SC accounts through a VM, executing all the queries against the same block
+func (nar *nodeApiResolver) ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	return nar.scQueryService.ExecuteQueries(queries)
+}
+
 // StatusMetrics returns an implementation of the StatusMetricsHandler interface
 func (nar *nodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
@@ -200,6 +213,16 @@ func (nar *nodeApiResolver) GetSCRsByTxHash(txHash string, scrHash string) ([]*t
 	return nar.apiTransactionHandler.GetSCRsByTxHash(txHash, scrHash)
 }
 
+// GetTransactionTrace will return the call frames of the smart contract executions triggered by the provided transaction hash
+func (nar *nodeApiResolver) GetTransactionTrace(hash string) (*tracing.TransactionTrace, error) {
+	txHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return nar.executionTracer.GetTransactionTrace(txHash)
+}
+
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
 func (nar *nodeApiResolver) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
 	return nar.apiTransactionHandler.GetTransactionsPool(fields)
@@ -210,6 +233,11 @@ func (nar *nodeApiResolver) GetTransactionsPoolForSender(sender, fields string)
 	return nar.apiTransactionHandler.GetTransactionsPoolForSender(sender, fields)
 }
 
+// GetTransactionsForAddress will return a page of the transactions involving the provided address
+func (nar *nodeApiResolver) GetTransactionsForAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsForAddress(address, options)
+}
+
 // GetLastPoolNonceForSender will return the last nonce from pool for sender that is to be returned on API calls
 func (nar *nodeApiResolver) GetLastPoolNonceForSender(sender string) (uint64, error) {
 	return nar.apiTransactionHandler.GetLastPoolNonceForSender(sender)
@@ -17,6 +17,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
@@ -186,6 +187,27 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_ExecuteSCQueriesShouldCall(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	wasCalled := false
+	providedQueries := []*process.SCQuery{{FuncName: "first"}, {FuncName: "second"}}
+	arg.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+			wasCalled = true
+			assert.Equal(t, providedQueries, queries)
+			return make([]*process.SCQueryResult, len(queries)), nil, nil
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	results, _, err := nar.ExecuteSCQueries(providedQueries)
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+	assert.True(t, wasCalled)
+}
+
 func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
@@ -492,6 +514,55 @@ func TestNodeApiResolver_GetTransactionsPoolForSender(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_GetTransactionsForAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionsForAddressCalled: func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		res, err := nar.GetTransactionsForAddress("address", common.AddressTransactionsQueryOptions{})
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedAddress := "alice"
+		providedOptions := common.AddressTransactionsQueryOptions{Size: 10}
+		expectedResponse := &common.AddressTransactionsApiResponse{
+			Transactions: []*common.AddressTransactionApiResponse{
+				{
+					Hash:     "txhash1",
+					Receiver: expectedAddress,
+				},
+			},
+		}
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionsForAddressCalled: func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+				require.Equal(t, expectedAddress, address)
+				require.Equal(t, providedOptions, options)
+				return expectedResponse, nil
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		res, err := nar.GetTransactionsForAddress(expectedAddress, providedOptions)
+		require.NoError(t, err)
+		require.Equal(t, expectedResponse, res)
+	})
+}
+
 func TestNodeApiResolver_GetLastPoolNonceForSender(t *testing.T) {
 	t.Parallel()
 
@@ -935,6 +1006,58 @@ func TestNodeApiResolver_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_GetTransactionTrace(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx hash")
+	t.Run("execution tracer not provided should error", func(t *testing.T) {
+		t.Parallel()
+
+		nar, err := external.NewNodeApiResolver(createMockArgs())
+		require.NoError(t, err)
+
+		trace, err := nar.GetTransactionTrace(hex.EncodeToString(txHash))
+		require.Equal(t, tracing.ErrExecutionTracingDisabled, err)
+		require.Nil(t, trace)
+	})
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		nar, err := external.NewNodeApiResolver(createMockArgs())
+		require.NoError(t, err)
+
+		trace, err := nar.GetTransactionTrace("not hex")
+		require.Error(t, err)
+		require.Nil(t, trace)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		executionTracer, _ := tracing.NewExecutionTracer(tracing.ArgsExecutionTracer{
+			PubkeyConverter:       testscommon.NewPubkeyConverterMock(32),
+			MaxTracedTransactions: 10,
+		})
+		executionTracer.TraceCall(process.TracedExecution{}, &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CurrentTxHash:  txHash,
+				OriginalTxHash: txHash,
+			},
+			Function: "function",
+		}, nil, nil)
+
+		args := createMockArgs()
+		args.ExecutionTracer = executionTracer
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		trace, err := nar.GetTransactionTrace(hex.EncodeToString(txHash))
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(txHash), trace.TxHash)
+		require.Equal(t, 1, len(trace.Frames))
+		require.Equal(t, "function", trace.Frames[0].Function)
+	})
+}
+
 func TestNodeApiResolver_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
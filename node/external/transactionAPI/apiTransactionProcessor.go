@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
@@ -269,6 +271,61 @@ func (atp *apiTransactionProcessor) GetTransactionsPoolForSender(sender, fields
 	return transactions, nil
 }
 
+// GetTransactionsForAddress will return a page of the transactions, smart contract results and rewards involving the
+// provided address, as recorded by the transactions by address index
+func (atp *apiTransactionProcessor) GetTransactionsForAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	addressBytes, err := atp.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
+
+	page, err := atp.historyRepository.GetAddressTransactions(addressTransactions.Query{
+		Address:    addressBytes,
+		Cursor:     options.Cursor,
+		Size:       options.Size,
+		Descending: options.Descending,
+		Types:      options.Types,
+		FromNonce:  options.FromNonce,
+		ToNonce:    options.ToNonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.AddressTransactionsApiResponse{
+		Transactions: make([]*common.AddressTransactionApiResponse, 0, len(page.Transactions)),
+	}
+	for _, entry := range page.Transactions {
+		response.Transactions = append(response.Transactions, &common.AddressTransactionApiResponse{
+			Hash:             hex.EncodeToString(entry.TxHash),
+			Type:             entry.Type,
+			Sender:           atp.encodeAddressIfNotEmpty(entry.Sender),
+			Receiver:         atp.encodeAddressIfNotEmpty(entry.Receiver),
+			BlockNonce:       entry.BlockNonce,
+			BlockHash:        hex.EncodeToString(entry.BlockHash),
+			Round:            entry.Round,
+			Epoch:            entry.Epoch,
+			MiniblockHash:    hex.EncodeToString(entry.MiniblockHash),
+			SourceShard:      entry.SourceShard,
+			DestinationShard: entry.DestinationShard,
+			Timestamp:        entry.Timestamp,
+		})
+	}
+	if page.NextCursor.HasValue {
+		response.NextCursor = strconv.FormatUint(page.NextCursor.Value, 10)
+	}
+
+	return response, nil
+}
+
+func (atp *apiTransactionProcessor) encodeAddressIfNotEmpty(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return atp.addressPubKeyConverter.SilentEncode(address, log)
+}
+
 // GetLastPoolNonceForSender will return the last nonce from pool for sender that is to be returned on API calls
 func (atp *apiTransactionProcessor) GetLastPoolNonceForSender(sender string) (uint64, error) {
 	senderAddr, err := atp.addressPubKeyConverter.Decode(sender)
@@ -24,6 +24,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	"github.com/multiversx/mx-chain-go/process"
 	processMocks "github.com/multiversx/mx-chain-go/process/mock"
@@ -973,6 +974,114 @@ func TestApiTransactionProcessor_GetTransactionsPoolForSender(t *testing.T) {
 	}, res)
 }
 
+func TestApiTransactionProcessor_GetTransactionsForAddress(t *testing.T) {
+	t.Parallel()
+
+	pubKeyConverter := &testscommon.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			if humanReadable == "invalid" {
+				return nil, errors.New("decode error")
+			}
+			return []byte(humanReadable), nil
+		},
+		SilentEncodeCalled: func(pkBytes []byte, log core.Logger) string {
+			return string(pkBytes)
+		},
+	}
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.AddressPubKeyConverter = pubKeyConverter
+		atp, _ := NewAPITransactionProcessor(args)
+
+		response, err := atp.GetTransactionsForAddress("invalid", common.AddressTransactionsQueryOptions{})
+		require.True(t, strings.Contains(err.Error(), ErrInvalidAddress.Error()))
+		require.Nil(t, response)
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgAPITransactionProcessor()
+		args.AddressPubKeyConverter = pubKeyConverter
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetAddressTransactionsCalled: func(query addressTransactions.Query) (*addressTransactions.Page, error) {
+				return nil, expectedErr
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		response, err := atp.GetTransactionsForAddress("alice", common.AddressTransactionsQueryOptions{})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, response)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		options := common.AddressTransactionsQueryOptions{
+			Cursor:     core.OptionalUint64{Value: 10, HasValue: true},
+			Size:       2,
+			Descending: true,
+			Types:      []transaction.TxType{transaction.TxTypeNormal, transaction.TxTypeReward},
+			FromNonce:  core.OptionalUint64{Value: 1, HasValue: true},
+			ToNonce:    core.OptionalUint64{Value: 100, HasValue: true},
+		}
+		args := createMockArgAPITransactionProcessor()
+		args.AddressPubKeyConverter = pubKeyConverter
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetAddressTransactionsCalled: func(query addressTransactions.Query) (*addressTransactions.Page, error) {
+				require.Equal(t, addressTransactions.Query{
+					Address:    []byte("alice"),
+					Cursor:     options.Cursor,
+					Size:       options.Size,
+					Descending: options.Descending,
+					Types:      options.Types,
+					FromNonce:  options.FromNonce,
+					ToNonce:    options.ToNonce,
+				}, query)
+
+				return &addressTransactions.Page{
+					Transactions: []*addressTransactions.AddressTransaction{
+						{
+							TxHash:     []byte("tx"),
+							Type:       string(transaction.TxTypeNormal),
+							Sender:     []byte("alice"),
+							Receiver:   []byte("bob"),
+							BlockNonce: 37,
+							BlockHash:  []byte("block"),
+						},
+						{
+							TxHash:   []byte("reward"),
+							Type:     string(transaction.TxTypeReward),
+							Receiver: []byte("alice"),
+						},
+					},
+					NextCursor: core.OptionalUint64{Value: 8, HasValue: true},
+				}, nil
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		response, err := atp.GetTransactionsForAddress("alice", options)
+		require.Nil(t, err)
+		require.Equal(t, "8", response.NextCursor)
+		require.Len(t, response.Transactions, 2)
+		require.Equal(t, &common.AddressTransactionApiResponse{
+			Hash:          hex.EncodeToString([]byte("tx")),
+			Type:          string(transaction.TxTypeNormal),
+			Sender:        "alice",
+			Receiver:      "bob",
+			BlockNonce:    37,
+			BlockHash:     hex.EncodeToString([]byte("block")),
+			MiniblockHash: "",
+		}, response.Transactions[0])
+		require.Empty(t, response.Transactions[1].Sender)
+		require.Equal(t, "alice", response.Transactions[1].Receiver)
+	})
+}
+
 func TestApiTransactionProcessor_GetLastPoolNonceForSender(t *testing.T) {
 	t.Parallel()
 
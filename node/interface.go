@@ -6,6 +6,8 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 
+	grpcApi "github.com/multiversx/mx-chain-go/api/grpc"
+	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/update"
 )
 
@@ -49,3 +51,13 @@ type accountHandlerWithDataTrieMigrationStatus interface {
 	vmcommon.AccountHandler
 	IsDataTrieMigrated() (bool, error)
 }
+
+type grpcServerHandler interface {
+	UpdateFacade(facade grpcApi.FacadeHandler) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+type stateSnapshotExporter interface {
+	ExportStateSnapshot(rootHash []byte, writer state.StateSnapshotWriter) error
+}
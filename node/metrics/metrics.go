@@ -57,6 +57,9 @@ func InitBaseMetrics(appStatusHandler core.AppStatusHandler) error {
 	appStatusHandler.SetUInt64Value(common.MetricPeersSnapshotInProgress, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricNonceAtEpochStart, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricRoundAtEpochStart, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricVMQueriesStateViewHits, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricVMQueriesStateViewMisses, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricVMQueriesLastStateViewRecreationDurationMs, initUint)
 
 	appStatusHandler.SetInt64Value(common.MetricLastAccountsSnapshotDurationSec, initInt)
 	appStatusHandler.SetInt64Value(common.MetricLastPeersSnapshotDurationSec, initInt)
@@ -68,6 +68,9 @@ func TestInitBaseMetrics(t *testing.T) {
 		common.MetricTrieSyncNumReceivedBytes,
 		common.MetricRoundAtEpochStart,
 		common.MetricNonceAtEpochStart,
+		common.MetricVMQueriesStateViewHits,
+		common.MetricVMQueriesStateViewMisses,
+		common.MetricVMQueriesLastStateViewRecreationDurationMs,
 	}
 
 	keys := make(map[string]struct{})
@@ -10,6 +10,7 @@ type TransactionAPIHandlerStub struct {
 	GetTransactionCalled                        func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
+	GetTransactionsForAddressCalled             func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
 	UnmarshalTransactionCalled                  func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
@@ -96,6 +97,15 @@ func (tas *TransactionAPIHandlerStub) PopulateComputedFields(tx *transaction.Api
 	}
 }
 
+// GetTransactionsForAddress -
+func (tas *TransactionAPIHandlerStub) GetTransactionsForAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	if tas.GetTransactionsForAddressCalled != nil {
+		return tas.GetTransactionsForAddressCalled(address, options)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (tas *TransactionAPIHandlerStub) IsInterfaceNil() bool {
 	return tas == nil
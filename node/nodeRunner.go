@@ -1,6 +1,7 @@
 package node
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
@@ -23,6 +24,7 @@ import (
 	logger "github.com/multiversx/mx-chain-logger-go"
 
 	"github.com/multiversx/mx-chain-go/api/gin"
+	grpcApi "github.com/multiversx/mx-chain-go/api/grpc"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/disabled"
@@ -34,6 +36,8 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	dbLookupFactory "github.com/multiversx/mx-chain-go/dblookupext/factory"
+	"github.com/multiversx/mx-chain-go/debug/gasProfiler"
+	"github.com/multiversx/mx-chain-go/debug/wasmCoverage"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/facade/initial"
 	mainFactory "github.com/multiversx/mx-chain-go/factory"
@@ -54,8 +58,10 @@ import (
 	"github.com/multiversx/mx-chain-go/health"
 	"github.com/multiversx/mx-chain-go/node/metrics"
 	"github.com/multiversx/mx-chain-go/outport"
+	outportFactory "github.com/multiversx/mx-chain-go/outport/factory"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state/syncer"
 	"github.com/multiversx/mx-chain-go/storage/cache"
@@ -318,7 +324,17 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	}
 
 	log.Debug("creating disabled API services")
-	webServerHandler, err := nr.createHttpServer(managedStatusCoreComponents)
+	initialFacade, err := nr.createInitialFacade(managedStatusCoreComponents)
+	if err != nil {
+		return true, err
+	}
+
+	webServerHandler, err := nr.createHttpServer(initialFacade, managedStatusCoreComponents.AppStatusHandler())
+	if err != nil {
+		return true, err
+	}
+
+	grpcServerHandler, err := nr.createGRPCServer(initialFacade)
 	if err != nil {
 		return true, err
 	}
@@ -433,6 +449,23 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	executionTracer, err := createExecutionTracer(configs.GeneralConfig.Debug.ExecutionTracer, managedCoreComponents.AddressPubKeyConverter())
+	if err != nil {
+		return true, err
+	}
+
+	gasProfilerInstance, err := createGasProfiler(configs.GeneralConfig.Debug.GasProfiler, managedCoreComponents.AddressPubKeyConverter())
+	if err != nil {
+		return true, err
+	}
+
+	wasmCoverageCollector := createWasmCoverageCollector(configs.GeneralConfig.Debug.WasmCoverage)
+	// the Wasm VMs are wrapped only if the coverage collection is enabled
+	var vmCoverageCollector process.WasmCoverageCollector
+	if configs.GeneralConfig.Debug.WasmCoverage.Enabled {
+		vmCoverageCollector = wasmCoverageCollector
+	}
+
 	log.Debug("creating process components")
 	managedProcessComponents, err := nr.CreateManagedProcessComponents(
 		managedCoreComponents,
@@ -445,6 +478,9 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		managedStatusCoreComponents,
 		gasScheduleNotifier,
 		nodesCoordinatorInstance,
+		executionTracer,
+		gasProfilerInstance,
+		vmCoverageCollector,
 	)
 	if err != nil {
 		return true, err
@@ -543,7 +579,14 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	allowExternalVMQueriesChan := make(chan struct{})
 
 	log.Debug("updating the API service after creating the node facade")
-	facadeInstance, err := nr.createApiFacade(currentNode, webServerHandler, gasScheduleNotifier, allowExternalVMQueriesChan)
+	facadeInstance, err := nr.createApiFacade(
+		currentNode,
+		webServerHandler,
+		grpcServerHandler,
+		gasScheduleNotifier,
+		allowExternalVMQueriesChan,
+		executionTracer,
+	)
 	if err != nil {
 		return true, err
 	}
@@ -571,13 +614,87 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		healthService,
 		facadeInstance,
 		webServerHandler,
+		grpcServerHandler,
 		currentNode,
 		goRoutinesNumberStart,
 	)
 
+	saveGasProfileReport(gasProfilerInstance, filepath.Join(configs.FlagsConfig.WorkingDir, common.DefaultStatsPath))
+	saveWasmCoverageReport(wasmCoverageCollector, filepath.Join(configs.FlagsConfig.WorkingDir, common.DefaultStatsPath))
+
 	return nextOperation == nextOperationShouldStop, nil
 }
 
+func createExecutionTracer(
+	tracerConfig config.ExecutionTracerDebugConfig,
+	pubkeyConverter core.PubkeyConverter,
+) (tracing.ExecutionTracerHandler, error) {
+	if !tracerConfig.Enabled {
+		return tracing.NewDisabledExecutionTracer(), nil
+	}
+
+	log.Warn("the smart contract execution tracer is enabled, the call frames of the executed transactions will be recorded",
+		"max traced transactions", tracerConfig.MaxTracedTransactions)
+
+	return tracing.NewExecutionTracer(tracing.ArgsExecutionTracer{
+		PubkeyConverter:       pubkeyConverter,
+		MaxTracedTransactions: tracerConfig.MaxTracedTransactions,
+	})
+}
+
+func createGasProfiler(
+	profilerConfig config.GasProfilerDebugConfig,
+	pubkeyConverter core.PubkeyConverter,
+) (gasProfiler.GasProfilerHandler, error) {
+	if !profilerConfig.Enabled {
+		return gasProfiler.NewDisabledGasProfiler(), nil
+	}
+
+	log.Warn("the gas profiler is enabled, the executed contract calls will be aggregated in memory",
+		"max profiled transactions", profilerConfig.MaxProfiledTransactions)
+
+	return gasProfiler.NewGasProfiler(gasProfiler.ArgsGasProfiler{
+		PubkeyConverter:         pubkeyConverter,
+		MaxProfiledTransactions: profilerConfig.MaxProfiledTransactions,
+	})
+}
+
+func saveGasProfileReport(gasProfilerInstance gasProfiler.GasProfilerHandler, folder string) {
+	err := gasProfilerInstance.SaveReport(folder)
+	if errors.Is(err, gasProfiler.ErrGasProfilingDisabled) {
+		return
+	}
+	if err != nil {
+		log.Warn("could not save the gas profile report", "error", err)
+		return
+	}
+
+	log.Info("gas profile report saved", "folder", folder)
+}
+
+func createWasmCoverageCollector(coverageConfig config.WasmCoverageDebugConfig) wasmCoverage.CoverageCollectorHandler {
+	if !coverageConfig.Enabled {
+		return wasmCoverage.NewDisabledCoverageCollector()
+	}
+
+	log.Warn("the Wasm coverage collection is enabled, the contracts run by the Wasm VM are instrumented and consume more gas")
+
+	return wasmCoverage.NewCoverageCollector()
+}
+
+func saveWasmCoverageReport(collector wasmCoverage.CoverageCollectorHandler, folder string) {
+	err := collector.SaveReport(folder)
+	if errors.Is(err, wasmCoverage.ErrWasmCoverageDisabled) {
+		return
+	}
+	if err != nil {
+		log.Warn("could not save the Wasm coverage report", "error", err)
+		return
+	}
+
+	log.Info("Wasm coverage report saved", "folder", folder)
+}
+
 func addSyncersToAccountsDB(
 	config *config.Config,
 	coreComponents mainFactory.CoreComponentsHolder,
@@ -716,8 +833,10 @@ func getBaseAccountSyncerArgs(
 func (nr *nodeRunner) createApiFacade(
 	currentNode *Node,
 	upgradableHttpServer shared.UpgradeableHttpServerHandler,
+	upgradableGRPCServer grpcServerHandler,
 	gasScheduleNotifier common.GasScheduleNotifierAPI,
 	allowVMQueriesChan chan struct{},
+	executionTracer tracing.ExecutionTracerHandler,
 ) (closing.Closer, error) {
 	configs := nr.configs
 
@@ -737,6 +856,7 @@ func (nr *nodeRunner) createApiFacade(
 		AllowVMQueriesChan:   allowVMQueriesChan,
 		StatusComponents:     currentNode.statusComponents,
 		ProcessingMode:       common.GetNodeProcessingMode(nr.configs.ImportDbConfig),
+		ExecutionTracer:      executionTracer,
 	}
 
 	apiResolver, err := apiComp.CreateApiResolver(apiResolverArgs)
@@ -744,6 +864,19 @@ func (nr *nodeRunner) createApiFacade(
 		return nil, err
 	}
 
+	subscriptionsHubConfig := configs.ExternalConfig.SubscriptionsHub
+	subscriptionsHub, err := outportFactory.CreateSubscriptionsHub(&outportFactory.SubscriptionsHubFactoryArgs{
+		Enabled:                 subscriptionsHubConfig.Enabled,
+		MaxSubscribers:          subscriptionsHubConfig.MaxSubscribers,
+		NotificationsBufferSize: subscriptionsHubConfig.NotificationsBufferSize,
+		Marshaller:              currentNode.coreComponents.InternalMarshalizer(),
+		AddressConverter:        currentNode.coreComponents.AddressPubKeyConverter(),
+		OutportHandler:          currentNode.statusComponents.OutportHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	log.Debug("creating multiversx node facade")
 
 	flagsConfig := configs.FlagsConfig
@@ -758,10 +891,11 @@ func (nr *nodeRunner) createApiFacade(
 			PprofEnabled:                flagsConfig.EnablePprof,
 			P2PPrometheusMetricsEnabled: flagsConfig.P2PPrometheusMetricsEnabled,
 		},
-		ApiRoutesConfig: *configs.ApiRoutesConfig,
-		AccountsState:   currentNode.stateComponents.AccountsAdapter(),
-		PeerState:       currentNode.stateComponents.PeerAccounts(),
-		Blockchain:      currentNode.dataComponents.Blockchain(),
+		ApiRoutesConfig:  *configs.ApiRoutesConfig,
+		AccountsState:    currentNode.stateComponents.AccountsAdapter(),
+		PeerState:        currentNode.stateComponents.PeerAccounts(),
+		Blockchain:       currentNode.dataComponents.Blockchain(),
+		SubscriptionsHub: subscriptionsHub,
 	}
 
 	ef, err := facade.NewNodeFacade(argNodeFacade)
@@ -776,6 +910,11 @@ func (nr *nodeRunner) createApiFacade(
 		return nil, err
 	}
 
+	err = upgradableGRPCServer.UpdateFacade(ef)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Debug("updated node facade")
 
 	log.Trace("starting background services")
@@ -783,7 +922,7 @@ func (nr *nodeRunner) createApiFacade(
 	return ef, nil
 }
 
-func (nr *nodeRunner) createHttpServer(managedStatusCoreComponents mainFactory.StatusCoreComponentsHolder) (shared.UpgradeableHttpServerHandler, error) {
+func (nr *nodeRunner) createInitialFacade(managedStatusCoreComponents mainFactory.StatusCoreComponentsHolder) (shared.FacadeHandler, error) {
 	if check.IfNil(managedStatusCoreComponents) {
 		return nil, ErrNilStatusHandler
 	}
@@ -794,15 +933,19 @@ func (nr *nodeRunner) createHttpServer(managedStatusCoreComponents mainFactory.S
 		P2PPrometheusMetricsEnabled: nr.configs.FlagsConfig.P2PPrometheusMetricsEnabled,
 		StatusMetricsHandler:        managedStatusCoreComponents.StatusMetrics(),
 	}
-	initialFacade, err := initial.NewInitialNodeFacade(argsInitialNodeFacade)
-	if err != nil {
-		return nil, err
-	}
 
+	return initial.NewInitialNodeFacade(argsInitialNodeFacade)
+}
+
+func (nr *nodeRunner) createHttpServer(
+	initialFacade shared.FacadeHandler,
+	statusHandler core.AppStatusHandler,
+) (shared.UpgradeableHttpServerHandler, error) {
 	httpServerArgs := gin.ArgsNewWebServer{
 		Facade:          initialFacade,
 		ApiConfig:       *nr.configs.ApiRoutesConfig,
 		AntiFloodConfig: nr.configs.GeneralConfig.WebServerAntiflood,
+		StatusHandler:   statusHandler,
 	}
 
 	httpServerWrapper, err := gin.NewGinWebServerHandler(httpServerArgs)
@@ -818,6 +961,26 @@ func (nr *nodeRunner) createHttpServer(managedStatusCoreComponents mainFactory.S
 	return httpServerWrapper, nil
 }
 
+func (nr *nodeRunner) createGRPCServer(initialFacade shared.FacadeHandler) (grpcServerHandler, error) {
+	grpcServerArgs := grpcApi.ArgsNewGRPCServer{
+		Facade:      initialFacade,
+		Config:      nr.configs.ApiRoutesConfig.GRPC,
+		APIPackages: nr.configs.ApiRoutesConfig.APIPackages,
+	}
+
+	grpcServer, err := grpcApi.NewGRPCServer(grpcServerArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = grpcServer.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	return grpcServer, nil
+}
+
 func (nr *nodeRunner) createMetrics(
 	statusCoreComponents mainFactory.StatusCoreComponentsHolder,
 	coreComponents mainFactory.CoreComponentsHolder,
@@ -980,6 +1143,7 @@ func waitForSignal(
 	healthService closing.Closer,
 	facade closing.Closer,
 	httpServer shared.UpgradeableHttpServerHandler,
+	grpcServer closing.Closer,
 	currentNode *Node,
 	goRoutinesNumberStart int,
 ) nextOperationForNode {
@@ -1004,7 +1168,7 @@ func waitForSignal(
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(healthService, facade, httpServer, currentNode, chanCloseComponents)
+		closeAllComponents(healthService, facade, httpServer, grpcServer, currentNode, chanCloseComponents)
 	}()
 
 	select {
@@ -1171,6 +1335,9 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 	statusCoreComponents mainFactory.StatusCoreComponentsHolder,
 	gasScheduleNotifier core.GasScheduleNotifier,
 	nodesCoordinator nodesCoordinator.NodesCoordinator,
+	executionTracer process.ExecutionTracer,
+	gasProfilerInstance process.GasProfiler,
+	wasmCoverageCollector process.WasmCoverageCollector,
 ) (mainFactory.ProcessComponentsHandler, error) {
 	configs := nr.configs
 	configurationPaths := nr.configs.ConfigurationPathsHolder
@@ -1278,6 +1445,9 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 		HistoryRepo:             historyRepository,
 		FlagsConfig:             *configs.FlagsConfig,
 		TxExecutionOrderHandler: txExecutionOrderHandler,
+		ExecutionTracer:         executionTracer,
+		GasProfiler:             gasProfilerInstance,
+		WasmCoverageCollector:   wasmCoverageCollector,
 	}
 	processComponentsFactory, err := processComp.NewProcessComponentsFactory(processArgs)
 	if err != nil {
@@ -1575,6 +1745,7 @@ func closeAllComponents(
 	healthService io.Closer,
 	facade mainFactory.Closer,
 	httpServer shared.UpgradeableHttpServerHandler,
+	grpcServer closing.Closer,
 	node *Node,
 	chanCloseComponents chan struct{},
 ) {
@@ -1585,6 +1756,9 @@ func closeAllComponents(
 	log.Debug("closing http server")
 	log.LogIfError(httpServer.Close())
 
+	log.Debug("closing gRPC server")
+	log.LogIfError(grpcServer.Close())
+
 	log.Debug("closing facade")
 	log.LogIfError(facade.Close())
 
@@ -161,6 +161,12 @@ func TestWaitForSignal(t *testing.T) {
 			return nil
 		},
 	}
+	grpcClosableComponent := &mock.CloserStub{
+		CloseCalled: func() error {
+			closedCalled["grpc"] = struct{}{}
+			return nil
+		},
+	}
 	internalNodeClosableComponent1 := &mock.CloserStub{
 		CloseCalled: func() error {
 			closedCalled["node closable component 1"] = struct{}{}
@@ -194,6 +200,7 @@ func TestWaitForSignal(t *testing.T) {
 			healthServiceClosableComponent,
 			facadeClosableComponent,
 			httpClosableComponent,
+			grpcClosableComponent,
 			n,
 			1,
 		)
@@ -220,6 +227,7 @@ func TestWaitForSignal(t *testing.T) {
 			healthServiceClosableComponent,
 			facadeClosableComponent,
 			httpClosableComponent,
+			grpcClosableComponent,
 			n,
 			1,
 		)
@@ -248,6 +256,7 @@ func TestWaitForSignal(t *testing.T) {
 				healthServiceClosableComponent,
 				facadeClosableComponent,
 				httpClosableComponent,
+				grpcClosableComponent,
 				n,
 				1,
 			)
@@ -288,6 +297,7 @@ func TestWaitForSignal(t *testing.T) {
 			healthServiceClosableComponent,
 			facadeClosableComponent,
 			httpClosableComponent,
+			grpcClosableComponent,
 			n,
 			1,
 		)
@@ -317,6 +327,7 @@ func TestWaitForSignal(t *testing.T) {
 			healthServiceClosableComponent,
 			facadeClosableComponent,
 			httpClosableComponent,
+			grpcClosableComponent,
 			n,
 			1,
 		)
@@ -331,7 +342,7 @@ func TestWaitForSignal(t *testing.T) {
 }
 
 func checkCloseCalledMap(tb testing.TB, closedCalled map[string]struct{}, exceptions ...string) {
-	allKeys := []string{"healthService", "facade", "http", "node closable component 1", "node closable component 2"}
+	allKeys := []string{"healthService", "facade", "http", "grpc", "node closable component 1", "node closable component 2"}
 	numKeys := 0
 	for _, key := range allKeys {
 		if contains(key, exceptions) {
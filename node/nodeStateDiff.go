@@ -0,0 +1,393 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+)
+
+// maxAccountsInStateDiff is the maximum number of accounts reported by a global state diff
+const maxAccountsInStateDiff = 1000
+
+var hexESDTKeyPrefix = hex.EncodeToString([]byte(core.ProtectedKeyPrefix + core.ESDTKeyIdentifier))
+
+// GetAccountStateDiff returns the differences of the provided account between two blocks, including its data trie and
+// its ESDT balances
+func (n *Node) GetAccountStateDiff(address string, options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffApiResponse, error) {
+	pubKey, err := n.decodeAddressToPubKey(address)
+	if err != nil {
+		return nil, err
+	}
+
+	fromOptions, toOptions, err := n.resolveStateDiffBlocks(options)
+	if err != nil {
+		return nil, err
+	}
+
+	response := newStateDiffApiResponse(fromOptions, toOptions)
+	accountDiff, err := n.computeAccountStateDiff(pubKey, fromOptions, toOptions, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if accountDiff != nil {
+		response.Accounts = append(response.Accounts, accountDiff)
+	}
+
+	return response, nil
+}
+
+// GetStateDiff walks the accounts trie at the two provided blocks and returns the differences of all the accounts that
+// were added, changed or removed in between. The walk stops after maxAccountsInStateDiff changed accounts are found.
+func (n *Node) GetStateDiff(options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffApiResponse, error) {
+	fromOptions, toOptions, err := n.resolveStateDiffBlocks(options)
+	if err != nil {
+		return nil, err
+	}
+
+	response := newStateDiffApiResponse(fromOptions, toOptions)
+	if bytes.Equal(fromOptions.BlockRootHash, toOptions.BlockRootHash) {
+		return response, nil
+	}
+
+	changedAddresses, err := n.getChangedAccounts(fromOptions.BlockRootHash, toOptions.BlockRootHash, maxAccountsInStateDiff+1, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(changedAddresses) > maxAccountsInStateDiff {
+		changedAddresses = changedAddresses[:maxAccountsInStateDiff]
+		response.Truncated = true
+	}
+
+	for _, address := range changedAddresses {
+		accountDiff, errDiff := n.computeAccountStateDiff(address, fromOptions, toOptions, ctx)
+		if errDiff != nil {
+			return nil, errDiff
+		}
+		if accountDiff != nil {
+			response.Accounts = append(response.Accounts, accountDiff)
+		}
+	}
+
+	return response, nil
+}
+
+func (n *Node) resolveStateDiffBlocks(options common.StateDiffQueryOptions) (api.AccountQueryOptions, api.AccountQueryOptions, error) {
+	fromOptions, err := n.addBlockCoordinatesToAccountQueryOptions(api.AccountQueryOptions{
+		BlockNonce: core.OptionalUint64{Value: options.FromNonce, HasValue: true},
+	})
+	if err != nil {
+		return api.AccountQueryOptions{}, api.AccountQueryOptions{}, err
+	}
+
+	toOptions, err := n.addBlockCoordinatesToAccountQueryOptions(api.AccountQueryOptions{
+		BlockNonce: core.OptionalUint64{Value: options.ToNonce, HasValue: true},
+	})
+	if err != nil {
+		return api.AccountQueryOptions{}, api.AccountQueryOptions{}, err
+	}
+
+	return fromOptions, toOptions, nil
+}
+
+func newStateDiffApiResponse(fromOptions api.AccountQueryOptions, toOptions api.AccountQueryOptions) *common.StateDiffApiResponse {
+	return &common.StateDiffApiResponse{
+		FromBlockInfo: accountQueryOptionsToApiBlockInfo(fromOptions),
+		ToBlockInfo:   accountQueryOptionsToApiBlockInfo(toOptions),
+		Accounts:      make([]*common.AccountStateDiffApiResponse, 0),
+	}
+}
+
+func accountQueryOptionsToApiBlockInfo(options api.AccountQueryOptions) api.BlockInfo {
+	return api.BlockInfo{
+		Nonce:    options.BlockNonce.Value,
+		Hash:     hex.EncodeToString(options.BlockHash),
+		RootHash: hex.EncodeToString(options.BlockRootHash),
+	}
+}
+
+// getChangedAccounts merges the leaves of the accounts trie at the two root hashes and returns, in sorted order, the
+// addresses of at most maxChangedAccounts accounts that differ. The trie leaves are produced sorted by key, so both
+// walks are stopped as soon as enough changed accounts are found
+func (n *Node) getChangedAccounts(fromRootHash []byte, toRootHash []byte, maxChangedAccounts int, ctx context.Context) ([][]byte, error) {
+	walkCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	fromLeaves, err := n.startAccountsTrieWalk(fromRootHash, walkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	toLeaves, err := n.startAccountsTrieWalk(toRootHash, walkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	changedAddresses := make([][]byte, 0)
+	fromLeaf, hasFromLeaf := <-fromLeaves.LeavesChan
+	toLeaf, hasToLeaf := <-toLeaves.LeavesChan
+	for (hasFromLeaf || hasToLeaf) && len(changedAddresses) < maxChangedAccounts {
+		compareResult := compareLeavesKeys(fromLeaf, hasFromLeaf, toLeaf, hasToLeaf)
+		switch {
+		case compareResult < 0:
+			changedAddresses = append(changedAddresses, fromLeaf.Key())
+			fromLeaf, hasFromLeaf = <-fromLeaves.LeavesChan
+		case compareResult > 0:
+			changedAddresses = append(changedAddresses, toLeaf.Key())
+			toLeaf, hasToLeaf = <-toLeaves.LeavesChan
+		default:
+			if !bytes.Equal(fromLeaf.Value(), toLeaf.Value()) {
+				changedAddresses = append(changedAddresses, fromLeaf.Key())
+			}
+			fromLeaf, hasFromLeaf = <-fromLeaves.LeavesChan
+			toLeaf, hasToLeaf = <-toLeaves.LeavesChan
+		}
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+
+	err = fromLeaves.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	err = toLeaves.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	return changedAddresses, nil
+}
+
+func (n *Node) startAccountsTrieWalk(rootHash []byte, ctx context.Context) (*common.TrieIteratorChannels, error) {
+	chLeaves := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+
+	err := n.stateComponents.AccountsAdapterAPI().GetAllLeaves(chLeaves, ctx, rootHash, parsers.NewMainTrieLeafParser())
+	if err != nil {
+		return nil, err
+	}
+
+	return chLeaves, nil
+}
+
+// compareLeavesKeys compares the keys of the two leaves, a missing leaf being greater than any existing one
+func compareLeavesKeys(fromLeaf core.KeyValueHolder, hasFromLeaf bool, toLeaf core.KeyValueHolder, hasToLeaf bool) int {
+	if !hasFromLeaf {
+		return 1
+	}
+	if !hasToLeaf {
+		return -1
+	}
+
+	return bytes.Compare(fromLeaf.Key(), toLeaf.Key())
+}
+
+func (n *Node) computeAccountStateDiff(
+	pubKey []byte,
+	fromOptions api.AccountQueryOptions,
+	toOptions api.AccountQueryOptions,
+	ctx context.Context,
+) (*common.AccountStateDiffApiResponse, error) {
+	fromAccount, fromKeys, err := n.loadAccountStateForDiff(pubKey, fromOptions, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	toAccount, toKeys, err := n.loadAccountStateForDiff(pubKey, toOptions, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if check.IfNil(fromAccount) && check.IfNil(toAccount) {
+		return nil, nil
+	}
+
+	accountDiff := &common.AccountStateDiffApiResponse{
+		Address:      n.coreComponents.AddressPubKeyConverter().SilentEncode(pubKey, log),
+		Status:       common.AccountStateChanged,
+		Fields:       computeValuesDiff(n.getAccountFields(fromAccount), n.getAccountFields(toAccount)),
+		AddedKeys:    make(map[string]string),
+		ChangedKeys:  make(map[string]*common.ValueDiffApiResponse),
+		RemovedKeys:  make(map[string]string),
+		ESDTBalances: make(map[string]*common.ValueDiffApiResponse),
+	}
+	if check.IfNil(fromAccount) {
+		accountDiff.Status = common.AccountStateAdded
+	}
+	if check.IfNil(toAccount) {
+		accountDiff.Status = common.AccountStateRemoved
+	}
+
+	for _, key := range getChangedKeys(fromKeys, toKeys) {
+		fromValue, existsInFrom := fromKeys[key]
+		toValue, existsInTo := toKeys[key]
+		switch {
+		case !existsInFrom:
+			accountDiff.AddedKeys[key] = toValue
+		case !existsInTo:
+			accountDiff.RemovedKeys[key] = fromValue
+		default:
+			accountDiff.ChangedKeys[key] = &common.ValueDiffApiResponse{From: fromValue, To: toValue}
+		}
+
+		n.addESDTBalanceDiff(accountDiff, key, fromValue, toValue)
+	}
+
+	isUnchanged := accountDiff.Status == common.AccountStateChanged &&
+		len(accountDiff.Fields) == 0 &&
+		len(accountDiff.AddedKeys)+len(accountDiff.ChangedKeys)+len(accountDiff.RemovedKeys) == 0
+	if isUnchanged {
+		return nil, nil
+	}
+
+	return accountDiff, nil
+}
+
+func (n *Node) loadAccountStateForDiff(pubKey []byte, options api.AccountQueryOptions, ctx context.Context) (state.UserAccountHandler, map[string]string, error) {
+	account, _, err := n.stateComponents.AccountsRepository().GetAccountWithBlockInfo(pubKey, options)
+	if err != nil {
+		_, isAccountNotFound := extractBlockInfoIfErrAccountNotFoundAtBlock(err)
+		if isAccountNotFound {
+			return nil, make(map[string]string), nil
+		}
+
+		return nil, nil, err
+	}
+
+	userAccount, err := n.castAccountToUserAccount(account)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if check.IfNil(userAccount.DataTrie()) {
+		return userAccount, make(map[string]string), nil
+	}
+
+	keys, err := n.getKeys(userAccount, ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, nil, ErrTrieOperationsTimeout
+	}
+
+	return userAccount, keys, nil
+}
+
+func (n *Node) getAccountFields(account state.UserAccountHandler) map[string]string {
+	if check.IfNil(account) {
+		return make(map[string]string)
+	}
+
+	ownerAddress := ""
+	if len(account.GetOwnerAddress()) > 0 {
+		ownerAddress = n.coreComponents.AddressPubKeyConverter().SilentEncode(account.GetOwnerAddress(), log)
+	}
+
+	return map[string]string{
+		"nonce":           strconv.FormatUint(account.GetNonce(), 10),
+		"balance":         bigIntToString(account.GetBalance()),
+		"developerReward": bigIntToString(account.GetDeveloperReward()),
+		"codeHash":        hex.EncodeToString(account.GetCodeHash()),
+		"codeMetadata":    hex.EncodeToString(account.GetCodeMetadata()),
+		"rootHash":        hex.EncodeToString(account.GetRootHash()),
+		"ownerAddress":    ownerAddress,
+		"username":        string(account.GetUserName()),
+	}
+}
+
+func (n *Node) addESDTBalanceDiff(accountDiff *common.AccountStateDiffApiResponse, hexKey string, fromValue string, toValue string) {
+	if len(hexKey) <= len(hexESDTKeyPrefix) || hexKey[:len(hexESDTKeyPrefix)] != hexESDTKeyPrefix {
+		return
+	}
+
+	tokenKey, err := hex.DecodeString(hexKey[len(hexESDTKeyPrefix):])
+	if err != nil {
+		return
+	}
+
+	fromBalance := n.getESDTBalanceFromHexValue(fromValue)
+	toBalance := n.getESDTBalanceFromHexValue(toValue)
+	if fromBalance == toBalance {
+		return
+	}
+
+	tokenID, nonce := common.ExtractTokenIDAndNonceFromTokenStorageKey(tokenKey)
+	tokenIdentifier := string(tokenID)
+	if nonce > 0 {
+		tokenIdentifier = adjustNftTokenIdentifier(tokenIdentifier, nonce)
+	}
+
+	accountDiff.ESDTBalances[tokenIdentifier] = &common.ValueDiffApiResponse{From: fromBalance, To: toBalance}
+}
+
+func (n *Node) getESDTBalanceFromHexValue(hexValue string) string {
+	value, err := hex.DecodeString(hexValue)
+	if err != nil || len(value) == 0 {
+		return "0"
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err = n.coreComponents.InternalMarshalizer().Unmarshal(esdtToken, value)
+	if err != nil {
+		log.Debug("cannot unmarshal esdt data for state diff", "error", err)
+		return "0"
+	}
+
+	return bigIntToString(esdtToken.Value)
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+func computeValuesDiff(from map[string]string, to map[string]string) map[string]*common.ValueDiffApiResponse {
+	valuesDiff := make(map[string]*common.ValueDiffApiResponse)
+	for _, key := range getChangedKeys(from, to) {
+		valuesDiff[key] = &common.ValueDiffApiResponse{From: from[key], To: to[key]}
+	}
+
+	return valuesDiff
+}
+
+// getChangedKeys returns, in sorted order, the keys that are present in only one of the maps or hold different values
+func getChangedKeys(from map[string]string, to map[string]string) []string {
+	changedKeys := make([]string, 0)
+	for key, fromValue := range from {
+		toValue, exists := to[key]
+		if !exists || toValue != fromValue {
+			changedKeys = append(changedKeys, key)
+		}
+	}
+	for key := range to {
+		_, exists := from[key]
+		if !exists {
+			changedKeys = append(changedKeys, key)
+		}
+	}
+
+	sort.Strings(changedKeys)
+
+	return changedKeys
+}
@@ -0,0 +1,338 @@
+package node_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon/dblookupext"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	stateDiffFromRootHash = []byte("fromRootHash")
+	stateDiffToRootHash   = []byte("toRootHash")
+	stateDiffAlice        = []byte("alice___________________________")
+	stateDiffBob          = []byte("bob_____________________________")
+	stateDiffCarol        = []byte("carol___________________________")
+	stateDiffDave         = []byte("dave____________________________")
+	stateDiffESDTKey      = []byte(core.ProtectedKeyPrefix + core.ESDTKeyIdentifier + "TKN-abcdef")
+)
+
+func createAccountWithDataTrieLeaves(t *testing.T, address []byte, balance int64, leaves map[string][]byte) state.UserAccountHandler {
+	acc := createAcc(address)
+	_ = acc.AddToBalance(big.NewInt(balance))
+	acc.SetDataTrie(
+		&trieMock.TrieStub{
+			GetAllLeavesOnChannelCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, _ common.KeyBuilder, tlp common.TrieLeafParser) error {
+				go func() {
+					for key, value := range leaves {
+						suffix := append([]byte(key), address...)
+						trieLeaf, err := tlp.ParseLeaf([]byte(key), append(value, suffix...), core.NotSpecified)
+						require.Nil(t, err)
+						leavesChannels.LeavesChan <- trieLeaf
+					}
+					close(leavesChannels.LeavesChan)
+					leavesChannels.ErrChan.Close()
+				}()
+
+				return nil
+			},
+			RootCalled: func() ([]byte, error) {
+				return nil, nil
+			},
+		})
+
+	return acc
+}
+
+func createNodeForStateDiff(t *testing.T, accountsAtRootHash map[string]map[string]state.UserAccountHandler) *node.Node {
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.AddrPubKeyConv = createMockPubkeyConverter()
+
+	chainStorerMock := genericMocks.NewChainStorerMock(0)
+	rootHashes := [][]byte{stateDiffFromRootHash, stateDiffToRootHash}
+	for i, rootHash := range rootHashes {
+		nonce := uint64(i + 1)
+		headerBytes, _ := coreComponents.InternalMarshalizer().Marshal(&block.Header{Nonce: nonce, RootHash: rootHash})
+		headerHash := []byte("hash" + string(rootHash))
+		_ = chainStorerMock.BlockHeaders.Put(headerHash, headerBytes)
+		_ = chainStorerMock.ShardHdrNonce.Put(coreComponents.Uint64ByteSliceConverter().ToByteSlice(nonce), headerHash)
+	}
+	dataComponents := getDefaultDataComponents()
+	dataComponents.Store = chainStorerMock
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+		IsEnabledCalled: func() bool {
+			return false
+		},
+	}
+
+	accountsAPI := &stateMock.AccountsStub{
+		GetAllLeavesCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
+			go sendSortedAccountsLeaves(leavesChannels, ctx, accountsAtRootHash[string(rootHash)])
+
+			return nil
+		},
+	}
+
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = accountsAPI
+	stateComponents.AccountsRepo = &stateMock.AccountsRepositoryStub{
+		GetAccountWithBlockInfoCalled: func(pubkey []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			blockInfo := holders.NewBlockInfo(nil, options.BlockNonce.Value, options.BlockRootHash)
+			account, found := accountsAtRootHash[string(options.BlockRootHash)][string(pubkey)]
+			if !found {
+				return nil, nil, state.NewErrAccountNotFoundAtBlock(blockInfo)
+			}
+
+			return account, blockInfo, nil
+		},
+	}
+
+	n, err := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithProcessComponents(processComponents),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+// sendSortedAccountsLeaves mimics the trie walk, which produces the leaves sorted by key and stops once the context is done
+func sendSortedAccountsLeaves(leavesChannels *common.TrieIteratorChannels, ctx context.Context, accounts map[string]state.UserAccountHandler) {
+	defer func() {
+		close(leavesChannels.LeavesChan)
+		leavesChannels.ErrChan.Close()
+	}()
+
+	addresses := make([]string, 0, len(accounts))
+	for address := range accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		account := accounts[address]
+		// the account root hash stands in for the serialized account, so unchanged accounts share the leaf value
+		leafValue := append(account.GetBalance().Bytes(), account.GetRootHash()...)
+		select {
+		case leavesChannels.LeavesChan <- keyValStorage.NewKeyValStorage([]byte(address), leafValue):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func marshalESDTForStateDiff(value int64) []byte {
+	esdtData := &esdt.ESDigitalToken{Value: big.NewInt(value)}
+	esdtDataBytes, _ := getMarshalizer().Marshal(esdtData)
+
+	return esdtDataBytes
+}
+
+func createAccountsForStateDiff(t *testing.T) map[string]map[string]state.UserAccountHandler {
+	return map[string]map[string]state.UserAccountHandler{
+		string(stateDiffFromRootHash): {
+			string(stateDiffAlice): createAccountWithDataTrieLeaves(t, stateDiffAlice, 100, map[string][]byte{
+				"key1":                   []byte("value1"),
+				"key2":                   []byte("value2"),
+				"key3":                   []byte("value3"),
+				string(stateDiffESDTKey): marshalESDTForStateDiff(10),
+			}),
+			string(stateDiffBob):  createAccountWithDataTrieLeaves(t, stateDiffBob, 5, nil),
+			string(stateDiffDave): createAccountWithDataTrieLeaves(t, stateDiffDave, 7, nil),
+		},
+		string(stateDiffToRootHash): {
+			string(stateDiffAlice): createAccountWithDataTrieLeaves(t, stateDiffAlice, 150, map[string][]byte{
+				"key1":                   []byte("value1"),
+				"key2":                   []byte("value2 changed"),
+				"key4":                   []byte("value4"),
+				string(stateDiffESDTKey): marshalESDTForStateDiff(25),
+			}),
+			string(stateDiffCarol): createAccountWithDataTrieLeaves(t, stateDiffCarol, 3, nil),
+			string(stateDiffDave):  createAccountWithDataTrieLeaves(t, stateDiffDave, 7, nil),
+		},
+	}
+}
+
+func TestNode_GetAccountStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(t, createAccountsForStateDiff(t))
+		response, err := n.GetAccountStateDiff("not a hex address", common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 2}, context.Background())
+		require.Error(t, err)
+		require.Nil(t, response)
+	})
+	t.Run("missing block should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(t, createAccountsForStateDiff(t))
+		response, err := n.GetAccountStateDiff(hex.EncodeToString(stateDiffAlice), common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 3}, context.Background())
+		require.Error(t, err)
+		require.Nil(t, response)
+	})
+	t.Run("account loading error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		n := createNodeForStateDiff(t, createAccountsForStateDiff(t))
+		n.GetStateComponents().AccountsRepository().(*stateMock.AccountsRepositoryStub).GetAccountWithBlockInfoCalled = func(pubkey []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			return nil, nil, expectedErr
+		}
+
+		response, err := n.GetAccountStateDiff(hex.EncodeToString(stateDiffAlice), common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 2}, context.Background())
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, response)
+	})
+	t.Run("changed account should report fields, keys and ESDT balances", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(t, createAccountsForStateDiff(t))
+		response, err := n.GetAccountStateDiff(hex.EncodeToString(stateDiffAlice), common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 2}, context.Background())
+		require.Nil(t, err)
+		require.Equal(t, uint64(1), response.FromBlockInfo.Nonce)
+		require.Equal(t, hex.EncodeToString(stateDiffFromRootHash), response.FromBlockInfo.RootHash)
+		require.Equal(t, uint64(2), response.ToBlockInfo.Nonce)
+		require.Equal(t, hex.EncodeToString(stateDiffToRootHash), response.ToBlockInfo.RootHash)
+		require.Len(t, response.Accounts, 1)
+
+		accountDiff := response.Accounts[0]
+		require.Equal(t, hex.EncodeToString(stateDiffAlice), accountDiff.Address)
+		require.Equal(t, common.AccountStateChanged, accountDiff.Status)
+		require.Equal(t, map[string]*common.ValueDiffApiResponse{"balance": {From: "100", To: "150"}}, accountDiff.Fields)
+		require.Equal(t, map[string]string{hex.EncodeToString([]byte("key4")): hex.EncodeToString([]byte("value4"))}, accountDiff.AddedKeys)
+		require.Equal(t, map[string]string{hex.EncodeToString([]byte("key3")): hex.EncodeToString([]byte("value3"))}, accountDiff.RemovedKeys)
+		require.Len(t, accountDiff.ChangedKeys, 2)
+		require.Equal(t, &common.ValueDiffApiResponse{
+			From: hex.EncodeToString([]byte("value2")),
+			To:   hex.EncodeToString([]byte("value2 changed")),
+		}, accountDiff.ChangedKeys[hex.EncodeToString([]byte("key2"))])
+		require.Equal(t, map[string]*common.ValueDiffApiResponse{"TKN-abcdef": {From: "10", To: "25"}}, accountDiff.ESDTBalances)
+	})
+	t.Run("added account should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(t, createAccountsForStateDiff(t))
+		response, err := n.GetAccountStateDiff(hex.EncodeToString(stateDiffCarol), common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 2}, context.Background())
+		require.Nil(t, err)
+		require.Len(t, response.Accounts, 1)
+		require.Equal(t, common.AccountStateAdded, response.Accounts[0].Status)
+		require.Equal(t, &common.ValueDiffApiResponse{From: "", To: "3"}, response.Accounts[0].Fields["balance"])
+	})
+	t.Run("unchanged account should not be reported", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(t, createAccountsForStateDiff(t))
+		response, err := n.GetAccountStateDiff(hex.EncodeToString(stateDiffDave), common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 2}, context.Background())
+		require.Nil(t, err)
+		require.Empty(t, response.Accounts)
+	})
+}
+
+func TestNode_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("trie iteration error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		n := createNodeForStateDiff(t, createAccountsForStateDiff(t))
+		n.GetStateComponents().AccountsAdapterAPI().(*stateMock.AccountsStub).GetAllLeavesCalled = func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
+			return expectedErr
+		}
+
+		response, err := n.GetStateDiff(common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 2}, context.Background())
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, response)
+	})
+	t.Run("same block should return no accounts", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(t, createAccountsForStateDiff(t))
+		response, err := n.GetStateDiff(common.StateDiffQueryOptions{FromNonce: 2, ToNonce: 2}, context.Background())
+		require.Nil(t, err)
+		require.Empty(t, response.Accounts)
+		require.False(t, response.Truncated)
+	})
+	t.Run("should report added, changed and removed accounts", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(t, createAccountsForStateDiff(t))
+		response, err := n.GetStateDiff(common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 2}, context.Background())
+		require.Nil(t, err)
+		require.False(t, response.Truncated)
+		require.Len(t, response.Accounts, 3)
+
+		// accounts are sorted by their public key
+		require.Equal(t, hex.EncodeToString(stateDiffAlice), response.Accounts[0].Address)
+		require.Equal(t, common.AccountStateChanged, response.Accounts[0].Status)
+		require.Equal(t, hex.EncodeToString(stateDiffBob), response.Accounts[1].Address)
+		require.Equal(t, common.AccountStateRemoved, response.Accounts[1].Status)
+		require.Equal(t, hex.EncodeToString(stateDiffCarol), response.Accounts[2].Address)
+		require.Equal(t, common.AccountStateAdded, response.Accounts[2].Status)
+	})
+	t.Run("should stop walking the tries after the maximum number of changed accounts", func(t *testing.T) {
+		t.Parallel()
+
+		numAccounts := 1500
+		toAccounts := make(map[string]state.UserAccountHandler, numAccounts)
+		for i := 0; i < numAccounts; i++ {
+			address := []byte(fmt.Sprintf("%032d", i))
+			toAccounts[string(address)] = createAccountWithDataTrieLeaves(t, address, 1, nil)
+		}
+		accounts := map[string]map[string]state.UserAccountHandler{
+			string(stateDiffToRootHash): toAccounts,
+		}
+
+		n := createNodeForStateDiff(t, accounts)
+		walksStopped := make(chan struct{}, 2)
+		n.GetStateComponents().AccountsAdapterAPI().(*stateMock.AccountsStub).GetAllLeavesCalled = func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
+			go func() {
+				sendSortedAccountsLeaves(leavesChannels, ctx, accounts[string(rootHash)])
+				if ctx.Err() != nil {
+					walksStopped <- struct{}{}
+				}
+			}()
+
+			return nil
+		}
+
+		response, err := n.GetStateDiff(common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 2}, context.Background())
+		require.Nil(t, err)
+		require.True(t, response.Truncated)
+		require.Len(t, response.Accounts, 1000)
+		require.Equal(t, hex.EncodeToString([]byte(fmt.Sprintf("%032d", 999))), response.Accounts[999].Address)
+
+		select {
+		case <-walksStopped:
+		case <-time.After(time.Second):
+			require.Fail(t, "the walk of the trie should have been stopped")
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package node
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
+)
+
+// stateSnapshotsDirectory is the directory, relative to the databases path, where the state snapshots are exported
+const stateSnapshotsDirectory = "StateSnapshots"
+
+type stateSnapshotTrie struct {
+	trieType string
+	rootHash []byte
+	exporter stateSnapshotExporter
+}
+
+// ExportStateSnapshot starts exporting, in background, the state tries of the given epoch start block in a state
+// snapshot file. The file can be used by other nodes to bootstrap without syncing the state tries from the network.
+// It returns the path of the file
+func (n *Node) ExportStateSnapshot(epoch uint32) (string, error) {
+	if epoch == 0 {
+		return "", ErrStateSnapshotForGenesis
+	}
+
+	tries, err := n.getStateSnapshotTries(epoch)
+	if err != nil {
+		return "", err
+	}
+
+	if n.isExportingStateSnapshot.SetReturningPrevious() {
+		return "", ErrStateSnapshotExportInProgress
+	}
+
+	shardID := n.bootstrapComponents.ShardCoordinator().SelfId()
+	directory := filepath.Join(n.coreComponents.PathHandler().DatabasePath(), stateSnapshotsDirectory)
+	filePath := filepath.Join(directory, fmt.Sprintf("Shard_%s_Epoch_%d.snapshot", core.GetShardIDString(shardID), epoch))
+	file, err := createStateSnapshotFile(directory, filePath)
+	if err != nil {
+		n.isExportingStateSnapshot.Reset()
+		return "", err
+	}
+
+	go n.exportStateSnapshot(file, shardID, epoch, tries)
+
+	return filePath, nil
+}
+
+func createStateSnapshotFile(directory string, filePath string) (*os.File, error) {
+	err := os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.Create(filePath)
+}
+
+func (n *Node) getStateSnapshotTries(epoch uint32) ([]*stateSnapshotTrie, error) {
+	accountsExporter, ok := n.stateComponents.AccountsAdapter().(stateSnapshotExporter)
+	if !ok {
+		return nil, ErrStateSnapshotNotSupported
+	}
+
+	metaBlock, err := n.getEpochStartMetaBlock(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	shardID := n.bootstrapComponents.ShardCoordinator().SelfId()
+	if shardID == core.MetachainShardId {
+		peerAccountsExporter, isExporter := n.stateComponents.PeerAccounts().(stateSnapshotExporter)
+		if !isExporter {
+			return nil, ErrStateSnapshotNotSupported
+		}
+
+		return []*stateSnapshotTrie{
+			{trieType: stateSnapshot.PeerTrie, rootHash: metaBlock.GetValidatorStatsRootHash(), exporter: peerAccountsExporter},
+			{trieType: stateSnapshot.AccountsTrie, rootHash: metaBlock.GetRootHash(), exporter: accountsExporter},
+		}, nil
+	}
+
+	rootHash, err := n.getShardRootHashNotarizedInEpochStart(metaBlock, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*stateSnapshotTrie{
+		{trieType: stateSnapshot.AccountsTrie, rootHash: rootHash, exporter: accountsExporter},
+	}, nil
+}
+
+func (n *Node) getEpochStartMetaBlock(epoch uint32) (*block.MetaBlock, error) {
+	storer, err := n.dataComponents.StorageService().GetStorer(dataRetriever.MetaBlockUnit)
+	if err != nil {
+		return nil, fmt.Errorf("%w for identifier MetaBlockUnit", err)
+	}
+
+	identifier := core.EpochStartIdentifier(epoch)
+	metaBlockBytes, err := storer.GetFromEpoch([]byte(identifier), epoch)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load epoch start block for epoch %d (%w)", epoch, err)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = n.coreComponents.InternalMarshalizer().Unmarshal(metaBlock, metaBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
+
+// getShardRootHashNotarizedInEpochStart returns the root hash synced by the nodes which bootstrap in the given shard
+// from the epoch start meta block
+func (n *Node) getShardRootHashNotarizedInEpochStart(metaBlock data.MetaHeaderHandler, shardID uint32) ([]byte, error) {
+	for _, shardData := range metaBlock.GetEpochStartHandler().GetLastFinalizedHeaderHandlers() {
+		if shardData.GetShardID() != shardID {
+			continue
+		}
+
+		storer, err := n.dataComponents.StorageService().GetStorer(dataRetriever.BlockHeaderUnit)
+		if err != nil {
+			return nil, fmt.Errorf("%w for identifier BlockHeaderUnit", err)
+		}
+
+		headerBytes, err := storer.SearchFirst(shardData.GetHeaderHash())
+		if err != nil {
+			return nil, fmt.Errorf("cannot load the notarized shard header %x (%w)", shardData.GetHeaderHash(), err)
+		}
+
+		header, err := process.UnmarshalShardHeader(n.coreComponents.InternalMarshalizer(), headerBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		isScheduledEnabled := n.coreComponents.EnableEpochsHandler().IsFlagEnabledInEpoch(common.ScheduledMiniBlocksFlag, header.GetEpoch())
+		if isScheduledEnabled && header.GetAdditionalData() != nil {
+			return header.GetAdditionalData().GetScheduledRootHash(), nil
+		}
+
+		return header.GetRootHash(), nil
+	}
+
+	return nil, fmt.Errorf("%w for shard %d", ErrNotarizedShardHeaderNotFound, shardID)
+}
+
+func (n *Node) exportStateSnapshot(file *os.File, shardID uint32, epoch uint32, tries []*stateSnapshotTrie) {
+	defer n.isExportingStateSnapshot.Reset()
+
+	log.Info("exporting state snapshot", "file", file.Name(), "shard", shardID, "epoch", epoch)
+
+	err := n.writeStateSnapshot(file, shardID, epoch, tries)
+	errClose := file.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err != nil {
+		log.Error("could not export state snapshot", "file", file.Name(), "error", err)
+		log.LogIfError(os.Remove(file.Name()))
+		return
+	}
+
+	log.Info("state snapshot exported", "file", file.Name(), "shard", shardID, "epoch", epoch)
+}
+
+func (n *Node) writeStateSnapshot(file *os.File, shardID uint32, epoch uint32, tries []*stateSnapshotTrie) error {
+	bufferedWriter := bufio.NewWriter(file)
+	snapshotWriter, err := stateSnapshot.NewSnapshotWriter(stateSnapshot.ArgsSnapshotWriter{
+		Writer:       bufferedWriter,
+		Hasher:       n.coreComponents.Hasher(),
+		Marshaller:   n.coreComponents.InternalMarshalizer(),
+		ShardID:      shardID,
+		Epoch:        epoch,
+		MaxChunkSize: stateSnapshot.DefaultMaxChunkSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, tr := range tries {
+		var trieWriter state.StateSnapshotWriter
+		trieWriter, err = snapshotWriter.TrieWriter(tr.trieType)
+		if err != nil {
+			return err
+		}
+
+		err = tr.exporter.ExportStateSnapshot(tr.rootHash, trieWriter)
+		if err != nil {
+			return fmt.Errorf("%w while exporting the %s trie", err, tr.trieType)
+		}
+	}
+
+	_, err = snapshotWriter.Finish()
+	if err != nil {
+		return err
+	}
+
+	return bufferedWriter.Flush()
+}
@@ -0,0 +1,230 @@
+package node_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/require"
+)
+
+const stateSnapshotTestEpoch = uint32(3)
+
+type stateSnapshotAccountsStub struct {
+	*stateMock.AccountsStub
+	ExportStateSnapshotCalled func(rootHash []byte, writer state.StateSnapshotWriter) error
+}
+
+// ExportStateSnapshot -
+func (stub *stateSnapshotAccountsStub) ExportStateSnapshot(rootHash []byte, writer state.StateSnapshotWriter) error {
+	if stub.ExportStateSnapshotCalled != nil {
+		return stub.ExportStateSnapshotCalled(rootHash, writer)
+	}
+
+	return nil
+}
+
+func createNodeForStateSnapshot(
+	t *testing.T,
+	shardID uint32,
+	databasePath string,
+	storer *genericMocks.ChainStorerMock,
+	accounts state.AccountsAdapter,
+	peerAccounts state.AccountsAdapter,
+) *node.Node {
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.Hash = &hashingMocks.HasherMock{}
+	coreComponents.PathHdl = &testscommon.PathManagerStub{
+		DatabasePathCalled: func() string {
+			return databasePath
+		},
+	}
+
+	dataComponents := getDefaultDataComponents()
+	dataComponents.Store = storer
+
+	stateComponents := getDefaultStateComponents()
+	stateComponents.Accounts = accounts
+	stateComponents.PeersAcc = peerAccounts
+
+	bootstrapComponents := getDefaultBootstrapComponents()
+	bootstrapComponents.ShCoordinator = &mock.ShardCoordinatorMock{SelfShardId: shardID}
+
+	n, err := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithBootstrapComponents(bootstrapComponents),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func putEpochStartMetaBlock(t *testing.T, storer *genericMocks.ChainStorerMock, metaBlock *block.MetaBlock) {
+	metaBlockBytes, err := getMarshalizer().Marshal(metaBlock)
+	require.Nil(t, err)
+
+	identifier := core.EpochStartIdentifier(stateSnapshotTestEpoch)
+	err = storer.Metablocks.PutInEpoch([]byte(identifier), metaBlockBytes, stateSnapshotTestEpoch)
+	require.Nil(t, err)
+}
+
+func readStateSnapshotManifest(t *testing.T, n *node.Node, filePath string) stateSnapshot.Manifest {
+	require.Eventually(t, func() bool {
+		return !n.IsExportingStateSnapshot()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	file, err := os.Open(filePath)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader, err := stateSnapshot.NewSnapshotReader(stateSnapshot.ArgsSnapshotReader{
+		Reader: file,
+		Hasher: &hashingMocks.HasherMock{},
+	})
+	require.Nil(t, err)
+
+	return reader.Manifest()
+}
+
+func TestNode_ExportStateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("genesis epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateSnapshot(t, 0, t.TempDir(), genericMocks.NewChainStorerMock(0), &stateSnapshotAccountsStub{}, nil)
+		filePath, err := n.ExportStateSnapshot(0)
+		require.Equal(t, node.ErrStateSnapshotForGenesis, err)
+		require.Empty(t, filePath)
+	})
+	t.Run("accounts adapter not supporting snapshots should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateSnapshot(t, 0, t.TempDir(), genericMocks.NewChainStorerMock(0), &stateMock.AccountsStub{}, nil)
+		filePath, err := n.ExportStateSnapshot(stateSnapshotTestEpoch)
+		require.Equal(t, node.ErrStateSnapshotNotSupported, err)
+		require.Empty(t, filePath)
+	})
+	t.Run("missing epoch start block should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateSnapshot(t, 0, t.TempDir(), genericMocks.NewChainStorerMock(0), &stateSnapshotAccountsStub{}, nil)
+		filePath, err := n.ExportStateSnapshot(stateSnapshotTestEpoch)
+		require.Error(t, err)
+		require.Empty(t, filePath)
+	})
+	t.Run("shard header not notarized should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer := genericMocks.NewChainStorerMock(0)
+		putEpochStartMetaBlock(t, storer, &block.MetaBlock{Epoch: stateSnapshotTestEpoch})
+
+		n := createNodeForStateSnapshot(t, 0, t.TempDir(), storer, &stateSnapshotAccountsStub{}, nil)
+		filePath, err := n.ExportStateSnapshot(stateSnapshotTestEpoch)
+		require.True(t, errors.Is(err, node.ErrNotarizedShardHeaderNotFound))
+		require.Empty(t, filePath)
+	})
+	t.Run("export error should remove the file", func(t *testing.T) {
+		t.Parallel()
+
+		storer := genericMocks.NewChainStorerMock(0)
+		putEpochStartMetaBlock(t, storer, &block.MetaBlock{
+			Epoch:                  stateSnapshotTestEpoch,
+			RootHash:               []byte("rootHash"),
+			ValidatorStatsRootHash: []byte("peerRootHash"),
+		})
+		accounts := &stateSnapshotAccountsStub{
+			ExportStateSnapshotCalled: func(rootHash []byte, writer state.StateSnapshotWriter) error {
+				return errors.New("expected error")
+			},
+		}
+
+		n := createNodeForStateSnapshot(t, core.MetachainShardId, t.TempDir(), storer, accounts, accounts)
+		filePath, err := n.ExportStateSnapshot(stateSnapshotTestEpoch)
+		require.Nil(t, err)
+
+		require.Eventually(t, func() bool {
+			return !n.IsExportingStateSnapshot()
+		}, 5*time.Second, 10*time.Millisecond)
+		_, err = os.Stat(filePath)
+		require.True(t, os.IsNotExist(err))
+	})
+	t.Run("metachain should export the peer and the accounts tries", func(t *testing.T) {
+		t.Parallel()
+
+		storer := genericMocks.NewChainStorerMock(0)
+		putEpochStartMetaBlock(t, storer, &block.MetaBlock{
+			Epoch:                  stateSnapshotTestEpoch,
+			RootHash:               []byte("rootHash"),
+			ValidatorStatsRootHash: []byte("peerRootHash"),
+		})
+		exportedRootHashes := make(chan []byte, 2)
+		accounts := &stateSnapshotAccountsStub{
+			ExportStateSnapshotCalled: func(rootHash []byte, writer state.StateSnapshotWriter) error {
+				exportedRootHashes <- rootHash
+				return nil
+			},
+		}
+
+		databasePath := t.TempDir()
+		n := createNodeForStateSnapshot(t, core.MetachainShardId, databasePath, storer, accounts, accounts)
+		filePath, err := n.ExportStateSnapshot(stateSnapshotTestEpoch)
+		require.Nil(t, err)
+		require.Equal(t, filepath.Join(databasePath, "StateSnapshots", "Shard_metachain_Epoch_3.snapshot"), filePath)
+
+		manifest := readStateSnapshotManifest(t, n, filePath)
+		require.Equal(t, core.MetachainShardId, manifest.ShardID)
+		require.Equal(t, stateSnapshotTestEpoch, manifest.Epoch)
+		require.Equal(t, []byte("peerRootHash"), <-exportedRootHashes)
+		require.Equal(t, []byte("rootHash"), <-exportedRootHashes)
+	})
+	t.Run("shard should export the root hash of the notarized header", func(t *testing.T) {
+		t.Parallel()
+
+		storer := genericMocks.NewChainStorerMock(0)
+		headerHash := []byte("headerHash")
+		headerBytes, _ := getMarshalizer().Marshal(&block.Header{ShardID: 1, Epoch: stateSnapshotTestEpoch, RootHash: []byte("shardRootHash")})
+		_ = storer.BlockHeaders.Put(headerHash, headerBytes)
+		putEpochStartMetaBlock(t, storer, &block.MetaBlock{
+			Epoch: stateSnapshotTestEpoch,
+			EpochStart: block.EpochStart{
+				LastFinalizedHeaders: []block.EpochStartShardData{
+					{ShardID: 0, HeaderHash: []byte("otherHeaderHash")},
+					{ShardID: 1, HeaderHash: headerHash},
+				},
+			},
+		})
+		var exportedRootHash []byte
+		accounts := &stateSnapshotAccountsStub{
+			ExportStateSnapshotCalled: func(rootHash []byte, writer state.StateSnapshotWriter) error {
+				exportedRootHash = rootHash
+				return nil
+			},
+		}
+
+		n := createNodeForStateSnapshot(t, 1, t.TempDir(), storer, accounts, nil)
+		filePath, err := n.ExportStateSnapshot(stateSnapshotTestEpoch)
+		require.Nil(t, err)
+
+		manifest := readStateSnapshotManifest(t, n, filePath)
+		require.Equal(t, uint32(1), manifest.ShardID)
+		require.Equal(t, []byte("shardRootHash"), exportedRootHash)
+	})
+}
@@ -0,0 +1,22 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
+)
+
+type disabledSubscriptionsHub struct{}
+
+// NewDisabledSubscriptionsHub will create a new instance of disabledSubscriptionsHub
+func NewDisabledSubscriptionsHub() *disabledSubscriptionsHub {
+	return new(disabledSubscriptionsHub)
+}
+
+// Subscribe returns ErrSubscriptionsNotEnabled
+func (n *disabledSubscriptionsHub) Subscribe(_ subscriptions.Filter) (*subscriptions.Subscription, error) {
+	return nil, subscriptions.ErrSubscriptionsNotEnabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (n *disabledSubscriptionsHub) IsInterfaceNil() bool {
+	return n == nil
+}
@@ -14,3 +14,6 @@ var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
 var errNilSaveBlockArgs = errors.New("nil save blocks args provided")
 
 var errNilHeaderAndBodyArgs = errors.New("nil header and body args provided")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
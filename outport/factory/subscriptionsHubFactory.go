@@ -0,0 +1,48 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/disabled"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
+)
+
+// SubscriptionsHubFactoryArgs defines the args needed for the subscriptions hub creation
+type SubscriptionsHubFactoryArgs struct {
+	Enabled                 bool
+	MaxSubscribers          uint32
+	NotificationsBufferSize uint32
+	Marshaller              marshal.Marshalizer
+	AddressConverter        core.PubkeyConverter
+	OutportHandler          outport.OutportHandler
+}
+
+// CreateSubscriptionsHub will create the subscriptions hub and subscribe it as an outport driver.
+// If the hub is not enabled, a disabled instance is returned
+func CreateSubscriptionsHub(args *SubscriptionsHubFactoryArgs) (outport.SubscriptionsHub, error) {
+	if !args.Enabled {
+		return disabled.NewDisabledSubscriptionsHub(), nil
+	}
+	if check.IfNil(args.OutportHandler) {
+		return nil, outport.ErrNilOutportHandler
+	}
+
+	hub, err := subscriptions.NewSubscriptionsHub(subscriptions.ArgsSubscriptionsHub{
+		Marshaller:              args.Marshaller,
+		AddressConverter:        args.AddressConverter,
+		MaxSubscribers:          args.MaxSubscribers,
+		NotificationsBufferSize: args.NotificationsBufferSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = args.OutportHandler.SubscribeDriver(hub)
+	if err != nil {
+		return nil, err
+	}
+
+	return hub, nil
+}
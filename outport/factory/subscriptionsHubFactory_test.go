@@ -0,0 +1,85 @@
+package factory_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	outportStub "github.com/multiversx/mx-chain-go/testscommon/outport"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockSubscriptionsHubFactoryArgs() *factory.SubscriptionsHubFactoryArgs {
+	return &factory.SubscriptionsHubFactoryArgs{
+		Enabled:                 true,
+		MaxSubscribers:          10,
+		NotificationsBufferSize: 100,
+		Marshaller:              &marshallerMock.MarshalizerMock{},
+		AddressConverter:        testscommon.RealWorldBech32PubkeyConverter,
+		OutportHandler:          &outportStub.OutportStub{},
+	}
+}
+
+func TestCreateSubscriptionsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should return disabled hub", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionsHubFactoryArgs()
+		args.Enabled = false
+		args.OutportHandler = &outportStub.OutportStub{
+			SubscribeDriverCalled: func(driver outport.Driver) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		hub, err := factory.CreateSubscriptionsHub(args)
+		require.Nil(t, err)
+
+		subscription, err := hub.Subscribe(subscriptions.Filter{})
+		require.Nil(t, subscription)
+		require.Equal(t, subscriptions.ErrSubscriptionsNotEnabled, err)
+	})
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionsHubFactoryArgs()
+		args.OutportHandler = nil
+
+		hub, err := factory.CreateSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, outport.ErrNilOutportHandler, err)
+	})
+	t.Run("invalid hub arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionsHubFactoryArgs()
+		args.MaxSubscribers = 0
+
+		hub, err := factory.CreateSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, subscriptions.ErrInvalidMaxSubscribers, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var subscribedDriver outport.Driver
+		args := createMockSubscriptionsHubFactoryArgs()
+		args.OutportHandler = &outportStub.OutportStub{
+			SubscribeDriverCalled: func(driver outport.Driver) error {
+				subscribedDriver = driver
+				return nil
+			},
+		}
+
+		hub, err := factory.CreateSubscriptionsHub(args)
+		require.Nil(t, err)
+		require.NotNil(t, hub)
+		require.Equal(t, hub, subscribedDriver)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/outport/process"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
 )
 
 // Driver is an interface for saving node specific data to other storage.
@@ -44,3 +45,9 @@ type DataProviderOutport interface {
 	PrepareOutportSaveBlockData(arg process.ArgPrepareOutportSaveBlockData) (*outportcore.OutportBlockWithHeaderAndBody, error)
 	IsInterfaceNil() bool
 }
+
+// SubscriptionsHub defines what a component able to register subscribers for node notifications should be able to do
+type SubscriptionsHub interface {
+	Subscribe(filter subscriptions.Filter) (*subscriptions.Subscription, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,29 @@
+package subscriptions
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidNotificationsBufferSize signals that an invalid notifications buffer size has been provided
+var ErrInvalidNotificationsBufferSize = errors.New("invalid notifications buffer size")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrUnknownNotificationType signals that an unknown notification type has been provided
+var ErrUnknownNotificationType = errors.New("unknown notification type")
+
+// ErrSubscriptionsNotEnabled signals that the subscriptions hub is not enabled
+var ErrSubscriptionsNotEnabled = errors.New("subscriptions are not enabled on this node")
+
+// ErrHubClosed signals that the subscriptions hub has been closed
+var ErrHubClosed = errors.New("subscriptions hub is closed")
+
+var errNilBlockData = errors.New("nil block data")
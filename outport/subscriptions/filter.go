@@ -0,0 +1,101 @@
+package subscriptions
+
+// Filter holds the criteria used to select the notifications pushed to a subscriber. Empty fields match everything.
+// Addresses are matched against the sender and the receiver of transactions and against the address of events,
+// while identifiers and topics are only applied on events
+type Filter struct {
+	Types       []NotificationType
+	Addresses   []string
+	Identifiers []string
+	Topics      [][]byte
+}
+
+type compiledFilter struct {
+	types       map[NotificationType]struct{}
+	addresses   map[string]struct{}
+	identifiers map[string]struct{}
+	topics      map[string]struct{}
+}
+
+func newCompiledFilter(filter Filter) *compiledFilter {
+	cf := &compiledFilter{
+		types:       make(map[NotificationType]struct{}, len(filter.Types)),
+		addresses:   make(map[string]struct{}, len(filter.Addresses)),
+		identifiers: make(map[string]struct{}, len(filter.Identifiers)),
+		topics:      make(map[string]struct{}, len(filter.Topics)),
+	}
+
+	for _, notificationType := range filter.Types {
+		cf.types[notificationType] = struct{}{}
+	}
+	for _, address := range filter.Addresses {
+		cf.addresses[address] = struct{}{}
+	}
+	for _, identifier := range filter.Identifiers {
+		cf.identifiers[identifier] = struct{}{}
+	}
+	for _, topic := range filter.Topics {
+		cf.topics[string(topic)] = struct{}{}
+	}
+
+	return cf
+}
+
+func (cf *compiledFilter) matches(notification *Notification) bool {
+	if !cf.matchesType(notification.Type) {
+		return false
+	}
+
+	switch notification.Type {
+	case TransactionNotificationType:
+		return cf.matchesAddress(notification.Transaction.Sender) || cf.matchesAddress(notification.Transaction.Receiver)
+	case EventNotificationType:
+		return cf.matchesEvent(notification.Event)
+	default:
+		return true
+	}
+}
+
+func (cf *compiledFilter) matchesType(notificationType NotificationType) bool {
+	if len(cf.types) == 0 {
+		return true
+	}
+
+	_, found := cf.types[notificationType]
+	return found
+}
+
+func (cf *compiledFilter) matchesAddress(address string) bool {
+	if len(cf.addresses) == 0 {
+		return true
+	}
+
+	_, found := cf.addresses[address]
+	return found
+}
+
+func (cf *compiledFilter) matchesEvent(event *EventNotification) bool {
+	if !cf.matchesAddress(event.Address) {
+		return false
+	}
+
+	if len(cf.identifiers) > 0 {
+		_, found := cf.identifiers[event.Identifier]
+		if !found {
+			return false
+		}
+	}
+
+	if len(cf.topics) == 0 {
+		return true
+	}
+
+	for _, topic := range event.Topics {
+		_, found := cf.topics[string(topic)]
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,11 @@
+package subscriptions
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+)
+
+type blockCreatorsContainer interface {
+	Add(headerType core.HeaderType, creator block.EmptyBlockCreator) error
+	Get(headerType core.HeaderType) (block.EmptyBlockCreator, error)
+}
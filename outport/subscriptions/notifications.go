@@ -0,0 +1,95 @@
+package subscriptions
+
+import (
+	"fmt"
+)
+
+// NotificationType defines the kind of notification pushed to subscribers
+type NotificationType string
+
+const (
+	// BlockNotificationType is used when a block has been committed
+	BlockNotificationType NotificationType = "block"
+	// FinalizedBlockNotificationType is used when a block has been finalized
+	FinalizedBlockNotificationType NotificationType = "finalizedBlock"
+	// RevertedBlockNotificationType is used when a committed block has been reverted
+	RevertedBlockNotificationType NotificationType = "revertedBlock"
+	// TransactionNotificationType is used when the status of a transaction changed
+	TransactionNotificationType NotificationType = "transaction"
+	// EventNotificationType is used for each smart contract event generated in a committed block
+	EventNotificationType NotificationType = "event"
+)
+
+var allNotificationTypes = []NotificationType{
+	BlockNotificationType,
+	FinalizedBlockNotificationType,
+	RevertedBlockNotificationType,
+	TransactionNotificationType,
+	EventNotificationType,
+}
+
+// TransactionStage defines the lifecycle stage of a transaction reported to subscribers
+type TransactionStage string
+
+const (
+	// TransactionIncluded signals that the transaction was included in a committed block
+	TransactionIncluded TransactionStage = "included"
+	// TransactionFinalized signals that the block holding the transaction was finalized
+	TransactionFinalized TransactionStage = "finalized"
+	// TransactionReverted signals that the block holding the transaction was reverted
+	TransactionReverted TransactionStage = "reverted"
+)
+
+// Notification is the message pushed to subscribers
+type Notification struct {
+	Type        NotificationType         `json:"type"`
+	Block       *BlockNotification       `json:"block,omitempty"`
+	Transaction *TransactionNotification `json:"transaction,omitempty"`
+	Event       *EventNotification       `json:"event,omitempty"`
+}
+
+// BlockNotification holds the block data pushed to subscribers
+type BlockNotification struct {
+	Hash      string `json:"hash"`
+	ShardID   uint32 `json:"shard"`
+	Nonce     uint64 `json:"nonce,omitempty"`
+	Round     uint64 `json:"round,omitempty"`
+	Epoch     uint32 `json:"epoch,omitempty"`
+	Timestamp uint64 `json:"timestamp,omitempty"`
+	NumTxs    uint32 `json:"numTxs,omitempty"`
+}
+
+// TransactionNotification holds the transaction data pushed to subscribers
+type TransactionNotification struct {
+	Hash      string           `json:"hash"`
+	BlockHash string           `json:"blockHash"`
+	Sender    string           `json:"sender"`
+	Receiver  string           `json:"receiver"`
+	Nonce     uint64           `json:"nonce"`
+	Value     string           `json:"value"`
+	Status    string           `json:"status"`
+	Stage     TransactionStage `json:"stage"`
+	GasUsed   uint64           `json:"gasUsed,omitempty"`
+	Fee       string           `json:"fee,omitempty"`
+}
+
+// EventNotification holds the smart contract event data pushed to subscribers
+type EventNotification struct {
+	TxHash     string   `json:"txHash"`
+	BlockHash  string   `json:"blockHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+// ParseNotificationType converts the provided string into a NotificationType
+func ParseNotificationType(value string) (NotificationType, error) {
+	for _, notificationType := range allNotificationTypes {
+		if string(notificationType) == value {
+			return notificationType, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrUnknownNotificationType, value)
+}
@@ -0,0 +1,68 @@
+package subscriptions
+
+import "sync"
+
+type unsubscribeHandler interface {
+	unsubscribe(id uint64)
+}
+
+// Subscription is the handle returned to a subscriber. Notifications are delivered on the channel returned by
+// Notifications until the subscription is closed, either by the subscriber or by the hub
+type Subscription struct {
+	id              uint64
+	filter          *compiledFilter
+	chNotifications chan *Notification
+	chDone          chan struct{}
+	closeOnce       sync.Once
+	hub             unsubscribeHandler
+}
+
+func newSubscription(id uint64, filter Filter, bufferSize uint32, hub unsubscribeHandler) *Subscription {
+	return &Subscription{
+		id:              id,
+		filter:          newCompiledFilter(filter),
+		chNotifications: make(chan *Notification, bufferSize),
+		chDone:          make(chan struct{}),
+		hub:             hub,
+	}
+}
+
+// ID returns the unique identifier of the subscription
+func (s *Subscription) ID() uint64 {
+	return s.id
+}
+
+// Notifications returns the channel on which the notifications are delivered
+func (s *Subscription) Notifications() <-chan *Notification {
+	return s.chNotifications
+}
+
+// Done returns a channel that is closed when the subscription ends
+func (s *Subscription) Done() <-chan struct{} {
+	return s.chDone
+}
+
+// Close will unregister the subscription from the hub
+func (s *Subscription) Close() {
+	s.hub.unsubscribe(s.id)
+}
+
+// tryPush returns false if the subscriber cannot keep up with the notifications rate
+func (s *Subscription) tryPush(notification *Notification) bool {
+	if !s.filter.matches(notification) {
+		return true
+	}
+
+	select {
+	case s.chNotifications <- notification:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Subscription) markDone() {
+	s.closeOnce.Do(func() {
+		close(s.chDone)
+	})
+}
@@ -0,0 +1,484 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/subscriptions")
+
+// maxTrackedBlocks bounds the number of not yet finalized blocks for which the included transactions are remembered
+const maxTrackedBlocks = 1000
+
+// ArgsSubscriptionsHub defines the arguments needed for the subscriptions hub creation
+type ArgsSubscriptionsHub struct {
+	Marshaller              marshal.Marshalizer
+	AddressConverter        core.PubkeyConverter
+	MaxSubscribers          uint32
+	NotificationsBufferSize uint32
+}
+
+type subscriptionsHub struct {
+	marshaller              marshal.Marshalizer
+	addressConverter        core.PubkeyConverter
+	blockCreators           blockCreatorsContainer
+	maxSubscribers          uint32
+	notificationsBufferSize uint32
+
+	mutSubscribers sync.RWMutex
+	subscribers    map[uint64]*Subscription
+	lastID         uint64
+	closed         bool
+
+	mutTrackedBlocks    sync.Mutex
+	trackedBlocksOrder  []string
+	trackedTransactions map[string][]*TransactionNotification
+}
+
+// NewSubscriptionsHub creates an outport driver that pushes block, transaction and event notifications
+// to the registered subscribers
+func NewSubscriptionsHub(args ArgsSubscriptionsHub) (*subscriptionsHub, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	blockCreators, err := createBlockCreatorsContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionsHub{
+		marshaller:              args.Marshaller,
+		addressConverter:        args.AddressConverter,
+		blockCreators:           blockCreators,
+		maxSubscribers:          args.MaxSubscribers,
+		notificationsBufferSize: args.NotificationsBufferSize,
+		subscribers:             make(map[uint64]*Subscription),
+		trackedBlocksOrder:      make([]string, 0, maxTrackedBlocks),
+		trackedTransactions:     make(map[string][]*TransactionNotification),
+	}, nil
+}
+
+func checkArgs(args ArgsSubscriptionsHub) error {
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.AddressConverter) {
+		return ErrNilAddressConverter
+	}
+	if args.MaxSubscribers == 0 {
+		return ErrInvalidMaxSubscribers
+	}
+	if args.NotificationsBufferSize == 0 {
+		return ErrInvalidNotificationsBufferSize
+	}
+
+	return nil
+}
+
+func createBlockCreatorsContainer() (blockCreatorsContainer, error) {
+	container := block.NewEmptyBlockCreatorsContainer()
+	err := container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.ShardHeaderV2, block.NewEmptyHeaderV2Creator())
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.MetaHeader, block.NewEmptyMetaBlockCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	return container, nil
+}
+
+// Subscribe registers a new subscriber which will receive all the notifications matching the provided filter
+func (hub *subscriptionsHub) Subscribe(filter Filter) (*Subscription, error) {
+	hub.mutSubscribers.Lock()
+	defer hub.mutSubscribers.Unlock()
+
+	if hub.closed {
+		return nil, ErrHubClosed
+	}
+	if uint32(len(hub.subscribers)) >= hub.maxSubscribers {
+		return nil, fmt.Errorf("%w, maximum: %d", ErrTooManySubscribers, hub.maxSubscribers)
+	}
+
+	hub.lastID++
+	subscription := newSubscription(hub.lastID, filter, hub.notificationsBufferSize, hub)
+	hub.subscribers[subscription.id] = subscription
+
+	log.Debug("subscriptionsHub: new subscriber", "id", subscription.id, "num subscribers", len(hub.subscribers))
+
+	return subscription, nil
+}
+
+func (hub *subscriptionsHub) unsubscribe(id uint64) {
+	hub.mutSubscribers.Lock()
+	defer hub.mutSubscribers.Unlock()
+
+	hub.removeSubscriberUnprotected(id)
+}
+
+func (hub *subscriptionsHub) removeSubscriberUnprotected(id uint64) {
+	subscription, found := hub.subscribers[id]
+	if !found {
+		return
+	}
+
+	delete(hub.subscribers, id)
+	subscription.markDone()
+
+	log.Debug("subscriptionsHub: removed subscriber", "id", id, "num subscribers", len(hub.subscribers))
+}
+
+// NumSubscribers returns the number of active subscribers
+func (hub *subscriptionsHub) NumSubscribers() int {
+	hub.mutSubscribers.RLock()
+	defer hub.mutSubscribers.RUnlock()
+
+	return len(hub.subscribers)
+}
+
+func (hub *subscriptionsHub) broadcast(notifications []*Notification) {
+	if len(notifications) == 0 {
+		return
+	}
+
+	hub.mutSubscribers.Lock()
+	defer hub.mutSubscribers.Unlock()
+
+	for id, subscription := range hub.subscribers {
+		for _, notification := range notifications {
+			if !subscription.tryPush(notification) {
+				log.Debug("subscriptionsHub: subscriber cannot keep up, dropping it", "id", id)
+				hub.removeSubscriberUnprotected(id)
+				break
+			}
+		}
+	}
+}
+
+// SaveBlock pushes the block, its transactions and its events to the subscribers
+func (hub *subscriptionsHub) SaveBlock(outportBlock *outport.OutportBlock) error {
+	if outportBlock == nil || outportBlock.BlockData == nil {
+		log.Warn("subscriptionsHub.SaveBlock", "error", errNilBlockData)
+		return nil
+	}
+
+	header, err := hub.getHeader(outportBlock.BlockData)
+	if err != nil {
+		// returning the error would make the outport retry forever on a block that can not be decoded
+		log.Warn("subscriptionsHub.SaveBlock: cannot decode header", "error", err)
+		return nil
+	}
+
+	blockHash := hex.EncodeToString(outportBlock.BlockData.HeaderHash)
+	transactions := hub.createTransactionNotifications(blockHash, outportBlock.TransactionPool)
+	hub.trackTransactions(blockHash, transactions)
+
+	notifications := make([]*Notification, 0, 1+len(transactions))
+	notifications = append(notifications, &Notification{
+		Type:  BlockNotificationType,
+		Block: createBlockNotification(blockHash, header),
+	})
+	for _, tx := range transactions {
+		notifications = append(notifications, &Notification{
+			Type:        TransactionNotificationType,
+			Transaction: tx,
+		})
+	}
+	notifications = append(notifications, hub.createEventNotifications(blockHash, outportBlock.TransactionPool)...)
+
+	hub.broadcast(notifications)
+
+	return nil
+}
+
+// RevertIndexedBlock notifies the subscribers about the reverted block and its transactions
+func (hub *subscriptionsHub) RevertIndexedBlock(blockData *outport.BlockData) error {
+	if blockData == nil {
+		log.Warn("subscriptionsHub.RevertIndexedBlock", "error", errNilBlockData)
+		return nil
+	}
+
+	header, err := hub.getHeader(blockData)
+	if err != nil {
+		log.Warn("subscriptionsHub.RevertIndexedBlock: cannot decode header", "error", err)
+		return nil
+	}
+
+	blockHash := hex.EncodeToString(blockData.HeaderHash)
+	notifications := []*Notification{
+		{
+			Type:  RevertedBlockNotificationType,
+			Block: createBlockNotification(blockHash, header),
+		},
+	}
+	notifications = append(notifications, hub.createStageNotifications(blockHash, TransactionReverted)...)
+
+	hub.broadcast(notifications)
+
+	return nil
+}
+
+// FinalizedBlock notifies the subscribers about the finalized block and its transactions
+func (hub *subscriptionsHub) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	if finalizedBlock == nil {
+		log.Warn("subscriptionsHub.FinalizedBlock", "error", errNilBlockData)
+		return nil
+	}
+
+	blockHash := hex.EncodeToString(finalizedBlock.HeaderHash)
+	notifications := []*Notification{
+		{
+			Type: FinalizedBlockNotificationType,
+			Block: &BlockNotification{
+				Hash:    blockHash,
+				ShardID: finalizedBlock.ShardID,
+			},
+		},
+	}
+	notifications = append(notifications, hub.createStageNotifications(blockHash, TransactionFinalized)...)
+
+	hub.broadcast(notifications)
+
+	return nil
+}
+
+func (hub *subscriptionsHub) getHeader(blockData *outport.BlockData) (data.HeaderHandler, error) {
+	creator, err := hub.blockCreators.Get(core.HeaderType(blockData.HeaderType))
+	if err != nil {
+		return nil, err
+	}
+
+	return block.GetHeaderFromBytes(hub.marshaller, creator, blockData.HeaderBytes)
+}
+
+func createBlockNotification(blockHash string, header data.HeaderHandler) *BlockNotification {
+	return &BlockNotification{
+		Hash:      blockHash,
+		ShardID:   header.GetShardID(),
+		Nonce:     header.GetNonce(),
+		Round:     header.GetRound(),
+		Epoch:     header.GetEpoch(),
+		Timestamp: header.GetTimeStamp(),
+		NumTxs:    header.GetTxCount(),
+	}
+}
+
+func (hub *subscriptionsHub) createTransactionNotifications(blockHash string, pool *outport.TransactionPool) []*TransactionNotification {
+	if pool == nil {
+		return nil
+	}
+
+	txs := make([]*TransactionNotification, 0, len(pool.Transactions)+len(pool.InvalidTxs))
+	txs = append(txs, hub.convertTransactions(blockHash, pool.Transactions, transaction.TxStatusSuccess)...)
+	txs = append(txs, hub.convertTransactions(blockHash, pool.InvalidTxs, transaction.TxStatusInvalid)...)
+
+	return txs
+}
+
+func (hub *subscriptionsHub) convertTransactions(
+	blockHash string,
+	txsInfo map[string]*outport.TxInfo,
+	status transaction.TxStatus,
+) []*TransactionNotification {
+	hashes := make([]string, 0, len(txsInfo))
+	for txHash, txInfo := range txsInfo {
+		if txInfo == nil || txInfo.Transaction == nil {
+			continue
+		}
+		hashes = append(hashes, txHash)
+	}
+
+	sort.Slice(hashes, func(i, j int) bool {
+		return txsInfo[hashes[i]].ExecutionOrder < txsInfo[hashes[j]].ExecutionOrder
+	})
+
+	txs := make([]*TransactionNotification, 0, len(hashes))
+	for _, txHash := range hashes {
+		txInfo := txsInfo[txHash]
+		tx := txInfo.Transaction
+		txNotification := &TransactionNotification{
+			Hash:      txHash,
+			BlockHash: blockHash,
+			Sender:    hub.addressConverter.SilentEncode(tx.SndAddr, log),
+			Receiver:  hub.addressConverter.SilentEncode(tx.RcvAddr, log),
+			Nonce:     tx.Nonce,
+			Value:     bigIntToString(tx.Value),
+			Status:    string(status),
+			Stage:     TransactionIncluded,
+		}
+		if txInfo.FeeInfo != nil {
+			txNotification.GasUsed = txInfo.FeeInfo.GasUsed
+			txNotification.Fee = bigIntToString(txInfo.FeeInfo.Fee)
+		}
+
+		txs = append(txs, txNotification)
+	}
+
+	return txs
+}
+
+func (hub *subscriptionsHub) createEventNotifications(blockHash string, pool *outport.TransactionPool) []*Notification {
+	if pool == nil {
+		return nil
+	}
+
+	notifications := make([]*Notification, 0)
+	for _, logData := range pool.Logs {
+		if logData == nil || logData.Log == nil {
+			continue
+		}
+
+		for _, event := range logData.Log.Events {
+			if event == nil {
+				continue
+			}
+
+			notifications = append(notifications, &Notification{
+				Type: EventNotificationType,
+				Event: &EventNotification{
+					TxHash:     logData.TxHash,
+					BlockHash:  blockHash,
+					Address:    hub.addressConverter.SilentEncode(event.Address, log),
+					Identifier: string(event.Identifier),
+					Topics:     event.Topics,
+					Data:       event.Data,
+				},
+			})
+		}
+	}
+
+	return notifications
+}
+
+func (hub *subscriptionsHub) trackTransactions(blockHash string, txs []*TransactionNotification) {
+	hub.mutTrackedBlocks.Lock()
+	defer hub.mutTrackedBlocks.Unlock()
+
+	_, alreadyTracked := hub.trackedTransactions[blockHash]
+	if !alreadyTracked {
+		if len(hub.trackedBlocksOrder) >= maxTrackedBlocks {
+			oldest := hub.trackedBlocksOrder[0]
+			hub.trackedBlocksOrder = hub.trackedBlocksOrder[1:]
+			delete(hub.trackedTransactions, oldest)
+		}
+		hub.trackedBlocksOrder = append(hub.trackedBlocksOrder, blockHash)
+	}
+
+	hub.trackedTransactions[blockHash] = txs
+}
+
+func (hub *subscriptionsHub) untrackTransactions(blockHash string) []*TransactionNotification {
+	hub.mutTrackedBlocks.Lock()
+	defer hub.mutTrackedBlocks.Unlock()
+
+	txs, found := hub.trackedTransactions[blockHash]
+	if !found {
+		return nil
+	}
+
+	delete(hub.trackedTransactions, blockHash)
+	for idx, hash := range hub.trackedBlocksOrder {
+		if hash == blockHash {
+			hub.trackedBlocksOrder = append(hub.trackedBlocksOrder[:idx], hub.trackedBlocksOrder[idx+1:]...)
+			break
+		}
+	}
+
+	return txs
+}
+
+func (hub *subscriptionsHub) createStageNotifications(blockHash string, stage TransactionStage) []*Notification {
+	txs := hub.untrackTransactions(blockHash)
+
+	notifications := make([]*Notification, 0, len(txs))
+	for _, tx := range txs {
+		txCopy := *tx
+		txCopy.Stage = stage
+		notifications = append(notifications, &Notification{
+			Type:        TransactionNotificationType,
+			Transaction: &txCopy,
+		})
+	}
+
+	return notifications
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// SaveRoundsInfo does nothing
+func (hub *subscriptionsHub) SaveRoundsInfo(_ *outport.RoundsInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys does nothing
+func (hub *subscriptionsHub) SaveValidatorsPubKeys(_ *outport.ValidatorsPubKeys) error {
+	return nil
+}
+
+// SaveValidatorsRating does nothing
+func (hub *subscriptionsHub) SaveValidatorsRating(_ *outport.ValidatorsRating) error {
+	return nil
+}
+
+// SaveAccounts does nothing
+func (hub *subscriptionsHub) SaveAccounts(_ *outport.Accounts) error {
+	return nil
+}
+
+// GetMarshaller returns the marshaller used to decode the block headers
+func (hub *subscriptionsHub) GetMarshaller() marshal.Marshalizer {
+	return hub.marshaller
+}
+
+// SetCurrentSettings does nothing
+func (hub *subscriptionsHub) SetCurrentSettings(_ outport.OutportConfig) error {
+	return nil
+}
+
+// RegisterHandler does nothing
+func (hub *subscriptionsHub) RegisterHandler(_ func() error, _ string) error {
+	return nil
+}
+
+// Close will end all the active subscriptions
+func (hub *subscriptionsHub) Close() error {
+	hub.mutSubscribers.Lock()
+	defer hub.mutSubscribers.Unlock()
+
+	hub.closed = true
+	for id := range hub.subscribers {
+		hub.removeSubscriberUnprotected(id)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hub *subscriptionsHub) IsInterfaceNil() bool {
+	return hub == nil
+}
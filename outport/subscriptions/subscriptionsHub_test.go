@@ -0,0 +1,355 @@
+package subscriptions_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	headerHash = []byte("header hash")
+	txHash     = hex.EncodeToString([]byte("tx hash"))
+)
+
+func createMockArgsSubscriptionsHub() subscriptions.ArgsSubscriptionsHub {
+	return subscriptions.ArgsSubscriptionsHub{
+		Marshaller:              &marshallerMock.MarshalizerMock{},
+		AddressConverter:        testscommon.RealWorldBech32PubkeyConverter,
+		MaxSubscribers:          2,
+		NotificationsBufferSize: 100,
+	}
+}
+
+func createOutportBlock(t *testing.T, marshaller *marshallerMock.MarshalizerMock) *outport.OutportBlock {
+	header := &block.Header{
+		Nonce:   10,
+		Round:   11,
+		Epoch:   1,
+		ShardID: 0,
+		TxCount: 1,
+	}
+	headerBytes, err := marshaller.Marshal(header)
+	require.Nil(t, err)
+
+	return &outport.OutportBlock{
+		BlockData: &outport.BlockData{
+			HeaderBytes: headerBytes,
+			HeaderType:  string(core.ShardHeaderV1),
+			HeaderHash:  headerHash,
+		},
+		TransactionPool: &outport.TransactionPool{
+			Transactions: map[string]*outport.TxInfo{
+				txHash: {
+					Transaction: &transaction.Transaction{
+						Nonce:   5,
+						Value:   big.NewInt(100),
+						SndAddr: testscommon.TestPubKeyAlice,
+						RcvAddr: testscommon.TestPubKeyBob,
+					},
+					FeeInfo: &outport.FeeInfo{
+						GasUsed: 50000,
+						Fee:     big.NewInt(1000),
+					},
+				},
+			},
+			Logs: []*outport.LogData{
+				{
+					TxHash: txHash,
+					Log: &transaction.Log{
+						Events: []*transaction.Event{
+							{
+								Address:    testscommon.TestPubKeyBob,
+								Identifier: []byte("ESDTTransfer"),
+								Topics:     [][]byte{[]byte("TKN-123456")},
+							},
+							{
+								Address:    testscommon.TestPubKeyBob,
+								Identifier: []byte("completedTxEvent"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readNotifications(t *testing.T, subscription *subscriptions.Subscription, num int) []*subscriptions.Notification {
+	notifications := make([]*subscriptions.Notification, 0, num)
+	for i := 0; i < num; i++ {
+		select {
+		case notification := <-subscription.Notifications():
+			notifications = append(notifications, notification)
+		case <-time.After(time.Second):
+			require.Fail(t, "timeout while waiting for notifications")
+		}
+	}
+
+	select {
+	case notification := <-subscription.Notifications():
+		require.Fail(t, "unexpected notification", "type", notification.Type)
+	default:
+	}
+
+	return notifications
+}
+
+func TestNewSubscriptionsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.Marshaller = nil
+
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, subscriptions.ErrNilMarshaller, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.AddressConverter = nil
+
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, subscriptions.ErrNilAddressConverter, err)
+	})
+	t.Run("invalid max subscribers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.MaxSubscribers = 0
+
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, subscriptions.ErrInvalidMaxSubscribers, err)
+	})
+	t.Run("invalid notifications buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.NotificationsBufferSize = 0
+
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, subscriptions.ErrInvalidNotificationsBufferSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		require.Nil(t, err)
+		require.False(t, hub.IsInterfaceNil())
+	})
+}
+
+func TestSubscriptionsHub_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("too many subscribers should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		_, _ = hub.Subscribe(subscriptions.Filter{})
+		_, _ = hub.Subscribe(subscriptions.Filter{})
+
+		subscription, err := hub.Subscribe(subscriptions.Filter{})
+		require.Nil(t, subscription)
+		require.True(t, errors.Is(err, subscriptions.ErrTooManySubscribers))
+	})
+	t.Run("closed subscription should free a slot", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		subscription, _ := hub.Subscribe(subscriptions.Filter{})
+		_, _ = hub.Subscribe(subscriptions.Filter{})
+		require.Equal(t, 2, hub.NumSubscribers())
+
+		subscription.Close()
+		require.Equal(t, 1, hub.NumSubscribers())
+		select {
+		case <-subscription.Done():
+		default:
+			require.Fail(t, "subscription should have been marked as done")
+		}
+
+		_, err := hub.Subscribe(subscriptions.Filter{})
+		require.Nil(t, err)
+	})
+	t.Run("closed hub should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		subscription, _ := hub.Subscribe(subscriptions.Filter{})
+
+		err := hub.Close()
+		require.Nil(t, err)
+		<-subscription.Done()
+
+		subscription, err = hub.Subscribe(subscriptions.Filter{})
+		require.Nil(t, subscription)
+		require.Equal(t, subscriptions.ErrHubClosed, err)
+	})
+}
+
+func TestSubscriptionsHub_SaveBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid block data should not error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		require.Nil(t, hub.SaveBlock(nil))
+		require.Nil(t, hub.SaveBlock(&outport.OutportBlock{}))
+		require.Nil(t, hub.SaveBlock(&outport.OutportBlock{BlockData: &outport.BlockData{HeaderType: "unknown"}}))
+	})
+	t.Run("empty filter should receive everything", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		hub, _ := subscriptions.NewSubscriptionsHub(args)
+		subscription, _ := hub.Subscribe(subscriptions.Filter{})
+
+		err := hub.SaveBlock(createOutportBlock(t, args.Marshaller.(*marshallerMock.MarshalizerMock)))
+		require.Nil(t, err)
+
+		notifications := readNotifications(t, subscription, 4)
+		require.Equal(t, subscriptions.BlockNotificationType, notifications[0].Type)
+		require.Equal(t, &subscriptions.BlockNotification{
+			Hash:    hex.EncodeToString(headerHash),
+			Nonce:   10,
+			Round:   11,
+			Epoch:   1,
+			ShardID: 0,
+			NumTxs:  1,
+		}, notifications[0].Block)
+
+		require.Equal(t, subscriptions.TransactionNotificationType, notifications[1].Type)
+		require.Equal(t, &subscriptions.TransactionNotification{
+			Hash:      txHash,
+			BlockHash: hex.EncodeToString(headerHash),
+			Sender:    testscommon.TestAddressAlice,
+			Receiver:  testscommon.TestAddressBob,
+			Nonce:     5,
+			Value:     "100",
+			Status:    string(transaction.TxStatusSuccess),
+			Stage:     subscriptions.TransactionIncluded,
+			GasUsed:   50000,
+			Fee:       "1000",
+		}, notifications[1].Transaction)
+
+		require.Equal(t, subscriptions.EventNotificationType, notifications[2].Type)
+		require.Equal(t, "ESDTTransfer", notifications[2].Event.Identifier)
+		require.Equal(t, testscommon.TestAddressBob, notifications[2].Event.Address)
+		require.Equal(t, subscriptions.EventNotificationType, notifications[3].Type)
+		require.Equal(t, "completedTxEvent", notifications[3].Event.Identifier)
+	})
+	t.Run("filter should apply", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		hub, _ := subscriptions.NewSubscriptionsHub(args)
+		eventsSubscription, _ := hub.Subscribe(subscriptions.Filter{
+			Types:  []subscriptions.NotificationType{subscriptions.EventNotificationType},
+			Topics: [][]byte{[]byte("TKN-123456")},
+		})
+		txsSubscription, _ := hub.Subscribe(subscriptions.Filter{
+			Types:     []subscriptions.NotificationType{subscriptions.TransactionNotificationType},
+			Addresses: []string{testscommon.TestAddressAlice},
+		})
+
+		err := hub.SaveBlock(createOutportBlock(t, args.Marshaller.(*marshallerMock.MarshalizerMock)))
+		require.Nil(t, err)
+
+		notifications := readNotifications(t, eventsSubscription, 1)
+		require.Equal(t, "ESDTTransfer", notifications[0].Event.Identifier)
+
+		notifications = readNotifications(t, txsSubscription, 1)
+		require.Equal(t, txHash, notifications[0].Transaction.Hash)
+	})
+	t.Run("slow subscriber should be dropped", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.NotificationsBufferSize = 1
+		hub, _ := subscriptions.NewSubscriptionsHub(args)
+		subscription, _ := hub.Subscribe(subscriptions.Filter{})
+
+		err := hub.SaveBlock(createOutportBlock(t, args.Marshaller.(*marshallerMock.MarshalizerMock)))
+		require.Nil(t, err)
+
+		<-subscription.Done()
+		require.Equal(t, 0, hub.NumSubscribers())
+	})
+}
+
+func TestSubscriptionsHub_FinalizedAndRevertedBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("finalized block should update the transactions stage", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		hub, _ := subscriptions.NewSubscriptionsHub(args)
+		subscription, _ := hub.Subscribe(subscriptions.Filter{
+			Types: []subscriptions.NotificationType{
+				subscriptions.FinalizedBlockNotificationType,
+				subscriptions.TransactionNotificationType,
+			},
+		})
+
+		_ = hub.SaveBlock(createOutportBlock(t, args.Marshaller.(*marshallerMock.MarshalizerMock)))
+		notifications := readNotifications(t, subscription, 1)
+		require.Equal(t, subscriptions.TransactionIncluded, notifications[0].Transaction.Stage)
+
+		err := hub.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: headerHash})
+		require.Nil(t, err)
+
+		notifications = readNotifications(t, subscription, 2)
+		require.Equal(t, subscriptions.FinalizedBlockNotificationType, notifications[0].Type)
+		require.Equal(t, hex.EncodeToString(headerHash), notifications[0].Block.Hash)
+		require.Equal(t, subscriptions.TransactionFinalized, notifications[1].Transaction.Stage)
+
+		// the transactions of a block are reported as finalized only once
+		_ = hub.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: headerHash})
+		_ = readNotifications(t, subscription, 1)
+	})
+	t.Run("reverted block should update the transactions stage", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		hub, _ := subscriptions.NewSubscriptionsHub(args)
+		subscription, _ := hub.Subscribe(subscriptions.Filter{
+			Types: []subscriptions.NotificationType{
+				subscriptions.RevertedBlockNotificationType,
+				subscriptions.TransactionNotificationType,
+			},
+		})
+
+		outportBlock := createOutportBlock(t, args.Marshaller.(*marshallerMock.MarshalizerMock))
+		_ = hub.SaveBlock(outportBlock)
+		_ = readNotifications(t, subscription, 1)
+
+		err := hub.RevertIndexedBlock(outportBlock.BlockData)
+		require.Nil(t, err)
+
+		notifications := readNotifications(t, subscription, 2)
+		require.Equal(t, subscriptions.RevertedBlockNotificationType, notifications[0].Type)
+		require.Equal(t, uint64(10), notifications[0].Block.Nonce)
+		require.Equal(t, subscriptions.TransactionReverted, notifications[1].Transaction.Stage)
+	})
+}
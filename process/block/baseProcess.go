@@ -266,6 +266,10 @@ func (bp *baseProcessor) verifyStateRoot(rootHash []byte) bool {
 }
 
 // getRootHash returns the accounts merkle tree root hash
+func (bp *baseProcessor) saveBlockProcessingDuration(startTime time.Time) {
+	bp.appStatusHandler.SetUInt64Value(common.MetricBlockProcessingDurationUs, uint64(time.Since(startTime).Microseconds()))
+}
+
 func (bp *baseProcessor) getRootHash() []byte {
 	rootHash, err := bp.accountsDB[state.UserAccountsState].RootHash()
 	if err != nil {
@@ -204,6 +204,8 @@ func (mp *metaProcessor) ProcessBlock(
 	mp.processStatusHandler.SetBusy("metaProcessor.ProcessBlock")
 	defer mp.processStatusHandler.SetIdle()
 
+	processingStartTime := time.Now()
+
 	err := mp.checkBlockValidity(headerHandler, bodyHandler)
 	if err != nil {
 		if errors.Is(err, process.ErrBlockHashDoesNotMatch) {
@@ -427,6 +429,8 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
+	mp.saveBlockProcessingDuration(processingStartTime)
+
 	return nil
 }
 
@@ -175,6 +175,8 @@ func (sp *shardProcessor) ProcessBlock(
 	sp.processStatusHandler.SetBusy("shardProcessor.ProcessBlock")
 	defer sp.processStatusHandler.SetIdle()
 
+	processingStartTime := time.Now()
+
 	err := sp.checkBlockValidity(headerHandler, bodyHandler)
 	if err != nil {
 		if errors.Is(err, process.ErrBlockHashDoesNotMatch) {
@@ -378,6 +380,8 @@ func (sp *shardProcessor) ProcessBlock(
 		return err
 	}
 
+	sp.saveBlockProcessingDuration(processingStartTime)
+
 	return nil
 }
 
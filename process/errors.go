@@ -987,6 +987,9 @@ var ErrNegativeBalanceDeltaOnCrossShardAccount = errors.New("negative balance de
 // ErrNilOrEmptyList signals that a nil or empty list was provided
 var ErrNilOrEmptyList = errors.New("nil or empty provided list")
 
+// ErrNilStateViewsPool signals that a nil state views pool was provided
+var ErrNilStateViewsPool = errors.New("nil state views pool")
+
 // ErrNilScQueryElement signals that a nil sc query service element was provided
 var ErrNilScQueryElement = errors.New("nil SC query service element")
 
@@ -1047,6 +1050,12 @@ var ErrNodeIsNotSynced = errors.New("node is not synced")
 // ErrStateChangedWhileExecutingVmQuery signals that the state has been changed while executing a vm query and the request required not to
 var ErrStateChangedWhileExecutingVmQuery = errors.New("state changed while executing vm query")
 
+// ErrNilSCQuery signals that a nil smart contract query has been provided
+var ErrNilSCQuery = errors.New("nil smart contract query")
+
+// ErrDifferentBlockCoordinatesInQueries signals that the queries of a batch do not target the same block
+var ErrDifferentBlockCoordinatesInQueries = errors.New("all queries in a batch should target the same block")
+
 // ErrNilEnableRoundsHandler signals a nil enable rounds handler has been provided
 var ErrNilEnableRoundsHandler = errors.New("nil enable rounds handler has been provided")
 
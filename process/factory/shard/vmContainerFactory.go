@@ -18,6 +18,7 @@ import (
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	wasmVMHost15 "github.com/multiversx/mx-chain-vm-go/vmhost/hostCore"
+	"github.com/multiversx/mx-chain-vm-go/wasmer2"
 	wasmvm12 "github.com/multiversx/mx-chain-vm-v1_2-go/vmhost"
 	wasmVMHost12 "github.com/multiversx/mx-chain-vm-v1_2-go/vmhost/hostCore"
 	wasmvm13 "github.com/multiversx/mx-chain-vm-v1_3-go/vmhost"
@@ -45,6 +46,7 @@ type vmContainerFactory struct {
 	esdtTransferParser  vmcommon.ESDTTransferParser
 	hasher              hashing.Hasher
 	pubKeyConverter     core.PubkeyConverter
+	coverageCollector   process.WasmCoverageCollector
 
 	mapOpcodeAddressIsAllowed map[string]map[string]struct{}
 }
@@ -64,6 +66,8 @@ type ArgVMContainerFactory struct {
 	BlockChainHook      process.BlockChainHookWithAccountsAdapter
 	Hasher              hashing.Hasher
 	PubKeyConverter     core.PubkeyConverter
+	// WasmCoverageCollector is optional, the contracts run by the Wasm VM 1.5 are instrumented to record their coverage only if set
+	WasmCoverageCollector process.WasmCoverageCollector
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
@@ -112,6 +116,7 @@ func NewVMContainerFactory(args ArgVMContainerFactory) (*vmContainerFactory, err
 		esdtTransferParser:  args.ESDTTransferParser,
 		hasher:              args.Hasher,
 		pubKeyConverter:     args.PubKeyConverter,
+		coverageCollector:   args.WasmCoverageCollector,
 	}
 
 	vmf.wasmVMVersions = args.Config.WasmVMVersions
@@ -388,6 +393,13 @@ func (vmf *vmContainerFactory) createInProcessWasmVMV15() (vmcommon.VMExecutionH
 		Hasher:                              vmf.hasher,
 		MapOpcodeAddressIsAllowed:           vmf.mapOpcodeAddressIsAllowed,
 	}
+	if !check.IfNil(vmf.coverageCollector) {
+		executorFactory, err := vmf.coverageCollector.CreateExecutorFactory(wasmer2.ExecutorFactory(), vmf.hasher)
+		if err != nil {
+			return nil, err
+		}
+		hostParameters.OverrideVMExecutor = executorFactory
+	}
 
 	return wasmVMHost15.NewVMHost(vmf.blockChainHook, hostParameters)
 }
@@ -1,12 +1,14 @@
 package shard
 
 import (
+	"errors"
 	"runtime"
 	"sync"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-go/common/forking"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
@@ -19,6 +21,7 @@ import (
 	vmcommonBuiltInFunctions "github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
 	"github.com/multiversx/mx-chain-vm-common-go/parsers"
 	wasmConfig "github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/executor"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -208,6 +211,54 @@ func TestVmContainerFactory_Create(t *testing.T) {
 	assert.Equal(t, len(vmf.mapOpcodeAddressIsAllowed[managedMultiTransferESDTNFTExecuteByUser]), 1)
 }
 
+func TestVmContainerFactory_CreateWithWasmCoverageCollector(t *testing.T) {
+	if runtime.GOARCH == "arm64" {
+		t.Skip("skipping test on arm64")
+	}
+
+	t.Run("create executor factory error should error", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		args := createMockVMAccountsArguments()
+		args.Config.WasmVMVersions = []config.WasmVMVersionByEpoch{{StartEpoch: 0, Version: "v1.5"}}
+		args.WasmCoverageCollector = &testscommon.WasmCoverageCollectorStub{
+			CreateExecutorFactoryCalled: func(executorFactory executor.ExecutorAbstractFactory, hasher hashing.Hasher) (executor.ExecutorAbstractFactory, error) {
+				return nil, expectedErr
+			},
+		}
+		vmf, _ := NewVMContainerFactory(args)
+		require.NotNil(t, vmf)
+
+		container, err := vmf.Create()
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, container)
+	})
+	t.Run("should work", func(t *testing.T) {
+		args := createMockVMAccountsArguments()
+		args.Config.WasmVMVersions = []config.WasmVMVersionByEpoch{{StartEpoch: 0, Version: "v1.5"}}
+		wasCalled := false
+		args.WasmCoverageCollector = &testscommon.WasmCoverageCollectorStub{
+			CreateExecutorFactoryCalled: func(executorFactory executor.ExecutorAbstractFactory, hasher hashing.Hasher) (executor.ExecutorAbstractFactory, error) {
+				wasCalled = true
+				require.Equal(t, args.Hasher, hasher)
+				return executorFactory, nil
+			},
+		}
+		vmf, _ := NewVMContainerFactory(args)
+		require.NotNil(t, vmf)
+
+		container, err := vmf.Create()
+		require.Nil(t, err)
+		defer func() {
+			_ = container.Close()
+		}()
+
+		vm, err := container.Get(factory.WasmVirtualMachine)
+		require.Nil(t, err)
+		require.Equal(t, "v1.5", vm.GetVersion())
+		require.True(t, wasCalled)
+	})
+}
+
 func TestVmContainerFactory_ResolveWasmVMVersion(t *testing.T) {
 	if runtime.GOARCH == "arm64" {
 		t.Skip("skipping test on arm64")
@@ -22,6 +22,7 @@ import (
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/multiversx/mx-chain-vm-go/executor"
 
 	"github.com/multiversx/mx-chain-go/common"
 	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
@@ -310,6 +311,44 @@ type TransactionLogProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// TracedExecution holds the shard and the block in which a smart contract execution was traced
+type TracedExecution struct {
+	ShardID    uint32
+	BlockNonce uint64
+	BlockRound uint64
+}
+
+// ExecutionTracer defines the component able to record the call frames of the smart contract executions
+type ExecutionTracer interface {
+	TraceCall(execution TracedExecution, input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error)
+	TraceBuiltInFunctionCall(execution TracedExecution, input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error)
+	TraceDeploy(execution TracedExecution, txHash []byte, input *vmcommon.ContractCreateInput, output *vmcommon.VMOutput, err error)
+	IsInterfaceNil() bool
+}
+
+// ProfiledExecution holds the gas used, the fee and the refund of a transaction executed by a smart contract
+type ProfiledExecution struct {
+	TxHash   []byte
+	Contract []byte
+	Endpoint string
+	IsFailed bool
+	GasUsed  uint64
+	Fee      *big.Int
+	Refund   *big.Int
+}
+
+// GasProfiler defines the component able to aggregate the gas used, the fees and the refunds of the smart contract executions
+type GasProfiler interface {
+	ProfileExecution(execution ProfiledExecution)
+	IsInterfaceNil() bool
+}
+
+// WasmCoverageCollector defines the component able to record the functions and branches executed by the Wasm VM for each contract code
+type WasmCoverageCollector interface {
+	CreateExecutorFactory(executorFactory executor.ExecutorAbstractFactory, hasher hashing.Hasher) (executor.ExecutorAbstractFactory, error)
+	IsInterfaceNil() bool
+}
+
 // TransactionLogProcessorDatabase is interface the  for saving logs also in RAM
 type TransactionLogProcessorDatabase interface {
 	GetLogFromCache(txHash []byte) (*data.LogData, bool)
@@ -815,6 +854,15 @@ type SCQuery struct {
 	ShouldBeSynced bool
 	BlockNonce     core.OptionalUint64
 	BlockHash      []byte
+	BlockRootHash  []byte
+	HintEpoch      core.OptionalUint32
+	OnFinalBlock   bool
+}
+
+// SCQueryResult holds the outcome of a single smart contract query executed as part of a batch
+type SCQueryResult struct {
+	VMOutput *vmcommon.VMOutput
+	Err      error
 }
 
 // GasHandler is able to perform some gas calculation
@@ -964,11 +1012,19 @@ type PeerValidatorMapper interface {
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteQueries(queries []*SCQuery) ([]*SCQueryResult, common.BlockInfo, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
 }
 
+// SCQueryStateView defines a smart contract query service owning an independent state, that can be pinned to the block requested by a query
+type SCQueryStateView interface {
+	SCQueryService
+	ResolveStateRootHash(query *SCQuery) ([]byte, error)
+	PinState(query *SCQuery) (bool, error)
+}
+
 // EpochStartDataCreator defines the functionality for node to create epoch start data
 type EpochStartDataCreator interface {
 	CreateEpochStartData() (*block.EpochStart, error)
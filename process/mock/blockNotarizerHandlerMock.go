@@ -2,6 +2,7 @@ package mock
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/process/track"
 )
 
 // BlockNotarizerHandlerMock -
@@ -16,6 +17,7 @@ type BlockNotarizerHandlerMock struct {
 	InitNotarizedHeadersCalled               func(startHeaders map[uint32]data.HeaderHandler) error
 	RemoveLastNotarizedHeaderCalled          func()
 	RestoreNotarizedHeadersToGenesisCalled   func()
+	RestoreNotarizedHeadersCalled            func(notarizedHeaders map[uint32][]*track.HeaderInfo)
 }
 
 // AddNotarizedHeader -
@@ -98,6 +100,13 @@ func (bngm *BlockNotarizerHandlerMock) RestoreNotarizedHeadersToGenesis() {
 	}
 }
 
+// RestoreNotarizedHeaders -
+func (bngm *BlockNotarizerHandlerMock) RestoreNotarizedHeaders(notarizedHeaders map[uint32][]*track.HeaderInfo) {
+	if bngm.RestoreNotarizedHeadersCalled != nil {
+		bngm.RestoreNotarizedHeadersCalled(notarizedHeaders)
+	}
+}
+
 // IsInterfaceNil -
 func (bngm *BlockNotarizerHandlerMock) IsInterfaceNil() bool {
 	return bngm == nil
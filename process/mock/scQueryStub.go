@@ -10,8 +10,11 @@ import (
 // ScQueryStub -
 type ScQueryStub struct {
 	ExecuteQueryCalled           func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteQueriesCalled         func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
 	ComputeScCallGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	CloseCalled                  func() error
+	ResolveStateRootHashCalled   func(query *process.SCQuery) ([]byte, error)
+	PinStateCalled               func(query *process.SCQuery) (bool, error)
 }
 
 // ExecuteQuery -
@@ -22,6 +25,14 @@ func (s *ScQueryStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput,
 	return &vmcommon.VMOutput{}, nil, nil
 }
 
+// ExecuteQueries -
+func (s *ScQueryStub) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if s.ExecuteQueriesCalled != nil {
+		return s.ExecuteQueriesCalled(queries)
+	}
+	return make([]*process.SCQueryResult, 0), nil, nil
+}
+
 // ComputeScCallGasLimit -
 func (s *ScQueryStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	if s.ComputeScCallGasLimitHandler != nil {
@@ -30,6 +41,22 @@ func (s *ScQueryStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64
 	return 100, nil
 }
 
+// ResolveStateRootHash -
+func (s *ScQueryStub) ResolveStateRootHash(query *process.SCQuery) ([]byte, error) {
+	if s.ResolveStateRootHashCalled != nil {
+		return s.ResolveStateRootHashCalled(query)
+	}
+	return nil, nil
+}
+
+// PinState -
+func (s *ScQueryStub) PinState(query *process.SCQuery) (bool, error) {
+	if s.PinStateCalled != nil {
+		return s.PinStateCalled(query)
+	}
+	return false, nil
+}
+
 // Close -
 func (s *ScQueryStub) Close() error {
 	if s.CloseCalled != nil {
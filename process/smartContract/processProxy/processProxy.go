@@ -72,6 +72,8 @@ func NewSmartContractProcessorProxy(args scrCommon.ArgsNewSmartContractProcessor
 			EnableEpochs:        args.EnableEpochs,
 			VMOutputCacher:      args.VMOutputCacher,
 			WasmVMChangeLocker:  args.WasmVMChangeLocker,
+			ExecutionTracer:     args.ExecutionTracer,
+			GasProfiler:         args.GasProfiler,
 			IsGenesisProcessing: args.IsGenesisProcessing,
 		},
 	}
@@ -50,6 +50,8 @@ func NewTestSmartContractProcessorProxy(args scrCommon.ArgsNewSmartContractProce
 			EnableEpochs:        args.EnableEpochs,
 			VMOutputCacher:      args.VMOutputCacher,
 			WasmVMChangeLocker:  args.WasmVMChangeLocker,
+			ExecutionTracer:     args.ExecutionTracer,
+			GasProfiler:         args.GasProfiler,
 			IsGenesisProcessing: args.IsGenesisProcessing,
 		},
 	}
@@ -25,9 +25,11 @@ import (
 	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
 
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/debug/gasProfiler"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -87,6 +89,8 @@ type scProcessor struct {
 	mutGasLock          sync.RWMutex
 	txLogsProcessor     process.TransactionLogProcessor
 	vmOutputCacher      storage.Cacher
+	executionTracer     process.ExecutionTracer
+	gasProfiler         process.GasProfiler
 	isGenesisProcessing bool
 
 	executableCheckers    map[string]scrCommon.ExecutableChecker
@@ -181,6 +185,15 @@ func NewSmartContractProcessorV2(args scrCommon.ArgsNewSmartContractProcessor) (
 		return nil, process.ErrNilBuiltInFunction
 	}
 
+	executionTracer := args.ExecutionTracer
+	if check.IfNil(executionTracer) {
+		executionTracer = tracing.NewDisabledExecutionTracer()
+	}
+	gasProfilerInstance := args.GasProfiler
+	if check.IfNil(gasProfilerInstance) {
+		gasProfilerInstance = gasProfiler.NewDisabledGasProfiler()
+	}
+
 	builtInFuncCost := args.GasSchedule.LatestGasSchedule()[common.BuiltInCost]
 	baseOperationCost := args.GasSchedule.LatestGasSchedule()[common.BaseOperationCost]
 	sc := &scProcessor{
@@ -204,6 +217,8 @@ func NewSmartContractProcessorV2(args scrCommon.ArgsNewSmartContractProcessor) (
 		isGenesisProcessing: args.IsGenesisProcessing,
 		arwenChangeLocker:   args.WasmVMChangeLocker,
 		vmOutputCacher:      args.VMOutputCacher,
+		executionTracer:     executionTracer,
+		gasProfiler:         gasProfilerInstance,
 		enableEpochsHandler: args.EnableEpochsHandler,
 		storePerByte:        baseOperationCost["StorePerByte"],
 		persistPerByte:      baseOperationCost["PersistPerByte"],
@@ -424,6 +439,7 @@ func (sc *scProcessor) executeSmartContractCall(
 
 	var vmOutput *vmcommon.VMOutput
 	vmOutput, err = vmExec.RunSmartContractCall(vmInput)
+	sc.executionTracer.TraceCall(sc.tracedExecution(), vmInput, vmOutput, err)
 
 	sc.arwenChangeLocker.RUnlock()
 	if err != nil {
@@ -547,6 +563,7 @@ func (sc *scProcessor) finishSCExecution(
 	totalConsumedFee, totalDevRwd := sc.computeTotalConsumedFeeAndDevRwd(tx, vmOutput, builtInFuncGasUsed)
 	sc.txFeeHandler.ProcessTransactionFee(totalConsumedFee, totalDevRwd, txHash)
 	sc.gasHandler.SetGasRefunded(vmOutput.GasRemaining, txHash)
+	sc.profileExecution(tx, txHash, vmOutput)
 
 	sc.vmOutputCacher.Put(txHash, vmOutput, 0)
 
@@ -1170,6 +1187,7 @@ func (sc *scProcessor) resolveBuiltInFunctions(
 ) (*vmcommon.VMOutput, error) {
 
 	vmOutput, err := sc.blockChainHook.ProcessBuiltInFunction(vmInput)
+	sc.executionTracer.TraceBuiltInFunctionCall(sc.tracedExecution(), vmInput, vmOutput, err)
 	if err != nil {
 		vmOutput = &vmcommon.VMOutput{
 			ReturnCode:    vmcommon.UserError,
@@ -1525,6 +1543,7 @@ func (sc *scProcessor) processIfErrorWithAddedLogs(acntSnd state.UserAccountHand
 	}
 
 	sc.txFeeHandler.ProcessTransactionFee(consumedFee, big.NewInt(0), failureContext.txHash)
+	sc.profileExecution(tx, failureContext.txHash, nil)
 
 	err = sc.blockChainHook.SaveNFTMetaDataToSystemAccount(tx)
 	if err != nil {
@@ -1835,6 +1854,7 @@ func (sc *scProcessor) doDeploySmartContract(
 	}
 
 	vmOutput, err = vmExec.RunSmartContractCreate(vmInput)
+	sc.executionTracer.TraceDeploy(sc.tracedExecution(), txHash, vmInput, vmOutput, err)
 	sc.arwenChangeLocker.RUnlock()
 	if err != nil {
 		log.Debug("VM error", "error", err.Error())
@@ -1893,6 +1913,7 @@ func (sc *scProcessor) doDeploySmartContract(
 	sc.txFeeHandler.ProcessTransactionFee(totalConsumedFee, totalDevRwd, txHash)
 	sc.printScDeployed(vmOutput, tx)
 	sc.gasHandler.SetGasRefunded(vmOutput.GasRemaining, txHash)
+	sc.profileExecution(tx, txHash, vmOutput)
 
 	sc.vmOutputCacher.Put(txHash, vmOutput, 0)
 
@@ -3054,6 +3075,85 @@ func (sc *scProcessor) printBlockchainHookCounters(tx data.TransactionHandler) {
 	)
 }
 
+// tracedExecution returns the shard and the block in which the current smart contract execution takes place
+func (sc *scProcessor) tracedExecution() process.TracedExecution {
+	return process.TracedExecution{
+		ShardID:    sc.shardCoordinator.SelfId(),
+		BlockNonce: sc.blockChainHook.CurrentNonce(),
+		BlockRound: sc.blockChainHook.CurrentRound(),
+	}
+}
+
+// profileExecution hands the gas used, the fee and the refund of a user transaction executed by a contract of this shard
+// to the gas profiler. A nil VM output marks a failed execution, which consumed all the provided gas
+func (sc *scProcessor) profileExecution(tx data.TransactionHandler, txHash []byte, vmOutput *vmcommon.VMOutput) {
+	userTx, isUserTx := tx.(*transaction.Transaction)
+	if !isUserTx {
+		return
+	}
+
+	contract, endpoint, isContractExecution := sc.getProfiledEndpoint(userTx, vmOutput)
+	if !isContractExecution || !sc.isSelfShard(contract) {
+		return
+	}
+
+	gasUsed := userTx.GetGasLimit()
+	if vmOutput != nil && vmOutput.GasRemaining < gasUsed {
+		gasUsed -= vmOutput.GasRemaining
+	}
+
+	initiallyPaidFee := sc.economicsFee.ComputeTxFee(userTx)
+	fee := sc.economicsFee.ComputeTxFeeBasedOnGasUsed(userTx, gasUsed)
+	sc.gasProfiler.ProfileExecution(process.ProfiledExecution{
+		TxHash:   txHash,
+		Contract: contract,
+		Endpoint: endpoint,
+		IsFailed: vmOutput == nil,
+		GasUsed:  gasUsed,
+		Fee:      fee,
+		Refund:   big.NewInt(0).Sub(initiallyPaidFee, fee),
+	})
+}
+
+// getProfiledEndpoint returns the contract and the endpoint executed by the provided user transaction, which can be a
+// deployment, a direct call or a call made through an ESDT transfer
+func (sc *scProcessor) getProfiledEndpoint(tx *transaction.Transaction, vmOutput *vmcommon.VMOutput) ([]byte, string, bool) {
+	if sc.isDestAddressEmpty(tx) {
+		return getDeployedContract(tx, vmOutput), scrCommon.ProfiledDeployEndpoint, true
+	}
+
+	function, args, err := sc.argsParser.ParseCallData(string(tx.GetData()))
+	if err != nil {
+		return nil, "", false
+	}
+
+	_, err = sc.builtInFunctions.Get(function)
+	if err != nil {
+		return tx.GetRcvAddr(), function, core.IsSmartContractAddress(tx.GetRcvAddr())
+	}
+
+	parsedTransfers, err := sc.esdtTransferParser.ParseESDTTransfers(tx.GetSndAddr(), tx.GetRcvAddr(), function, args)
+	if err != nil || len(parsedTransfers.CallFunction) == 0 {
+		return nil, "", false
+	}
+
+	return parsedTransfers.RcvAddr, parsedTransfers.CallFunction, core.IsSmartContractAddress(parsedTransfers.RcvAddr)
+}
+
+func getDeployedContract(tx data.TransactionHandler, vmOutput *vmcommon.VMOutput) []byte {
+	if vmOutput == nil {
+		return tx.GetRcvAddr()
+	}
+
+	for _, account := range vmOutput.OutputAccounts {
+		if account != nil && len(account.Code) > 0 {
+			return account.Address
+		}
+	}
+
+	return tx.GetRcvAddr()
+}
+
 func (sc *scProcessor) getBlockchainHookCountersString() string {
 	counters := sc.blockChainHook.GetCounterValues()
 	keys := make([]string, len(counters))
@@ -1751,6 +1751,149 @@ func TestScProcessor_ExecuteSmartContractTransaction(t *testing.T) {
 	require.Nil(t, err)
 }
 
+// executeContractCall executes the provided transaction on a contract whose VM returns the provided output
+func executeContractCall(t *testing.T, arguments scrCommon.ArgsNewSmartContractProcessor, tx *transaction.Transaction, vmOutput *vmcommon.VMOutput) {
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return vmOutput, nil
+		},
+	}
+	accntState := &stateMock.AccountsStub{
+		RevertToSnapshotCalled: func(snapshot int) error {
+			return nil
+		},
+	}
+	arguments.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			return vm, nil
+		},
+	}
+	arguments.ArgsParser = smartContract.NewArgumentParser()
+	arguments.AccountsDB = accntState
+	sc, err := NewSmartContractProcessorV2(arguments)
+	require.Nil(t, err)
+
+	acntSrc, acntDst := createAccounts(tx)
+	accntState.LoadAccountCalled = func(address []byte) (handler vmcommon.AccountHandler, e error) {
+		return acntSrc, nil
+	}
+
+	acntDst.SetCode([]byte("code"))
+	_, _ = sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst)
+}
+
+func TestScProcessor_ExecutionTracer(t *testing.T) {
+	t.Parallel()
+
+	vmOutput := &vmcommon.VMOutput{
+		GasRefund:  big.NewInt(0),
+		ReturnCode: vmcommon.Ok,
+	}
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.BlockChainHook = &testscommon.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return 37
+		},
+		CurrentRoundCalled: func() uint64 {
+			return 38
+		},
+	}
+	numTracedCalls := 0
+	arguments.ExecutionTracer = &testscommon.ExecutionTracerStub{
+		TraceCallCalled: func(execution process.TracedExecution, input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error) {
+			numTracedCalls++
+			require.Equal(t, process.TracedExecution{ShardID: 0, BlockNonce: 37, BlockRound: 38}, execution)
+			require.Equal(t, []byte("DST0000000"), input.RecipientAddr)
+			require.Equal(t, "add", input.Function)
+			require.Equal(t, vmOutput, output)
+			require.Nil(t, err)
+		},
+	}
+
+	tx := &transaction.Transaction{
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST0000000"),
+		Data:    []byte("add@05"),
+		Value:   big.NewInt(0),
+	}
+	executeContractCall(t, arguments, tx, vmOutput)
+	require.Equal(t, 1, numTracedCalls)
+}
+
+func TestScProcessor_GasProfiler(t *testing.T) {
+	t.Parallel()
+
+	contract := append(make([]byte, 10), []byte("contract00000000000000")...)
+	executeCallWithReturnCode := func(t *testing.T, returnCode vmcommon.ReturnCode) []process.ProfiledExecution {
+		arguments := createMockSmartContractProcessorArguments()
+		arguments.EconomicsFee = &economicsmocks.EconomicsHandlerMock{
+			ComputeTxFeeCalled: func(tx data.TransactionWithFeeHandler) *big.Int {
+				return core.SafeMul(tx.GetGasLimit(), tx.GetGasPrice())
+			},
+			ComputeTxFeeBasedOnGasUsedCalled: func(tx data.TransactionWithFeeHandler, gasUsed uint64) *big.Int {
+				return core.SafeMul(gasUsed, tx.GetGasPrice())
+			},
+		}
+		profiledExecutions := make([]process.ProfiledExecution, 0)
+		arguments.GasProfiler = &testscommon.GasProfilerStub{
+			ProfileExecutionCalled: func(execution process.ProfiledExecution) {
+				profiledExecutions = append(profiledExecutions, execution)
+			},
+		}
+
+		tx := &transaction.Transaction{
+			SndAddr:  []byte("SRC"),
+			RcvAddr:  contract,
+			Data:     []byte("add@05"),
+			Value:    big.NewInt(0),
+			GasLimit: 1000,
+			GasPrice: 10,
+		}
+		vmOutput := &vmcommon.VMOutput{
+			GasRemaining: 300,
+			GasRefund:    big.NewInt(0),
+			ReturnCode:   returnCode,
+		}
+		executeContractCall(t, arguments, tx, vmOutput)
+
+		return profiledExecutions
+	}
+
+	t.Run("successful call should be profiled", func(t *testing.T) {
+		t.Parallel()
+
+		profiledExecutions := executeCallWithReturnCode(t, vmcommon.Ok)
+		require.Len(t, profiledExecutions, 1)
+		require.NotEmpty(t, profiledExecutions[0].TxHash)
+		profiledExecutions[0].TxHash = nil
+		expectedExecution := process.ProfiledExecution{
+			Contract: contract,
+			Endpoint: "add",
+			GasUsed:  700,
+			Fee:      big.NewInt(7000),
+			Refund:   big.NewInt(3000),
+		}
+		require.Equal(t, expectedExecution, profiledExecutions[0])
+	})
+	t.Run("failed call should be profiled with the whole gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		profiledExecutions := executeCallWithReturnCode(t, vmcommon.UserError)
+		require.Len(t, profiledExecutions, 1)
+		require.Zero(t, profiledExecutions[0].Refund.Sign())
+		profiledExecutions[0].TxHash = nil
+		profiledExecutions[0].Refund = nil
+		expectedExecution := process.ProfiledExecution{
+			Contract: contract,
+			Endpoint: "add",
+			IsFailed: true,
+			GasUsed:  1000,
+			Fee:      big.NewInt(10000),
+		}
+		require.Equal(t, expectedExecution, profiledExecutions[0])
+	})
+}
+
 func TestScProcessor_ExecuteSmartContractTransactionSaveLogCalled(t *testing.T) {
 	t.Parallel()
 
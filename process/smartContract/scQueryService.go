@@ -27,7 +27,7 @@ import (
 	"github.com/multiversx/mx-chain-vm-common-go/parsers"
 )
 
-var _ process.SCQueryService = (*SCQueryService)(nil)
+var _ process.SCQueryStateView = (*SCQueryService)(nil)
 
 var logQueryService = logger.GetOrCreate("process/smartcontract.queryService")
 
@@ -57,6 +57,8 @@ type SCQueryService struct {
 	uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
 	isInHistoricalBalancesMode bool
 	epochStartHdrCache         map[uint32]data.HeaderHandler
+	pinnedRootHash             []byte
+	pinnedBlockHash            []byte
 }
 
 // ArgsNewSCQueryService defines the arguments needed for the sc query service
@@ -166,11 +168,9 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 		return nil, nil, process.ErrQueriesNotAllowedYet
 	}
 
-	if query.ScAddress == nil {
-		return nil, nil, process.ErrNilScAddress
-	}
-	if len(query.FuncName) == 0 {
-		return nil, nil, process.ErrEmptyFunctionName
+	err := checkQuery(query)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	service.mutRunSc.Lock()
@@ -179,6 +179,115 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 	return service.executeScCall(query, 0)
 }
 
+// ExecuteQueries runs all the provided queries against the same block. The block is selected by the block coordinates
+// of the queries, which should be the same for all of them. An error is returned only if the block could not be
+// prepared, otherwise each query has its own result, holding either the VMOutput or the execution error.
+func (service *SCQueryService) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if !service.shouldAllowQueriesExecution() {
+		return nil, nil, process.ErrQueriesNotAllowedYet
+	}
+
+	err := checkQueriesBlockCoordinates(queries)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	service.mutRunSc.Lock()
+	defer service.mutRunSc.Unlock()
+
+	blockInfo, err := service.prepareBlockForQueries(queries[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make([]*process.SCQueryResult, 0, len(queries))
+	for _, query := range queries {
+		vmOutput, errExecute := service.executeQueryOnPreparedBlock(query)
+		results = append(results, &process.SCQueryResult{
+			VMOutput: vmOutput,
+			Err:      errExecute,
+		})
+	}
+
+	return results, blockInfo, nil
+}
+
+func (service *SCQueryService) executeQueryOnPreparedBlock(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	err := checkQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.checkSyncState(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.executeScCallOnPreparedBlock(query, 0)
+}
+
+// ResolveStateRootHash returns the root hash of the state the provided query will be executed on
+func (service *SCQueryService) ResolveStateRootHash(query *process.SCQuery) ([]byte, error) {
+	if query == nil {
+		return nil, process.ErrNilSCQuery
+	}
+
+	_, blockRootHash, err := service.extractBlockHeaderAndRootHash(query)
+
+	return blockRootHash, err
+}
+
+// PinState recreates the state of the service at the block requested by the provided query, without executing it.
+// It returns false if the service was already pinned to the requested state, so the state was not recreated
+func (service *SCQueryService) PinState(query *process.SCQuery) (bool, error) {
+	if query == nil {
+		return false, process.ErrNilSCQuery
+	}
+
+	service.mutRunSc.Lock()
+	defer service.mutRunSc.Unlock()
+
+	_, isStateRecreated, err := service.pinBlock(query)
+
+	return isStateRecreated, err
+}
+
+func checkQuery(query *process.SCQuery) error {
+	if query.ScAddress == nil {
+		return process.ErrNilScAddress
+	}
+	if len(query.FuncName) == 0 {
+		return process.ErrEmptyFunctionName
+	}
+
+	return nil
+}
+
+func checkQueriesBlockCoordinates(queries []*process.SCQuery) error {
+	if len(queries) == 0 {
+		return process.ErrNilOrEmptyList
+	}
+
+	for i, query := range queries {
+		if query == nil {
+			return fmt.Errorf("%w at index %d", process.ErrNilSCQuery, i)
+		}
+		if !haveSameBlockCoordinates(queries[0], query) {
+			return fmt.Errorf("%w, query at index %d differs", process.ErrDifferentBlockCoordinatesInQueries, i)
+		}
+	}
+
+	return nil
+}
+
+func haveSameBlockCoordinates(first *process.SCQuery, second *process.SCQuery) bool {
+	return first.OnFinalBlock == second.OnFinalBlock &&
+		first.BlockNonce == second.BlockNonce &&
+		first.HintEpoch == second.HintEpoch &&
+		bytes.Equal(first.BlockHash, second.BlockHash) &&
+		bytes.Equal(first.BlockRootHash, second.BlockRootHash)
+}
+
 func (service *SCQueryService) shouldAllowQueriesExecution() bool {
 	select {
 	case <-service.allowExternalQueriesChan:
@@ -188,46 +297,130 @@ func (service *SCQueryService) shouldAllowQueriesExecution() bool {
 	}
 }
 
-func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, common.BlockInfo, error) {
-	logQueryService.Trace("executeScCall", "address", query.ScAddress, "function", query.FuncName, "blockNonce", query.BlockNonce.Value, "blockHash", query.BlockHash)
-
+func (service *SCQueryService) checkSyncState(query *process.SCQuery) error {
 	shouldEarlyExitBecauseOfSyncState := query.ShouldBeSynced && service.bootstrapper.GetNodeState() == common.NsNotSynchronized
 	if shouldEarlyExitBecauseOfSyncState {
-		return nil, nil, process.ErrNodeIsNotSynced
+		return process.ErrNodeIsNotSynced
 	}
 
-	blockHeader, blockRootHash, err := service.extractBlockHeaderAndRootHash(query)
+	return nil
+}
+
+func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, common.BlockInfo, error) {
+	err := service.checkSyncState(query)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if len(blockRootHash) > 0 {
-		err = service.apiBlockChain.SetCurrentBlockHeaderAndRootHash(blockHeader, blockRootHash)
-		if err != nil {
-			return nil, nil, err
-		}
+	blockInfo, err := service.prepareBlockForQueries(query)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		err = service.recreateTrie(blockRootHash, blockHeader)
-		if err != nil {
-			return nil, nil, err
-		}
+	vmOutput, err := service.executeScCallOnPreparedBlock(query, gasPrice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vmOutput, blockInfo, nil
+}
+
+// prepareBlockForQueries sets the block selected by the query coordinates as the current block of the API blockchain
+// and recreates the state at that block. The caller should hold the mutRunSc mutex.
+func (service *SCQueryService) prepareBlockForQueries(query *process.SCQuery) (common.BlockInfo, error) {
+	blockInfo, _, err := service.pinBlock(query)
+
+	return blockInfo, err
+}
+
+// pinBlock sets the block selected by the query coordinates as the current block of the API blockchain and recreates
+// the state at that block. The preparation is skipped if the service is already pinned to the same block and the
+// state is not recreated if the service is already pinned to the same root hash. It returns true if the state was
+// recreated. The caller should hold the mutRunSc mutex.
+func (service *SCQueryService) pinBlock(query *process.SCQuery) (common.BlockInfo, bool, error) {
+	blockHeader, blockRootHash, err := service.extractBlockHeaderAndRootHash(query)
+	if err != nil {
+		return nil, false, err
+	}
 
-		epochStartHdr, err := service.getEpochStartBlockHdr(blockHeader.GetEpoch())
+	var blockHash []byte
+	var blockNonce uint64
+	if !check.IfNil(blockHeader) {
+		blockNonce = blockHeader.GetNonce()
+		blockHash, err = core.CalculateHash(service.marshaller, service.hasher, blockHeader)
 		if err != nil {
-			return nil, nil, err
+			return nil, false, err
 		}
+	}
 
-		err = service.blockChainHook.SetEpochStartHeader(epochStartHdr)
+	isStateRecreated := false
+	if len(blockRootHash) > 0 {
+		isStateRecreated, err = service.pinState(query, blockHeader, blockHash, blockRootHash)
 		if err != nil {
-			return nil, nil, err
+			return nil, false, err
 		}
+	}
+
+	if len(query.BlockRootHash) > 0 {
+		// the block hash and nonce cannot be inferred from a root hash
+		return holders.NewBlockInfo(nil, 0, blockRootHash), isStateRecreated, nil
+	}
+
+	return holders.NewBlockInfo(blockHash, blockNonce, blockRootHash), isStateRecreated, nil
+}
+
+func (service *SCQueryService) pinState(query *process.SCQuery, blockHeader data.HeaderHandler, blockHash []byte, blockRootHash []byte) (bool, error) {
+	isSameRootHash := bytes.Equal(service.pinnedRootHash, blockRootHash)
+	if isSameRootHash && bytes.Equal(service.pinnedBlockHash, blockHash) {
+		return false, nil
+	}
+
+	// the pinned state is no longer reliable until the preparation completes
+	service.pinnedRootHash = nil
+	service.pinnedBlockHash = nil
+
+	err := service.apiBlockChain.SetCurrentBlockHeaderAndRootHash(blockHeader, blockRootHash)
+	if err != nil {
+		return false, err
+	}
 
-		err = service.blockChainHook.SetCurrentHeader(blockHeader)
+	if !isSameRootHash {
+		hintEpoch := core.OptionalUint32{}
+		if len(query.BlockRootHash) > 0 {
+			hintEpoch = query.HintEpoch
+		}
+		err = service.recreateTrieWithHintEpoch(blockRootHash, blockHeader, hintEpoch)
 		if err != nil {
-			return nil, nil, err
+			return false, err
 		}
 	}
 
+	epochStartHdr, err := service.getEpochStartBlockHdr(blockHeader.GetEpoch())
+	if err != nil {
+		return false, err
+	}
+
+	err = service.blockChainHook.SetEpochStartHeader(epochStartHdr)
+	if err != nil {
+		return false, err
+	}
+
+	err = service.blockChainHook.SetCurrentHeader(blockHeader)
+	if err != nil {
+		return false, err
+	}
+
+	service.pinnedRootHash = blockRootHash
+	service.pinnedBlockHash = blockHash
+
+	return !isSameRootHash, nil
+}
+
+func (service *SCQueryService) executeScCallOnPreparedBlock(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
+	logQueryService.Trace("executeScCall", "address", query.ScAddress, "function", query.FuncName,
+		"blockNonce", query.BlockNonce.Value, "blockHash", query.BlockHash, "blockRootHash", query.BlockRootHash,
+		"onFinalBlock", query.OnFinalBlock)
+
 	shouldCheckRootHashChanges := query.SameScState
 	rootHashBeforeExecution := make([]byte, 0)
 
@@ -239,7 +432,7 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	vm, _, err := scrCommon.FindVMByScAddress(service.vmContainer, query.ScAddress)
 	if err != nil {
 		service.wasmVMChangeLocker.RUnlock()
-		return nil, nil, err
+		return nil, err
 	}
 
 	query = prepareScQuery(query)
@@ -247,39 +440,38 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	vmOutput, err := vm.RunSmartContractCall(vmInput)
 	service.wasmVMChangeLocker.RUnlock()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	if query.SameScState {
 		err = service.checkForRootHashChanges(rootHashBeforeExecution)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 	}
 
-	var blockHash []byte
-	var blockNonce uint64
-	if !check.IfNil(blockHeader) {
-		blockNonce = blockHeader.GetNonce()
-		blockHash, err = core.CalculateHash(service.marshaller, service.hasher, blockHeader)
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-	blockInfo := holders.NewBlockInfo(blockHash, blockNonce, blockRootHash)
-	return vmOutput, blockInfo, nil
+	return vmOutput, nil
 }
 
 func (service *SCQueryService) recreateTrie(blockRootHash []byte, blockHeader data.HeaderHandler) error {
+	return service.recreateTrieWithHintEpoch(blockRootHash, blockHeader, core.OptionalUint32{})
+}
+
+func (service *SCQueryService) recreateTrieWithHintEpoch(blockRootHash []byte, blockHeader data.HeaderHandler, hintEpoch core.OptionalUint32) error {
 	if check.IfNil(blockHeader) {
 		return process.ErrNilBlockHeader
 	}
 
 	accountsAdapter := service.blockChainHook.GetAccountsAdapter()
 
+	epoch := blockHeader.GetEpoch()
+	if hintEpoch.HasValue {
+		epoch = hintEpoch.Value
+	}
+
 	rootHashHolder := holders.NewDefaultRootHashesHolder(blockRootHash)
 	if service.isInHistoricalBalancesMode {
-		rootHashHolder = holders.NewRootHashHolder(blockRootHash, core.OptionalUint32{Value: blockHeader.GetEpoch(), HasValue: true})
+		rootHashHolder = holders.NewRootHashHolder(blockRootHash, core.OptionalUint32{Value: epoch, HasValue: true})
 	}
 
 	logQueryService.Trace("calling RecreateTrie", "block", blockHeader.GetNonce(), "rootHashHolder", rootHashHolder)
@@ -306,6 +498,25 @@ func (service *SCQueryService) extractBlockHeaderAndRootHash(query *process.SCQu
 		return service.getRootHashForBlock(currentHeader)
 	}
 
+	if query.OnFinalBlock {
+		_, finalBlockHash, _ := service.mainBlockChain.GetFinalBlockInfo()
+		if len(finalBlockHash) == 0 {
+			return nil, nil, process.ErrNilBlockHeader
+		}
+
+		currentHeader, err := service.getBlockHeaderByHash(finalBlockHash)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return service.getRootHashForBlock(currentHeader)
+	}
+
+	if len(query.BlockRootHash) > 0 {
+		// the state is recreated from the provided root hash, while the current block provides the execution context
+		return service.mainBlockChain.GetCurrentBlockHeader(), query.BlockRootHash, nil
+	}
+
 	return service.mainBlockChain.GetCurrentBlockHeader(), service.mainBlockChain.GetCurrentBlockRootHash(), nil
 }
 
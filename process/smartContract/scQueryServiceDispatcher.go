@@ -12,11 +12,12 @@ import (
 )
 
 type scQueryServiceDispatcher struct {
-	mutList     sync.RWMutex
-	list        []process.SCQueryService
-	mutIndex    sync.Mutex
-	index       int
-	maxListSize int
+	mutList        sync.RWMutex
+	list           []process.SCQueryService
+	mutIndex       sync.Mutex
+	index          int
+	maxListSize    int
+	stateViewsPool process.SCQueryService
 }
 
 // NewScQueryServiceDispatcher returns a smart contract query service dispatcher that for each function call
@@ -38,8 +39,34 @@ func NewScQueryServiceDispatcher(list []process.SCQueryService) (*scQueryService
 	}, nil
 }
 
-// ExecuteQuery will call this method on one of the element from provided list
+// NewScQueryServiceDispatcherWithStateViews returns a smart contract query service dispatcher that forwards the queries
+// on past blocks towards the provided state views pool, while the rest of the calls are forwarded towards the provided
+// list in a round-robin fashion
+func NewScQueryServiceDispatcherWithStateViews(
+	list []process.SCQueryService,
+	stateViewsPool process.SCQueryService,
+) (*scQueryServiceDispatcher, error) {
+	if check.IfNil(stateViewsPool) {
+		return nil, process.ErrNilStateViewsPool
+	}
+
+	sqsd, err := NewScQueryServiceDispatcher(list)
+	if err != nil {
+		return nil, err
+	}
+
+	sqsd.stateViewsPool = stateViewsPool
+
+	return sqsd, nil
+}
+
+// ExecuteQuery will call this method on the state views pool, for queries on past blocks, or on one of the element
+// from provided list
 func (sqsd *scQueryServiceDispatcher) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+	if sqsd.shouldUseStateViews(query) {
+		return sqsd.stateViewsPool.ExecuteQuery(query)
+	}
+
 	index := sqsd.getNewIndex()
 
 	sqsd.mutList.RLock()
@@ -48,6 +75,21 @@ func (sqsd *scQueryServiceDispatcher) ExecuteQuery(query *process.SCQuery) (*vmc
 	return sqsd.list[index].ExecuteQuery(query)
 }
 
+// ExecuteQueries will forward the whole batch towards one of the elements from provided list, so all queries are
+// executed against the same block
+func (sqsd *scQueryServiceDispatcher) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if len(queries) > 0 && sqsd.shouldUseStateViews(queries[0]) {
+		return sqsd.stateViewsPool.ExecuteQueries(queries)
+	}
+
+	index := sqsd.getNewIndex()
+
+	sqsd.mutList.RLock()
+	defer sqsd.mutList.RUnlock()
+
+	return sqsd.list[index].ExecuteQueries(queries)
+}
+
 // ComputeScCallGasLimit will call this method on one of the element from provided list
 func (sqsd *scQueryServiceDispatcher) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	index := sqsd.getNewIndex()
@@ -58,6 +100,14 @@ func (sqsd *scQueryServiceDispatcher) ComputeScCallGasLimit(tx *transaction.Tran
 	return sqsd.list[index].ComputeScCallGasLimit(tx)
 }
 
+func (sqsd *scQueryServiceDispatcher) shouldUseStateViews(query *process.SCQuery) bool {
+	if check.IfNil(sqsd.stateViewsPool) || query == nil {
+		return false
+	}
+
+	return query.BlockNonce.HasValue || len(query.BlockHash) > 0 || len(query.BlockRootHash) > 0 || query.OnFinalBlock
+}
+
 func (sqsd *scQueryServiceDispatcher) getNewIndex() int {
 	sqsd.mutIndex.Lock()
 	updatedValue := sqsd.index
@@ -83,6 +133,14 @@ func (sqsd *scQueryServiceDispatcher) Close() error {
 		}
 	}
 
+	if !check.IfNil(sqsd.stateViewsPool) {
+		err := sqsd.stateViewsPool.Close()
+		if err != nil {
+			logQueryService.Error("error while closing the state views pool in scQueryServiceDispatcher.Close", "error", err)
+			errFound = err
+		}
+	}
+
 	return errFound
 }
 
@@ -6,6 +6,7 @@ import (
 	"sync/atomic"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
@@ -81,6 +82,39 @@ func TestScQueryServiceDispatcher_ExecuteQueryShouldCallInRoundRobinFashion(t *t
 	assert.Equal(t, 1, calledElement2)
 }
 
+func TestScQueryServiceDispatcher_ExecuteQueriesShouldForwardTheWholeBatchInRoundRobinFashion(t *testing.T) {
+	t.Parallel()
+
+	providedQueries := []*process.SCQuery{{FuncName: "first"}, {FuncName: "second"}}
+	calledElement1 := 0
+	calledElement2 := 0
+	sqsd, _ := NewScQueryServiceDispatcher([]process.SCQueryService{
+		&mock.ScQueryStub{
+			ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+				assert.Equal(t, providedQueries, queries)
+				calledElement1++
+
+				return nil, nil, nil
+			},
+		},
+		&mock.ScQueryStub{
+			ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+				assert.Equal(t, providedQueries, queries)
+				calledElement2++
+
+				return nil, nil, nil
+			},
+		},
+	})
+
+	_, _, _ = sqsd.ExecuteQueries(providedQueries)
+	_, _, _ = sqsd.ExecuteQueries(providedQueries)
+	_, _, _ = sqsd.ExecuteQueries(providedQueries)
+
+	assert.Equal(t, 2, calledElement1)
+	assert.Equal(t, 1, calledElement2)
+}
+
 func TestScQueryServiceDispatcher_ComputeScCallGasLimitShouldCallInRoundRobinFashion(t *testing.T) {
 	t.Parallel()
 
@@ -189,3 +223,107 @@ func TestNewScQueryServiceDispatcher_CloseShouldWork(t *testing.T) {
 	assert.True(t, closeCalled1)
 	assert.True(t, closeCalled2)
 }
+
+func TestNewScQueryServiceDispatcherWithStateViews(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil state views pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		sqsd, err := NewScQueryServiceDispatcherWithStateViews([]process.SCQueryService{&mock.ScQueryStub{}}, nil)
+		assert.True(t, check.IfNil(sqsd))
+		assert.Equal(t, process.ErrNilStateViewsPool, err)
+	})
+	t.Run("empty list should error", func(t *testing.T) {
+		t.Parallel()
+
+		sqsd, err := NewScQueryServiceDispatcherWithStateViews(nil, &mock.ScQueryStub{})
+		assert.True(t, check.IfNil(sqsd))
+		assert.True(t, errors.Is(err, process.ErrNilOrEmptyList))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sqsd, err := NewScQueryServiceDispatcherWithStateViews([]process.SCQueryService{&mock.ScQueryStub{}}, &mock.ScQueryStub{})
+		assert.False(t, check.IfNil(sqsd))
+		assert.Nil(t, err)
+	})
+}
+
+func TestScQueryServiceDispatcher_QueriesOnPastBlocksShouldUseTheStateViews(t *testing.T) {
+	t.Parallel()
+
+	calledLatestState := 0
+	calledStateViews := 0
+	sqsd, _ := NewScQueryServiceDispatcherWithStateViews(
+		[]process.SCQueryService{
+			&mock.ScQueryStub{
+				ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+					calledLatestState++
+					return nil, nil, nil
+				},
+				ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+					calledLatestState++
+					return nil, nil, nil
+				},
+				ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (uint64, error) {
+					calledLatestState++
+					return 0, nil
+				},
+			},
+		},
+		&mock.ScQueryStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				calledStateViews++
+				return nil, nil, nil
+			},
+			ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+				calledStateViews++
+				return nil, nil, nil
+			},
+			ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (uint64, error) {
+				assert.Fail(t, "should not have been called")
+				return 0, nil
+			},
+		},
+	)
+
+	_, _, _ = sqsd.ExecuteQuery(&process.SCQuery{})
+	_, _, _ = sqsd.ExecuteQueries([]*process.SCQuery{{}})
+	_, _ = sqsd.ComputeScCallGasLimit(&transaction.Transaction{})
+	assert.Equal(t, 3, calledLatestState)
+	assert.Equal(t, 0, calledStateViews)
+
+	queriesOnPastBlocks := []*process.SCQuery{
+		{BlockNonce: core.OptionalUint64{Value: 1, HasValue: true}},
+		{BlockHash: []byte("hash")},
+		{BlockRootHash: []byte("root hash")},
+		{OnFinalBlock: true},
+	}
+	for _, query := range queriesOnPastBlocks {
+		_, _, _ = sqsd.ExecuteQuery(query)
+		_, _, _ = sqsd.ExecuteQueries([]*process.SCQuery{query})
+	}
+	assert.Equal(t, 3, calledLatestState)
+	assert.Equal(t, 2*len(queriesOnPastBlocks), calledStateViews)
+}
+
+func TestScQueryServiceDispatcher_CloseShouldCloseTheStateViews(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	closeCalled := false
+	sqsd, _ := NewScQueryServiceDispatcherWithStateViews(
+		[]process.SCQueryService{&mock.ScQueryStub{}},
+		&mock.ScQueryStub{
+			CloseCalled: func() error {
+				closeCalled = true
+				return expectedErr
+			},
+		},
+	)
+
+	err := sqsd.Close()
+	assert.Equal(t, expectedErr, err)
+	assert.True(t, closeCalled)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/dblookupext"
 	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	stateMocks "github.com/multiversx/mx-chain-go/testscommon/state"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
@@ -1396,3 +1397,378 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, closeCalled)
 }
+
+func TestSCQueryService_ExecuteQueries(t *testing.T) {
+	t.Parallel()
+
+	createQuery := func(funcName string) *process.SCQuery {
+		return &process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  funcName,
+		}
+	}
+
+	t.Run("queries not allowed yet should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForSCQuery()
+		args.AllowExternalQueriesChan = make(chan struct{})
+		qs, _ := NewSCQueryService(args)
+
+		results, blockInfo, err := qs.ExecuteQueries([]*process.SCQuery{createQuery("function")})
+		require.Nil(t, results)
+		require.Nil(t, blockInfo)
+		require.Equal(t, process.ErrQueriesNotAllowedYet, err)
+	})
+	t.Run("empty list should error", func(t *testing.T) {
+		t.Parallel()
+
+		qs, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		results, _, err := qs.ExecuteQueries(nil)
+		require.Nil(t, results)
+		require.Equal(t, process.ErrNilOrEmptyList, err)
+	})
+	t.Run("nil query should error", func(t *testing.T) {
+		t.Parallel()
+
+		qs, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		results, _, err := qs.ExecuteQueries([]*process.SCQuery{createQuery("function"), nil})
+		require.Nil(t, results)
+		require.ErrorIs(t, err, process.ErrNilSCQuery)
+	})
+	t.Run("different block coordinates should error", func(t *testing.T) {
+		t.Parallel()
+
+		qs, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		otherBlockQuery := createQuery("function")
+		otherBlockQuery.BlockNonce = core.OptionalUint64{Value: 2, HasValue: true}
+		results, _, err := qs.ExecuteQueries([]*process.SCQuery{createQuery("function"), otherBlockQuery})
+		require.Nil(t, results)
+		require.ErrorIs(t, err, process.ErrDifferentBlockCoordinatesInQueries)
+		require.Contains(t, err.Error(), "index 1")
+	})
+	t.Run("block preparation failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		qs, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		query := createQuery("function")
+		query.BlockRootHash = []byte("root hash")
+		results, _, err := qs.ExecuteQueries([]*process.SCQuery{query})
+		require.Nil(t, results)
+		require.Equal(t, process.ErrNilBlockHeader, err)
+	})
+	t.Run("should execute all queries against the same block", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgumentsForSCQuery()
+		numRecreateTrieCalls := 0
+		numSetCurrentHeaderCalls := 0
+		args.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return &stateMocks.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						numRecreateTrieCalls++
+						require.Equal(t, []byte("current root hash"), options.GetRootHash())
+						return nil
+					},
+				}
+			},
+			SetCurrentHeaderCalled: func(hdr data.HeaderHandler) error {
+				numSetCurrentHeaderCalls++
+				return nil
+			},
+		}
+		args.MainBlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 5}
+			},
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return []byte("current root hash")
+			},
+		}
+		args.Marshaller = &marshallerMock.MarshalizerMock{}
+		args.Bootstrapper = &mock.BootstrapperStub{
+			GetNodeStateCalled: func() common.NodeState {
+				return common.NsNotSynchronized
+			},
+		}
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+						if input.Function == "fail" {
+							return nil, expectedErr
+						}
+
+						return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte(input.Function)}}, nil
+					},
+				}, nil
+			},
+		}
+		qs, _ := NewSCQueryService(args)
+
+		syncedQuery := createQuery("synced")
+		syncedQuery.ShouldBeSynced = true
+		results, blockInfo, err := qs.ExecuteQueries([]*process.SCQuery{
+			createQuery("first"),
+			createQuery("fail"),
+			createQuery(""),
+			syncedQuery,
+			createQuery("last"),
+		})
+		require.Nil(t, err)
+		require.Equal(t, 1, numRecreateTrieCalls)
+		require.Equal(t, 1, numSetCurrentHeaderCalls)
+		require.Equal(t, uint64(5), blockInfo.GetNonce())
+		require.Equal(t, []byte("current root hash"), blockInfo.GetRootHash())
+
+		require.Len(t, results, 5)
+		require.Nil(t, results[0].Err)
+		require.Equal(t, [][]byte{[]byte("first")}, results[0].VMOutput.ReturnData)
+		require.Nil(t, results[1].VMOutput)
+		require.Equal(t, expectedErr, results[1].Err)
+		require.Equal(t, process.ErrEmptyFunctionName, results[2].Err)
+		require.Equal(t, process.ErrNodeIsNotSynced, results[3].Err)
+		require.Nil(t, results[4].Err)
+		require.Equal(t, [][]byte{[]byte("last")}, results[4].VMOutput.ReturnData)
+	})
+	t.Run("block root hash with hint epoch should work in deep history mode", func(t *testing.T) {
+		t.Parallel()
+
+		providedRootHash := []byte("provided root hash")
+		args := createMockArgumentsForSCQuery()
+		args.IsInHistoricalBalancesMode = true
+		recreateTrieCalled := false
+		args.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return &stateMocks.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						recreateTrieCalled = true
+						require.Equal(t, providedRootHash, options.GetRootHash())
+						require.Equal(t, core.OptionalUint32{Value: 7, HasValue: true}, options.GetEpoch())
+						return nil
+					},
+				}
+			},
+		}
+		args.MainBlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 100}
+			},
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return []byte("current root hash")
+			},
+		}
+		qs, _ := NewSCQueryService(args)
+
+		queries := []*process.SCQuery{createQuery("first"), createQuery("second")}
+		for _, query := range queries {
+			query.BlockRootHash = providedRootHash
+			query.HintEpoch = core.OptionalUint32{Value: 7, HasValue: true}
+		}
+
+		results, blockInfo, err := qs.ExecuteQueries(queries)
+		require.Nil(t, err)
+		require.True(t, recreateTrieCalled)
+		require.Len(t, results, 2)
+		require.Nil(t, results[0].Err)
+		require.Nil(t, results[1].Err)
+		require.Empty(t, blockInfo.GetHash())
+		require.Zero(t, blockInfo.GetNonce())
+		require.Equal(t, providedRootHash, blockInfo.GetRootHash())
+	})
+}
+
+func TestSCQueryService_ResolveStateRootHashAndPinState(t *testing.T) {
+	t.Parallel()
+
+	createArgsWithFinalBlock := func(finalRootHash []byte) ArgsNewSCQueryService {
+		args := createMockArgumentsForSCQuery()
+		args.Marshaller = &marshallerMock.MarshalizerMock{}
+		args.MainBlockChain = &testscommon.ChainHandlerStub{
+			GetFinalBlockInfoCalled: func() (uint64, []byte, []byte) {
+				return 4, []byte("final hash"), finalRootHash
+			},
+		}
+		args.HistoryRepository = &dblookupext.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}
+		args.StorageService = &storageStubs.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+				return &storageStubs.StorerStub{
+					GetCalled: func(key []byte) ([]byte, error) {
+						return args.Marshaller.Marshal(&block.Header{Nonce: 4, RootHash: finalRootHash})
+					},
+				}, nil
+			},
+		}
+
+		return args
+	}
+
+	t.Run("nil query should error", func(t *testing.T) {
+		t.Parallel()
+
+		qs, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		rootHash, err := qs.ResolveStateRootHash(nil)
+		require.Nil(t, rootHash)
+		require.Equal(t, process.ErrNilSCQuery, err)
+
+		isStateRecreated, err := qs.PinState(nil)
+		require.False(t, isStateRecreated)
+		require.Equal(t, process.ErrNilSCQuery, err)
+	})
+	t.Run("on final block without final block info should error", func(t *testing.T) {
+		t.Parallel()
+
+		qs, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		rootHash, err := qs.ResolveStateRootHash(&process.SCQuery{OnFinalBlock: true})
+		require.Nil(t, rootHash)
+		require.Equal(t, process.ErrNilBlockHeader, err)
+	})
+	t.Run("should resolve the root hash of the final block", func(t *testing.T) {
+		t.Parallel()
+
+		finalRootHash := []byte("final root hash")
+		qs, _ := NewSCQueryService(createArgsWithFinalBlock(finalRootHash))
+
+		rootHash, err := qs.ResolveStateRootHash(&process.SCQuery{OnFinalBlock: true})
+		require.Nil(t, err)
+		require.Equal(t, finalRootHash, rootHash)
+	})
+	t.Run("should resolve the provided block root hash", func(t *testing.T) {
+		t.Parallel()
+
+		qs, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		rootHash, err := qs.ResolveStateRootHash(&process.SCQuery{BlockRootHash: []byte("root hash")})
+		require.Nil(t, err)
+		require.Equal(t, []byte("root hash"), rootHash)
+	})
+	t.Run("pin state should recreate the state of the final block", func(t *testing.T) {
+		t.Parallel()
+
+		finalRootHash := []byte("final root hash")
+		args := createArgsWithFinalBlock(finalRootHash)
+		recreatedRootHash := make([]byte, 0)
+		var currentHeader data.HeaderHandler
+		args.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return &stateMocks.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						recreatedRootHash = options.GetRootHash()
+						return nil
+					},
+				}
+			},
+			SetCurrentHeaderCalled: func(hdr data.HeaderHandler) error {
+				currentHeader = hdr
+				return nil
+			},
+		}
+		qs, _ := NewSCQueryService(args)
+
+		isStateRecreated, err := qs.PinState(&process.SCQuery{OnFinalBlock: true})
+		require.Nil(t, err)
+		require.True(t, isStateRecreated)
+		require.Equal(t, finalRootHash, recreatedRootHash)
+		require.Equal(t, uint64(4), currentHeader.GetNonce())
+	})
+	t.Run("queries on the pinned state should not prepare the block again", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsWithFinalBlock([]byte("final root hash"))
+		numRecreateTrieCalls := 0
+		numSetCurrentHeaderCalls := 0
+		args.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return &stateMocks.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						numRecreateTrieCalls++
+						return nil
+					},
+				}
+			},
+			SetCurrentHeaderCalled: func(hdr data.HeaderHandler) error {
+				numSetCurrentHeaderCalls++
+				return nil
+			},
+		}
+		numSetCurrentBlockCalls := 0
+		args.APIBlockChain = &testscommon.ChainHandlerStub{
+			SetCurrentBlockHeaderAndRootHashCalled: func(header data.HeaderHandler, rootHash []byte) error {
+				numSetCurrentBlockCalls++
+				return nil
+			},
+		}
+		qs, _ := NewSCQueryService(args)
+
+		isStateRecreated, err := qs.PinState(&process.SCQuery{OnFinalBlock: true})
+		require.Nil(t, err)
+		require.True(t, isStateRecreated)
+
+		isStateRecreated, err = qs.PinState(&process.SCQuery{OnFinalBlock: true})
+		require.Nil(t, err)
+		require.False(t, isStateRecreated)
+
+		_, blockInfo, err := qs.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "function", OnFinalBlock: true})
+		require.Nil(t, err)
+		require.Equal(t, []byte("final root hash"), blockInfo.GetRootHash())
+		require.Equal(t, uint64(4), blockInfo.GetNonce())
+
+		require.Equal(t, 1, numRecreateTrieCalls)
+		require.Equal(t, 1, numSetCurrentBlockCalls)
+		require.Equal(t, 1, numSetCurrentHeaderCalls)
+	})
+	t.Run("another block with the same root hash should not recreate the state", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForSCQuery()
+		args.Marshaller = &marshallerMock.MarshalizerMock{}
+		args.Hasher = &hashingMocks.HasherMock{}
+		currentBlockNonce := uint64(4)
+		args.MainBlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: currentBlockNonce}
+			},
+		}
+		numRecreateTrieCalls := 0
+		var currentHeader data.HeaderHandler
+		args.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return &stateMocks.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						numRecreateTrieCalls++
+						return nil
+					},
+				}
+			},
+			SetCurrentHeaderCalled: func(hdr data.HeaderHandler) error {
+				currentHeader = hdr
+				return nil
+			},
+		}
+		qs, _ := NewSCQueryService(args)
+
+		isStateRecreated, err := qs.PinState(&process.SCQuery{BlockRootHash: []byte("root hash")})
+		require.Nil(t, err)
+		require.True(t, isStateRecreated)
+		require.Equal(t, uint64(4), currentHeader.GetNonce())
+
+		currentBlockNonce = 5
+		isStateRecreated, err = qs.PinState(&process.SCQuery{BlockRootHash: []byte("root hash")})
+		require.Nil(t, err)
+		require.False(t, isStateRecreated)
+		require.Equal(t, uint64(5), currentHeader.GetNonce())
+		require.Equal(t, 1, numRecreateTrieCalls)
+	})
+}
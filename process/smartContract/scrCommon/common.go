@@ -16,6 +16,9 @@ import (
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
+// ProfiledDeployEndpoint is the endpoint under which the gas profiler aggregates the contract deployments
+const ProfiledDeployEndpoint = "deploy"
+
 // TestSmartContractProcessor is a SmartContractProcessor used in integration tests
 type TestSmartContractProcessor interface {
 	process.SmartContractProcessorFacade
@@ -54,6 +57,8 @@ type ArgsNewSmartContractProcessor struct {
 	EnableEpochs        config.EnableEpochs
 	VMOutputCacher      storage.Cacher
 	WasmVMChangeLocker  common.Locker
+	ExecutionTracer     process.ExecutionTracer
+	GasProfiler         process.GasProfiler
 	IsGenesisProcessing bool
 }
 
@@ -0,0 +1,201 @@
+package smartContract
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// ArgsStateViewsPool is the DTO used to create a new instance of stateViewsPool
+type ArgsStateViewsPool struct {
+	StateViews    []process.SCQueryStateView
+	StatusHandler core.AppStatusHandler
+}
+
+type stateView struct {
+	handler  process.SCQueryStateView
+	rootHash []byte
+	isBusy   bool
+	lastUsed uint64
+}
+
+type stateViewsPool struct {
+	mut           sync.Mutex
+	cond          *sync.Cond
+	views         []*stateView
+	usageCounter  uint64
+	statusHandler core.AppStatusHandler
+}
+
+// NewStateViewsPool returns a bounded pool of independent state views. Each query is executed on an idle view
+// already pinned to the requested state, if any, otherwise on the least recently used idle view that gets re-pinned
+func NewStateViewsPool(args ArgsStateViewsPool) (*stateViewsPool, error) {
+	if len(args.StateViews) == 0 {
+		return nil, fmt.Errorf("%w in NewStateViewsPool", process.ErrNilOrEmptyList)
+	}
+	for i := 0; i < len(args.StateViews); i++ {
+		if check.IfNil(args.StateViews[i]) {
+			return nil, fmt.Errorf("%w at element %d", process.ErrNilScQueryElement, i)
+		}
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, process.ErrNilAppStatusHandler
+	}
+
+	pool := &stateViewsPool{
+		views:         make([]*stateView, 0, len(args.StateViews)),
+		statusHandler: args.StatusHandler,
+	}
+	pool.cond = sync.NewCond(&pool.mut)
+	for _, handler := range args.StateViews {
+		pool.views = append(pool.views, &stateView{
+			handler: handler,
+		})
+	}
+
+	return pool, nil
+}
+
+// ExecuteQuery will execute the query on a view pinned to the state requested by the query
+func (pool *stateViewsPool) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+	if query == nil {
+		return nil, nil, process.ErrNilSCQuery
+	}
+
+	view, rootHash, err := pool.acquirePinnedView(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vmOutput, blockInfo, err := view.handler.ExecuteQuery(query)
+	pool.releaseView(view, rootHash)
+
+	return vmOutput, blockInfo, err
+}
+
+// ExecuteQueries will execute the whole batch on a view pinned to the state requested by the queries
+func (pool *stateViewsPool) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	err := checkQueriesBlockCoordinates(queries)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	view, rootHash, err := pool.acquirePinnedView(queries[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results, blockInfo, err := view.handler.ExecuteQueries(queries)
+	pool.releaseView(view, rootHash)
+
+	return results, blockInfo, err
+}
+
+// ComputeScCallGasLimit will estimate the gas limit on the least recently used idle view. Since the view is moved
+// on the latest state, it will not be considered pinned afterward
+func (pool *stateViewsPool) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
+	view, _ := pool.acquireView(nil)
+	gasLimit, err := view.handler.ComputeScCallGasLimit(tx)
+	pool.releaseView(view, nil)
+
+	return gasLimit, err
+}
+
+func (pool *stateViewsPool) acquirePinnedView(query *process.SCQuery) (*stateView, []byte, error) {
+	// the root hash resolution only reads headers from storage, so it is safe to be done on any view
+	rootHash, err := pool.views[0].handler.ResolveStateRootHash(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	view, _ := pool.acquireView(rootHash)
+
+	startTime := time.Now()
+	isStateRecreated, err := view.handler.PinState(query)
+	if err != nil {
+		pool.releaseView(view, nil)
+		return nil, nil, err
+	}
+	if !isStateRecreated {
+		// the view already holds the requested state, so the query execution will skip the block preparation
+		pool.statusHandler.Increment(common.MetricVMQueriesStateViewHits)
+		return view, rootHash, nil
+	}
+
+	pool.statusHandler.Increment(common.MetricVMQueriesStateViewMisses)
+	pool.statusHandler.SetUInt64Value(common.MetricVMQueriesLastStateViewRecreationDurationMs, uint64(time.Since(startTime).Milliseconds()))
+
+	return view, rootHash, nil
+}
+
+// acquireView blocks until an idle view is available, preferring the one already pinned to the provided root hash
+func (pool *stateViewsPool) acquireView(rootHash []byte) (*stateView, bool) {
+	pool.mut.Lock()
+	defer pool.mut.Unlock()
+
+	for {
+		view, isHit := pool.findIdleView(rootHash)
+		if view != nil {
+			pool.usageCounter++
+			view.isBusy = true
+			view.lastUsed = pool.usageCounter
+
+			return view, isHit
+		}
+
+		pool.cond.Wait()
+	}
+}
+
+func (pool *stateViewsPool) findIdleView(rootHash []byte) (*stateView, bool) {
+	var leastRecentlyUsed *stateView
+	for _, view := range pool.views {
+		if view.isBusy {
+			continue
+		}
+		if len(rootHash) > 0 && bytes.Equal(view.rootHash, rootHash) {
+			return view, true
+		}
+		if leastRecentlyUsed == nil || view.lastUsed < leastRecentlyUsed.lastUsed {
+			leastRecentlyUsed = view
+		}
+	}
+
+	return leastRecentlyUsed, false
+}
+
+func (pool *stateViewsPool) releaseView(view *stateView, rootHash []byte) {
+	pool.mut.Lock()
+	view.rootHash = rootHash
+	view.isBusy = false
+	pool.mut.Unlock()
+
+	pool.cond.Signal()
+}
+
+// Close closes all underlying state views
+func (pool *stateViewsPool) Close() error {
+	var errFound error
+	for _, view := range pool.views {
+		err := view.handler.Close()
+		if err != nil {
+			logQueryService.Error("error while closing inner SC query service in stateViewsPool.Close", "error", err)
+			errFound = err
+		}
+	}
+
+	return errFound
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pool *stateViewsPool) IsInterfaceNil() bool {
+	return pool == nil
+}
@@ -0,0 +1,383 @@
+package smartContract
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStateViewStub(pinnedStates *[]string, mut *sync.Mutex) *mock.ScQueryStub {
+	currentState := ""
+	return &mock.ScQueryStub{
+		ResolveStateRootHashCalled: func(query *process.SCQuery) ([]byte, error) {
+			return query.BlockHash, nil
+		},
+		PinStateCalled: func(query *process.SCQuery) (bool, error) {
+			if currentState == string(query.BlockHash) {
+				return false, nil
+			}
+
+			currentState = string(query.BlockHash)
+			mut.Lock()
+			*pinnedStates = append(*pinnedStates, currentState)
+			mut.Unlock()
+
+			return true, nil
+		},
+		ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (uint64, error) {
+			// the gas limit is computed on the latest state, so the view is no longer pinned
+			currentState = ""
+			return 100, nil
+		},
+	}
+}
+
+func createStateViewsPoolArgs(views ...process.SCQueryStateView) ArgsStateViewsPool {
+	return ArgsStateViewsPool{
+		StateViews:    views,
+		StatusHandler: &statusHandler.AppStatusHandlerStub{},
+	}
+}
+
+func TestNewStateViewsPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty list should error", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := NewStateViewsPool(createStateViewsPoolArgs())
+		assert.True(t, check.IfNil(pool))
+		assert.True(t, errors.Is(err, process.ErrNilOrEmptyList))
+	})
+	t.Run("nil element should error", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := NewStateViewsPool(createStateViewsPoolArgs(&mock.ScQueryStub{}, nil))
+		assert.True(t, check.IfNil(pool))
+		assert.True(t, errors.Is(err, process.ErrNilScQueryElement))
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createStateViewsPoolArgs(&mock.ScQueryStub{})
+		args.StatusHandler = nil
+		pool, err := NewStateViewsPool(args)
+		assert.True(t, check.IfNil(pool))
+		assert.Equal(t, process.ErrNilAppStatusHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := NewStateViewsPool(createStateViewsPoolArgs(&mock.ScQueryStub{}, &mock.ScQueryStub{}))
+		assert.False(t, check.IfNil(pool))
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(pool.views))
+	})
+}
+
+func TestStateViewsPool_ExecuteQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil query should error", func(t *testing.T) {
+		t.Parallel()
+
+		pool, _ := NewStateViewsPool(createStateViewsPoolArgs(&mock.ScQueryStub{}))
+		vmOutput, blockInfo, err := pool.ExecuteQuery(nil)
+		assert.Nil(t, vmOutput)
+		assert.Nil(t, blockInfo)
+		assert.Equal(t, process.ErrNilSCQuery, err)
+	})
+	t.Run("resolve state root hash fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		pool, _ := NewStateViewsPool(createStateViewsPoolArgs(&mock.ScQueryStub{
+			ResolveStateRootHashCalled: func(query *process.SCQuery) ([]byte, error) {
+				return nil, expectedErr
+			},
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				assert.Fail(t, "should not have been called")
+				return nil, nil, nil
+			},
+		}))
+		_, _, err := pool.ExecuteQuery(&process.SCQuery{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("pin state fails should error and release the view", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numPinCalls := 0
+		view := &mock.ScQueryStub{
+			ResolveStateRootHashCalled: func(query *process.SCQuery) ([]byte, error) {
+				return []byte("root hash"), nil
+			},
+			PinStateCalled: func(query *process.SCQuery) (bool, error) {
+				numPinCalls++
+				return false, expectedErr
+			},
+		}
+		pool, _ := NewStateViewsPool(createStateViewsPoolArgs(view))
+
+		_, _, err := pool.ExecuteQuery(&process.SCQuery{})
+		assert.Equal(t, expectedErr, err)
+
+		// the view should not be considered pinned after the failure
+		_, _, err = pool.ExecuteQuery(&process.SCQuery{})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 2, numPinCalls)
+		assert.False(t, pool.views[0].isBusy)
+	})
+	t.Run("should reuse the view pinned on the same state", func(t *testing.T) {
+		t.Parallel()
+
+		mut := &sync.Mutex{}
+		pinnedStates := make([]string, 0)
+		pool, _ := NewStateViewsPool(createStateViewsPoolArgs(
+			createStateViewStub(&pinnedStates, mut),
+			createStateViewStub(&pinnedStates, mut),
+		))
+
+		for i := 0; i < 3; i++ {
+			_, _, err := pool.ExecuteQuery(&process.SCQuery{BlockHash: []byte("hash A")})
+			require.Nil(t, err)
+			_, _, err = pool.ExecuteQuery(&process.SCQuery{BlockHash: []byte("hash B")})
+			require.Nil(t, err)
+		}
+
+		assert.Equal(t, []string{"hash A", "hash B"}, pinnedStates)
+	})
+	t.Run("should re-pin the least recently used view", func(t *testing.T) {
+		t.Parallel()
+
+		mut := &sync.Mutex{}
+		pinnedStates := make([]string, 0)
+		pool, _ := NewStateViewsPool(createStateViewsPoolArgs(
+			createStateViewStub(&pinnedStates, mut),
+			createStateViewStub(&pinnedStates, mut),
+		))
+
+		queries := []string{"hash A", "hash B", "hash A", "hash C", "hash A", "hash B"}
+		for _, hash := range queries {
+			_, _, err := pool.ExecuteQuery(&process.SCQuery{BlockHash: []byte(hash)})
+			require.Nil(t, err)
+		}
+
+		// hash C evicts hash B, the least recently used one, while hash A remains pinned
+		assert.Equal(t, []string{"hash A", "hash B", "hash C", "hash B"}, pinnedStates)
+	})
+	t.Run("should update the metrics", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := make(map[string]uint64)
+		mutMetrics := sync.Mutex{}
+		pinnedStates := make([]string, 0)
+		args := createStateViewsPoolArgs(createStateViewStub(&pinnedStates, &sync.Mutex{}))
+		args.StatusHandler = &statusHandler.AppStatusHandlerStub{
+			IncrementHandler: func(key string) {
+				mutMetrics.Lock()
+				metrics[key]++
+				mutMetrics.Unlock()
+			},
+			SetUInt64ValueHandler: func(key string, value uint64) {
+				mutMetrics.Lock()
+				metrics[key] = value + 1000
+				mutMetrics.Unlock()
+			},
+		}
+		pool, _ := NewStateViewsPool(args)
+
+		for i := 0; i < 3; i++ {
+			_, _, err := pool.ExecuteQuery(&process.SCQuery{BlockHash: []byte("hash A")})
+			require.Nil(t, err)
+		}
+
+		mutMetrics.Lock()
+		defer mutMetrics.Unlock()
+		assert.Equal(t, uint64(2), metrics[common.MetricVMQueriesStateViewHits])
+		assert.Equal(t, uint64(1), metrics[common.MetricVMQueriesStateViewMisses])
+		_, found := metrics[common.MetricVMQueriesLastStateViewRecreationDurationMs]
+		assert.True(t, found)
+	})
+	t.Run("view reporting a recreated state should not count as hit", func(t *testing.T) {
+		t.Parallel()
+
+		numHits := uint64(0)
+		numMisses := uint64(0)
+		args := createStateViewsPoolArgs(&mock.ScQueryStub{
+			ResolveStateRootHashCalled: func(query *process.SCQuery) ([]byte, error) {
+				return []byte("root hash"), nil
+			},
+			PinStateCalled: func(query *process.SCQuery) (bool, error) {
+				// the view state was moved meanwhile, so it had to be recreated
+				return true, nil
+			},
+		})
+		args.StatusHandler = &statusHandler.AppStatusHandlerStub{
+			IncrementHandler: func(key string) {
+				switch key {
+				case common.MetricVMQueriesStateViewHits:
+					atomic.AddUint64(&numHits, 1)
+				case common.MetricVMQueriesStateViewMisses:
+					atomic.AddUint64(&numMisses, 1)
+				}
+			},
+		}
+		pool, _ := NewStateViewsPool(args)
+
+		for i := 0; i < 3; i++ {
+			_, _, err := pool.ExecuteQuery(&process.SCQuery{})
+			require.Nil(t, err)
+		}
+
+		assert.Equal(t, uint64(0), atomic.LoadUint64(&numHits))
+		assert.Equal(t, uint64(3), atomic.LoadUint64(&numMisses))
+	})
+	t.Run("concurrent queries should not share a view", func(t *testing.T) {
+		t.Parallel()
+
+		numViews := 3
+		views := make([]process.SCQueryStateView, 0, numViews)
+		maxConcurrentQueries := int32(0)
+		concurrentQueries := int32(0)
+		for i := 0; i < numViews; i++ {
+			isInUse := int32(0)
+			views = append(views, &mock.ScQueryStub{
+				ResolveStateRootHashCalled: func(query *process.SCQuery) ([]byte, error) {
+					return query.BlockHash, nil
+				},
+				ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+					assert.True(t, atomic.CompareAndSwapInt32(&isInUse, 0, 1))
+					current := atomic.AddInt32(&concurrentQueries, 1)
+					for {
+						maxValue := atomic.LoadInt32(&maxConcurrentQueries)
+						if current <= maxValue || atomic.CompareAndSwapInt32(&maxConcurrentQueries, maxValue, current) {
+							break
+						}
+					}
+
+					time.Sleep(time.Millisecond)
+
+					atomic.AddInt32(&concurrentQueries, -1)
+					atomic.StoreInt32(&isInUse, 0)
+
+					return &vmcommon.VMOutput{}, nil, nil
+				},
+			})
+		}
+		pool, _ := NewStateViewsPool(createStateViewsPoolArgs(views...))
+
+		numQueries := 100
+		wg := sync.WaitGroup{}
+		wg.Add(numQueries)
+		for i := 0; i < numQueries; i++ {
+			go func(idx int) {
+				_, _, err := pool.ExecuteQuery(&process.SCQuery{BlockHash: []byte{byte(idx % 5)}})
+				assert.Nil(t, err)
+				wg.Done()
+			}(i)
+		}
+		wg.Wait()
+
+		assert.LessOrEqual(t, atomic.LoadInt32(&maxConcurrentQueries), int32(numViews))
+	})
+}
+
+func TestStateViewsPool_ExecuteQueries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("different block coordinates should error", func(t *testing.T) {
+		t.Parallel()
+
+		pool, _ := NewStateViewsPool(createStateViewsPoolArgs(&mock.ScQueryStub{}))
+		queries := []*process.SCQuery{
+			{BlockNonce: core.OptionalUint64{Value: 1, HasValue: true}},
+			{BlockNonce: core.OptionalUint64{Value: 2, HasValue: true}},
+		}
+		_, _, err := pool.ExecuteQueries(queries)
+		assert.True(t, errors.Is(err, process.ErrDifferentBlockCoordinatesInQueries))
+	})
+	t.Run("should forward the whole batch to a pinned view", func(t *testing.T) {
+		t.Parallel()
+
+		mut := &sync.Mutex{}
+		pinnedStates := make([]string, 0)
+		numCalls := 0
+		view := createStateViewStub(&pinnedStates, mut)
+		view.ExecuteQueriesCalled = func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+			numCalls++
+			assert.Equal(t, 2, len(queries))
+			return make([]*process.SCQueryResult, 0), nil, nil
+		}
+		pool, _ := NewStateViewsPool(createStateViewsPoolArgs(view))
+
+		queries := []*process.SCQuery{
+			{BlockHash: []byte("hash")},
+			{BlockHash: []byte("hash")},
+		}
+		_, _, err := pool.ExecuteQueries(queries)
+		assert.Nil(t, err)
+		_, _, err = pool.ExecuteQueries(queries)
+		assert.Nil(t, err)
+
+		assert.Equal(t, 2, numCalls)
+		assert.Equal(t, []string{"hash"}, pinnedStates)
+	})
+}
+
+func TestStateViewsPool_ComputeScCallGasLimitShouldUnpinTheView(t *testing.T) {
+	t.Parallel()
+
+	mut := &sync.Mutex{}
+	pinnedStates := make([]string, 0)
+	pool, _ := NewStateViewsPool(createStateViewsPoolArgs(createStateViewStub(&pinnedStates, mut)))
+
+	_, _, err := pool.ExecuteQuery(&process.SCQuery{BlockHash: []byte("hash")})
+	require.Nil(t, err)
+
+	gasLimit, err := pool.ComputeScCallGasLimit(&transaction.Transaction{})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100), gasLimit)
+
+	_, _, err = pool.ExecuteQuery(&process.SCQuery{BlockHash: []byte("hash")})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"hash", "hash"}, pinnedStates)
+}
+
+func TestStateViewsPool_Close(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numCloseCalls := 0
+	pool, _ := NewStateViewsPool(createStateViewsPoolArgs(
+		&mock.ScQueryStub{
+			CloseCalled: func() error {
+				numCloseCalls++
+				return expectedErr
+			},
+		},
+		&mock.ScQueryStub{
+			CloseCalled: func() error {
+				numCloseCalls++
+				return nil
+			},
+		},
+	))
+
+	err := pool.Close()
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, numCloseCalls)
+}
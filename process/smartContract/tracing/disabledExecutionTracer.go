@@ -0,0 +1,36 @@
+package tracing
+
+import (
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+type disabledExecutionTracer struct {
+}
+
+// NewDisabledExecutionTracer returns a new instance of an execution tracer that does not record anything
+func NewDisabledExecutionTracer() *disabledExecutionTracer {
+	return &disabledExecutionTracer{}
+}
+
+// TraceCall does nothing
+func (tracer *disabledExecutionTracer) TraceCall(_ process.TracedExecution, _ *vmcommon.ContractCallInput, _ *vmcommon.VMOutput, _ error) {
+}
+
+// TraceBuiltInFunctionCall does nothing
+func (tracer *disabledExecutionTracer) TraceBuiltInFunctionCall(_ process.TracedExecution, _ *vmcommon.ContractCallInput, _ *vmcommon.VMOutput, _ error) {
+}
+
+// TraceDeploy does nothing
+func (tracer *disabledExecutionTracer) TraceDeploy(_ process.TracedExecution, _ []byte, _ *vmcommon.ContractCreateInput, _ *vmcommon.VMOutput, _ error) {
+}
+
+// GetTransactionTrace returns ErrExecutionTracingDisabled
+func (tracer *disabledExecutionTracer) GetTransactionTrace(_ []byte) (*TransactionTrace, error) {
+	return nil, ErrExecutionTracingDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracer *disabledExecutionTracer) IsInterfaceNil() bool {
+	return tracer == nil
+}
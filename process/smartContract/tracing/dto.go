@@ -0,0 +1,73 @@
+package tracing
+
+// CallFrameType defines the kind of execution recorded by a call frame
+type CallFrameType string
+
+const (
+	// DeployFrame is the call frame of a smart contract deployment
+	DeployFrame CallFrameType = "deploy"
+	// SmartContractCallFrame is the call frame of a smart contract call executed by a VM
+	SmartContractCallFrame CallFrameType = "smartContractCall"
+	// BuiltInFunctionCallFrame is the call frame of a built-in function call
+	BuiltInFunctionCallFrame CallFrameType = "builtInFunctionCall"
+)
+
+// ESDTTransfer holds an ESDT transfer provided to a call frame
+type ESDTTransfer struct {
+	Token string `json:"token"`
+	Nonce uint64 `json:"nonce,omitempty"`
+	Value string `json:"value"`
+}
+
+// OutputTransfer holds a transfer generated by a call frame, to be executed by the next call frames
+type OutputTransfer struct {
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Value    string `json:"value"`
+	GasLimit uint64 `json:"gasLimit"`
+	Data     string `json:"data,omitempty"`
+	CallType string `json:"callType"`
+}
+
+// NestedCall holds a synchronous call made by the VM host on behalf of a call frame, to a smart contract or to a
+// built-in function transferring tokens
+type NestedCall struct {
+	CallType      string          `json:"callType"`
+	Caller        string          `json:"caller"`
+	Callee        string          `json:"callee"`
+	Function      string          `json:"function,omitempty"`
+	Arguments     []string        `json:"arguments,omitempty"`
+	Value         string          `json:"value"`
+	ESDTTransfers []*ESDTTransfer `json:"esdtTransfers,omitempty"`
+}
+
+// CallFrame holds the details of a smart contract execution
+type CallFrame struct {
+	Type            CallFrameType     `json:"type"`
+	ShardID         uint32            `json:"shardID"`
+	BlockNonce      uint64            `json:"blockNonce"`
+	BlockRound      uint64            `json:"blockRound"`
+	TxHash          string            `json:"txHash"`
+	PrevTxHash      string            `json:"prevTxHash,omitempty"`
+	CallType        string            `json:"callType"`
+	Caller          string            `json:"caller"`
+	Callee          string            `json:"callee,omitempty"`
+	Function        string            `json:"function,omitempty"`
+	Arguments       []string          `json:"arguments,omitempty"`
+	Value           string            `json:"value"`
+	ESDTTransfers   []*ESDTTransfer   `json:"esdtTransfers,omitempty"`
+	GasProvided     uint64            `json:"gasProvided"`
+	GasUsed         uint64            `json:"gasUsed"`
+	ReturnCode      string            `json:"returnCode,omitempty"`
+	ReturnMessage   string            `json:"returnMessage,omitempty"`
+	ReturnData      []string          `json:"returnData,omitempty"`
+	NestedCalls     []*NestedCall     `json:"nestedCalls,omitempty"`
+	OutputTransfers []*OutputTransfer `json:"outputTransfers,omitempty"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// TransactionTrace holds all the call frames executed, in all the shards, on behalf of a transaction
+type TransactionTrace struct {
+	TxHash string       `json:"txHash"`
+	Frames []*CallFrame `json:"frames"`
+}
@@ -0,0 +1,15 @@
+package tracing
+
+import "errors"
+
+// ErrNilPubkeyConverter signals that a nil public key converter was provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrInvalidMaxTracedTransactions signals that an invalid maximum number of traced transactions was provided
+var ErrInvalidMaxTracedTransactions = errors.New("invalid maximum number of traced transactions")
+
+// ErrTraceNotFound signals that no execution trace was recorded for the provided transaction hash
+var ErrTraceNotFound = errors.New("execution trace not found")
+
+// ErrExecutionTracingDisabled signals that the execution tracing is not enabled
+var ErrExecutionTracingDisabled = errors.New("execution tracing is not enabled")
@@ -0,0 +1,399 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var log = logger.GetOrCreate("process/smartcontract/tracing")
+
+// nestedCallTypes holds the call types of the transfer log entries written by the VM host for the synchronous calls
+// executed within the same VM run. The log entries of the asynchronous calls and of the cross shard transfers have
+// other call types, as they are executed later, by their own call frames
+var nestedCallTypes = map[string]struct{}{
+	vmhost.ExecuteOnDestContextString: {},
+	vmhost.ExecuteOnSameContextString: {},
+}
+
+// numTopicsPerESDTTransfer is the number of topics describing each token of an ESDT transfer log entry: the token
+// identifier, the nonce and the value
+const numTopicsPerESDTTransfer = 3
+
+// ArgsExecutionTracer is the DTO used to create a new instance of the execution tracer
+type ArgsExecutionTracer struct {
+	PubkeyConverter       core.PubkeyConverter
+	MaxTracedTransactions int
+}
+
+type executionTracer struct {
+	pubkeyConverter       core.PubkeyConverter
+	maxTracedTransactions int
+
+	mut         sync.RWMutex
+	traces      map[string]*TransactionTrace
+	tracedOrder []string
+}
+
+// NewExecutionTracer creates a new execution tracer. It keeps the traces of the last MaxTracedTransactions transactions,
+// a trace gathering the call frames executed in all the shards on behalf of the same original transaction, including
+// the ones of the asynchronous calls and callbacks. The same instance can be shared by multiple nodes
+func NewExecutionTracer(args ArgsExecutionTracer) (*executionTracer, error) {
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if args.MaxTracedTransactions < 1 {
+		return nil, ErrInvalidMaxTracedTransactions
+	}
+
+	return &executionTracer{
+		pubkeyConverter:       args.PubkeyConverter,
+		maxTracedTransactions: args.MaxTracedTransactions,
+		traces:                make(map[string]*TransactionTrace),
+	}, nil
+}
+
+// TraceCall records the call frame of a smart contract call executed by a VM, including the synchronous calls made by
+// the VM host during the execution
+func (tracer *executionTracer) TraceCall(execution process.TracedExecution, input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error) {
+	tracer.traceCall(SmartContractCallFrame, execution, input, output, err)
+}
+
+// TraceBuiltInFunctionCall records the call frame of a built-in function call
+func (tracer *executionTracer) TraceBuiltInFunctionCall(execution process.TracedExecution, input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error) {
+	tracer.traceCall(BuiltInFunctionCallFrame, execution, input, output, err)
+}
+
+func (tracer *executionTracer) traceCall(frameType CallFrameType, execution process.TracedExecution, input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error) {
+	if input == nil {
+		return
+	}
+
+	frame := tracer.createCallFrame(frameType, execution, &input.VMInput, output, err)
+	frame.TxHash = hex.EncodeToString(input.CurrentTxHash)
+	frame.Callee = tracer.encodeAddress(input.RecipientAddr)
+	frame.Function = input.Function
+	frame.OutputTransfers = tracer.createOutputTransfers(input.RecipientAddr, output)
+
+	tracer.addCallFrame(getOriginalTxHash(&input.VMInput), frame)
+}
+
+// TraceDeploy records the call frame of a smart contract deployment, including the synchronous calls made by the VM host
+// during the execution of the init function
+func (tracer *executionTracer) TraceDeploy(execution process.TracedExecution, txHash []byte, input *vmcommon.ContractCreateInput, output *vmcommon.VMOutput, err error) {
+	if input == nil {
+		return
+	}
+
+	deployedAddress := getDeployedAddress(input.CallerAddr, output)
+	frame := tracer.createCallFrame(DeployFrame, execution, &input.VMInput, output, err)
+	frame.TxHash = hex.EncodeToString(txHash)
+	frame.Callee = tracer.encodeAddress(deployedAddress)
+	frame.OutputTransfers = tracer.createOutputTransfers(deployedAddress, output)
+
+	tracer.addCallFrame(txHash, frame)
+}
+
+func (tracer *executionTracer) createCallFrame(
+	frameType CallFrameType,
+	execution process.TracedExecution,
+	input *vmcommon.VMInput,
+	output *vmcommon.VMOutput,
+	err error,
+) *CallFrame {
+	frame := &CallFrame{
+		Type:          frameType,
+		ShardID:       execution.ShardID,
+		BlockNonce:    execution.BlockNonce,
+		BlockRound:    execution.BlockRound,
+		CallType:      input.CallType.ToString(),
+		Caller:        tracer.encodeAddress(input.CallerAddr),
+		Arguments:     encodeSlice(input.Arguments),
+		Value:         bigIntToString(input.CallValue),
+		ESDTTransfers: createESDTTransfers(input.ESDTTransfers),
+		GasProvided:   input.GasProvided,
+		GasUsed:       input.GasProvided,
+	}
+	if len(input.PrevTxHash) > 0 && !bytes.Equal(input.PrevTxHash, input.CurrentTxHash) {
+		frame.PrevTxHash = hex.EncodeToString(input.PrevTxHash)
+	}
+	if err != nil {
+		frame.Error = err.Error()
+	}
+	if output == nil {
+		return frame
+	}
+
+	if output.GasRemaining <= input.GasProvided {
+		frame.GasUsed = input.GasProvided - output.GasRemaining
+	}
+	frame.ReturnCode = output.ReturnCode.String()
+	frame.ReturnMessage = output.ReturnMessage
+	frame.ReturnData = encodeSlice(output.ReturnData)
+	frame.NestedCalls = tracer.createNestedCalls(output)
+
+	return frame
+}
+
+// createNestedCalls extracts the synchronous calls from the transfer log entries the VM host writes, in execution
+// order, on its execute on destination and execute on same context paths. The data of such a log entry holds the call
+// type, the called function and its arguments
+func (tracer *executionTracer) createNestedCalls(output *vmcommon.VMOutput) []*NestedCall {
+	nestedCalls := make([]*NestedCall, 0)
+	for _, logEntry := range output.Logs {
+		if logEntry == nil || len(logEntry.Data) < 2 || len(logEntry.Topics) < 2 {
+			continue
+		}
+		_, isNestedCall := nestedCallTypes[string(logEntry.Data[0])]
+		if !isNestedCall {
+			continue
+		}
+
+		nestedCall := &NestedCall{
+			CallType:  string(logEntry.Data[0]),
+			Caller:    tracer.encodeAddress(logEntry.Address),
+			Function:  string(logEntry.Data[1]),
+			Arguments: encodeSlice(logEntry.Data[2:]),
+			Value:     "0",
+		}
+
+		switch string(logEntry.Identifier) {
+		case vmhost.TransferValueOnlyString:
+			// topics: value and destination
+			nestedCall.Callee = tracer.encodeAddress(logEntry.Topics[1])
+			nestedCall.Value = big.NewInt(0).SetBytes(logEntry.Topics[0]).String()
+		case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTNFTTransfer, core.BuiltInFunctionMultiESDTNFTTransfer:
+			// topics: token identifier, nonce and value of each transferred token, followed by the destination
+			numTokensTopics := len(logEntry.Topics) - 1
+			if numTokensTopics%numTopicsPerESDTTransfer != 0 {
+				continue
+			}
+			nestedCall.Callee = tracer.encodeAddress(logEntry.Topics[numTokensTopics])
+			nestedCall.ESDTTransfers = createESDTTransfersFromTopics(logEntry.Topics[:numTokensTopics])
+		default:
+			continue
+		}
+
+		nestedCalls = append(nestedCalls, nestedCall)
+	}
+	if len(nestedCalls) == 0 {
+		return nil
+	}
+
+	return nestedCalls
+}
+
+func (tracer *executionTracer) createOutputTransfers(callee []byte, output *vmcommon.VMOutput) []*OutputTransfer {
+	type indexedTransfer struct {
+		receiver []byte
+		transfer vmcommon.OutputTransfer
+	}
+
+	transfers := make([]indexedTransfer, 0)
+	for _, outputAccount := range sortedOutputAccounts(output) {
+		for _, transfer := range outputAccount.OutputTransfers {
+			transfers = append(transfers, indexedTransfer{
+				receiver: outputAccount.Address,
+				transfer: transfer,
+			})
+		}
+	}
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	// the VM assigns increasing indexes to the transfers, in the order they were generated
+	sort.SliceStable(transfers, func(i, j int) bool {
+		return transfers[i].transfer.Index < transfers[j].transfer.Index
+	})
+
+	outputTransfers := make([]*OutputTransfer, 0, len(transfers))
+	for _, indexed := range transfers {
+		sender := indexed.transfer.SenderAddress
+		if len(sender) == 0 {
+			sender = callee
+		}
+
+		outputTransfers = append(outputTransfers, &OutputTransfer{
+			Sender:   tracer.encodeAddress(sender),
+			Receiver: tracer.encodeAddress(indexed.receiver),
+			Value:    bigIntToString(indexed.transfer.Value),
+			GasLimit: indexed.transfer.GasLimit,
+			Data:     string(indexed.transfer.Data),
+			CallType: indexed.transfer.CallType.ToString(),
+		})
+	}
+
+	return outputTransfers
+}
+
+func (tracer *executionTracer) addCallFrame(txHash []byte, frame *CallFrame) {
+	key := string(txHash)
+
+	tracer.mut.Lock()
+	defer tracer.mut.Unlock()
+
+	trace, found := tracer.traces[key]
+	if !found {
+		tracer.evictOldestTraceIfNeeded()
+
+		trace = &TransactionTrace{
+			TxHash: hex.EncodeToString(txHash),
+		}
+		tracer.traces[key] = trace
+		tracer.tracedOrder = append(tracer.tracedOrder, key)
+	}
+
+	trace.Frames = append(removeRevertedFrames(trace.Frames, frame), frame)
+	log.Trace("executionTracer.addCallFrame", "tx hash", txHash, "type", frame.Type, "shard", frame.ShardID,
+		"block nonce", frame.BlockNonce, "block round", frame.BlockRound, "callee", frame.Callee, "function", frame.Function)
+}
+
+// removeRevertedFrames removes the call frames recorded by a previous execution, in the same shard, of the block the
+// new frame belongs to or of a later block. A block with the same or a lower nonce being executed in another round
+// means those blocks were reverted, so their frames are replaced by the ones of the re-execution
+func removeRevertedFrames(frames []*CallFrame, newFrame *CallFrame) []*CallFrame {
+	keptFrames := frames[:0]
+	for _, frame := range frames {
+		isReverted := frame.ShardID == newFrame.ShardID &&
+			frame.BlockNonce >= newFrame.BlockNonce &&
+			frame.BlockRound != newFrame.BlockRound
+		if !isReverted {
+			keptFrames = append(keptFrames, frame)
+		}
+	}
+
+	return keptFrames
+}
+
+func (tracer *executionTracer) evictOldestTraceIfNeeded() {
+	if len(tracer.tracedOrder) < tracer.maxTracedTransactions {
+		return
+	}
+
+	delete(tracer.traces, tracer.tracedOrder[0])
+	tracer.tracedOrder = tracer.tracedOrder[1:]
+}
+
+// GetTransactionTrace returns the call frames recorded for the provided original transaction hash
+func (tracer *executionTracer) GetTransactionTrace(txHash []byte) (*TransactionTrace, error) {
+	tracer.mut.RLock()
+	defer tracer.mut.RUnlock()
+
+	trace, found := tracer.traces[string(txHash)]
+	if !found {
+		return nil, ErrTraceNotFound
+	}
+
+	return &TransactionTrace{
+		TxHash: trace.TxHash,
+		Frames: append(make([]*CallFrame, 0, len(trace.Frames)), trace.Frames...),
+	}, nil
+}
+
+func (tracer *executionTracer) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return tracer.pubkeyConverter.SilentEncode(address, log)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracer *executionTracer) IsInterfaceNil() bool {
+	return tracer == nil
+}
+
+func getOriginalTxHash(input *vmcommon.VMInput) []byte {
+	if len(input.OriginalTxHash) > 0 {
+		return input.OriginalTxHash
+	}
+
+	return input.CurrentTxHash
+}
+
+func getDeployedAddress(deployer []byte, output *vmcommon.VMOutput) []byte {
+	for _, outputAccount := range sortedOutputAccounts(output) {
+		if len(outputAccount.Code) > 0 && bytes.Equal(outputAccount.CodeDeployerAddress, deployer) {
+			return outputAccount.Address
+		}
+	}
+
+	return nil
+}
+
+func sortedOutputAccounts(output *vmcommon.VMOutput) []*vmcommon.OutputAccount {
+	if output == nil {
+		return nil
+	}
+
+	outputAccounts := make([]*vmcommon.OutputAccount, 0, len(output.OutputAccounts))
+	for _, outputAccount := range output.OutputAccounts {
+		outputAccounts = append(outputAccounts, outputAccount)
+	}
+	sort.Slice(outputAccounts, func(i, j int) bool {
+		return bytes.Compare(outputAccounts[i].Address, outputAccounts[j].Address) < 0
+	})
+
+	return outputAccounts
+}
+
+func createESDTTransfers(esdtTransfers []*vmcommon.ESDTTransfer) []*ESDTTransfer {
+	if len(esdtTransfers) == 0 {
+		return nil
+	}
+
+	transfers := make([]*ESDTTransfer, 0, len(esdtTransfers))
+	for _, esdtTransfer := range esdtTransfers {
+		transfers = append(transfers, &ESDTTransfer{
+			Token: string(esdtTransfer.ESDTTokenName),
+			Nonce: esdtTransfer.ESDTTokenNonce,
+			Value: bigIntToString(esdtTransfer.ESDTValue),
+		})
+	}
+
+	return transfers
+}
+
+func createESDTTransfersFromTopics(topics [][]byte) []*ESDTTransfer {
+	transfers := make([]*ESDTTransfer, 0, len(topics)/numTopicsPerESDTTransfer)
+	for i := 0; i+numTopicsPerESDTTransfer <= len(topics); i += numTopicsPerESDTTransfer {
+		transfers = append(transfers, &ESDTTransfer{
+			Token: string(topics[i]),
+			Nonce: big.NewInt(0).SetBytes(topics[i+1]).Uint64(),
+			Value: big.NewInt(0).SetBytes(topics[i+2]).String(),
+		})
+	}
+
+	return transfers
+}
+
+func encodeSlice(values [][]byte) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded = append(encoded, hex.EncodeToString(value))
+	}
+
+	return encoded
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
@@ -0,0 +1,451 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	originalTxHash = []byte("original tx hash")
+	callerAddress  = []byte("caller")
+	calleeAddress  = []byte("callee")
+	otherAddress   = []byte("other")
+)
+
+func createMockArgsExecutionTracer() ArgsExecutionTracer {
+	return ArgsExecutionTracer{
+		PubkeyConverter:       testscommon.NewPubkeyConverterMock(32),
+		MaxTracedTransactions: 10,
+	}
+}
+
+func createContractCallInput(currentTxHash []byte, callType vm.CallType) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:     callerAddress,
+			Arguments:      [][]byte{[]byte("arg1"), []byte("arg2")},
+			CallValue:      big.NewInt(100),
+			CallType:       callType,
+			GasProvided:    1000,
+			OriginalTxHash: originalTxHash,
+			CurrentTxHash:  currentTxHash,
+			PrevTxHash:     originalTxHash,
+			ESDTTransfers: []*vmcommon.ESDTTransfer{
+				{
+					ESDTValue:      big.NewInt(5),
+					ESDTTokenName:  []byte("TKN-123456"),
+					ESDTTokenNonce: 2,
+				},
+			},
+		},
+		RecipientAddr: calleeAddress,
+		Function:      "function",
+	}
+}
+
+func TestNewExecutionTracer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExecutionTracer()
+		args.PubkeyConverter = nil
+		tracer, err := NewExecutionTracer(args)
+		require.Equal(t, ErrNilPubkeyConverter, err)
+		require.Nil(t, tracer)
+	})
+	t.Run("invalid max traced transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExecutionTracer()
+		args.MaxTracedTransactions = 0
+		tracer, err := NewExecutionTracer(args)
+		require.Equal(t, ErrInvalidMaxTracedTransactions, err)
+		require.Nil(t, tracer)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := NewExecutionTracer(createMockArgsExecutionTracer())
+		require.Nil(t, err)
+		require.False(t, tracer.IsInterfaceNil())
+	})
+}
+
+func TestExecutionTracer_TraceCall(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(createMockArgsExecutionTracer())
+	converter := testscommon.NewPubkeyConverterMock(32)
+
+	output := &vmcommon.VMOutput{
+		ReturnData:    [][]byte{[]byte("result")},
+		ReturnCode:    vmcommon.Ok,
+		ReturnMessage: "message",
+		GasRemaining:  400,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(otherAddress): {
+				Address: otherAddress,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Index:    2,
+						Value:    big.NewInt(7),
+						GasLimit: 300,
+						Data:     []byte("callback@00"),
+						CallType: vm.AsynchronousCallBack,
+					},
+				},
+			},
+			string(callerAddress): {
+				Address: callerAddress,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Index:         1,
+						Value:         big.NewInt(3),
+						Data:          []byte("asyncCall"),
+						CallType:      vm.AsynchronousCall,
+						SenderAddress: otherAddress,
+					},
+				},
+			},
+		},
+	}
+	tracer.TraceCall(process.TracedExecution{ShardID: 1, BlockNonce: 5, BlockRound: 6}, createContractCallInput(originalTxHash, vm.DirectCall), output, nil)
+	tracer.TraceBuiltInFunctionCall(process.TracedExecution{ShardID: 2, BlockNonce: 7, BlockRound: 8}, createContractCallInput([]byte("scr hash"), vm.AsynchronousCall), nil, errors.New("built-in function error"))
+
+	trace, err := tracer.GetTransactionTrace(originalTxHash)
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString(originalTxHash), trace.TxHash)
+	require.Equal(t, 2, len(trace.Frames))
+
+	expectedCallFrame := &CallFrame{
+		Type:        SmartContractCallFrame,
+		ShardID:     1,
+		BlockNonce:  5,
+		BlockRound:  6,
+		TxHash:      hex.EncodeToString(originalTxHash),
+		CallType:    vm.DirectCallStr,
+		Caller:      converter.SilentEncode(callerAddress, log),
+		Callee:      converter.SilentEncode(calleeAddress, log),
+		Function:    "function",
+		Arguments:   []string{hex.EncodeToString([]byte("arg1")), hex.EncodeToString([]byte("arg2"))},
+		Value:       "100",
+		GasProvided: 1000,
+		GasUsed:     600,
+		ESDTTransfers: []*ESDTTransfer{
+			{
+				Token: "TKN-123456",
+				Nonce: 2,
+				Value: "5",
+			},
+		},
+		ReturnCode:    vmcommon.Ok.String(),
+		ReturnMessage: "message",
+		ReturnData:    []string{hex.EncodeToString([]byte("result"))},
+		OutputTransfers: []*OutputTransfer{
+			{
+				Sender:   converter.SilentEncode(otherAddress, log),
+				Receiver: converter.SilentEncode(callerAddress, log),
+				Value:    "3",
+				Data:     "asyncCall",
+				CallType: vm.AsynchronousCallStr,
+			},
+			{
+				Sender:   converter.SilentEncode(calleeAddress, log),
+				Receiver: converter.SilentEncode(otherAddress, log),
+				Value:    "7",
+				GasLimit: 300,
+				Data:     "callback@00",
+				CallType: vm.AsynchronousCallBackStr,
+			},
+		},
+	}
+	require.Equal(t, expectedCallFrame, trace.Frames[0])
+
+	builtInFunctionFrame := trace.Frames[1]
+	require.Equal(t, BuiltInFunctionCallFrame, builtInFunctionFrame.Type)
+	require.Equal(t, uint32(2), builtInFunctionFrame.ShardID)
+	require.Equal(t, uint64(7), builtInFunctionFrame.BlockNonce)
+	require.Equal(t, uint64(8), builtInFunctionFrame.BlockRound)
+	require.Equal(t, hex.EncodeToString([]byte("scr hash")), builtInFunctionFrame.TxHash)
+	require.Equal(t, hex.EncodeToString(originalTxHash), builtInFunctionFrame.PrevTxHash)
+	require.Equal(t, vm.AsynchronousCallStr, builtInFunctionFrame.CallType)
+	require.Equal(t, uint64(1000), builtInFunctionFrame.GasUsed)
+	require.Equal(t, "built-in function error", builtInFunctionFrame.Error)
+	require.Empty(t, builtInFunctionFrame.ReturnCode)
+
+	_, err = json.Marshal(trace)
+	require.Nil(t, err)
+}
+
+func TestExecutionTracer_TraceCallWithNestedCalls(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(createMockArgsExecutionTracer())
+	converter := testscommon.NewPubkeyConverterMock(32)
+
+	output := &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		Logs: []*vmcommon.LogEntry{
+			{
+				Identifier: []byte(vmhost.TransferValueOnlyString),
+				Address:    calleeAddress,
+				Topics:     [][]byte{big.NewInt(10).Bytes(), otherAddress},
+				Data:       vmcommon.FormatLogDataForCall(vmhost.ExecuteOnDestContextString, "nested", [][]byte{[]byte("arg")}),
+			},
+			{
+				Identifier: []byte("event"),
+				Address:    otherAddress,
+				Topics:     [][]byte{[]byte("topic")},
+			},
+			{
+				Identifier: []byte(core.BuiltInFunctionESDTTransfer),
+				Address:    otherAddress,
+				Topics:     [][]byte{[]byte("TKN-123456"), nil, big.NewInt(3).Bytes(), callerAddress},
+				Data:       vmcommon.FormatLogDataForCall(vmhost.ExecuteOnDestContextString, core.BuiltInFunctionESDTTransfer, [][]byte{[]byte("TKN-123456"), {3}}),
+			},
+			{
+				Identifier: []byte(vmhost.TransferValueOnlyString),
+				Address:    calleeAddress,
+				Topics:     [][]byte{nil, otherAddress},
+				Data:       vmcommon.FormatLogDataForCall(vmhost.ExecuteOnSameContextString, "library", nil),
+			},
+			{
+				Identifier: []byte(vmhost.TransferValueOnlyString),
+				Address:    calleeAddress,
+				Topics:     [][]byte{nil, otherAddress},
+				Data:       vmcommon.FormatLogDataForCall(vmhost.AsyncCallString, "async", nil),
+			},
+			{
+				Identifier: []byte(vmhost.TransferValueOnlyString),
+				Address:    callerAddress,
+				Topics:     [][]byte{big.NewInt(100).Bytes(), calleeAddress},
+				Data:       vmcommon.FormatLogDataForCall(vmhost.DirectCallString, "function", nil),
+			},
+		},
+	}
+	tracer.TraceCall(process.TracedExecution{}, createContractCallInput(originalTxHash, vm.DirectCall), output, nil)
+
+	trace, err := tracer.GetTransactionTrace(originalTxHash)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(trace.Frames))
+
+	expectedNestedCalls := []*NestedCall{
+		{
+			CallType:  vmhost.ExecuteOnDestContextString,
+			Caller:    converter.SilentEncode(calleeAddress, log),
+			Callee:    converter.SilentEncode(otherAddress, log),
+			Function:  "nested",
+			Arguments: []string{hex.EncodeToString([]byte("arg"))},
+			Value:     "10",
+		},
+		{
+			CallType:  vmhost.ExecuteOnDestContextString,
+			Caller:    converter.SilentEncode(otherAddress, log),
+			Callee:    converter.SilentEncode(callerAddress, log),
+			Function:  core.BuiltInFunctionESDTTransfer,
+			Arguments: []string{hex.EncodeToString([]byte("TKN-123456")), "03"},
+			Value:     "0",
+			ESDTTransfers: []*ESDTTransfer{
+				{
+					Token: "TKN-123456",
+					Value: "3",
+				},
+			},
+		},
+		{
+			CallType: vmhost.ExecuteOnSameContextString,
+			Caller:   converter.SilentEncode(calleeAddress, log),
+			Callee:   converter.SilentEncode(otherAddress, log),
+			Function: "library",
+			Value:    "0",
+		},
+	}
+	require.Equal(t, expectedNestedCalls, trace.Frames[0].NestedCalls)
+}
+
+func TestExecutionTracer_TraceDeploy(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(createMockArgsExecutionTracer())
+	converter := testscommon.NewPubkeyConverterMock(32)
+
+	txHash := []byte("deploy tx hash")
+	input := &vmcommon.ContractCreateInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  callerAddress,
+			Arguments:   [][]byte{[]byte("init")},
+			CallValue:   big.NewInt(0),
+			GasProvided: 500,
+		},
+		ContractCode: []byte("code"),
+	}
+	output := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 100,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(callerAddress): {
+				Address: callerAddress,
+			},
+			string(calleeAddress): {
+				Address:             calleeAddress,
+				Code:                []byte("code"),
+				CodeDeployerAddress: callerAddress,
+			},
+		},
+	}
+	tracer.TraceDeploy(process.TracedExecution{}, txHash, input, output, nil)
+	tracer.TraceDeploy(process.TracedExecution{}, txHash, nil, output, nil)
+
+	trace, err := tracer.GetTransactionTrace(txHash)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(trace.Frames))
+
+	frame := trace.Frames[0]
+	require.Equal(t, DeployFrame, frame.Type)
+	require.Equal(t, hex.EncodeToString(txHash), frame.TxHash)
+	require.Equal(t, converter.SilentEncode(callerAddress, log), frame.Caller)
+	require.Equal(t, converter.SilentEncode(calleeAddress, log), frame.Callee)
+	require.Equal(t, vm.DirectCallStr, frame.CallType)
+	require.Equal(t, uint64(400), frame.GasUsed)
+	require.Empty(t, frame.Function)
+	require.Empty(t, frame.PrevTxHash)
+}
+
+func TestExecutionTracer_GetTransactionTrace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, _ := NewExecutionTracer(createMockArgsExecutionTracer())
+		trace, err := tracer.GetTransactionTrace([]byte("unknown"))
+		require.Equal(t, ErrTraceNotFound, err)
+		require.Nil(t, trace)
+	})
+	t.Run("should keep only the last traced transactions", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExecutionTracer()
+		args.MaxTracedTransactions = 2
+		tracer, _ := NewExecutionTracer(args)
+
+		traceTransaction := func(txHash string) {
+			input := createContractCallInput([]byte(txHash), vm.DirectCall)
+			input.OriginalTxHash = []byte(txHash)
+			tracer.TraceCall(process.TracedExecution{}, input, nil, nil)
+		}
+		traceTransaction("tx1")
+		traceTransaction("tx2")
+		traceTransaction("tx2")
+		traceTransaction("tx3")
+
+		_, err := tracer.GetTransactionTrace([]byte("tx1"))
+		require.Equal(t, ErrTraceNotFound, err)
+
+		trace, err := tracer.GetTransactionTrace([]byte("tx2"))
+		require.Nil(t, err)
+		require.Equal(t, 2, len(trace.Frames))
+
+		trace, err = tracer.GetTransactionTrace([]byte("tx3"))
+		require.Nil(t, err)
+		require.Equal(t, 1, len(trace.Frames))
+	})
+	t.Run("returned trace should not change on new call frames", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, _ := NewExecutionTracer(createMockArgsExecutionTracer())
+		tracer.TraceCall(process.TracedExecution{}, createContractCallInput(originalTxHash, vm.DirectCall), nil, nil)
+
+		trace, _ := tracer.GetTransactionTrace(originalTxHash)
+		tracer.TraceCall(process.TracedExecution{ShardID: 1}, createContractCallInput([]byte("scr hash"), vm.AsynchronousCall), nil, nil)
+		require.Equal(t, 1, len(trace.Frames))
+	})
+	t.Run("re-executed block should replace the call frames", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, _ := NewExecutionTracer(createMockArgsExecutionTracer())
+		traceCall := func(execution process.TracedExecution, currentTxHash string) {
+			tracer.TraceCall(execution, createContractCallInput([]byte(currentTxHash), vm.DirectCall), nil, nil)
+		}
+		traceCall(process.TracedExecution{ShardID: 0, BlockNonce: 10, BlockRound: 10}, "tx")
+		traceCall(process.TracedExecution{ShardID: 1, BlockNonce: 11, BlockRound: 11}, "scr on shard 1")
+		traceCall(process.TracedExecution{ShardID: 0, BlockNonce: 12, BlockRound: 12}, "callback")
+		traceCall(process.TracedExecution{ShardID: 0, BlockNonce: 12, BlockRound: 12}, "callback continuation")
+
+		// the block with nonce 12 of shard 0 was reverted and executed again in a later round
+		traceCall(process.TracedExecution{ShardID: 0, BlockNonce: 12, BlockRound: 13}, "callback")
+
+		trace, err := tracer.GetTransactionTrace(originalTxHash)
+		require.Nil(t, err)
+		frameTxHashes := make([]string, 0, len(trace.Frames))
+		for _, frame := range trace.Frames {
+			frameTxHashes = append(frameTxHashes, frame.TxHash)
+		}
+		expectedTxHashes := []string{
+			hex.EncodeToString([]byte("tx")),
+			hex.EncodeToString([]byte("scr on shard 1")),
+			hex.EncodeToString([]byte("callback")),
+		}
+		require.Equal(t, expectedTxHashes, frameTxHashes)
+		require.Equal(t, uint64(13), trace.Frames[2].BlockRound)
+	})
+}
+
+func TestExecutionTracer_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(createMockArgsExecutionTracer())
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			txHash := []byte(fmt.Sprintf("tx%d", idx%20))
+			switch idx % 3 {
+			case 0:
+				input := createContractCallInput(txHash, vm.DirectCall)
+				input.OriginalTxHash = txHash
+				tracer.TraceCall(process.TracedExecution{}, input, nil, nil)
+			case 1:
+				tracer.TraceDeploy(process.TracedExecution{}, txHash, &vmcommon.ContractCreateInput{}, nil, nil)
+			default:
+				_, _ = tracer.GetTransactionTrace(txHash)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+func TestDisabledExecutionTracer(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewDisabledExecutionTracer()
+	require.False(t, tracer.IsInterfaceNil())
+
+	tracer.TraceCall(process.TracedExecution{}, createContractCallInput(originalTxHash, vm.DirectCall), nil, nil)
+	tracer.TraceBuiltInFunctionCall(process.TracedExecution{}, createContractCallInput(originalTxHash, vm.DirectCall), nil, nil)
+	tracer.TraceDeploy(process.TracedExecution{}, originalTxHash, &vmcommon.ContractCreateInput{}, nil, nil)
+
+	trace, err := tracer.GetTransactionTrace(originalTxHash)
+	require.Equal(t, ErrExecutionTracingDisabled, err)
+	require.Nil(t, trace)
+}
@@ -0,0 +1,9 @@
+package tracing
+
+import "github.com/multiversx/mx-chain-go/process"
+
+// ExecutionTracerHandler defines the execution tracer able to also provide the recorded traces
+type ExecutionTracerHandler interface {
+	process.ExecutionTracer
+	GetTransactionTrace(txHash []byte) (*TransactionTrace, error)
+}
@@ -785,6 +785,21 @@ func (bbt *baseBlockTrack) RestoreToGenesis() {
 	bbt.restoreTrackedHeadersToGenesis()
 }
 
+// RestoreHeaders replaces the cross notarized, self notarized and tracked headers with the provided ones
+func (bbt *baseBlockTrack) RestoreHeaders(
+	crossNotarizedHeaders map[uint32][]*HeaderInfo,
+	selfNotarizedHeaders map[uint32][]*HeaderInfo,
+	trackedHeaders []*HeaderInfo,
+) {
+	bbt.crossNotarizer.RestoreNotarizedHeaders(crossNotarizedHeaders)
+	bbt.selfNotarizer.RestoreNotarizedHeaders(selfNotarizedHeaders)
+	bbt.restoreTrackedHeadersToGenesis()
+
+	for _, trackedHeader := range trackedHeaders {
+		_ = bbt.addHeader(trackedHeader.Header, trackedHeader.Hash)
+	}
+}
+
 func (bbt *baseBlockTrack) restoreTrackedHeadersToGenesis() {
 	bbt.mutHeaders.Lock()
 	bbt.headers = make(map[uint32]map[uint64][]*HeaderInfo)
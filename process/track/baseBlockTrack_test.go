@@ -2151,6 +2151,58 @@ func TestRestoreToGenesis_ShouldWork(t *testing.T) {
 	assert.Equal(t, shardArguments.StartHeaders[header.GetShardID()], lastSelfNotarizedHeader)
 }
 
+func TestRestoreHeaders_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	shardArguments := CreateShardTrackerMockArguments()
+	sbt, _ := track.NewShardBlockTrack(shardArguments)
+
+	metaBlock1 := &block.MetaBlock{Nonce: 1}
+	metaBlock1Hash := []byte("meta hash 1")
+	sbt.AddCrossNotarizedHeader(metaBlock1.GetShardID(), metaBlock1, metaBlock1Hash)
+	sbt.AddTrackedHeader(metaBlock1, metaBlock1Hash)
+
+	header1 := &block.Header{
+		ShardID: shardArguments.ShardCoordinator.SelfId(),
+		Nonce:   1,
+	}
+	header1Hash := []byte("hash 1")
+	sbt.AddSelfNotarizedHeader(header1.GetShardID(), header1, header1Hash)
+
+	metaBlock2 := &block.MetaBlock{Nonce: 2}
+	metaBlock2Hash := []byte("meta hash 2")
+	sbt.AddCrossNotarizedHeader(metaBlock2.GetShardID(), metaBlock2, metaBlock2Hash)
+	sbt.AddTrackedHeader(metaBlock2, metaBlock2Hash)
+
+	sbt.RestoreHeaders(
+		map[uint32][]*track.HeaderInfo{
+			core.MetachainShardId: {
+				{Header: shardArguments.StartHeaders[core.MetachainShardId], Hash: []byte("meta genesis hash")},
+				{Header: metaBlock1, Hash: metaBlock1Hash},
+			},
+		},
+		map[uint32][]*track.HeaderInfo{
+			header1.GetShardID(): {
+				{Header: shardArguments.StartHeaders[header1.GetShardID()], Hash: []byte("genesis hash")},
+			},
+		},
+		[]*track.HeaderInfo{
+			{Header: metaBlock1, Hash: metaBlock1Hash},
+		},
+	)
+
+	trackedHeaders, _ := sbt.GetTrackedHeaders(core.MetachainShardId)
+	require.Equal(t, 1, len(trackedHeaders))
+	assert.Equal(t, metaBlock1, trackedHeaders[0])
+
+	lastCrossNotarizedHeader, lastCrossNotarizedHeaderHash, _ := sbt.GetLastCrossNotarizedHeader(core.MetachainShardId)
+	assert.Equal(t, metaBlock1, lastCrossNotarizedHeader)
+	assert.Equal(t, metaBlock1Hash, lastCrossNotarizedHeaderHash)
+
+	lastSelfNotarizedHeader, _, _ := sbt.GetLastSelfNotarizedHeader(header1.GetShardID())
+	assert.Equal(t, shardArguments.StartHeaders[header1.GetShardID()], lastSelfNotarizedHeader)
+}
+
 func TestCheckTrackerNilParameters_ShouldErrNilHasher(t *testing.T) {
 	t.Parallel()
 
@@ -260,6 +260,26 @@ func (bn *blockNotarizer) RestoreNotarizedHeadersToGenesis() {
 	bn.mutNotarizedHeaders.Unlock()
 }
 
+// RestoreNotarizedHeaders replaces the notarized headers of each given shard with the provided ones. The shards
+// which are not given, or are given with an empty list, keep their current notarized headers
+func (bn *blockNotarizer) RestoreNotarizedHeaders(notarizedHeaders map[uint32][]*HeaderInfo) {
+	bn.mutNotarizedHeaders.Lock()
+	for shardID, headersInfo := range notarizedHeaders {
+		if len(headersInfo) == 0 {
+			continue
+		}
+
+		restoredHeadersInfo := make([]*HeaderInfo, len(headersInfo))
+		copy(restoredHeadersInfo, headersInfo)
+		sort.Slice(restoredHeadersInfo, func(i, j int) bool {
+			return restoredHeadersInfo[i].Header.GetNonce() < restoredHeadersInfo[j].Header.GetNonce()
+		})
+
+		bn.notarizedHeaders[shardID] = restoredHeadersInfo
+	}
+	bn.mutNotarizedHeaders.Unlock()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (bn *blockNotarizer) IsInterfaceNil() bool {
 	return bn == nil
@@ -419,3 +419,51 @@ func TestRestoreNotarizedHeadersToGenesis_ShouldWork(t *testing.T) {
 	assert.Equal(t, 1, len(bn.GetNotarizedHeaders()[0]))
 	assert.Equal(t, &hdr1, lastNotarizedHeader)
 }
+
+func TestRestoreNotarizedHeaders_ShouldKeepShardsWithoutProvidedHeaders(t *testing.T) {
+	t.Parallel()
+
+	bn, _ := track.NewBlockNotarizer(&hashingMocks.HasherMock{}, &mock.MarshalizerMock{}, mock.NewMultipleShardsCoordinatorMock())
+
+	hdr1 := block.Header{Nonce: 1}
+	hdr2 := block.Header{Nonce: 2, ShardID: 1}
+	bn.AddNotarizedHeader(0, &hdr1, nil)
+	bn.AddNotarizedHeader(1, &hdr2, nil)
+
+	bn.RestoreNotarizedHeaders(map[uint32][]*track.HeaderInfo{
+		0: {},
+	})
+
+	assert.Equal(t, 1, len(bn.GetNotarizedHeaders()[0]))
+	assert.Equal(t, 1, len(bn.GetNotarizedHeaders()[1]))
+}
+
+func TestRestoreNotarizedHeaders_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	bn, _ := track.NewBlockNotarizer(&hashingMocks.HasherMock{}, &mock.MarshalizerMock{}, mock.NewMultipleShardsCoordinatorMock())
+
+	hdr1 := block.Header{Nonce: 1}
+	hdr2 := block.Header{Nonce: 2}
+	hdr3 := block.Header{Nonce: 3}
+	hdr4 := block.Header{Nonce: 4}
+	bn.AddNotarizedHeader(0, &hdr3, []byte("hash3"))
+	bn.AddNotarizedHeader(0, &hdr4, []byte("hash4"))
+
+	bn.RestoreNotarizedHeaders(map[uint32][]*track.HeaderInfo{
+		0: {
+			{Header: &hdr2, Hash: []byte("hash2")},
+			{Header: &hdr1, Hash: []byte("hash1")},
+		},
+	})
+
+	assert.Equal(t, 2, len(bn.GetNotarizedHeaders()[0]))
+
+	firstNotarizedHeader, firstNotarizedHeaderHash, _ := bn.GetFirstNotarizedHeader(0)
+	assert.Equal(t, &hdr1, firstNotarizedHeader)
+	assert.Equal(t, []byte("hash1"), firstNotarizedHeaderHash)
+
+	lastNotarizedHeader, lastNotarizedHeaderHash, _ := bn.GetLastNotarizedHeader(0)
+	assert.Equal(t, &hdr2, lastNotarizedHeader)
+	assert.Equal(t, []byte("hash2"), lastNotarizedHeaderHash)
+}
@@ -16,6 +16,7 @@ type blockNotarizerHandler interface {
 	InitNotarizedHeaders(startHeaders map[uint32]data.HeaderHandler) error
 	RemoveLastNotarizedHeader()
 	RestoreNotarizedHeadersToGenesis()
+	RestoreNotarizedHeaders(notarizedHeaders map[uint32][]*HeaderInfo)
 	IsInterfaceNil() bool
 }
 
@@ -79,6 +79,8 @@ type AccountsDB struct {
 	storagePruningManager  StoragePruningManager
 	obsoleteDataTrieHashes map[string][][]byte
 	snapshotsManger        SnapshotsManager
+	appStatusHandler       core.AppStatusHandler
+	dataTriesCommitWorkers int
 
 	lastRootHash []byte
 	dataTries    common.TriesHolder
@@ -102,6 +104,11 @@ type ArgsAccountsDB struct {
 	StoragePruningManager StoragePruningManager
 	AddressConverter      core.PubkeyConverter
 	SnapshotsManager      SnapshotsManager
+	AppStatusHandler      core.AppStatusHandler
+
+	// DataTriesCommitWorkers is the maximum number of data tries committed concurrently. With 0 or 1, the data tries
+	// are committed one by one
+	DataTriesCommitWorkers uint32
 }
 
 // NewAccountsDB creates a new account manager
@@ -128,8 +135,10 @@ func createAccountsDb(args ArgsAccountsDB) *AccountsDB {
 		loadCodeMeasurements: &loadingMeasurements{
 			identifier: "load code",
 		},
-		addressConverter: args.AddressConverter,
-		snapshotsManger:  args.SnapshotsManager,
+		addressConverter:       args.AddressConverter,
+		snapshotsManger:        args.SnapshotsManager,
+		appStatusHandler:       args.AppStatusHandler,
+		dataTriesCommitWorkers: int(args.DataTriesCommitWorkers),
 	}
 }
 
@@ -155,6 +164,9 @@ func checkArgsAccountsDB(args ArgsAccountsDB) error {
 	if check.IfNil(args.SnapshotsManager) {
 		return ErrNilSnapshotsManager
 	}
+	if check.IfNil(args.AppStatusHandler) {
+		return ErrNilAppStatusHandler
+	}
 
 	return nil
 }
@@ -814,24 +826,22 @@ func (adb *AccountsDB) Commit() ([]byte, error) {
 
 func (adb *AccountsDB) commit() ([]byte, error) {
 	log.Trace("accountsDB.Commit started")
+	startTime := time.Now()
 	adb.entries = make([]JournalEntry, 0)
 
 	oldHashes := make(common.ModifiedHashes)
 	newHashes := make(common.ModifiedHashes)
 	// Step 1. commit all data tries
-	dataTries := adb.dataTries.GetAll()
-	for i := 0; i < len(dataTries); i++ {
-		err := adb.commitTrie(dataTries[i], oldHashes, newHashes)
-		if err != nil {
-			return nil, err
-		}
+	err := adb.commitDataTries(adb.dataTries.GetAll(), oldHashes, newHashes)
+	if err != nil {
+		return nil, err
 	}
 	adb.dataTries.Reset()
 
 	oldRoot := adb.mainTrie.GetOldRoot()
 
 	// Step 2. commit main trie
-	err := adb.commitTrie(adb.mainTrie, oldHashes, newHashes)
+	err = adb.commitTrie(adb.mainTrie, oldHashes, newHashes)
 	if err != nil {
 		return nil, err
 	}
@@ -851,6 +861,7 @@ func (adb *AccountsDB) commit() ([]byte, error) {
 	adb.obsoleteDataTrieHashes = make(map[string][][]byte)
 
 	log.Trace("accountsDB.Commit ended", "root hash", newRoot)
+	adb.appStatusHandler.SetUInt64Value(common.MetricTrieCommitDurationUs, uint64(time.Since(startTime).Microseconds()))
 
 	adb.printTrieStorageStatistics()
 
@@ -876,6 +887,71 @@ func (adb *AccountsDB) markForEviction(
 	return adb.storagePruningManager.MarkForEviction(oldRoot, newRoot, oldHashes, newHashes)
 }
 
+// commitDataTries commits the data tries using at most dataTriesCommitWorkers go routines. The data tries are
+// independent of each other, so the order in which they are committed does not change their root hashes. Each worker
+// collects the old and new hashes in its own maps, which are merged once all the data tries are committed
+func (adb *AccountsDB) commitDataTries(dataTries []common.Trie, oldHashes common.ModifiedHashes, newHashes common.ModifiedHashes) error {
+	numWorkers := adb.dataTriesCommitWorkers
+	if numWorkers > len(dataTries) {
+		numWorkers = len(dataTries)
+	}
+	if numWorkers <= 1 {
+		for _, dataTrie := range dataTries {
+			err := adb.commitTrie(dataTrie, oldHashes, newHashes)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	dataTriesChan := make(chan common.Trie, len(dataTries))
+	for _, dataTrie := range dataTries {
+		dataTriesChan <- dataTrie
+	}
+	close(dataTriesChan)
+
+	workersOldHashes := make([]common.ModifiedHashes, numWorkers)
+	workersNewHashes := make([]common.ModifiedHashes, numWorkers)
+	commitErrChan := errChan.NewErrChanWrapper()
+	wg := sync.WaitGroup{}
+	wg.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		workersOldHashes[i] = make(common.ModifiedHashes)
+		workersNewHashes[i] = make(common.ModifiedHashes)
+
+		go func(idx int) {
+			defer wg.Done()
+
+			for dataTrie := range dataTriesChan {
+				err := adb.commitTrie(dataTrie, workersOldHashes[idx], workersNewHashes[idx])
+				if err != nil {
+					commitErrChan.WriteInChanNonBlocking(err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	err := commitErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < numWorkers; i++ {
+		for hash := range workersOldHashes[i] {
+			oldHashes[hash] = struct{}{}
+		}
+		for hash := range workersNewHashes[i] {
+			newHashes[hash] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
 func (adb *AccountsDB) commitTrie(tr common.Trie, oldHashes common.ModifiedHashes, newHashes common.ModifiedHashes) error {
 	if adb.mainTrie.GetStorageManager().IsPruningEnabled() {
 		oldTrieHashes := tr.GetObsoleteHashes()
@@ -1077,6 +1153,11 @@ func (adb *AccountsDB) SnapshotState(rootHash []byte, epoch uint32) {
 	adb.snapshotsManger.SnapshotState(rootHash, epoch, adb.getMainTrie().GetStorageManager())
 }
 
+// ExportStateSnapshot writes the state found at the given root hash using the provided state snapshot writer
+func (adb *AccountsDB) ExportStateSnapshot(rootHash []byte, writer StateSnapshotWriter) error {
+	return adb.snapshotsManger.ExportStateSnapshot(rootHash, adb.getMainTrie().GetStorageManager(), writer)
+}
+
 func (adb *AccountsDB) getTrieStorageManagerAndLatestEpoch(mainTrie common.Trie) (common.StorageManager, uint32, error) {
 	trieStorageManager := mainTrie.GetStorageManager()
 	epoch, err := trieStorageManager.GetLatestStorageEpoch()
@@ -37,6 +37,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	statusHandlerMock "github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
 	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
@@ -80,6 +81,7 @@ func createMockAccountsDBArgs() state.ArgsAccountsDB {
 		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      snapshotsManager,
+		AppStatusHandler:      &statusHandlerMock.AppStatusHandlerStub{},
 	}
 }
 
@@ -168,6 +170,7 @@ func getDefaultStateComponents(
 		StoragePruningManager: spm,
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      snapshotsManager,
+		AppStatusHandler:      &statusHandlerMock.AppStatusHandlerStub{},
 	}
 	adb, _ := state.NewAccountsDB(argsAccountsDB)
 
@@ -247,6 +250,16 @@ func TestNewAccountsDB(t *testing.T) {
 		assert.True(t, check.IfNil(adb))
 		assert.Equal(t, state.ErrNilSnapshotsManager, err)
 	})
+	t.Run("nil app status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockAccountsDBArgs()
+		args.AppStatusHandler = nil
+
+		adb, err := state.NewAccountsDB(args)
+		assert.True(t, check.IfNil(adb))
+		assert.Equal(t, state.ErrNilAppStatusHandler, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -910,6 +923,34 @@ func TestAccountsDB_CommitShouldCallCommitFromTrie(t *testing.T) {
 	assert.Equal(t, 2, commitCalled)
 }
 
+func TestAccountsDB_CommitShouldReportTheCommitDuration(t *testing.T) {
+	t.Parallel()
+
+	reportedKey := ""
+	args := createMockAccountsDBArgs()
+	args.Trie = &trieMock.TrieStub{
+		CommitCalled: func() error {
+			return nil
+		},
+		RootCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+		GetStorageManagerCalled: func() common.StorageManager {
+			return &storageManager.StorageManagerStub{}
+		},
+	}
+	args.AppStatusHandler = &statusHandlerMock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			reportedKey = key
+		},
+	}
+	adb, _ := state.NewAccountsDB(args)
+
+	_, err := adb.Commit()
+	assert.Nil(t, err)
+	assert.Equal(t, common.MetricTrieCommitDurationUs, reportedKey)
+}
+
 // ------- RecreateTrie
 
 func TestAccountsDB_RecreateTrieMalfunctionTrieShouldErr(t *testing.T) {
@@ -3068,3 +3109,181 @@ func testAccountLoadInParallel(
 
 	wg.Wait()
 }
+
+func createAccountsDBWithDataTriesCommitWorkers(
+	numWorkers uint32,
+	spm state.StoragePruningManager,
+) *state.AccountsDB {
+	marshaller := &marshallerMock.MarshalizerMock{}
+	hasher := &hashingMocks.HasherMock{}
+	enableEpochsHandler := enableEpochsHandlerMock.NewEnableEpochsHandlerStub()
+	tsm, _ := trie.NewTrieStorageManager(storage.GetStorageManagerArgs())
+	tr, _ := trie.NewTrie(tsm, marshaller, hasher, enableEpochsHandler, uint(5))
+	argsAccountsDB := createMockAccountsDBArgs()
+	argsAccountsDB.Trie = tr
+	argsAccountsDB.Hasher = hasher
+	argsAccountsDB.Marshaller = marshaller
+	argsAccCreator := factory.ArgsAccountCreator{
+		Hasher:              hasher,
+		Marshaller:          marshaller,
+		EnableEpochsHandler: enableEpochsHandler,
+	}
+	argsAccountsDB.AccountFactory, _ = factory.NewAccountCreator(argsAccCreator)
+	argsAccountsDB.StoragePruningManager = spm
+	argsAccountsDB.DataTriesCommitWorkers = numWorkers
+	adb, _ := state.NewAccountsDB(argsAccountsDB)
+
+	return adb
+}
+
+func saveKeyValuesInDataTries(t testing.TB, adb state.AccountsAdapter, addresses [][]byte, numKeys int, valuePrefix string) {
+	for _, address := range addresses {
+		acc, err := adb.LoadAccount(address)
+		require.Nil(t, err)
+
+		userAcc := acc.(state.UserAccountHandler)
+		for i := 0; i < numKeys; i++ {
+			key := []byte(fmt.Sprintf("key%d", i))
+			value := []byte(fmt.Sprintf("%s%d", valuePrefix, i))
+			err = userAcc.SaveKeyValue(key, value)
+			require.Nil(t, err)
+		}
+
+		err = adb.SaveAccount(userAcc)
+		require.Nil(t, err)
+	}
+}
+
+func TestAccountsDB_CommitDataTriesInParallel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should produce the same hashes as the sequential commit", func(t *testing.T) {
+		t.Parallel()
+
+		numAccounts := 200
+		addresses := make([][]byte, numAccounts)
+		for i := 0; i < numAccounts; i++ {
+			addresses[i] = []byte(fmt.Sprintf("%032d", i))
+		}
+
+		type commitResult struct {
+			rootHash  []byte
+			oldHashes common.ModifiedHashes
+			newHashes common.ModifiedHashes
+		}
+		commitAccounts := func(numWorkers uint32) []commitResult {
+			results := make([]commitResult, 0)
+			spm := &stateMock.StoragePruningManagerStub{
+				MarkForEvictionCalled: func(_ []byte, _ []byte, oldHashes common.ModifiedHashes, newHashes common.ModifiedHashes) error {
+					results = append(results, commitResult{
+						oldHashes: oldHashes,
+						newHashes: newHashes,
+					})
+
+					return nil
+				},
+			}
+			adb := createAccountsDBWithDataTriesCommitWorkers(numWorkers, spm)
+
+			saveKeyValuesInDataTries(t, adb, addresses, 20, "value")
+			rootHash, err := adb.Commit()
+			require.Nil(t, err)
+			results[len(results)-1].rootHash = rootHash
+
+			// modify half of the data tries and remove some of the accounts
+			saveKeyValuesInDataTries(t, adb, addresses[:numAccounts/2], 10, "new value")
+			for i := numAccounts / 2; i < numAccounts; i += 10 {
+				err = adb.RemoveAccount(addresses[i])
+				require.Nil(t, err)
+			}
+			rootHash, err = adb.Commit()
+			require.Nil(t, err)
+			results[len(results)-1].rootHash = rootHash
+
+			return results
+		}
+
+		sequentialResults := commitAccounts(1)
+		parallelResults := commitAccounts(8)
+		require.Equal(t, 2, len(sequentialResults))
+		require.NotEmpty(t, sequentialResults[1].oldHashes)
+		require.NotEmpty(t, sequentialResults[1].newHashes)
+		require.Equal(t, sequentialResults, parallelResults)
+	})
+	t.Run("data trie commit error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		mainTrieCommitCalled := false
+		numDataTriesCommitted := atomic.Counter{}
+		marshaller := &marshallerMock.MarshalizerMock{}
+		serializedAccount, _ := marshaller.Marshal(stateMock.AccountWrapMock{})
+		args := createMockAccountsDBArgs()
+		args.DataTriesCommitWorkers = 4
+		args.Trie = &trieMock.TrieStub{
+			CommitCalled: func() error {
+				mainTrieCommitCalled = true
+				return nil
+			},
+			RootCalled: func() ([]byte, error) {
+				return nil, nil
+			},
+			GetCalled: func(_ []byte) ([]byte, uint32, error) {
+				return serializedAccount, 0, nil
+			},
+			RecreateCalled: func(_ common.RootHashHolder) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetCalled: func(_ []byte) ([]byte, uint32, error) {
+						return []byte("doge"), 0, nil
+					},
+					UpdateWithVersionCalled: func(_, _ []byte, _ core.TrieNodeVersion) error {
+						return nil
+					},
+					CommitCalled: func() error {
+						numDataTriesCommitted.Increment()
+						return expectedErr
+					},
+					RootCalled: func() ([]byte, error) {
+						return nil, nil
+					},
+				}, nil
+			},
+			GetStorageManagerCalled: func() common.StorageManager {
+				return &storageManager.StorageManagerStub{}
+			},
+		}
+		adb, _ := state.NewAccountsDB(args)
+
+		for i := 0; i < 10; i++ {
+			acc, _ := adb.LoadAccount([]byte(fmt.Sprintf("%032d", i)))
+			_ = acc.(state.UserAccountHandler).SaveKeyValue([]byte("dog"), []byte("puppy"))
+			_ = adb.SaveAccount(acc)
+		}
+
+		_, err := adb.Commit()
+		assert.Equal(t, expectedErr, err)
+		assert.False(t, mainTrieCommitCalled)
+		// each worker stops after its first failed commit
+		assert.Equal(t, int64(4), numDataTriesCommitted.Get())
+	})
+}
+
+func BenchmarkAccountsDB_CommitDataTries(b *testing.B) {
+	numAccounts := 2000
+	numKeys := 50
+
+	for _, numWorkers := range []uint32{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("%d workers", numWorkers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				adb := createAccountsDBWithDataTriesCommitWorkers(numWorkers, disabled.NewDisabledStoragePruningManager())
+				addresses := generateAccounts(b, numAccounts, adb)
+				saveKeyValuesInDataTries(b, adb, addresses, numKeys, "value")
+				b.StartTimer()
+
+				_, err := adb.Commit()
+				require.Nil(b, err)
+			}
+		})
+	}
+}
@@ -32,6 +32,11 @@ func (d *disabledSnapshotsManger) SetSyncer(_ state.AccountsDBSyncer) error {
 	return nil
 }
 
+// ExportStateSnapshot returns nil for this implementation
+func (d *disabledSnapshotsManger) ExportStateSnapshot(_ []byte, _ common.StorageManager, _ state.StateSnapshotWriter) error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (d *disabledSnapshotsManger) IsInterfaceNil() bool {
 	return d == nil
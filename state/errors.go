@@ -165,3 +165,6 @@ var ErrValidatorsDifferentShards = errors.New("validators are not in the same sh
 
 // ErrValidatorNotFound signals that a validator was not found
 var ErrValidatorNotFound = errors.New("validator not found")
+
+// ErrNilStateSnapshotWriter signals that a nil state snapshot writer has been provided
+var ErrNilStateSnapshotWriter = errors.New("nil state snapshot writer")
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	mockState "github.com/multiversx/mx-chain-go/testscommon/state"
+	statusHandlerMock "github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
 	mockTrie "github.com/multiversx/mx-chain-go/testscommon/trie"
 	"github.com/stretchr/testify/assert"
@@ -28,6 +29,7 @@ func createMockAccountsArgs() state.ArgsAccountsDB {
 		StoragePruningManager: &mockState.StoragePruningManagerStub{},
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      &mockState.SnapshotsManagerStub{},
+		AppStatusHandler:      &statusHandlerMock.AppStatusHandlerStub{},
 	}
 }
 
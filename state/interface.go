@@ -100,6 +100,14 @@ type SnapshotsManager interface {
 	StartSnapshotAfterRestartIfNeeded(trieStorageManager common.StorageManager) error
 	IsSnapshotInProgress() bool
 	SetSyncer(syncer AccountsDBSyncer) error
+	ExportStateSnapshot(rootHash []byte, trieStorageManager common.StorageManager, writer StateSnapshotWriter) error
+	IsInterfaceNil() bool
+}
+
+// StateSnapshotWriter defines the methods needed to write the nodes of a state trie in a portable state snapshot
+type StateSnapshotWriter interface {
+	WriteMainTrie(rootHash []byte, db common.TrieStorageInteractor, leafHandler func(key []byte, value []byte) error) error
+	WriteDataTrie(rootHash []byte, db common.TrieStorageInteractor) error
 	IsInterfaceNil() bool
 }
 
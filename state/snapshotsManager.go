@@ -391,6 +391,63 @@ func (sm *snapshotsManager) finishSnapshotOperation(
 	stats.PrintStats(message, rootHash)
 }
 
+// ExportStateSnapshot writes the nodes of the main trie found at the given root hash, together with the nodes of all
+// the data tries referenced by its accounts, using the provided state snapshot writer
+func (sm *snapshotsManager) ExportStateSnapshot(rootHash []byte, trieStorageManager common.StorageManager, writer StateSnapshotWriter) error {
+	if check.IfNil(trieStorageManager) {
+		return ErrNilStorageManager
+	}
+	if check.IfNil(writer) {
+		return ErrNilStateSnapshotWriter
+	}
+
+	// the nodes should not be pruned while they are exported
+	trieStorageManager.EnterPruningBufferingMode()
+	defer trieStorageManager.ExitPruningBufferingMode()
+
+	dataTriesRootHashes := make([][]byte, 0)
+	exportedDataTries := make(map[string]struct{})
+	leafHandler := func(key []byte, value []byte) error {
+		userAccount, skipAccount, err := getUserAccountFromBytes(sm.accountFactory, sm.marshaller, key, value)
+		if err != nil {
+			return err
+		}
+		if skipAccount || common.IsEmptyTrie(userAccount.GetRootHash()) {
+			return nil
+		}
+
+		dataTrieRootHash := userAccount.GetRootHash()
+		_, isExported := exportedDataTries[string(dataTrieRootHash)]
+		if isExported {
+			return nil
+		}
+
+		exportedDataTries[string(dataTrieRootHash)] = struct{}{}
+		dataTriesRootHashes = append(dataTriesRootHashes, dataTrieRootHash)
+
+		return nil
+	}
+
+	log.Info("starting state snapshot export", "type", sm.stateMetrics.GetSnapshotMessage(), "rootHash", rootHash)
+
+	err := writer.WriteMainTrie(rootHash, trieStorageManager, leafHandler)
+	if err != nil {
+		return err
+	}
+
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		err = writer.WriteDataTrie(dataTrieRootHash, trieStorageManager)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Info("finished state snapshot export", "type", sm.stateMetrics.GetSnapshotMessage(),
+		"rootHash", rootHash, "num data tries", len(dataTriesRootHashes))
+
+	return nil
+}
+
 func (sm *snapshotsManager) waitForCompletionIfAppropriate(stats common.SnapshotStatisticsHandler) {
 	shouldSerializeSnapshots := sm.shouldSerializeSnapshots || sm.processingMode == common.ImportDb
 	if !shouldSerializeSnapshots {
@@ -18,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	stateTest "github.com/multiversx/mx-chain-go/testscommon/state"
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -552,3 +553,126 @@ func TestSnapshotsManager_SnapshotState(t *testing.T) {
 		assert.True(t, removeFromAllActiveEpochsCalled)
 	})
 }
+
+func TestSnapshotsManager_ExportStateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("rootHash")
+	expectedErr := errors.New("expected error")
+
+	t.Run("nil storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		sm, _ := state.NewSnapshotsManager(getDefaultSnapshotManagerArgs())
+		err := sm.ExportStateSnapshot(rootHash, nil, &stateTest.StateSnapshotWriterStub{})
+		assert.Equal(t, state.ErrNilStorageManager, err)
+	})
+	t.Run("nil writer should error", func(t *testing.T) {
+		t.Parallel()
+
+		sm, _ := state.NewSnapshotsManager(getDefaultSnapshotManagerArgs())
+		err := sm.ExportStateSnapshot(rootHash, &storageManager.StorageManagerStub{}, nil)
+		assert.Equal(t, state.ErrNilStateSnapshotWriter, err)
+	})
+	t.Run("main trie write error should error", func(t *testing.T) {
+		t.Parallel()
+
+		sm, _ := state.NewSnapshotsManager(getDefaultSnapshotManagerArgs())
+		numExitPruningBufferingMode := 0
+		tsm := &storageManager.StorageManagerStub{
+			ExitPruningBufferingModeCalled: func() {
+				numExitPruningBufferingMode++
+			},
+		}
+		writer := &stateTest.StateSnapshotWriterStub{
+			WriteMainTrieCalled: func(_ []byte, _ common.TrieStorageInteractor, _ func(key []byte, value []byte) error) error {
+				return expectedErr
+			},
+			WriteDataTrieCalled: func(_ []byte, _ common.TrieStorageInteractor) error {
+				assert.Fail(t, "should not have been called")
+				return nil
+			},
+		}
+
+		err := sm.ExportStateSnapshot(rootHash, tsm, writer)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numExitPruningBufferingMode)
+	})
+	t.Run("should write the main trie and each data trie once", func(t *testing.T) {
+		t.Parallel()
+
+		dataTriesRootHashes := map[string][]byte{
+			"address0": []byte("dataTrie0"),
+			"address1": []byte("dataTrie1"),
+			"address2": []byte("dataTrie0"),
+			"address3": nil,
+		}
+		args := getDefaultSnapshotManagerArgs()
+		args.AccountFactory = &stateTest.AccountsFactoryStub{
+			CreateAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateTest.UserAccountStub{
+					GetRootHashCalled: func() []byte {
+						return dataTriesRootHashes[string(address)]
+					},
+				}, nil
+			},
+		}
+		sm, _ := state.NewSnapshotsManager(args)
+
+		numEnterPruningBufferingMode := 0
+		tsm := &storageManager.StorageManagerStub{
+			EnterPruningBufferingModeCalled: func() {
+				numEnterPruningBufferingMode++
+			},
+		}
+		writtenDataTries := make([][]byte, 0)
+		writer := &stateTest.StateSnapshotWriterStub{
+			WriteMainTrieCalled: func(hash []byte, db common.TrieStorageInteractor, leafHandler func(key []byte, value []byte) error) error {
+				assert.Equal(t, rootHash, hash)
+				assert.Equal(t, tsm, db)
+				for i := 0; i < len(dataTriesRootHashes); i++ {
+					assert.Nil(t, leafHandler([]byte(fmt.Sprintf("address%d", i)), []byte("{}")))
+				}
+
+				// code leaves can not be unmarshalled as accounts and should be skipped
+				return leafHandler([]byte("codeHash"), []byte("code"))
+			},
+			WriteDataTrieCalled: func(hash []byte, db common.TrieStorageInteractor) error {
+				assert.Equal(t, tsm, db)
+				writtenDataTries = append(writtenDataTries, hash)
+				return nil
+			},
+		}
+
+		err := sm.ExportStateSnapshot(rootHash, tsm, writer)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("dataTrie0"), []byte("dataTrie1")}, writtenDataTries)
+		assert.Equal(t, 1, numEnterPruningBufferingMode)
+	})
+	t.Run("data trie write error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultSnapshotManagerArgs()
+		args.AccountFactory = &stateTest.AccountsFactoryStub{
+			CreateAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateTest.UserAccountStub{
+					GetRootHashCalled: func() []byte {
+						return []byte("dataTrie")
+					},
+				}, nil
+			},
+		}
+		sm, _ := state.NewSnapshotsManager(args)
+		writer := &stateTest.StateSnapshotWriterStub{
+			WriteMainTrieCalled: func(_ []byte, _ common.TrieStorageInteractor, leafHandler func(key []byte, value []byte) error) error {
+				return leafHandler([]byte("address"), []byte("{}"))
+			},
+			WriteDataTrieCalled: func(_ []byte, _ common.TrieStorageInteractor) error {
+				return expectedErr
+			},
+		}
+
+		err := sm.ExportStateSnapshot(rootHash, &storageManager.StorageManagerStub{}, writer)
+		assert.Equal(t, expectedErr, err)
+	})
+}
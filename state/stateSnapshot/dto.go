@@ -0,0 +1,25 @@
+package stateSnapshot
+
+const (
+	// AccountsTrie is the identifier of the user accounts trie, together with its data tries, inside a state snapshot
+	AccountsTrie = "accounts"
+	// PeerTrie is the identifier of the peer accounts trie inside a state snapshot
+	PeerTrie = "peer"
+)
+
+// Manifest describes the content of a state snapshot file
+type Manifest struct {
+	Version    uint32            `json:"version"`
+	ShardID    uint32            `json:"shardID"`
+	Epoch      uint32            `json:"epoch"`
+	RootHashes map[string]string `json:"rootHashes"`
+	Chunks     []*ChunkInfo      `json:"chunks"`
+}
+
+// ChunkInfo describes a chunk of trie nodes from a state snapshot file
+type ChunkInfo struct {
+	TrieType string `json:"trieType"`
+	NumNodes uint64 `json:"numNodes"`
+	Size     uint64 `json:"size"`
+	Hash     string `json:"hash"`
+}
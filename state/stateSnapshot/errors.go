@@ -0,0 +1,51 @@
+package stateSnapshot
+
+import "errors"
+
+// ErrNilWriter signals that a nil writer has been provided
+var ErrNilWriter = errors.New("nil writer")
+
+// ErrNilReader signals that a nil reader has been provided
+var ErrNilReader = errors.New("nil reader")
+
+// ErrInvalidMaxChunkSize signals that an invalid maximum chunk size has been provided
+var ErrInvalidMaxChunkSize = errors.New("invalid maximum chunk size")
+
+// ErrUnknownTrieType signals that an unknown trie type has been provided
+var ErrUnknownTrieType = errors.New("unknown trie type")
+
+// ErrTrieAlreadyWritten signals that the main trie of the given type has already been written in the snapshot
+var ErrTrieAlreadyWritten = errors.New("trie already written")
+
+// ErrMainTrieNotWritten signals that a data trie was written before the main trie
+var ErrMainTrieNotWritten = errors.New("main trie not written")
+
+// ErrSnapshotFinished signals that the snapshot was already finished
+var ErrSnapshotFinished = errors.New("snapshot already finished")
+
+// ErrInvalidSnapshot signals that the snapshot file is not a valid state snapshot
+var ErrInvalidSnapshot = errors.New("invalid state snapshot")
+
+// ErrUnsupportedVersion signals that the snapshot file has an unsupported version
+var ErrUnsupportedVersion = errors.New("unsupported state snapshot version")
+
+// ErrShardMismatch signals that the snapshot was created for another shard
+var ErrShardMismatch = errors.New("state snapshot shard mismatch")
+
+// ErrTrieNotFound signals that the snapshot does not contain the requested trie
+var ErrTrieNotFound = errors.New("trie not found in state snapshot")
+
+// ErrRootHashMismatch signals that the snapshot contains another root hash than the expected one
+var ErrRootHashMismatch = errors.New("state snapshot root hash mismatch")
+
+// ErrChunkHashMismatch signals that the content of a chunk does not match its hash
+var ErrChunkHashMismatch = errors.New("chunk hash mismatch")
+
+// ErrInvalidChunk signals that a chunk could not be decoded
+var ErrInvalidChunk = errors.New("invalid chunk")
+
+// ErrNodeHashMismatch signals that the content of a trie node does not match its hash
+var ErrNodeHashMismatch = errors.New("trie node hash mismatch")
+
+// ErrRootNodeNotFound signals that the root node of the trie was not found in the snapshot
+var ErrRootNodeNotFound = errors.New("root node not found in state snapshot")
@@ -0,0 +1,95 @@
+package stateSnapshot
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// ArgsLocalTrieNodesRequestHandler is the DTO used to create a new local trie nodes request handler
+type ArgsLocalTrieNodesRequestHandler struct {
+	RequestHandler   process.RequestHandler
+	TrieStorage      common.BaseStorer
+	InterceptedNodes storage.Cacher
+	Hasher           hashing.Hasher
+}
+
+// localTrieNodesRequestHandler serves the requested trie nodes from the local trie storage, as if they were received
+// from the network. Only the nodes which can not be found locally are requested from the network
+type localTrieNodesRequestHandler struct {
+	process.RequestHandler
+	trieStorage      common.BaseStorer
+	interceptedNodes storage.Cacher
+	hasher           hashing.Hasher
+}
+
+// NewLocalTrieNodesRequestHandler creates a new local trie nodes request handler
+func NewLocalTrieNodesRequestHandler(args ArgsLocalTrieNodesRequestHandler) (*localTrieNodesRequestHandler, error) {
+	if check.IfNil(args.RequestHandler) {
+		return nil, state.ErrNilRequestHandler
+	}
+	if check.IfNil(args.TrieStorage) {
+		return nil, state.ErrNilStorageManager
+	}
+	if check.IfNil(args.InterceptedNodes) {
+		return nil, state.ErrNilCacher
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, state.ErrNilHasher
+	}
+
+	return &localTrieNodesRequestHandler{
+		RequestHandler:   args.RequestHandler,
+		trieStorage:      args.TrieStorage,
+		interceptedNodes: args.InterceptedNodes,
+		hasher:           args.Hasher,
+	}, nil
+}
+
+// RequestTrieNodes adds the locally found trie nodes in the intercepted nodes cacher and requests the missing ones
+func (handler *localTrieNodesRequestHandler) RequestTrieNodes(destShardID uint32, hashes [][]byte, topic string) {
+	missingHashes := make([][]byte, 0)
+	for _, hash := range hashes {
+		if !handler.addLocalTrieNode(hash) {
+			missingHashes = append(missingHashes, hash)
+		}
+	}
+
+	if len(missingHashes) == 0 {
+		return
+	}
+
+	log.Trace("requesting the missing trie nodes from the network", "num hashes", len(missingHashes))
+	handler.RequestHandler.RequestTrieNodes(destShardID, missingHashes, topic)
+}
+
+func (handler *localTrieNodesRequestHandler) addLocalTrieNode(hash []byte) bool {
+	encodedNode, err := handler.trieStorage.Get(hash)
+	if err != nil {
+		return false
+	}
+
+	interceptedNode, err := trie.NewInterceptedTrieNode(encodedNode, handler.hasher)
+	if err != nil {
+		return false
+	}
+	if !bytes.Equal(interceptedNode.Hash(), hash) {
+		log.Warn("corrupted local trie node", "hash", hash)
+		return false
+	}
+
+	handler.interceptedNodes.Put(hash, interceptedNode, interceptedNode.SizeInBytes()+len(hash))
+
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *localTrieNodesRequestHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
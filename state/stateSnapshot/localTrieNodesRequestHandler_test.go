@@ -0,0 +1,105 @@
+package stateSnapshot
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cache"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsLocalTrieNodesRequestHandler() ArgsLocalTrieNodesRequestHandler {
+	return ArgsLocalTrieNodesRequestHandler{
+		RequestHandler:   &testscommon.RequestHandlerStub{},
+		TrieStorage:      testscommon.NewMemDbMock(),
+		InterceptedNodes: cache.NewCacherMock(),
+		Hasher:           testHasher,
+	}
+}
+
+func TestNewLocalTrieNodesRequestHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil request handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalTrieNodesRequestHandler()
+		args.RequestHandler = nil
+		handler, err := NewLocalTrieNodesRequestHandler(args)
+		require.Equal(t, state.ErrNilRequestHandler, err)
+		require.Nil(t, handler)
+	})
+	t.Run("nil trie storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalTrieNodesRequestHandler()
+		args.TrieStorage = nil
+		handler, err := NewLocalTrieNodesRequestHandler(args)
+		require.Equal(t, state.ErrNilStorageManager, err)
+		require.Nil(t, handler)
+	})
+	t.Run("nil intercepted nodes cacher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalTrieNodesRequestHandler()
+		args.InterceptedNodes = nil
+		handler, err := NewLocalTrieNodesRequestHandler(args)
+		require.Equal(t, state.ErrNilCacher, err)
+		require.Nil(t, handler)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalTrieNodesRequestHandler()
+		args.Hasher = nil
+		handler, err := NewLocalTrieNodesRequestHandler(args)
+		require.Equal(t, state.ErrNilHasher, err)
+		require.Nil(t, handler)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewLocalTrieNodesRequestHandler(createMockArgsLocalTrieNodesRequestHandler())
+		require.Nil(t, err)
+		require.False(t, handler.IsInterfaceNil())
+	})
+}
+
+func TestLocalTrieNodesRequestHandler_RequestTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	localNode := []byte("local node")
+	localHash := testHasher.Compute(string(localNode))
+	corruptedHash := testHasher.Compute("corrupted node")
+	missingHash := testHasher.Compute("missing node")
+
+	args := createMockArgsLocalTrieNodesRequestHandler()
+	storer := testscommon.NewMemDbMock()
+	require.Nil(t, storer.Put(localHash, localNode))
+	require.Nil(t, storer.Put(corruptedHash, []byte("altered node")))
+	args.TrieStorage = storer
+
+	var requestedHashes [][]byte
+	args.RequestHandler = &testscommon.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			require.Equal(t, testShardID, destShardID)
+			require.Equal(t, "topic", topic)
+			requestedHashes = append(requestedHashes, hashes...)
+		},
+	}
+	handler, _ := NewLocalTrieNodesRequestHandler(args)
+
+	handler.RequestTrieNodes(testShardID, [][]byte{localHash}, "topic")
+	require.Empty(t, requestedHashes)
+
+	handler.RequestTrieNodes(testShardID, [][]byte{localHash, corruptedHash, missingHash}, "topic")
+	require.Equal(t, [][]byte{corruptedHash, missingHash}, requestedHashes)
+
+	cachedNode, ok := args.InterceptedNodes.Get(localHash)
+	require.True(t, ok)
+	require.Equal(t, localNode, cachedNode.(*trie.InterceptedTrieNode).GetSerialized())
+	require.False(t, args.InterceptedNodes.Has(corruptedHash))
+	require.False(t, args.InterceptedNodes.Has(missingHash))
+}
@@ -0,0 +1,257 @@
+package stateSnapshot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// maxManifestSize limits the memory used when reading the manifest of an untrusted file
+const maxManifestSize = 512 * 1024 * 1024
+
+// ArgsSnapshotReader is the DTO used to create a new snapshot reader
+type ArgsSnapshotReader struct {
+	Reader io.ReadSeeker
+	Hasher hashing.Hasher
+}
+
+// ArgsImportTrie is the DTO used to import the tries of a single type from the snapshot
+type ArgsImportTrie struct {
+	TrieType string
+	RootHash []byte
+	ShardID  uint32
+	Storer   common.BaseStorer
+}
+
+type snapshotReader struct {
+	reader   io.ReadSeeker
+	hasher   hashing.Hasher
+	manifest *Manifest
+}
+
+// NewSnapshotReader creates a new state snapshot reader. The manifest of the snapshot is read and checked on creation
+func NewSnapshotReader(args ArgsSnapshotReader) (*snapshotReader, error) {
+	if args.Reader == nil {
+		return nil, ErrNilReader
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, state.ErrNilHasher
+	}
+
+	manifest, err := readManifest(args.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshotReader{
+		reader:   args.Reader,
+		hasher:   args.Hasher,
+		manifest: manifest,
+	}, nil
+}
+
+func readManifest(reader io.ReadSeeker) (*Manifest, error) {
+	fileSize, err := reader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if fileSize < int64(trailerSize) {
+		return nil, fmt.Errorf("%w: file too small", ErrInvalidSnapshot)
+	}
+
+	trailer := make([]byte, trailerSize)
+	_, err = reader.Seek(fileSize-int64(trailerSize), io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+	_, err = io.ReadFull(reader, trailer)
+	if err != nil {
+		return nil, err
+	}
+	if string(trailer[8:]) != snapshotMagic {
+		return nil, fmt.Errorf("%w: missing snapshot magic", ErrInvalidSnapshot)
+	}
+
+	manifestOffset := binary.BigEndian.Uint64(trailer)
+	manifestEnd := uint64(fileSize) - uint64(trailerSize)
+	if manifestOffset > manifestEnd || manifestEnd-manifestOffset > maxManifestSize {
+		return nil, fmt.Errorf("%w: invalid manifest offset %d", ErrInvalidSnapshot, manifestOffset)
+	}
+
+	manifestBytes := make([]byte, manifestEnd-manifestOffset)
+	_, err = reader.Seek(int64(manifestOffset), io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+	_, err = io.ReadFull(reader, manifestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(manifestBytes, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSnapshot, err.Error())
+	}
+	if manifest.Version != SnapshotVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, manifest.Version)
+	}
+
+	chunksSize := uint64(0)
+	for _, chunk := range manifest.Chunks {
+		chunksSize += chunk.Size
+	}
+	if chunksSize != manifestOffset {
+		return nil, fmt.Errorf("%w: the chunks size does not match the manifest offset", ErrInvalidSnapshot)
+	}
+
+	return manifest, nil
+}
+
+// Manifest returns the manifest of the snapshot
+func (sr *snapshotReader) Manifest() Manifest {
+	return *sr.manifest
+}
+
+// ImportTrie checks that the snapshot was created for the given shard and root hash and then saves in the provided
+// storer all the nodes of the tries of the given type. Every chunk and every trie node is verified against its hash
+// before being saved. It returns the number of imported trie nodes
+func (sr *snapshotReader) ImportTrie(args ArgsImportTrie) (uint64, error) {
+	if check.IfNil(args.Storer) {
+		return 0, state.ErrNilStorageManager
+	}
+	if sr.manifest.ShardID != args.ShardID {
+		return 0, fmt.Errorf("%w: expected %d, snapshot shard %d", ErrShardMismatch, args.ShardID, sr.manifest.ShardID)
+	}
+
+	snapshotRootHash, ok := sr.manifest.RootHashes[args.TrieType]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrTrieNotFound, args.TrieType)
+	}
+	if snapshotRootHash != hex.EncodeToString(args.RootHash) {
+		return 0, fmt.Errorf("%w: expected %s, snapshot root hash %s",
+			ErrRootHashMismatch, hex.EncodeToString(args.RootHash), snapshotRootHash)
+	}
+
+	numNodes := uint64(0)
+	rootNodeFound := false
+	offset := uint64(0)
+	for index, chunk := range sr.manifest.Chunks {
+		chunkOffset := offset
+		offset += chunk.Size
+		if chunk.TrieType != args.TrieType {
+			continue
+		}
+
+		chunkBytes, err := sr.readChunk(chunkOffset, chunk)
+		if err != nil {
+			return 0, fmt.Errorf("%w for chunk %d", err, index)
+		}
+
+		numChunkNodes, foundRoot, err := sr.importChunk(chunkBytes, args)
+		if err != nil {
+			return 0, fmt.Errorf("%w for chunk %d", err, index)
+		}
+		if numChunkNodes != chunk.NumNodes {
+			return 0, fmt.Errorf("%w: chunk %d has %d nodes, expected %d", ErrInvalidChunk, index, numChunkNodes, chunk.NumNodes)
+		}
+
+		numNodes += numChunkNodes
+		rootNodeFound = rootNodeFound || foundRoot
+	}
+
+	if !rootNodeFound {
+		return 0, ErrRootNodeNotFound
+	}
+
+	log.Debug("imported trie from state snapshot", "type", args.TrieType, "rootHash", args.RootHash, "num nodes", numNodes)
+
+	return numNodes, nil
+}
+
+func (sr *snapshotReader) readChunk(offset uint64, chunk *ChunkInfo) ([]byte, error) {
+	_, err := sr.reader.Seek(int64(offset), io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+
+	chunkBytes := make([]byte, chunk.Size)
+	_, err = io.ReadFull(sr.reader, chunkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if hex.EncodeToString(sr.hasher.Compute(string(chunkBytes))) != chunk.Hash {
+		return nil, ErrChunkHashMismatch
+	}
+
+	return chunkBytes, nil
+}
+
+func (sr *snapshotReader) importChunk(chunkBytes []byte, args ArgsImportTrie) (uint64, bool, error) {
+	reader := bytes.NewReader(chunkBytes)
+	numNodes := uint64(0)
+	rootNodeFound := false
+	for {
+		hash, err := readEntry(reader)
+		if err == io.EOF {
+			return numNodes, rootNodeFound, nil
+		}
+		if err != nil {
+			return 0, false, err
+		}
+
+		encodedNode, err := readEntry(reader)
+		if err == io.EOF {
+			return 0, false, fmt.Errorf("%w: missing trie node", ErrInvalidChunk)
+		}
+		if err != nil {
+			return 0, false, err
+		}
+		if !bytes.Equal(sr.hasher.Compute(string(encodedNode)), hash) {
+			return 0, false, fmt.Errorf("%w: %s", ErrNodeHashMismatch, hex.EncodeToString(hash))
+		}
+
+		err = args.Storer.Put(hash, encodedNode)
+		if err != nil {
+			return 0, false, err
+		}
+
+		numNodes++
+		rootNodeFound = rootNodeFound || bytes.Equal(hash, args.RootHash)
+	}
+}
+
+func readEntry(reader *bytes.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidChunk, err.Error())
+	}
+	if length > uint64(reader.Len()) {
+		return nil, fmt.Errorf("%w: truncated entry", ErrInvalidChunk)
+	}
+
+	entry := make([]byte, length)
+	_, err = io.ReadFull(reader, entry)
+	if err != nil {
+		return nil, fmt.Errorf("%w: truncated entry", ErrInvalidChunk)
+	}
+
+	return entry, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sr *snapshotReader) IsInterfaceNil() bool {
+	return sr == nil
+}
@@ -0,0 +1,195 @@
+package stateSnapshot
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsImportTrie(tries *testTries, storer *testscommon.MemDbMock) ArgsImportTrie {
+	return ArgsImportTrie{
+		TrieType: AccountsTrie,
+		RootHash: tries.rootHash,
+		ShardID:  testShardID,
+		Storer:   storer,
+	}
+}
+
+// writeCustomSnapshot writes a snapshot of the accounts trie holding the provided nodes
+func writeCustomSnapshot(t *testing.T, rootHash []byte, hashes [][]byte, nodes [][]byte) *bytes.Reader {
+	buffer := &bytes.Buffer{}
+	sw, _ := NewSnapshotWriter(createMockArgsSnapshotWriter(buffer))
+	require.Nil(t, sw.setRootHash(AccountsTrie, rootHash))
+	for i := range hashes {
+		require.Nil(t, sw.addNode(AccountsTrie, hashes[i], nodes[i]))
+	}
+	_, err := sw.Finish()
+	require.Nil(t, err)
+
+	return bytes.NewReader(buffer.Bytes())
+}
+
+func TestNewSnapshotReader(t *testing.T) {
+	t.Parallel()
+
+	tries := createTestTries(t)
+	snapshotBytes := writeTestSnapshot(t, tries).Bytes()
+
+	t.Run("nil reader should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, err := NewSnapshotReader(ArgsSnapshotReader{Hasher: testHasher})
+		require.Equal(t, ErrNilReader, err)
+		require.Nil(t, sr)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, err := NewSnapshotReader(ArgsSnapshotReader{Reader: bytes.NewReader(snapshotBytes)})
+		require.Equal(t, state.ErrNilHasher, err)
+		require.Nil(t, sr)
+	})
+	t.Run("file too small should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, err := NewSnapshotReader(ArgsSnapshotReader{Reader: bytes.NewReader([]byte("small")), Hasher: testHasher})
+		require.True(t, errors.Is(err, ErrInvalidSnapshot))
+		require.Nil(t, sr)
+	})
+	t.Run("missing magic should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidBytes := append([]byte{}, snapshotBytes...)
+		invalidBytes[len(invalidBytes)-1]++
+		sr, err := NewSnapshotReader(ArgsSnapshotReader{Reader: bytes.NewReader(invalidBytes), Hasher: testHasher})
+		require.True(t, errors.Is(err, ErrInvalidSnapshot))
+		require.Nil(t, sr)
+	})
+	t.Run("invalid manifest offset should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidBytes := append([]byte{}, snapshotBytes...)
+		invalidBytes[len(invalidBytes)-trailerSize] = 0xff
+		sr, err := NewSnapshotReader(ArgsSnapshotReader{Reader: bytes.NewReader(invalidBytes), Hasher: testHasher})
+		require.True(t, errors.Is(err, ErrInvalidSnapshot))
+		require.Nil(t, sr)
+	})
+	t.Run("should read the manifest", func(t *testing.T) {
+		t.Parallel()
+
+		sr, err := NewSnapshotReader(ArgsSnapshotReader{Reader: bytes.NewReader(snapshotBytes), Hasher: testHasher})
+		require.Nil(t, err)
+		require.False(t, sr.IsInterfaceNil())
+
+		manifest := sr.Manifest()
+		require.Equal(t, testShardID, manifest.ShardID)
+		require.Equal(t, testEpoch, manifest.Epoch)
+		require.Len(t, manifest.RootHashes, 2)
+	})
+}
+
+func TestSnapshotReader_ImportTrie(t *testing.T) {
+	t.Parallel()
+
+	tries := createTestTries(t)
+	snapshotBytes := writeTestSnapshot(t, tries).Bytes()
+	createReader := func(snapshot []byte) *snapshotReader {
+		sr, err := NewSnapshotReader(ArgsSnapshotReader{Reader: bytes.NewReader(snapshot), Hasher: testHasher})
+		require.Nil(t, err)
+
+		return sr
+	}
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsImportTrie(tries, nil)
+		args.Storer = nil
+		numNodes, err := createReader(snapshotBytes).ImportTrie(args)
+		require.Equal(t, state.ErrNilStorageManager, err)
+		require.Zero(t, numNodes)
+	})
+	t.Run("other shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsImportTrie(tries, testscommon.NewMemDbMock())
+		args.ShardID = testShardID + 1
+		numNodes, err := createReader(snapshotBytes).ImportTrie(args)
+		require.True(t, errors.Is(err, ErrShardMismatch))
+		require.Zero(t, numNodes)
+	})
+	t.Run("missing trie type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsImportTrie(tries, testscommon.NewMemDbMock())
+		args.TrieType = "unknown"
+		numNodes, err := createReader(snapshotBytes).ImportTrie(args)
+		require.True(t, errors.Is(err, ErrTrieNotFound))
+		require.Zero(t, numNodes)
+	})
+	t.Run("other root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testscommon.NewMemDbMock()
+		args := createMockArgsImportTrie(tries, storer)
+		args.RootHash = tries.peerRootHash
+		numNodes, err := createReader(snapshotBytes).ImportTrie(args)
+		require.True(t, errors.Is(err, ErrRootHashMismatch))
+		require.Zero(t, numNodes)
+		require.Zero(t, countKeys(storer))
+	})
+	t.Run("altered chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		alteredBytes := append([]byte{}, snapshotBytes...)
+		alteredBytes[len(tries.rootHash)+2]++
+		numNodes, err := createReader(alteredBytes).ImportTrie(createMockArgsImportTrie(tries, testscommon.NewMemDbMock()))
+		require.True(t, errors.Is(err, ErrChunkHashMismatch))
+		require.Zero(t, numNodes)
+	})
+	t.Run("altered trie node should error", func(t *testing.T) {
+		t.Parallel()
+
+		reader := writeCustomSnapshot(t, tries.rootHash, [][]byte{tries.rootHash}, [][]byte{[]byte("altered node")})
+		sr, _ := NewSnapshotReader(ArgsSnapshotReader{Reader: reader, Hasher: testHasher})
+		numNodes, err := sr.ImportTrie(createMockArgsImportTrie(tries, testscommon.NewMemDbMock()))
+		require.True(t, errors.Is(err, ErrNodeHashMismatch))
+		require.Zero(t, numNodes)
+	})
+	t.Run("missing root node should error", func(t *testing.T) {
+		t.Parallel()
+
+		node := []byte("node")
+		reader := writeCustomSnapshot(t, tries.rootHash, [][]byte{testHasher.Compute(string(node))}, [][]byte{node})
+		sr, _ := NewSnapshotReader(ArgsSnapshotReader{Reader: reader, Hasher: testHasher})
+		numNodes, err := sr.ImportTrie(createMockArgsImportTrie(tries, testscommon.NewMemDbMock()))
+		require.Equal(t, ErrRootNodeNotFound, err)
+		require.Zero(t, numNodes)
+	})
+	t.Run("should import all the tries", func(t *testing.T) {
+		t.Parallel()
+
+		sr := createReader(snapshotBytes)
+		storer := testscommon.NewMemDbMock()
+		numAccountsNodes, err := sr.ImportTrie(createMockArgsImportTrie(tries, storer))
+		require.Nil(t, err)
+
+		args := createMockArgsImportTrie(tries, storer)
+		args.TrieType = PeerTrie
+		args.RootHash = tries.peerRootHash
+		numPeerNodes, err := sr.ImportTrie(args)
+		require.Nil(t, err)
+
+		require.Equal(t, countKeys(tries.storer), numAccountsNodes+numPeerNodes)
+		tries.storer.RangeKeys(func(key []byte, value []byte) bool {
+			importedValue, errGet := storer.Get(key)
+			require.Nil(t, errGet)
+			require.Equal(t, value, importedValue)
+			return true
+		})
+	})
+}
@@ -0,0 +1,292 @@
+package stateSnapshot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/stateSnapshot")
+
+const (
+	// SnapshotVersion is the version of the state snapshot format
+	SnapshotVersion = uint32(1)
+
+	// DefaultMaxChunkSize is the default maximum size of a chunk of trie nodes, in bytes
+	DefaultMaxChunkSize = 4 * 1024 * 1024
+
+	snapshotMagic = "MXSTSNAP"
+	trailerSize   = 8 + len(snapshotMagic)
+)
+
+// ArgsSnapshotWriter is the DTO used to create a new snapshot writer
+type ArgsSnapshotWriter struct {
+	Writer       io.Writer
+	Hasher       hashing.Hasher
+	Marshaller   marshal.Marshalizer
+	ShardID      uint32
+	Epoch        uint32
+	MaxChunkSize int
+}
+
+// snapshotWriter writes the trie nodes in chunks. The file layout is: the chunks, one after the other, the JSON encoded
+// manifest and a trailer containing the offset of the manifest followed by the snapshot magic
+type snapshotWriter struct {
+	mutWriter    sync.Mutex
+	writer       io.Writer
+	hasher       hashing.Hasher
+	marshaller   marshal.Marshalizer
+	maxChunkSize int
+
+	manifest      *Manifest
+	chunk         *bytes.Buffer
+	chunkTrieType string
+	chunkNumNodes uint64
+	numWritten    uint64
+	isFinished    bool
+	varintBuffer  []byte
+}
+
+type nodeToExport struct {
+	hash       []byte
+	keyBuilder common.KeyBuilder
+}
+
+// NewSnapshotWriter creates a new state snapshot writer
+func NewSnapshotWriter(args ArgsSnapshotWriter) (*snapshotWriter, error) {
+	if args.Writer == nil {
+		return nil, ErrNilWriter
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, state.ErrNilHasher
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, state.ErrNilMarshalizer
+	}
+	if args.MaxChunkSize <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxChunkSize, args.MaxChunkSize)
+	}
+
+	return &snapshotWriter{
+		writer:       args.Writer,
+		hasher:       args.Hasher,
+		marshaller:   args.Marshaller,
+		maxChunkSize: args.MaxChunkSize,
+		manifest: &Manifest{
+			Version:    SnapshotVersion,
+			ShardID:    args.ShardID,
+			Epoch:      args.Epoch,
+			RootHashes: make(map[string]string),
+			Chunks:     make([]*ChunkInfo, 0),
+		},
+		chunk:        bytes.NewBuffer(make([]byte, 0, args.MaxChunkSize)),
+		varintBuffer: make([]byte, binary.MaxVarintLen64),
+	}, nil
+}
+
+// TrieWriter returns the writer used for the trie of the given type
+func (sw *snapshotWriter) TrieWriter(trieType string) (*trieWriter, error) {
+	if !isKnownTrieType(trieType) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTrieType, trieType)
+	}
+
+	return &trieWriter{
+		trieType:       trieType,
+		snapshotWriter: sw,
+	}, nil
+}
+
+func (sw *snapshotWriter) setRootHash(trieType string, rootHash []byte) error {
+	sw.mutWriter.Lock()
+	defer sw.mutWriter.Unlock()
+
+	if sw.isFinished {
+		return ErrSnapshotFinished
+	}
+
+	_, exists := sw.manifest.RootHashes[trieType]
+	if exists {
+		return fmt.Errorf("%w: %s", ErrTrieAlreadyWritten, trieType)
+	}
+
+	sw.manifest.RootHashes[trieType] = hex.EncodeToString(rootHash)
+
+	return nil
+}
+
+func (sw *snapshotWriter) hasRootHash(trieType string) bool {
+	sw.mutWriter.Lock()
+	defer sw.mutWriter.Unlock()
+
+	_, exists := sw.manifest.RootHashes[trieType]
+
+	return exists
+}
+
+func (sw *snapshotWriter) writeTrie(
+	trieType string,
+	rootHash []byte,
+	db common.TrieStorageInteractor,
+	leafHandler func(key []byte, value []byte) error,
+) error {
+	stack := []*nodeToExport{{hash: rootHash, keyBuilder: keyBuilder.NewKeyBuilder()}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		encodedNode, err := db.Get(current.hash)
+		if err != nil {
+			return fmt.Errorf("%w for trie node %s", err, hex.EncodeToString(current.hash))
+		}
+
+		nodeData, err := trie.GetNodeDataFromHash(current.hash, current.keyBuilder, db, sw.marshaller, sw.hasher)
+		if err != nil {
+			return fmt.Errorf("%w for trie node %s", err, hex.EncodeToString(current.hash))
+		}
+
+		err = sw.addNode(trieType, current.hash, encodedNode)
+		if err != nil {
+			return err
+		}
+
+		for _, data := range nodeData {
+			if !data.IsLeaf() {
+				stack = append(stack, &nodeToExport{hash: data.GetData(), keyBuilder: data.GetKeyBuilder()})
+				continue
+			}
+			if leafHandler == nil {
+				continue
+			}
+
+			key, errKey := data.GetKeyBuilder().GetKey()
+			if errKey != nil {
+				return errKey
+			}
+
+			err = leafHandler(key, data.GetData())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (sw *snapshotWriter) addNode(trieType string, hash []byte, encodedNode []byte) error {
+	sw.mutWriter.Lock()
+	defer sw.mutWriter.Unlock()
+
+	if sw.isFinished {
+		return ErrSnapshotFinished
+	}
+
+	if sw.chunkTrieType != trieType {
+		err := sw.flushChunk()
+		if err != nil {
+			return err
+		}
+		sw.chunkTrieType = trieType
+	}
+
+	sw.appendToChunk(hash)
+	sw.appendToChunk(encodedNode)
+	sw.chunkNumNodes++
+
+	if sw.chunk.Len() < sw.maxChunkSize {
+		return nil
+	}
+
+	return sw.flushChunk()
+}
+
+func (sw *snapshotWriter) appendToChunk(data []byte) {
+	n := binary.PutUvarint(sw.varintBuffer, uint64(len(data)))
+	sw.chunk.Write(sw.varintBuffer[:n])
+	sw.chunk.Write(data)
+}
+
+func (sw *snapshotWriter) flushChunk() error {
+	if sw.chunkNumNodes == 0 {
+		return nil
+	}
+
+	chunkBytes := sw.chunk.Bytes()
+	_, err := sw.writer.Write(chunkBytes)
+	if err != nil {
+		return err
+	}
+
+	sw.manifest.Chunks = append(sw.manifest.Chunks, &ChunkInfo{
+		TrieType: sw.chunkTrieType,
+		NumNodes: sw.chunkNumNodes,
+		Size:     uint64(len(chunkBytes)),
+		Hash:     hex.EncodeToString(sw.hasher.Compute(string(chunkBytes))),
+	})
+	sw.numWritten += uint64(len(chunkBytes))
+
+	sw.chunk.Reset()
+	sw.chunkNumNodes = 0
+
+	return nil
+}
+
+// Finish writes the last chunk and the manifest of the snapshot. No trie can be written afterward
+func (sw *snapshotWriter) Finish() (*Manifest, error) {
+	sw.mutWriter.Lock()
+	defer sw.mutWriter.Unlock()
+
+	if sw.isFinished {
+		return nil, ErrSnapshotFinished
+	}
+
+	err := sw.flushChunk()
+	if err != nil {
+		return nil, err
+	}
+
+	manifestBytes, err := json.Marshal(sw.manifest)
+	if err != nil {
+		return nil, err
+	}
+	_, err = sw.writer.Write(manifestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	trailer := make([]byte, trailerSize)
+	binary.BigEndian.PutUint64(trailer, sw.numWritten)
+	copy(trailer[8:], snapshotMagic)
+	_, err = sw.writer.Write(trailer)
+	if err != nil {
+		return nil, err
+	}
+
+	sw.isFinished = true
+	log.Debug("state snapshot finished", "shard", sw.manifest.ShardID, "epoch", sw.manifest.Epoch,
+		"num chunks", len(sw.manifest.Chunks), "size", sw.numWritten)
+
+	return sw.manifest, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sw *snapshotWriter) IsInterfaceNil() bool {
+	return sw == nil
+}
+
+func isKnownTrieType(trieType string) bool {
+	return trieType == AccountsTrie || trieType == PeerTrie
+}
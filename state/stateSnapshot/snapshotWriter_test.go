@@ -0,0 +1,283 @@
+package stateSnapshot
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	testStorage "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	numMainTrieLeaves = 20
+	numDataTrieLeaves = 30
+	testShardID       = uint32(1)
+	testEpoch         = uint32(7)
+	maxLevelInMemory  = 5
+)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	testHasher     = &hashingMocks.HasherMock{}
+)
+
+type testTries struct {
+	storer           *testscommon.MemDbMock
+	storageManager   common.StorageManager
+	rootHash         []byte
+	dataTrieRootHash []byte
+	peerRootHash     []byte
+}
+
+func createTestTrie(t *testing.T, storer *testscommon.MemDbMock, prefix string, numLeaves int) ([]byte, common.StorageManager) {
+	args := testStorage.GetStorageManagerArgs()
+	args.MainStorer = storer
+	args.Marshalizer = testMarshaller
+	args.Hasher = testHasher
+	trieStorageManager, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(trieStorageManager, testMarshaller, testHasher, enableEpochsHandlerMock.NewEnableEpochsHandlerStub(), maxLevelInMemory)
+	require.Nil(t, err)
+	for i := 0; i < numLeaves; i++ {
+		require.Nil(t, tr.Update([]byte(fmt.Sprintf("%s key %d", prefix, i)), []byte(fmt.Sprintf("%s value %d", prefix, i))))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash, trieStorageManager
+}
+
+// createTestTries creates in the same storer a main trie, a data trie and a peer trie
+func createTestTries(t *testing.T) *testTries {
+	storer := testscommon.NewMemDbMock()
+	rootHash, storageManager := createTestTrie(t, storer, "account", numMainTrieLeaves)
+	dataTrieRootHash, _ := createTestTrie(t, storer, "data", numDataTrieLeaves)
+	peerRootHash, _ := createTestTrie(t, storer, "peer", numMainTrieLeaves)
+
+	return &testTries{
+		storer:           storer,
+		storageManager:   storageManager,
+		rootHash:         rootHash,
+		dataTrieRootHash: dataTrieRootHash,
+		peerRootHash:     peerRootHash,
+	}
+}
+
+func countKeys(storer *testscommon.MemDbMock) uint64 {
+	numKeys := uint64(0)
+	storer.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		return true
+	})
+
+	return numKeys
+}
+
+func createMockArgsSnapshotWriter(writer *bytes.Buffer) ArgsSnapshotWriter {
+	return ArgsSnapshotWriter{
+		Writer:       writer,
+		Hasher:       testHasher,
+		Marshaller:   testMarshaller,
+		ShardID:      testShardID,
+		Epoch:        testEpoch,
+		MaxChunkSize: 256,
+	}
+}
+
+// writeTestSnapshot exports the accounts trie, with its data trie, and the peer trie of the test tries
+func writeTestSnapshot(t *testing.T, tries *testTries) *bytes.Buffer {
+	buffer := &bytes.Buffer{}
+	sw, err := NewSnapshotWriter(createMockArgsSnapshotWriter(buffer))
+	require.Nil(t, err)
+
+	accountsWriter, err := sw.TrieWriter(AccountsTrie)
+	require.Nil(t, err)
+	require.Nil(t, accountsWriter.WriteMainTrie(tries.rootHash, tries.storageManager, nil))
+	require.Nil(t, accountsWriter.WriteDataTrie(tries.dataTrieRootHash, tries.storageManager))
+
+	peerWriter, err := sw.TrieWriter(PeerTrie)
+	require.Nil(t, err)
+	require.Nil(t, peerWriter.WriteMainTrie(tries.peerRootHash, tries.storageManager, nil))
+
+	_, err = sw.Finish()
+	require.Nil(t, err)
+
+	return buffer
+}
+
+func TestNewSnapshotWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil writer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotWriter(nil)
+		args.Writer = nil
+		sw, err := NewSnapshotWriter(args)
+		require.Equal(t, ErrNilWriter, err)
+		require.Nil(t, sw)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotWriter(&bytes.Buffer{})
+		args.Hasher = nil
+		sw, err := NewSnapshotWriter(args)
+		require.Equal(t, state.ErrNilHasher, err)
+		require.Nil(t, sw)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotWriter(&bytes.Buffer{})
+		args.Marshaller = nil
+		sw, err := NewSnapshotWriter(args)
+		require.Equal(t, state.ErrNilMarshalizer, err)
+		require.Nil(t, sw)
+	})
+	t.Run("invalid max chunk size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotWriter(&bytes.Buffer{})
+		args.MaxChunkSize = 0
+		sw, err := NewSnapshotWriter(args)
+		require.True(t, errors.Is(err, ErrInvalidMaxChunkSize))
+		require.Nil(t, sw)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sw, err := NewSnapshotWriter(createMockArgsSnapshotWriter(&bytes.Buffer{}))
+		require.Nil(t, err)
+		require.False(t, sw.IsInterfaceNil())
+	})
+}
+
+func TestSnapshotWriter_TrieWriter(t *testing.T) {
+	t.Parallel()
+
+	sw, _ := NewSnapshotWriter(createMockArgsSnapshotWriter(&bytes.Buffer{}))
+	tw, err := sw.TrieWriter("unknown")
+	require.True(t, errors.Is(err, ErrUnknownTrieType))
+	require.Nil(t, tw)
+
+	tw, err = sw.TrieWriter(PeerTrie)
+	require.Nil(t, err)
+	require.False(t, tw.IsInterfaceNil())
+}
+
+func TestSnapshotWriter_WriteTries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("data trie before the main trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		sw, _ := NewSnapshotWriter(createMockArgsSnapshotWriter(&bytes.Buffer{}))
+		tw, _ := sw.TrieWriter(AccountsTrie)
+		require.Equal(t, ErrMainTrieNotWritten, tw.WriteDataTrie(tries.dataTrieRootHash, tries.storageManager))
+	})
+	t.Run("main trie written twice should error", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		sw, _ := NewSnapshotWriter(createMockArgsSnapshotWriter(&bytes.Buffer{}))
+		tw, _ := sw.TrieWriter(AccountsTrie)
+		require.Nil(t, tw.WriteMainTrie(tries.rootHash, tries.storageManager, nil))
+		err := tw.WriteMainTrie(tries.rootHash, tries.storageManager, nil)
+		require.True(t, errors.Is(err, ErrTrieAlreadyWritten))
+	})
+	t.Run("missing trie node should error", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		require.Nil(t, tries.storer.Remove(tries.rootHash))
+		sw, _ := NewSnapshotWriter(createMockArgsSnapshotWriter(&bytes.Buffer{}))
+		tw, _ := sw.TrieWriter(AccountsTrie)
+		err := tw.WriteMainTrie(tries.rootHash, tries.storageManager, nil)
+		require.ErrorContains(t, err, hex.EncodeToString(tries.rootHash))
+	})
+	t.Run("leaf handler error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		expectedErr := errors.New("expected error")
+		sw, _ := NewSnapshotWriter(createMockArgsSnapshotWriter(&bytes.Buffer{}))
+		tw, _ := sw.TrieWriter(AccountsTrie)
+		err := tw.WriteMainTrie(tries.rootHash, tries.storageManager, func(_ []byte, _ []byte) error {
+			return expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("write after finish should error", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		sw, _ := NewSnapshotWriter(createMockArgsSnapshotWriter(&bytes.Buffer{}))
+		_, err := sw.Finish()
+		require.Nil(t, err)
+
+		_, err = sw.Finish()
+		require.Equal(t, ErrSnapshotFinished, err)
+		tw, _ := sw.TrieWriter(AccountsTrie)
+		require.Equal(t, ErrSnapshotFinished, tw.WriteMainTrie(tries.rootHash, tries.storageManager, nil))
+	})
+	t.Run("should write all the nodes in chunks", func(t *testing.T) {
+		t.Parallel()
+
+		tries := createTestTries(t)
+		buffer := &bytes.Buffer{}
+		sw, _ := NewSnapshotWriter(createMockArgsSnapshotWriter(buffer))
+
+		leaves := make(map[string]string)
+		accountsWriter, _ := sw.TrieWriter(AccountsTrie)
+		err := accountsWriter.WriteMainTrie(tries.rootHash, tries.storageManager, func(key []byte, value []byte) error {
+			leaves[string(key)] = string(value)
+			return nil
+		})
+		require.Nil(t, err)
+		require.Nil(t, accountsWriter.WriteDataTrie(tries.dataTrieRootHash, tries.storageManager))
+		peerWriter, _ := sw.TrieWriter(PeerTrie)
+		require.Nil(t, peerWriter.WriteMainTrie(tries.peerRootHash, tries.storageManager, nil))
+
+		manifest, err := sw.Finish()
+		require.Nil(t, err)
+
+		require.Len(t, leaves, numMainTrieLeaves)
+		for i := 0; i < numMainTrieLeaves; i++ {
+			require.Equal(t, fmt.Sprintf("account value %d", i), leaves[fmt.Sprintf("account key %d", i)])
+		}
+
+		require.Equal(t, SnapshotVersion, manifest.Version)
+		require.Equal(t, testShardID, manifest.ShardID)
+		require.Equal(t, testEpoch, manifest.Epoch)
+		require.Equal(t, map[string]string{
+			AccountsTrie: hex.EncodeToString(tries.rootHash),
+			PeerTrie:     hex.EncodeToString(tries.peerRootHash),
+		}, manifest.RootHashes)
+		require.Greater(t, len(manifest.Chunks), 2)
+
+		numNodes := uint64(0)
+		chunksSize := uint64(0)
+		for _, chunk := range manifest.Chunks {
+			numNodes += chunk.NumNodes
+			chunksSize += chunk.Size
+		}
+		// all the nodes of the three tries were stored in the same storer
+		require.Equal(t, countKeys(tries.storer), numNodes)
+		require.Less(t, chunksSize, uint64(buffer.Len()))
+	})
+}
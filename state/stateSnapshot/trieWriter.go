@@ -0,0 +1,36 @@
+package stateSnapshot
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// trieWriter writes the nodes of the tries of a single type in the state snapshot
+type trieWriter struct {
+	trieType       string
+	snapshotWriter *snapshotWriter
+}
+
+// WriteMainTrie writes all the nodes of the main trie found at the given root hash. The leaf handler, if provided,
+// is called for each leaf of the main trie
+func (tw *trieWriter) WriteMainTrie(rootHash []byte, db common.TrieStorageInteractor, leafHandler func(key []byte, value []byte) error) error {
+	err := tw.snapshotWriter.setRootHash(tw.trieType, rootHash)
+	if err != nil {
+		return err
+	}
+
+	return tw.snapshotWriter.writeTrie(tw.trieType, rootHash, db, leafHandler)
+}
+
+// WriteDataTrie writes all the nodes of the data trie found at the given root hash
+func (tw *trieWriter) WriteDataTrie(rootHash []byte, db common.TrieStorageInteractor) error {
+	if !tw.snapshotWriter.hasRootHash(tw.trieType) {
+		return ErrMainTrieNotWritten
+	}
+
+	return tw.snapshotWriter.writeTrie(tw.trieType, rootHash, db, nil)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tw *trieWriter) IsInterfaceNil() bool {
+	return tw == nil
+}
@@ -1,8 +1,15 @@
 package statusHandler
 
+import "github.com/prometheus/client_golang/prometheus"
+
 // StatusMetricsMap will return all metrics in a map
 func (sm *statusMetrics) StatusMetricsMap() map[string]interface{} {
 	return sm.getMetricsWithKeyFilterMutexProtected(func(_ string) bool {
 		return true
 	})
 }
+
+// PrometheusRegistry -
+func (sm *statusMetrics) PrometheusRegistry() *prometheus.Registry {
+	return sm.registry
+}
@@ -0,0 +1,300 @@
+package statusHandler
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	shardLabel           = common.MetricShardId
+	epochLabel           = common.MetricEpochNumber
+	infoMetricSuffix     = "_info"
+	infoValueLabel       = "value"
+	durationMetricSuffix = "_us"
+	histogramNameSuffix  = "_seconds"
+	microsecondsInSecond = float64(time.Second / time.Microsecond)
+)
+
+var invalidMetricNameChars = regexp.MustCompile(`[^a-zA-Z0-9_:]`)
+
+// counterMetrics holds the status metrics that only increase while the node is running. All the other numeric
+// status metrics are exported as gauges
+var counterMetrics = map[string]struct{}{
+	common.MetricCountAcceptedBlocks:          {},
+	common.MetricCountConsensusAcceptedBlocks: {},
+	common.MetricCountLeader:                  {},
+	common.MetricNumTimesInForkChoice:         {},
+	common.MetricNumProcessedTxs:              {},
+	common.MetricApiKeysRejectedRequests:      {},
+	common.MetricVMQueriesStateViewHits:       {},
+	common.MetricVMQueriesStateViewMisses:     {},
+}
+
+// infoMetrics holds the non-numeric string status metrics which rarely change, exported as info metrics. The other
+// non-numeric strings, such as the block hashes or the consensus states, are not exported as each new value would
+// create a new time series
+var infoMetrics = map[string]struct{}{
+	common.MetricAppVersion:               {},
+	common.MetricLatestTagSoftwareVersion: {},
+	common.MetricNodeType:                 {},
+	common.MetricPeerType:                 {},
+	common.MetricPeerSubType:              {},
+	common.MetricNodeDisplayName:          {},
+	common.MetricChainId:                  {},
+	common.MetricPublicKeyBlockSign:       {},
+	common.MetricAreVMQueriesReady:        {},
+	common.MetricAdaptivity:               {},
+	common.MetricRedundancyIsMainActive:   {},
+	common.MetricGatewayMetricsEndpoint:   {},
+}
+
+// durationHistogram observes the durations reported, in microseconds, on a status metric key. A labeled histogram
+// receives the durations on the keys built with common.ComputeLabeledMetricKey and uses the key suffix as label value
+type durationHistogram struct {
+	metricKey string
+	labelName string
+	vec       *prometheus.HistogramVec
+}
+
+func newDurationHistogram(metricKey string, labelName string, help string, buckets []float64) *durationHistogram {
+	labelNames := []string{shardLabel}
+	if len(labelName) > 0 {
+		labelNames = append(labelNames, labelName)
+	}
+
+	return &durationHistogram{
+		metricKey: metricKey,
+		labelName: labelName,
+		vec: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    strings.TrimSuffix(metricKey, durationMetricSuffix) + histogramNameSuffix,
+				Help:    help,
+				Buckets: buckets,
+			},
+			labelNames,
+		),
+	}
+}
+
+func createDurationHistograms() []*durationHistogram {
+	return []*durationHistogram{
+		newDurationHistogram(
+			common.MetricBlockProcessingDurationUs,
+			"",
+			"duration of the successfully processed blocks",
+			prometheus.ExponentialBuckets(0.005, 2, 12),
+		),
+		newDurationHistogram(
+			common.MetricConsensusSubroundDurationUs,
+			"subround",
+			"duration of the consensus subrounds",
+			prometheus.ExponentialBuckets(0.001, 2, 14),
+		),
+		newDurationHistogram(
+			common.MetricApiRequestDurationUs,
+			"group",
+			"duration of the API requests",
+			prometheus.DefBuckets,
+		),
+		newDurationHistogram(
+			common.MetricTrieCommitDurationUs,
+			"",
+			"duration of the accounts trie commit",
+			prometheus.ExponentialBuckets(0.001, 2, 14),
+		),
+	}
+}
+
+// labelValue returns the label value encoded in the provided key and true if the key belongs to this histogram
+func (histogram *durationHistogram) labelValue(key string) (string, bool) {
+	if len(histogram.labelName) == 0 {
+		return "", key == histogram.metricKey
+	}
+
+	value, found := strings.CutPrefix(key, common.ComputeLabeledMetricKey(histogram.metricKey, ""))
+	return value, found && len(value) > 0
+}
+
+func (histogram *durationHistogram) observe(shardID string, labelValue string, durationInMicroseconds uint64) {
+	labelValues := []string{shardID}
+	if len(histogram.labelName) > 0 {
+		labelValues = append(labelValues, labelValue)
+	}
+
+	histogram.vec.WithLabelValues(labelValues...).Observe(float64(durationInMicroseconds) / microsecondsInSecond)
+}
+
+// statusMetricsCollector exports the status metrics into a prometheus registry. It is an unchecked collector as the
+// set of status metrics is known only at collection time
+type statusMetricsCollector struct {
+	sm *statusMetrics
+}
+
+// Describe does not send any descriptor, making this collector an unchecked one
+func (collector *statusMetricsCollector) Describe(_ chan<- *prometheus.Desc) {
+}
+
+// Collect sends the current values of the status metrics
+func (collector *statusMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	collector.sm.collectPrometheusMetrics(ch)
+}
+
+func createPrometheusRegistry(sm *statusMetrics) *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(&statusMetricsCollector{sm: sm})
+	for _, histogram := range sm.durationHistograms {
+		registry.MustRegister(histogram.vec)
+	}
+
+	return registry
+}
+
+// observeDuration returns true if the key is a duration metric, in which case the value is observed
+// in the matching histogram instead of being stored
+func (sm *statusMetrics) observeDuration(key string, value uint64) bool {
+	for _, histogram := range sm.durationHistograms {
+		labelValue, isDurationMetric := histogram.labelValue(key)
+		if !isDurationMetric {
+			continue
+		}
+
+		sm.mutUint64Operations.RLock()
+		shardID := sm.uint64Metrics[common.MetricShardId]
+		sm.mutUint64Operations.RUnlock()
+
+		histogram.observe(strconv.FormatUint(shardID, 10), labelValue, value)
+		return true
+	}
+
+	return false
+}
+
+func (sm *statusMetrics) collectPrometheusMetrics(ch chan<- prometheus.Metric) {
+	sm.mutUint64Operations.RLock()
+	uint64Metrics := make(map[string]uint64, len(sm.uint64Metrics))
+	for key, value := range sm.uint64Metrics {
+		uint64Metrics[key] = value
+	}
+	sm.mutUint64Operations.RUnlock()
+
+	sm.mutInt64Operations.RLock()
+	int64Metrics := make(map[string]int64, len(sm.int64Metrics))
+	for key, value := range sm.int64Metrics {
+		int64Metrics[key] = value
+	}
+	sm.mutInt64Operations.RUnlock()
+
+	sm.mutStringOperations.RLock()
+	stringMetrics := make(map[string]string, len(sm.stringMetrics))
+	for key, value := range sm.stringMetrics {
+		stringMetrics[key] = value
+	}
+	sm.mutStringOperations.RUnlock()
+
+	// these metrics are computed at call time and would return 0 otherwise
+	_, exists := uint64Metrics[common.MetricNoncesPassedInCurrentEpoch]
+	if exists {
+		uint64Metrics[common.MetricNoncesPassedInCurrentEpoch] = computeDelta(uint64Metrics[common.MetricNonce], uint64Metrics[common.MetricNonceAtEpochStart])
+	}
+	_, exists = uint64Metrics[common.MetricRoundsPassedInCurrentEpoch]
+	if exists {
+		uint64Metrics[common.MetricRoundsPassedInCurrentEpoch] = computeDelta(uint64Metrics[common.MetricCurrentRound], uint64Metrics[common.MetricRoundAtEpochStart])
+	}
+
+	labelValues := []string{
+		strconv.FormatUint(uint64Metrics[common.MetricShardId], 10),
+		strconv.FormatUint(uint64Metrics[common.MetricEpochNumber], 10),
+	}
+	builder := &prometheusMetricsBuilder{
+		ch:          ch,
+		labelValues: labelValues,
+		sentNames:   make(map[string]struct{}),
+	}
+
+	for key, value := range uint64Metrics {
+		builder.sendNumericMetric(key, float64(value))
+	}
+	for key, value := range int64Metrics {
+		builder.sendNumericMetric(key, float64(value))
+	}
+	for key, value := range stringMetrics {
+		builder.sendStringMetric(key, value)
+	}
+}
+
+type prometheusMetricsBuilder struct {
+	ch          chan<- prometheus.Metric
+	labelValues []string
+	sentNames   map[string]struct{}
+}
+
+func (builder *prometheusMetricsBuilder) sendNumericMetric(key string, value float64) {
+	valueType := prometheus.GaugeValue
+	_, isCounter := counterMetrics[key]
+	if isCounter {
+		valueType = prometheus.CounterValue
+	}
+
+	builder.send(sanitizeMetricName(key), key, valueType, value, nil)
+}
+
+// sendStringMetric exports the numeric strings, such as the denominated amounts, as gauges. Out of the other strings,
+// only the allowed info metrics are exported, holding the string in a label and having the value 1
+func (builder *prometheusMetricsBuilder) sendStringMetric(key string, value string) {
+	numericValue, err := strconv.ParseFloat(value, 64)
+	if err == nil {
+		builder.send(sanitizeMetricName(key), key, prometheus.GaugeValue, numericValue, nil)
+		return
+	}
+
+	_, isInfoMetric := infoMetrics[key]
+	if !isInfoMetric {
+		return
+	}
+
+	if !utf8.ValidString(value) {
+		value = strings.ToValidUTF8(value, "?")
+	}
+	builder.send(sanitizeMetricName(key)+infoMetricSuffix, key, prometheus.GaugeValue, 1, &value)
+}
+
+func (builder *prometheusMetricsBuilder) send(name string, key string, valueType prometheus.ValueType, value float64, infoValue *string) {
+	_, alreadySent := builder.sentNames[name]
+	if alreadySent {
+		log.Trace("duplicated prometheus metric name", "name", name, "key", key)
+		return
+	}
+	builder.sentNames[name] = struct{}{}
+
+	labelNames := []string{shardLabel, epochLabel}
+	labelValues := builder.labelValues
+	if infoValue != nil {
+		labelNames = append(labelNames, infoValueLabel)
+		labelValues = append(append(make([]string, 0, len(labelValues)+1), labelValues...), *infoValue)
+	}
+
+	desc := prometheus.NewDesc(name, fmt.Sprintf("node status metric %s", key), labelNames, nil)
+	metric, err := prometheus.NewConstMetric(desc, valueType, value, labelValues...)
+	if err != nil {
+		log.Trace("can not create prometheus metric", "name", name, "error", err)
+		return
+	}
+
+	builder.ch <- metric
+}
+
+func sanitizeMetricName(key string) string {
+	name := invalidMetricNameChars.ReplaceAllString(key, "_")
+	if len(name) > 0 && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+
+	return name
+}
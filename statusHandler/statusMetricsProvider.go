@@ -6,6 +6,8 @@ import (
 	"sync"
 
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
 )
 
 // statusMetrics will handle displaying at /node/details all metrics already collected for other status handlers
@@ -18,15 +20,22 @@ type statusMetrics struct {
 
 	int64Metrics       map[string]int64
 	mutInt64Operations sync.RWMutex
+
+	durationHistograms []*durationHistogram
+	registry           *prometheus.Registry
 }
 
 // NewStatusMetrics will return an instance of the struct
 func NewStatusMetrics() *statusMetrics {
-	return &statusMetrics{
-		uint64Metrics: make(map[string]uint64),
-		stringMetrics: make(map[string]string),
-		int64Metrics:  make(map[string]int64),
+	sm := &statusMetrics{
+		uint64Metrics:      make(map[string]uint64),
+		stringMetrics:      make(map[string]string),
+		int64Metrics:       make(map[string]int64),
+		durationHistograms: createDurationHistograms(),
 	}
+	sm.registry = createPrometheusRegistry(sm)
+
+	return sm
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -88,8 +97,13 @@ func (sm *statusMetrics) SetInt64Value(key string, value int64) {
 	sm.int64Metrics[key] = value
 }
 
-// SetUInt64Value method - sets an uint64 value for a key
+// SetUInt64Value method - sets an uint64 value for a key. The values of the duration metrics are observed in
+// the prometheus histograms instead
 func (sm *statusMetrics) SetUInt64Value(key string, value uint64) {
+	if sm.observeDuration(key, value) {
+		return
+	}
+
 	sm.mutUint64Operations.Lock()
 	defer sm.mutUint64Operations.Unlock()
 
@@ -175,44 +189,28 @@ func (sm *statusMetrics) getMetricsWithKeyFilterMutexProtected(filterFunc func(i
 	return statusMetricsMap
 }
 
-// StatusMetricsWithoutP2PPrometheusString returns the metrics in a string format which respects prometheus style
+// StatusMetricsWithoutP2PPrometheusString returns the non-p2p metrics and the duration histograms in the prometheus
+// text exposition format. The status metrics are labeled with the shard ID and the epoch, the histograms only with
+// the shard ID
 func (sm *statusMetrics) StatusMetricsWithoutP2PPrometheusString() (string, error) {
-	metrics, err := sm.getMetricsWithoutP2P()
+	metricFamilies, err := sm.registry.Gather()
 	if err != nil {
 		return "", err
 	}
 
-	sm.mutUint64Operations.RLock()
-	shardID := sm.uint64Metrics[common.MetricShardId]
-	sm.mutUint64Operations.RUnlock()
-
 	stringBuilder := strings.Builder{}
-	for key, value := range metrics {
-		sm.addPrometheusMetricToStringBuilder(&stringBuilder, shardID, key, value)
-	}
-
-	return stringBuilder.String(), nil
-}
+	for _, metricFamily := range metricFamilies {
+		if strings.Contains(metricFamily.GetName(), "_p2p_") {
+			continue
+		}
 
-func (sm *statusMetrics) addPrometheusMetricToStringBuilder(builder *strings.Builder, shardID uint64, key string, value interface{}) {
-	// only numeric values are accepted for prometheus. return if the value is not int64 or uint64
-	switch value.(type) {
-	case int64, uint64:
-	default:
-		return
+		_, err = expfmt.MetricFamilyToText(&stringBuilder, metricFamily)
+		if err != nil {
+			return "", err
+		}
 	}
 
-	if key == common.MetricNoncesPassedInCurrentEpoch {
-		sm.mutUint64Operations.RLock()
-		value = computeDelta(sm.uint64Metrics[common.MetricNonce], sm.uint64Metrics[common.MetricNonceAtEpochStart])
-		sm.mutUint64Operations.RUnlock()
-	}
-	if key == common.MetricRoundsPassedInCurrentEpoch {
-		sm.mutUint64Operations.RLock()
-		value = computeDelta(sm.uint64Metrics[common.MetricCurrentRound], sm.uint64Metrics[common.MetricRoundAtEpochStart])
-		sm.mutUint64Operations.RUnlock()
-	}
-	builder.WriteString(fmt.Sprintf("%s{%s=\"%d\"} %v\n", key, common.MetricShardId, shardID, value))
+	return stringBuilder.String(), nil
 }
 
 // EconomicsMetrics returns the economics related metrics
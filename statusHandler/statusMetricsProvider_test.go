@@ -2,6 +2,10 @@ package statusHandler_test
 
 import (
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -119,13 +123,15 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldPutDefaultSh
 
 	sm := statusHandler.NewStatusMetrics()
 	key1, value1 := "test-key7", uint64(100)
-	key2, value2 := "test-key8", "value8"
+	key2, value2 := common.MetricNodeType, "observer"
 	sm.SetUInt64Value(key1, value1)
 	sm.SetStringValue(key2, value2)
 
 	strRes, _ := sm.StatusMetricsWithoutP2PPrometheusString()
 
-	expectedMetricOutput := fmt.Sprintf("%s{%s=\"%d\"} %v", key1, common.MetricShardId, 0, value1)
+	expectedMetricOutput := fmt.Sprintf("test_key7{%s=\"0\",%s=\"%d\"} %v", common.MetricEpochNumber, common.MetricShardId, 0, value1)
+	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
+	expectedMetricOutput = fmt.Sprintf("erd_node_type_info{%s=\"0\",%s=\"%d\",value=\"%s\"} 1", common.MetricEpochNumber, common.MetricShardId, 0, value2)
 	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
 }
 
@@ -143,7 +149,7 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldPutCorrectSh
 
 	strRes, _ := sm.StatusMetricsWithoutP2PPrometheusString()
 
-	expectedMetricOutput := fmt.Sprintf("%s{%s=\"%d\"} %v", key1, common.MetricShardId, shardID, value1)
+	expectedMetricOutput := fmt.Sprintf("test_key7{%s=\"0\",%s=\"%d\"} %v", common.MetricEpochNumber, common.MetricShardId, shardID, value1)
 	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
 }
 
@@ -162,8 +168,145 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldComputeRound
 
 	strRes, _ := sm.StatusMetricsWithoutP2PPrometheusString()
 
-	assert.Contains(t, strRes, `erd_rounds_passed_in_current_epoch{erd_shard_id="2"} 37`)
-	assert.Contains(t, strRes, `erd_nonces_passed_in_current_epoch{erd_shard_id="2"} 38`)
+	assert.Contains(t, strRes, `erd_rounds_passed_in_current_epoch{erd_epoch_number="0",erd_shard_id="2"} 37`)
+	assert.Contains(t, strRes, `erd_nonces_passed_in_current_epoch{erd_epoch_number="0",erd_shard_id="2"} 38`)
+}
+
+func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldExportTypedMetrics(t *testing.T) {
+	t.Parallel()
+
+	sm := statusHandler.NewStatusMetrics()
+	sm.SetUInt64Value(common.MetricShardId, 1)
+	sm.SetUInt64Value(common.MetricEpochNumber, 7)
+	sm.SetUInt64Value(common.MetricCountAcceptedBlocks, 0)
+	sm.Increment(common.MetricCountAcceptedBlocks)
+	sm.SetInt64Value("erd_test_signed_value", -5)
+	sm.SetStringValue(common.MetricTotalSupply, "20000000000000000000000000")
+	sm.SetStringValue(common.MetricTotalFees, "20000000")
+	sm.SetStringValue(common.MetricNodeType, "validator")
+	sm.SetStringValue(common.MetricCurrentBlockHash, "0a0b0c")
+	sm.SetStringValue(common.MetricConsensusRoundState, "signed")
+	sm.SetUInt64Value(common.MetricP2PUnknownPeers, 10)
+
+	strRes, err := sm.StatusMetricsWithoutP2PPrometheusString()
+	require.Nil(t, err)
+
+	assert.Contains(t, strRes, "# TYPE erd_count_accepted_blocks counter")
+	assert.Contains(t, strRes, `erd_count_accepted_blocks{erd_epoch_number="7",erd_shard_id="1"} 1`)
+	assert.Contains(t, strRes, "# TYPE erd_test_signed_value gauge")
+	assert.Contains(t, strRes, `erd_test_signed_value{erd_epoch_number="7",erd_shard_id="1"} -5`)
+	assert.Contains(t, strRes, `erd_total_fees{erd_epoch_number="7",erd_shard_id="1"} 2e+07`)
+	assert.Contains(t, strRes, `erd_total_supply{erd_epoch_number="7",erd_shard_id="1"} 2e+25`)
+	assert.Contains(t, strRes, `erd_node_type_info{erd_epoch_number="7",erd_shard_id="1",value="validator"} 1`)
+	assert.NotContains(t, strRes, common.MetricCurrentBlockHash)
+	assert.NotContains(t, strRes, common.MetricConsensusRoundState)
+	assert.NotContains(t, strRes, common.MetricP2PUnknownPeers)
+}
+
+func TestStatusMetrics_DurationMetricsShouldBeObservedInHistograms(t *testing.T) {
+	t.Parallel()
+
+	sm := statusHandler.NewStatusMetrics()
+	sm.SetUInt64Value(common.MetricShardId, 2)
+	sm.SetUInt64Value(common.MetricBlockProcessingDurationUs, 150000)
+	sm.SetUInt64Value(common.MetricBlockProcessingDurationUs, 50000)
+	sm.SetUInt64Value(common.ComputeLabeledMetricKey(common.MetricConsensusSubroundDurationUs, "BLOCK"), 2000)
+	sm.SetUInt64Value(common.ComputeLabeledMetricKey(common.MetricApiRequestDurationUs, "address"), 300)
+	sm.SetUInt64Value(common.MetricTrieCommitDurationUs, 1000)
+
+	metrics := sm.StatusMetricsMap()
+	assert.NotContains(t, metrics, common.MetricBlockProcessingDurationUs)
+	assert.NotContains(t, metrics, common.MetricTrieCommitDurationUs)
+
+	strRes, err := sm.StatusMetricsWithoutP2PPrometheusString()
+	require.Nil(t, err)
+
+	assert.Contains(t, strRes, "# TYPE erd_block_processing_duration_seconds histogram")
+	assert.Contains(t, strRes, `erd_block_processing_duration_seconds_count{erd_shard_id="2"} 2`)
+	assert.Contains(t, strRes, `erd_block_processing_duration_seconds_sum{erd_shard_id="2"} 0.2`)
+	assert.Contains(t, strRes, `erd_block_processing_duration_seconds_bucket{erd_shard_id="2",le="0.08"} 1`)
+	assert.Contains(t, strRes, `erd_consensus_subround_duration_seconds_count{erd_shard_id="2",subround="block"} 1`)
+	assert.Contains(t, strRes, `erd_api_request_duration_seconds_count{erd_shard_id="2",group="address"} 1`)
+	assert.Contains(t, strRes, `erd_trie_commit_duration_seconds_sum{erd_shard_id="2"} 0.001`)
+}
+
+func TestStatusMetrics_PrometheusExpositionShouldCoverAllMetrics(t *testing.T) {
+	t.Parallel()
+
+	metricKeys := parseMetricKeys(t, "../common/constants.go")
+	require.Greater(t, len(metricKeys), 200)
+
+	sm := statusHandler.NewStatusMetrics()
+	for _, key := range metricKeys {
+		sm.SetUInt64Value(key, 1)
+	}
+
+	families, err := sm.PrometheusRegistry().Gather()
+	require.Nil(t, err)
+
+	exportedNames := make(map[string]struct{})
+	for _, family := range families {
+		exportedNames[family.GetName()] = struct{}{}
+	}
+
+	durationMetrics := map[string]struct{}{
+		common.MetricBlockProcessingDurationUs:   {},
+		common.MetricConsensusSubroundDurationUs: {},
+		common.MetricApiRequestDurationUs:        {},
+		common.MetricTrieCommitDurationUs:        {},
+	}
+	for _, key := range metricKeys {
+		_, isDurationMetric := durationMetrics[key]
+		if isDurationMetric {
+			continue
+		}
+
+		_, found := exportedNames[key]
+		assert.True(t, found, "metric %s was not exported", key)
+	}
+	_, found := exportedNames["erd_block_processing_duration_seconds"]
+	assert.True(t, found)
+	_, found = exportedNames["erd_trie_commit_duration_seconds"]
+	assert.True(t, found)
+}
+
+// parseMetricKeys returns the values of all the string constants named Metric* from the provided file
+func parseMetricKeys(tb testing.TB, filePath string) []string {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, filePath, nil, 0)
+	require.Nil(tb, err)
+
+	keys := make([]string, 0)
+	for _, declaration := range file.Decls {
+		genDecl, ok := declaration.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			for i, name := range valueSpec.Names {
+				if !strings.HasPrefix(name.Name, "Metric") || i >= len(valueSpec.Values) {
+					continue
+				}
+
+				literal, isLiteral := valueSpec.Values[i].(*ast.BasicLit)
+				if !isLiteral || literal.Kind != token.STRING {
+					continue
+				}
+
+				key, errUnquote := strconv.Unquote(literal.Value)
+				require.Nil(tb, errUnquote)
+				if key == common.MetricValueNA {
+					// a metric value, not a metric key
+					continue
+				}
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys
 }
 
 func TestStatusMetrics_NetworkConfig(t *testing.T) {
@@ -12,7 +12,8 @@ const minNumShards = 2
 
 // persisterCreator is the factory which will handle creating new persisters
 type persisterCreator struct {
-	conf config.DBConfig
+	conf     config.DBConfig
+	readOnly bool
 }
 
 func newPersisterCreator(config config.DBConfig) *persisterCreator {
@@ -21,6 +22,13 @@ func newPersisterCreator(config config.DBConfig) *persisterCreator {
 	}
 }
 
+func newReadOnlyPersisterCreator(config config.DBConfig) *persisterCreator {
+	return &persisterCreator{
+		conf:     config,
+		readOnly: true,
+	}
+}
+
 // Create will create the persister for the provided path
 func (pc *persisterCreator) Create(path string) (storage.Persister, error) {
 	if len(path) == 0 {
@@ -41,6 +49,12 @@ func (pc *persisterCreator) Create(path string) (storage.Persister, error) {
 // CreateBasePersister will create base the persister for the provided path
 func (pc *persisterCreator) CreateBasePersister(path string) (storage.Persister, error) {
 	var dbType = storageunit.DBType(pc.conf.Type)
+	if pc.readOnly {
+		return pc.createReadOnlyBasePersister(dbType, path)
+	}
+	if dbType == storageunit.PebbleDB {
+		return database.NewPebbleDB(path, pc.conf.BatchDelaySeconds, pc.conf.MaxBatchSize, pc.conf.MaxOpenFiles)
+	}
 
 	argsDB := factory.ArgDB{
 		DBType:            dbType,
@@ -53,6 +67,17 @@ func (pc *persisterCreator) CreateBasePersister(path string) (storage.Persister,
 	return storageunit.NewDB(argsDB)
 }
 
+func (pc *persisterCreator) createReadOnlyBasePersister(dbType storageunit.DBType, path string) (storage.Persister, error) {
+	switch dbType {
+	case storageunit.LvlDB, storageunit.LvlDBSerial:
+		return database.NewReadOnlyLevelDB(path, pc.conf.MaxOpenFiles)
+	case storageunit.PebbleDB:
+		return database.NewReadOnlyPebbleDB(path, pc.conf.MaxOpenFiles)
+	default:
+		return nil, storage.ErrNotSupportedDBType
+	}
+}
+
 func (pc *persisterCreator) createShardIDProvider() (storage.ShardIDProvider, error) {
 	switch storageunit.ShardIDProviderType(pc.conf.ShardIDProviderType) {
 	case storageunit.BinarySplit:
@@ -2,6 +2,7 @@ package factory_test
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -46,7 +47,7 @@ func TestPersisterCreator_Create(t *testing.T) {
 
 		pc := factory.NewPersisterCreator(conf)
 
-		p, err := pc.Create("path1")
+		p, err := pc.Create(filepath.Join(t.TempDir(), "path1"))
 		require.Nil(t, err)
 		require.NotNil(t, p)
 	})
@@ -76,6 +77,32 @@ func TestPersisterCreator_Create(t *testing.T) {
 
 		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*sharded.shardedPersister"))
 	})
+
+	t.Run("should create sharded pebble persister", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createDefaultDBConfig()
+		dbConfig.Type = string(storageunit.PebbleDB)
+		pc := factory.NewPersisterCreator(dbConfig)
+
+		dir := t.TempDir()
+		p, err := pc.Create(dir)
+		require.NotNil(t, p)
+		require.Nil(t, err)
+
+		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*sharded.shardedPersister"))
+
+		key, value := []byte("key"), []byte("value")
+		require.Nil(t, p.Put(key, value))
+		require.Nil(t, p.Close())
+
+		p, err = pc.Create(dir)
+		require.Nil(t, err)
+		recovered, err := p.Get(key)
+		require.Nil(t, err)
+		require.Equal(t, value, recovered)
+		require.Nil(t, p.Close())
+	})
 }
 
 func TestPersisterCreator_CreateBasePersister(t *testing.T) {
@@ -136,6 +163,22 @@ func TestPersisterCreator_CreateBasePersister(t *testing.T) {
 
 		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*memorydb.DB"))
 	})
+
+	t.Run("pebble", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createDefaultBasePersisterConfig()
+		dbConfig.Type = string(storageunit.PebbleDB)
+		pc := factory.NewPersisterCreator(dbConfig)
+
+		dir := t.TempDir()
+		p, err := pc.CreateBasePersister(dir)
+		require.NotNil(t, p)
+		require.Nil(t, err)
+
+		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*pebbledb.DB"))
+		require.Nil(t, p.Close())
+	})
 }
 
 func TestPersisterCreator_CreateShardIDProvider(t *testing.T) {
@@ -79,6 +79,21 @@ func (pf *persisterFactory) Create(path string) (storage.Persister, error) {
 	return persister, nil
 }
 
+// CreateReadOnly will open the existing DB found in the given path without altering it. The DB type and the sharding
+// are read from the config file of the DB, if present
+func (pf *persisterFactory) CreateReadOnly(path string) (storage.Persister, error) {
+	if len(path) == 0 {
+		return nil, storage.ErrInvalidFilePath
+	}
+
+	dbConfig, err := pf.dbConfigHandler.GetDBConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return newReadOnlyPersisterCreator(*dbConfig).Create(path)
+}
+
 // CreateDisabled will return a new disabled persister
 func (pf *persisterFactory) CreateDisabled() storage.Persister {
 	return disabled.NewErrorDisabledPersister()
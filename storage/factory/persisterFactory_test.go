@@ -190,6 +190,74 @@ func TestPersisterFactory_Create_ConfigSaveToFilePath(t *testing.T) {
 	})
 }
 
+func TestPersisterFactory_CreateReadOnly(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid file path, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		pf, _ := factory.NewPersisterFactory(createDefaultDBConfig())
+
+		p, err := pf.CreateReadOnly("")
+		require.Nil(t, p)
+		require.Equal(t, storage.ErrInvalidFilePath, err)
+	})
+	t.Run("missing database, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		pf, _ := factory.NewPersisterFactory(createDefaultDBConfig())
+
+		dir := path.Join(t.TempDir(), "missing")
+		p, err := pf.CreateReadOnly(dir)
+		require.Nil(t, p)
+		require.NotNil(t, err)
+
+		_, err = os.Stat(dir)
+		require.True(t, os.IsNotExist(err))
+	})
+	t.Run("memory database, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createDefaultDBConfig()
+		dbConfig.Type = string(storageunit.MemoryDB)
+		pf, _ := factory.NewPersisterFactory(dbConfig)
+
+		p, err := pf.CreateReadOnly(t.TempDir())
+		require.Nil(t, p)
+		require.Equal(t, storage.ErrNotSupportedDBType, err)
+	})
+	t.Run("should open the database described by its config file as read-only", func(t *testing.T) {
+		t.Parallel()
+
+		for _, dbType := range []storageunit.DBType{storageunit.LvlDBSerial, storageunit.PebbleDB} {
+			dbConfig := createDefaultDBConfig()
+			dbConfig.Type = string(dbType)
+			dbConfig.MaxBatchSize = 1
+			dbConfig.NumShards = 4
+			dbConfig.ShardIDProviderType = string(storageunit.BinarySplit)
+			pf, _ := factory.NewPersisterFactory(dbConfig)
+
+			dir := t.TempDir()
+			p, err := pf.Create(dir)
+			require.Nil(t, err)
+			require.Nil(t, p.Put([]byte("key"), []byte("value")))
+			require.Nil(t, p.Close())
+
+			// the config file of the database takes precedence over the provided config
+			pf, _ = factory.NewPersisterFactory(createDefaultDBConfig())
+			p, err = pf.CreateReadOnly(dir)
+			require.Nil(t, err, string(dbType))
+
+			value, err := p.Get([]byte("key"))
+			require.Nil(t, err)
+			require.Equal(t, []byte("value"), value)
+			require.Equal(t, storage.ErrReadOnlyPersister, p.Put([]byte("key"), []byte("other")))
+			require.Equal(t, storage.ErrReadOnlyPersister, p.Remove([]byte("key")))
+			require.Nil(t, p.Close())
+		}
+	})
+}
+
 func TestPersisterFactory_CreateDisabled(t *testing.T) {
 	t.Parallel()
 
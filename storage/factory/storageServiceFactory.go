@@ -534,9 +534,28 @@ func (psf *StorageServiceFactory) setUpDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	err = psf.setUpAddressTransactionsStorer(chainStorer, shardID)
+	if err != nil {
+		return err
+	}
+
 	return psf.setUpEsdtSuppliesStorer(chainStorer, shardID)
 }
 
+func (psf *StorageServiceFactory) setUpAddressTransactionsStorer(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
+	if !psf.generalConfig.DbLookupExtensions.AddressTransactionsIndexEnabled {
+		return nil
+	}
+
+	addressTransactionsUnit, err := psf.createStaticStorageUnit(psf.generalConfig.DbLookupExtensions.AddressTransactionsStorageConfig, shardIDStr, emptyDBPathSuffix)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.AddressTransactionsStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.AddressTransactionsUnit, addressTransactionsUnit)
+	return nil
+}
+
 func (psf *StorageServiceFactory) setUpEsdtSuppliesStorer(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
 	esdtSuppliesUnit, err := psf.createStaticStorageUnit(psf.generalConfig.DbLookupExtensions.ESDTSuppliesStorageConfig, shardIDStr, emptyDBPathSuffix)
 	if err != nil {
@@ -57,6 +57,7 @@ func createMockArgument(t *testing.T) StorageServiceFactoryArgs {
 				ResultsHashesByTxHashStorageConfig: createMockStorageConfig("ResultsHashesByTxHashStorage"),
 				ESDTSuppliesStorageConfig:          createMockStorageConfig("ESDTSuppliesStorage"),
 				RoundHashStorageConfig:             createMockStorageConfig("RoundHashStorage"),
+				AddressTransactionsStorageConfig:   createMockStorageConfig("AddressTransactionsStorage"),
 			},
 			LogsAndEvents: config.LogsAndEventsConfig{
 				SaveInStorageEnabled: true,
@@ -390,6 +391,17 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.RoundHashStorageConfig", err.Error())
 		assert.True(t, check.IfNil(storageService))
 	})
+	t.Run("wrong config for DbLookupExtensions.AddressTransactionsStorageConfig should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.AddressTransactionsIndexEnabled = true
+		args.Config.DbLookupExtensions.AddressTransactionsStorageConfig.Cache.Type = ""
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.AddressTransactionsStorageConfig", err.Error())
+		assert.True(t, check.IfNil(storageService))
+	})
 	t.Run("wrong config for LogsAndEvents.TxLogsStorage should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -420,6 +432,24 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 
 		_ = storageService.CloseAll()
 	})
+	t.Run("should work with the address transactions index", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.AddressTransactionsIndexEnabled = true
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(storageService))
+		allStorers := storageService.GetAllStorers()
+		expectedStorers := 25
+		assert.Equal(t, expectedStorers, len(allStorers))
+
+		_, err = storageService.GetStorer(dataRetriever.AddressTransactionsUnit)
+		assert.Nil(t, err)
+
+		_ = storageService.CloseAll()
+	})
 	t.Run("should work without DbLookupExtensions", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,163 @@
+package leveldb
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+var _ storage.Persister = (*readOnlyDB)(nil)
+
+var log = logger.GetOrCreate("storage/leveldb")
+
+// readOnlyDB opens an existing leveldb database without altering it. All the write operations will error
+type readOnlyDB struct {
+	mutDb sync.RWMutex
+	db    *leveldb.DB
+	path  string
+}
+
+// NewReadOnlyDB opens the existing leveldb database found in the location given as parameter as read-only
+func NewReadOnlyDB(path string, maxOpenFiles int) (*readOnlyDB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	options := &opt.Options{
+		// disable internal cache
+		BlockCacheCapacity:     -1,
+		OpenFilesCacheCapacity: maxOpenFiles,
+		ErrorIfMissing:         true,
+		ReadOnly:               true,
+	}
+	db, err := leveldb.OpenFile(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	log.Debug("opened read-only leveldb persister", "path", path)
+
+	return &readOnlyDB{
+		db:   db,
+		path: path,
+	}, nil
+}
+
+// Put returns ErrReadOnlyPersister
+func (s *readOnlyDB) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Get returns the value associated to the key
+func (s *readOnlyDB) Get(key []byte) ([]byte, error) {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	data, err := s.db.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *readOnlyDB) Has(key []byte) error {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	has, err := s.db.Has(key, nil)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return storage.ErrKeyNotFound
+	}
+
+	return nil
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *readOnlyDB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return
+	}
+
+	iterator := s.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		key := iterator.Key()
+		clonedKey := make([]byte, len(key))
+		copy(clonedKey, key)
+
+		val := iterator.Value()
+		clonedVal := make([]byte, len(val))
+		copy(clonedVal, val)
+
+		shouldContinue := handler(clonedKey, clonedVal)
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+// Remove returns ErrReadOnlyPersister
+func (s *readOnlyDB) Remove(_ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *readOnlyDB) Close() error {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	if s.db == nil {
+		return nil
+	}
+
+	db := s.db
+	s.db = nil
+	log.Debug("closing read-only leveldb persister", "path", s.path)
+
+	return db.Close()
+}
+
+// Destroy returns ErrReadOnlyPersister
+func (s *readOnlyDB) Destroy() error {
+	return storage.ErrReadOnlyPersister
+}
+
+// DestroyClosed returns ErrReadOnlyPersister
+func (s *readOnlyDB) DestroyClosed() error {
+	return storage.ErrReadOnlyPersister
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *readOnlyDB) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,94 @@
+package leveldb
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-storage-go/leveldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLevelDBWithPairs(t *testing.T, pairs map[string]string) string {
+	path := t.TempDir()
+	db, err := leveldb.NewDB(path, 1, 1, 10)
+	require.Nil(t, err)
+
+	for key, value := range pairs {
+		require.Nil(t, db.Put([]byte(key), []byte(value)))
+	}
+	require.Nil(t, db.Close())
+
+	return path
+}
+
+func TestNewReadOnlyDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of open files should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewReadOnlyDB(createLevelDBWithPairs(t, nil), 0)
+		assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+		assert.Nil(t, db)
+	})
+	t.Run("missing database should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewReadOnlyDB(filepath.Join(t.TempDir(), "missing"), 10)
+		assert.NotNil(t, err)
+		assert.Nil(t, db)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewReadOnlyDB(createLevelDBWithPairs(t, nil), 10)
+		assert.Nil(t, err)
+		assert.False(t, db.IsInterfaceNil())
+		assert.Nil(t, db.Close())
+	})
+}
+
+func TestReadOnlyDB_Operations(t *testing.T) {
+	t.Parallel()
+
+	path := createLevelDBWithPairs(t, map[string]string{"key1": "value1", "key2": "value2"})
+	db, err := NewReadOnlyDB(path, 10)
+	require.Nil(t, err)
+
+	value, err := db.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	assert.Nil(t, db.Has([]byte("key2")))
+
+	_, err = db.Get([]byte("key3"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has([]byte("key3")))
+
+	assert.Equal(t, storage.ErrReadOnlyPersister, db.Put([]byte("key3"), []byte("value3")))
+	assert.Equal(t, storage.ErrReadOnlyPersister, db.Remove([]byte("key1")))
+	assert.Equal(t, storage.ErrReadOnlyPersister, db.Destroy())
+	assert.Equal(t, storage.ErrReadOnlyPersister, db.DestroyClosed())
+
+	pairs := make(map[string]string)
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		pairs[string(key)] = string(value)
+		return true
+	})
+	assert.Equal(t, map[string]string{"key1": "value1", "key2": "value2"}, pairs)
+
+	assert.Nil(t, db.Close())
+	assert.Nil(t, db.Close())
+	_, err = db.Get([]byte("key1"))
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+	assert.Equal(t, storage.ErrDBIsClosed, db.Has([]byte("key1")))
+
+	// the database was not altered
+	db, err = NewReadOnlyDB(path, 10)
+	require.Nil(t, err)
+	value, err = db.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	assert.Nil(t, db.Close())
+}
@@ -0,0 +1,136 @@
+package pebbledb
+
+import (
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+var _ storage.Batcher = (*batch)(nil)
+
+type batch struct {
+	batch       *pebble.Batch
+	cachedData  map[string][]byte
+	removedData map[string]struct{}
+	mutBatch    sync.RWMutex
+}
+
+// newBatch creates a batch bound to the provided pebble database
+func newBatch(db *pebble.DB) *batch {
+	return &batch{
+		batch:       db.NewBatch(),
+		cachedData:  make(map[string][]byte),
+		removedData: make(map[string]struct{}),
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch
+func (b *batch) Put(key []byte, val []byte) error {
+	b.mutBatch.Lock()
+	defer b.mutBatch.Unlock()
+
+	if b.batch == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	err := b.batch.Set(key, val, nil)
+	if err != nil {
+		return err
+	}
+
+	b.cachedData[string(key)] = val
+	delete(b.removedData, string(key))
+
+	return nil
+}
+
+// Delete deletes the entry for the provided key from the batch
+func (b *batch) Delete(key []byte) error {
+	b.mutBatch.Lock()
+	defer b.mutBatch.Unlock()
+
+	if b.batch == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	err := b.batch.Delete(key, nil)
+	if err != nil {
+		return err
+	}
+
+	b.removedData[string(key)] = struct{}{}
+	delete(b.cachedData, string(key))
+
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	if b.batch != nil {
+		b.batch.Reset()
+	}
+	b.cachedData = make(map[string][]byte)
+	b.removedData = make(map[string]struct{})
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.cachedData[string(key)]
+}
+
+// IsRemoved returns true if the key is marked for removal
+func (b *batch) IsRemoved(key []byte) bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	_, found := b.removedData[string(key)]
+
+	return found
+}
+
+func (b *batch) isEmpty() bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.batch == nil || b.batch.Empty()
+}
+
+func (b *batch) commit() error {
+	b.mutBatch.Lock()
+	defer b.mutBatch.Unlock()
+
+	if b.batch == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	return b.batch.Commit(pebble.Sync)
+}
+
+// close releases the underlying pebble batch, after which all the writes are rejected. Closing an already closed
+// batch does nothing
+func (b *batch) close() error {
+	b.mutBatch.Lock()
+	defer b.mutBatch.Unlock()
+
+	if b.batch == nil {
+		return nil
+	}
+
+	err := b.batch.Close()
+	b.batch = nil
+	b.cachedData = make(map[string][]byte)
+	b.removedData = make(map[string]struct{})
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
@@ -0,0 +1,55 @@
+package pebbledb
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+
+	db, err := pebble.Open(t.TempDir(), &pebble.Options{Logger: &pebbleLogger{}})
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	b := newBatch(db)
+	assert.False(t, b.IsInterfaceNil())
+	assert.True(t, b.isEmpty())
+
+	key, value := []byte("key"), []byte("value")
+	require.Nil(t, b.Put(key, value))
+	assert.Equal(t, value, b.Get(key))
+	assert.False(t, b.IsRemoved(key))
+	assert.False(t, b.isEmpty())
+
+	require.Nil(t, b.Delete(key))
+	assert.Nil(t, b.Get(key))
+	assert.True(t, b.IsRemoved(key))
+
+	require.Nil(t, b.Put(key, value))
+	assert.False(t, b.IsRemoved(key))
+	require.Nil(t, b.commit())
+
+	b.Reset()
+	assert.True(t, b.isEmpty())
+	assert.Nil(t, b.Get(key))
+
+	recovered, closer, err := db.Get(key)
+	require.Nil(t, err)
+	assert.Equal(t, value, recovered)
+	_ = closer.Close()
+
+	require.Nil(t, b.close())
+	require.Nil(t, b.close())
+	assert.True(t, b.isEmpty())
+	assert.Equal(t, storage.ErrDBIsClosed, b.Put(key, value))
+	assert.Equal(t, storage.ErrDBIsClosed, b.Delete(key))
+	assert.Equal(t, storage.ErrDBIsClosed, b.commit())
+	b.Reset()
+}
@@ -0,0 +1,20 @@
+package pebbledb
+
+import (
+	"fmt"
+)
+
+// pebbleLogger redirects the pebble internal messages to the node's logger
+type pebbleLogger struct{}
+
+// Infof logs the pebble informative messages, which are too verbose for the info level
+func (pl *pebbleLogger) Infof(format string, args ...interface{}) {
+	log.Trace(fmt.Sprintf(format, args...))
+}
+
+// Fatalf logs the pebble fatal messages and panics, as pebble does not expect this call to return
+func (pl *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	log.Error(message)
+	panic(message)
+}
@@ -0,0 +1,309 @@
+package pebbledb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+var log = logger.GetOrCreate("storage/pebbledb")
+
+// DB holds a pointer to the pebble database and the path to where it is stored.
+// The writes are accumulated in a batch which is committed either when it reaches
+// the maximum size or when the batch delay expires, same as the leveldb persister
+type DB struct {
+	mutDb             sync.RWMutex
+	db                *pebble.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             *batch
+	mutBatch          sync.RWMutex
+	cancel            context.CancelFunc
+}
+
+// NewDB is a constructor for the pebble persister
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (*DB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &pebble.Options{
+		MaxOpenFiles: maxOpenFiles,
+		Logger:       &pebbleLogger{},
+	}
+	db, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		batch:             newBatch(db),
+		cancel:            cancel,
+	}
+
+	go dbStore.batchTimeoutHandle(ctx)
+
+	log.Debug("opened pebble db persister", "path", path)
+
+	return dbStore, nil
+}
+
+func (s *DB) batchTimeoutHandle(ctx context.Context) {
+	interval := time.Duration(s.batchDelaySeconds) * time.Second
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(interval)
+
+		select {
+		case <-timer.C:
+			s.mutBatch.Lock()
+			err := s.putBatch()
+			if err != nil {
+				log.Warn("pebble putBatch", "error", err.Error())
+			}
+			s.mutBatch.Unlock()
+		case <-ctx.Done():
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch()
+	if err != nil {
+		log.Warn("pebble putBatch", "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// putBatch commits the batch into the database and resets it. Should be called under the batch mutex
+func (s *DB) putBatch() error {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return storage.ErrDBIsClosed
+	}
+	if s.batch.isEmpty() {
+		return nil
+	}
+
+	err := s.batch.commit()
+	if err != nil {
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	s.mutBatch.RLock()
+	err := s.batch.Put(key, val)
+	s.mutBatch.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	if s.batch.IsRemoved(key) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	data := s.batch.Get(key)
+	if data != nil {
+		return data, nil
+	}
+
+	value, closer, err := s.db.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the returned value is valid only until the closer is called
+	data = make([]byte, len(value))
+	copy(data, value)
+
+	return data, closer.Close()
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	_, err := s.Get(key)
+
+	return err
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return
+	}
+
+	rangeKeys(s.db, s.path, handler)
+}
+
+func rangeKeys(db *pebble.DB, path string, handler func(key []byte, value []byte) bool) {
+	iterator, err := db.NewIter(nil)
+	if err != nil {
+		log.Warn("pebble RangeKeys: cannot create iterator", "path", path, "error", err.Error())
+		return
+	}
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	for iterator.First(); iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		clonedKey := make([]byte, len(key))
+		copy(clonedKey, key)
+
+		val, errValue := iterator.ValueAndErr()
+		if errValue != nil {
+			log.Warn("pebble RangeKeys: cannot read value", "path", path, "error", errValue.Error())
+			return
+		}
+		clonedVal := make([]byte, len(val))
+		copy(clonedVal, val)
+
+		shouldContinue := handler(clonedKey, clonedVal)
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	s.mutBatch.Lock()
+	_ = s.batch.Delete(key)
+	s.mutBatch.Unlock()
+
+	return s.updateBatchWithIncrement()
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutBatch.Lock()
+	_ = s.putBatch()
+	s.closeBatch()
+	s.mutBatch.Unlock()
+
+	s.cancel()
+
+	return s.closeDB()
+}
+
+// closeBatch releases the batch. Should be called under the batch mutex
+func (s *DB) closeBatch() {
+	err := s.batch.close()
+	if err != nil {
+		log.Warn("cannot close the pebble batch", "path", s.path, "error", err.Error())
+	}
+}
+
+func (s *DB) closeDB() error {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	if s.db == nil {
+		return nil
+	}
+
+	db := s.db
+	s.db = nil
+	log.Debug("closing pebble db persister", "path", s.path)
+
+	return db.Close()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.closeBatch()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+	err := s.closeDB()
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
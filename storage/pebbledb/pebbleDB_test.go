@@ -0,0 +1,209 @@
+package pebbledb
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPebbleDB(t *testing.T, batchDelaySeconds int, maxBatchSize int) (*DB, string) {
+	path := t.TempDir()
+	db, err := NewDB(path, batchDelaySeconds, maxBatchSize, 10)
+	require.Nil(t, err)
+
+	return db, path
+}
+
+func TestNewDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of open files should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewDB(t.TempDir(), 1, 1, 0)
+		assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+		assert.Nil(t, db)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewDB(t.TempDir(), 1, 1, 10)
+		assert.Nil(t, err)
+		assert.False(t, db.IsInterfaceNil())
+		assert.Nil(t, db.Close())
+	})
+}
+
+func TestDB_PutGetHasRemove(t *testing.T) {
+	t.Parallel()
+
+	db, _ := createPebbleDB(t, 10, 100)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	key, value := []byte("key"), []byte("value")
+	_, err := db.Get(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+
+	// the value is served from the batch
+	require.Nil(t, db.Put(key, value))
+	recovered, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, value, recovered)
+	assert.Nil(t, db.Has(key))
+
+	// the value is served from the database
+	db.mutBatch.Lock()
+	require.Nil(t, db.putBatch())
+	db.mutBatch.Unlock()
+	recovered, err = db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, value, recovered)
+
+	// the removal is served from the batch, before being committed
+	require.Nil(t, db.Remove(key))
+	_, err = db.Get(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+}
+
+func TestDB_BatchIsCommittedWhenFull(t *testing.T) {
+	t.Parallel()
+
+	maxBatchSize := 3
+	db, path := createPebbleDB(t, 100, maxBatchSize)
+
+	for i := 0; i < maxBatchSize; i++ {
+		require.Nil(t, db.Put([]byte(fmt.Sprintf("key%d", i)), []byte("value")))
+	}
+	assert.True(t, db.batch.isEmpty())
+	assert.Zero(t, db.sizeBatch)
+
+	require.Nil(t, db.Put([]byte("pending"), []byte("value")))
+	assert.False(t, db.batch.isEmpty())
+	assert.Equal(t, 1, db.sizeBatch)
+
+	// the pending writes are committed on close
+	require.Nil(t, db.Close())
+	db, err := NewDB(path, 100, maxBatchSize, 10)
+	require.Nil(t, err)
+	_, err = db.Get([]byte("pending"))
+	assert.Nil(t, err)
+	assert.Nil(t, db.Close())
+}
+
+func TestDB_BatchIsCommittedWhenTheDelayExpires(t *testing.T) {
+	t.Parallel()
+
+	db, _ := createPebbleDB(t, 1, 100)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	require.Nil(t, db.Put([]byte("key"), []byte("value")))
+	assert.Eventually(t, db.batch.isEmpty, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	t.Parallel()
+
+	db, _ := createPebbleDB(t, 10, 1)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	db.RangeKeys(nil)
+
+	expected := map[string][]byte{
+		"key0": []byte("value0"),
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+	}
+	for key, value := range expected {
+		require.Nil(t, db.Put([]byte(key), value))
+	}
+
+	recovered := make(map[string][]byte)
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		recovered[string(key)] = value
+		return true
+	})
+	assert.Equal(t, expected, recovered)
+
+	numCalls := 0
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		numCalls++
+		return false
+	})
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestDB_ClosedDB(t *testing.T) {
+	t.Parallel()
+
+	db, path := createPebbleDB(t, 10, 1)
+	require.Nil(t, db.Close())
+	require.Nil(t, db.Close())
+
+	_, err := db.Get([]byte("key"))
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+	assert.Equal(t, storage.ErrDBIsClosed, db.Has([]byte("key")))
+	assert.Equal(t, storage.ErrDBIsClosed, db.Put([]byte("key"), []byte("value")))
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		assert.Fail(t, "should have not been called")
+		return false
+	})
+
+	require.Nil(t, db.DestroyClosed())
+	assert.NoDirExists(t, path)
+}
+
+func TestDB_Destroy(t *testing.T) {
+	t.Parallel()
+
+	db, path := createPebbleDB(t, 10, 10)
+	require.Nil(t, db.Put([]byte("key"), []byte("value")))
+	require.Nil(t, db.Destroy())
+	assert.NoDirExists(t, path)
+}
+
+func TestDB_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	db, _ := createPebbleDB(t, 1, 5)
+
+	numOperations := 500
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			key := []byte(fmt.Sprintf("key%d", idx%50))
+			switch idx % 5 {
+			case 0:
+				_ = db.Put(key, []byte("value"))
+			case 1:
+				_, _ = db.Get(key)
+			case 2:
+				_ = db.Has(key)
+			case 3:
+				_ = db.Remove(key)
+			case 4:
+				db.RangeKeys(func(key []byte, value []byte) bool {
+					return true
+				})
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	assert.Nil(t, db.Close())
+}
@@ -0,0 +1,133 @@
+package pebbledb
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+var _ storage.Persister = (*readOnlyDB)(nil)
+
+// readOnlyDB opens an existing pebble database without altering it. All the write operations will error
+type readOnlyDB struct {
+	mutDb sync.RWMutex
+	db    *pebble.DB
+	path  string
+}
+
+// NewReadOnlyDB opens the existing pebble database found in the location given as parameter as read-only
+func NewReadOnlyDB(path string, maxOpenFiles int) (*readOnlyDB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	options := &pebble.Options{
+		MaxOpenFiles:     maxOpenFiles,
+		Logger:           &pebbleLogger{},
+		ErrorIfNotExists: true,
+		ReadOnly:         true,
+	}
+	db, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	log.Debug("opened read-only pebble db persister", "path", path)
+
+	return &readOnlyDB{
+		db:   db,
+		path: path,
+	}, nil
+}
+
+// Put returns ErrReadOnlyPersister
+func (s *readOnlyDB) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Get returns the value associated to the key
+func (s *readOnlyDB) Get(key []byte) ([]byte, error) {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	value, closer, err := s.db.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the returned value is valid only until the closer is called
+	data := make([]byte, len(value))
+	copy(data, value)
+
+	return data, closer.Close()
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *readOnlyDB) Has(key []byte) error {
+	_, err := s.Get(key)
+
+	return err
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *readOnlyDB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return
+	}
+
+	rangeKeys(s.db, s.path, handler)
+}
+
+// Remove returns ErrReadOnlyPersister
+func (s *readOnlyDB) Remove(_ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *readOnlyDB) Close() error {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	if s.db == nil {
+		return nil
+	}
+
+	db := s.db
+	s.db = nil
+	log.Debug("closing read-only pebble db persister", "path", s.path)
+
+	return db.Close()
+}
+
+// Destroy returns ErrReadOnlyPersister
+func (s *readOnlyDB) Destroy() error {
+	return storage.ErrReadOnlyPersister
+}
+
+// DestroyClosed returns ErrReadOnlyPersister
+func (s *readOnlyDB) DestroyClosed() error {
+	return storage.ErrReadOnlyPersister
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *readOnlyDB) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/statistics"
+	disabledStatistics "github.com/multiversx/mx-chain-go/common/statistics/disabled"
 	"github.com/multiversx/mx-chain-go/epochStart/notifier"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/clean"
@@ -100,6 +101,7 @@ type PruningStorer struct {
 	epochForPutOperation   uint32
 	pruningEnabled         bool
 	stateStatsHandler      common.StateStatisticsHandler
+	cacheRates             common.CacheRatesHandler
 }
 
 // NewPruningStorer will return a new instance of PruningStorer without sharded directories' naming scheme
@@ -160,6 +162,7 @@ func initPruningStorer(
 	pdb.activePersisters = activePersisters
 	pdb.lastEpochNeededHandler = pdb.lastEpochNeeded
 	pdb.stateStatsHandler = args.StateStatsHandler
+	pdb.cacheRates = args.StateStatsHandler.CacheRates(identifier)
 
 	return pdb, nil
 }
@@ -437,9 +440,27 @@ func (ps *PruningStorer) Get(key []byte) ([]byte, error) {
 	v, ok := ps.cacher.Get(key)
 	if ok {
 		ps.stateStatsHandler.IncrCache()
+		ps.cacheRates.IncrCacheHit()
 		return v.([]byte), nil
 	}
+	ps.cacheRates.IncrCacheMiss()
 
+	return ps.getFromActivePersisters(key, ps.stateStatsHandler)
+}
+
+// Prefetch loads the value of the given key in the cache, if it is not already there. The read is not counted in the
+// state statistics
+func (ps *PruningStorer) Prefetch(key []byte) error {
+	if ps.cacher.Has(key) {
+		return nil
+	}
+
+	_, err := ps.getFromActivePersisters(key, disabledStatistics.NewStateStatistics())
+
+	return err
+}
+
+func (ps *PruningStorer) getFromActivePersisters(key []byte, stateStatsHandler common.StateStatisticsHandler) ([]byte, error) {
 	// not found in cache
 	// search it in active persisters
 	ps.lock.RLock()
@@ -447,7 +468,7 @@ func (ps *PruningStorer) Get(key []byte) ([]byte, error) {
 
 	numClosedDbs := 0
 	for idx := 0; idx < len(ps.activePersisters); idx++ {
-		ps.stateStatsHandler.IncrPersister(ps.activePersisters[idx].epoch)
+		stateStatsHandler.IncrPersister(ps.activePersisters[idx].epoch)
 
 		val, err := ps.activePersisters[idx].persister.Get(key)
 		if err != nil {
@@ -502,8 +523,10 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 	v, ok := ps.cacher.Get(key)
 	if ok {
 		ps.stateStatsHandler.IncrCache()
+		ps.cacheRates.IncrCacheHit()
 		return v.([]byte), nil
 	}
+	ps.cacheRates.IncrCacheMiss()
 
 	ps.lock.RLock()
 	pd, exists := ps.persistersMapByEpoch[epoch]
@@ -561,9 +584,11 @@ func (ps *PruningStorer) GetBulkFromEpoch(keys [][]byte, epoch uint32) ([]data.K
 			keyValue := data.KeyValuePair{Key: key, Value: v.([]byte)}
 			results = append(results, keyValue)
 			ps.stateStatsHandler.IncrCache()
+			ps.cacheRates.IncrCacheHit()
 			continue
 		}
 
+		ps.cacheRates.IncrCacheMiss()
 		ps.stateStatsHandler.IncrPersister(pd.epoch)
 		res, errGet := persisterToRead.Get(key)
 		if errGet != nil {
@@ -586,8 +611,10 @@ func (ps *PruningStorer) SearchFirst(key []byte) ([]byte, error) {
 	v, ok := ps.cacher.Get(key)
 	if ok {
 		ps.stateStatsHandler.IncrCache()
+		ps.cacheRates.IncrCacheHit()
 		return v.([]byte), nil
 	}
+	ps.cacheRates.IncrCacheMiss()
 
 	var res []byte
 	var err error
@@ -17,6 +17,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core/random"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/statistics/disabled"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -622,6 +623,97 @@ func TestNewPruningStorer_ChangeEpochConcurrentPut(t *testing.T) {
 	time.Sleep(time.Second * 2)
 }
 
+func TestPruningStorer_CacheHitRates(t *testing.T) {
+	t.Parallel()
+
+	numHits := 0
+	numMisses := 0
+	ratesStorers := make([]string, 0)
+	args := getDefaultArgs()
+	args.StateStatsHandler = &testscommon.StateStatisticsHandlerStub{
+		CacheRatesCalled: func(storer string) common.CacheRatesHandler {
+			ratesStorers = append(ratesStorers, storer)
+
+			return &testscommon.CacheRatesHandlerStub{
+				IncrCacheHitCalled: func() {
+					numHits++
+				},
+				IncrCacheMissCalled: func() {
+					numMisses++
+				},
+			}
+		},
+	}
+	ps, _ := pruning.NewPruningStorer(args)
+	require.Len(t, ratesStorers, 1)
+	assert.True(t, strings.HasPrefix(ratesStorers[0], args.Identifier))
+
+	testKey := []byte("key")
+	testVal := []byte("value")
+	err := ps.Put(testKey, testVal)
+	require.Nil(t, err)
+
+	_, err = ps.Get(testKey)
+	require.Nil(t, err)
+	ps.ClearCache()
+	_, err = ps.Get(testKey)
+	require.Nil(t, err)
+	_, err = ps.SearchFirst(testKey)
+	require.Nil(t, err)
+
+	assert.Equal(t, 2, numHits)
+	assert.Equal(t, 1, numMisses)
+}
+
+func TestPruningStorer_Prefetch(t *testing.T) {
+	t.Parallel()
+
+	numHits := 0
+	numMisses := 0
+	numPersisterReads := 0
+	args := getDefaultArgs()
+	args.StateStatsHandler = &testscommon.StateStatisticsHandlerStub{
+		CacheRatesCalled: func(storer string) common.CacheRatesHandler {
+			return &testscommon.CacheRatesHandlerStub{
+				IncrCacheHitCalled: func() {
+					numHits++
+				},
+				IncrCacheMissCalled: func() {
+					numMisses++
+				},
+			}
+		},
+		IncrPersisterCalled: func(epoch uint32) {
+			numPersisterReads++
+		},
+	}
+	ps, _ := pruning.NewPruningStorer(args)
+
+	testKey := []byte("key")
+	testVal := []byte("value")
+	err := ps.Put(testKey, testVal)
+	require.Nil(t, err)
+
+	err = ps.Prefetch(testKey)
+	require.Nil(t, err)
+	ps.ClearCache()
+	err = ps.Prefetch(testKey)
+	require.Nil(t, err)
+	err = ps.Prefetch([]byte("missing key"))
+	require.NotNil(t, err)
+	assert.Zero(t, numHits)
+	assert.Zero(t, numMisses)
+	assert.Zero(t, numPersisterReads)
+
+	// the prefetched value should be served from the cache
+	val, err := ps.Get(testKey)
+	require.Nil(t, err)
+	assert.Equal(t, testVal, val)
+	assert.Equal(t, 1, numHits)
+	assert.Zero(t, numMisses)
+	assert.Zero(t, numPersisterReads)
+}
+
 func TestPruningStorer_SearchFirst(t *testing.T) {
 	t.Parallel()
 
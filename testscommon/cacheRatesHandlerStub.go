@@ -0,0 +1,21 @@
+package testscommon
+
+// CacheRatesHandlerStub -
+type CacheRatesHandlerStub struct {
+	IncrCacheHitCalled  func()
+	IncrCacheMissCalled func()
+}
+
+// IncrCacheHit -
+func (stub *CacheRatesHandlerStub) IncrCacheHit() {
+	if stub.IncrCacheHitCalled != nil {
+		stub.IncrCacheHitCalled()
+	}
+}
+
+// IncrCacheMiss -
+func (stub *CacheRatesHandlerStub) IncrCacheMiss() {
+	if stub.IncrCacheMissCalled != nil {
+		stub.IncrCacheMissCalled()
+	}
+}
@@ -0,0 +1,114 @@
+package chainSimulator
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+)
+
+// SimulatorFacadeStub -
+type SimulatorFacadeStub struct {
+	GenerateBlocksCalled                    func(numOfBlocks int) error
+	GenerateBlocksUntilEpochIsReachedCalled func(targetEpoch int32) error
+	ForceChangeOfEpochCalled                func() error
+	SetStateMultipleCalled                  func(stateSlice []*dtos.AddressState) error
+	SetKeyValueForAddressCalled             func(address string, keyValueMap map[string]string) error
+	AddValidatorKeysCalled                  func(validatorsPrivateKeys [][]byte) error
+	GenerateAndMintWalletAddressCalled      func(targetShardID uint32, value *big.Int) (dtos.WalletAddress, error)
+	GetRestAPIInterfacesCalled              func() map[uint32]string
+	StartAutoBlockGenerationCalled          func(args dtos.AutoBlockGeneration) error
+	StopAutoBlockGenerationCalled           func()
+}
+
+// GenerateBlocks -
+func (stub *SimulatorFacadeStub) GenerateBlocks(numOfBlocks int) error {
+	if stub.GenerateBlocksCalled != nil {
+		return stub.GenerateBlocksCalled(numOfBlocks)
+	}
+
+	return nil
+}
+
+// GenerateBlocksUntilEpochIsReached -
+func (stub *SimulatorFacadeStub) GenerateBlocksUntilEpochIsReached(targetEpoch int32) error {
+	if stub.GenerateBlocksUntilEpochIsReachedCalled != nil {
+		return stub.GenerateBlocksUntilEpochIsReachedCalled(targetEpoch)
+	}
+
+	return nil
+}
+
+// ForceChangeOfEpoch -
+func (stub *SimulatorFacadeStub) ForceChangeOfEpoch() error {
+	if stub.ForceChangeOfEpochCalled != nil {
+		return stub.ForceChangeOfEpochCalled()
+	}
+
+	return nil
+}
+
+// SetStateMultiple -
+func (stub *SimulatorFacadeStub) SetStateMultiple(stateSlice []*dtos.AddressState) error {
+	if stub.SetStateMultipleCalled != nil {
+		return stub.SetStateMultipleCalled(stateSlice)
+	}
+
+	return nil
+}
+
+// SetKeyValueForAddress -
+func (stub *SimulatorFacadeStub) SetKeyValueForAddress(address string, keyValueMap map[string]string) error {
+	if stub.SetKeyValueForAddressCalled != nil {
+		return stub.SetKeyValueForAddressCalled(address, keyValueMap)
+	}
+
+	return nil
+}
+
+// AddValidatorKeys -
+func (stub *SimulatorFacadeStub) AddValidatorKeys(validatorsPrivateKeys [][]byte) error {
+	if stub.AddValidatorKeysCalled != nil {
+		return stub.AddValidatorKeysCalled(validatorsPrivateKeys)
+	}
+
+	return nil
+}
+
+// GenerateAndMintWalletAddress -
+func (stub *SimulatorFacadeStub) GenerateAndMintWalletAddress(targetShardID uint32, value *big.Int) (dtos.WalletAddress, error) {
+	if stub.GenerateAndMintWalletAddressCalled != nil {
+		return stub.GenerateAndMintWalletAddressCalled(targetShardID, value)
+	}
+
+	return dtos.WalletAddress{}, nil
+}
+
+// GetRestAPIInterfaces -
+func (stub *SimulatorFacadeStub) GetRestAPIInterfaces() map[uint32]string {
+	if stub.GetRestAPIInterfacesCalled != nil {
+		return stub.GetRestAPIInterfacesCalled()
+	}
+
+	return nil
+}
+
+// StartAutoBlockGeneration -
+func (stub *SimulatorFacadeStub) StartAutoBlockGeneration(args dtos.AutoBlockGeneration) error {
+	if stub.StartAutoBlockGenerationCalled != nil {
+		return stub.StartAutoBlockGenerationCalled(args)
+	}
+
+	return nil
+}
+
+// StopAutoBlockGeneration -
+func (stub *SimulatorFacadeStub) StopAutoBlockGeneration() {
+	if stub.StopAutoBlockGenerationCalled != nil {
+		stub.StopAutoBlockGenerationCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *SimulatorFacadeStub) IsInterfaceNil() bool {
+	return stub == nil
+}
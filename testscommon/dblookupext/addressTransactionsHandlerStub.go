@@ -0,0 +1,52 @@
+package dblookupext
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
+)
+
+// AddressTransactionsHandlerStub -
+type AddressTransactionsHandlerStub struct {
+	RecordBlockCalled     func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrResultsFromPool map[string]data.TransactionHandler, createdIntraShardMiniBlocks []*block.MiniBlock) error
+	RevertBlockCalled     func(blockHeaderHash []byte) error
+	GetTransactionsCalled func(query addressTransactions.Query) (*addressTransactions.Page, error)
+}
+
+// RecordBlock -
+func (stub *AddressTransactionsHandlerStub) RecordBlock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	blockBody data.BodyHandler,
+	scrResultsFromPool map[string]data.TransactionHandler,
+	createdIntraShardMiniBlocks []*block.MiniBlock,
+) error {
+	if stub.RecordBlockCalled != nil {
+		return stub.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, createdIntraShardMiniBlocks)
+	}
+
+	return nil
+}
+
+// RevertBlock -
+func (stub *AddressTransactionsHandlerStub) RevertBlock(blockHeaderHash []byte) error {
+	if stub.RevertBlockCalled != nil {
+		return stub.RevertBlockCalled(blockHeaderHash)
+	}
+
+	return nil
+}
+
+// GetTransactions -
+func (stub *AddressTransactionsHandlerStub) GetTransactions(query addressTransactions.Query) (*addressTransactions.Page, error) {
+	if stub.GetTransactionsCalled != nil {
+		return stub.GetTransactionsCalled(query)
+	}
+
+	return &addressTransactions.Page{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *AddressTransactionsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 )
 
@@ -17,6 +18,7 @@ type HistoryRepositoryStub struct {
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
+	GetAddressTransactionsCalled       func(query addressTransactions.Query) (*addressTransactions.Page, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -89,6 +91,15 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// GetAddressTransactions -
+func (hp *HistoryRepositoryStub) GetAddressTransactions(query addressTransactions.Query) (*addressTransactions.Page, error) {
+	if hp.GetAddressTransactionsCalled != nil {
+		return hp.GetAddressTransactionsCalled(query)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
@@ -0,0 +1,39 @@
+package testscommon
+
+import (
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// ExecutionTracerStub -
+type ExecutionTracerStub struct {
+	TraceCallCalled                func(execution process.TracedExecution, input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error)
+	TraceBuiltInFunctionCallCalled func(execution process.TracedExecution, input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error)
+	TraceDeployCalled              func(execution process.TracedExecution, txHash []byte, input *vmcommon.ContractCreateInput, output *vmcommon.VMOutput, err error)
+}
+
+// TraceCall -
+func (stub *ExecutionTracerStub) TraceCall(execution process.TracedExecution, input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error) {
+	if stub.TraceCallCalled != nil {
+		stub.TraceCallCalled(execution, input, output, err)
+	}
+}
+
+// TraceBuiltInFunctionCall -
+func (stub *ExecutionTracerStub) TraceBuiltInFunctionCall(execution process.TracedExecution, input *vmcommon.ContractCallInput, output *vmcommon.VMOutput, err error) {
+	if stub.TraceBuiltInFunctionCallCalled != nil {
+		stub.TraceBuiltInFunctionCallCalled(execution, input, output, err)
+	}
+}
+
+// TraceDeploy -
+func (stub *ExecutionTracerStub) TraceDeploy(execution process.TracedExecution, txHash []byte, input *vmcommon.ContractCreateInput, output *vmcommon.VMOutput, err error) {
+	if stub.TraceDeployCalled != nil {
+		stub.TraceDeployCalled(execution, txHash, input, output, err)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ExecutionTracerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,20 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/process"
+
+// GasProfilerStub -
+type GasProfilerStub struct {
+	ProfileExecutionCalled func(execution process.ProfiledExecution)
+}
+
+// ProfileExecution -
+func (stub *GasProfilerStub) ProfileExecution(execution process.ProfiledExecution) {
+	if stub.ProfileExecutionCalled != nil {
+		stub.ProfileExecutionCalled(execution)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *GasProfilerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -12,6 +12,7 @@ type OutportStub struct {
 	SaveValidatorsPubKeysCalled func(validatorsPubKeys *outportcore.ValidatorsPubKeys)
 	HasDriversCalled            func() bool
 	SaveRoundsInfoCalled        func(roundsInfo *outportcore.RoundsInfo)
+	SubscribeDriverCalled       func(driver outport.Driver) error
 }
 
 // SaveBlock -
@@ -74,7 +75,11 @@ func (as *OutportStub) SaveRoundsInfo(roundsInfo *outportcore.RoundsInfo) {
 }
 
 // SubscribeDriver -
-func (as *OutportStub) SubscribeDriver(_ outport.Driver) error {
+func (as *OutportStub) SubscribeDriver(driver outport.Driver) error {
+	if as.SubscribeDriverCalled != nil {
+		return as.SubscribeDriverCalled(driver)
+	}
+
 	return nil
 }
 
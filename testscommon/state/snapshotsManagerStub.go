@@ -11,6 +11,7 @@ type SnapshotsManagerStub struct {
 	StartSnapshotAfterRestartIfNeededCalled func(trieStorageManager common.StorageManager) error
 	IsSnapshotInProgressCalled              func() bool
 	SetSyncerCalled                         func(syncer state.AccountsDBSyncer) error
+	ExportStateSnapshotCalled               func(rootHash []byte, trieStorageManager common.StorageManager, writer state.StateSnapshotWriter) error
 }
 
 // SnapshotState -
@@ -44,6 +45,14 @@ func (s *SnapshotsManagerStub) SetSyncer(syncer state.AccountsDBSyncer) error {
 	return nil
 }
 
+// ExportStateSnapshot -
+func (s *SnapshotsManagerStub) ExportStateSnapshot(rootHash []byte, trieStorageManager common.StorageManager, writer state.StateSnapshotWriter) error {
+	if s.ExportStateSnapshotCalled != nil {
+		return s.ExportStateSnapshotCalled(rootHash, trieStorageManager, writer)
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s *SnapshotsManagerStub) IsInterfaceNil() bool {
 	return s == nil
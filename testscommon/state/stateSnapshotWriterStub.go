@@ -0,0 +1,32 @@
+package state
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// StateSnapshotWriterStub -
+type StateSnapshotWriterStub struct {
+	WriteMainTrieCalled func(rootHash []byte, db common.TrieStorageInteractor, leafHandler func(key []byte, value []byte) error) error
+	WriteDataTrieCalled func(rootHash []byte, db common.TrieStorageInteractor) error
+}
+
+// WriteMainTrie -
+func (s *StateSnapshotWriterStub) WriteMainTrie(rootHash []byte, db common.TrieStorageInteractor, leafHandler func(key []byte, value []byte) error) error {
+	if s.WriteMainTrieCalled != nil {
+		return s.WriteMainTrieCalled(rootHash, db, leafHandler)
+	}
+	return nil
+}
+
+// WriteDataTrie -
+func (s *StateSnapshotWriterStub) WriteDataTrie(rootHash []byte, db common.TrieStorageInteractor) error {
+	if s.WriteDataTrieCalled != nil {
+		return s.WriteDataTrieCalled(rootHash, db)
+	}
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *StateSnapshotWriterStub) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -1,5 +1,7 @@
 package testscommon
 
+import "github.com/multiversx/mx-chain-go/common"
+
 // StateStatisticsHandlerStub -
 type StateStatisticsHandlerStub struct {
 	ResetCalled                 func()
@@ -16,6 +18,9 @@ type StateStatisticsHandlerStub struct {
 	SnapshotPersisterCalled     func(epoch uint32) uint64
 	IncrTrieCalled              func()
 	TrieCalled                  func() uint64
+	CacheRatesCalled            func(storer string) common.CacheRatesHandler
+	CacheHitsCalled             func(storer string) uint64
+	CacheMissesCalled           func(storer string) uint64
 	ProcessingStatsCalled       func() []string
 	SnapshotStatsCalled         func() []string
 }
@@ -130,6 +135,33 @@ func (stub *StateStatisticsHandlerStub) Trie() uint64 {
 	return 0
 }
 
+// CacheRates -
+func (stub *StateStatisticsHandlerStub) CacheRates(storer string) common.CacheRatesHandler {
+	if stub.CacheRatesCalled != nil {
+		return stub.CacheRatesCalled(storer)
+	}
+
+	return &CacheRatesHandlerStub{}
+}
+
+// CacheHits -
+func (stub *StateStatisticsHandlerStub) CacheHits(storer string) uint64 {
+	if stub.CacheHitsCalled != nil {
+		return stub.CacheHitsCalled(storer)
+	}
+
+	return 0
+}
+
+// CacheMisses -
+func (stub *StateStatisticsHandlerStub) CacheMisses(storer string) uint64 {
+	if stub.CacheMissesCalled != nil {
+		return stub.CacheMissesCalled(storer)
+	}
+
+	return 0
+}
+
 // ProcessingStats -
 func (stub *StateStatisticsHandlerStub) ProcessingStats() []string {
 	if stub.ProcessingStatsCalled != nil {
@@ -21,6 +21,7 @@ type StorerStub struct {
 	RangeKeysCalled              func(handler func(key []byte, val []byte) bool)
 	GetIdentifierCalled          func() string
 	CloseCalled                  func() error
+	PrefetchCalled               func(key []byte) error
 }
 
 // Put -
@@ -141,6 +142,14 @@ func (ss *StorerStub) Close() error {
 	return nil
 }
 
+// Prefetch -
+func (ss *StorerStub) Prefetch(key []byte) error {
+	if ss.PrefetchCalled != nil {
+		return ss.PrefetchCalled(key)
+	}
+	return nil
+}
+
 // IsInterfaceNil -
 func (ss *StorerStub) IsInterfaceNil() bool {
 	return ss == nil
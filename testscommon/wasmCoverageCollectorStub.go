@@ -0,0 +1,25 @@
+package testscommon
+
+import (
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// WasmCoverageCollectorStub -
+type WasmCoverageCollectorStub struct {
+	CreateExecutorFactoryCalled func(executorFactory executor.ExecutorAbstractFactory, hasher hashing.Hasher) (executor.ExecutorAbstractFactory, error)
+}
+
+// CreateExecutorFactory -
+func (stub *WasmCoverageCollectorStub) CreateExecutorFactory(executorFactory executor.ExecutorAbstractFactory, hasher hashing.Hasher) (executor.ExecutorAbstractFactory, error) {
+	if stub.CreateExecutorFactoryCalled != nil {
+		return stub.CreateExecutorFactoryCalled(executorFactory, hasher)
+	}
+
+	return executorFactory, nil
+}
+
+// IsInterfaceNil -
+func (stub *WasmCoverageCollectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -129,3 +129,6 @@ var ErrEmptyInitialIteratorState = errors.New("empty initial iterator state")
 
 // ErrInvalidIteratorState signals that an invalid iterator state was provided
 var ErrInvalidIteratorState = errors.New("invalid iterator state")
+
+// ErrInvalidTrieNodesWarmupConfig signals that an invalid trie nodes warmup config has been provided
+var ErrInvalidTrieNodesWarmupConfig = errors.New("invalid trie nodes warmup config")
@@ -1,6 +1,8 @@
 package factory
 
 import (
+	"path/filepath"
+
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
@@ -12,6 +14,9 @@ import (
 	"github.com/multiversx/mx-chain-go/trie"
 )
 
+// trieNodesWarmupDirectory is the directory, relative to the databases path, where the most accessed trie nodes are saved
+const trieNodesWarmupDirectory = "TrieNodesWarmup"
+
 // TrieCreateArgs holds arguments for calling the Create method on the TrieFactory
 type TrieCreateArgs struct {
 	MainStorer          storage.Storer
@@ -22,6 +27,7 @@ type TrieCreateArgs struct {
 	Identifier          string
 	EnableEpochsHandler common.EnableEpochsHandler
 	StatsCollector      common.StateStatisticsHandler
+	TrieNodesWarmup     bool
 }
 
 type trieCreator struct {
@@ -64,6 +70,9 @@ func (tc *trieCreator) Create(args TrieCreateArgs) (common.StorageManager, commo
 		Identifier:     args.Identifier,
 		StatsCollector: args.StatsCollector,
 	}
+	if args.TrieNodesWarmup {
+		storageManagerArgs.WarmupFilePath = filepath.Join(tc.pathManager.DatabasePath(), trieNodesWarmupDirectory, args.Identifier)
+	}
 
 	options := trie.StorageManagerOptions{
 		PruningEnabled:   args.PruningEnabled,
@@ -91,12 +100,14 @@ func (tc *trieCreator) IsInterfaceNil() bool {
 	return tc == nil
 }
 
-// CreateTriesComponentsForShardId creates the user and peer tries and trieStorageManagers
+// CreateTriesComponentsForShardId creates the user and peer tries and trieStorageManagers. When trieNodesWarmup is set,
+// the trie storage managers will persist their most accessed trie nodes on close and prefetch them on creation
 func CreateTriesComponentsForShardId(
 	generalConfig config.Config,
 	coreComponentsHolder coreComponentsHandler,
 	storageService dataRetriever.StorageService,
 	stateStatsHandler common.StateStatisticsHandler,
+	trieNodesWarmup bool,
 ) (common.TriesHolder, map[string]common.StorageManager, error) {
 	trieFactoryArgs := TrieFactoryArgs{
 		Marshalizer:              coreComponentsHolder.InternalMarshalizer(),
@@ -123,6 +134,7 @@ func CreateTriesComponentsForShardId(
 		Identifier:          dataRetriever.UserAccountsUnit.String(),
 		EnableEpochsHandler: coreComponentsHolder.EnableEpochsHandler(),
 		StatsCollector:      stateStatsHandler,
+		TrieNodesWarmup:     trieNodesWarmup,
 	}
 	userStorageManager, userAccountTrie, err := trFactory.Create(args)
 	if err != nil {
@@ -149,6 +161,7 @@ func CreateTriesComponentsForShardId(
 		Identifier:          dataRetriever.PeerAccountsUnit.String(),
 		EnableEpochsHandler: coreComponentsHolder.EnableEpochsHandler(),
 		StatsCollector:      stateStatsHandler,
+		TrieNodesWarmup:     trieNodesWarmup,
 	}
 	peerStorageManager, peerAccountsTrie, err := trFactory.Create(args)
 	if err != nil {
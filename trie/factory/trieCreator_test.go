@@ -177,6 +177,7 @@ func TestTrieCreator_CreateTriesComponentsForShardId(t *testing.T) {
 				},
 			},
 			disabled.NewStateStatistics(),
+			false,
 		)
 		require.NotNil(t, holder)
 		require.NotNil(t, storageManager)
@@ -206,6 +207,7 @@ func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T
 				},
 			},
 			disabled.NewStateStatistics(),
+			false,
 		)
 		require.True(t, check.IfNil(holder))
 		require.Nil(t, storageManager)
@@ -87,6 +87,12 @@ type epochStorer interface {
 	SetEpochForPutOperation(epoch uint32)
 }
 
+// trieNodesPrefetcher is used for storers able to load values in their cache without counting the reads in the state
+// statistics
+type trieNodesPrefetcher interface {
+	Prefetch(key []byte) error
+}
+
 type snapshotPruningStorer interface {
 	common.BaseStorer
 	GetFromOldEpochsWithoutAddingToCache(key []byte, maxEpochToSearchFrom uint32) ([]byte, core.OptionalUint32, error)
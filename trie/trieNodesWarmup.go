@@ -0,0 +1,144 @@
+package trie
+
+import (
+	"bufio"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// trieNodesAccessTracker counts the accesses of the trie nodes so that the most accessed ones can be persisted on
+// close and prefetched on the next startup. When the maximum number of tracked nodes is reached, all the counters are
+// halved and the nodes which are no longer accessed are discarded, so recent accesses weigh more than the old ones
+type trieNodesAccessTracker struct {
+	mutCounters     sync.Mutex
+	counters        map[string]uint32
+	maxTrackedNodes int
+}
+
+func newTrieNodesAccessTracker(maxTrackedNodes int) *trieNodesAccessTracker {
+	return &trieNodesAccessTracker{
+		counters:        make(map[string]uint32),
+		maxTrackedNodes: maxTrackedNodes,
+	}
+}
+
+// add records an access of the given trie node. It does nothing if the tracker is disabled
+func (tracker *trieNodesAccessTracker) add(hash []byte) {
+	if tracker.maxTrackedNodes == 0 {
+		return
+	}
+
+	tracker.mutCounters.Lock()
+	defer tracker.mutCounters.Unlock()
+
+	tracker.counters[string(hash)]++
+	if len(tracker.counters) <= tracker.maxTrackedNodes {
+		return
+	}
+
+	for len(tracker.counters) > tracker.maxTrackedNodes*3/4 {
+		tracker.decay()
+	}
+}
+
+func (tracker *trieNodesAccessTracker) decay() {
+	for hash, counter := range tracker.counters {
+		counter /= 2
+		if counter == 0 {
+			delete(tracker.counters, hash)
+			continue
+		}
+
+		tracker.counters[hash] = counter
+	}
+}
+
+// mostAccessed returns the hashes of the most accessed trie nodes, the most accessed one first
+func (tracker *trieNodesAccessTracker) mostAccessed(maxNodes int) [][]byte {
+	tracker.mutCounters.Lock()
+	hashes := make([]string, 0, len(tracker.counters))
+	for hash := range tracker.counters {
+		hashes = append(hashes, hash)
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		return tracker.counters[hashes[i]] > tracker.counters[hashes[j]]
+	})
+	tracker.mutCounters.Unlock()
+
+	if len(hashes) > maxNodes {
+		hashes = hashes[:maxNodes]
+	}
+
+	result := make([][]byte, 0, len(hashes))
+	for _, hash := range hashes {
+		result = append(result, []byte(hash))
+	}
+
+	return result
+}
+
+// saveTrieNodesHashes writes the given hashes in the file, one hex encoded hash per line. The file is first written
+// in a temporary file and then renamed, so a crash while saving does not leave a partially written file behind
+func saveTrieNodesHashes(filePath string, hashes [][]byte) error {
+	err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	tmpFilePath := filePath + ".tmp"
+	file, err := os.Create(tmpFilePath)
+	if err != nil {
+		return err
+	}
+
+	err = writeTrieNodesHashes(file, hashes)
+	errClose := file.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err != nil {
+		_ = os.Remove(tmpFilePath)
+		return err
+	}
+
+	return os.Rename(tmpFilePath, filePath)
+}
+
+func writeTrieNodesHashes(file *os.File, hashes [][]byte) error {
+	writer := bufio.NewWriter(file)
+	for _, hash := range hashes {
+		_, err := writer.WriteString(hex.EncodeToString(hash) + "\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	return writer.Flush()
+}
+
+// loadTrieNodesHashes reads at most maxNodes hashes from the file. Invalid lines are skipped
+func loadTrieNodesHashes(filePath string, maxNodes int) ([][]byte, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	hashes := make([][]byte, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() && len(hashes) < maxNodes {
+		hash, errDecode := hex.DecodeString(scanner.Text())
+		if errDecode != nil || len(hash) == 0 {
+			continue
+		}
+
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, scanner.Err()
+}
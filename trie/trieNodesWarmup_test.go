@@ -0,0 +1,94 @@
+package trie
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrieNodesAccessTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled tracker should not track", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := newTrieNodesAccessTracker(0)
+		tracker.add([]byte("hash"))
+		assert.Empty(t, tracker.mostAccessed(10))
+	})
+	t.Run("should return the most accessed nodes first", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := newTrieNodesAccessTracker(10)
+		tracker.add([]byte("hash1"))
+		tracker.add([]byte("hash2"))
+		tracker.add([]byte("hash2"))
+		tracker.add([]byte("hash2"))
+		tracker.add([]byte("hash3"))
+		tracker.add([]byte("hash3"))
+
+		assert.Equal(t, [][]byte{[]byte("hash2"), []byte("hash3"), []byte("hash1")}, tracker.mostAccessed(10))
+		assert.Equal(t, [][]byte{[]byte("hash2"), []byte("hash3")}, tracker.mostAccessed(2))
+	})
+	t.Run("should discard the least accessed nodes when full", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := newTrieNodesAccessTracker(4)
+		for i := 0; i < 4; i++ {
+			tracker.add([]byte("hot"))
+		}
+		tracker.add([]byte("cold1"))
+		tracker.add([]byte("cold2"))
+		tracker.add([]byte("cold3"))
+		tracker.add([]byte("cold4"))
+
+		mostAccessed := tracker.mostAccessed(10)
+		assert.Equal(t, [][]byte{[]byte("hot")}, mostAccessed)
+		assert.Equal(t, uint32(2), tracker.counters["hot"])
+	})
+}
+
+func TestSaveAndLoadTrieNodesHashes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		hashes, err := loadTrieNodesHashes(filepath.Join(t.TempDir(), "missing"), 10)
+		assert.True(t, os.IsNotExist(err))
+		assert.Nil(t, hashes)
+	})
+	t.Run("should save and load the hashes", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "warmup", "UserAccountsUnit")
+		hashes := [][]byte{[]byte("hash1"), []byte("hash2"), []byte("hash3")}
+		err := saveTrieNodesHashes(filePath, hashes)
+		require.Nil(t, err)
+
+		_, err = os.Stat(filePath + ".tmp")
+		assert.True(t, os.IsNotExist(err))
+
+		loadedHashes, err := loadTrieNodesHashes(filePath, 10)
+		require.Nil(t, err)
+		assert.Equal(t, hashes, loadedHashes)
+
+		loadedHashes, err = loadTrieNodesHashes(filePath, 2)
+		require.Nil(t, err)
+		assert.Equal(t, hashes[:2], loadedHashes)
+	})
+	t.Run("invalid lines should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "UserAccountsUnit")
+		err := os.WriteFile(filePath, []byte("6161\nnot hex\n\n6262\n"), os.ModePerm)
+		require.Nil(t, err)
+
+		loadedHashes, err := loadTrieNodesHashes(filePath, 10)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("aa"), []byte("bb")}, loadedHashes)
+	})
+}
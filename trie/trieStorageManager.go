@@ -31,6 +31,9 @@ type trieStorageManager struct {
 	idleProvider          IdleNodeProvider
 	identifier            string
 	statsCollector        common.StateStatisticsHandler
+	warmupFilePath        string
+	warmupMaxNodes        int
+	accessTracker         *trieNodesAccessTracker
 }
 
 type snapshotsQueueEntry struct {
@@ -52,6 +55,7 @@ type NewTrieStorageManagerArgs struct {
 	IdleProvider   IdleNodeProvider
 	Identifier     string
 	StatsCollector common.StateStatisticsHandler
+	WarmupFilePath string
 }
 
 // NewTrieStorageManager creates a new instance of trieStorageManager
@@ -74,6 +78,13 @@ func NewTrieStorageManager(args NewTrieStorageManagerArgs) (*trieStorageManager,
 	if check.IfNil(args.StatsCollector) {
 		return nil, storage.ErrNilStatsCollector
 	}
+	isWarmupEnabled := args.GeneralConfig.Warmup.Enabled && len(args.WarmupFilePath) > 0
+	if isWarmupEnabled {
+		err := checkTrieNodesWarmupConfig(args.GeneralConfig.Warmup)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
@@ -86,16 +97,100 @@ func NewTrieStorageManager(args NewTrieStorageManagerArgs) (*trieStorageManager,
 		idleProvider:       args.IdleProvider,
 		identifier:         args.Identifier,
 		statsCollector:     args.StatsCollector,
+		accessTracker:      newTrieNodesAccessTracker(0),
 	}
 	goRoutinesThrottler, err := throttler.NewNumGoRoutinesThrottler(int32(args.GeneralConfig.SnapshotsGoroutineNum))
 	if err != nil {
 		return nil, err
 	}
 
+	if isWarmupEnabled {
+		tsm.warmupFilePath = args.WarmupFilePath
+		tsm.warmupMaxNodes = int(args.GeneralConfig.Warmup.MaxNodes)
+		tsm.accessTracker = newTrieNodesAccessTracker(int(args.GeneralConfig.Warmup.MaxTrackedNodes))
+		go tsm.prefetchTrieNodes(ctx)
+	}
+
 	go tsm.doSnapshot(ctx, args.Marshalizer, args.Hasher, goRoutinesThrottler)
 	return tsm, nil
 }
 
+func checkTrieNodesWarmupConfig(warmupConfig config.TrieNodesWarmupConfig) error {
+	if warmupConfig.MaxNodes == 0 {
+		return fmt.Errorf("%w, MaxNodes should be greater than 0", ErrInvalidTrieNodesWarmupConfig)
+	}
+	if warmupConfig.MaxTrackedNodes < warmupConfig.MaxNodes {
+		return fmt.Errorf("%w, MaxTrackedNodes should not be lower than MaxNodes", ErrInvalidTrieNodesWarmupConfig)
+	}
+
+	return nil
+}
+
+// prefetchTrieNodes loads in the main storer cache the trie nodes that were the most accessed before the last close.
+// The prefetch reads are not counted in the state statistics, so the cache hit rates reflect only the processing
+func (tsm *trieStorageManager) prefetchTrieNodes(ctx context.Context) {
+	prefetcher, ok := tsm.mainStorer.(trieNodesPrefetcher)
+	if !ok {
+		log.Debug("trie nodes warmup skipped, the main storer can not prefetch",
+			"identifier", tsm.identifier, "storer type", fmt.Sprintf("%T", tsm.mainStorer))
+		return
+	}
+
+	hashes, err := loadTrieNodesHashes(tsm.warmupFilePath, tsm.warmupMaxNodes)
+	if err != nil {
+		log.Debug("trie nodes warmup skipped", "identifier", tsm.identifier, "error", err.Error())
+		return
+	}
+
+	startTime := time.Now()
+	numPrefetched := 0
+	for _, hash := range hashes {
+		select {
+		case <-ctx.Done():
+			log.Debug("trie nodes warmup interrupted", "identifier", tsm.identifier, "num prefetched", numPrefetched)
+			return
+		default:
+		}
+
+		if tsm.prefetchTrieNode(prefetcher, hash) {
+			numPrefetched++
+		}
+	}
+
+	log.Info("trie nodes warmup finished", "identifier", tsm.identifier,
+		"num hashes", len(hashes), "num prefetched", numPrefetched, "duration", time.Since(startTime))
+}
+
+func (tsm *trieStorageManager) prefetchTrieNode(prefetcher trieNodesPrefetcher, hash []byte) bool {
+	tsm.storageOperationMutex.RLock()
+	defer tsm.storageOperationMutex.RUnlock()
+
+	if tsm.closed {
+		return false
+	}
+
+	return prefetcher.Prefetch(hash) == nil
+}
+
+func (tsm *trieStorageManager) saveMostAccessedTrieNodes() {
+	if len(tsm.warmupFilePath) == 0 {
+		return
+	}
+
+	hashes := tsm.accessTracker.mostAccessed(tsm.warmupMaxNodes)
+	if len(hashes) == 0 {
+		return
+	}
+
+	err := saveTrieNodesHashes(tsm.warmupFilePath, hashes)
+	if err != nil {
+		log.Warn("could not save the most accessed trie nodes", "identifier", tsm.identifier, "error", err.Error())
+		return
+	}
+
+	log.Debug("saved the most accessed trie nodes", "identifier", tsm.identifier, "num hashes", len(hashes))
+}
+
 func (tsm *trieStorageManager) doSnapshot(ctx context.Context, msh marshal.Marshalizer, hsh hashing.Hasher, goRoutinesThrottler core.Throttler) {
 	tsm.doProcessLoop(ctx, msh, hsh, goRoutinesThrottler)
 	tsm.cleanupChans()
@@ -174,6 +269,8 @@ func (tsm *trieStorageManager) Get(key []byte) ([]byte, error) {
 		return nil, ErrKeyNotFound
 	}
 
+	tsm.accessTracker.add(key)
+
 	return val, nil
 }
 
@@ -491,6 +588,8 @@ func (tsm *trieStorageManager) Close() error {
 	// (just to close some go routines started as edge cases that would otherwise hang)
 	defer tsm.closer.Close()
 
+	tsm.saveMostAccessedTrieNodes()
+
 	var err error
 
 	errMainStorerClose := tsm.mainStorer.Close()
@@ -1,7 +1,10 @@
 package trie_test
 
 import (
+	"encoding/hex"
 	errorsGo "errors"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -10,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/config"
 	storageMx "github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/storage"
@@ -89,6 +93,35 @@ func TestNewTrieStorageManager(t *testing.T) {
 		assert.Nil(t, ts)
 		assert.Equal(t, trie.ErrInvalidIdentifier, err)
 	})
+	t.Run("invalid warmup max nodes", func(t *testing.T) {
+		t.Parallel()
+
+		args := trie.GetDefaultTrieStorageManagerParameters()
+		args.GeneralConfig.Warmup = config.TrieNodesWarmupConfig{Enabled: true, MaxNodes: 0, MaxTrackedNodes: 10}
+		args.WarmupFilePath = filepath.Join(t.TempDir(), "warmup")
+		ts, err := trie.NewTrieStorageManager(args)
+		assert.Nil(t, ts)
+		assert.True(t, errorsGo.Is(err, trie.ErrInvalidTrieNodesWarmupConfig))
+	})
+	t.Run("invalid warmup max tracked nodes", func(t *testing.T) {
+		t.Parallel()
+
+		args := trie.GetDefaultTrieStorageManagerParameters()
+		args.GeneralConfig.Warmup = config.TrieNodesWarmupConfig{Enabled: true, MaxNodes: 10, MaxTrackedNodes: 9}
+		args.WarmupFilePath = filepath.Join(t.TempDir(), "warmup")
+		ts, err := trie.NewTrieStorageManager(args)
+		assert.Nil(t, ts)
+		assert.True(t, errorsGo.Is(err, trie.ErrInvalidTrieNodesWarmupConfig))
+	})
+	t.Run("invalid warmup config without warmup file should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := trie.GetDefaultTrieStorageManagerParameters()
+		args.GeneralConfig.Warmup = config.TrieNodesWarmupConfig{Enabled: true}
+		ts, err := trie.NewTrieStorageManager(args)
+		assert.Nil(t, err)
+		assert.NotNil(t, ts)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -608,6 +641,82 @@ func TestTrieStorageManager_Close(t *testing.T) {
 	})
 }
 
+func TestTrieStorageManager_TrieNodesWarmup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("warmup disabled should not save the accessed nodes", func(t *testing.T) {
+		t.Parallel()
+
+		warmupFilePath := filepath.Join(t.TempDir(), "warmup")
+		args := trie.GetDefaultTrieStorageManagerParameters()
+		args.WarmupFilePath = warmupFilePath
+		ts, _ := trie.NewTrieStorageManager(args)
+
+		_ = ts.Put(providedKey, providedVal)
+		_, _ = ts.Get(providedKey)
+		err := ts.Close()
+		require.Nil(t, err)
+
+		_, err = os.Stat(warmupFilePath)
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("should save the most accessed nodes on close and prefetch them on startup", func(t *testing.T) {
+		t.Parallel()
+
+		warmupFilePath := filepath.Join(t.TempDir(), "warmup")
+		args := trie.GetDefaultTrieStorageManagerParameters()
+		args.GeneralConfig.Warmup = config.TrieNodesWarmupConfig{Enabled: true, MaxNodes: 2, MaxTrackedNodes: 10}
+		args.WarmupFilePath = warmupFilePath
+		ts, err := trie.NewTrieStorageManager(args)
+		require.Nil(t, err)
+
+		accesses := map[string]int{"key1": 1, "key2": 3, "key3": 2}
+		for key, numAccesses := range accesses {
+			_ = ts.Put([]byte(key), providedVal)
+			for i := 0; i < numAccesses; i++ {
+				_, err = ts.Get([]byte(key))
+				require.Nil(t, err)
+			}
+		}
+		_, _ = ts.Get([]byte("missing key"))
+
+		err = ts.Close()
+		require.Nil(t, err)
+
+		savedHashes, err := os.ReadFile(warmupFilePath)
+		require.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString([]byte("key2"))+"\n"+hex.EncodeToString([]byte("key3"))+"\n", string(savedHashes))
+
+		mutPrefetched := sync.Mutex{}
+		prefetched := make([]string, 0)
+		args.MainStorer = &storage.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				require.Fail(t, "the prefetch should not read through Get")
+				return nil, nil
+			},
+			PrefetchCalled: func(key []byte) error {
+				mutPrefetched.Lock()
+				prefetched = append(prefetched, string(key))
+				mutPrefetched.Unlock()
+
+				return nil
+			},
+		}
+		ts, err = trie.NewTrieStorageManager(args)
+		require.Nil(t, err)
+
+		require.Eventually(t, func() bool {
+			mutPrefetched.Lock()
+			defer mutPrefetched.Unlock()
+
+			return len(prefetched) == 2
+		}, time.Second, time.Millisecond*10)
+		assert.Equal(t, []string{"key2", "key3"}, prefetched)
+
+		_ = ts.Close()
+	})
+}
+
 func TestWriteInChanNonBlocking(t *testing.T) {
 	t.Parallel()
 
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
+	commonDisabled "github.com/multiversx/mx-chain-go/common/disabled"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/errors"
@@ -420,6 +421,7 @@ func (si *stateImport) getAccountsDB(accType Type, shardID uint32, accountFactor
 				StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
 				AddressConverter:      si.addressConverter,
 				SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+				AppStatusHandler:      commonDisabled.NewAppStatusHandler(),
 			}
 			accountsDB, errCreate := state.NewAccountsDB(argsAccountDB)
 			if errCreate != nil {
@@ -443,6 +445,7 @@ func (si *stateImport) getAccountsDB(accType Type, shardID uint32, accountFactor
 		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
 		AddressConverter:      si.addressConverter,
 		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+		AppStatusHandler:      commonDisabled.NewAppStatusHandler(),
 	}
 	accountsDB, err = state.NewAccountsDB(argsAccountDB)
 	si.accountDBsMap[shardID] = accountsDB